@@ -139,3 +139,14 @@ const (
 	// VirtualMachineImage provider doesn't meet security compliance requirements.
 	VirtualMachineImageProviderSecurityNotCompliantReason = "VirtualMachineImageProviderSecurityNotCompliant"
 )
+
+// Condition.Reason for Conditions related to ContentSources.
+const (
+	// ContentSourceGetImagesFailedReason (Severity=Error) documents that the
+	// images of the ContentSource's provider could not be listed.
+	ContentSourceGetImagesFailedReason = "GetImagesFailed"
+
+	// ContentSourceImportFailedReason (Severity=Error) documents that one or
+	// more images of the ContentSource's provider could not be imported.
+	ContentSourceImportFailedReason = "ImportFailed"
+)
@@ -20,18 +20,69 @@ type ContentProviderReference struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// OVFPropertyFilter describes which OVF properties are surfaced on the images
+// produced from a content source.
+//
+// Each entry in the allow and deny lists is either an exact OVF property key,
+// or a key prefix when the entry ends with "*".
+type OVFPropertyFilter struct {
+	// Allow describes the OVF property keys that are surfaced. If empty, all
+	// OVF properties that are not denied are surfaced.
+	// +optional
+	Allow []string `json:"allow,omitempty"`
+
+	// Deny describes the OVF property keys that are not surfaced, even if
+	// they are also allowed.
+	// +optional
+	Deny []string `json:"deny,omitempty"`
+}
+
 // ContentSourceSpec defines the desired state of ContentSource.
 type ContentSourceSpec struct {
 	// ProviderRef is a reference to a content provider object that describes a provider.
 	ProviderRef ContentProviderReference `json:"providerRef,omitempty"`
+
+	// OVFPropertyFilter describes which OVF properties are surfaced on the
+	// images produced from this content source. If nil, all OVF properties are
+	// surfaced.
+	// +optional
+	OVFPropertyFilter *OVFPropertyFilter `json:"ovfPropertyFilter,omitempty"`
+}
+
+// ContentSourceImageStatus describes an image imported from the provider of
+// a ContentSource.
+type ContentSourceImageStatus struct {
+	// Name is the name of the image.
+	Name string `json:"name"`
+
+	// Version identifies the content of the image when it was imported, ex.
+	// the checksum or ETag of the image's file. The image is not imported
+	// again unless its version changes. If empty, the provider does not report
+	// the version of the image, and the image is imported only once.
+	// +optional
+	Version string `json:"version,omitempty"`
 }
 
 // ContentSourceStatus defines the observed state of ContentSource.
 type ContentSourceStatus struct {
+	// Images describes the images imported from the provider.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Images []ContentSourceImageStatus `json:"images,omitempty"`
+
+	// Conditions describes the current condition information of the
+	// ContentSource. The Ready condition is false if the images could not be
+	// listed or imported from the provider.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
 }
 
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
 // +kubebuilder:deprecatedversion:warning="This API has been deprecated and is unsupported in future versions"
 
 // ContentSource is the Schema for the contentsources API.
@@ -44,6 +95,14 @@ type ContentSource struct {
 	Status ContentSourceStatus `json:"status,omitempty"`
 }
 
+func (cs *ContentSource) GetConditions() Conditions {
+	return cs.Status.Conditions
+}
+
+func (cs *ContentSource) SetConditions(conditions Conditions) {
+	cs.Status.Conditions = conditions
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:deprecatedversion:warning="This API has been deprecated and is unsupported in future versions"
 
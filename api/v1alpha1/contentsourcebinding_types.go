@@ -18,6 +18,7 @@ type ContentSourceReference struct {
 	Name string `json:"name"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced
 
@@ -0,0 +1,70 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HTTPContentProviderSpec defines the desired state of HTTPContentProvider.
+type HTTPContentProviderSpec struct {
+	// URL is the URL of the provider's index. The index is a JSON document
+	// that lists the provider's images, ex.:
+	//
+	//	{
+	//	  "items": [
+	//	    {
+	//	      "name": "photon-5",
+	//	      "url": "photon-5.ova",
+	//	      "sha256": "...",
+	//	      "version": "5.0"
+	//	    }
+	//	  ]
+	//	}
+	//
+	// The url of each item may be relative to the index's URL, and its sha256
+	// checksum and version are optional. An image is imported again when its
+	// checksum, version, or the ETag of its url changes.
+	URL string `json:"url"`
+
+	// LibraryUUID is the UUID of the vSphere content library into which the
+	// provider's images are imported.
+	LibraryUUID string `json:"libraryUUID"`
+}
+
+// HTTPContentProviderStatus defines the observed state of HTTPContentProvider.
+type HTTPContentProviderStatus struct {
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.url",description="URL of the provider's index"
+// +kubebuilder:printcolumn:name="Content-Library-UUID",type="string",JSONPath=".spec.libraryUUID",description="UUID of the vSphere content library"
+
+// HTTPContentProvider is the Schema for the httpcontentproviders API.
+// An HTTPContentProvider describes a registry of OVF and OVA images served
+// over HTTP.
+type HTTPContentProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HTTPContentProviderSpec   `json:"spec,omitempty"`
+	Status HTTPContentProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HTTPContentProviderList contains a list of HTTPContentProvider.
+type HTTPContentProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HTTPContentProvider `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &HTTPContentProvider{}, &HTTPContentProviderList{})
+}
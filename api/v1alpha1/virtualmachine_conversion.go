@@ -126,6 +126,12 @@ func convert_v1alpha3_Conditions_To_v1alpha1_Phase(
 	return Unknown
 }
 
+func Convert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource(
+	in *vmopv1.PersistentVolumeClaimVolumeSource, out *PersistentVolumeClaimVolumeSource, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource(in, out, s)
+}
+
 func Convert_v1alpha3_VirtualMachineVolume_To_v1alpha1_VirtualMachineVolume(
 	in *vmopv1.VirtualMachineVolume, out *VirtualMachineVolume, s apiconversion.Scope) error {
 
@@ -846,14 +852,22 @@ func restore_v1alpha3_VirtualMachineBootstrapSpec(
 		// v1a1 doesn't have a way to represent standalone LinuxPrep. If we didn't do a
 		// conversion in convert_v1alpha1_VmMetadata_To_v1alpha3_BootstrapSpec() but we
 		// saved a LinuxPrep in the conversion annotation, restore that here.
-		if srcBootstrap.LinuxPrep != nil {
+		if srcBootstrap.LinuxPrep != nil ||
+			srcBootstrap.GuestCustomizationPolicy != "" ||
+			srcBootstrap.GuestCustomizationRetryPolicy != "" {
+
 			dst.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
-				LinuxPrep: srcBootstrap.LinuxPrep,
+				LinuxPrep:                     srcBootstrap.LinuxPrep,
+				GuestCustomizationPolicy:      srcBootstrap.GuestCustomizationPolicy,
+				GuestCustomizationRetryPolicy: srcBootstrap.GuestCustomizationRetryPolicy,
 			}
 		}
 		return
 	}
 
+	dstBootstrap.GuestCustomizationPolicy = srcBootstrap.GuestCustomizationPolicy
+	dstBootstrap.GuestCustomizationRetryPolicy = srcBootstrap.GuestCustomizationRetryPolicy
+
 	mergeSecretKeySelector := func(dstSel, srcSel *vmopv1common.SecretKeySelector) *vmopv1common.SecretKeySelector {
 		if dstSel == nil || srcSel == nil {
 			return dstSel
@@ -996,6 +1010,38 @@ func restore_v1alpha3_VirtualMachineCdrom(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Cdrom = src.Spec.Cdrom
 }
 
+func restore_v1alpha3_VirtualMachineVolumeDeletePolicy(dst, src *vmopv1.VirtualMachine) {
+	for i := range dst.Spec.Volumes {
+		dstPVC := dst.Spec.Volumes[i].PersistentVolumeClaim
+		if dstPVC == nil {
+			continue
+		}
+		for j := range src.Spec.Volumes {
+			srcPVC := src.Spec.Volumes[j].PersistentVolumeClaim
+			if srcPVC == nil {
+				continue
+			}
+			if dst.Spec.Volumes[i].Name == src.Spec.Volumes[j].Name &&
+				dstPVC.ClaimName == srcPVC.ClaimName {
+
+				dstPVC.DeletePolicy = srcPVC.DeletePolicy
+				break
+			}
+		}
+	}
+}
+
+func restore_v1alpha3_VirtualMachineVolumePreventDelete(dst, src *vmopv1.VirtualMachine) {
+	for i := range dst.Spec.Volumes {
+		for j := range src.Spec.Volumes {
+			if dst.Spec.Volumes[i].Name == src.Spec.Volumes[j].Name {
+				dst.Spec.Volumes[i].PreventDelete = src.Spec.Volumes[j].PreventDelete
+				break
+			}
+		}
+	}
+}
+
 func convert_v1alpha1_PreReqsReadyCondition_to_v1alpha3_Conditions(
 	dst *vmopv1.VirtualMachine) []metav1.Condition {
 
@@ -1220,6 +1266,26 @@ func Convert_v1alpha1_VirtualMachine_To_v1alpha3_VirtualMachine(in *VirtualMachi
 	return nil
 }
 
+func restore_v1alpha3_VirtualMachineAdvancedSpecDataDisks(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Advanced == nil || len(src.Spec.Advanced.DataDisks) == 0 {
+		return
+	}
+	if dst.Spec.Advanced == nil {
+		dst.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{}
+	}
+	dst.Spec.Advanced.DataDisks = src.Spec.Advanced.DataDisks
+}
+
+func restore_v1alpha3_VirtualMachineAdvancedSpecSCSIControllers(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Advanced == nil || len(src.Spec.Advanced.SCSIControllers) == 0 {
+		return
+	}
+	if dst.Spec.Advanced == nil {
+		dst.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{}
+	}
+	dst.Spec.Advanced.SCSIControllers = src.Spec.Advanced.SCSIControllers
+}
+
 // ConvertTo converts this VirtualMachine to the Hub version.
 func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachine)
@@ -1248,6 +1314,10 @@ func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	restore_v1alpha3_VirtualMachineGuestID(dst, restored)
 	restore_v1alpha3_VirtualMachineCdrom(dst, restored)
 	restore_v1alpha3_VirtualMachineCryptoSpec(dst, restored)
+	restore_v1alpha3_VirtualMachineVolumeDeletePolicy(dst, restored)
+	restore_v1alpha3_VirtualMachineVolumePreventDelete(dst, restored)
+	restore_v1alpha3_VirtualMachineAdvancedSpecDataDisks(dst, restored)
+	restore_v1alpha3_VirtualMachineAdvancedSpecSCSIControllers(dst, restored)
 
 	// END RESTORE
 
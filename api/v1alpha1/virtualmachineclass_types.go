@@ -146,6 +146,7 @@ type VirtualMachineClassSpec struct {
 type VirtualMachineClassStatus struct {
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vmclass
 // +kubebuilder:storageversion:false
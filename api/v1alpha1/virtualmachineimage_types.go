@@ -158,6 +158,7 @@ func (vmImage *VirtualMachineImage) SetConditions(conditions Conditions) {
 	vmImage.Status.Conditions = conditions
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vmi;vmimage
 // +kubebuilder:storageversion:false
@@ -200,6 +201,8 @@ func (clusterVirtualMachineImage *ClusterVirtualMachineImage) SetConditions(cond
 	clusterVirtualMachineImage.Status.Conditions = conditions
 }
 
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster,shortName=cvmi;cvmimage;clustervmi;clustervmimage
 // +kubebuilder:storageversion:false
@@ -341,6 +341,7 @@ func (vmpr *VirtualMachinePublishRequest) SetConditions(conditions Conditions) {
 	vmpr.Status.Conditions = conditions
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vmpub
 // +kubebuilder:storageversion:false
@@ -134,6 +134,7 @@ type VirtualMachineServiceStatus struct {
 	LoadBalancer LoadBalancerStatus `json:"loadBalancer,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:shortName=vmservice
 // +kubebuilder:storageversion:false
@@ -55,6 +55,7 @@ type ClusterModuleStatus struct {
 	ClusterMoID string `json:"clusterMoID"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion:false
 // +kubebuilder:subresource:status
@@ -48,6 +48,7 @@ type WebConsoleRequestStatus struct {
 	ProxyAddr string `json:"proxyAddr,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced
 // +kubebuilder:storageversion
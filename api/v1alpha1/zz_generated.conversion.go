@@ -142,11 +142,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha3.PersistentVolumeClaimVolumeSource)(nil), (*PersistentVolumeClaimVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource(a.(*v1alpha3.PersistentVolumeClaimVolumeSource), b.(*PersistentVolumeClaimVolumeSource), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ResourcePoolSpec)(nil), (*v1alpha3.ResourcePoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResourcePoolSpec_To_v1alpha3_ResourcePoolSpec(a.(*ResourcePoolSpec), b.(*v1alpha3.ResourcePoolSpec), scope)
 	}); err != nil {
@@ -467,11 +462,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha3.VirtualMachineSetResourcePolicyStatus)(nil), (*VirtualMachineSetResourcePolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha1_VirtualMachineSetResourcePolicyStatus(a.(*v1alpha3.VirtualMachineSetResourcePolicyStatus), b.(*VirtualMachineSetResourcePolicyStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineTemplate)(nil), (*v1alpha3.VirtualMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VirtualMachineTemplate_To_v1alpha3_VirtualMachineTemplate(a.(*VirtualMachineTemplate), b.(*v1alpha3.VirtualMachineTemplate), scope)
 	}); err != nil {
@@ -537,6 +527,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.PersistentVolumeClaimVolumeSource)(nil), (*PersistentVolumeClaimVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource(a.(*v1alpha3.PersistentVolumeClaimVolumeSource), b.(*PersistentVolumeClaimVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineImageOSInfo)(nil), (*VirtualMachineImageOSInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineImageOSInfo_To_v1alpha1_VirtualMachineImageOSInfo(a.(*v1alpha3.VirtualMachineImageOSInfo), b.(*VirtualMachineImageOSInfo), scope)
 	}); err != nil {
@@ -562,6 +557,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineSetResourcePolicyStatus)(nil), (*VirtualMachineSetResourcePolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha1_VirtualMachineSetResourcePolicyStatus(a.(*v1alpha3.VirtualMachineSetResourcePolicyStatus), b.(*VirtualMachineSetResourcePolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineSpec)(nil), (*VirtualMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineSpec_To_v1alpha1_VirtualMachineSpec(a.(*v1alpha3.VirtualMachineSpec), b.(*VirtualMachineSpec), scope)
 	}); err != nil {
@@ -894,14 +894,10 @@ func Convert_v1alpha1_PersistentVolumeClaimVolumeSource_To_v1alpha3_PersistentVo
 func autoConvert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource(in *v1alpha3.PersistentVolumeClaimVolumeSource, out *PersistentVolumeClaimVolumeSource, s conversion.Scope) error {
 	out.PersistentVolumeClaimVolumeSource = in.PersistentVolumeClaimVolumeSource
 	out.InstanceVolumeClaim = (*InstanceVolumeClaimVolumeSource)(unsafe.Pointer(in.InstanceVolumeClaim))
+	// WARNING: in.DeletePolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource is an autogenerated conversion function.
-func Convert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource(in *v1alpha3.PersistentVolumeClaimVolumeSource, out *PersistentVolumeClaimVolumeSource, s conversion.Scope) error {
-	return autoConvert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha1_PersistentVolumeClaimVolumeSource(in, out, s)
-}
-
 func autoConvert_v1alpha1_ResourcePoolSpec_To_v1alpha3_ResourcePoolSpec(in *ResourcePoolSpec, out *v1alpha3.ResourcePoolSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_VirtualMachineResourceSpec_To_v1alpha3_VirtualMachineResourceSpec(&in.Reservations, &out.Reservations, s); err != nil {
@@ -1426,10 +1422,15 @@ func autoConvert_v1alpha3_VirtualMachineImageStatus_To_v1alpha1_VirtualMachineIm
 	// WARNING: in.OSInfo requires manual conversion: does not exist in peer-type
 	// WARNING: in.OVFProperties requires manual conversion: does not exist in peer-type
 	// WARNING: in.VMwareSystemProperties requires manual conversion: does not exist in peer-type
+	// WARNING: in.ExtraConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.VGPUDevices requires manual conversion: does not exist in peer-type
+	// WARNING: in.OVFPropertiesConfigMapName requires manual conversion: does not exist in peer-type
 	// WARNING: in.ProductInfo requires manual conversion: does not exist in peer-type
 	// WARNING: in.Disks requires manual conversion: does not exist in peer-type
+	// WARNING: in.RecommendedResources requires manual conversion: does not exist in peer-type
 	// WARNING: in.ProviderContentVersion requires manual conversion: does not exist in peer-type
 	// WARNING: in.ProviderItemID requires manual conversion: does not exist in peer-type
+	// WARNING: in.ProviderItemSize requires manual conversion: does not exist in peer-type
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]Condition, len(*in))
@@ -2011,14 +2012,10 @@ func Convert_v1alpha1_VirtualMachineSetResourcePolicyStatus_To_v1alpha3_VirtualM
 
 func autoConvert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha1_VirtualMachineSetResourcePolicyStatus(in *v1alpha3.VirtualMachineSetResourcePolicyStatus, out *VirtualMachineSetResourcePolicyStatus, s conversion.Scope) error {
 	out.ClusterModules = *(*[]ClusterModuleStatus)(unsafe.Pointer(&in.ClusterModules))
+	// WARNING: in.Zones requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha1_VirtualMachineSetResourcePolicyStatus is an autogenerated conversion function.
-func Convert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha1_VirtualMachineSetResourcePolicyStatus(in *v1alpha3.VirtualMachineSetResourcePolicyStatus, out *VirtualMachineSetResourcePolicyStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha1_VirtualMachineSetResourcePolicyStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_VirtualMachineSpec_To_v1alpha3_VirtualMachineSpec(in *VirtualMachineSpec, out *v1alpha3.VirtualMachineSpec, s conversion.Scope) error {
 	out.ImageName = in.ImageName
 	out.ClassName = in.ClassName
@@ -2229,6 +2226,7 @@ func autoConvert_v1alpha1_VirtualMachineVolume_To_v1alpha3_VirtualMachineVolume(
 func autoConvert_v1alpha3_VirtualMachineVolume_To_v1alpha1_VirtualMachineVolume(in *v1alpha3.VirtualMachineVolume, out *VirtualMachineVolume, s conversion.Scope) error {
 	out.Name = in.Name
 	// WARNING: in.VirtualMachineVolumeSource requires manual conversion: does not exist in peer-type
+	// WARNING: in.PreventDelete requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -264,8 +264,8 @@ func (in *ContentSource) DeepCopyInto(out *ContentSource) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentSource.
@@ -344,6 +344,21 @@ func (in *ContentSourceBindingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentSourceImageStatus) DeepCopyInto(out *ContentSourceImageStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentSourceImageStatus.
+func (in *ContentSourceImageStatus) DeepCopy() *ContentSourceImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentSourceImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContentSourceList) DeepCopyInto(out *ContentSourceList) {
 	*out = *in
@@ -395,6 +410,11 @@ func (in *ContentSourceReference) DeepCopy() *ContentSourceReference {
 func (in *ContentSourceSpec) DeepCopyInto(out *ContentSourceSpec) {
 	*out = *in
 	out.ProviderRef = in.ProviderRef
+	if in.OVFPropertyFilter != nil {
+		in, out := &in.OVFPropertyFilter, &out.OVFPropertyFilter
+		*out = new(OVFPropertyFilter)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentSourceSpec.
@@ -410,6 +430,18 @@ func (in *ContentSourceSpec) DeepCopy() *ContentSourceSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContentSourceStatus) DeepCopyInto(out *ContentSourceStatus) {
 	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ContentSourceImageStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentSourceStatus.
@@ -467,6 +499,95 @@ func (in *GuestHeartbeatAction) DeepCopy() *GuestHeartbeatAction {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPContentProvider) DeepCopyInto(out *HTTPContentProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPContentProvider.
+func (in *HTTPContentProvider) DeepCopy() *HTTPContentProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPContentProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPContentProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPContentProviderList) DeepCopyInto(out *HTTPContentProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HTTPContentProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPContentProviderList.
+func (in *HTTPContentProviderList) DeepCopy() *HTTPContentProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPContentProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPContentProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPContentProviderSpec) DeepCopyInto(out *HTTPContentProviderSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPContentProviderSpec.
+func (in *HTTPContentProviderSpec) DeepCopy() *HTTPContentProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPContentProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPContentProviderStatus) DeepCopyInto(out *HTTPContentProviderStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPContentProviderStatus.
+func (in *HTTPContentProviderStatus) DeepCopy() *HTTPContentProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPContentProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceStorage) DeepCopyInto(out *InstanceStorage) {
 	*out = *in
@@ -638,6 +759,31 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVFPropertyFilter) DeepCopyInto(out *OVFPropertyFilter) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVFPropertyFilter.
+func (in *OVFPropertyFilter) DeepCopy() *OVFPropertyFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(OVFPropertyFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OvfProperty) DeepCopyInto(out *OvfProperty) {
 	*out = *in
@@ -19,6 +19,12 @@ func Convert_v1alpha3_VirtualMachineBootstrapCloudInitSpec_To_v1alpha2_VirtualMa
 	return autoConvert_v1alpha3_VirtualMachineBootstrapCloudInitSpec_To_v1alpha2_VirtualMachineBootstrapCloudInitSpec(in, out, s)
 }
 
+func Convert_v1alpha3_VirtualMachineBootstrapSpec_To_v1alpha2_VirtualMachineBootstrapSpec(
+	in *vmopv1.VirtualMachineBootstrapSpec, out *VirtualMachineBootstrapSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha3_VirtualMachineBootstrapSpec_To_v1alpha2_VirtualMachineBootstrapSpec(in, out, s)
+}
+
 func Convert_v1alpha3_VirtualMachineNetworkConfigDNSStatus_To_v1alpha2_VirtualMachineNetworkConfigDNSStatus(
 	in *vmopv1.VirtualMachineNetworkConfigDNSStatus, out *VirtualMachineNetworkConfigDNSStatus, s apiconversion.Scope) error {
 
@@ -47,6 +53,30 @@ func Convert_v1alpha3_VirtualMachineSpec_To_v1alpha2_VirtualMachineSpec(
 	return nil
 }
 
+func Convert_v1alpha3_VirtualMachineAdvancedSpec_To_v1alpha2_VirtualMachineAdvancedSpec(
+	in *vmopv1.VirtualMachineAdvancedSpec, out *VirtualMachineAdvancedSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha3_VirtualMachineAdvancedSpec_To_v1alpha2_VirtualMachineAdvancedSpec(in, out, s)
+}
+
+func Convert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha2_PersistentVolumeClaimVolumeSource(
+	in *vmopv1.PersistentVolumeClaimVolumeSource, out *PersistentVolumeClaimVolumeSource, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha2_PersistentVolumeClaimVolumeSource(in, out, s)
+}
+
+func Convert_v1alpha3_VirtualMachineVolume_To_v1alpha2_VirtualMachineVolume(
+	in *vmopv1.VirtualMachineVolume, out *VirtualMachineVolume, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha3_VirtualMachineVolume_To_v1alpha2_VirtualMachineVolume(in, out, s)
+}
+
+func Convert_v1alpha3_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_VirtualMachineNetworkInterfaceSpec(
+	in *vmopv1.VirtualMachineNetworkInterfaceSpec, out *VirtualMachineNetworkInterfaceSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha3_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_VirtualMachineNetworkInterfaceSpec(in, out, s)
+}
+
 func Convert_v1alpha2_VirtualMachineVolumeStatus_To_v1alpha3_VirtualMachineVolumeStatus(
 	in *VirtualMachineVolumeStatus, out *vmopv1.VirtualMachineVolumeStatus, s apiconversion.Scope) error {
 
@@ -269,6 +299,42 @@ func restore_v1alpha3_VirtualMachineBootstrapCloudInitInstanceID(
 	dst.Spec.Bootstrap.CloudInit.InstanceID = iid
 }
 
+func restore_v1alpha3_VirtualMachineBootstrapGuestCustomizationPolicy(
+	dst, src *vmopv1.VirtualMachine) {
+
+	var policy vmopv1.GuestCustomizationPolicy
+	if bs := src.Spec.Bootstrap; bs != nil {
+		policy = bs.GuestCustomizationPolicy
+	}
+
+	if policy == "" {
+		return
+	}
+
+	if dst.Spec.Bootstrap == nil {
+		dst.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{}
+	}
+	dst.Spec.Bootstrap.GuestCustomizationPolicy = policy
+}
+
+func restore_v1alpha3_VirtualMachineBootstrapGuestCustomizationRetryPolicy(
+	dst, src *vmopv1.VirtualMachine) {
+
+	var policy vmopv1.GuestCustomizationRetryPolicy
+	if bs := src.Spec.Bootstrap; bs != nil {
+		policy = bs.GuestCustomizationRetryPolicy
+	}
+
+	if policy == "" {
+		return
+	}
+
+	if dst.Spec.Bootstrap == nil {
+		dst.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{}
+	}
+	dst.Spec.Bootstrap.GuestCustomizationRetryPolicy = policy
+}
+
 func restore_v1alpha3_VirtualMachineGuestID(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.GuestID = src.Spec.GuestID
 }
@@ -277,6 +343,74 @@ func restore_v1alpha3_VirtualMachineCdrom(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Cdrom = src.Spec.Cdrom
 }
 
+func restore_v1alpha3_VirtualMachineVolumeDeletePolicy(dst, src *vmopv1.VirtualMachine) {
+	for i := range dst.Spec.Volumes {
+		dstPVC := dst.Spec.Volumes[i].PersistentVolumeClaim
+		if dstPVC == nil {
+			continue
+		}
+		for j := range src.Spec.Volumes {
+			srcPVC := src.Spec.Volumes[j].PersistentVolumeClaim
+			if srcPVC == nil {
+				continue
+			}
+			if dst.Spec.Volumes[i].Name == src.Spec.Volumes[j].Name &&
+				dstPVC.ClaimName == srcPVC.ClaimName {
+
+				dstPVC.DeletePolicy = srcPVC.DeletePolicy
+				break
+			}
+		}
+	}
+}
+
+func restore_v1alpha3_VirtualMachineVolumePreventDelete(dst, src *vmopv1.VirtualMachine) {
+	for i := range dst.Spec.Volumes {
+		for j := range src.Spec.Volumes {
+			if dst.Spec.Volumes[i].Name == src.Spec.Volumes[j].Name {
+				dst.Spec.Volumes[i].PreventDelete = src.Spec.Volumes[j].PreventDelete
+				break
+			}
+		}
+	}
+}
+
+func restore_v1alpha3_VirtualMachineAdvancedSpecDataDisks(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Advanced == nil || len(src.Spec.Advanced.DataDisks) == 0 {
+		return
+	}
+	if dst.Spec.Advanced == nil {
+		dst.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{}
+	}
+	dst.Spec.Advanced.DataDisks = src.Spec.Advanced.DataDisks
+}
+
+func restore_v1alpha3_VirtualMachineAdvancedSpecSCSIControllers(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Advanced == nil || len(src.Spec.Advanced.SCSIControllers) == 0 {
+		return
+	}
+	if dst.Spec.Advanced == nil {
+		dst.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{}
+	}
+	dst.Spec.Advanced.SCSIControllers = src.Spec.Advanced.SCSIControllers
+}
+
+func restore_v1alpha3_VirtualMachineNetworkInterfaceMACAddr(dst, src *vmopv1.VirtualMachine) {
+	if dst.Spec.Network == nil || src.Spec.Network == nil {
+		return
+	}
+	for i := range dst.Spec.Network.Interfaces {
+		dstIface := &dst.Spec.Network.Interfaces[i]
+		for j := range src.Spec.Network.Interfaces {
+			srcIface := &src.Spec.Network.Interfaces[j]
+			if dstIface.Name == srcIface.Name {
+				dstIface.MACAddr = srcIface.MACAddr
+				break
+			}
+		}
+	}
+}
+
 // ConvertTo converts this VirtualMachine to the Hub version.
 func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachine)
@@ -296,10 +430,17 @@ func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	restore_v1alpha3_VirtualMachineInstanceUUID(dst, restored)
 	restore_v1alpha3_VirtualMachineBiosUUID(dst, restored)
 	restore_v1alpha3_VirtualMachineBootstrapCloudInitInstanceID(dst, restored)
+	restore_v1alpha3_VirtualMachineBootstrapGuestCustomizationPolicy(dst, restored)
+	restore_v1alpha3_VirtualMachineBootstrapGuestCustomizationRetryPolicy(dst, restored)
 	restore_v1alpha3_VirtualMachineSpecNetworkDomainName(dst, restored)
 	restore_v1alpha3_VirtualMachineGuestID(dst, restored)
 	restore_v1alpha3_VirtualMachineCdrom(dst, restored)
 	restore_v1alpha3_VirtualMachineCryptoSpec(dst, restored)
+	restore_v1alpha3_VirtualMachineVolumeDeletePolicy(dst, restored)
+	restore_v1alpha3_VirtualMachineVolumePreventDelete(dst, restored)
+	restore_v1alpha3_VirtualMachineAdvancedSpecDataDisks(dst, restored)
+	restore_v1alpha3_VirtualMachineAdvancedSpecSCSIControllers(dst, restored)
+	restore_v1alpha3_VirtualMachineNetworkInterfaceMACAddr(dst, restored)
 
 	// END RESTORE
 
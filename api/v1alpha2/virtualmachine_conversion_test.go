@@ -121,6 +121,7 @@ func TestVirtualMachineConversion(t *testing.T) {
 								Name: "primary",
 							},
 							GuestDeviceName: "eth10",
+							MACAddr:         "00:50:56:2a:bb:cc",
 						},
 						{
 							Name: "ncp-interface",
@@ -195,11 +196,13 @@ func TestVirtualMachineConversion(t *testing.T) {
 								PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
 									ClaimName: "my-claim",
 								},
+								DeletePolicy: vmopv1.VirtualMachineVolumeDeletePolicyDelete,
 							}),
 						},
 					},
 					{
-						Name: "my-volume-2",
+						Name:          "my-volume-2",
+						PreventDelete: true,
 						VirtualMachineVolumeSource: vmopv1.VirtualMachineVolumeSource{
 							PersistentVolumeClaim: ptrOf(vmopv1.PersistentVolumeClaimVolumeSource{
 								PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
@@ -234,6 +237,20 @@ func TestVirtualMachineConversion(t *testing.T) {
 					BootDiskCapacity:              ptrOf(resource.MustParse("1024k")),
 					DefaultVolumeProvisioningMode: vmopv1.VirtualMachineVolumeProvisioningModeThickEagerZero,
 					ChangeBlockTracking:           ptrOf(true),
+					DataDisks: []vmopv1.VirtualMachineDataDiskSpec{
+						{
+							Name:             "data-1",
+							Capacity:         resource.MustParse("10Gi"),
+							ProvisioningMode: vmopv1.VirtualMachineVolumeProvisioningModeThin,
+							StorageClass:     "my-storage-class",
+						},
+					},
+					SCSIControllers: []vmopv1.VirtualMachineSCSIControllerSpec{
+						{
+							BusNumber:   1,
+							SharingMode: vmopv1.VirtualMachineSCSIControllerSharingModePhysical,
+						},
+					},
 				},
 				Reserved: &vmopv1.VirtualMachineReservedSpec{
 					ResourcePolicyName: "my-resource-policy",
@@ -531,6 +531,7 @@ type VirtualMachineStatus struct {
 	HardwareVersion int32 `json:"hardwareVersion,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vm
 // +kubebuilder:subresource:status
@@ -172,6 +172,7 @@ type VirtualMachineClassSpec struct {
 type VirtualMachineClassStatus struct {
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vmclass
 // +kubebuilder:subresource:status
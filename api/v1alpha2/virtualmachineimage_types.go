@@ -213,6 +213,7 @@ type VirtualMachineImageStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vmi;vmimage
 // +kubebuilder:subresource:status
@@ -249,6 +250,8 @@ type VirtualMachineImageList struct {
 	Items           []VirtualMachineImage `json:"items"`
 }
 
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster,shortName=cvmi;cvmimage;clustervmi;clustervmimage
 // +kubebuilder:subresource:status
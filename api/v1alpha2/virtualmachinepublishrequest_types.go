@@ -333,6 +333,7 @@ type VirtualMachinePublishRequestStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vmpub
 // +kubebuilder:subresource:status
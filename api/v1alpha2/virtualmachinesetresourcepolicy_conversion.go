@@ -5,21 +5,51 @@
 package v1alpha2
 
 import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	"github.com/vmware-tanzu/vm-operator/api/utilconversion"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 )
 
+func Convert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha2_VirtualMachineSetResourcePolicyStatus(
+	in *vmopv1.VirtualMachineSetResourcePolicyStatus, out *VirtualMachineSetResourcePolicyStatus, s apiconversion.Scope) error {
+	return autoConvert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha2_VirtualMachineSetResourcePolicyStatus(in, out, s)
+}
+
+func restore_v1alpha3_VirtualMachineSetResourcePolicyStatusZones(
+	dst, src *vmopv1.VirtualMachineSetResourcePolicy) {
+
+	dst.Status.Zones = src.Status.Zones
+}
+
 // ConvertTo converts this VirtualMachineSetResourcePolicy to the Hub version.
 func (src *VirtualMachineSetResourcePolicy) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachineSetResourcePolicy)
-	return Convert_v1alpha2_VirtualMachineSetResourcePolicy_To_v1alpha3_VirtualMachineSetResourcePolicy(src, dst, nil)
+	if err := Convert_v1alpha2_VirtualMachineSetResourcePolicy_To_v1alpha3_VirtualMachineSetResourcePolicy(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &vmopv1.VirtualMachineSetResourcePolicy{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restore_v1alpha3_VirtualMachineSetResourcePolicyStatusZones(dst, restored)
+
+	return nil
 }
 
 // ConvertFrom converts the hub version to this VirtualMachineSetResourcePolicy.
 func (dst *VirtualMachineSetResourcePolicy) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	src := srcRaw.(*vmopv1.VirtualMachineSetResourcePolicy)
-	return Convert_v1alpha3_VirtualMachineSetResourcePolicy_To_v1alpha2_VirtualMachineSetResourcePolicy(src, dst, nil)
+	if err := Convert_v1alpha3_VirtualMachineSetResourcePolicy_To_v1alpha2_VirtualMachineSetResourcePolicy(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata
+	return utilconversion.MarshalData(src, dst)
 }
 
 // ConvertTo converts this VirtualMachineSetResourcePolicyList to the Hub version.
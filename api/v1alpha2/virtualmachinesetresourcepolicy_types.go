@@ -47,6 +47,7 @@ type VSphereClusterModuleStatus struct {
 	ClusterMoID string `json:"clusterMoID"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 
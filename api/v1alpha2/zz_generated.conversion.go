@@ -170,11 +170,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha3.PersistentVolumeClaimVolumeSource)(nil), (*PersistentVolumeClaimVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha2_PersistentVolumeClaimVolumeSource(a.(*v1alpha3.PersistentVolumeClaimVolumeSource), b.(*PersistentVolumeClaimVolumeSource), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ResourcePoolSpec)(nil), (*v1alpha3.ResourcePoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ResourcePoolSpec_To_v1alpha3_ResourcePoolSpec(a.(*ResourcePoolSpec), b.(*v1alpha3.ResourcePoolSpec), scope)
 	}); err != nil {
@@ -230,11 +225,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha3.VirtualMachineAdvancedSpec)(nil), (*VirtualMachineAdvancedSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_VirtualMachineAdvancedSpec_To_v1alpha2_VirtualMachineAdvancedSpec(a.(*v1alpha3.VirtualMachineAdvancedSpec), b.(*VirtualMachineAdvancedSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineBootstrapCloudInitSpec)(nil), (*v1alpha3.VirtualMachineBootstrapCloudInitSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineBootstrapCloudInitSpec_To_v1alpha3_VirtualMachineBootstrapCloudInitSpec(a.(*VirtualMachineBootstrapCloudInitSpec), b.(*v1alpha3.VirtualMachineBootstrapCloudInitSpec), scope)
 	}); err != nil {
@@ -255,11 +245,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha3.VirtualMachineBootstrapSpec)(nil), (*VirtualMachineBootstrapSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_VirtualMachineBootstrapSpec_To_v1alpha2_VirtualMachineBootstrapSpec(a.(*v1alpha3.VirtualMachineBootstrapSpec), b.(*VirtualMachineBootstrapSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineBootstrapSysprepSpec)(nil), (*v1alpha3.VirtualMachineBootstrapSysprepSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineBootstrapSysprepSpec_To_v1alpha3_VirtualMachineBootstrapSysprepSpec(a.(*VirtualMachineBootstrapSysprepSpec), b.(*v1alpha3.VirtualMachineBootstrapSysprepSpec), scope)
 	}); err != nil {
@@ -555,11 +540,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha3.VirtualMachineNetworkInterfaceSpec)(nil), (*VirtualMachineNetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_VirtualMachineNetworkInterfaceSpec(a.(*v1alpha3.VirtualMachineNetworkInterfaceSpec), b.(*VirtualMachineNetworkInterfaceSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineNetworkInterfaceStatus)(nil), (*v1alpha3.VirtualMachineNetworkInterfaceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineNetworkInterfaceStatus_To_v1alpha3_VirtualMachineNetworkInterfaceStatus(a.(*VirtualMachineNetworkInterfaceStatus), b.(*v1alpha3.VirtualMachineNetworkInterfaceStatus), scope)
 	}); err != nil {
@@ -800,11 +780,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha3.VirtualMachineSetResourcePolicyStatus)(nil), (*VirtualMachineSetResourcePolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha2_VirtualMachineSetResourcePolicyStatus(a.(*v1alpha3.VirtualMachineSetResourcePolicyStatus), b.(*VirtualMachineSetResourcePolicyStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineSpec)(nil), (*v1alpha3.VirtualMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineSpec_To_v1alpha3_VirtualMachineSpec(a.(*VirtualMachineSpec), b.(*v1alpha3.VirtualMachineSpec), scope)
 	}); err != nil {
@@ -825,11 +800,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha3.VirtualMachineVolume)(nil), (*VirtualMachineVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_VirtualMachineVolume_To_v1alpha2_VirtualMachineVolume(a.(*v1alpha3.VirtualMachineVolume), b.(*VirtualMachineVolume), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineVolumeSource)(nil), (*v1alpha3.VirtualMachineVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineVolumeSource_To_v1alpha3_VirtualMachineVolumeSource(a.(*VirtualMachineVolumeSource), b.(*v1alpha3.VirtualMachineVolumeSource), scope)
 	}); err != nil {
@@ -895,11 +865,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.PersistentVolumeClaimVolumeSource)(nil), (*PersistentVolumeClaimVolumeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha2_PersistentVolumeClaimVolumeSource(a.(*v1alpha3.PersistentVolumeClaimVolumeSource), b.(*PersistentVolumeClaimVolumeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineAdvancedSpec)(nil), (*VirtualMachineAdvancedSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_VirtualMachineAdvancedSpec_To_v1alpha2_VirtualMachineAdvancedSpec(a.(*v1alpha3.VirtualMachineAdvancedSpec), b.(*VirtualMachineAdvancedSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineBootstrapCloudInitSpec)(nil), (*VirtualMachineBootstrapCloudInitSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineBootstrapCloudInitSpec_To_v1alpha2_VirtualMachineBootstrapCloudInitSpec(a.(*v1alpha3.VirtualMachineBootstrapCloudInitSpec), b.(*VirtualMachineBootstrapCloudInitSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineBootstrapSpec)(nil), (*VirtualMachineBootstrapSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_VirtualMachineBootstrapSpec_To_v1alpha2_VirtualMachineBootstrapSpec(a.(*v1alpha3.VirtualMachineBootstrapSpec), b.(*VirtualMachineBootstrapSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineImageStatus)(nil), (*VirtualMachineImageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineImageStatus_To_v1alpha2_VirtualMachineImageStatus(a.(*v1alpha3.VirtualMachineImageStatus), b.(*VirtualMachineImageStatus), scope)
 	}); err != nil {
@@ -910,11 +895,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineNetworkInterfaceSpec)(nil), (*VirtualMachineNetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_VirtualMachineNetworkInterfaceSpec(a.(*v1alpha3.VirtualMachineNetworkInterfaceSpec), b.(*VirtualMachineNetworkInterfaceSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineNetworkSpec)(nil), (*VirtualMachineNetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineNetworkSpec_To_v1alpha2_VirtualMachineNetworkSpec(a.(*v1alpha3.VirtualMachineNetworkSpec), b.(*VirtualMachineNetworkSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineSetResourcePolicyStatus)(nil), (*VirtualMachineSetResourcePolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha2_VirtualMachineSetResourcePolicyStatus(a.(*v1alpha3.VirtualMachineSetResourcePolicyStatus), b.(*VirtualMachineSetResourcePolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineSpec)(nil), (*VirtualMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineSpec_To_v1alpha2_VirtualMachineSpec(a.(*v1alpha3.VirtualMachineSpec), b.(*VirtualMachineSpec), scope)
 	}); err != nil {
@@ -925,6 +920,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineVolume)(nil), (*VirtualMachineVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_VirtualMachineVolume_To_v1alpha2_VirtualMachineVolume(a.(*v1alpha3.VirtualMachineVolume), b.(*VirtualMachineVolume), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha3.VirtualMachineVolumeStatus)(nil), (*VirtualMachineVolumeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineVolumeStatus_To_v1alpha2_VirtualMachineVolumeStatus(a.(*v1alpha3.VirtualMachineVolumeStatus), b.(*VirtualMachineVolumeStatus), scope)
 	}); err != nil {
@@ -1268,14 +1268,10 @@ func Convert_v1alpha2_PersistentVolumeClaimVolumeSource_To_v1alpha3_PersistentVo
 func autoConvert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha2_PersistentVolumeClaimVolumeSource(in *v1alpha3.PersistentVolumeClaimVolumeSource, out *PersistentVolumeClaimVolumeSource, s conversion.Scope) error {
 	out.PersistentVolumeClaimVolumeSource = in.PersistentVolumeClaimVolumeSource
 	out.InstanceVolumeClaim = (*InstanceVolumeClaimVolumeSource)(unsafe.Pointer(in.InstanceVolumeClaim))
+	// WARNING: in.DeletePolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha2_PersistentVolumeClaimVolumeSource is an autogenerated conversion function.
-func Convert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha2_PersistentVolumeClaimVolumeSource(in *v1alpha3.PersistentVolumeClaimVolumeSource, out *PersistentVolumeClaimVolumeSource, s conversion.Scope) error {
-	return autoConvert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha2_PersistentVolumeClaimVolumeSource(in, out, s)
-}
-
 func autoConvert_v1alpha2_ResourcePoolSpec_To_v1alpha3_ResourcePoolSpec(in *ResourcePoolSpec, out *v1alpha3.ResourcePoolSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha2_VirtualMachineResourceSpec_To_v1alpha3_VirtualMachineResourceSpec(&in.Reservations, &out.Reservations, s); err != nil {
@@ -1434,14 +1430,11 @@ func autoConvert_v1alpha3_VirtualMachineAdvancedSpec_To_v1alpha2_VirtualMachineA
 	out.BootDiskCapacity = (*resource.Quantity)(unsafe.Pointer(in.BootDiskCapacity))
 	out.DefaultVolumeProvisioningMode = VirtualMachineVolumeProvisioningMode(in.DefaultVolumeProvisioningMode)
 	out.ChangeBlockTracking = (*bool)(unsafe.Pointer(in.ChangeBlockTracking))
+	// WARNING: in.DataDisks requires manual conversion: does not exist in peer-type
+	// WARNING: in.SCSIControllers requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha3_VirtualMachineAdvancedSpec_To_v1alpha2_VirtualMachineAdvancedSpec is an autogenerated conversion function.
-func Convert_v1alpha3_VirtualMachineAdvancedSpec_To_v1alpha2_VirtualMachineAdvancedSpec(in *v1alpha3.VirtualMachineAdvancedSpec, out *VirtualMachineAdvancedSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha3_VirtualMachineAdvancedSpec_To_v1alpha2_VirtualMachineAdvancedSpec(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineBootstrapCloudInitSpec_To_v1alpha3_VirtualMachineBootstrapCloudInitSpec(in *VirtualMachineBootstrapCloudInitSpec, out *v1alpha3.VirtualMachineBootstrapCloudInitSpec, s conversion.Scope) error {
 	out.CloudConfig = (*cloudinit.CloudConfig)(unsafe.Pointer(in.CloudConfig))
 	out.RawCloudConfig = (*common.SecretKeySelector)(unsafe.Pointer(in.RawCloudConfig))
@@ -1538,14 +1531,11 @@ func autoConvert_v1alpha3_VirtualMachineBootstrapSpec_To_v1alpha2_VirtualMachine
 		out.Sysprep = nil
 	}
 	out.VAppConfig = (*VirtualMachineBootstrapVAppConfigSpec)(unsafe.Pointer(in.VAppConfig))
+	// WARNING: in.GuestCustomizationPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.GuestCustomizationRetryPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha3_VirtualMachineBootstrapSpec_To_v1alpha2_VirtualMachineBootstrapSpec is an autogenerated conversion function.
-func Convert_v1alpha3_VirtualMachineBootstrapSpec_To_v1alpha2_VirtualMachineBootstrapSpec(in *v1alpha3.VirtualMachineBootstrapSpec, out *VirtualMachineBootstrapSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha3_VirtualMachineBootstrapSpec_To_v1alpha2_VirtualMachineBootstrapSpec(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineBootstrapSysprepSpec_To_v1alpha3_VirtualMachineBootstrapSysprepSpec(in *VirtualMachineBootstrapSysprepSpec, out *v1alpha3.VirtualMachineBootstrapSysprepSpec, s conversion.Scope) error {
 	if in.Sysprep != nil {
 		in, out := &in.Sysprep, &out.Sysprep
@@ -1984,12 +1974,17 @@ func autoConvert_v1alpha3_VirtualMachineImageStatus_To_v1alpha2_VirtualMachineIm
 	}
 	out.OVFProperties = *(*[]OVFProperty)(unsafe.Pointer(&in.OVFProperties))
 	out.VMwareSystemProperties = *(*[]v1alpha2common.KeyValuePair)(unsafe.Pointer(&in.VMwareSystemProperties))
+	// WARNING: in.ExtraConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.VGPUDevices requires manual conversion: does not exist in peer-type
+	// WARNING: in.OVFPropertiesConfigMapName requires manual conversion: does not exist in peer-type
 	if err := Convert_v1alpha3_VirtualMachineImageProductInfo_To_v1alpha2_VirtualMachineImageProductInfo(&in.ProductInfo, &out.ProductInfo, s); err != nil {
 		return err
 	}
 	// WARNING: in.Disks requires manual conversion: does not exist in peer-type
+	// WARNING: in.RecommendedResources requires manual conversion: does not exist in peer-type
 	out.ProviderContentVersion = in.ProviderContentVersion
 	out.ProviderItemID = in.ProviderItemID
+	// WARNING: in.ProviderItemSize requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	// WARNING: in.Type requires manual conversion: does not exist in peer-type
 	return nil
@@ -2454,6 +2449,7 @@ func autoConvert_v1alpha3_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_Virtual
 	out.Name = in.Name
 	out.Network = (*v1alpha2common.PartialObjectRef)(unsafe.Pointer(in.Network))
 	out.GuestDeviceName = in.GuestDeviceName
+	// WARNING: in.MACAddr requires manual conversion: does not exist in peer-type
 	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
 	out.DHCP4 = in.DHCP4
 	out.DHCP6 = in.DHCP6
@@ -2466,11 +2462,6 @@ func autoConvert_v1alpha3_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_Virtual
 	return nil
 }
 
-// Convert_v1alpha3_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_VirtualMachineNetworkInterfaceSpec is an autogenerated conversion function.
-func Convert_v1alpha3_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_VirtualMachineNetworkInterfaceSpec(in *v1alpha3.VirtualMachineNetworkInterfaceSpec, out *VirtualMachineNetworkInterfaceSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha3_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_VirtualMachineNetworkInterfaceSpec(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineNetworkInterfaceStatus_To_v1alpha3_VirtualMachineNetworkInterfaceStatus(in *VirtualMachineNetworkInterfaceStatus, out *v1alpha3.VirtualMachineNetworkInterfaceStatus, s conversion.Scope) error {
 	out.Name = in.Name
 	out.DeviceKey = in.DeviceKey
@@ -2546,7 +2537,17 @@ func autoConvert_v1alpha2_VirtualMachineNetworkSpec_To_v1alpha3_VirtualMachineNe
 	out.Disabled = in.Disabled
 	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
 	out.SearchDomains = *(*[]string)(unsafe.Pointer(&in.SearchDomains))
-	out.Interfaces = *(*[]v1alpha3.VirtualMachineNetworkInterfaceSpec)(unsafe.Pointer(&in.Interfaces))
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]v1alpha3.VirtualMachineNetworkInterfaceSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_VirtualMachineNetworkInterfaceSpec_To_v1alpha3_VirtualMachineNetworkInterfaceSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Interfaces = nil
+	}
 	return nil
 }
 
@@ -2561,7 +2562,17 @@ func autoConvert_v1alpha3_VirtualMachineNetworkSpec_To_v1alpha2_VirtualMachineNe
 	out.Disabled = in.Disabled
 	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
 	out.SearchDomains = *(*[]string)(unsafe.Pointer(&in.SearchDomains))
-	out.Interfaces = *(*[]VirtualMachineNetworkInterfaceSpec)(unsafe.Pointer(&in.Interfaces))
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]VirtualMachineNetworkInterfaceSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_VirtualMachineNetworkInterfaceSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Interfaces = nil
+	}
 	return nil
 }
 
@@ -3131,14 +3142,10 @@ func Convert_v1alpha2_VirtualMachineSetResourcePolicyStatus_To_v1alpha3_VirtualM
 
 func autoConvert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha2_VirtualMachineSetResourcePolicyStatus(in *v1alpha3.VirtualMachineSetResourcePolicyStatus, out *VirtualMachineSetResourcePolicyStatus, s conversion.Scope) error {
 	out.ClusterModules = *(*[]VSphereClusterModuleStatus)(unsafe.Pointer(&in.ClusterModules))
+	// WARNING: in.Zones requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha2_VirtualMachineSetResourcePolicyStatus is an autogenerated conversion function.
-func Convert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha2_VirtualMachineSetResourcePolicyStatus(in *v1alpha3.VirtualMachineSetResourcePolicyStatus, out *VirtualMachineSetResourcePolicyStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha3_VirtualMachineSetResourcePolicyStatus_To_v1alpha2_VirtualMachineSetResourcePolicyStatus(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineSpec_To_v1alpha3_VirtualMachineSpec(in *VirtualMachineSpec, out *v1alpha3.VirtualMachineSpec, s conversion.Scope) error {
 	out.ImageName = in.ImageName
 	out.ClassName = in.ClassName
@@ -3166,9 +3173,27 @@ func autoConvert_v1alpha2_VirtualMachineSpec_To_v1alpha3_VirtualMachineSpec(in *
 	out.SuspendMode = v1alpha3.VirtualMachinePowerOpMode(in.SuspendMode)
 	out.NextRestartTime = in.NextRestartTime
 	out.RestartMode = v1alpha3.VirtualMachinePowerOpMode(in.RestartMode)
-	out.Volumes = *(*[]v1alpha3.VirtualMachineVolume)(unsafe.Pointer(&in.Volumes))
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1alpha3.VirtualMachineVolume, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_VirtualMachineVolume_To_v1alpha3_VirtualMachineVolume(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Volumes = nil
+	}
 	out.ReadinessProbe = (*v1alpha3.VirtualMachineReadinessProbeSpec)(unsafe.Pointer(in.ReadinessProbe))
-	out.Advanced = (*v1alpha3.VirtualMachineAdvancedSpec)(unsafe.Pointer(in.Advanced))
+	if in.Advanced != nil {
+		in, out := &in.Advanced, &out.Advanced
+		*out = new(v1alpha3.VirtualMachineAdvancedSpec)
+		if err := Convert_v1alpha2_VirtualMachineAdvancedSpec_To_v1alpha3_VirtualMachineAdvancedSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Advanced = nil
+	}
 	out.Reserved = (*v1alpha3.VirtualMachineReservedSpec)(unsafe.Pointer(in.Reserved))
 	out.MinHardwareVersion = in.MinHardwareVersion
 	return nil
@@ -3209,9 +3234,27 @@ func autoConvert_v1alpha3_VirtualMachineSpec_To_v1alpha2_VirtualMachineSpec(in *
 	out.SuspendMode = VirtualMachinePowerOpMode(in.SuspendMode)
 	out.NextRestartTime = in.NextRestartTime
 	out.RestartMode = VirtualMachinePowerOpMode(in.RestartMode)
-	out.Volumes = *(*[]VirtualMachineVolume)(unsafe.Pointer(&in.Volumes))
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VirtualMachineVolume, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_VirtualMachineVolume_To_v1alpha2_VirtualMachineVolume(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Volumes = nil
+	}
 	out.ReadinessProbe = (*VirtualMachineReadinessProbeSpec)(unsafe.Pointer(in.ReadinessProbe))
-	out.Advanced = (*VirtualMachineAdvancedSpec)(unsafe.Pointer(in.Advanced))
+	if in.Advanced != nil {
+		in, out := &in.Advanced, &out.Advanced
+		*out = new(VirtualMachineAdvancedSpec)
+		if err := Convert_v1alpha3_VirtualMachineAdvancedSpec_To_v1alpha2_VirtualMachineAdvancedSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Advanced = nil
+	}
 	out.Reserved = (*VirtualMachineReservedSpec)(unsafe.Pointer(in.Reserved))
 	out.MinHardwareVersion = in.MinHardwareVersion
 	// WARNING: in.InstanceUUID requires manual conversion: does not exist in peer-type
@@ -3353,16 +3396,20 @@ func autoConvert_v1alpha3_VirtualMachineVolume_To_v1alpha2_VirtualMachineVolume(
 	if err := Convert_v1alpha3_VirtualMachineVolumeSource_To_v1alpha2_VirtualMachineVolumeSource(&in.VirtualMachineVolumeSource, &out.VirtualMachineVolumeSource, s); err != nil {
 		return err
 	}
+	// WARNING: in.PreventDelete requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha3_VirtualMachineVolume_To_v1alpha2_VirtualMachineVolume is an autogenerated conversion function.
-func Convert_v1alpha3_VirtualMachineVolume_To_v1alpha2_VirtualMachineVolume(in *v1alpha3.VirtualMachineVolume, out *VirtualMachineVolume, s conversion.Scope) error {
-	return autoConvert_v1alpha3_VirtualMachineVolume_To_v1alpha2_VirtualMachineVolume(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineVolumeSource_To_v1alpha3_VirtualMachineVolumeSource(in *VirtualMachineVolumeSource, out *v1alpha3.VirtualMachineVolumeSource, s conversion.Scope) error {
-	out.PersistentVolumeClaim = (*v1alpha3.PersistentVolumeClaimVolumeSource)(unsafe.Pointer(in.PersistentVolumeClaim))
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(v1alpha3.PersistentVolumeClaimVolumeSource)
+		if err := Convert_v1alpha2_PersistentVolumeClaimVolumeSource_To_v1alpha3_PersistentVolumeClaimVolumeSource(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PersistentVolumeClaim = nil
+	}
 	return nil
 }
 
@@ -3372,7 +3419,15 @@ func Convert_v1alpha2_VirtualMachineVolumeSource_To_v1alpha3_VirtualMachineVolum
 }
 
 func autoConvert_v1alpha3_VirtualMachineVolumeSource_To_v1alpha2_VirtualMachineVolumeSource(in *v1alpha3.VirtualMachineVolumeSource, out *VirtualMachineVolumeSource, s conversion.Scope) error {
-	out.PersistentVolumeClaim = (*PersistentVolumeClaimVolumeSource)(unsafe.Pointer(in.PersistentVolumeClaim))
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(PersistentVolumeClaimVolumeSource)
+		if err := Convert_v1alpha3_PersistentVolumeClaimVolumeSource_To_v1alpha2_PersistentVolumeClaimVolumeSource(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PersistentVolumeClaim = nil
+	}
 	return nil
 }
 
@@ -17,6 +17,10 @@ var (
 	// GroupVersion is group version used to register these objects.
 	GroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha3"}
 
+	// SchemeGroupVersion is an alias for GroupVersion that is used by the
+	// generated clientset, listers, and informers.
+	SchemeGroupVersion = GroupVersion
+
 	// schemeBuilder is used to add go types to the GroupVersionKind scheme.
 	schemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
 
@@ -34,3 +38,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	metav1.AddToGroupVersion(scheme, GroupVersion)
 	return nil
 }
+
+// Resource takes an unqualified resource and returns a Group qualified
+// GroupResource.
+func Resource(resource string) schema.GroupResource {
+	return GroupVersion.WithResource(resource).GroupResource()
+}
@@ -81,8 +81,77 @@ type VirtualMachineBootstrapSpec struct {
 	// This bootstrap provider may not be used in conjunction with the CloudInit
 	// bootstrap provider.
 	VAppConfig *VirtualMachineBootstrapVAppConfigSpec `json:"vAppConfig,omitempty"`
+
+	// +optional
+
+	// GuestCustomizationPolicy describes whether the Guest OS Customization
+	// (GOSC) spec produced by the bootstrap provider is applied to the VM.
+	//
+	// Please note some images do not support GOSC and fail to boot when it is
+	// applied.
+	//
+	// This policy does not select a bootstrap provider. If the bootstrap spec
+	// only specifies this policy, then the default bootstrap provider is used,
+	// just as if the bootstrap spec was omitted.
+	//
+	// If omitted, the policy configured for VM Operator is used, which
+	// defaults to Auto.
+	GuestCustomizationPolicy GuestCustomizationPolicy `json:"guestCustomizationPolicy,omitempty"`
+
+	// +optional
+
+	// GuestCustomizationRetryPolicy describes what happens when the Guest OS
+	// Customization (GOSC) fails because the guest's identity, ex. its
+	// hostname or SID, conflicts with another system on the network.
+	//
+	// When set to RegenerateIdentity, the customization is retried once with a
+	// regenerated identity. A random suffix is appended to the hostname, a new
+	// Cloud-Init instance ID is used, and Sysprep is directed to generate a new
+	// SID. The VM is power cycled so the customization can be reapplied and
+	// an event documents the changes.
+	//
+	// If omitted, the customization is not retried.
+	GuestCustomizationRetryPolicy GuestCustomizationRetryPolicy `json:"guestCustomizationRetryPolicy,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=None;RegenerateIdentity
+
+// GuestCustomizationRetryPolicy describes whether failed Guest OS
+// Customization is retried.
+type GuestCustomizationRetryPolicy string
+
+const (
+	// GuestCustomizationRetryPolicyNone indicates failed customization is not
+	// retried.
+	GuestCustomizationRetryPolicyNone GuestCustomizationRetryPolicy = "None"
+
+	// GuestCustomizationRetryPolicyRegenerateIdentity indicates customization
+	// that failed due to a duplicate identity is retried once with a
+	// regenerated identity.
+	GuestCustomizationRetryPolicyRegenerateIdentity GuestCustomizationRetryPolicy = "RegenerateIdentity"
+)
+
+// +kubebuilder:validation:Enum=Enabled;Disabled;Auto
+
+// GuestCustomizationPolicy describes whether Guest OS Customization is applied
+// to a VM.
+type GuestCustomizationPolicy string
+
+const (
+	// GuestCustomizationPolicyEnabled indicates the customization is always
+	// applied, even if the VM has the vsphere-customization bypass
+	// annotation.
+	GuestCustomizationPolicyEnabled GuestCustomizationPolicy = "Enabled"
+
+	// GuestCustomizationPolicyDisabled indicates the customization is never
+	// applied.
+	GuestCustomizationPolicyDisabled GuestCustomizationPolicy = "Disabled"
+
+	// GuestCustomizationPolicyAuto indicates the customization is applied
+	// unless the VM has the vsphere-customization bypass annotation.
+	GuestCustomizationPolicyAuto GuestCustomizationPolicy = "Auto"
+)
+
 // VirtualMachineBootstrapCloudInitSpec describes the CloudInit configuration
 // used to bootstrap the VM.
 type VirtualMachineBootstrapCloudInitSpec struct {
@@ -54,6 +54,24 @@ type VirtualMachineNetworkInterfaceSpec struct {
 	// inside the guest, ex. dvd, cdrom, sda, etc.
 	GuestDeviceName string `json:"guestDeviceName,omitempty"`
 
+	// +optional
+	// +kubebuilder:validation:Pattern="^([0-9a-fA-F]{2}:){5}[0-9a-fA-F]{2}$"
+
+	// MACAddr is an optional, manually assigned MAC address for this
+	// interface, ex. 00:50:56:2a:bb:cc.
+	//
+	// A MAC address with the VMware OUI, 00:50:56, must be in the range
+	// 00:50:56:00:00:00-00:50:56:3f:ff:ff, which vSphere reserves for
+	// manually assigned addresses.
+	//
+	// When this field is set, the interface's address type is Manual unless
+	// the connected network provides its own MAC address. Otherwise the MAC
+	// address is generated by vSphere.
+	//
+	// Please note the MAC address must be unique across all of the VMs
+	// managed by VM Operator.
+	MACAddr string `json:"macAddr,omitempty"`
+
 	// +optional
 
 	// Addresses is an optional list of IP4 or IP6 addresses to assign to this
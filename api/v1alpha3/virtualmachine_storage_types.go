@@ -32,6 +32,18 @@ type VirtualMachineVolume struct {
 	// VirtualMachineVolumeSource represents the location and type of a volume
 	// to mount.
 	VirtualMachineVolumeSource `json:",inline"`
+
+	// +optional
+
+	// PreventDelete is a safety latch that protects the volume's disk from
+	// being deleted. While this field is true, VM Operator never removes the
+	// disk from the VM in a way that also deletes the disk's files, the VM is
+	// not deleted while the disk is still attached to it, and the volume's
+	// PVC is not deleted with the VM regardless of the DeletePolicy.
+	//
+	// The latch must be removed, i.e. this field set to false, before the
+	// volume may be removed from the VM's spec.
+	PreventDelete bool `json:"preventDelete,omitempty"`
 }
 
 // VirtualMachineVolumeSource represents the source location of a volume to
@@ -56,8 +68,42 @@ type PersistentVolumeClaimVolumeSource struct {
 
 	// InstanceVolumeClaim is set if the PVC is backed by instance storage.
 	InstanceVolumeClaim *InstanceVolumeClaimVolumeSource `json:"instanceVolumeClaim,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=Retain
+
+	// DeletePolicy describes what happens to the PersistentVolumeClaim when
+	// the VirtualMachine is deleted.
+	//
+	// When set to Retain, the PVC is detached from the VM and remains in the
+	// namespace after the VM is deleted.
+	//
+	// When set to Delete, the PVC is deleted after the VM has been deleted
+	// and the volume has been detached from it.
+	//
+	// Defaults to Retain.
+	//
+	// Please note, this field does not apply to PVCs backed by instance
+	// storage, as they are always deleted with the VM.
+	DeletePolicy VirtualMachineVolumeDeletePolicy `json:"deletePolicy,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=Retain;Delete
+
+// VirtualMachineVolumeDeletePolicy describes what happens to a VirtualMachine
+// volume's PersistentVolumeClaim when the VirtualMachine is deleted.
+type VirtualMachineVolumeDeletePolicy string
+
+const (
+	// VirtualMachineVolumeDeletePolicyRetain indicates the PVC is retained
+	// when the VirtualMachine is deleted.
+	VirtualMachineVolumeDeletePolicyRetain VirtualMachineVolumeDeletePolicy = "Retain"
+
+	// VirtualMachineVolumeDeletePolicyDelete indicates the PVC is deleted
+	// when the VirtualMachine is deleted.
+	VirtualMachineVolumeDeletePolicyDelete VirtualMachineVolumeDeletePolicy = "Delete"
+)
+
 // InstanceVolumeClaimVolumeSource contains information about the instance
 // storage volume claimed as a PVC.
 type InstanceVolumeClaimVolumeSource struct {
@@ -49,9 +49,129 @@ const (
 	// VirtualMachineConditionCreated indicates that the VM has been created.
 	VirtualMachineConditionCreated = "VirtualMachineCreated"
 
+	// VirtualMachineCreateInProgressReason is the reason for the Created
+	// condition while the VM is being created. The condition's message
+	// reports the percent complete of the create.
+	VirtualMachineCreateInProgressReason = "VirtualMachineCreateInProgress"
+
 	// VirtualMachineClassConfigurationSynced indicates that the VM's current configuration is synced to the
 	// current version of its VirtualMachineClass.
 	VirtualMachineClassConfigurationSynced = "VirtualMachineClassConfigurationSynced"
+
+	// VirtualMachineConditionNetworkMigrated indicates that the VM's network
+	// interfaces that use the default named network have been re-backed to
+	// the current default named network.
+	VirtualMachineConditionNetworkMigrated = "VirtualMachineNetworkMigrated"
+
+	// VirtualMachineConditionDevicesManaged indicates that all of the VM's
+	// devices are represented in its spec or VM class, i.e. no devices, such
+	// as USB controllers, serial ports, or raw disks, were added out-of-band.
+	VirtualMachineConditionDevicesManaged = "VirtualMachineDevicesManaged"
+
+	// VirtualMachineConditionReconcileComplete indicates whether the last
+	// reconcile of the VM completed within the reconcile budget. The condition
+	// is only present when the reconcile did not complete.
+	VirtualMachineConditionReconcileComplete = "VirtualMachineReconcileComplete"
+
+	// VirtualMachineConditionHostReady indicates whether the host on which the
+	// VM is running is available, i.e. the host is connected, responding, and
+	// not in maintenance mode. The condition is only present when the VM has
+	// been placed on a host.
+	VirtualMachineConditionHostReady = "VirtualMachineHostReady"
+
+	// VirtualMachineConditionTaskStalled indicates that a vSphere task for the
+	// VM has been queued or running for longer than the configured threshold.
+	// The condition is only present when the VM has a stalled task.
+	VirtualMachineConditionTaskStalled = "TaskStalled"
+
+	// VirtualMachineConditionMACAddressesUnique indicates whether the MAC
+	// addresses assigned to or observed on the VM's network interfaces are not
+	// used by any other VM. The condition is only present when the VM has at
+	// least one known MAC address.
+	VirtualMachineConditionMACAddressesUnique = "VirtualMachineMACAddressesUnique"
+
+	// VirtualMachinePowerStateSyncedCondition indicates whether the VM's
+	// observed power state matches its desired power state. The condition is
+	// only present when an attempt to change the VM's power state failed.
+	VirtualMachinePowerStateSyncedCondition = "VirtualMachinePowerStateSynced"
+
+	// VirtualMachinePreDeployHooksSucceededCondition indicates whether the
+	// VirtualMachineDeployHooks with the PreDeploy phase that apply to the VM
+	// succeeded. The VM is not deployed until this condition is true. The
+	// condition is only present when at least one such hook applies to the VM.
+	VirtualMachinePreDeployHooksSucceededCondition = "VirtualMachinePreDeployHooksSucceeded"
+
+	// VirtualMachinePostFirstBootHooksSucceededCondition indicates whether the
+	// VirtualMachineDeployHooks with the PostFirstBoot phase that apply to the
+	// VM succeeded. The VM is not ready until this condition is true. The
+	// condition is only present when at least one such hook applies to the VM.
+	VirtualMachinePostFirstBootHooksSucceededCondition = "VirtualMachinePostFirstBootHooksSucceeded"
+)
+
+const (
+	// VirtualMachineUnmanagedDevicesFoundReason documents that the VM has
+	// devices that are not represented in its spec or VM class.
+	VirtualMachineUnmanagedDevicesFoundReason = "UnmanagedDevicesFound"
+
+	// VirtualMachineDevicesAuditFailedReason documents that the VM's devices
+	// could not be audited or the unmanaged devices could not be removed.
+	VirtualMachineDevicesAuditFailedReason = "DevicesAuditFailed"
+
+	// VirtualMachineReconcileBudgetExceededReason documents that the reconcile
+	// of the VM exceeded its budget, and the VM was requeued to complete the
+	// reconcile. The condition's message describes the progress that was made.
+	VirtualMachineReconcileBudgetExceededReason = "ReconcileBudgetExceeded"
+)
+
+const (
+	// VirtualMachineHostInMaintenanceModeReason documents that the VM's host
+	// is in maintenance mode.
+	VirtualMachineHostInMaintenanceModeReason = "HostInMaintenanceMode"
+
+	// VirtualMachineHostDisconnectedReason documents that the VM's host is
+	// disconnected from vCenter.
+	VirtualMachineHostDisconnectedReason = "HostDisconnected"
+
+	// VirtualMachineHostNotRespondingReason documents that the VM's host is
+	// not responding to vCenter.
+	VirtualMachineHostNotRespondingReason = "HostNotResponding"
+)
+
+const (
+	// VirtualMachineTaskInProgressReason documents that the VM's stalled task
+	// is still queued or running.
+	VirtualMachineTaskInProgressReason = "TaskInProgress"
+
+	// VirtualMachineTaskCancelledReason documents that the VM's stalled task
+	// was cancelled.
+	VirtualMachineTaskCancelledReason = "TaskCancelled"
+)
+
+const (
+	// VirtualMachineDuplicateMACAddressReason documents that one or more of
+	// the VM's MAC addresses are also used by another VM.
+	VirtualMachineDuplicateMACAddressReason = "DuplicateMACAddress"
+)
+
+const (
+	// VirtualMachinePowerStateChangePendingReason documents that an attempt to
+	// change the VM's power state failed, and the change will be retried.
+	VirtualMachinePowerStateChangePendingReason = "PowerStateChangePending"
+
+	// VirtualMachinePowerStateChangeFailedReason documents that the attempts
+	// to change the VM's power state exhausted the retry budget, and the
+	// change will not be retried until the VM's desired power state changes.
+	VirtualMachinePowerStateChangeFailedReason = "PowerStateChangeFailed"
+)
+
+const (
+	// VirtualMachineDeployHooksPendingReason documents that one or more of
+	// the VM's deploy hooks have not completed.
+	VirtualMachineDeployHooksPendingReason = "DeployHooksPending"
+
+	// VirtualMachineDeployHooksFailedReason documents that one or more of the
+	// VM's deploy hooks failed.
+	VirtualMachineDeployHooksFailedReason = "DeployHooksFailed"
 )
 
 const (
@@ -117,6 +237,17 @@ const (
 	VirtualMachineReconcilePausedReason = "VirtualMachineReconcilePaused"
 )
 
+const (
+	// VirtualMachineConditionUpgradeRecommended indicates the VM's hardware
+	// version is older than the minimum hardware version supported for new
+	// VMs, and the VM should be upgraded.
+	VirtualMachineConditionUpgradeRecommended = "UpgradeRecommended"
+
+	// VirtualMachineHardwareVersionBelowMinimumReason documents that the VM's
+	// hardware version is older than the minimum supported hardware version.
+	VirtualMachineHardwareVersionBelowMinimumReason = "HardwareVersionBelowMinimum"
+)
+
 const (
 	// PauseAnnotation is an annotation that prevents a VM from being
 	// reconciled.
@@ -142,6 +273,13 @@ const (
 	// be removed once set until the VM is deleted.
 	FirstBootDoneAnnotation = "virtualmachine." + GroupName + "/first-boot-done"
 
+	// PostFirstBootHooksAnnotation is an annotation that lists the names of the
+	// PostFirstBoot VirtualMachineDeployHooks that applied to the VM when the
+	// VM was deployed. Only these hooks are run once the VM has booted, so a
+	// hook is not run for the VMs deployed before the hook was created. This
+	// annotation cannot be set by users.
+	PostFirstBootHooksAnnotation = GroupName + "/post-first-boot-hooks"
+
 	// V1alpha1ConfigMapTransportAnnotation is an annotation that indicates that the VM
 	// was created with the v1alpha1 API and specifies a configMap as the metadata transport resource type.
 	V1alpha1ConfigMapTransportAnnotation = GroupName + "/v1a1-configmap-md-transport"
@@ -695,6 +833,10 @@ type VirtualMachineSpec struct {
 	// For a complete list of supported values, refer to https://bit.ly/3TiZX3G.
 	// Note that some guest ID values may require a minimal hardware version,
 	// which can be set using the `spec.minHardwareVersion` field.
+	// A guest ID that requires a newer hardware version than the VM's is
+	// rejected. A guest ID that is not known to VM Operator is allowed since it
+	// may be supported by a newer vSphere release, and is validated by vSphere
+	// when the VM is deployed or reconfigured.
 	// To see the mapping between virtual hardware versions and the product
 	// versions that support a specific guest ID, visit the following link:
 	// https://knowledge.broadcom.com/external/article/315655/virtual-machine-hardware-versions.html
@@ -749,6 +891,116 @@ type VirtualMachineAdvancedSpec struct {
 	// for this VM, a feature utilized by external backup systems such as
 	// VMware Data Recovery.
 	ChangeBlockTracking *bool `json:"changeBlockTracking,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// DataDisks describes additional, blank virtual disks that are added to
+	// the VM when it is created. This allows a VM to have data disks without
+	// the VirtualMachineImage from which the VM is deployed bundling empty
+	// disks.
+	//
+	// The disks are created in the VM's home directory before the VM is
+	// powered on for the first time. Unlike volumes, the disks are not backed
+	// by PersistentVolumeClaims and are deleted along with the VM.
+	//
+	// Please note this field may not be changed after the VM is created.
+	DataDisks []VirtualMachineDataDiskSpec `json:"dataDisks,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=busNumber
+
+	// SCSIControllers describes the VM's SCSI controllers whose bus sharing
+	// mode is configured. Controllers that do not exist are added to the VM.
+	// This allows legacy clustering workloads that require a shared SCSI bus,
+	// ex. Microsoft Windows Server Failover Clustering, to be modeled.
+	//
+	// Please note, vSphere does not support snapshots of a VM with a shared
+	// SCSI bus, and the disks on a shared SCSI bus must be eagerly zeroed.
+	// Therefore, when any controller's sharing mode is not None, the VM's data
+	// disks must use the ThickEagerZero provisioning mode and the VM may not
+	// opt into a snapshot before a reconfigure.
+	//
+	// Please note this field may not be changed while the VM is powered on.
+	SCSIControllers []VirtualMachineSCSIControllerSpec `json:"scsiControllers,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=None;Physical;Virtual
+
+// VirtualMachineSCSIControllerSharingMode describes the bus sharing mode of a
+// SCSI controller.
+type VirtualMachineSCSIControllerSharingMode string
+
+const (
+	// VirtualMachineSCSIControllerSharingModeNone indicates the SCSI bus is
+	// not shared.
+	VirtualMachineSCSIControllerSharingModeNone VirtualMachineSCSIControllerSharingMode = "None"
+
+	// VirtualMachineSCSIControllerSharingModePhysical indicates the SCSI bus
+	// may be shared with VMs on any host.
+	VirtualMachineSCSIControllerSharingModePhysical VirtualMachineSCSIControllerSharingMode = "Physical"
+
+	// VirtualMachineSCSIControllerSharingModeVirtual indicates the SCSI bus
+	// may be shared with VMs on the same host.
+	VirtualMachineSCSIControllerSharingModeVirtual VirtualMachineSCSIControllerSharingMode = "Virtual"
+)
+
+// VirtualMachineSCSIControllerSpec describes the bus sharing mode of one of a
+// VM's SCSI controllers.
+type VirtualMachineSCSIControllerSpec struct {
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3
+
+	// BusNumber is the bus number of the SCSI controller.
+	BusNumber int32 `json:"busNumber"`
+
+	// +optional
+	// +kubebuilder:default=None
+
+	// SharingMode is the bus sharing mode of the SCSI controller.
+	//
+	// Defaults to None.
+	SharingMode VirtualMachineSCSIControllerSharingMode `json:"sharingMode,omitempty"`
+}
+
+// HasSharedSCSIBus returns true if any of the SCSI controllers described by
+// the advanced spec has a sharing mode other than None.
+func (in *VirtualMachineAdvancedSpec) HasSharedSCSIBus() bool {
+	if in == nil {
+		return false
+	}
+	for _, c := range in.SCSIControllers {
+		if c.SharingMode != "" && c.SharingMode != VirtualMachineSCSIControllerSharingModeNone {
+			return true
+		}
+	}
+	return false
+}
+
+// VirtualMachineDataDiskSpec describes a blank virtual disk that is added to
+// a VM when the VM is created.
+type VirtualMachineDataDiskSpec struct {
+	// Name is the name of the data disk. Must be a DNS_LABEL and unique among
+	// the VM's data disks.
+	Name string `json:"name"`
+
+	// Capacity is the size of the data disk. The value must be a multiple of
+	// MB.
+	Capacity resource.Quantity `json:"capacity"`
+
+	// +optional
+
+	// ProvisioningMode is the provisioning mode of the data disk. Defaults to
+	// spec.advanced.defaultVolumeProvisioningMode if set, otherwise Thin.
+	ProvisioningMode VirtualMachineVolumeProvisioningMode `json:"provisioningMode,omitempty"`
+
+	// +optional
+
+	// StorageClass is the name of the StorageClass whose storage policy is
+	// applied to the data disk. Defaults to the VM's storage class.
+	StorageClass string `json:"storageClass,omitempty"`
 }
 
 type VirtualMachineEncryptionType string
@@ -885,6 +1137,7 @@ type VirtualMachineStatus struct {
 	Storage *VirtualMachineStorageStatus `json:"storage,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vm
 // +kubebuilder:storageversion
@@ -173,6 +173,7 @@ type VirtualMachineClassSpec struct {
 type VirtualMachineClassStatus struct {
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vmclass
 // +kubebuilder:storageversion
@@ -0,0 +1,260 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineDeployHookPhase describes when a deploy hook is run.
+type VirtualMachineDeployHookPhase string
+
+const (
+	// VirtualMachineDeployHookPhasePreDeploy indicates the hook is run before
+	// the VM is deployed. The VM is not deployed until the hook succeeds,
+	// which allows a hook to, for example, approve the deployment of the VM
+	// or to provision a license for the VM.
+	VirtualMachineDeployHookPhasePreDeploy VirtualMachineDeployHookPhase = "PreDeploy"
+
+	// VirtualMachineDeployHookPhasePostFirstBoot indicates the hook is run
+	// after the VM is powered on for the first time, which allows a hook to,
+	// for example, register the VM in a CMDB. The hook is only run for the VMs
+	// deployed after the hook was created.
+	VirtualMachineDeployHookPhasePostFirstBoot VirtualMachineDeployHookPhase = "PostFirstBoot"
+)
+
+// VirtualMachineDeployHookFailurePolicy describes how a failed deploy hook
+// is handled.
+type VirtualMachineDeployHookFailurePolicy string
+
+const (
+	// VirtualMachineDeployHookFailurePolicyFail indicates a failed hook gates
+	// the VM, i.e. a VM is not deployed if one of its PreDeploy hooks failed,
+	// and a VM is not ready if one of its PostFirstBoot hooks failed.
+	VirtualMachineDeployHookFailurePolicyFail VirtualMachineDeployHookFailurePolicy = "Fail"
+
+	// VirtualMachineDeployHookFailurePolicyIgnore indicates a failed hook is
+	// treated as if it succeeded.
+	VirtualMachineDeployHookFailurePolicyIgnore VirtualMachineDeployHookFailurePolicy = "Ignore"
+)
+
+// VirtualMachineDeployHookWebhook describes a webhook that is called to run a
+// deploy hook.
+//
+// The webhook is sent an HTTP POST request with a JSON-encoded
+// VirtualMachineDeployHookReview whose request field describes the VM and the
+// phase of the hook. The webhook responds with the same object with its
+// response field set. A response that is not allowed fails the hook, and any
+// other error, such as a timeout, is retried.
+//
+// The webhook is called in the background, and the hook is pending until the
+// webhook responds. Redirects are not followed.
+//
+// Please note, the webhook may be called more than once for the same VM and
+// phase, so the webhook must be idempotent. The webhook is called by VM
+// Operator, so the webhook's host must be allowed by the administrator. A hook
+// whose host is not allowed fails.
+type VirtualMachineDeployHookWebhook struct {
+	// +kubebuilder:validation:Pattern="^https://"
+
+	// URL is the HTTPS URL of the webhook. The URL's host must be one of the
+	// hosts the administrator allows webhooks to call.
+	URL string `json:"url"`
+
+	// +optional
+
+	// CABundle is a PEM encoded CA bundle used to validate the webhook's
+	// server certificate. If unspecified, the system trust roots are used.
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	// +kubebuilder:default=10
+
+	// TimeoutSeconds is the number of seconds to wait for the webhook to
+	// respond.
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// VirtualMachineDeployHookJob describes a Job that is created to run a deploy
+// hook.
+//
+// The Job is created in the VM's namespace and is owned by the VM. The
+// following environment variables are set in the Job's container:
+//
+//   - VM_NAME        -- the name of the VM
+//   - VM_NAMESPACE   -- the namespace of the VM
+//   - VM_UID         -- the UID of the VM
+//   - VM_IMAGE_NAME  -- the name of the VM's image
+//   - VM_PRIMARY_IP  -- the VM's primary IP address, if known
+//   - HOOK_PHASE     -- the phase of the hook, ex. PreDeploy
+//
+// The hook succeeds when the Job completes, and fails when the Job fails. A
+// failed Job is deleted so the hook is run with a new Job when it is retried.
+//
+// Please note, the Job is created by VM Operator, so the Job's image and
+// service account must be allowed by the administrator. A hook whose image or
+// service account is not allowed fails.
+type VirtualMachineDeployHookJob struct {
+	// Image is the container image used to run the hook. The image must be
+	// one of the images the administrator allows hooks to use.
+	Image string `json:"image"`
+
+	// +optional
+
+	// Command is the entrypoint of the container. The image's entrypoint is
+	// used if this field is not specified.
+	Command []string `json:"command,omitempty"`
+
+	// +optional
+
+	// Args are the arguments to the entrypoint.
+	Args []string `json:"args,omitempty"`
+
+	// +optional
+
+	// ServiceAccountName is the name of the service account used to run the
+	// Job's pod. The service account must be one of the service accounts the
+	// administrator allows hooks to use. The namespace's default service
+	// account is used if this field is not specified.
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// BackoffLimit is the number of retries before the Job is considered to
+	// have failed. Defaults to the Job's default.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+
+	// ActiveDeadlineSeconds is the duration in seconds the Job may be active
+	// before it is considered to have failed.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// VirtualMachineDeployHookSpec defines the desired state of
+// VirtualMachineDeployHook.
+type VirtualMachineDeployHookSpec struct {
+	// +kubebuilder:validation:Enum=PreDeploy;PostFirstBoot
+
+	// Phase describes when the hook is run.
+	Phase VirtualMachineDeployHookPhase `json:"phase"`
+
+	// +optional
+
+	// ImageNames is the list of the names of the images, i.e. the value of a
+	// VM's spec.imageName field, whose VMs run this hook. If empty, all of the
+	// VMs in the hook's namespace run this hook.
+	ImageNames []string `json:"imageNames,omitempty"`
+
+	// +optional
+
+	// Webhook describes the webhook called to run the hook.
+	//
+	// Please note, exactly one of Webhook or Job must be specified.
+	Webhook *VirtualMachineDeployHookWebhook `json:"webhook,omitempty"`
+
+	// +optional
+
+	// Job describes the Job created to run the hook.
+	//
+	// Please note, exactly one of Webhook or Job must be specified.
+	Job *VirtualMachineDeployHookJob `json:"job,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=Fail;Ignore
+	// +kubebuilder:default=Fail
+
+	// FailurePolicy describes how a failure of the hook is handled.
+	FailurePolicy VirtualMachineDeployHookFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmdeployhook
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".spec.phase"
+// +kubebuilder:printcolumn:name="Failure Policy",type="string",JSONPath=".spec.failurePolicy"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineDeployHook is the schema for the virtualmachinedeployhooks
+// API.
+//
+// A hook is run by each VM in the hook's namespace, or by the VMs deployed
+// from the hook's images, either before the VM is deployed or after the VM is
+// first powered on. The results of a VM's hooks are reported by the VM's
+// DeployHooks conditions, and gate whether the VM is deployed and whether the
+// VM is ready.
+type VirtualMachineDeployHook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineDeployHookSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineDeployHookList contains a list of VirtualMachineDeployHook.
+type VirtualMachineDeployHookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineDeployHook `json:"items"`
+}
+
+// VirtualMachineDeployHookRequest describes the VM for which a webhook is
+// called to run a deploy hook.
+type VirtualMachineDeployHookRequest struct {
+	// UID uniquely identifies the request.
+	UID string `json:"uid"`
+
+	// Hook is the name of the hook.
+	Hook string `json:"hook"`
+
+	// Phase is the phase of the hook.
+	Phase VirtualMachineDeployHookPhase `json:"phase"`
+
+	// VirtualMachine is the VM that runs the hook.
+	VirtualMachine VirtualMachine `json:"virtualMachine"`
+}
+
+// VirtualMachineDeployHookResponse describes the result of a webhook called
+// to run a deploy hook.
+type VirtualMachineDeployHookResponse struct {
+	// UID is the UID of the request.
+	UID string `json:"uid"`
+
+	// Allowed indicates whether the hook succeeded.
+	Allowed bool `json:"allowed"`
+
+	// +optional
+
+	// Message describes why the hook failed.
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineDeployHookReview is the object sent to and returned by a
+// webhook called to run a deploy hook.
+type VirtualMachineDeployHookReview struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+
+	// Request describes the VM for which the webhook is called.
+	Request *VirtualMachineDeployHookRequest `json:"request,omitempty"`
+
+	// +optional
+
+	// Response describes the result of the webhook.
+	Response *VirtualMachineDeployHookResponse `json:"response,omitempty"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineDeployHook{},
+		&VirtualMachineDeployHookList{})
+}
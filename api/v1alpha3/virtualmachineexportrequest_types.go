@@ -0,0 +1,305 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VirtualMachineExportRequestConditionSourceValid is the Type for a
+	// VirtualMachineExportRequest resource's status condition.
+	//
+	// The condition's status is set to true only when the source VM exists,
+	// has been created, and is powered off.
+	VirtualMachineExportRequestConditionSourceValid = "SourceValid"
+
+	// VirtualMachineExportRequestConditionTargetValid is the Type for a
+	// VirtualMachineExportRequest resource's status condition.
+	//
+	// The condition's status is set to true only when the information that
+	// describes the target of the export has been validated.
+	VirtualMachineExportRequestConditionTargetValid = "TargetValid"
+
+	// VirtualMachineExportRequestConditionExported is the Type for a
+	// VirtualMachineExportRequest resource's status condition.
+	//
+	// The condition's status is set to true only when the VM's disks have
+	// been exported to the content library item, or when the URLs from which
+	// the VM's disks may be downloaded are available.
+	VirtualMachineExportRequestConditionExported = "Exported"
+
+	// VirtualMachineExportRequestConditionComplete is the Type for a
+	// VirtualMachineExportRequest resource's status condition.
+	//
+	// The condition's status is set to true only when the export is finished,
+	// i.e. the content library item has been created, or the download URLs
+	// have expired.
+	VirtualMachineExportRequestConditionComplete = "Complete"
+)
+
+// Condition.Reason for Conditions related to VirtualMachineExportRequest.
+const (
+	// SourceVirtualMachineNotPoweredOffReason documents that the source VM of
+	// the VirtualMachineExportRequest is not powered off.
+	SourceVirtualMachineNotPoweredOffReason = "SourceVirtualMachineNotPoweredOff"
+
+	// TargetInvalidReason documents that the target of the
+	// VirtualMachineExportRequest does not describe the target for its type.
+	TargetInvalidReason = "TargetInvalid"
+
+	// ExportFailureReason documents that exporting the VM failed.
+	ExportFailureReason = "ExportFailure"
+
+	// ExportURLsExpiredReason documents that the URLs from which the VM's disks
+	// could be downloaded have expired.
+	ExportURLsExpiredReason = "URLsExpired"
+)
+
+// VirtualMachineExportTargetType describes the type of the target to which a
+// VM is exported.
+//
+// +kubebuilder:validation:Enum=ContentLibraryItem;HTTP
+type VirtualMachineExportTargetType string
+
+const (
+	// VirtualMachineExportTargetTypeContentLibraryItem indicates the VM is
+	// exported as an OVF to a new item in a content library.
+	VirtualMachineExportTargetTypeContentLibraryItem VirtualMachineExportTargetType = "ContentLibraryItem"
+
+	// VirtualMachineExportTargetTypeHTTP indicates the VM's disks are made
+	// available for download from HTTP URLs reported in the status.
+	VirtualMachineExportTargetTypeHTTP VirtualMachineExportTargetType = "HTTP"
+)
+
+const (
+	// VirtualMachineExportRequestDefaultURLTTLSeconds is the default number of
+	// seconds for which the download URLs of an HTTP export are valid.
+	VirtualMachineExportRequestDefaultURLTTLSeconds = 3600
+)
+
+// VirtualMachineExportRequestSource is the source of an export request.
+type VirtualMachineExportRequestSource struct {
+	// +optional
+
+	// Name is the name of the VirtualMachine resource to export.
+	//
+	// If omitted this value defaults to the name of the
+	// VirtualMachineExportRequest resource.
+	Name string `json:"name,omitempty"`
+}
+
+// VirtualMachineExportRequestContentLibraryItemTarget describes the content
+// library item to which the VM is exported.
+type VirtualMachineExportRequestContentLibraryItemTarget struct {
+	// Library is the name of the ContentLibrary resource, in the same
+	// namespace as the request, to which the VM is exported. The content
+	// library must be writable.
+	Library string `json:"library"`
+
+	// +optional
+
+	// Name is the name of the content library item.
+	//
+	// If omitted then the controller will use spec.source.name + "-export".
+	Name string `json:"name,omitempty"`
+
+	// +optional
+
+	// Description is the description of the content library item.
+	Description string `json:"description,omitempty"`
+}
+
+// VirtualMachineExportRequestHTTPTarget describes how the VM's disks are made
+// available for download.
+type VirtualMachineExportRequestHTTPTarget struct {
+	// +optional
+	// +kubebuilder:validation:Minimum=60
+
+	// URLTTLSeconds is the number of seconds for which the download URLs are
+	// valid once they are available. The VM remains locked for the export
+	// until the URLs expire.
+	//
+	// If omitted the URLs are valid for one hour.
+	URLTTLSeconds *int64 `json:"urlTTLSeconds,omitempty"`
+}
+
+// VirtualMachineExportRequestTarget is the target of an export request.
+type VirtualMachineExportRequestTarget struct {
+	// Type is the type of the target.
+	Type VirtualMachineExportTargetType `json:"type"`
+
+	// +optional
+
+	// ContentLibraryItem describes the content library item to which the VM
+	// is exported. This field is required when the type is
+	// ContentLibraryItem.
+	ContentLibraryItem *VirtualMachineExportRequestContentLibraryItemTarget `json:"contentLibraryItem,omitempty"`
+
+	// +optional
+
+	// HTTP describes how the VM's disks are made available for download when
+	// the type is HTTP.
+	HTTP *VirtualMachineExportRequestHTTPTarget `json:"http,omitempty"`
+}
+
+// VirtualMachineExportRequestSpec defines the desired state of a
+// VirtualMachineExportRequest.
+type VirtualMachineExportRequestSpec struct {
+	// +optional
+
+	// Source is the source of the export request, i.e. the VirtualMachine
+	// resource whose disks are exported. The VM must be powered off.
+	Source VirtualMachineExportRequestSource `json:"source,omitempty"`
+
+	// Target is the target of the export request.
+	Target VirtualMachineExportRequestTarget `json:"target"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// TTLSecondsAfterFinished is the time-to-live duration for how long this
+	// resource will be allowed to exist once the export completes. After the
+	// TTL expires, the resource will be automatically deleted without the
+	// user having to take any direct action.
+	//
+	// If this field is unset then the request resource will not be
+	// automatically deleted. If this field is set to zero then the request
+	// resource is eligible for deletion immediately after it finishes.
+	TTLSecondsAfterFinished *int64 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// VirtualMachineExportRequestFile describes a file that may be downloaded as
+// part of an HTTP export.
+type VirtualMachineExportRequestFile struct {
+	// Name is the name of the file, ex. disk-0.vmdk.
+	Name string `json:"name"`
+
+	// URL is the URL from which the file may be downloaded. The URL embeds
+	// the export's lease ticket and is valid until status.expirationTime.
+	URL string `json:"url"`
+
+	// +optional
+
+	// Size is the size of the file in bytes, if known.
+	Size int64 `json:"size,omitempty"`
+
+	// +optional
+
+	// SSLThumbprint is the SSL thumbprint of the host that serves the URL.
+	SSLThumbprint string `json:"sslThumbprint,omitempty"`
+}
+
+// VirtualMachineExportRequestStatus defines the observed state of a
+// VirtualMachineExportRequest.
+type VirtualMachineExportRequestStatus struct {
+	// +optional
+
+	// StartTime represents time when the request was acknowledged by the
+	// controller.
+	StartTime metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+
+	// CompletionTime represents time when the request was completed.
+	//
+	// The value of this field should be equal to the value of the
+	// LastTransitionTime for the status condition Type=Complete.
+	CompletionTime metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+
+	// ItemID is the ID of the content library item to which the VM was
+	// exported.
+	ItemID string `json:"itemID,omitempty"`
+
+	// +optional
+
+	// Attempts represents the number of times the request to export the VM
+	// to a content library item has been attempted.
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// +optional
+
+	// LastAttemptTime represents the time when the latest request to export
+	// the VM to a content library item was sent.
+	LastAttemptTime metav1.Time `json:"lastAttemptTime,omitempty"`
+
+	// +optional
+
+	// LeaseID is the ID of the vSphere export lease of an HTTP export.
+	LeaseID string `json:"leaseID,omitempty"`
+
+	// +optional
+
+	// Files are the files that may be downloaded as part of an HTTP export.
+	// The files are removed once the URLs expire.
+	Files []VirtualMachineExportRequestFile `json:"files,omitempty"`
+
+	// +optional
+
+	// ExpirationTime is the time at which the download URLs of an HTTP export
+	// expire.
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
+	// +optional
+
+	// Ready is set to true only when the VM has been exported to the content
+	// library item, or the download URLs of an HTTP export are available.
+	Ready bool `json:"ready,omitempty"`
+
+	// +optional
+
+	// Conditions is a list of the latest, available observations of the
+	// request's current state.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmexport
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".spec.source.name"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.target.type"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineExportRequest defines the information necessary to export a
+// powered-off VirtualMachine's disks, either as an OVF to a content library
+// item, or for download from HTTP URLs, so the VM may be archived or migrated
+// off-cluster.
+type VirtualMachineExportRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineExportRequestSpec   `json:"spec,omitempty"`
+	Status VirtualMachineExportRequestStatus `json:"status,omitempty"`
+}
+
+func (vmexp *VirtualMachineExportRequest) GetConditions() []metav1.Condition {
+	return vmexp.Status.Conditions
+}
+
+func (vmexp *VirtualMachineExportRequest) SetConditions(conditions []metav1.Condition) {
+	vmexp.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineExportRequestList contains a list of
+// VirtualMachineExportRequest resources.
+type VirtualMachineExportRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineExportRequest `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineExportRequest{},
+		&VirtualMachineExportRequestList{},
+	)
+}
@@ -49,6 +49,17 @@ const (
 	// VirtualMachineImageV1Alpha1CompatibleCondition denotes that an image was prepared by
 	// VMware specifically for compatibility with VMService.
 	VirtualMachineImageV1Alpha1CompatibleCondition = "VirtualMachineImageV1Alpha1Compatible"
+
+	// VirtualMachineImageDeployableWithoutInputCondition denotes that an image
+	// can be deployed without user input, i.e. the image does not have a EULA
+	// that must be accepted or user configurable OVF properties without
+	// default values.
+	VirtualMachineImageDeployableWithoutInputCondition = "DeployableWithoutInput"
+
+	// VirtualMachineImageExtraConfigCompatibleCondition denotes that the
+	// extraConfig keys an image requires be set on VMs deployed from it do not
+	// conflict with the keys managed by VM Operator.
+	VirtualMachineImageExtraConfigCompatibleCondition = "ExtraConfigCompatible"
 )
 
 // Condition reasons for VirtualMachineImages.
@@ -64,6 +75,21 @@ const (
 	// VirtualMachineImageProviderSecurityNotCompliantReason documents that the
 	// VirtualMachineImage provider doesn't meet security compliance requirements.
 	VirtualMachineImageProviderSecurityNotCompliantReason = "VirtualMachineImageProviderSecurityNotCompliant"
+
+	// VirtualMachineImageEULARequiredReason documents that the image has a
+	// EULA that must be accepted to deploy the image.
+	VirtualMachineImageEULARequiredReason = "EULARequired"
+
+	// VirtualMachineImageOVFPropertiesRequiredReason documents that the image
+	// has user configurable OVF properties without default values, which must
+	// be specified to deploy the image.
+	VirtualMachineImageOVFPropertiesRequiredReason = "OVFPropertiesRequired"
+
+	// VirtualMachineImageExtraConfigConflictReason documents that some of the
+	// extraConfig keys the image requires conflict with the keys managed by VM
+	// Operator. The conflicting keys are not set on VMs deployed from the
+	// image.
+	VirtualMachineImageExtraConfigConflictReason = "ExtraConfigConflict"
 )
 
 // VirtualMachineImageProductInfo describes product information for an image.
@@ -103,6 +129,20 @@ type VirtualMachineImageDiskInfo struct {
 	Size *resource.Quantity `json:"size,omitempty"`
 }
 
+// VirtualMachineImageResourceInfo describes the CPU and memory resources
+// recommended for VMs deployed from an image.
+type VirtualMachineImageResourceInfo struct {
+	// +optional
+
+	// Cpus describes the recommended number of virtual CPUs.
+	Cpus int64 `json:"cpus,omitempty"`
+
+	// +optional
+
+	// Memory describes the recommended amount of memory.
+	Memory *resource.Quantity `json:"memory,omitempty"`
+}
+
 // VirtualMachineImageOSInfo describes the image's guest operating system.
 type VirtualMachineImageOSInfo struct {
 	// +optional
@@ -215,6 +255,47 @@ type VirtualMachineImageStatus struct {
 	// this image.
 	VMwareSystemProperties []vmopv1common.KeyValuePair `json:"vmwareSystemProperties,omitempty"`
 
+	// +optional
+	// +listType=map
+	// +listMapKey=key
+
+	// ExtraConfig describes the extraConfig keys the image requires be set on
+	// VMs deployed from it, ex. GPU driver hints.
+	//
+	// If the source of an image is an OVF, then the keys are parsed from the
+	// ExtraConfig entries in the OVF's virtual hardware section that are
+	// marked as required. The keys are merged into the extraConfig of VMs
+	// deployed from the image, unless they are also set by the VM's class.
+	// Keys that are managed by VM Operator are excluded, and the conflict is
+	// reported by the image's ExtraConfigCompatible condition.
+	ExtraConfig []vmopv1common.KeyValuePair `json:"extraConfig,omitempty"`
+
+	// +optional
+	// +listType=atomic
+
+	// VGPUDevices describes the vGPU devices the image requires be added to
+	// VMs deployed from it.
+	//
+	// If the source of an image is an OVF, then the devices are parsed from
+	// the PCI passthrough items in the OVF's virtual hardware section that
+	// have a vGPU profile. The devices are added to VMs deployed from the
+	// image, unless the VM's class specifies vGPU devices, in which case the
+	// class's devices are used instead.
+	VGPUDevices []VGPUDevice `json:"vgpuDevices,omitempty"`
+
+	// +optional
+
+	// OVFPropertiesConfigMapName describes the name of the ConfigMap that
+	// contains this image's OVF properties and VMware system properties when
+	// they are too large to be stored in this status. When this field is set,
+	// the ovfProperties and vmwareSystemProperties fields are empty.
+	//
+	// The ConfigMap's "ovfProperties" and "vmwareSystemProperties" keys
+	// contain the JSON encoded properties. The ConfigMap is in the same
+	// namespace as a VirtualMachineImage, or in the VM Operator's namespace for
+	// a ClusterVirtualMachineImage.
+	OVFPropertiesConfigMapName string `json:"ovfPropertiesConfigMapName,omitempty"`
+
 	// +optional
 
 	// ProductInfo describes the observed product information for this image.
@@ -227,6 +308,18 @@ type VirtualMachineImageStatus struct {
 
 	// +optional
 
+	// RecommendedResources describes the CPU and memory resources recommended
+	// for VMs deployed from this image.
+	//
+	// If the source of an image is an OVF, then the recommended resources are
+	// parsed from the CPU and memory items in the OVF's virtual hardware
+	// section. VMs deployed with a VirtualMachineClass that has fewer resources
+	// than recommended are still allowed, but a warning is returned when the VM
+	// is created.
+	RecommendedResources *VirtualMachineImageResourceInfo `json:"recommendedResources,omitempty"`
+
+	// +optional
+
 	// ProviderContentVersion describes the content version from the provider item
 	// that this image corresponds to. If the provider of this image is a Content
 	// Library, this will be the version of the corresponding Content Library item.
@@ -239,6 +332,13 @@ type VirtualMachineImageStatus struct {
 	// corresponding Content Library item.
 	ProviderItemID string `json:"providerItemID,omitempty"`
 
+	// +optional
+
+	// ProviderItemSize describes the size of the provider item that this image
+	// corresponds to on storage. If the provider of this image is a Content
+	// Library, this will be the size of the corresponding Content Library item.
+	ProviderItemSize *resource.Quantity `json:"providerItemSize,omitempty"`
+
 	// +optional
 	// +listType=map
 	// +listMapKey=type
@@ -260,6 +360,7 @@ func (i *VirtualMachineImageStatus) SetConditions(conditions []metav1.Condition)
 	i.Conditions = conditions
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vmi;vmimage
 // +kubebuilder:storageversion
@@ -298,6 +399,8 @@ type VirtualMachineImageList struct {
 	Items           []VirtualMachineImage `json:"items"`
 }
 
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster,shortName=cvmi;cvmimage;clustervmi;clustervmimage
 // +kubebuilder:storageversion
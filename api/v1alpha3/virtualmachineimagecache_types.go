@@ -180,6 +180,7 @@ func (i *VirtualMachineImageCache) SetConditions(conditions []metav1.Condition)
 	i.Status.Conditions = conditions
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vmic;vmicache;vmimagecache
 // +kubebuilder:storageversion
@@ -0,0 +1,202 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VirtualMachineImageCatalogName is the name of the singleton
+	// VirtualMachineImageCatalog resource.
+	VirtualMachineImageCatalogName = "default"
+
+	// VirtualMachineImageCatalogMaxRecentErrors is the maximum number of
+	// errors reported in a VirtualMachineImageCatalog's status.recentErrors.
+	VirtualMachineImageCatalogMaxRecentErrors = 10
+)
+
+// VirtualMachineImageCatalogContentSourceStatus describes the sync status
+// of a single content source and the aggregated status of its images.
+type VirtualMachineImageCatalogContentSourceStatus struct {
+	// Kind describes the kind of the content source, ex. ContentLibrary or
+	// ClusterContentLibrary.
+	Kind string `json:"kind"`
+
+	// +optional
+
+	// Namespace describes the namespace of the content source. This field is
+	// empty for cluster-scoped content sources.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name describes the name of the content source.
+	Name string `json:"name"`
+
+	// Images describes the number of images provided by the content source.
+	Images int32 `json:"images"`
+
+	// ReadyImages describes the number of images provided by the content
+	// source that are ready.
+	ReadyImages int32 `json:"readyImages"`
+
+	// +optional
+
+	// Type describes the type of the content library, ex. Local or
+	// Subscribed.
+	Type string `json:"type,omitempty"`
+
+	// +optional
+
+	// Ready describes whether the content library is ready, i.e. it exists
+	// and, if it is subscribed, its last sync succeeded.
+	Ready bool `json:"ready,omitempty"`
+
+	// +optional
+
+	// LastSyncTime describes when the content library was last synced. This
+	// field is only set for subscribed content libraries.
+	LastSyncTime metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// +optional
+
+	// Reason describes the reason the content library is not ready.
+	Reason string `json:"reason,omitempty"`
+
+	// +optional
+
+	// Message describes why the content library is not ready.
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineImageCatalogError describes an image or content source that
+// is not ready.
+type VirtualMachineImageCatalogError struct {
+	// Kind describes the kind of the image or content source, ex.
+	// VirtualMachineImage, ClusterVirtualMachineImage, ContentLibrary, or
+	// ClusterContentLibrary.
+	Kind string `json:"kind"`
+
+	// +optional
+
+	// Namespace describes the namespace of the image or content source. This
+	// field is empty for cluster-scoped resources.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name describes the name of the image or content source.
+	Name string `json:"name"`
+
+	// +optional
+
+	// Reason describes the reason the image or content source is not ready.
+	Reason string `json:"reason,omitempty"`
+
+	// +optional
+
+	// Message describes why the image or content source is not ready.
+	Message string `json:"message,omitempty"`
+
+	// +optional
+
+	// LastTransitionTime describes when the image or content source last
+	// became not ready.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// VirtualMachineImageCatalogStatus defines the observed state of
+// VirtualMachineImageCatalog.
+type VirtualMachineImageCatalogStatus struct {
+	// +optional
+
+	// Images describes the number of VirtualMachineImage resources across all
+	// namespaces.
+	Images int32 `json:"images,omitempty"`
+
+	// +optional
+
+	// ReadyImages describes the number of VirtualMachineImage resources across
+	// all namespaces that are ready.
+	ReadyImages int32 `json:"readyImages,omitempty"`
+
+	// +optional
+
+	// ClusterImages describes the number of ClusterVirtualMachineImage
+	// resources.
+	ClusterImages int32 `json:"clusterImages,omitempty"`
+
+	// +optional
+
+	// ReadyClusterImages describes the number of ClusterVirtualMachineImage
+	// resources that are ready.
+	ReadyClusterImages int32 `json:"readyClusterImages,omitempty"`
+
+	// +optional
+
+	// ContentSources describes the sync status of each content source and the
+	// aggregated status of the images it provides.
+	ContentSources []VirtualMachineImageCatalogContentSourceStatus `json:"contentSources,omitempty"`
+
+	// +optional
+
+	// RecentErrors describes the most recent images and content sources that
+	// are not ready, newest first. At most
+	// VirtualMachineImageCatalogMaxRecentErrors are reported.
+	RecentErrors []VirtualMachineImageCatalogError `json:"recentErrors,omitempty"`
+
+	// +optional
+
+	// Conditions describes any conditions associated with the catalog.
+	//
+	// Generally this should just include the ReadyType condition, which will
+	// only be True if all of the images and content sources are ready.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+func (i VirtualMachineImageCatalog) GetConditions() []metav1.Condition {
+	return i.Status.Conditions
+}
+
+func (i *VirtualMachineImageCatalog) SetConditions(conditions []metav1.Condition) {
+	i.Status.Conditions = conditions
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=vmicat;vmimagecatalog
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Images",type="integer",JSONPath=".status.images"
+// +kubebuilder:printcolumn:name="Ready Images",type="integer",JSONPath=".status.readyImages"
+// +kubebuilder:printcolumn:name="Cluster Images",type="integer",JSONPath=".status.clusterImages"
+// +kubebuilder:printcolumn:name="Ready Cluster Images",type="integer",JSONPath=".status.readyClusterImages"
+
+// VirtualMachineImageCatalog is the schema for the virtualmachineimagecatalogs
+// API.
+//
+// The catalog is a singleton, named "default", that aggregates the status of
+// all the images and content sources so clients have a single resource to
+// poll instead of listing every image.
+type VirtualMachineImageCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status VirtualMachineImageCatalogStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineImageCatalogList contains a list of
+// VirtualMachineImageCatalog.
+type VirtualMachineImageCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineImageCatalog `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineImageCatalog{},
+		&VirtualMachineImageCatalogList{})
+}
@@ -0,0 +1,208 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VirtualMachineInventoryReportName is the name of the singleton
+	// VirtualMachineInventoryReport resource.
+	VirtualMachineInventoryReportName = "default"
+
+	// VirtualMachineInventoryReportMaxItems is the maximum number of drifted
+	// and orphaned VMs listed in a VirtualMachineInventoryReport's status.
+	// The status's counts always include all of the VMs.
+	VirtualMachineInventoryReportMaxItems = 500
+
+	// VirtualMachineInventoryReportListFailedReason is the reason of the
+	// report's Ready condition when the VMs on the underlying infrastructure
+	// could not be listed. The orphaned VMs are not reported in this case.
+	VirtualMachineInventoryReportListFailedReason = "ListVirtualMachinesFailed"
+)
+
+// VirtualMachineInventoryReportCount describes the number of VMs with a given
+// value, ex. the VMs of a VM class or the VMs in a zone.
+type VirtualMachineInventoryReportCount struct {
+	// Name describes the value, ex. the name of the VM class or zone.
+	Name string `json:"name"`
+
+	// VirtualMachines describes the number of VMs with the value.
+	VirtualMachines int32 `json:"virtualMachines"`
+}
+
+// VirtualMachineInventoryReportImage describes an image that is in use by at
+// least one VM.
+type VirtualMachineInventoryReportImage struct {
+	// Kind describes the kind of the image, ex. VirtualMachineImage or
+	// ClusterVirtualMachineImage.
+	Kind string `json:"kind"`
+
+	// +optional
+
+	// Namespace describes the namespace of the image. This field is empty for
+	// cluster-scoped images.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name describes the name of the image.
+	Name string `json:"name"`
+
+	// VirtualMachines describes the number of VMs deployed from the image.
+	VirtualMachines int32 `json:"virtualMachines"`
+}
+
+// VirtualMachineInventoryReportDriftedVM describes a VM whose observed state
+// has drifted from its desired state.
+type VirtualMachineInventoryReportDriftedVM struct {
+	// Namespace describes the namespace of the VM.
+	Namespace string `json:"namespace"`
+
+	// Name describes the name of the VM.
+	Name string `json:"name"`
+
+	// Conditions describes the types of the VM's conditions that indicate
+	// drift and are false, ex. VirtualMachineDevicesManaged.
+	Conditions []string `json:"conditions"`
+}
+
+// VirtualMachineInventoryReportOrphanedVM describes a VM on the underlying
+// infrastructure that was created by VM Operator, but that no longer has a
+// VirtualMachine resource.
+type VirtualMachineInventoryReportOrphanedVM struct {
+	// ID describes the ID of the VM on the underlying infrastructure, ex. the
+	// managed object ID of a vSphere VM.
+	ID string `json:"id"`
+
+	// +optional
+
+	// Name describes the name of the VM on the underlying infrastructure.
+	Name string `json:"name,omitempty"`
+
+	// +optional
+
+	// InstanceUUID describes the instance UUID of the VM.
+	InstanceUUID string `json:"instanceUUID,omitempty"`
+
+	// +optional
+
+	// VirtualMachine describes the namespace and name, in the format
+	// "namespace/name", of the VirtualMachine resource that created the VM.
+	VirtualMachine string `json:"virtualMachine,omitempty"`
+}
+
+// VirtualMachineInventoryReportStatus defines the observed state of
+// VirtualMachineInventoryReport.
+type VirtualMachineInventoryReportStatus struct {
+	// +optional
+
+	// LastGeneratedTime describes when the report was last generated.
+	LastGeneratedTime metav1.Time `json:"lastGeneratedTime,omitempty"`
+
+	// +optional
+
+	// VirtualMachines describes the number of VirtualMachine resources across
+	// all namespaces.
+	VirtualMachines int32 `json:"virtualMachines,omitempty"`
+
+	// +optional
+
+	// Classes describes the number of VMs of each VM class.
+	Classes []VirtualMachineInventoryReportCount `json:"classes,omitempty"`
+
+	// +optional
+
+	// Zones describes the number of VMs in each zone.
+	Zones []VirtualMachineInventoryReportCount `json:"zones,omitempty"`
+
+	// +optional
+
+	// Images describes the images in use and the number of VMs deployed from
+	// each of them.
+	Images []VirtualMachineInventoryReportImage `json:"images,omitempty"`
+
+	// +optional
+
+	// DriftedVirtualMachines describes the number of VMs whose observed state
+	// has drifted from their desired state.
+	DriftedVirtualMachines int32 `json:"driftedVirtualMachines,omitempty"`
+
+	// +optional
+
+	// Drifted describes the VMs whose observed state has drifted from their
+	// desired state. At most VirtualMachineInventoryReportMaxItems are
+	// reported.
+	Drifted []VirtualMachineInventoryReportDriftedVM `json:"drifted,omitempty"`
+
+	// +optional
+
+	// OrphanedVirtualMachines describes the number of VMs on the underlying
+	// infrastructure that were created by VM Operator, but no longer have a
+	// VirtualMachine resource.
+	OrphanedVirtualMachines int32 `json:"orphanedVirtualMachines,omitempty"`
+
+	// +optional
+
+	// Orphaned describes the VMs on the underlying infrastructure that were
+	// created by VM Operator, but no longer have a VirtualMachine resource.
+	// At most VirtualMachineInventoryReportMaxItems are reported.
+	Orphaned []VirtualMachineInventoryReportOrphanedVM `json:"orphaned,omitempty"`
+
+	// +optional
+
+	// Conditions describes any conditions associated with the report.
+	//
+	// Generally this should just include the ReadyType condition, which will
+	// only be True if the last report was generated without error.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+func (r VirtualMachineInventoryReport) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+func (r *VirtualMachineInventoryReport) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=vminv;vminventoryreport
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="VMs",type="integer",JSONPath=".status.virtualMachines"
+// +kubebuilder:printcolumn:name="Drifted",type="integer",JSONPath=".status.driftedVirtualMachines"
+// +kubebuilder:printcolumn:name="Orphaned",type="integer",JSONPath=".status.orphanedVirtualMachines"
+// +kubebuilder:printcolumn:name="Last Generated",type="date",JSONPath=".status.lastGeneratedTime"
+
+// VirtualMachineInventoryReport is the schema for the
+// virtualmachineinventoryreports API.
+//
+// The report is a singleton, named "default", that is periodically
+// regenerated with an inventory of all the VMs so it may be exported to
+// asset-management systems.
+type VirtualMachineInventoryReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status VirtualMachineInventoryReportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineInventoryReportList contains a list of
+// VirtualMachineInventoryReport.
+type VirtualMachineInventoryReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineInventoryReport `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineInventoryReport{},
+		&VirtualMachineInventoryReportList{})
+}
@@ -7,6 +7,7 @@ package v1alpha3
 import (
 	vmopv1common "github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
@@ -39,8 +40,87 @@ const (
 
 	// ScalingDownReason documents a VirtualMachineReplicaSet is decreasing the number of replicas.
 	ScalingDownReason = "ScalingDown"
+
+	// VirtualMachinesUpdatedCondition documents that the virtual machines
+	// controlled by the VirtualMachineReplicaSet match the class and image
+	// specified in the VirtualMachineReplicaSet's template.
+	VirtualMachinesUpdatedCondition = "VirtualMachinesUpdated"
+
+	// RollingUpdateReason documents a VirtualMachineReplicaSet is replacing
+	// outdated replicas one by one.
+	RollingUpdateReason = "RollingUpdate"
+
+	// RecreateReason documents a VirtualMachineReplicaSet is deleting all of
+	// its outdated replicas before creating new ones.
+	RecreateReason = "Recreate"
+)
+
+// +kubebuilder:validation:Enum=RollingUpdate;Recreate
+
+// VirtualMachineReplicaSetUpdateStrategyType describes how a
+// VirtualMachineReplicaSet replaces its virtual machines when the class or
+// image in its template changes.
+type VirtualMachineReplicaSetUpdateStrategyType string
+
+const (
+	// VirtualMachineReplicaSetUpdateStrategyTypeRollingUpdate replaces the
+	// outdated virtual machines one by one, bounded by the strategy's
+	// MaxUnavailable and MaxSurge.
+	VirtualMachineReplicaSetUpdateStrategyTypeRollingUpdate VirtualMachineReplicaSetUpdateStrategyType = "RollingUpdate"
+
+	// VirtualMachineReplicaSetUpdateStrategyTypeRecreate deletes all of the
+	// outdated virtual machines before any new ones are created.
+	VirtualMachineReplicaSetUpdateStrategyTypeRecreate VirtualMachineReplicaSetUpdateStrategyType = "Recreate"
 )
 
+// VirtualMachineReplicaSetUpdateStrategy describes how to replace existing
+// virtual machines with new ones.
+type VirtualMachineReplicaSetUpdateStrategy struct {
+	// +optional
+	// +kubebuilder:default=RollingUpdate
+
+	// Type of the update strategy. May be either RollingUpdate or Recreate.
+	// Defaults to RollingUpdate.
+	Type VirtualMachineReplicaSetUpdateStrategyType `json:"type,omitempty"`
+
+	// +optional
+
+	// RollingUpdate describes the parameters used when Type is
+	// RollingUpdate.
+	RollingUpdate *VirtualMachineReplicaSetRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// VirtualMachineReplicaSetRollingUpdate describes the parameters of a rolling
+// update.
+type VirtualMachineReplicaSetRollingUpdate struct {
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+
+	// MaxUnavailable is the maximum number of virtual machines that can be
+	// unavailable during the update. The value may be an absolute number, ex.
+	// 1, or a percentage of the desired replicas, ex. 10%. A percentage is
+	// rounded down. A virtual machine is available when it has been created
+	// and, if it has a readiness probe, is ready.
+	//
+	// This value may not be zero if MaxSurge is also zero.
+	//
+	// Defaults to 0.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+
+	// MaxSurge is the maximum number of virtual machines that can be created
+	// above the desired replicas during the update. The value may be an
+	// absolute number, ex. 1, or a percentage of the desired replicas, ex.
+	// 10%. A percentage is rounded up.
+	//
+	// This value may not be zero if MaxUnavailable is also zero.
+	//
+	// Defaults to 1.
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
 const (
 	// VirtualMachineReplicaSetNameLabel is the key of the label applied on all the
 	// replicas VirtualMachine objects that it owns.  The value of this label is the
@@ -80,6 +160,12 @@ type VirtualMachineReplicaSetSpec struct {
 	// Only supported deletion policy is "Random".
 	DeletePolicy string `json:"deletePolicy,omitempty"`
 
+	// +optional
+	//
+	// Strategy describes how existing virtual machines are replaced with new
+	// ones when the class or image in the template changes.
+	Strategy VirtualMachineReplicaSetUpdateStrategy `json:"strategy,omitempty"`
+
 	// +optional
 	//
 	// Selector is a label to query over virtual machines that should match the
@@ -118,6 +204,12 @@ type VirtualMachineReplicaSetStatus struct {
 	// true.
 	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
 
+	// +optional
+	//
+	// UpdatedReplicas is the number of replicas whose class and image match
+	// the VirtualMachineReplicaSet's template.
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
 	// +optional
 	//
 	// ObservedGeneration reflects the generation of the most recently observed
@@ -139,6 +231,7 @@ func (rs *VirtualMachineReplicaSet) SetConditions(conditions []metav1.Condition)
 	rs.Status.Conditions = conditions
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,shortName=vmrs;vmreplicaset
 // +kubebuilder:storageversion
@@ -40,6 +40,42 @@ type VirtualMachineSetResourcePolicySpec struct {
 // VirtualMachineSetResourcePolicy.
 type VirtualMachineSetResourcePolicyStatus struct {
 	ClusterModules []VSphereClusterModuleStatus `json:"clustermodules,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// Zones describes the observed state of the policy's child folder and
+	// resource pools in each of the namespace's availability zones.
+	Zones []VirtualMachineSetResourcePolicyZoneStatus `json:"zones,omitempty"`
+}
+
+// VirtualMachineSetResourcePolicyZoneStatus describes the observed state of a
+// VirtualMachineSetResourcePolicy in an availability zone.
+type VirtualMachineSetResourcePolicyZoneStatus struct {
+	// Name is the name of the availability zone.
+	Name string `json:"name"`
+
+	// Ready is true when the policy's child folder, resource pools, and
+	// cluster modules exist in the zone.
+	Ready bool `json:"ready"`
+
+	// +optional
+
+	// FolderMoID is the managed object ID of the policy's child folder in the
+	// zone.
+	FolderMoID string `json:"folderMoID,omitempty"`
+
+	// +optional
+
+	// ResourcePoolMoIDs are the managed object IDs of the policy's child
+	// resource pools in the zone.
+	ResourcePoolMoIDs []string `json:"resourcePoolMoIDs,omitempty"`
+
+	// +optional
+
+	// Message describes why the policy is not ready in the zone.
+	Message string `json:"message,omitempty"`
 }
 
 // VSphereClusterModuleStatus describes the observed state of a vSphere
@@ -50,6 +86,7 @@ type VSphereClusterModuleStatus struct {
 	ClusterMoID string `json:"clusterMoID"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
 // +kubebuilder:subresource:status
@@ -53,6 +53,7 @@ type VirtualMachineWebConsoleRequestStatus struct {
 	ProxyAddr string `json:"proxyAddr,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced
 // +kubebuilder:storageversion
@@ -15,6 +15,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/sysprep"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -426,6 +427,18 @@ func (in *VirtualMachineAdvancedSpec) DeepCopyInto(out *VirtualMachineAdvancedSp
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DataDisks != nil {
+		in, out := &in.DataDisks, &out.DataDisks
+		*out = make([]VirtualMachineDataDiskSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SCSIControllers != nil {
+		in, out := &in.SCSIControllers, &out.SCSIControllers
+		*out = make([]VirtualMachineSCSIControllerSpec, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineAdvancedSpec.
@@ -793,6 +806,427 @@ func (in *VirtualMachineCryptoStatus) DeepCopy() *VirtualMachineCryptoStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDataDiskSpec) DeepCopyInto(out *VirtualMachineDataDiskSpec) {
+	*out = *in
+	out.Capacity = in.Capacity.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDataDiskSpec.
+func (in *VirtualMachineDataDiskSpec) DeepCopy() *VirtualMachineDataDiskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDataDiskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeployHook) DeepCopyInto(out *VirtualMachineDeployHook) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeployHook.
+func (in *VirtualMachineDeployHook) DeepCopy() *VirtualMachineDeployHook {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeployHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineDeployHook) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeployHookJob) DeepCopyInto(out *VirtualMachineDeployHookJob) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeployHookJob.
+func (in *VirtualMachineDeployHookJob) DeepCopy() *VirtualMachineDeployHookJob {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeployHookJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeployHookList) DeepCopyInto(out *VirtualMachineDeployHookList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineDeployHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeployHookList.
+func (in *VirtualMachineDeployHookList) DeepCopy() *VirtualMachineDeployHookList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeployHookList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineDeployHookList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeployHookRequest) DeepCopyInto(out *VirtualMachineDeployHookRequest) {
+	*out = *in
+	in.VirtualMachine.DeepCopyInto(&out.VirtualMachine)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeployHookRequest.
+func (in *VirtualMachineDeployHookRequest) DeepCopy() *VirtualMachineDeployHookRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeployHookRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeployHookResponse) DeepCopyInto(out *VirtualMachineDeployHookResponse) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeployHookResponse.
+func (in *VirtualMachineDeployHookResponse) DeepCopy() *VirtualMachineDeployHookResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeployHookResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeployHookReview) DeepCopyInto(out *VirtualMachineDeployHookReview) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(VirtualMachineDeployHookRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(VirtualMachineDeployHookResponse)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeployHookReview.
+func (in *VirtualMachineDeployHookReview) DeepCopy() *VirtualMachineDeployHookReview {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeployHookReview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeployHookSpec) DeepCopyInto(out *VirtualMachineDeployHookSpec) {
+	*out = *in
+	if in.ImageNames != nil {
+		in, out := &in.ImageNames, &out.ImageNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(VirtualMachineDeployHookWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(VirtualMachineDeployHookJob)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeployHookSpec.
+func (in *VirtualMachineDeployHookSpec) DeepCopy() *VirtualMachineDeployHookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeployHookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeployHookWebhook) DeepCopyInto(out *VirtualMachineDeployHookWebhook) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeployHookWebhook.
+func (in *VirtualMachineDeployHookWebhook) DeepCopy() *VirtualMachineDeployHookWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeployHookWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportRequest) DeepCopyInto(out *VirtualMachineExportRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportRequest.
+func (in *VirtualMachineExportRequest) DeepCopy() *VirtualMachineExportRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineExportRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportRequestContentLibraryItemTarget) DeepCopyInto(out *VirtualMachineExportRequestContentLibraryItemTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportRequestContentLibraryItemTarget.
+func (in *VirtualMachineExportRequestContentLibraryItemTarget) DeepCopy() *VirtualMachineExportRequestContentLibraryItemTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportRequestContentLibraryItemTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportRequestFile) DeepCopyInto(out *VirtualMachineExportRequestFile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportRequestFile.
+func (in *VirtualMachineExportRequestFile) DeepCopy() *VirtualMachineExportRequestFile {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportRequestFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportRequestHTTPTarget) DeepCopyInto(out *VirtualMachineExportRequestHTTPTarget) {
+	*out = *in
+	if in.URLTTLSeconds != nil {
+		in, out := &in.URLTTLSeconds, &out.URLTTLSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportRequestHTTPTarget.
+func (in *VirtualMachineExportRequestHTTPTarget) DeepCopy() *VirtualMachineExportRequestHTTPTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportRequestHTTPTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportRequestList) DeepCopyInto(out *VirtualMachineExportRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineExportRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportRequestList.
+func (in *VirtualMachineExportRequestList) DeepCopy() *VirtualMachineExportRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineExportRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportRequestSource) DeepCopyInto(out *VirtualMachineExportRequestSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportRequestSource.
+func (in *VirtualMachineExportRequestSource) DeepCopy() *VirtualMachineExportRequestSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportRequestSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportRequestSpec) DeepCopyInto(out *VirtualMachineExportRequestSpec) {
+	*out = *in
+	out.Source = in.Source
+	in.Target.DeepCopyInto(&out.Target)
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportRequestSpec.
+func (in *VirtualMachineExportRequestSpec) DeepCopy() *VirtualMachineExportRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportRequestStatus) DeepCopyInto(out *VirtualMachineExportRequestStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+	in.LastAttemptTime.DeepCopyInto(&out.LastAttemptTime)
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]VirtualMachineExportRequestFile, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportRequestStatus.
+func (in *VirtualMachineExportRequestStatus) DeepCopy() *VirtualMachineExportRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportRequestTarget) DeepCopyInto(out *VirtualMachineExportRequestTarget) {
+	*out = *in
+	if in.ContentLibraryItem != nil {
+		in, out := &in.ContentLibraryItem, &out.ContentLibraryItem
+		*out = new(VirtualMachineExportRequestContentLibraryItemTarget)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(VirtualMachineExportRequestHTTPTarget)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportRequestTarget.
+func (in *VirtualMachineExportRequestTarget) DeepCopy() *VirtualMachineExportRequestTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportRequestTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineImage) DeepCopyInto(out *VirtualMachineImage) {
 	*out = *in
@@ -926,65 +1360,191 @@ func (in *VirtualMachineImageCacheLocationStatus) DeepCopyInto(out *VirtualMachi
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheLocationStatus.
-func (in *VirtualMachineImageCacheLocationStatus) DeepCopy() *VirtualMachineImageCacheLocationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheLocationStatus.
+func (in *VirtualMachineImageCacheLocationStatus) DeepCopy() *VirtualMachineImageCacheLocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCacheLocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCacheOVFStatus) DeepCopyInto(out *VirtualMachineImageCacheOVFStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheOVFStatus.
+func (in *VirtualMachineImageCacheOVFStatus) DeepCopy() *VirtualMachineImageCacheOVFStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCacheOVFStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCacheSpec) DeepCopyInto(out *VirtualMachineImageCacheSpec) {
+	*out = *in
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make([]VirtualMachineImageCacheLocationSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheSpec.
+func (in *VirtualMachineImageCacheSpec) DeepCopy() *VirtualMachineImageCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCacheStatus) DeepCopyInto(out *VirtualMachineImageCacheStatus) {
+	*out = *in
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make([]VirtualMachineImageCacheLocationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OVF != nil {
+		in, out := &in.OVF, &out.OVF
+		*out = new(VirtualMachineImageCacheOVFStatus)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheStatus.
+func (in *VirtualMachineImageCacheStatus) DeepCopy() *VirtualMachineImageCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCatalog) DeepCopyInto(out *VirtualMachineImageCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCatalog.
+func (in *VirtualMachineImageCatalog) DeepCopy() *VirtualMachineImageCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineImageCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCatalogContentSourceStatus) DeepCopyInto(out *VirtualMachineImageCatalogContentSourceStatus) {
+	*out = *in
+	in.LastSyncTime.DeepCopyInto(&out.LastSyncTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCatalogContentSourceStatus.
+func (in *VirtualMachineImageCatalogContentSourceStatus) DeepCopy() *VirtualMachineImageCatalogContentSourceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageCacheLocationStatus)
+	out := new(VirtualMachineImageCatalogContentSourceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageCacheOVFStatus) DeepCopyInto(out *VirtualMachineImageCacheOVFStatus) {
+func (in *VirtualMachineImageCatalogError) DeepCopyInto(out *VirtualMachineImageCatalogError) {
 	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheOVFStatus.
-func (in *VirtualMachineImageCacheOVFStatus) DeepCopy() *VirtualMachineImageCacheOVFStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCatalogError.
+func (in *VirtualMachineImageCatalogError) DeepCopy() *VirtualMachineImageCatalogError {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageCacheOVFStatus)
+	out := new(VirtualMachineImageCatalogError)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageCacheSpec) DeepCopyInto(out *VirtualMachineImageCacheSpec) {
+func (in *VirtualMachineImageCatalogList) DeepCopyInto(out *VirtualMachineImageCatalogList) {
 	*out = *in
-	if in.Locations != nil {
-		in, out := &in.Locations, &out.Locations
-		*out = make([]VirtualMachineImageCacheLocationSpec, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineImageCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheSpec.
-func (in *VirtualMachineImageCacheSpec) DeepCopy() *VirtualMachineImageCacheSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCatalogList.
+func (in *VirtualMachineImageCatalogList) DeepCopy() *VirtualMachineImageCatalogList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageCacheSpec)
+	out := new(VirtualMachineImageCatalogList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineImageCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageCacheStatus) DeepCopyInto(out *VirtualMachineImageCacheStatus) {
+func (in *VirtualMachineImageCatalogStatus) DeepCopyInto(out *VirtualMachineImageCatalogStatus) {
 	*out = *in
-	if in.Locations != nil {
-		in, out := &in.Locations, &out.Locations
-		*out = make([]VirtualMachineImageCacheLocationStatus, len(*in))
+	if in.ContentSources != nil {
+		in, out := &in.ContentSources, &out.ContentSources
+		*out = make([]VirtualMachineImageCatalogContentSourceStatus, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.OVF != nil {
-		in, out := &in.OVF, &out.OVF
-		*out = new(VirtualMachineImageCacheOVFStatus)
-		**out = **in
+	if in.RecentErrors != nil {
+		in, out := &in.RecentErrors, &out.RecentErrors
+		*out = make([]VirtualMachineImageCatalogError, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -995,12 +1555,12 @@ func (in *VirtualMachineImageCacheStatus) DeepCopyInto(out *VirtualMachineImageC
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheStatus.
-func (in *VirtualMachineImageCacheStatus) DeepCopy() *VirtualMachineImageCacheStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCatalogStatus.
+func (in *VirtualMachineImageCatalogStatus) DeepCopy() *VirtualMachineImageCatalogStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageCacheStatus)
+	out := new(VirtualMachineImageCatalogStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1107,6 +1667,26 @@ func (in *VirtualMachineImageRef) DeepCopy() *VirtualMachineImageRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageResourceInfo) DeepCopyInto(out *VirtualMachineImageResourceInfo) {
+	*out = *in
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageResourceInfo.
+func (in *VirtualMachineImageResourceInfo) DeepCopy() *VirtualMachineImageResourceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageResourceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineImageSpec) DeepCopyInto(out *VirtualMachineImageSpec) {
 	*out = *in
@@ -1153,6 +1733,16 @@ func (in *VirtualMachineImageStatus) DeepCopyInto(out *VirtualMachineImageStatus
 		*out = make([]common.KeyValuePair, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExtraConfig != nil {
+		in, out := &in.ExtraConfig, &out.ExtraConfig
+		*out = make([]common.KeyValuePair, len(*in))
+		copy(*out, *in)
+	}
+	if in.VGPUDevices != nil {
+		in, out := &in.VGPUDevices, &out.VGPUDevices
+		*out = make([]VGPUDevice, len(*in))
+		copy(*out, *in)
+	}
 	out.ProductInfo = in.ProductInfo
 	if in.Disks != nil {
 		in, out := &in.Disks, &out.Disks
@@ -1161,6 +1751,16 @@ func (in *VirtualMachineImageStatus) DeepCopyInto(out *VirtualMachineImageStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RecommendedResources != nil {
+		in, out := &in.RecommendedResources, &out.RecommendedResources
+		*out = new(VirtualMachineImageResourceInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderItemSize != nil {
+		in, out := &in.ProviderItemSize, &out.ProviderItemSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1180,6 +1780,179 @@ func (in *VirtualMachineImageStatus) DeepCopy() *VirtualMachineImageStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInventoryReport) DeepCopyInto(out *VirtualMachineInventoryReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInventoryReport.
+func (in *VirtualMachineInventoryReport) DeepCopy() *VirtualMachineInventoryReport {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInventoryReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInventoryReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInventoryReportCount) DeepCopyInto(out *VirtualMachineInventoryReportCount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInventoryReportCount.
+func (in *VirtualMachineInventoryReportCount) DeepCopy() *VirtualMachineInventoryReportCount {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInventoryReportCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInventoryReportDriftedVM) DeepCopyInto(out *VirtualMachineInventoryReportDriftedVM) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInventoryReportDriftedVM.
+func (in *VirtualMachineInventoryReportDriftedVM) DeepCopy() *VirtualMachineInventoryReportDriftedVM {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInventoryReportDriftedVM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInventoryReportImage) DeepCopyInto(out *VirtualMachineInventoryReportImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInventoryReportImage.
+func (in *VirtualMachineInventoryReportImage) DeepCopy() *VirtualMachineInventoryReportImage {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInventoryReportImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInventoryReportList) DeepCopyInto(out *VirtualMachineInventoryReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineInventoryReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInventoryReportList.
+func (in *VirtualMachineInventoryReportList) DeepCopy() *VirtualMachineInventoryReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInventoryReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInventoryReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInventoryReportOrphanedVM) DeepCopyInto(out *VirtualMachineInventoryReportOrphanedVM) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInventoryReportOrphanedVM.
+func (in *VirtualMachineInventoryReportOrphanedVM) DeepCopy() *VirtualMachineInventoryReportOrphanedVM {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInventoryReportOrphanedVM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInventoryReportStatus) DeepCopyInto(out *VirtualMachineInventoryReportStatus) {
+	*out = *in
+	in.LastGeneratedTime.DeepCopyInto(&out.LastGeneratedTime)
+	if in.Classes != nil {
+		in, out := &in.Classes, &out.Classes
+		*out = make([]VirtualMachineInventoryReportCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]VirtualMachineInventoryReportCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]VirtualMachineInventoryReportImage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Drifted != nil {
+		in, out := &in.Drifted, &out.Drifted
+		*out = make([]VirtualMachineInventoryReportDriftedVM, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Orphaned != nil {
+		in, out := &in.Orphaned, &out.Orphaned
+		*out = make([]VirtualMachineInventoryReportOrphanedVM, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInventoryReportStatus.
+func (in *VirtualMachineInventoryReportStatus) DeepCopy() *VirtualMachineInventoryReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInventoryReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
 	*out = *in
@@ -1968,6 +2741,31 @@ func (in *VirtualMachineReplicaSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReplicaSetRollingUpdate) DeepCopyInto(out *VirtualMachineReplicaSetRollingUpdate) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSetRollingUpdate.
+func (in *VirtualMachineReplicaSetRollingUpdate) DeepCopy() *VirtualMachineReplicaSetRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineReplicaSetRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineReplicaSetSpec) DeepCopyInto(out *VirtualMachineReplicaSetSpec) {
 	*out = *in
@@ -1976,6 +2774,7 @@ func (in *VirtualMachineReplicaSetSpec) DeepCopyInto(out *VirtualMachineReplicaS
 		*out = new(int32)
 		**out = **in
 	}
+	in.Strategy.DeepCopyInto(&out.Strategy)
 	if in.Selector != nil {
 		in, out := &in.Selector, &out.Selector
 		*out = new(v1.LabelSelector)
@@ -2016,6 +2815,26 @@ func (in *VirtualMachineReplicaSetStatus) DeepCopy() *VirtualMachineReplicaSetSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReplicaSetUpdateStrategy) DeepCopyInto(out *VirtualMachineReplicaSetUpdateStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(VirtualMachineReplicaSetRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSetUpdateStrategy.
+func (in *VirtualMachineReplicaSetUpdateStrategy) DeepCopy() *VirtualMachineReplicaSetUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineReplicaSetUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineReservedSpec) DeepCopyInto(out *VirtualMachineReservedSpec) {
 	*out = *in
@@ -2048,6 +2867,21 @@ func (in *VirtualMachineResourceSpec) DeepCopy() *VirtualMachineResourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSCSIControllerSpec) DeepCopyInto(out *VirtualMachineSCSIControllerSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSCSIControllerSpec.
+func (in *VirtualMachineSCSIControllerSpec) DeepCopy() *VirtualMachineSCSIControllerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSCSIControllerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineService) DeepCopyInto(out *VirtualMachineService) {
 	*out = *in
@@ -2258,6 +3092,13 @@ func (in *VirtualMachineSetResourcePolicyStatus) DeepCopyInto(out *VirtualMachin
 		*out = make([]VSphereClusterModuleStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]VirtualMachineSetResourcePolicyZoneStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicyStatus.
@@ -2270,6 +3111,26 @@ func (in *VirtualMachineSetResourcePolicyStatus) DeepCopy() *VirtualMachineSetRe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSetResourcePolicyZoneStatus) DeepCopyInto(out *VirtualMachineSetResourcePolicyZoneStatus) {
+	*out = *in
+	if in.ResourcePoolMoIDs != nil {
+		in, out := &in.ResourcePoolMoIDs, &out.ResourcePoolMoIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicyZoneStatus.
+func (in *VirtualMachineSetResourcePolicyZoneStatus) DeepCopy() *VirtualMachineSetResourcePolicyZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSetResourcePolicyZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	*out = *in
@@ -0,0 +1,100 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	klog "k8s.io/klog/v2"
+	"k8s.io/klog/v2/textlogger"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
+)
+
+// vm-export prints the VirtualMachineClass and VirtualMachine resources that
+// most closely match an existing vSphere VM, so the VM may be brought under
+// the management of VM Operator. The vSphere endpoint and credentials are read
+// from VM Operator's provider ConfigMap, so the POD_NAMESPACE environment
+// variable must be the namespace in which VM Operator is deployed.
+func main() {
+	klog.InitFlags(nil)
+	ctrllog.SetLogger(textlogger.NewLogger(textlogger.NewConfig()))
+	logger := ctrllog.Log.WithName("entrypoint")
+
+	moID := flag.String(
+		"vm",
+		"",
+		"The managed object ID of the vSphere VM to export, ex. vm-42.",
+	)
+	namespace := flag.String(
+		"namespace",
+		"",
+		"The namespace of the exported VirtualMachine and VirtualMachineClass.",
+	)
+
+	flag.Parse()
+
+	if *moID == "" || *namespace == "" {
+		fmt.Fprintln(os.Stderr, "the -vm and -namespace flags are required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		logger.Error(err, "Failed to get Kubernetes config")
+		os.Exit(1)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		logger.Error(err, "Failed to add client-go scheme")
+		os.Exit(1)
+	}
+	if err := vmopv1.AddToScheme(scheme); err != nil {
+		logger.Error(err, "Failed to add vm-operator v1alpha3 scheme")
+		os.Exit(1)
+	}
+
+	client, err := ctrlclient.New(restConfig, ctrlclient.Options{Scheme: scheme})
+	if err != nil {
+		logger.Error(err, "Failed to initialize controller-runtime client")
+		os.Exit(1)
+	}
+
+	ctx := pkgcfg.WithConfig(pkgcfg.FromEnv())
+	ctx = ovfcache.WithContext(ctx)
+
+	provider := vsphere.NewVSphereVMProviderFromClient(ctx, client, nil)
+
+	vm, vmClass, err := provider.ExportVirtualMachine(ctx, *moID, *namespace)
+	if err != nil {
+		logger.Error(err, "Failed to export VM", "vm", *moID)
+		os.Exit(1)
+	}
+
+	for i, obj := range []any{vmClass, vm} {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			logger.Error(err, "Failed to marshal exported resource")
+			os.Exit(1)
+		}
+		if i > 0 {
+			fmt.Println("---")
+		}
+		fmt.Print(string(data))
+	}
+}
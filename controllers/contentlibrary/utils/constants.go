@@ -30,3 +30,26 @@ const (
 	// otherwise TKGServiceTypeLabelKeyPrefix is used.
 	MultipleCLServiceTypeLabelKeyPrefix = "services.supervisor.vmware.com/"
 )
+
+const (
+	// MaxOVFPropertiesStatusSize is the maximum size, in bytes, of an image's
+	// JSON encoded OVF properties and VMware system properties that are stored
+	// in the image's status. Larger properties are stored in a ConfigMap so
+	// the image does not exceed the maximum size of an object.
+	MaxOVFPropertiesStatusSize = 128 * 1024
+
+	// OVFPropertiesConfigMapKey is the key in an image's OVF properties
+	// ConfigMap that contains the JSON encoded OVF properties.
+	OVFPropertiesConfigMapKey = "ovfProperties"
+
+	// VMwareSystemPropertiesConfigMapKey is the key in an image's OVF
+	// properties ConfigMap that contains the JSON encoded VMware system
+	// properties.
+	VMwareSystemPropertiesConfigMapKey = "vmwareSystemProperties"
+
+	// OVFPropertyFilterHashAnnotationKey is the annotation on an image that
+	// contains the hash of the OVF property filter that was applied to the
+	// image's properties. The image content is synced again when the filter
+	// of the image's ContentSource no longer has this hash.
+	OVFPropertyFilterHashAnnotationKey = "vmoperator.vmware.com/ovf-property-filter-hash"
+)
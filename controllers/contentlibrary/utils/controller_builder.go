@@ -6,21 +6,26 @@ package utils
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
 
+	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
 	pkgcnd "github.com/vmware-tanzu/vm-operator/pkg/conditions"
@@ -33,7 +38,9 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	imgutil "github.com/vmware-tanzu/vm-operator/pkg/util/image"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
@@ -85,7 +92,20 @@ func AddToManager(
 			))
 	}
 
-	return builder.Complete(r)
+	// Sync the images again when the OVF property filter of their
+	// ContentSource changes.
+	builder = builder.Watches(
+		&vmopv1a1.ContentSource{},
+		handler.EnqueueRequestsFromMapFunc(
+			r.ContentSourceToItemMapper(controlledItemTypeName)),
+		ctrlbuilder.WithPredicates(predicate.GenerationChangedPredicate{}))
+
+	if _, ok := obj.(*imgregv1a1.ContentLibraryItem); ok {
+		builder = shard.WatchNamespaces(ctx, builder, mgr.GetClient(), &imgregv1a1.ContentLibraryItemList{})
+	}
+
+	return builder.Complete(shard.NewReconciler(ctx, mgr.GetClient(),
+		metrics.NewSLOReconciler(controllerNameShort, r)))
 }
 
 func NewReconciler(
@@ -353,6 +373,17 @@ func (r *Reconciler) ReconcileNormal(
 			vmiObj.GetName(),
 			vmiObj.GetNamespace(),
 			didSync && syncErr == nil)
+		if copErr == nil {
+			var libraryName string
+			if ref := cliStatus.ContentLibraryRef; ref != nil {
+				libraryName = ref.Name
+			}
+			r.Metrics.RegisterVMIProviderItemSize(logger,
+				vmiObj.GetName(),
+				vmiObj.GetNamespace(),
+				libraryName,
+				cliStatus.SizeInBytes.Value())
+		}
 	}()
 
 	if copErr != nil {
@@ -447,6 +478,11 @@ func (r *Reconciler) setUpVMIFromCLItem(
 	vmiStatus.Name = cliStatus.Name
 	vmiStatus.ProviderItemID = string(cliSpec.UUID)
 	vmiStatus.Type = string(cliStatus.Type)
+	if cliStatus.SizeInBytes.IsZero() {
+		vmiStatus.ProviderItemSize = nil
+	} else {
+		vmiStatus.ProviderItemSize = ptr.To(cliStatus.SizeInBytes.DeepCopy())
+	}
 
 	return AddContentLibraryRefToAnnotation(
 		vmiObj, cliStatus.ContentLibraryRef)
@@ -461,15 +497,30 @@ func (r *Reconciler) syncImageContent(
 	vmiObj client.Object,
 	vmiStatus *vmopv1.VirtualMachineImageStatus) error {
 
+	// The image content is also synced when the OVF property filter of the
+	// image's ContentSource changed since the content was last synced.
+	filter, err := r.getOVFPropertyFilter(ctx, cliObj, cliStatus)
+	filterHash := OVFPropertyFilterHash(filter)
+
 	latestVersion := cliStatus.ContentVersion
-	if vmiStatus.ProviderContentVersion == latestVersion {
+	if err == nil && vmiStatus.ProviderContentVersion == latestVersion &&
+		vmiObj.GetAnnotations()[OVFPropertyFilterHashAnnotationKey] == filterHash {
 		// Hack: populate Disks fields during version upgrade.
 		if len(vmiStatus.Disks) != 0 {
 			return nil
 		}
 	}
 
-	err := r.VMProvider.SyncVirtualMachineImage(ctx, cliObj, vmiObj)
+	if err == nil {
+		err = r.VMProvider.SyncVirtualMachineImage(ctx, cliObj, vmiObj)
+	}
+	if err == nil {
+		if filter != nil {
+			FilterOVFProperties(*filter, vmiStatus)
+		}
+		setOVFPropertyFilterHash(vmiObj, filterHash)
+		err = r.syncOVFPropertiesConfigMap(ctx, cliObj, vmiObj, vmiStatus)
+	}
 	if err != nil {
 		if !pkgerr.WatchVMICacheIfNotReady(err, cliObj) {
 			pkgcnd.MarkFalse(
@@ -497,6 +548,67 @@ func (r *Reconciler) syncImageContent(
 	return err
 }
 
+// syncOVFPropertiesConfigMap moves the image's OVF properties and VMware
+// system properties from the image's status to a ConfigMap when they exceed
+// MaxOVFPropertiesStatusSize. The ConfigMap is deleted once the properties
+// fit in the status again.
+func (r *Reconciler) syncOVFPropertiesConfigMap(
+	ctx context.Context,
+	cliObj client.Object,
+	vmiObj client.Object,
+	vmiStatus *vmopv1.VirtualMachineImageStatus) error {
+
+	ovfProps, err := json.Marshal(vmiStatus.OVFProperties)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ovf properties: %w", err)
+	}
+	sysProps, err := json.Marshal(vmiStatus.VMwareSystemProperties)
+	if err != nil {
+		return fmt.Errorf("failed to marshal vmware system properties: %w", err)
+	}
+
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cliObj.GetNamespace(),
+			Name:      vmiObj.GetName(),
+		},
+	}
+	if cm.Namespace == "" {
+		cm.Namespace = pkgcfg.FromContext(ctx).PodNamespace
+	}
+
+	if len(ovfProps)+len(sysProps) <= MaxOVFPropertiesStatusSize {
+		if vmiStatus.OVFPropertiesConfigMapName != "" {
+			if err := r.Delete(ctx, &cm); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed to delete ovf properties configmap: %w", err)
+			}
+			vmiStatus.OVFPropertiesConfigMapName = ""
+		}
+		return nil
+	}
+
+	if _, err := controllerutil.CreateOrPatch(
+		ctx,
+		r.Client,
+		&cm,
+		func() error {
+			cm.Data = map[string]string{
+				OVFPropertiesConfigMapKey:          string(ovfProps),
+				VMwareSystemPropertiesConfigMapKey: string(sysProps),
+			}
+			return controllerutil.SetOwnerReference(cliObj, &cm, r.Scheme())
+		}); err != nil {
+
+		return fmt.Errorf("failed to createOrPatch ovf properties configmap: %w", err)
+	}
+
+	vmiStatus.OVFProperties = nil
+	vmiStatus.VMwareSystemProperties = nil
+	vmiStatus.OVFPropertiesConfigMapName = cm.Name
+
+	return nil
+}
+
 // GetAppropriateFinalizers returns the finalizers for this type of object.
 func GetAppropriateFinalizers(obj client.Object) (string, string) {
 	if obj.GetNamespace() != "" {
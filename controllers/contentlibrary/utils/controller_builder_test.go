@@ -8,17 +8,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
 
 	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
 	"github.com/vmware-tanzu/vm-operator/controllers/contentlibrary/utils"
@@ -432,6 +435,241 @@ var _ = Describe("Reconcile",
 							Expect(vmiStatus.Firmware).To(Equal(firmwareValue))
 						})
 					})
+
+					When("Image content source has an OVF property filter", func() {
+
+						BeforeEach(func() {
+							fakeVMProvider.SyncVirtualMachineImageFn = func(_ context.Context, _, vmiObj client.Object) error {
+								vmi := vmiObj.(*vmopv1.VirtualMachineImage)
+								vmi.Status.OVFProperties = []vmopv1.OVFProperty{
+									{Key: "guestinfo.hostname", Type: "string"},
+									{Key: "guestinfo.password", Type: "password"},
+									{Key: "appliance.knob", Type: "string"},
+								}
+								return nil
+							}
+						})
+
+						JustBeforeEach(func() {
+							Expect(ctx.Client.Create(ctx, &imgregv1a1.ContentLibrary{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: req.Namespace,
+									Name:      cliStatus.ContentLibraryRef.Name,
+								},
+								Spec: imgregv1a1.ContentLibrarySpec{
+									UUID: "dummy-cl-uuid",
+								},
+							})).To(Succeed())
+							Expect(ctx.Client.Create(ctx, &vmopv1a1.ContentLibraryProvider{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "dummy-clp",
+								},
+								Spec: vmopv1a1.ContentLibraryProviderSpec{
+									UUID: "dummy-cl-uuid",
+								},
+							})).To(Succeed())
+							Expect(ctx.Client.Create(ctx, &vmopv1a1.ContentSource{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "dummy-cs",
+								},
+								Spec: vmopv1a1.ContentSourceSpec{
+									ProviderRef: vmopv1a1.ContentProviderReference{
+										Kind: utils.ContentLibraryProviderKind,
+										Name: "dummy-clp",
+									},
+									OVFPropertyFilter: &vmopv1a1.OVFPropertyFilter{
+										Allow: []string{"guestinfo.*"},
+										Deny:  []string{"guestinfo.password"},
+									},
+								},
+							})).To(Succeed())
+						})
+
+						It("should only surface the allowed properties", func() {
+							_, err := reconciler.Reconcile(context.Background(), req)
+							Expect(err).ToNot(HaveOccurred())
+
+							vmi, _, vmiStatus := getVMI(ctx, req.Namespace, vmiName)
+							Expect(vmiStatus.OVFProperties).To(Equal([]vmopv1.OVFProperty{
+								{Key: "guestinfo.hostname", Type: "string"},
+							}))
+							Expect(vmi.GetAnnotations()).To(HaveKey(utils.OVFPropertyFilterHashAnnotationKey))
+						})
+
+						It("should sync the image again when the filter changes", func() {
+							var syncs int
+							syncFn := fakeVMProvider.SyncVirtualMachineImageFn
+							fakeVMProvider.SyncVirtualMachineImageFn = func(ctx context.Context, cliObj, vmiObj client.Object) error {
+								syncs++
+								vmiObj.(*vmopv1.VirtualMachineImage).Status.Disks = make([]vmopv1.VirtualMachineImageDiskInfo, 1)
+								return syncFn(ctx, cliObj, vmiObj)
+							}
+
+							_, err := reconciler.Reconcile(context.Background(), req)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(syncs).To(Equal(1))
+
+							By("not syncing the image when the filter is unchanged", func() {
+								_, err := reconciler.Reconcile(context.Background(), req)
+								Expect(err).ToNot(HaveOccurred())
+								Expect(syncs).To(Equal(1))
+							})
+
+							cs := &vmopv1a1.ContentSource{}
+							Expect(ctx.Client.Get(ctx, client.ObjectKey{Name: "dummy-cs"}, cs)).To(Succeed())
+							cs.Spec.OVFPropertyFilter.Allow = []string{"appliance.*"}
+							Expect(ctx.Client.Update(ctx, cs)).To(Succeed())
+
+							By("enqueueing the library item for the content source", func() {
+								requests := reconciler.ContentSourceToItemMapper(utils.ContentLibraryItemKind)(ctx, cs)
+								Expect(requests).To(ConsistOf(ctrl.Request{NamespacedName: req.NamespacedName}))
+							})
+
+							_, err = reconciler.Reconcile(context.Background(), req)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(syncs).To(Equal(2))
+
+							_, _, vmiStatus := getVMI(ctx, req.Namespace, vmiName)
+							Expect(vmiStatus.OVFProperties).To(Equal([]vmopv1.OVFProperty{
+								{Key: "appliance.knob", Type: "string"},
+							}))
+						})
+					})
+
+					When("Image content source is an HTTP provider with an OVF property filter", func() {
+
+						BeforeEach(func() {
+							fakeVMProvider.SyncVirtualMachineImageFn = func(_ context.Context, _, vmiObj client.Object) error {
+								vmi := vmiObj.(*vmopv1.VirtualMachineImage)
+								vmi.Status.OVFProperties = []vmopv1.OVFProperty{
+									{Key: "guestinfo.hostname", Type: "string"},
+									{Key: "appliance.knob", Type: "string"},
+								}
+								return nil
+							}
+						})
+
+						JustBeforeEach(func() {
+							Expect(ctx.Client.Create(ctx, &imgregv1a1.ContentLibrary{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: req.Namespace,
+									Name:      cliStatus.ContentLibraryRef.Name,
+								},
+								Spec: imgregv1a1.ContentLibrarySpec{
+									UUID: "dummy-cl-uuid",
+								},
+							})).To(Succeed())
+							Expect(ctx.Client.Create(ctx, &vmopv1a1.HTTPContentProvider{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "dummy-hcp",
+								},
+								Spec: vmopv1a1.HTTPContentProviderSpec{
+									URL:         "https://images.example.com/index.json",
+									LibraryUUID: "dummy-cl-uuid",
+								},
+							})).To(Succeed())
+							Expect(ctx.Client.Create(ctx, &vmopv1a1.ContentSource{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "dummy-cs",
+								},
+								Spec: vmopv1a1.ContentSourceSpec{
+									ProviderRef: vmopv1a1.ContentProviderReference{
+										Kind: utils.HTTPContentProviderKind,
+										Name: "dummy-hcp",
+									},
+									OVFPropertyFilter: &vmopv1a1.OVFPropertyFilter{
+										Allow: []string{"guestinfo.*"},
+									},
+								},
+							})).To(Succeed())
+						})
+
+						It("should only surface the allowed properties", func() {
+							_, err := reconciler.Reconcile(context.Background(), req)
+							Expect(err).ToNot(HaveOccurred())
+
+							_, _, vmiStatus := getVMI(ctx, req.Namespace, vmiName)
+							Expect(vmiStatus.OVFProperties).To(Equal([]vmopv1.OVFProperty{
+								{Key: "guestinfo.hostname", Type: "string"},
+							}))
+						})
+					})
+
+					When("Image OVF properties are too large for the status", func() {
+
+						BeforeEach(func() {
+							fakeVMProvider.SyncVirtualMachineImageFn = func(_ context.Context, _, vmiObj client.Object) error {
+								vmi := vmiObj.(*vmopv1.VirtualMachineImage)
+								vmi.Status.Firmware = firmwareValue
+								vmi.Status.OVFProperties = []vmopv1.OVFProperty{
+									{
+										Key:     "large",
+										Type:    "string",
+										Default: ptr.To(strings.Repeat("x", utils.MaxOVFPropertiesStatusSize)),
+									},
+								}
+								vmi.Status.VMwareSystemProperties = []common.KeyValuePair{
+									{
+										Key:   "vmware-system-key",
+										Value: "vmware-system-value",
+									},
+								}
+								return nil
+							}
+						})
+
+						It("should move the properties to a configmap", func() {
+							_, err := reconciler.Reconcile(context.Background(), req)
+							Expect(err).ToNot(HaveOccurred())
+
+							_, _, vmiStatus := getVMI(ctx, req.Namespace, vmiName)
+							Expect(vmiStatus.Firmware).To(Equal(firmwareValue))
+							Expect(vmiStatus.OVFProperties).To(BeEmpty())
+							Expect(vmiStatus.VMwareSystemProperties).To(BeEmpty())
+							Expect(vmiStatus.OVFPropertiesConfigMapName).To(Equal(vmiName))
+
+							var cm corev1.ConfigMap
+							Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: req.Namespace, Name: vmiName}, &cm)).To(Succeed())
+							Expect(cm.OwnerReferences).To(HaveLen(1))
+							Expect(cm.OwnerReferences[0].Name).To(Equal(req.Name))
+							Expect(cm.Data).To(HaveKey(utils.OVFPropertiesConfigMapKey))
+							Expect(cm.Data[utils.OVFPropertiesConfigMapKey]).To(ContainSubstring(`"key":"large"`))
+							Expect(cm.Data).To(HaveKeyWithValue(
+								utils.VMwareSystemPropertiesConfigMapKey,
+								`[{"key":"vmware-system-key","value":"vmware-system-value"}]`))
+						})
+
+						When("the properties fit in the status again", func() {
+
+							It("should move the properties back to the status and delete the configmap", func() {
+								_, err := reconciler.Reconcile(context.Background(), req)
+								Expect(err).ToNot(HaveOccurred())
+
+								fakeVMProvider.SyncVirtualMachineImageFn = func(_ context.Context, _, vmiObj client.Object) error {
+									vmi := vmiObj.(*vmopv1.VirtualMachineImage)
+									vmi.Status.OVFProperties = []vmopv1.OVFProperty{
+										{
+											Key:  "small",
+											Type: "string",
+										},
+									}
+									vmi.Status.VMwareSystemProperties = nil
+									return nil
+								}
+
+								_, err = reconciler.Reconcile(context.Background(), req)
+								Expect(err).ToNot(HaveOccurred())
+
+								_, _, vmiStatus := getVMI(ctx, req.Namespace, vmiName)
+								Expect(vmiStatus.OVFProperties).To(HaveLen(1))
+								Expect(vmiStatus.OVFPropertiesConfigMapName).To(BeEmpty())
+
+								var cm corev1.ConfigMap
+								err = ctx.Client.Get(ctx, client.ObjectKey{Namespace: req.Namespace, Name: vmiName}, &cm)
+								Expect(apierrors.IsNotFound(err)).To(BeTrue())
+							})
+						})
+					})
 				})
 
 				When("Image resource is created and already up-to-date and Status.Disk is not empty", func() {
@@ -799,6 +1037,8 @@ func assertVMImageFromCLItem(
 		Expect(vmiStatus.ProviderItemID).To(BeEquivalentTo(cliSpec.UUID))
 		Expect(vmiStatus.ProviderContentVersion).To(Equal(cliStatus.ContentVersion))
 		Expect(vmiStatus.Type).To(BeEquivalentTo(cliStatus.Type))
+		Expect(vmiStatus.ProviderItemSize).ToNot(BeNil())
+		Expect(vmiStatus.ProviderItemSize.Equal(cliStatus.SizeInBytes)).To(BeTrue())
 		Expect(pkgcnd.IsTrue(vmiStatus, vmopv1.ReadyConditionType)).To(BeTrue())
 	})
 }
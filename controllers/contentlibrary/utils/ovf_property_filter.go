@@ -0,0 +1,282 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+
+	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
+)
+
+const (
+	// ContentLibraryProviderKind is the kind of the provider referenced by a
+	// ContentSource whose content is a vSphere content library.
+	ContentLibraryProviderKind = "ContentLibraryProvider"
+
+	// HTTPContentProviderKind is the kind of the provider referenced by a
+	// ContentSource whose content is served over HTTP and imported into a
+	// vSphere content library.
+	HTTPContentProviderKind = "HTTPContentProvider"
+)
+
+// FilterOVFProperties removes the OVF properties and VMware system properties
+// from the image status whose keys are not allowed by the filter.
+func FilterOVFProperties(
+	filter vmopv1a1.OVFPropertyFilter,
+	vmiStatus *vmopv1.VirtualMachineImageStatus) {
+
+	if len(filter.Allow) == 0 && len(filter.Deny) == 0 {
+		return
+	}
+
+	var ovfProps []vmopv1.OVFProperty
+	for _, p := range vmiStatus.OVFProperties {
+		if IsOVFPropertyAllowed(filter, p.Key) {
+			ovfProps = append(ovfProps, p)
+		}
+	}
+	vmiStatus.OVFProperties = ovfProps
+
+	var sysProps []common.KeyValuePair
+	for _, p := range vmiStatus.VMwareSystemProperties {
+		if IsOVFPropertyAllowed(filter, p.Key) {
+			sysProps = append(sysProps, p)
+		}
+	}
+	vmiStatus.VMwareSystemProperties = sysProps
+}
+
+// IsOVFPropertyAllowed returns true if the OVF property key is allowed by the
+// filter. A key that is denied is never allowed.
+func IsOVFPropertyAllowed(filter vmopv1a1.OVFPropertyFilter, key string) bool {
+	if matchesOVFPropertyKey(filter.Deny, key) {
+		return false
+	}
+	return len(filter.Allow) == 0 || matchesOVFPropertyKey(filter.Allow, key)
+}
+
+// matchesOVFPropertyKey returns true if the key is equal to one of the
+// patterns, or has the prefix of a pattern that ends with "*".
+func matchesOVFPropertyKey(patterns []string, key string) bool {
+	for _, p := range patterns {
+		if prefix, ok := strings.CutSuffix(p, "*"); ok {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		} else if p == key {
+			return true
+		}
+	}
+	return false
+}
+
+// getOVFPropertyFilter returns the OVF property filter of the ContentSource
+// whose provider is the library that contains the library item. Nil is
+// returned if there is no such ContentSource or it does not have a filter.
+func (r *Reconciler) getOVFPropertyFilter(
+	ctx context.Context,
+	cliObj client.Object,
+	cliStatus imgregv1a1.ContentLibraryItemStatus) (*vmopv1a1.OVFPropertyFilter, error) {
+
+	ref := cliStatus.ContentLibraryRef
+	if ref == nil {
+		return nil, nil
+	}
+
+	var libraryUUID types.UID
+	if ref.Kind == ClusterContentLibraryKind {
+		var cl imgregv1a1.ClusterContentLibrary
+		if err := r.Get(ctx, client.ObjectKey{Name: ref.Name}, &cl); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		libraryUUID = cl.Spec.UUID
+	} else {
+		var cl imgregv1a1.ContentLibrary
+		key := client.ObjectKey{Namespace: cliObj.GetNamespace(), Name: ref.Name}
+		if err := r.Get(ctx, key, &cl); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		libraryUUID = cl.Spec.UUID
+	}
+
+	var contentSources vmopv1a1.ContentSourceList
+	if err := r.List(ctx, &contentSources); err != nil {
+		return nil, fmt.Errorf("failed to list content sources: %w", err)
+	}
+
+	for _, cs := range contentSources.Items {
+		if cs.Spec.OVFPropertyFilter == nil {
+			continue
+		}
+
+		providerLibraryUUID, err := r.getProviderLibraryUUID(ctx, cs.Spec.ProviderRef)
+		if err != nil {
+			return nil, err
+		}
+
+		if providerLibraryUUID != "" && providerLibraryUUID == string(libraryUUID) {
+			return cs.Spec.OVFPropertyFilter, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getProviderLibraryUUID returns the UUID of the library that contains the
+// content of the ContentSource's provider. An empty string is returned if the
+// provider does not exist or is not a supported kind.
+func (r *Reconciler) getProviderLibraryUUID(
+	ctx context.Context,
+	ref vmopv1a1.ContentProviderReference) (string, error) {
+
+	key := client.ObjectKey{Name: ref.Name}
+
+	switch ref.Kind {
+	case ContentLibraryProviderKind:
+		var clProvider vmopv1a1.ContentLibraryProvider
+		if err := r.Get(ctx, key, &clProvider); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				return "", nil
+			}
+			return "", fmt.Errorf("failed to get content library provider: %w", err)
+		}
+		return clProvider.Spec.UUID, nil
+
+	case HTTPContentProviderKind:
+		var httpProvider vmopv1a1.HTTPContentProvider
+		if err := r.Get(ctx, key, &httpProvider); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				return "", nil
+			}
+			return "", fmt.Errorf("failed to get http content provider: %w", err)
+		}
+		return httpProvider.Spec.LibraryUUID, nil
+	}
+
+	return "", nil
+}
+
+// OVFPropertyFilterHash returns the hash of the OVF property filter. An empty
+// string is returned if the filter is nil or does not filter any properties.
+func OVFPropertyFilterHash(filter *vmopv1a1.OVFPropertyFilter) string {
+	if filter == nil || (len(filter.Allow) == 0 && len(filter.Deny) == 0) {
+		return ""
+	}
+	data, _ := json.Marshal(filter)
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+func setOVFPropertyFilterHash(vmiObj client.Object, hash string) {
+	annotations := vmiObj.GetAnnotations()
+	if hash == "" {
+		delete(annotations, OVFPropertyFilterHashAnnotationKey)
+	} else {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[OVFPropertyFilterHashAnnotationKey] = hash
+	}
+	vmiObj.SetAnnotations(annotations)
+}
+
+// ContentSourceToItemMapper returns a mapper function that enqueues the
+// library items of the given kind that are in the library of a ContentSource's
+// provider, so the images of the items are synced again when the
+// ContentSource's OVF property filter changes.
+func (r *Reconciler) ContentSourceToItemMapper(kind string) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		cs, ok := o.(*vmopv1a1.ContentSource)
+		if !ok {
+			return nil
+		}
+
+		logger := r.Logger.WithValues("contentSource", cs.Name)
+
+		libraryUUID, err := r.getProviderLibraryUUID(ctx, cs.Spec.ProviderRef)
+		if err != nil {
+			logger.Error(err, "Failed to get the library of the content source provider")
+			return nil
+		}
+		if libraryUUID == "" {
+			return nil
+		}
+
+		var requests []reconcile.Request
+
+		switch kind {
+		case ClusterContentLibraryItemKind:
+			var libraries imgregv1a1.ClusterContentLibraryList
+			if err := r.List(ctx, &libraries); err != nil {
+				logger.Error(err, "Failed to list cluster content libraries")
+				return nil
+			}
+			libraryNames := map[string]struct{}{}
+			for _, cl := range libraries.Items {
+				if string(cl.Spec.UUID) == libraryUUID {
+					libraryNames[cl.Name] = struct{}{}
+				}
+			}
+			if len(libraryNames) == 0 {
+				return nil
+			}
+
+			var items imgregv1a1.ClusterContentLibraryItemList
+			if err := r.List(ctx, &items); err != nil {
+				logger.Error(err, "Failed to list cluster content library items")
+				return nil
+			}
+			for _, item := range items.Items {
+				if ref := item.Status.ContentLibraryRef; ref != nil {
+					if _, ok := libraryNames[ref.Name]; ok {
+						requests = append(requests, reconcile.Request{
+							NamespacedName: client.ObjectKeyFromObject(&item),
+						})
+					}
+				}
+			}
+
+		case ContentLibraryItemKind:
+			var libraries imgregv1a1.ContentLibraryList
+			if err := r.List(ctx, &libraries); err != nil {
+				logger.Error(err, "Failed to list content libraries")
+				return nil
+			}
+			for _, cl := range libraries.Items {
+				if string(cl.Spec.UUID) != libraryUUID {
+					continue
+				}
+
+				var items imgregv1a1.ContentLibraryItemList
+				if err := r.List(ctx, &items, client.InNamespace(cl.Namespace)); err != nil {
+					logger.Error(err, "Failed to list content library items",
+						"namespace", cl.Namespace)
+					continue
+				}
+				for _, item := range items.Items {
+					if ref := item.Status.ContentLibraryRef; ref != nil && ref.Name == cl.Name {
+						requests = append(requests, reconcile.Request{
+							NamespacedName: client.ObjectKeyFromObject(&item),
+						})
+					}
+				}
+			}
+		}
+
+		return requests
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
@@ -36,6 +37,7 @@ func DummyClusterContentLibraryItem(name string) *imgregv1a1.ClusterContentLibra
 			Type:           imgregv1a1.ContentLibraryItemTypeOvf,
 			Name:           "dummy-image-name",
 			ContentVersion: "dummy-content-version",
+			SizeInBytes:    resource.MustParse("1Gi"),
 			ContentLibraryRef: &imgregv1a1.NameAndKindRef{
 				Kind: ClusterContentLibraryKind,
 				Name: "dummy-ccl-name",
@@ -71,6 +73,7 @@ func DummyContentLibraryItem(name, namespace string) *imgregv1a1.ContentLibraryI
 			Type:           imgregv1a1.ContentLibraryItemTypeOvf,
 			Name:           "dummy-image-name",
 			ContentVersion: "dummy-content-version",
+			SizeInBytes:    resource.MustParse("1Gi"),
 			ContentLibraryRef: &imgregv1a1.NameAndKindRef{
 				Kind: ContentLibraryKind,
 				Name: "cl-dummy",
@@ -15,7 +15,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
 	"github.com/vmware-tanzu/vm-operator/controllers/contentlibrary/utils"
 )
 
@@ -144,3 +146,63 @@ func assertAnnotation(obj client.Object) {
 	ExpectWithOffset(1, coreRef.Kind).To(Equal("FooKind"))
 	ExpectWithOffset(1, coreRef.Name).To(Equal("foo"))
 }
+
+var _ = DescribeTable("IsOVFPropertyAllowed",
+	func(filter vmopv1a1.OVFPropertyFilter, key string, expected bool) {
+		Expect(utils.IsOVFPropertyAllowed(filter, key)).To(Equal(expected))
+	},
+	Entry("empty filter", vmopv1a1.OVFPropertyFilter{}, "foo", true),
+	Entry("exact allow match", vmopv1a1.OVFPropertyFilter{Allow: []string{"foo"}}, "foo", true),
+	Entry("exact allow mismatch", vmopv1a1.OVFPropertyFilter{Allow: []string{"foo"}}, "foobar", false),
+	Entry("prefix allow match", vmopv1a1.OVFPropertyFilter{Allow: []string{"foo*"}}, "foobar", true),
+	Entry("prefix allow mismatch", vmopv1a1.OVFPropertyFilter{Allow: []string{"foo*"}}, "barfoo", false),
+	Entry("exact deny match", vmopv1a1.OVFPropertyFilter{Deny: []string{"foo"}}, "foo", false),
+	Entry("prefix deny match", vmopv1a1.OVFPropertyFilter{Deny: []string{"foo.*"}}, "foo.password", false),
+	Entry("deny mismatch", vmopv1a1.OVFPropertyFilter{Deny: []string{"foo.*"}}, "bar", true),
+	Entry("deny wins over allow", vmopv1a1.OVFPropertyFilter{Allow: []string{"foo*"}, Deny: []string{"foo.password"}}, "foo.password", false),
+)
+
+var _ = Describe("FilterOVFProperties", func() {
+	It("should filter the OVF and VMware system properties", func() {
+		status := vmopv1.VirtualMachineImageStatus{
+			OVFProperties: []vmopv1.OVFProperty{
+				{Key: "guestinfo.hostname"},
+				{Key: "guestinfo.password"},
+				{Key: "appliance.knob"},
+			},
+			VMwareSystemProperties: []common.KeyValuePair{
+				{Key: "vmware-system.guest.kind"},
+				{Key: "vmware-system.secret"},
+			},
+		}
+
+		utils.FilterOVFProperties(vmopv1a1.OVFPropertyFilter{
+			Allow: []string{"guestinfo.*", "vmware-system.*"},
+			Deny:  []string{"guestinfo.password", "vmware-system.secret"},
+		}, &status)
+
+		Expect(status.OVFProperties).To(Equal([]vmopv1.OVFProperty{
+			{Key: "guestinfo.hostname"},
+		}))
+		Expect(status.VMwareSystemProperties).To(Equal([]common.KeyValuePair{
+			{Key: "vmware-system.guest.kind"},
+		}))
+	})
+})
+
+var _ = Describe("OVFPropertyFilterHash", func() {
+	It("returns an empty hash for a filter that does not filter anything", func() {
+		Expect(utils.OVFPropertyFilterHash(nil)).To(BeEmpty())
+		Expect(utils.OVFPropertyFilterHash(&vmopv1a1.OVFPropertyFilter{})).To(BeEmpty())
+	})
+
+	It("returns a different hash when the filter changes", func() {
+		filter := &vmopv1a1.OVFPropertyFilter{Allow: []string{"guestinfo.*"}}
+		hash := utils.OVFPropertyFilterHash(filter)
+		Expect(hash).ToNot(BeEmpty())
+		Expect(utils.OVFPropertyFilterHash(filter.DeepCopy())).To(Equal(hash))
+
+		filter.Deny = []string{"guestinfo.password"}
+		Expect(utils.OVFPropertyFilterHash(filter)).ToNot(Equal(hash))
+	})
+})
@@ -0,0 +1,249 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package contentsource
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	apierrorsutil "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"github.com/vmware-tanzu/vm-operator/controllers/contentlibrary/utils"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinewebconsolerequest/v1alpha1/conditions"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinewebconsolerequest/v1alpha1/patch"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+// resyncPeriod is how often the provider of a ContentSource is checked for
+// new images.
+const resyncPeriod = 10 * time.Minute
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1a1.ContentSource{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+	)
+
+	// Index the ContentSources by their provider so the ContentSources that
+	// reference a provider may be found when the provider changes.
+	if err := vmopv1util.IndexContentSourceProvider(ctx, mgr.GetFieldIndexer()); err != nil {
+		return fmt.Errorf("failed to index ContentSource providers: %w", err)
+	}
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		ctx.VMProvider,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		WithOptions(controller.Options{MaxConcurrentReconciles: ctx.MaxConcurrentReconciles}).
+		Watches(&vmopv1a1.HTTPContentProvider{},
+			handler.EnqueueRequestsFromMapFunc(r.ProviderToContentSources(utils.HTTPContentProviderKind))).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(), r))
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client ctrlclient.Client,
+	logger logr.Logger,
+	vmProvider providers.VirtualMachineProviderInterface) *Reconciler {
+
+	return &Reconciler{
+		Context:    ctx,
+		Client:     client,
+		Logger:     logger,
+		VMProvider: vmProvider,
+		contentProviders: map[string]ContentProvider{
+			utils.ContentLibraryProviderKind: contentLibraryProvider{},
+			utils.HTTPContentProviderKind:    newHTTPProvider(client, vmProvider),
+		},
+	}
+}
+
+// Reconciler reconciles a ContentSource object.
+type Reconciler struct {
+	ctrlclient.Client
+	Context    context.Context
+	Logger     logr.Logger
+	VMProvider providers.VirtualMachineProviderInterface
+
+	// contentProviders are the content providers by the kind of the provider
+	// referenced by a ContentSource.
+	contentProviders map[string]ContentProvider
+}
+
+// ProviderToContentSources returns a mapper function that enqueues the
+// ContentSources that reference a provider of the specified kind. The client
+// must index the ContentSources by vmopv1util.ContentSourceProviderField.
+func (r *Reconciler) ProviderToContentSources(kind string) handler.MapFunc {
+	return func(ctx context.Context, o ctrlclient.Object) []reconcile.Request {
+		var list vmopv1a1.ContentSourceList
+		if err := r.List(ctx, &list, ctrlclient.MatchingFields{
+			vmopv1util.ContentSourceProviderField: vmopv1util.ContentSourceProviderIndexValue(kind, o.GetName()),
+		}); err != nil {
+			r.Logger.Error(err, "Failed to list ContentSources for reconciliation due to provider watch",
+				"providerKind", kind, "providerName", o.GetName())
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(list.Items))
+		for _, cs := range list.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: cs.Name},
+			})
+		}
+		return requests
+	}
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=contentsources,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=contentsources/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=contentlibraryproviders,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=httpcontentproviders,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	var cs vmopv1a1.ContentSource
+	if err := r.Get(ctx, req.NamespacedName, &cs); err != nil {
+		return ctrl.Result{}, ctrlclient.IgnoreNotFound(err)
+	}
+
+	if !cs.DeletionTimestamp.IsZero() {
+		// Noop. The images already imported from the provider are kept.
+		return ctrl.Result{}, nil
+	}
+
+	logger := r.Logger.WithValues(
+		"name", req.Name,
+		"providerKind", cs.Spec.ProviderRef.Kind,
+		"providerName", cs.Spec.ProviderRef.Name)
+	ctx = logr.NewContext(ctx, logger)
+
+	patchHelper, err := patch.NewHelper(&cs, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for ContentSource %s: %w", cs.Name, err)
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, &cs); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			logger.Error(err, "patch failed")
+		}
+	}()
+
+	if err := r.ReconcileNormal(ctx, &cs); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: resyncPeriod}, nil
+}
+
+// ReconcileNormal imports the images of the ContentSource's provider. An image
+// already imported is skipped unless its version has changed. The imported
+// images and any errors are reported in the ContentSource's status.
+func (r *Reconciler) ReconcileNormal(ctx context.Context, cs *vmopv1a1.ContentSource) error {
+	images, err := r.GetImagesFromContentProvider(ctx, cs)
+	if err != nil {
+		conditions.MarkFalse(cs, vmopv1a1.ReadyCondition,
+			vmopv1a1.ContentSourceGetImagesFailedReason, vmopv1a1.ConditionSeverityError, "%v", err)
+		return err
+	}
+
+	cp := r.contentProviders[cs.Spec.ProviderRef.Kind]
+
+	imported := make(map[string]string, len(cs.Status.Images))
+	for _, image := range cs.Status.Images {
+		imported[image.Name] = image.Version
+	}
+
+	var (
+		errs     []error
+		statuses = make([]vmopv1a1.ContentSourceImageStatus, 0, len(images))
+	)
+	for _, image := range images {
+		if version, ok := imported[image.Name]; ok && (image.Version == "" || image.Version == version) {
+			statuses = append(statuses, vmopv1a1.ContentSourceImageStatus{
+				Name:    image.Name,
+				Version: version,
+			})
+			continue
+		}
+
+		if err := cp.ImportImage(ctx, image); err != nil {
+			errs = append(errs, fmt.Errorf("failed to import image %s: %w", image.Name, err))
+			if version, ok := imported[image.Name]; ok {
+				// Keep the previously imported version so the image is
+				// imported again when the error is resolved.
+				statuses = append(statuses, vmopv1a1.ContentSourceImageStatus{
+					Name:    image.Name,
+					Version: version,
+				})
+			}
+			continue
+		}
+
+		statuses = append(statuses, vmopv1a1.ContentSourceImageStatus{
+			Name:    image.Name,
+			Version: image.Version,
+		})
+	}
+
+	// Images no longer listed by the provider are removed from the status.
+	cs.Status.Images = statuses
+
+	if err := apierrorsutil.NewAggregate(errs); err != nil {
+		conditions.MarkFalse(cs, vmopv1a1.ReadyCondition,
+			vmopv1a1.ContentSourceImportFailedReason, vmopv1a1.ConditionSeverityError, "%v", err)
+		return err
+	}
+
+	conditions.MarkTrue(cs, vmopv1a1.ReadyCondition)
+
+	return nil
+}
+
+// GetImagesFromContentProvider returns the images of the ContentSource's
+// provider. The images are listed by the content provider for the kind of the
+// provider. Nil is returned if the kind is not supported.
+func (r *Reconciler) GetImagesFromContentProvider(
+	ctx context.Context,
+	cs *vmopv1a1.ContentSource) ([]Image, error) {
+
+	ref := cs.Spec.ProviderRef
+
+	cp, ok := r.contentProviders[ref.Kind]
+	if !ok {
+		logr.FromContextOrDiscard(ctx).Info("Skipping ContentSource with unsupported provider kind")
+		return nil, nil
+	}
+
+	images, err := cp.GetImages(ctx, ref.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get images from %s %s: %w", ref.Kind, ref.Name, err)
+	}
+
+	return images, nil
+}
@@ -0,0 +1,29 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package contentsource_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+
+	"github.com/vmware-tanzu/vm-operator/controllers/contentsource"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/manager"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var suite = builder.NewTestSuiteForControllerWithContext(
+	pkgcfg.NewContextWithDefaultConfig(),
+	contentsource.AddToManager,
+	manager.InitializeProvidersNoopFn)
+
+func TestContentSourceController(t *testing.T) {
+	suite.Register(t, "ContentSource controller suite", nil, unitTests)
+}
+
+var _ = BeforeSuite(suite.BeforeSuite)
+
+var _ = AfterSuite(suite.AfterSuite)
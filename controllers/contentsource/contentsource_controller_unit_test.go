@@ -0,0 +1,374 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package contentsource_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"github.com/vmware-tanzu/vm-operator/controllers/contentlibrary/utils"
+	"github.com/vmware-tanzu/vm-operator/controllers/contentsource"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinewebconsolerequest/v1alpha1/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+		),
+		unitTestsReconcile,
+	)
+	Describe(
+		"ProviderToContentSources",
+		Label(
+			testlabels.Controller,
+		),
+		unitTestsProviderToContentSources,
+	)
+}
+
+type importedItem struct {
+	contentLibrary, itemName, sourceURL, sha256 string
+}
+
+func unitTestsReconcile() {
+	const (
+		contentSourceName = "my-content-source"
+		providerName      = "my-provider"
+		libraryUUID       = "my-library-uuid"
+	)
+
+	var (
+		ctx            *builder.UnitTestContextForController
+		withObjects    []client.Object
+		reconciler     *contentsource.Reconciler
+		fakeVMProvider *providerfake.VMProvider
+		contentSource  *vmopv1a1.ContentSource
+		server         *httptest.Server
+		index          string
+		etag           string
+		imported       []importedItem
+		importErr      error
+		result         reconcile.Result
+		err            error
+	)
+
+	BeforeEach(func() {
+		index = `{"items":[]}`
+		etag = `"v1"`
+		imported = nil
+		importErr = nil
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/images/index.json":
+				_, _ = w.Write([]byte(index))
+			case "/images/image-4.ova":
+				w.Header().Set("ETag", etag)
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+
+		contentSource = &vmopv1a1.ContentSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: contentSourceName,
+			},
+			Spec: vmopv1a1.ContentSourceSpec{
+				ProviderRef: vmopv1a1.ContentProviderReference{
+					Kind: utils.HTTPContentProviderKind,
+					Name: providerName,
+				},
+			},
+		}
+
+		withObjects = []client.Object{
+			contentSource,
+			&vmopv1a1.HTTPContentProvider{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: providerName,
+				},
+				Spec: vmopv1a1.HTTPContentProviderSpec{
+					URL:         server.URL + "/images/index.json",
+					LibraryUUID: libraryUUID,
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(withObjects...)
+		fakeVMProvider = ctx.VMProvider.(*providerfake.VMProvider)
+		fakeVMProvider.Reset()
+		fakeVMProvider.ImportContentLibraryItemFn = func(
+			_ context.Context, contentLibrary, itemName, sourceURL, sha256 string) error {
+
+			imported = append(imported, importedItem{contentLibrary, itemName, sourceURL, sha256})
+			return importErr
+		}
+
+		reconciler = contentsource.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.VMProvider)
+
+		result, err = reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: contentSourceName},
+		})
+	})
+
+	getContentSource := func() *vmopv1a1.ContentSource {
+		var cs vmopv1a1.ContentSource
+		Expect(ctx.Client.Get(ctx, client.ObjectKey{Name: contentSourceName}, &cs)).To(Succeed())
+		return &cs
+	}
+
+	AfterEach(func() {
+		server.Close()
+		ctx = nil
+		withObjects = nil
+	})
+
+	When("the provider is an HTTPContentProvider", func() {
+		BeforeEach(func() {
+			index = `{
+				"items": [
+					{"name": "image-1", "url": "image-1.ova", "sha256": "abc"},
+					{"name": "image-2", "url": "https://other.example.com/image-2.ovf", "version": "2.0"},
+					{"name": "image-3"},
+					{"name": "image-4", "url": "image-4.ova"}
+				]
+			}`
+		})
+
+		It("imports the images in the provider's index", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).ToNot(BeZero())
+			Expect(imported).To(Equal([]importedItem{
+				{libraryUUID, "image-1", server.URL + "/images/image-1.ova", "abc"},
+				{libraryUUID, "image-2", "https://other.example.com/image-2.ovf", ""},
+				{libraryUUID, "image-4", server.URL + "/images/image-4.ova", ""},
+			}))
+
+			cs := getContentSource()
+			Expect(cs.Status.Images).To(Equal([]vmopv1a1.ContentSourceImageStatus{
+				{Name: "image-1", Version: "sha256:abc"},
+				{Name: "image-2", Version: "2.0"},
+				{Name: "image-4", Version: `etag:"v1"`},
+			}))
+			Expect(conditions.IsTrue(cs, vmopv1a1.ReadyCondition)).To(BeTrue())
+		})
+
+		When("the images were already imported", func() {
+			BeforeEach(func() {
+				contentSource.Status.Images = []vmopv1a1.ContentSourceImageStatus{
+					{Name: "image-1", Version: "sha256:abc"},
+					{Name: "image-2", Version: "1.0"},
+					{Name: "image-4", Version: `etag:"v1"`},
+					{Name: "image-5", Version: "5.0"},
+				}
+			})
+
+			It("imports only the images whose version changed", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(imported).To(Equal([]importedItem{
+					{libraryUUID, "image-2", "https://other.example.com/image-2.ovf", ""},
+				}))
+
+				cs := getContentSource()
+				Expect(cs.Status.Images).To(Equal([]vmopv1a1.ContentSourceImageStatus{
+					{Name: "image-1", Version: "sha256:abc"},
+					{Name: "image-2", Version: "2.0"},
+					{Name: "image-4", Version: `etag:"v1"`},
+				}))
+			})
+
+			When("the ETag of an image changed", func() {
+				BeforeEach(func() {
+					etag = `"v2"`
+				})
+
+				It("imports the image again", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(imported).To(Equal([]importedItem{
+						{libraryUUID, "image-2", "https://other.example.com/image-2.ovf", ""},
+						{libraryUUID, "image-4", server.URL + "/images/image-4.ova", ""},
+					}))
+					Expect(getContentSource().Status.Images).To(ContainElement(
+						vmopv1a1.ContentSourceImageStatus{Name: "image-4", Version: `etag:"v2"`}))
+				})
+			})
+		})
+
+		When("an image fails to import", func() {
+			BeforeEach(func() {
+				importErr = errors.New("import failed")
+			})
+
+			It("returns an error and reports it in the status", func() {
+				Expect(err).To(MatchError(ContainSubstring("failed to import image image-1: import failed")))
+				Expect(imported).To(HaveLen(3))
+
+				cs := getContentSource()
+				Expect(cs.Status.Images).To(BeEmpty())
+				c := conditions.Get(cs, vmopv1a1.ReadyCondition)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1a1.ContentSourceImportFailedReason))
+				Expect(c.Message).To(ContainSubstring("failed to import image image-1: import failed"))
+			})
+		})
+
+		When("the index does not exist", func() {
+			BeforeEach(func() {
+				withObjects[1].(*vmopv1a1.HTTPContentProvider).Spec.URL = server.URL + "/missing.json"
+			})
+
+			It("returns an error and reports it in the status", func() {
+				Expect(err).To(MatchError(ContainSubstring("index returned status 404")))
+				Expect(imported).To(BeEmpty())
+
+				c := conditions.Get(getContentSource(), vmopv1a1.ReadyCondition)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1a1.ContentSourceGetImagesFailedReason))
+			})
+		})
+
+		When("the provider does not exist", func() {
+			BeforeEach(func() {
+				withObjects = withObjects[:1]
+			})
+
+			It("does not import any images", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(imported).To(BeEmpty())
+			})
+		})
+	})
+
+	When("the provider is a ContentLibraryProvider", func() {
+		BeforeEach(func() {
+			contentSource.Spec.ProviderRef.Kind = utils.ContentLibraryProviderKind
+		})
+
+		It("does not import any images", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(imported).To(BeEmpty())
+		})
+	})
+
+	When("the provider kind is not supported", func() {
+		BeforeEach(func() {
+			contentSource.Spec.ProviderRef.Kind = "OtherProvider"
+		})
+
+		It("does not import any images", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(imported).To(BeEmpty())
+		})
+	})
+
+	When("the content source does not exist", func() {
+		BeforeEach(func() {
+			withObjects = nil
+		})
+
+		It("does not return an error", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(imported).To(BeEmpty())
+		})
+	})
+}
+
+func unitTestsProviderToContentSources() {
+	const providerName = "my-provider"
+
+	var (
+		ctx         *builder.UnitTestContextForController
+		withObjects []client.Object
+		reconciler  *contentsource.Reconciler
+		provider    *vmopv1a1.HTTPContentProvider
+		requests    []reconcile.Request
+	)
+
+	newContentSource := func(name, kind, providerName string) *vmopv1a1.ContentSource {
+		return &vmopv1a1.ContentSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: vmopv1a1.ContentSourceSpec{
+				ProviderRef: vmopv1a1.ContentProviderReference{
+					Kind: kind,
+					Name: providerName,
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		provider = &vmopv1a1.HTTPContentProvider{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: providerName,
+			},
+		}
+		withObjects = []client.Object{
+			newContentSource("cs-1", utils.HTTPContentProviderKind, providerName),
+			newContentSource("cs-2", utils.HTTPContentProviderKind, "other-provider"),
+			newContentSource("cs-3", utils.ContentLibraryProviderKind, providerName),
+			newContentSource("cs-4", utils.HTTPContentProviderKind, providerName),
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(withObjects...)
+		reconciler = contentsource.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.VMProvider)
+
+		requests = reconciler.ProviderToContentSources(utils.HTTPContentProviderKind)(ctx, provider)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+		withObjects = nil
+	})
+
+	It("enqueues the ContentSources that reference the provider", func() {
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "cs-1"}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "cs-4"}},
+		))
+	})
+
+	When("no ContentSources reference the provider", func() {
+		BeforeEach(func() {
+			withObjects = withObjects[1:3]
+		})
+
+		It("does not enqueue any ContentSources", func() {
+			Expect(requests).To(BeEmpty())
+		})
+	})
+}
@@ -0,0 +1,239 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package contentsource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-logr/logr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+)
+
+const (
+	// indexTimeout is the timeout for getting the index of an HTTP content
+	// provider.
+	indexTimeout = 30 * time.Second
+
+	// maxIndexBytes is the maximum size of the index of an HTTP content
+	// provider.
+	maxIndexBytes = 1 << 20
+)
+
+// Image is an image listed by a content provider.
+type Image struct {
+	// Name is the name of the image.
+	Name string
+
+	// URL is the URL of the image's OVF or OVA file.
+	URL string
+
+	// SHA256 is the optional SHA-256 checksum of the image's file.
+	SHA256 string
+
+	// LibraryUUID is the UUID of the content library into which the image is
+	// imported.
+	LibraryUUID string
+
+	// Version identifies the content of the image, ex. its checksum or the
+	// ETag of its file. An image is imported again when its version changes.
+	// If empty, the image is imported only once.
+	Version string
+}
+
+// ContentProvider lists and imports the images of a kind of content provider.
+type ContentProvider interface {
+	// GetImages returns the images of the content provider with the specified
+	// name.
+	GetImages(ctx context.Context, name string) ([]Image, error)
+
+	// ImportImage imports the image so VMs may be deployed from it.
+	ImportImage(ctx context.Context, image Image) error
+}
+
+// contentLibraryProvider is the content provider for a ContentLibraryProvider.
+// The items in a vSphere content library are already surfaced as images by the
+// image registry, so there are no images to list or import.
+type contentLibraryProvider struct{}
+
+func (contentLibraryProvider) GetImages(context.Context, string) ([]Image, error) {
+	return nil, nil
+}
+
+func (contentLibraryProvider) ImportImage(context.Context, Image) error {
+	return nil
+}
+
+// httpIndex is the index of an HTTP content provider.
+type httpIndex struct {
+	Items []httpIndexItem `json:"items"`
+}
+
+// httpIndexItem is an image in the index of an HTTP content provider.
+type httpIndexItem struct {
+	Name    string `json:"name"`
+	URL     string `json:"url"`
+	SHA256  string `json:"sha256,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// httpProvider is the content provider for an HTTPContentProvider. Its images
+// are imported into the provider's content library, from which they are
+// surfaced as images by the image registry.
+type httpProvider struct {
+	client     ctrlclient.Client
+	vmProvider providers.VirtualMachineProviderInterface
+	httpClient *http.Client
+}
+
+func newHTTPProvider(
+	client ctrlclient.Client,
+	vmProvider providers.VirtualMachineProviderInterface) *httpProvider {
+
+	return &httpProvider{
+		client:     client,
+		vmProvider: vmProvider,
+		httpClient: &http.Client{
+			Timeout: indexTimeout,
+		},
+	}
+}
+
+func (p *httpProvider) GetImages(ctx context.Context, name string) ([]Image, error) {
+	var obj vmopv1a1.HTTPContentProvider
+	if err := p.client.Get(ctx, ctrlclient.ObjectKey{Name: name}, &obj); err != nil {
+		// The provider's ContentSources are reconciled when it is created.
+		return nil, ctrlclient.IgnoreNotFound(err)
+	}
+
+	if obj.Spec.LibraryUUID == "" {
+		return nil, errors.New("library uuid is empty")
+	}
+
+	indexURL, err := url.Parse(obj.Spec.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse url %q: %w", obj.Spec.URL, err)
+	}
+
+	index, err := p.getIndex(ctx, indexURL)
+	if err != nil {
+		return nil, err
+	}
+
+	images := make([]Image, 0, len(index.Items))
+	for _, item := range index.Items {
+		if item.Name == "" || item.URL == "" {
+			logr.FromContextOrDiscard(ctx).Info("Skipping index item without a name or url",
+				"itemName", item.Name, "itemURL", item.URL)
+			continue
+		}
+
+		itemURL, err := indexURL.Parse(item.URL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse url %q of item %s: %w", item.URL, item.Name, err)
+		}
+
+		images = append(images, Image{
+			Name:        item.Name,
+			URL:         itemURL.String(),
+			SHA256:      item.SHA256,
+			LibraryUUID: obj.Spec.LibraryUUID,
+			Version:     p.getVersion(ctx, item, itemURL),
+		})
+	}
+
+	return images, nil
+}
+
+func (p *httpProvider) getIndex(ctx context.Context, indexURL *url.URL) (httpIndex, error) {
+	var index httpIndex
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, indexURL.String(), nil)
+	if err != nil {
+		return index, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return index, fmt.Errorf("failed to get index: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return index, fmt.Errorf("index returned status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxIndexBytes))
+	if err != nil {
+		return index, fmt.Errorf("failed to read index: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &index); err != nil {
+		return index, fmt.Errorf("failed to decode index: %w", err)
+	}
+
+	return index, nil
+}
+
+// getVersion returns the version of an item in the index. The item's checksum
+// is preferred, then the version in the index, then the ETag or Last-Modified
+// header of the item's file. An empty version is returned if the item's file
+// does not report one.
+func (p *httpProvider) getVersion(
+	ctx context.Context,
+	item httpIndexItem,
+	itemURL *url.URL) string {
+
+	switch {
+	case item.SHA256 != "":
+		return "sha256:" + item.SHA256
+	case item.Version != "":
+		return item.Version
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, itemURL.String(), nil)
+	if err != nil {
+		return ""
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		logr.FromContextOrDiscard(ctx).Error(err, "Failed to get version of index item",
+			"itemName", item.Name, "itemURL", itemURL.String())
+		return ""
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return ""
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		return "etag:" + etag
+	}
+	if lastModified := resp.Header.Get("Last-Modified"); lastModified != "" {
+		return "last-modified:" + lastModified
+	}
+
+	return ""
+}
+
+func (p *httpProvider) ImportImage(ctx context.Context, image Image) error {
+	return p.vmProvider.ImportContentLibraryItem(
+		ctx,
+		image.LibraryUUID,
+		image.Name,
+		image.URL,
+		image.SHA256)
+}
@@ -10,12 +10,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/vmware-tanzu/vm-operator/controllers/contentlibrary"
+	"github.com/vmware-tanzu/vm-operator/controllers/contentsource"
 	"github.com/vmware-tanzu/vm-operator/controllers/infra"
 	"github.com/vmware-tanzu/vm-operator/controllers/storageclass"
 	spq "github.com/vmware-tanzu/vm-operator/controllers/storagepolicyquota"
+	"github.com/vmware-tanzu/vm-operator/controllers/storageversionmigration"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachine"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineclass"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineexportrequest"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineimagecache"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineimagecatalog"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineinventoryreport"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinepublishrequest"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinereplicaset"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineservice"
@@ -30,6 +35,9 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 	if err := contentlibrary.AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to initialize ContentLibrary controllers: %w", err)
 	}
+	if err := contentsource.AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to initialize ContentSource controller: %w", err)
+	}
 	if err := infra.AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to initialize Infra controllers: %w", err)
 	}
@@ -38,12 +46,23 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 			return fmt.Errorf("failed to initialize StoragePolicyQuota controller: %w", err)
 		}
 	}
+	if err := storageversionmigration.AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to initialize StorageVersionMigration controller: %w", err)
+	}
 	if err := virtualmachine.AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to initialize VirtualMachine controller: %w", err)
 	}
 	if err := virtualmachineclass.AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to initialize VirtualMachineClass controller: %w", err)
 	}
+	if err := virtualmachineimagecatalog.AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to initialize VirtualMachineImageCatalog controller: %w", err)
+	}
+	if pkgcfg.FromContext(ctx).InventoryReportInterval > 0 {
+		if err := virtualmachineinventoryreport.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineInventoryReport controller: %w", err)
+		}
+	}
 	if err := virtualmachineservice.AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to initialize VirtualMachineService controller: %w", err)
 	}
@@ -56,6 +75,9 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 	if err := virtualmachinepublishrequest.AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to initialize VirtualMachinePublishRequest controller: %w", err)
 	}
+	if err := virtualmachineexportrequest.AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to initialize VirtualMachineExportRequest controller: %w", err)
+	}
 
 	if pkgcfg.FromContext(ctx).Features.K8sWorkloadMgmtAPI {
 		if err := virtualmachinereplicaset.AddToManager(ctx, mgr); err != nil {
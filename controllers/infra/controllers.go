@@ -20,6 +20,10 @@ import (
 )
 
 // AddToManager adds the controllers to the provided manager.
+//
+// Please note, the capability, configmap, node, and secret controllers update
+// the state of this process, ex. the vCenter client, rather than any objects,
+// so they run in every shard. The other controllers are sharded.
 func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
 	if err := capability.AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to initialize infra capability controller: %w", err)
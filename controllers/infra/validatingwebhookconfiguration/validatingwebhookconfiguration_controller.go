@@ -28,6 +28,7 @@ import (
 	pkgmgr "github.com/vmware-tanzu/vm-operator/pkg/manager"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 	spqutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube/spq"
 )
 
@@ -48,7 +49,9 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
 	)
 
-	c, err := controller.New(controllerNameShort, mgr, controller.Options{Reconciler: r})
+	c, err := controller.New(controllerNameShort, mgr, controller.Options{
+		Reconciler: shard.NewReconciler(ctx, mgr.GetClient(), r),
+	})
 	if err != nil {
 		return err
 	}
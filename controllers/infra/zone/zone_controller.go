@@ -24,6 +24,7 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/patch"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/watcher"
 )
 
@@ -50,12 +51,14 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
 	)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(controlledType).
 		WithOptions(controller.Options{
 			SkipNameValidation: SkipNameValidation,
-		}).
-		Complete(r)
+		})
+
+	return shard.WatchNamespaces(ctx, builder, mgr.GetClient(), &topologyv1.ZoneList{}).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(), r))
 }
 
 func NewReconciler(
@@ -24,6 +24,7 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 	spqutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube/spq"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 )
@@ -46,9 +47,11 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		ctx.Namespace,
 	)
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(controlledType).
-		Complete(r)
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(controlledType)
+
+	return shard.WatchNamespaces(ctx, builder, mgr.GetClient(), &spqv1.StoragePolicyQuotaList{}).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(), r))
 }
 
 func NewReconciler(
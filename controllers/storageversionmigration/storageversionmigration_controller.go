@@ -0,0 +1,318 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package storageversionmigration
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apierrorsutil "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+const (
+	// ProgressAnnotationKey is the annotation on a CRD that records the
+	// progress of migrating the CRD's objects to the storage version, in the
+	// form "<migrated>/<total>".
+	ProgressAnnotationKey = "vmoperator.vmware.com/storage-version-migration"
+
+	// listPageSize is the number of objects listed at a time while migrating.
+	listPageSize = 100
+)
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &apiextensionsv1.CustomResourceDefinition{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-storageversionmigration-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName("StorageVersionMigration"),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(controllerNameShort).
+		For(controlledType).
+		WithEventFilter(predicate.NewPredicateFuncs(IsVMOperatorCRD)).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(), r))
+}
+
+// IsVMOperatorCRD returns true if the object is the CRD of a VM Operator API.
+func IsVMOperatorCRD(obj ctrlclient.Object) bool {
+	return strings.HasSuffix(obj.GetName(), "."+vmopv1.GroupName)
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client ctrlclient.Client,
+	logger logr.Logger,
+	recorder record.Recorder) *Reconciler {
+
+	return &Reconciler{
+		Context:  ctx,
+		Client:   client,
+		Logger:   logger,
+		Recorder: recorder,
+	}
+}
+
+// Reconciler migrates the persisted objects of a VM Operator CRD to the CRD's
+// storage version. Once all objects are migrated, the older versions are
+// removed from the CRD's status.storedVersions so they may eventually be
+// removed from the API.
+type Reconciler struct {
+	ctrlclient.Client
+	Context  context.Context
+	Logger   logr.Logger
+	Recorder record.Recorder
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=get;update;patch
+
+func (r *Reconciler) Reconcile(
+	ctx context.Context,
+	req ctrl.Request) (_ ctrl.Result, reterr error) {
+
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	var obj apiextensionsv1.CustomResourceDefinition
+	if err := r.Get(ctx, req.NamespacedName, &obj); err != nil {
+		return ctrl.Result{}, ctrlclient.IgnoreNotFound(err)
+	}
+
+	if !obj.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{}, r.ReconcileNormal(
+		logr.NewContext(
+			ctx,
+			r.Logger.WithValues("name", req.Name),
+		),
+		&obj)
+}
+
+func (r *Reconciler) ReconcileNormal(
+	ctx context.Context,
+	crd *apiextensionsv1.CustomResourceDefinition) error {
+
+	logger := logr.FromContextOrDiscard(ctx)
+
+	storageVersion := getStorageVersion(*crd)
+	if storageVersion == "" {
+		return nil
+	}
+
+	if slices.Equal(crd.Status.StoredVersions, []string{storageVersion}) {
+		return nil
+	}
+
+	logger.Info("Migrating objects to storage version",
+		"storageVersion", storageVersion,
+		"storedVersions", crd.Status.StoredVersions)
+
+	migrated, total, err := r.migrateObjects(ctx, *crd, storageVersion)
+
+	if err := r.patchProgress(ctx, crd, migrated, total); err != nil {
+		logger.Error(err, "failed to update migration progress")
+	}
+
+	if err != nil {
+		r.Recorder.EmitEvent(crd, "StorageVersionMigration", err, false)
+		return err
+	}
+
+	crd.Status.StoredVersions = []string{storageVersion}
+	if err := r.Status().Update(ctx, crd); err != nil {
+		return fmt.Errorf("failed to update stored versions: %w", err)
+	}
+
+	logger.Info("Migrated objects to storage version",
+		"storageVersion", storageVersion, "total", total)
+	r.Recorder.EmitEvent(crd, "StorageVersionMigration", nil, false)
+
+	return nil
+}
+
+// getStorageVersion returns the name of the CRD's storage version, or an empty
+// string if the CRD does not have one.
+func getStorageVersion(crd apiextensionsv1.CustomResourceDefinition) string {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+	return ""
+}
+
+// migrateObjects rewrites each of the CRD's objects so they are persisted
+// with the storage version. The number of objects that were migrated and the
+// total number of objects are returned.
+func (r *Reconciler) migrateObjects(
+	ctx context.Context,
+	crd apiextensionsv1.CustomResourceDefinition,
+	storageVersion string) (int, int, error) {
+
+	var (
+		errs     []error
+		migrated int
+		total    int
+		list     unstructured.UnstructuredList
+	)
+
+	gvk := schema.GroupVersionKind{
+		Group:   crd.Spec.Group,
+		Version: storageVersion,
+		Kind:    crd.Spec.Names.Kind,
+	}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(crd.Spec.Names.ListKind))
+
+	opts := &ctrlclient.ListOptions{Limit: listPageSize}
+	for {
+		if err := r.List(ctx, &list, opts); err != nil {
+			return migrated, total, fmt.Errorf("failed to list %s: %w", crd.Spec.Names.Plural, err)
+		}
+
+		for i := range list.Items {
+			total++
+			list.Items[i].SetGroupVersionKind(gvk)
+			if err := r.migrateObject(ctx, &list.Items[i]); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			migrated++
+		}
+
+		if list.GetContinue() == "" {
+			break
+		}
+		opts.Continue = list.GetContinue()
+	}
+
+	return migrated, total, apierrorsutil.NewAggregate(errs)
+}
+
+// migrateObject rewrites the object with the storage version, backfilling any
+// fields that were introduced by the storage version.
+func (r *Reconciler) migrateObject(
+	ctx context.Context,
+	obj *unstructured.Unstructured) error {
+
+	var err error
+	if obj.GetKind() == "VirtualMachine" &&
+		obj.GroupVersionKind().Version == vmopv1.GroupVersion.Version {
+
+		err = r.migrateVirtualMachine(ctx, ctrlclient.ObjectKeyFromObject(obj))
+	} else {
+		// An update without any changes is sufficient for the API server to
+		// persist the object with the storage version.
+		err = r.Update(ctx, obj)
+	}
+
+	switch {
+	case err == nil:
+		return nil
+	case apierrors.IsNotFound(err):
+		// The object was deleted since it was listed.
+		return nil
+	case apierrors.IsConflict(err):
+		// The object was updated since it was listed, which means it was
+		// already persisted with the storage version.
+		return nil
+	}
+
+	return fmt.Errorf("failed to migrate %s %s/%s: %w",
+		obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+}
+
+// migrateVirtualMachine rewrites the VM with the storage version. Fields that
+// VMs created with older versions may not have are backfilled from the
+// VM's status or other fields.
+func (r *Reconciler) migrateVirtualMachine(
+	ctx context.Context,
+	key ctrlclient.ObjectKey) error {
+
+	var vm vmopv1.VirtualMachine
+	if err := r.Get(ctx, key, &vm); err != nil {
+		return err
+	}
+
+	if vm.Spec.BiosUUID == "" {
+		vm.Spec.BiosUUID = vm.Status.BiosUUID
+	}
+	if vm.Spec.InstanceUUID == "" {
+		vm.Spec.InstanceUUID = vm.Status.InstanceUUID
+	}
+
+	if vm.Spec.Image == nil && vm.Spec.ImageName != "" {
+		img, err := vmopv1util.ResolveImageName(ctx, r.Client, vm.Namespace, vm.Spec.ImageName)
+		switch {
+		case err == nil:
+			vm.Spec.Image = &vmopv1.VirtualMachineImageRef{Name: img.GetName()}
+			switch img.(type) {
+			case *vmopv1.VirtualMachineImage:
+				vm.Spec.Image.Kind = "VirtualMachineImage"
+			case *vmopv1.ClusterVirtualMachineImage:
+				vm.Spec.Image.Kind = "ClusterVirtualMachineImage"
+			}
+		case apierrors.IsNotFound(err):
+			// The image may no longer exist, so the image ref cannot be
+			// backfilled. This does not prevent the VM from being migrated.
+			logr.FromContextOrDiscard(ctx).V(4).Info(
+				"Skipping backfill of VM image ref",
+				"vm", key, "imageName", vm.Spec.ImageName, "err", err.Error())
+		default:
+			return err
+		}
+	}
+
+	return r.Update(ctx, &vm)
+}
+
+// patchProgress records the progress of the migration on the CRD.
+func (r *Reconciler) patchProgress(
+	ctx context.Context,
+	crd *apiextensionsv1.CustomResourceDefinition,
+	migrated, total int) error {
+
+	progress := fmt.Sprintf("%d/%d", migrated, total)
+	if crd.Annotations[ProgressAnnotationKey] == progress {
+		return nil
+	}
+
+	patch := ctrlclient.MergeFrom(crd.DeepCopy())
+	if crd.Annotations == nil {
+		crd.Annotations = map[string]string{}
+	}
+	crd.Annotations[ProgressAnnotationKey] = progress
+
+	return r.Patch(ctx, crd, patch)
+}
@@ -0,0 +1,209 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package storageversionmigration_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/controllers/storageversionmigration"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.V1Alpha3,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const (
+		crdName = "virtualmachines." + vmopv1.GroupName
+		ns      = "my-namespace"
+	)
+
+	var (
+		initObjects []ctrlclient.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler *storageversionmigration.Reconciler
+		crd        *apiextensionsv1.CustomResourceDefinition
+		vm         *vmopv1.VirtualMachine
+		vmi        *vmopv1.VirtualMachineImage
+
+		err error
+	)
+
+	BeforeEach(func() {
+		err = nil
+		initObjects = nil
+
+		crd = &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crdName,
+			},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: vmopv1.GroupName,
+				Names: apiextensionsv1.CustomResourceDefinitionNames{
+					Kind:     "VirtualMachine",
+					ListKind: "VirtualMachineList",
+					Plural:   "virtualmachines",
+				},
+				Scope: apiextensionsv1.NamespaceScoped,
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name:   "v1alpha1",
+						Served: true,
+					},
+					{
+						Name:    "v1alpha3",
+						Served:  true,
+						Storage: true,
+					},
+				},
+			},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{
+				StoredVersions: []string{"v1alpha1", "v1alpha3"},
+			},
+		}
+
+		vmi = builder.DummyVirtualMachineImage("vmi-0123456789")
+		vmi.Namespace = ns
+
+		vm = builder.DummyBasicVirtualMachine("my-vm", ns)
+		vm.Spec.ImageName = vmi.Name
+		vm.Spec.Image = nil
+		vm.Spec.BiosUUID = ""
+		vm.Spec.InstanceUUID = ""
+		vm.Status.BiosUUID = "my-bios-uuid"
+		vm.Status.InstanceUUID = "my-instance-uuid"
+	})
+
+	JustBeforeEach(func() {
+		initObjects = append(initObjects, crd, vm, vmi)
+
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+
+		reconciler = storageversionmigration.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder)
+
+		_, err = reconciler.Reconcile(
+			context.Background(),
+			reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: crd.Name,
+				},
+			})
+	})
+
+	AfterEach(func() {
+		ctx = nil
+		reconciler = nil
+	})
+
+	It("should migrate the objects and update the stored versions", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		var obj apiextensionsv1.CustomResourceDefinition
+		Expect(ctx.Client.Get(ctx, ctrlclient.ObjectKey{Name: crdName}, &obj)).To(Succeed())
+		Expect(obj.Status.StoredVersions).To(Equal([]string{"v1alpha3"}))
+		Expect(obj.Annotations).To(HaveKeyWithValue(storageversionmigration.ProgressAnnotationKey, "1/1"))
+	})
+
+	It("should backfill the VM", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		var obj vmopv1.VirtualMachine
+		Expect(ctx.Client.Get(ctx, ctrlclient.ObjectKeyFromObject(vm), &obj)).To(Succeed())
+		Expect(obj.Spec.BiosUUID).To(Equal("my-bios-uuid"))
+		Expect(obj.Spec.InstanceUUID).To(Equal("my-instance-uuid"))
+		Expect(obj.Spec.Image).To(Equal(&vmopv1.VirtualMachineImageRef{
+			Kind: "VirtualMachineImage",
+			Name: vmi.Name,
+		}))
+	})
+
+	When("the VM already has the fields", func() {
+		BeforeEach(func() {
+			vm.Spec.BiosUUID = "spec-bios-uuid"
+			vm.Spec.InstanceUUID = "spec-instance-uuid"
+			vm.Spec.Image = &vmopv1.VirtualMachineImageRef{
+				Kind: "ClusterVirtualMachineImage",
+				Name: vmi.Name,
+			}
+		})
+
+		It("should not change the VM", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			var obj vmopv1.VirtualMachine
+			Expect(ctx.Client.Get(ctx, ctrlclient.ObjectKeyFromObject(vm), &obj)).To(Succeed())
+			Expect(obj.Spec.BiosUUID).To(Equal("spec-bios-uuid"))
+			Expect(obj.Spec.InstanceUUID).To(Equal("spec-instance-uuid"))
+			Expect(obj.Spec.Image.Kind).To(Equal("ClusterVirtualMachineImage"))
+		})
+	})
+
+	When("the VM image does not exist", func() {
+		BeforeEach(func() {
+			vm.Spec.ImageName = "vmi-does-not-exist"
+		})
+
+		It("should still migrate the VM", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			var obj vmopv1.VirtualMachine
+			Expect(ctx.Client.Get(ctx, ctrlclient.ObjectKeyFromObject(vm), &obj)).To(Succeed())
+			Expect(obj.Spec.Image).To(BeNil())
+			Expect(obj.Spec.BiosUUID).To(Equal("my-bios-uuid"))
+		})
+	})
+
+	When("the stored versions are already the storage version", func() {
+		BeforeEach(func() {
+			crd.Status.StoredVersions = []string{"v1alpha3"}
+		})
+
+		It("should not migrate the objects", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			var obj vmopv1.VirtualMachine
+			Expect(ctx.Client.Get(ctx, ctrlclient.ObjectKeyFromObject(vm), &obj)).To(Succeed())
+			Expect(obj.Spec.BiosUUID).To(BeEmpty())
+
+			var crdObj apiextensionsv1.CustomResourceDefinition
+			Expect(ctx.Client.Get(ctx, ctrlclient.ObjectKey{Name: crdName}, &crdObj)).To(Succeed())
+			Expect(crdObj.Annotations).ToNot(HaveKey(storageversionmigration.ProgressAnnotationKey))
+		})
+	})
+
+	Context("IsVMOperatorCRD", func() {
+		It("should return true only for VM Operator CRDs", func() {
+			Expect(storageversionmigration.IsVMOperatorCRD(crd)).To(BeTrue())
+			Expect(storageversionmigration.IsVMOperatorCRD(
+				&apiextensionsv1.CustomResourceDefinition{
+					ObjectMeta: metav1.ObjectMeta{Name: "foos.example.com"},
+				})).To(BeFalse())
+		})
+	})
+}
@@ -25,6 +25,7 @@ import (
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 	spqutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube/spq"
 )
 
@@ -44,7 +45,9 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 	)
 
 	c, err := controller.New(
-		controllerName, mgr, controller.Options{Reconciler: r})
+		controllerName, mgr, controller.Options{
+			Reconciler: shard.NewReconciler(ctx, mgr.GetClient(), r),
+		})
 	if err != nil {
 		return err
 	}
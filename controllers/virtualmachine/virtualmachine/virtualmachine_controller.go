@@ -10,9 +10,11 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -28,10 +30,12 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 
 	byokv1 "github.com/vmware-tanzu/vm-operator/external/byok/api/v1alpha1"
+	cnsv1alpha1 "github.com/vmware-tanzu/vm-operator/external/vsphere-csi-driver/pkg/syncer/cnsoperator/apis/cnsnodevmattachment/v1alpha1"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	ctxbudget "github.com/vmware-tanzu/vm-operator/pkg/context/budget"
 	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
 	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
@@ -41,8 +45,11 @@ import (
 	vspherevm "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/deployhook"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/externaldns"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 	"github.com/vmware-tanzu/vm-operator/pkg/vmconfig"
@@ -58,6 +65,18 @@ const (
 	// indicates a VM pointing to that VM Class should be reconciled by this
 	// controller.
 	vmClassControllerName = "vmoperator.vmware.com/vsphere"
+
+	// volumeDetachRequeueDelay is how long to wait before checking again if
+	// a deleted VM's volumes have been detached.
+	volumeDetachRequeueDelay = 10 * time.Second
+
+	// deployHooksRequeueDelay is how long to wait before running a VM's
+	// pending deploy hooks again.
+	deployHooksRequeueDelay = 10 * time.Second
+
+	// deviceAuditInterval is the minimum time between the audits of a VM's
+	// devices.
+	deviceAuditInterval = 10 * time.Minute
 )
 
 // SkipNameValidation is used for testing to allow multiple controllers with the
@@ -121,6 +140,28 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 	builder = builder.Watches(&vmopv1.VirtualMachineClass{},
 		handler.EnqueueRequestsFromMapFunc(classToVMMapperFn(ctx, r.Client, isDefaultVMClassController)))
 
+	imageToVMMapper := handler.EnqueueRequestsFromMapFunc(
+		vmopv1util.ImageToVirtualMachineMapper(ctx, r.Client))
+	builder = builder.
+		Watches(&vmopv1.VirtualMachineImage{}, imageToVMMapper).
+		Watches(&vmopv1.ClusterVirtualMachineImage{}, imageToVMMapper).
+		Watches(
+			&vmopv1.VirtualMachineSetResourcePolicy{},
+			handler.EnqueueRequestsFromMapFunc(
+				vmopv1util.ResourcePolicyToVirtualMachineMapper(ctx, r.Client)))
+
+	// ConfigMap and Secret resources are not cached because of how many may
+	// exist, so only their metadata is watched for changes to the VMs'
+	// bootstrap data, and only the events for the resources referenced by a
+	// VM are handled.
+	bootstrapToVMMapper := handler.EnqueueRequestsFromMapFunc(
+		vmopv1util.BootstrapResourceToVirtualMachineMapper(ctx, r.Client))
+	bootstrapPredicate := ctrlbuilder.WithPredicates(
+		vmopv1util.BootstrapResourcePredicate(ctx, r.Client))
+	builder = builder.
+		Watches(&corev1.Secret{}, bootstrapToVMMapper, ctrlbuilder.OnlyMetadata, bootstrapPredicate).
+		Watches(&corev1.ConfigMap{}, bootstrapToVMMapper, ctrlbuilder.OnlyMetadata, bootstrapPredicate)
+
 	if pkgcfg.FromContext(ctx).Features.BringYourOwnEncryptionKey {
 		builder = builder.Watches(
 			&byokv1.EncryptionClass{},
@@ -150,7 +191,10 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		)
 	}
 
-	return builder.Complete(r)
+	builder = shard.WatchNamespaces(ctx, builder, mgr.GetClient(), &vmopv1.VirtualMachineList{})
+
+	return builder.Complete(shard.NewReconciler(ctx, mgr.GetClient(),
+		metrics.NewSLOReconciler(controllerNameShort, r)))
 }
 
 // classToVMMapperFn returns a mapper function that can be used to queue reconcile request
@@ -251,39 +295,53 @@ func NewReconciler(
 	prober prober.Manager) *Reconciler {
 
 	return &Reconciler{
-		Context:    ctx,
-		Client:     client,
-		Logger:     logger,
-		Recorder:   recorder,
-		VMProvider: vmProvider,
-		Prober:     prober,
-		vmMetrics:  metrics.NewVMMetrics(),
+		Context:     ctx,
+		Client:      client,
+		Logger:      logger,
+		Recorder:    recorder,
+		VMProvider:  vmProvider,
+		Prober:      prober,
+		vmMetrics:   metrics.NewVMMetrics(),
+		deployHooks: deployhook.NewRunner(ctx),
 	}
 }
 
 // Reconciler reconciles a VirtualMachine object.
 type Reconciler struct {
 	client.Client
-	Context    context.Context
-	Logger     logr.Logger
-	Recorder   record.Recorder
-	VMProvider providers.VirtualMachineProviderInterface
-	Prober     prober.Manager
-	vmMetrics  *metrics.VMMetrics
+	Context     context.Context
+	Logger      logr.Logger
+	Recorder    record.Recorder
+	VMProvider  providers.VirtualMachineProviderInterface
+	Prober      prober.Manager
+	vmMetrics   *metrics.VMMetrics
+	deployHooks *deployhook.Runner
+
+	// deviceAudits records when the devices of a VM were last audited, keyed
+	// by the VM's UID.
+	deviceAudits sync.Map
 }
 
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclasses,verbs=get;list
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineimages;clustervirtualmachineimages;virtualmachinesetresourcepolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups=vmware.com,resources=virtualnetworkinterfaces;virtualnetworkinterfaces/status,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vmware.com,resources=virtualnetworks,verbs=get;list;watch
 // +kubebuilder:rbac:groups=netoperator.vmware.com,resources=networkinterfaces,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cns.vmware.com,resources=storagepolicyquotas,verbs=get;list;watch
 // +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=subnetports,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=subnetports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=securitypolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events;configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=resourcequotas;namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;delete
+// +kubebuilder:rbac:groups=externaldns.k8s.io,resources=dnsendpoints,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=encryption.vmware.com,resources=encryptionclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinedeployhooks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
 
 // Reconcile the object.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -296,7 +354,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 	}
 
 	ctx = ctxop.WithContext(ctx)
+	ctx = ctxbudget.WithContext(ctx, pkgcfg.FromContext(ctx).ReconcileBudget)
 	ctx = ovfcache.JoinContext(ctx, r.Context)
+	ctx = vmopv1util.JoinImageResolverContext(ctx, r.Context)
 	ctx = record.WithContext(ctx, r.Recorder)
 
 	vm := &vmopv1.VirtualMachine{}
@@ -443,6 +503,17 @@ func (r *Reconciler) ReconcileDelete(ctx *pkgctx.VirtualMachineContext) (reterr
 
 	if controllerutil.ContainsFinalizer(ctx.VM, finalizerName) ||
 		controllerutil.ContainsFinalizer(ctx.VM, deprecatedFinalizerName) {
+
+		// The volumes are left attached in observer mode since the vSphere VM
+		// is not deleted.
+		observerMode := pkgcfg.FromContext(ctx).ObserverMode
+
+		if !observerMode {
+			if err := r.detachVolumesBeforeDelete(ctx); err != nil {
+				return err
+			}
+		}
+
 		defer func() {
 			r.Recorder.EmitEvent(ctx.VM, "Delete", reterr, false)
 		}()
@@ -457,6 +528,12 @@ func (r *Reconciler) ReconcileDelete(ctx *pkgctx.VirtualMachineContext) (reterr
 			return err
 		}
 
+		if !observerMode {
+			if err := r.deleteVolumeClaimsAfterDelete(ctx); err != nil {
+				return err
+			}
+		}
+
 		controllerutil.RemoveFinalizer(ctx.VM, finalizerName)
 		controllerutil.RemoveFinalizer(ctx.VM, deprecatedFinalizerName)
 		ctx.Logger.Info("Provider Completed deleting Virtual Machine", "time", time.Now().Format(time.RFC3339))
@@ -465,13 +542,94 @@ func (r *Reconciler) ReconcileDelete(ctx *pkgctx.VirtualMachineContext) (reterr
 	// BMV: Shouldn't these be in the ContainsFinalizer block?
 	r.vmMetrics.DeleteMetrics(ctx)
 	r.Prober.RemoveFromProberManager(ctx.VM)
+	r.deployHooks.Forget(ctx.VM)
+	r.deviceAudits.Delete(ctx.VM.UID)
 
 	ctx.Logger.Info("Finished Reconciling VirtualMachine Deletion")
 	return nil
 }
 
+// detachVolumesBeforeDelete deletes the VM's CnsNodeVmAttachments so CNS
+// detaches the volumes before the VM is deleted. Otherwise the volumes' disks
+// would be destroyed along with the VM. A RequeueError is returned while any
+// of the attachments still exist.
+func (r *Reconciler) detachVolumesBeforeDelete(ctx *pkgctx.VirtualMachineContext) error {
+	list := &cnsv1alpha1.CnsNodeVmAttachmentList{}
+	if err := r.Client.List(ctx, list, client.InNamespace(ctx.VM.Namespace)); err != nil {
+		return fmt.Errorf("failed to list CnsNodeVmAttachments: %w", err)
+	}
+
+	var pending []string
+	for i := range list.Items {
+		attachment := &list.Items[i]
+		if !metav1.IsControlledBy(attachment, ctx.VM) {
+			continue
+		}
+
+		pending = append(pending, attachment.Name)
+
+		if attachment.DeletionTimestamp.IsZero() {
+			if err := r.Client.Delete(ctx, attachment); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed to delete CnsNodeVmAttachment %s: %w", attachment.Name, err)
+			}
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	ctx.Logger.Info("Waiting for volumes to be detached before deleting VM",
+		"attachments", pending)
+	return pkgerr.RequeueError{After: volumeDetachRequeueDelay}
+}
+
+// deleteVolumeClaimsAfterDelete deletes the PVCs of the VM's volumes that have
+// a Delete policy. It is called after the VM has been deleted, at which point
+// the volumes have already been detached. The PVCs of volumes with a Retain
+// policy, and those backed by instance storage, are left alone. The latter are
+// owned by the VM and garbage collected with it. The PVCs of volumes with the
+// PreventDelete latch set are never deleted.
+func (r *Reconciler) deleteVolumeClaimsAfterDelete(ctx *pkgctx.VirtualMachineContext) error {
+	for _, vol := range ctx.VM.Spec.Volumes {
+		pvc := vol.PersistentVolumeClaim
+		if pvc == nil || pvc.InstanceVolumeClaim != nil {
+			continue
+		}
+		if pvc.DeletePolicy != vmopv1.VirtualMachineVolumeDeletePolicyDelete {
+			continue
+		}
+		if vol.PreventDelete {
+			ctx.Logger.Info("Skipping delete of PersistentVolumeClaim with preventDelete set",
+				"volumeName", vol.Name, "claimName", pvc.ClaimName)
+			continue
+		}
+
+		obj := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pvc.ClaimName,
+				Namespace: ctx.VM.Namespace,
+			},
+		}
+		if err := r.Client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete PersistentVolumeClaim %s for volume %s: %w",
+				pvc.ClaimName, vol.Name, err)
+		}
+
+		ctx.Logger.Info("Deleted PersistentVolumeClaim with Delete policy",
+			"volumeName", vol.Name, "claimName", pvc.ClaimName)
+	}
+
+	return nil
+}
+
 // ReconcileNormal processes a level trigger for this VM: create if it doesn't exist otherwise update the existing VM.
 func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineContext) (reterr error) {
+	// Normalize VMs created by a different build version. This is done before
+	// checking whether the VM is paused since the pause annotation may be
+	// migrated.
+	upgradeVM(ctx)
+
 	// Return early if the VM reconciliation is paused.
 	if _, exists := ctx.VM.Annotations[vmopv1.PauseAnnotation]; exists {
 		ctx.Logger.Info("Skipping reconciliation since VirtualMachine contains the pause annotation")
@@ -513,6 +671,7 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineContext) (reterr
 
 	defer func() {
 		r.vmMetrics.RegisterVMCreateOrUpdateMetrics(ctx)
+		r.registerChargebackMetrics(ctx)
 	}()
 
 	// Upgrade schema fields where needed
@@ -525,6 +684,17 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineContext) (reterr
 		}
 	}
 
+	if pkgcfg.FromContext(ctx).Features.DeployHooks && ctx.VM.Status.UniqueID == "" {
+		// Do not proceed with the create until the PreDeploy hooks succeed.
+		if ok, err := r.reconcileDeployHooks(ctx, vmopv1.VirtualMachineDeployHookPhasePreDeploy); !ok {
+			return err
+		}
+		// Record the PostFirstBoot hooks to run once the VM is deployed.
+		if err := deployhook.RecordPostFirstBootHooks(ctx, r.Client, ctx.VM); err != nil {
+			return err
+		}
+	}
+
 	var (
 		err     error
 		chanErr <-chan error
@@ -543,6 +713,10 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineContext) (reterr
 		err = r.VMProvider.CreateOrUpdateVirtualMachine(ctx, ctx.VM)
 	}
 
+	if pkgcfg.FromContext(ctx).ReconcileBudget > 0 {
+		updateReconcileCompleteCondition(ctx, err)
+	}
+
 	switch {
 	case ctxop.IsCreate(ctx) && !ignoredCreateErr(err):
 
@@ -600,9 +774,109 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineContext) (reterr
 		r.Prober.RemoveFromProberManager(ctx.VM)
 	}
 
+	if policy := pkgcfg.FromContext(ctx).DeviceDriftPolicy; err == nil && policy != "" {
+		r.auditDevices(ctx, policy)
+	}
+
+	if err == nil {
+		r.checkMACAddresses(ctx)
+	}
+
+	if pkgcfg.FromContext(ctx).Features.DeployHooks && err == nil {
+		if _, ok := ctx.VM.Annotations[vmopv1.FirstBootDoneAnnotation]; ok {
+			_, err = r.reconcileDeployHooks(ctx, vmopv1.VirtualMachineDeployHookPhasePostFirstBoot)
+		}
+		deployhook.UpdateReadyCondition(ctx.VM)
+	}
+
+	if domain := pkgcfg.FromContext(ctx).ExternalDNSDomain; err == nil && domain != "" {
+		err = externaldns.ReconcileVMRecords(ctx, r.Client, ctx.VM, domain)
+	}
+
 	return err
 }
 
+// reconcileDeployHooks runs the VM's deploy hooks with the provided phase and
+// returns true if the hooks succeeded. A requeue error is returned while the
+// hooks are pending so the hooks are run again. Failed hooks are not requeued,
+// but are run again the next time the VM is reconciled.
+func (r *Reconciler) reconcileDeployHooks(
+	ctx *pkgctx.VirtualMachineContext,
+	phase vmopv1.VirtualMachineDeployHookPhase) (bool, error) {
+
+	ok, err := r.deployHooks.Reconcile(ctx, r.Client, ctx.VM, phase)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		return true, nil
+	}
+
+	c := conditions.Get(ctx.VM, deployhook.ConditionType(phase))
+	if c != nil && c.Reason == vmopv1.VirtualMachineDeployHooksFailedReason {
+		ctx.Logger.Info("VM deploy hooks failed", "phase", phase, "message", c.Message)
+		r.Recorder.Warnf(ctx.VM, "DeployHooksFailed", "%s hooks failed: %s", phase, c.Message)
+		return false, nil
+	}
+
+	ctx.Logger.V(4).Info("Waiting for VM deploy hooks", "phase", phase)
+	return false, pkgerr.RequeueError{After: deployHooksRequeueDelay}
+}
+
+// auditDevices updates the VM's DevicesManaged condition with the devices
+// on the VM that are not represented in its spec or VM class, and removes
+// them, other than disks, if the policy is Strict. The devices of a VM are
+// audited at most once per deviceAuditInterval. Failing to audit the devices
+// does not fail the reconcile.
+func (r *Reconciler) auditDevices(ctx *pkgctx.VirtualMachineContext, policy string) {
+	// The VM has not been created yet.
+	if ctx.VM.Status.UniqueID == "" {
+		return
+	}
+
+	now := time.Now()
+	if last, ok := r.deviceAudits.Load(ctx.VM.UID); ok && now.Sub(last.(time.Time)) < deviceAuditInterval {
+		return
+	}
+
+	prune := policy == pkgcfg.DeviceDriftPolicyStrict
+
+	result, err := r.VMProvider.AuditVirtualMachineDevices(ctx, ctx.VM, prune)
+	if err != nil {
+		ctx.Logger.Error(err, "Failed to audit VM devices")
+		conditions.MarkFalse(
+			ctx.VM,
+			vmopv1.VirtualMachineConditionDevicesManaged,
+			vmopv1.VirtualMachineDevicesAuditFailedReason,
+			"%v", err)
+		return
+	}
+
+	r.deviceAudits.Store(ctx.VM.UID, now)
+
+	var removed string
+	if len(result.Removed) > 0 {
+		removed = fmt.Sprintf("Removed unmanaged devices: %s", strings.Join(result.Removed, ", "))
+	}
+
+	if len(result.Unmanaged) == 0 {
+		c := conditions.TrueCondition(vmopv1.VirtualMachineConditionDevicesManaged)
+		c.Message = removed
+		conditions.Set(ctx.VM, c)
+		return
+	}
+
+	msg := fmt.Sprintf("Unmanaged devices: %s", strings.Join(result.Unmanaged, ", "))
+	if removed != "" {
+		msg = removed + ". " + msg
+	}
+	conditions.MarkFalse(
+		ctx.VM,
+		vmopv1.VirtualMachineConditionDevicesManaged,
+		vmopv1.VirtualMachineUnmanagedDevicesFoundReason,
+		"%s", msg)
+}
+
 func getIsDefaultVMClassController(ctx context.Context) bool {
 	if v := pkgcfg.FromContext(ctx).DefaultVMClassControllerName; v == "" || v == vmClassControllerName {
 		return true
@@ -634,10 +908,108 @@ func (r *Reconciler) handleBlockingCreateErr(
 		}
 	}
 
+	// Exceeding the reconcile budget does not fail the create.
+	if errors.As(err, &ctxbudget.ExceededError{}) {
+		return err
+	}
+
 	r.Recorder.EmitEvent(ctx.VM, "Create", err, false)
 	return err
 }
 
+// checkMACAddresses updates the VM's MACAddressesUnique condition to reflect
+// whether any of the VM's MAC addresses are also used by another VM, in any
+// namespace. Duplicate MAC addresses otherwise only surface as hard to
+// diagnose networking issues inside the guests. Failing to check the MAC
+// addresses does not fail the reconcile.
+func (r *Reconciler) checkMACAddresses(ctx *pkgctx.VirtualMachineContext) {
+	macs := vmopv1util.GetMACAddresses(*ctx.VM)
+	if len(macs) == 0 {
+		conditions.Delete(ctx.VM, vmopv1.VirtualMachineConditionMACAddressesUnique)
+		return
+	}
+
+	duplicates, err := vmopv1util.GetDuplicateMACAddresses(ctx, r.Client, *ctx.VM, macs)
+	if err != nil {
+		ctx.Logger.Error(err, "Failed to check for duplicate MAC addresses")
+		return
+	}
+
+	if len(duplicates) == 0 {
+		conditions.MarkTrue(ctx.VM, vmopv1.VirtualMachineConditionMACAddressesUnique)
+		return
+	}
+
+	// The names of the other VMs are only logged since they may be in a
+	// namespace the VM's owner cannot access.
+	var dups []string
+	for _, mac := range macs {
+		if names, ok := duplicates[mac]; ok {
+			dups = append(dups, mac)
+			ctx.Logger.Info("MAC address is used by other VMs", "macAddr", mac, "vms", names)
+		}
+	}
+
+	// Emit an event when the duplicate MAC addresses are first detected.
+	if !conditions.IsFalse(ctx.VM, vmopv1.VirtualMachineConditionMACAddressesUnique) {
+		r.Recorder.Warnf(ctx.VM, vmopv1.VirtualMachineDuplicateMACAddressReason,
+			"Duplicate MAC addresses: %s", strings.Join(dups, "; "))
+	}
+
+	conditions.MarkFalse(
+		ctx.VM,
+		vmopv1.VirtualMachineConditionMACAddressesUnique,
+		vmopv1.VirtualMachineDuplicateMACAddressReason,
+		"Duplicate MAC addresses: %s",
+		strings.Join(dups, "; "))
+}
+
+// updateReconcileCompleteCondition records the progress made by the reconcile
+// if the reconcile budget was exceeded.
+func updateReconcileCompleteCondition(ctx *pkgctx.VirtualMachineContext, err error) {
+	var budgetErr ctxbudget.ExceededError
+	if errors.As(err, &budgetErr) {
+		ctx.Logger.Info("Reconcile budget exceeded", "completed", budgetErr.Completed)
+		conditions.MarkFalse(
+			ctx.VM,
+			vmopv1.VirtualMachineConditionReconcileComplete,
+			vmopv1.VirtualMachineReconcileBudgetExceededReason,
+			"Completed %s",
+			budgetErr.Completed)
+		return
+	}
+	if err == nil {
+		conditions.Delete(ctx.VM, vmopv1.VirtualMachineConditionReconcileComplete)
+	}
+}
+
+// registerChargebackMetrics reports the VM's chargeback metrics when there are
+// chargeback label keys.
+func (r *Reconciler) registerChargebackMetrics(ctx *pkgctx.VirtualMachineContext) {
+	keys := pkgcfg.StringToSlice(pkgcfg.FromContext(ctx).ChargebackLabelKeys)
+	if len(keys) == 0 {
+		return
+	}
+
+	className := ctx.VM.Spec.ClassName
+	if ctx.VM.Status.Class != nil {
+		className = ctx.VM.Status.Class.Name
+	}
+
+	var vmClass *vmopv1.VirtualMachineClass
+	if className != "" {
+		obj := &vmopv1.VirtualMachineClass{}
+		key := client.ObjectKey{Namespace: ctx.VM.Namespace, Name: className}
+		if err := r.Get(ctx, key, obj); err != nil {
+			ctx.Logger.V(5).Info("Failed to get VM class for chargeback metrics", "error", err)
+		} else {
+			vmClass = obj
+		}
+	}
+
+	r.vmMetrics.RegisterVMChargebackMetrics(ctx, keys, vmClass)
+}
+
 func (r *Reconciler) isVMICacheReady(ctx *pkgctx.VirtualMachineContext) bool {
 	vmicName := ctx.VM.Labels[pkgconst.VMICacheLabelKey]
 	if vmicName == "" {
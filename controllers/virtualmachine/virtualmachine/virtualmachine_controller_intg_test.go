@@ -17,6 +17,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/vmware/govmomi/object"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -26,6 +27,7 @@ import (
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachine/virtualmachine"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineservice"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
@@ -704,3 +706,199 @@ var _ = Describe(
 			})
 		})
 	})
+
+var _ = Describe(
+	"VSphereProvider",
+	Label(
+		testlabels.Controller,
+		testlabels.EnvTest,
+		testlabels.V1Alpha3,
+	), func() {
+
+		const (
+			vmName        = "my-vm-1"
+			vmServiceName = "my-vm-service-1"
+			vmIP          = "192.168.10.10"
+		)
+
+		var (
+			ctx      context.Context
+			vcSimCtx *builder.IntegrationTestContextForVCSim
+			vmClass  *vmopv1.VirtualMachineClass
+			selector = map[string]string{"vm-intg-test": "selector"}
+		)
+
+		BeforeEach(func() {
+			ctx = logr.NewContext(context.Background(), testutil.GinkgoLogr(4))
+			ctx = pkgcfg.WithContext(ctx, pkgcfg.Default())
+			ctx = pkgcfg.UpdateContext(
+				ctx,
+				func(config *pkgcfg.Config) {
+					config.AsyncCreateEnabled = false
+					config.AsyncSignalEnabled = false
+				},
+			)
+			ctx = cource.WithContext(ctx)
+			ctx = watcher.WithContext(ctx)
+			ctx = ovfcache.WithContext(ctx)
+
+			vcSimCtx = builder.NewIntegrationTestContextForVCSimWithProvider(
+				ctx,
+				builder.VCSimTestConfig{
+					WithContentLibrary: true,
+				},
+				func(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+					if err := vmwatcher.AddToManager(ctx, mgr); err != nil {
+						return err
+					}
+					if err := virtualmachineservice.AddToManager(ctx, mgr); err != nil {
+						return err
+					}
+					return virtualmachine.AddToManager(ctx, mgr)
+				},
+				func(ctx *builder.IntegrationTestContextForVCSim) {
+					vmClass = builder.DummyVirtualMachineClassGenName()
+					vmClass.Namespace = ctx.NSInfo.Namespace
+					Expect(ctx.Client.Create(ctx, vmClass)).To(Succeed())
+				})
+			Expect(vcSimCtx).ToNot(BeNil())
+
+			vcSimCtx.BeforeEach()
+		})
+
+		AfterEach(func() {
+			vcSimCtx.AfterEach()
+		})
+
+		Specify("vm should be created, get an IP, be selected by a service, and be deleted on vSphere", func() {
+			vmKey := client.ObjectKey{
+				Namespace: vcSimCtx.NSInfo.Namespace,
+				Name:      vmName,
+			}
+			vmServiceKey := client.ObjectKey{
+				Namespace: vcSimCtx.NSInfo.Namespace,
+				Name:      vmServiceName,
+			}
+
+			By("creating the vm in k8s", func() {
+				vm := builder.DummyBasicVirtualMachine(vmName, vmKey.Namespace)
+				vm.Labels = selector
+				vm.Spec.ClassName = vmClass.Name
+				vm.Spec.ImageName = vcSimCtx.ContentLibraryImageName
+				vm.Spec.Image = &vmopv1.VirtualMachineImageRef{
+					Kind: "ClusterVirtualMachineImage",
+					Name: vcSimCtx.ContentLibraryImageName,
+				}
+				vm.Spec.StorageClass = vcSimCtx.StorageClassName
+				vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+				vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+					Disabled: true,
+				}
+				Expect(vcSimCtx.Client.Create(vcSimCtx, vm)).To(Succeed())
+			})
+
+			var uniqueID string
+
+			By("waiting for the vm to be created on vSphere", func() {
+				Eventually(func(g Gomega) {
+					var obj vmopv1.VirtualMachine
+					g.Expect(vcSimCtx.Client.Get(vcSimCtx, vmKey, &obj)).To(Succeed())
+					g.Expect(obj.Status.UniqueID).ToNot(BeEmpty())
+					g.Expect(obj.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+					uniqueID = obj.Status.UniqueID
+				}, "30s").Should(Succeed())
+
+				Expect(vcSimCtx.GetVMFromMoID(uniqueID)).ToNot(BeNil())
+			})
+
+			By("assigning an IP to the vm's guest", func() {
+				// vcsim sets the guest's IP address from this extraConfig key.
+				t, err := vcSimCtx.GetVMFromMoID(uniqueID).Reconfigure(
+					vcSimCtx,
+					vimtypes.VirtualMachineConfigSpec{
+						ExtraConfig: []vimtypes.BaseOptionValue{
+							&vimtypes.OptionValue{
+								Key:   "SET.guest.ipAddress",
+								Value: vmIP,
+							},
+						},
+					})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(t.Wait(vcSimCtx)).To(Succeed())
+			})
+
+			By("waiting for the vm's status to have the IP", func() {
+				Eventually(func(g Gomega) {
+					var obj vmopv1.VirtualMachine
+					g.Expect(vcSimCtx.Client.Get(vcSimCtx, vmKey, &obj)).To(Succeed())
+					g.Expect(obj.Status.Network).ToNot(BeNil())
+					g.Expect(obj.Status.Network.PrimaryIP4).To(Equal(vmIP))
+				}, "30s").Should(Succeed())
+			})
+
+			By("creating a service that selects the vm", func() {
+				vmService := &vmopv1.VirtualMachineService{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      vmServiceKey.Name,
+						Namespace: vmServiceKey.Namespace,
+					},
+					Spec: vmopv1.VirtualMachineServiceSpec{
+						Type: vmopv1.VirtualMachineServiceTypeClusterIP,
+						Ports: []vmopv1.VirtualMachineServicePort{
+							{
+								Name:       "ssh",
+								Protocol:   "TCP",
+								Port:       22,
+								TargetPort: 22,
+							},
+						},
+						Selector: selector,
+					},
+				}
+				Expect(vcSimCtx.Client.Create(vcSimCtx, vmService)).To(Succeed())
+			})
+
+			By("waiting for the service's endpoints to have the vm's IP", func() {
+				Eventually(func(g Gomega) {
+					var service corev1.Service
+					g.Expect(vcSimCtx.Client.Get(vcSimCtx, vmServiceKey, &service)).To(Succeed())
+
+					var endpoints corev1.Endpoints
+					g.Expect(vcSimCtx.Client.Get(vcSimCtx, vmServiceKey, &endpoints)).To(Succeed())
+					g.Expect(endpoints.Subsets).To(HaveLen(1))
+					g.Expect(endpoints.Subsets[0].Addresses).To(HaveLen(1))
+					g.Expect(endpoints.Subsets[0].Addresses[0].IP).To(Equal(vmIP))
+					g.Expect(endpoints.Subsets[0].Addresses[0].TargetRef).ToNot(BeNil())
+					g.Expect(endpoints.Subsets[0].Addresses[0].TargetRef.Name).To(Equal(vmName))
+				}, "30s").Should(Succeed())
+			})
+
+			By("deleting the vm in k8s", func() {
+				var obj vmopv1.VirtualMachine
+				Expect(vcSimCtx.Client.Get(vcSimCtx, vmKey, &obj)).To(Succeed())
+				Expect(vcSimCtx.Client.Delete(vcSimCtx, &obj)).To(Succeed())
+			})
+
+			By("waiting for the vm to be deleted from vSphere", func() {
+				Eventually(func(g Gomega) {
+					var obj vmopv1.VirtualMachine
+					err := vcSimCtx.Client.Get(vcSimCtx, vmKey, &obj)
+					g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				}, "30s").Should(Succeed())
+
+				vmList, err := vcSimCtx.Finder.VirtualMachineList(vcSimCtx, "*")
+				Expect(err).ToNot(HaveOccurred())
+				for _, o := range vmList {
+					Expect(o.Reference().Value).ToNot(Equal(uniqueID))
+				}
+			})
+
+			By("waiting for the vm to be removed from the service's endpoints", func() {
+				Eventually(func(g Gomega) {
+					var endpoints corev1.Endpoints
+					g.Expect(vcSimCtx.Client.Get(vcSimCtx, vmServiceKey, &endpoints)).To(Succeed())
+					g.Expect(endpoints.Subsets).To(BeEmpty())
+				}, "30s").Should(Succeed())
+			})
+		})
+	})
@@ -8,23 +8,35 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachine/virtualmachine"
+	cnsv1alpha1 "github.com/vmware-tanzu/vm-operator/external/vsphere-csi-driver/pkg/syncer/cnsoperator/apis/cnsnodevmattachment/v1alpha1"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	ctxbudget "github.com/vmware-tanzu/vm-operator/pkg/context/budget"
 	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	proberfake "github.com/vmware-tanzu/vm-operator/pkg/prober/fake"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/deployhook"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -185,6 +197,449 @@ func unitTestsReconcile() {
 			})
 		})
 
+		Context("Device audit", func() {
+			var (
+				prunes  []bool
+				devices []string
+				disks   []string
+			)
+
+			BeforeEach(func() {
+				prunes = nil
+				devices = []string{"serialport-9000", "usb-xhci-15000"}
+				disks = nil
+				vm.Status.UniqueID = "vm-1"
+			})
+
+			JustBeforeEach(func() {
+				fakeVMProvider.AuditVirtualMachineDevicesFn = func(
+					_ context.Context,
+					_ *vmopv1.VirtualMachine,
+					prune bool) (providers.DeviceAuditResult, error) {
+
+					prunes = append(prunes, prune)
+					if prune {
+						return providers.DeviceAuditResult{Unmanaged: disks, Removed: devices}, nil
+					}
+					return providers.DeviceAuditResult{Unmanaged: append(devices, disks...)}, nil
+				}
+			})
+
+			It("does not audit the devices when there is no policy", func() {
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(prunes).To(BeEmpty())
+				Expect(conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionDevicesManaged)).To(BeNil())
+			})
+
+			When("the policy is Report", func() {
+				JustBeforeEach(func() {
+					pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+						config.DeviceDriftPolicy = pkgcfg.DeviceDriftPolicyReport
+					})
+				})
+
+				It("reports the unmanaged devices", func() {
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+					Expect(prunes).To(Equal([]bool{false}))
+
+					c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionDevicesManaged)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					Expect(c.Reason).To(Equal(vmopv1.VirtualMachineUnmanagedDevicesFoundReason))
+					Expect(c.Message).To(Equal("Unmanaged devices: serialport-9000, usb-xhci-15000"))
+				})
+
+				It("does not audit the devices again until the interval has elapsed", func() {
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+					Expect(prunes).To(Equal([]bool{false}))
+				})
+
+				It("marks the condition true when there are no unmanaged devices", func() {
+					devices = nil
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+					Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachineConditionDevicesManaged)).To(BeTrue())
+				})
+
+				It("does not audit the devices of a VM that has not been created", func() {
+					vm.Status.UniqueID = ""
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+					Expect(prunes).To(BeEmpty())
+				})
+
+				It("marks the condition false when the audit fails", func() {
+					fakeVMProvider.AuditVirtualMachineDevicesFn = func(
+						_ context.Context,
+						_ *vmopv1.VirtualMachine,
+						_ bool) (providers.DeviceAuditResult, error) {
+
+						prunes = append(prunes, false)
+						return providers.DeviceAuditResult{}, errors.New(providerError)
+					}
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+
+					c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionDevicesManaged)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDevicesAuditFailedReason))
+					Expect(c.Message).To(Equal(providerError))
+
+					By("auditing the devices again on the next reconcile", func() {
+						Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+						Expect(prunes).To(HaveLen(2))
+					})
+				})
+			})
+
+			When("the policy is Strict", func() {
+				JustBeforeEach(func() {
+					pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+						config.DeviceDriftPolicy = pkgcfg.DeviceDriftPolicyStrict
+					})
+				})
+
+				It("removes the unmanaged devices", func() {
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+					Expect(prunes).To(Equal([]bool{true}))
+
+					c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionDevicesManaged)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionTrue))
+					Expect(c.Message).To(Equal("Removed unmanaged devices: serialport-9000, usb-xhci-15000"))
+				})
+
+				When("there is an unmanaged raw disk", func() {
+					BeforeEach(func() {
+						disks = []string{"disk-1000-1"}
+					})
+
+					It("reports the raw disk since it is not removed", func() {
+						Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+
+						c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionDevicesManaged)
+						Expect(c).ToNot(BeNil())
+						Expect(c.Status).To(Equal(metav1.ConditionFalse))
+						Expect(c.Reason).To(Equal(vmopv1.VirtualMachineUnmanagedDevicesFoundReason))
+						Expect(c.Message).To(Equal("Removed unmanaged devices: serialport-9000, usb-xhci-15000. " +
+							"Unmanaged devices: disk-1000-1"))
+					})
+				})
+			})
+		})
+
+		Context("MAC addresses", func() {
+			var otherVM *vmopv1.VirtualMachine
+
+			BeforeEach(func() {
+				vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+					Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+						{
+							Name:    "eth0",
+							MACAddr: "00:50:56:2a:bb:cc",
+						},
+					},
+				}
+
+				otherVM = &vmopv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other-vm",
+						Namespace: "other-ns",
+					},
+				}
+			})
+
+			It("does not add the condition when the VM has no MAC addresses", func() {
+				vm.Spec.Network = nil
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionMACAddressesUnique)).To(BeNil())
+			})
+
+			It("marks the condition true when the MAC addresses are unique", func() {
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachineConditionMACAddressesUnique)).To(BeTrue())
+			})
+
+			When("another VM uses the same MAC address", func() {
+				BeforeEach(func() {
+					otherVM.Status.Network = &vmopv1.VirtualMachineNetworkStatus{
+						Interfaces: []vmopv1.VirtualMachineNetworkInterfaceStatus{
+							{
+								IP: &vmopv1.VirtualMachineNetworkInterfaceIPStatus{
+									MACAddr: "00:50:56:2A:BB:CC",
+								},
+							},
+						},
+					}
+					initObjects = append(initObjects, otherVM)
+				})
+
+				It("marks the condition false", func() {
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+
+					c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionMACAddressesUnique)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDuplicateMACAddressReason))
+					Expect(c.Message).To(Equal("Duplicate MAC addresses: 00:50:56:2a:bb:cc"))
+				})
+			})
+		})
+
+		Context("Deploy hooks", func() {
+			var (
+				hook    *vmopv1.VirtualMachineDeployHook
+				created bool
+			)
+
+			BeforeEach(func() {
+				created = false
+				hook = &vmopv1.VirtualMachineDeployHook{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-hook",
+						Namespace: vm.Namespace,
+					},
+					Spec: vmopv1.VirtualMachineDeployHookSpec{
+						Phase: vmopv1.VirtualMachineDeployHookPhasePreDeploy,
+						Job: &vmopv1.VirtualMachineDeployHookJob{
+							Image: "registry.example.com/hook:latest",
+						},
+					},
+				}
+				initObjects = append(initObjects, hook)
+			})
+
+			JustBeforeEach(func() {
+				pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+					config.Features.DeployHooks = true
+					config.DeployHookJobImages = hook.Spec.Job.Image
+				})
+				providerfake.SetCreateOrUpdateFunction(
+					vmCtx,
+					fakeVMProvider,
+					func(ctx context.Context, vm *vmopv1.VirtualMachine) error {
+						created = true
+						return nil
+					},
+				)
+			})
+
+			setJobCondition := func(t batchv1.JobConditionType) {
+				job := &batchv1.Job{}
+				key := client.ObjectKey{Namespace: vm.Namespace, Name: deployhook.JobName(*vm, *hook)}
+				Expect(ctx.Client.Get(ctx, key, job)).To(Succeed())
+				job.Status.Conditions = []batchv1.JobCondition{
+					{Type: t, Status: corev1.ConditionTrue},
+				}
+				Expect(ctx.Client.Status().Update(ctx, job)).To(Succeed())
+			}
+
+			It("does not run the hooks when deploy hooks are disabled", func() {
+				pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+					config.Features.DeployHooks = false
+				})
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(created).To(BeTrue())
+				Expect(conditions.Get(vmCtx.VM, vmopv1.VirtualMachinePreDeployHooksSucceededCondition)).To(BeNil())
+			})
+
+			It("does not deploy the VM until the PreDeploy hooks succeed", func() {
+				err := reconciler.ReconcileNormal(vmCtx)
+				Expect(err).To(MatchError(pkgerr.RequeueError{After: 10 * time.Second}))
+				Expect(created).To(BeFalse())
+
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachinePreDeployHooksSucceededCondition)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksPendingReason))
+
+				setJobCondition(batchv1.JobComplete)
+
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(created).To(BeTrue())
+				Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachinePreDeployHooksSucceededCondition)).To(BeTrue())
+			})
+
+			It("does not deploy the VM when a PreDeploy hook fails", func() {
+				Expect(reconciler.ReconcileNormal(vmCtx)).ToNot(Succeed())
+				setJobCondition(batchv1.JobFailed)
+
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(created).To(BeFalse())
+
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachinePreDeployHooksSucceededCondition)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksFailedReason))
+				expectEvents(ctx, "DeployHooksFailed")
+			})
+
+			When("the hook runs after first boot", func() {
+				BeforeEach(func() {
+					hook.Spec.Phase = vmopv1.VirtualMachineDeployHookPhasePostFirstBoot
+				})
+
+				It("records the hook when the VM is deployed", func() {
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+					Expect(created).To(BeTrue())
+					Expect(vmCtx.VM.Annotations).To(HaveKeyWithValue(vmopv1.PostFirstBootHooksAnnotation, hook.Name))
+				})
+
+				It("does not run the hook before first boot", func() {
+					vm.Status.UniqueID = "vm-1"
+					vm.Annotations[vmopv1.PostFirstBootHooksAnnotation] = hook.Name
+
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+					Expect(conditions.Get(vmCtx.VM, vmopv1.VirtualMachinePostFirstBootHooksSucceededCondition)).To(BeNil())
+				})
+
+				It("does not run the hook for a VM deployed before the hook was created", func() {
+					vm.Status.UniqueID = "vm-1"
+					vm.Annotations[vmopv1.FirstBootDoneAnnotation] = "true"
+
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+					Expect(conditions.Get(vmCtx.VM, vmopv1.VirtualMachinePostFirstBootHooksSucceededCondition)).To(BeNil())
+					Expect(conditions.Get(vmCtx.VM, vmopv1.ReadyConditionType)).To(BeNil())
+				})
+
+				It("gates the VM's Ready condition until the hook succeeds", func() {
+					vm.Status.UniqueID = "vm-1"
+					vm.Annotations[vmopv1.FirstBootDoneAnnotation] = "true"
+					vm.Annotations[vmopv1.PostFirstBootHooksAnnotation] = hook.Name
+
+					err := reconciler.ReconcileNormal(vmCtx)
+					Expect(err).To(MatchError(pkgerr.RequeueError{After: 10 * time.Second}))
+
+					c := conditions.Get(vmCtx.VM, vmopv1.ReadyConditionType)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksPendingReason))
+
+					setJobCondition(batchv1.JobComplete)
+
+					Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+					Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachinePostFirstBootHooksSucceededCondition)).To(BeTrue())
+					Expect(conditions.Get(vmCtx.VM, vmopv1.ReadyConditionType)).To(BeNil())
+				})
+			})
+		})
+
+		Context("Upgrade", func() {
+			const buildVersion = "v2"
+
+			JustBeforeEach(func() {
+				pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+					config.BuildVersion = buildVersion
+				})
+			})
+
+			It("normalizes a VM created by an older version once", func() {
+				vm.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+				vm.Status.UniqueID = "vm-42"
+				vm.Finalizers = append(vm.Finalizers, "virtualmachine.vmoperator.vmware.com")
+
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(vm.Annotations).To(HaveKeyWithValue(constants.UpgradedAtBuildVersionAnnotationKey, buildVersion))
+				Expect(vm.Annotations).To(HaveKeyWithValue(vmopv1.FirstBootDoneAnnotation, "true"))
+				Expect(vm.Finalizers).To(ConsistOf(finalizer))
+
+				delete(vm.Annotations, vmopv1.FirstBootDoneAnnotation)
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(vm.Annotations).ToNot(HaveKey(vmopv1.FirstBootDoneAnnotation))
+			})
+
+			It("does not record the first boot of a VM that was not deployed", func() {
+				vm.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+				vm.Status.UniqueID = ""
+
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(vm.Annotations).To(HaveKeyWithValue(constants.UpgradedAtBuildVersionAnnotationKey, buildVersion))
+				Expect(vm.Annotations).ToNot(HaveKey(vmopv1.FirstBootDoneAnnotation))
+			})
+
+			It("migrates the pause annotation used by older versions", func() {
+				vm.Annotations["vmoperator.vmware.com/pause-reconcile"] = "true"
+
+				var called bool
+				fakeVMProvider.CreateOrUpdateVirtualMachineFn = func(
+					_ context.Context, _ *vmopv1.VirtualMachine) error {
+
+					called = true
+					return nil
+				}
+
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(vm.Annotations).To(HaveKeyWithValue(vmopv1.PauseAnnotation, "true"))
+				Expect(vm.Annotations).ToNot(HaveKey("vmoperator.vmware.com/pause-reconcile"))
+				Expect(vm.Annotations).To(HaveKeyWithValue(constants.UpgradedAtBuildVersionAnnotationKey, buildVersion))
+				Expect(called).To(BeFalse(), "a paused VM must not be reconciled")
+			})
+
+			It("migrates the Cloud-Init instance ID annotation to the spec", func() {
+				vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+					CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+						InstanceID: "my-instance-id",
+					},
+				}
+				vm.Annotations[vmopv1.InstanceIDAnnotation] = "iid-datasource-none"
+
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(vm.Spec.Bootstrap.CloudInit.InstanceID).To(Equal("iid-datasource-none"))
+				Expect(vm.Annotations).ToNot(HaveKey(vmopv1.InstanceIDAnnotation))
+			})
+
+			It("does not normalize a VM created by the current version", func() {
+				vm.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+				vm.Annotations[constants.CreatedAtBuildVersionAnnotationKey] = buildVersion
+
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(vm.Annotations).ToNot(HaveKey(constants.UpgradedAtBuildVersionAnnotationKey))
+				Expect(vm.Annotations).ToNot(HaveKey(vmopv1.FirstBootDoneAnnotation))
+			})
+		})
+
+		Context("Reconcile budget", func() {
+			var providerErr error
+
+			BeforeEach(func() {
+				providerErr = ctxbudget.ExceededError{Completed: "create"}
+			})
+
+			JustBeforeEach(func() {
+				pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+					config.AsyncCreateEnabled = false
+					config.ReconcileBudget = time.Minute
+				})
+				providerfake.SetCreateOrUpdateFunction(
+					vmCtx,
+					fakeVMProvider,
+					func(ctx context.Context, vm *vmopv1.VirtualMachine) error {
+						ctxop.MarkCreate(ctx)
+						return providerErr
+					},
+				)
+			})
+
+			It("records the progress and requeues the VM when the budget is exceeded", func() {
+				err := reconciler.ReconcileNormal(vmCtx)
+				Expect(err).To(MatchError(pkgerr.RequeueError{}))
+				expectEvents(ctx)
+
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionReconcileComplete)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineReconcileBudgetExceededReason))
+				Expect(c.Message).To(Equal("Completed create"))
+			})
+
+			It("removes the condition when the reconcile completes", func() {
+				conditions.MarkFalse(
+					vmCtx.VM,
+					vmopv1.VirtualMachineConditionReconcileComplete,
+					vmopv1.VirtualMachineReconcileBudgetExceededReason,
+					"Completed create")
+				providerErr = nil
+				Expect(reconciler.ReconcileNormal(vmCtx)).To(Succeed())
+				Expect(conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionReconcileComplete)).To(BeNil())
+			})
+		})
+
 		When("blocking create", func() {
 			JustBeforeEach(func() {
 				pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
@@ -367,6 +822,153 @@ func unitTestsReconcile() {
 			Expect(reconciler.ReconcileDelete(vmCtx)).Should(Succeed())
 			Expect(fakeProbeManager.IsRemoveFromProberManagerCalled).Should(BeTrue())
 		})
+
+		When("the VM has CnsNodeVmAttachments", func() {
+			var (
+				attachment      *cnsv1alpha1.CnsNodeVmAttachment
+				otherAttachment *cnsv1alpha1.CnsNodeVmAttachment
+			)
+
+			BeforeEach(func() {
+				vm.UID = "dummy-vm-uid"
+
+				attachment = &cnsv1alpha1.CnsNodeVmAttachment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dummy-vm-my-pvc",
+						Namespace: vm.Namespace,
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion: vmopv1.GroupVersion.String(),
+								Kind:       "VirtualMachine",
+								Name:       vm.Name,
+								UID:        vm.UID,
+								Controller: ptr.To(true),
+							},
+						},
+					},
+				}
+				otherAttachment = &cnsv1alpha1.CnsNodeVmAttachment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other-vm-my-pvc",
+						Namespace: vm.Namespace,
+					},
+				}
+				initObjects = append(initObjects, attachment, otherAttachment)
+			})
+
+			It("detaches the volumes before deleting the VM", func() {
+				deleteCalled := false
+				fakeVMProvider.DeleteVirtualMachineFn = func(ctx context.Context, vm *vmopv1.VirtualMachine) error {
+					deleteCalled = true
+					return nil
+				}
+
+				err := reconciler.ReconcileDelete(vmCtx)
+				Expect(err).To(HaveOccurred())
+				var requeueErr pkgerr.RequeueError
+				Expect(errors.As(err, &requeueErr)).To(BeTrue())
+				Expect(requeueErr.After).ToNot(BeZero())
+				Expect(deleteCalled).To(BeFalse())
+				Expect(vm.GetFinalizers()).To(ContainElement(finalizer))
+
+				err = ctx.Client.Get(ctx, client.ObjectKeyFromObject(attachment), attachment)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(otherAttachment), otherAttachment)).To(Succeed())
+
+				Expect(reconciler.ReconcileDelete(vmCtx)).To(Succeed())
+				Expect(deleteCalled).To(BeTrue())
+				Expect(vm.GetFinalizers()).ToNot(ContainElement(finalizer))
+			})
+		})
+
+		When("the VM has volumes with a delete policy", func() {
+			var (
+				retainPVC *corev1.PersistentVolumeClaim
+				deletePVC *corev1.PersistentVolumeClaim
+			)
+
+			BeforeEach(func() {
+				retainPVC = &corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "retain-pvc",
+						Namespace: vm.Namespace,
+					},
+				}
+				deletePVC = &corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "delete-pvc",
+						Namespace: vm.Namespace,
+					},
+				}
+				initObjects = append(initObjects, retainPVC, deletePVC)
+
+				vm.Spec.Volumes = []vmopv1.VirtualMachineVolume{
+					{
+						Name: "retain-vol",
+						VirtualMachineVolumeSource: vmopv1.VirtualMachineVolumeSource{
+							PersistentVolumeClaim: &vmopv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: retainPVC.Name,
+								},
+								DeletePolicy: vmopv1.VirtualMachineVolumeDeletePolicyRetain,
+							},
+						},
+					},
+					{
+						Name: "delete-vol",
+						VirtualMachineVolumeSource: vmopv1.VirtualMachineVolumeSource{
+							PersistentVolumeClaim: &vmopv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: deletePVC.Name,
+								},
+								DeletePolicy: vmopv1.VirtualMachineVolumeDeletePolicyDelete,
+							},
+						},
+					},
+					{
+						Name: "missing-vol",
+						VirtualMachineVolumeSource: vmopv1.VirtualMachineVolumeSource{
+							PersistentVolumeClaim: &vmopv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: "missing-pvc",
+								},
+								DeletePolicy: vmopv1.VirtualMachineVolumeDeletePolicyDelete,
+							},
+						},
+					},
+				}
+			})
+
+			It("deletes only the PVCs with a Delete policy", func() {
+				Expect(reconciler.ReconcileDelete(vmCtx)).To(Succeed())
+				Expect(vm.GetFinalizers()).ToNot(ContainElement(finalizer))
+
+				Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(retainPVC), retainPVC)).To(Succeed())
+				err := ctx.Client.Get(ctx, client.ObjectKeyFromObject(deletePVC), deletePVC)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("does not delete the PVC of a volume with preventDelete set", func() {
+				vm.Spec.Volumes[1].PreventDelete = true
+
+				Expect(reconciler.ReconcileDelete(vmCtx)).To(Succeed())
+				Expect(vm.GetFinalizers()).ToNot(ContainElement(finalizer))
+				Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(deletePVC), deletePVC)).To(Succeed())
+			})
+
+			When("the VM fails to be deleted", func() {
+				JustBeforeEach(func() {
+					fakeVMProvider.DeleteVirtualMachineFn = func(ctx context.Context, vm *vmopv1.VirtualMachine) error {
+						return errors.New("delete failed")
+					}
+				})
+
+				It("does not delete the PVCs", func() {
+					Expect(reconciler.ReconcileDelete(vmCtx)).ToNot(Succeed())
+					Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(deletePVC), deletePVC)).To(Succeed())
+				})
+			})
+		})
 	})
 }
 
@@ -0,0 +1,148 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+)
+
+// upgradeNormalizer is a one-time normalization of a VM that was created by a
+// different build version of VM Operator.
+type upgradeNormalizer struct {
+	name string
+	fn   func(vm *vmopv1.VirtualMachine) bool
+}
+
+// upgradeNormalizers are run in order on VMs created by a different build
+// version. A normalizer must be idempotent since a VM that is upgraded more
+// than once is normalized again by each build version.
+var upgradeNormalizers = []upgradeNormalizer{
+	{
+		name: "removeDeprecatedFinalizer",
+		fn:   removeDeprecatedFinalizer,
+	},
+	{
+		name: "setFirstBootDone",
+		fn:   setFirstBootDone,
+	},
+	{
+		name: "migratePauseAnnotation",
+		fn:   migratePauseAnnotation,
+	},
+	{
+		name: "migrateCloudInitInstanceIDAnnotation",
+		fn:   migrateCloudInitInstanceIDAnnotation,
+	},
+}
+
+// upgradeVM runs the one-time normalization of a VM that was created by a
+// different build version, before the VM is reconciled by this version. The
+// build version that normalized the VM is recorded in an annotation so the VM
+// is only normalized once per build version.
+func upgradeVM(ctx *pkgctx.VirtualMachineContext) {
+	buildVersion := pkgcfg.FromContext(ctx).BuildVersion
+	if buildVersion == "" {
+		return
+	}
+
+	vm := ctx.VM
+	if vm.Annotations[pkgconst.CreatedAtBuildVersionAnnotationKey] == buildVersion ||
+		vm.Annotations[pkgconst.UpgradedAtBuildVersionAnnotationKey] == buildVersion {
+		return
+	}
+
+	for _, n := range upgradeNormalizers {
+		if n.fn(vm) {
+			ctx.Logger.Info("Normalized VM for upgrade",
+				"normalizer", n.name,
+				"createdAtBuildVersion", vm.Annotations[pkgconst.CreatedAtBuildVersionAnnotationKey],
+				"buildVersion", buildVersion)
+		}
+	}
+
+	if vm.Annotations == nil {
+		vm.Annotations = map[string]string{}
+	}
+	vm.Annotations[pkgconst.UpgradedAtBuildVersionAnnotationKey] = buildVersion
+}
+
+// removeDeprecatedFinalizer removes the finalizer used by older versions if
+// the VM also has the current finalizer.
+func removeDeprecatedFinalizer(vm *vmopv1.VirtualMachine) bool {
+	if !controllerutil.ContainsFinalizer(vm, finalizerName) {
+		// ReconcileNormal replaces the deprecated finalizer.
+		return false
+	}
+	return controllerutil.RemoveFinalizer(vm, deprecatedFinalizerName)
+}
+
+// setFirstBootDone records that a powered on VM has been booted. Versions
+// that predate the created-at annotations did not record the first boot.
+//
+// Only the VMs that were created before the upgrade are stamped. This assumes
+// that every VM created since the upgrade has the created-at annotations, which
+// are set on create by the mutation webhook, so a VM without them was created
+// by an older version. The VM must also have a non-empty Status.UniqueID, i.e.
+// it was deployed on vSphere, since its power state is otherwise not from a
+// VM that has been booted.
+func setFirstBootDone(vm *vmopv1.VirtualMachine) bool {
+	if _, ok := vm.Annotations[pkgconst.CreatedAtBuildVersionAnnotationKey]; ok {
+		return false
+	}
+	if vm.Status.UniqueID == "" {
+		return false
+	}
+	if vm.Status.PowerState != vmopv1.VirtualMachinePowerStateOn {
+		return false
+	}
+	if _, ok := vm.Annotations[vmopv1.FirstBootDoneAnnotation]; ok {
+		return false
+	}
+	if vm.Annotations == nil {
+		vm.Annotations = map[string]string{}
+	}
+	vm.Annotations[vmopv1.FirstBootDoneAnnotation] = "true"
+	return true
+}
+
+// migratePauseAnnotation replaces the pause annotation used by older versions
+// with the current pause annotation. The older annotation is renamed when the
+// VM is converted from an older API version, but not when the VM was written
+// with the annotation at the current API version, in which case the VM would
+// no longer be paused after the upgrade.
+func migratePauseAnnotation(vm *vmopv1.VirtualMachine) bool {
+	v, ok := vm.Annotations[vmopv1a1.PauseAnnotation]
+	if !ok {
+		return false
+	}
+	if _, ok := vm.Annotations[vmopv1.PauseAnnotation]; !ok {
+		vm.Annotations[vmopv1.PauseAnnotation] = v
+	}
+	delete(vm.Annotations, vmopv1a1.PauseAnnotation)
+	return true
+}
+
+// migrateCloudInitInstanceIDAnnotation moves the Cloud-Init instance ID from
+// the annotation used by older versions to spec.bootstrap.cloudInit.instanceID.
+// The annotation takes precedence over the field, which matches how the
+// instance ID is derived when the VM is bootstrapped. The annotation is
+// removed from VMs that do not use Cloud-Init, since it is never used.
+func migrateCloudInitInstanceIDAnnotation(vm *vmopv1.VirtualMachine) bool {
+	v, ok := vm.Annotations[vmopv1.InstanceIDAnnotation]
+	if !ok {
+		return false
+	}
+	if bs := vm.Spec.Bootstrap; bs != nil && bs.CloudInit != nil && v != "" {
+		bs.CloudInit.InstanceID = v
+	}
+	delete(vm.Annotations, vmopv1.InstanceIDAnnotation)
+	return true
+}
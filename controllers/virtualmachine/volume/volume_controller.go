@@ -40,6 +40,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	"github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
@@ -75,7 +76,7 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 	)
 
 	c, err := controller.New(controllerName, mgr, controller.Options{
-		Reconciler:              r,
+		Reconciler:              shard.NewReconciler(ctx, mgr.GetClient(), r),
 		MaxConcurrentReconciles: ctx.MaxConcurrentReconciles,
 	})
 	if err != nil {
@@ -92,6 +93,19 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		return err
 	}
 
+	// Watch for namespaces assigned to a different shard, and enqueue the
+	// VirtualMachines in the namespace.
+	if shard.IsEnabled(ctx) {
+		if err := c.Watch(source.Kind(
+			mgr.GetCache(),
+			client.Object(&corev1.Namespace{}),
+			shard.NamespaceHandler(mgr.GetClient(), &vmopv1.VirtualMachineList{}),
+		)); err != nil {
+
+			return err
+		}
+	}
+
 	// Watch for changes for CnsNodeVmAttachment, and enqueue VirtualMachine
 	// which is the owner of CnsNodeVmAttachment.
 	if err := c.Watch(source.Kind(
@@ -292,6 +306,12 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VolumeContext) error {
 		ctx.Logger.Info("Finished Reconciling VirtualMachine for processing volumes")
 	}()
 
+	// Attaching and detaching volumes results in CNS reconfiguring the VM.
+	if pkgcfg.FromContext(ctx).ObserverMode {
+		ctx.Logger.V(4).Info("Skipping volumes since observer mode is enabled")
+		return nil
+	}
+
 	if pkgcfg.FromContext(ctx).Features.InstanceStorage {
 		ready, err := r.reconcileInstanceStoragePVCs(ctx)
 		if err != nil || !ready {
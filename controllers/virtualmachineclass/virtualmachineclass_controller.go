@@ -21,6 +21,7 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/patch"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 )
 
 // AddToManager adds this package's controller to the provided manager.
@@ -40,10 +41,12 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
 	)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(controlledType).
-		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
-		Complete(r)
+		WithOptions(controller.Options{MaxConcurrentReconciles: 1})
+
+	return shard.WatchNamespaces(ctx, builder, mgr.GetClient(), &vmopv1.VirtualMachineClassList{}).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(), r))
 }
 
 func NewReconciler(
@@ -0,0 +1,635 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineexportrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/go-logr/logr"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+const (
+	finalizerName = "vmoperator.vmware.com/virtualmachineexportrequest"
+
+	// leaseRenewInterval is how often the export lease of an HTTP export is
+	// renewed. vSphere times out a lease that is not renewed within five
+	// minutes.
+	leaseRenewInterval = time.Minute
+
+	// exportTaskDescriptionID is the description ID of the vSphere task that
+	// exports a VM to a content library item.
+	exportTaskDescriptionID = "com.vmware.ovfs.LibraryItem.capture"
+
+	// exportTaskPollInterval is how often the task of an export to a content
+	// library item is checked while it is in progress.
+	exportTaskPollInterval = 30 * time.Second
+
+	// waitForTaskTimeout is how long to wait for the task of an export to a
+	// content library item to be registered before the export is retried.
+	waitForTaskTimeout = 30 * time.Second
+)
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineExportRequest{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		ctx.VMProvider,
+	)
+
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		WithOptions(controller.Options{MaxConcurrentReconciles: ctx.MaxConcurrentReconciles}).
+		Watches(&vmopv1.VirtualMachine{},
+			handler.EnqueueRequestsFromMapFunc(vmToVMExportMapperFn(ctx, r.Client)))
+
+	return shard.WatchNamespaces(ctx, builder, mgr.GetClient(), &vmopv1.VirtualMachineExportRequestList{}).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(),
+			metrics.NewSLOReconciler(controllerNameShort, r)))
+}
+
+// vmToVMExportMapperFn returns a mapper function that can be used to queue a
+// reconcile request for the VirtualMachineExportRequests in response to an
+// event on the VirtualMachine resource, ex. when the VM is powered off.
+func vmToVMExportMapperFn(ctx *pkgctx.ControllerManagerContext, c client.Client) func(_ context.Context, o client.Object) []reconcile.Request {
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		vm := o.(*vmopv1.VirtualMachine)
+
+		vmExportList := &vmopv1.VirtualMachineExportRequestList{}
+		if err := c.List(ctx, vmExportList, client.InNamespace(vm.Namespace)); err != nil {
+			ctx.Logger.Error(err, "Failed to list VirtualMachineExportRequests for reconciliation due to VirtualMachine watch",
+				"name", vm.Name, "namespace", vm.Namespace)
+			return nil
+		}
+
+		var reconcileRequests []reconcile.Request
+		for i := range vmExportList.Items {
+			vmExport := &vmExportList.Items[i]
+			if vmopv1util.ExportSourceName(vmExport) == vm.Name && !conditions.IsTrue(vmExport, vmopv1.VirtualMachineExportRequestConditionComplete) {
+				key := client.ObjectKey{Namespace: vmExport.Namespace, Name: vmExport.Name}
+				reconcileRequests = append(reconcileRequests, reconcile.Request{NamespacedName: key})
+			}
+		}
+
+		return reconcileRequests
+	}
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder,
+	vmProvider providers.VirtualMachineProviderInterface) *Reconciler {
+
+	return &Reconciler{
+		Context:    ctx,
+		Client:     client,
+		Logger:     logger,
+		Recorder:   recorder,
+		VMProvider: vmProvider,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineExportRequest object.
+type Reconciler struct {
+	client.Client
+	Context    context.Context
+	Logger     logr.Logger
+	Recorder   record.Recorder
+	VMProvider providers.VirtualMachineProviderInterface
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineexportrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineexportrequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=imageregistry.vmware.com,resources=contentlibraries,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	vmExportReq := &vmopv1.VirtualMachineExportRequest{}
+	if err := r.Get(ctx, req.NamespacedName, vmExportReq); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	vmExportCtx := &pkgctx.VirtualMachineExportRequestContext{
+		Context:         ctx,
+		Logger:          r.Logger.WithValues("name", req.NamespacedName),
+		VMExportRequest: vmExportReq,
+	}
+
+	patchHelper, err := patch.NewHelper(vmExportReq, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s/%s: %w", vmExportReq.Namespace, vmExportReq.Name, err)
+	}
+	// The patch is skipped when the status is updated by
+	// exportToContentLibrary.
+	defer func() {
+		if vmExportCtx.SkipPatch {
+			return
+		}
+
+		if err := patchHelper.Patch(ctx, vmExportReq); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			vmExportCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	if !vmExportReq.DeletionTimestamp.IsZero() {
+		return r.ReconcileDelete(vmExportCtx)
+	}
+
+	return r.ReconcileNormal(vmExportCtx)
+}
+
+func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineExportRequestContext) (ctrl.Result, error) {
+	ctx.Logger.Info("Reconciling VirtualMachineExportRequest")
+	vmExportReq := ctx.VMExportRequest
+
+	if !controllerutil.ContainsFinalizer(vmExportReq, finalizerName) {
+		// The finalizer must be present before proceeding in order to ensure
+		// that an export lease is released when the request is deleted.
+		controllerutil.AddFinalizer(vmExportReq, finalizerName)
+		return ctrl.Result{}, nil
+	}
+
+	if conditions.IsTrue(vmExportReq, vmopv1.VirtualMachineExportRequestConditionComplete) {
+		return r.removeVMExportResourceFromCluster(ctx)
+	}
+
+	if vmExportReq.Status.StartTime.IsZero() {
+		vmExportReq.Status.StartTime = metav1.Now()
+	}
+
+	if vmExportReq.Status.LeaseID != "" {
+		return r.renewDiskExport(ctx)
+	}
+
+	if err := r.checkIsSourceValid(ctx); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.checkIsTargetValid(ctx); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !conditions.IsTrue(vmExportReq, vmopv1.VirtualMachineExportRequestConditionTargetValid) {
+		// The target is invalid until the request's spec is changed.
+		return ctrl.Result{}, nil
+	}
+
+	if vmExportReq.Status.ItemID != "" {
+		// A prior attempt exported the VM to the content library item, which
+		// was found by checkIsTargetValid.
+		r.markExported(ctx)
+		return r.removeVMExportResourceFromCluster(ctx)
+	}
+
+	switch vmExportReq.Spec.Target.Type {
+	case vmopv1.VirtualMachineExportTargetTypeContentLibraryItem:
+		return r.exportToContentLibrary(ctx)
+	case vmopv1.VirtualMachineExportTargetTypeHTTP:
+		return r.startDiskExport(ctx)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) ReconcileDelete(ctx *pkgctx.VirtualMachineExportRequestContext) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(ctx.VMExportRequest, finalizerName) {
+		r.completeDiskExport(ctx)
+		controllerutil.RemoveFinalizer(ctx.VMExportRequest, finalizerName)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// checkIsSourceValid checks if the source VM is valid. It is invalid if the VM
+// resource does not exist, has not been created, or is not powered off.
+func (r *Reconciler) checkIsSourceValid(ctx *pkgctx.VirtualMachineExportRequestContext) error {
+	vmExportReq := ctx.VMExportRequest
+
+	vm := &vmopv1.VirtualMachine{}
+	objKey := client.ObjectKey{Name: vmopv1util.ExportSourceName(vmExportReq), Namespace: vmExportReq.Namespace}
+	if err := r.Get(ctx, objKey, vm); err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(vmExportReq,
+				vmopv1.VirtualMachineExportRequestConditionSourceValid,
+				vmopv1.SourceVirtualMachineNotExistReason,
+				err.Error())
+		}
+		return err
+	}
+	ctx.VM = vm
+
+	if vm.Status.UniqueID == "" {
+		err := errors.New("VM hasn't been created and has no uniqueID")
+		conditions.MarkFalse(vmExportReq,
+			vmopv1.VirtualMachineExportRequestConditionSourceValid,
+			vmopv1.SourceVirtualMachineNotCreatedReason,
+			err.Error())
+		return err
+	}
+
+	if vm.Status.PowerState != vmopv1.VirtualMachinePowerStateOff {
+		err := fmt.Errorf("VM is not powered off, power state is %q", vm.Status.PowerState)
+		conditions.MarkFalse(vmExportReq,
+			vmopv1.VirtualMachineExportRequestConditionSourceValid,
+			vmopv1.SourceVirtualMachineNotPoweredOffReason,
+			err.Error())
+		return err
+	}
+
+	conditions.MarkTrue(vmExportReq, vmopv1.VirtualMachineExportRequestConditionSourceValid)
+	return nil
+}
+
+// checkIsTargetValid checks if the target is valid. A content library item
+// target is invalid if the content library does not exist, is not writable or
+// ready, or already has an item with the same name. An error is not returned
+// when the target is invalid because of the request's spec.
+func (r *Reconciler) checkIsTargetValid(ctx *pkgctx.VirtualMachineExportRequestContext) error {
+	vmExportReq := ctx.VMExportRequest
+	target := vmExportReq.Spec.Target
+
+	if target.Type != vmopv1.VirtualMachineExportTargetTypeContentLibraryItem {
+		conditions.MarkTrue(vmExportReq, vmopv1.VirtualMachineExportRequestConditionTargetValid)
+		return nil
+	}
+
+	if target.ContentLibraryItem == nil || target.ContentLibraryItem.Library == "" {
+		conditions.MarkFalse(vmExportReq,
+			vmopv1.VirtualMachineExportRequestConditionTargetValid,
+			vmopv1.TargetInvalidReason,
+			"spec.target.contentLibraryItem.library is required when the target type is %s",
+			vmopv1.VirtualMachineExportTargetTypeContentLibraryItem)
+		return nil
+	}
+
+	contentLibrary := &imgregv1a1.ContentLibrary{}
+	objKey := client.ObjectKey{Name: target.ContentLibraryItem.Library, Namespace: vmExportReq.Namespace}
+	if err := r.Get(ctx, objKey, contentLibrary); err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(vmExportReq,
+				vmopv1.VirtualMachineExportRequestConditionTargetValid,
+				vmopv1.TargetContentLibraryNotExistReason,
+				err.Error())
+		}
+		return err
+	}
+
+	if !contentLibrary.Spec.Writable {
+		err := fmt.Errorf("target location %s is not writable", contentLibrary.Status.Name)
+		conditions.MarkFalse(vmExportReq,
+			vmopv1.VirtualMachineExportRequestConditionTargetValid,
+			vmopv1.TargetContentLibraryNotWritableReason,
+			err.Error())
+		return err
+	}
+
+	isReady := false
+	for _, condition := range contentLibrary.Status.Conditions {
+		if condition.Type == imgregv1a1.ReadyCondition {
+			isReady = condition.Status == corev1.ConditionTrue
+			break
+		}
+	}
+
+	if !isReady {
+		err := fmt.Errorf("target location %s is not ready", contentLibrary.Status.Name)
+		conditions.MarkFalse(vmExportReq,
+			vmopv1.VirtualMachineExportRequestConditionTargetValid,
+			vmopv1.TargetContentLibraryNotReadyReason,
+			err.Error())
+		return err
+	}
+
+	ctx.ContentLibrary = contentLibrary
+
+	name := vmopv1util.ExportItemName(vmExportReq)
+	item, err := r.VMProvider.GetItemFromLibraryByName(ctx, string(contentLibrary.Spec.UUID), name)
+	if err != nil {
+		return err
+	}
+
+	if item != nil {
+		// The item may have been created by a prior attempt of this request
+		// that failed to update the status.
+		if item.Description != nil && strings.Contains(*item.Description, string(vmExportReq.UID)) {
+			ctx.Logger.Info("Existing target item was exported by this request", "itemID", item.ID)
+			vmExportReq.Status.ItemID = item.ID
+			conditions.MarkTrue(vmExportReq, vmopv1.VirtualMachineExportRequestConditionTargetValid)
+			return nil
+		}
+
+		conditions.MarkFalse(vmExportReq,
+			vmopv1.VirtualMachineExportRequestConditionTargetValid,
+			vmopv1.TargetItemAlreadyExistsReason,
+			"item with name %s already exists in the content library %s",
+			name, contentLibrary.Status.Name)
+		return nil
+	}
+
+	conditions.MarkTrue(vmExportReq, vmopv1.VirtualMachineExportRequestConditionTargetValid)
+	return nil
+}
+
+// exportToContentLibrary exports the VM to the content library item. Since
+// the export may take a long time, it is run in the background like a
+// publish, and its progress is tracked with the vSphere task that has the
+// attempt's activation ID. An export is only retried after checkIsTargetValid
+// did not find an item exported by this request.
+func (r *Reconciler) exportToContentLibrary(ctx *pkgctx.VirtualMachineExportRequestContext) (ctrl.Result, error) {
+	vmExportReq := ctx.VMExportRequest
+
+	if vmExportReq.Status.Attempts > 0 {
+		result, retry, err := r.checkExportTask(ctx)
+		if err != nil || !retry {
+			return result, err
+		}
+	}
+
+	vmExportReq.Status.Attempts++
+	vmExportReq.Status.LastAttemptTime = metav1.Now()
+	conditions.MarkFalse(vmExportReq,
+		vmopv1.VirtualMachineExportRequestConditionExported,
+		vmopv1.UploadTaskNotStartedReason,
+		"The export task has not started")
+
+	// Update the status instead of patching it so a stale object results in
+	// a conflict. Otherwise, the same number of attempts may be recorded more
+	// than once, and multiple exports sent with the same activation ID.
+	ctx.SkipPatch = true
+	if err := r.Client.Status().Update(ctx, vmExportReq); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	actID := exportActID(vmExportReq)
+	vmExportReq = vmExportReq.DeepCopy()
+	vm, contentLibrary := ctx.VM, ctx.ContentLibrary
+
+	go func() {
+		itemID, err := r.VMProvider.ExportVirtualMachineToContentLibrary(ctx, vm, vmExportReq, contentLibrary, actID)
+		if err != nil {
+			ctx.Logger.Error(err, "failed to export VM", "actID", actID)
+		} else {
+			ctx.Logger.Info("Exported VM to content library item", "itemID", itemID, "actID", actID)
+		}
+		r.Recorder.EmitEvent(vmExportReq, "Export", err, false)
+	}()
+
+	return ctrl.Result{RequeueAfter: exportTaskPollInterval}, nil
+}
+
+// checkExportTask checks the task of the latest attempt to export the VM to
+// the content library item, and returns whether the export should be retried.
+func (r *Reconciler) checkExportTask(ctx *pkgctx.VirtualMachineExportRequestContext) (ctrl.Result, bool, error) {
+	vmExportReq := ctx.VMExportRequest
+	actID := exportActID(vmExportReq)
+	logger := ctx.Logger.WithValues("actID", actID)
+
+	tasks, err := r.VMProvider.GetTasksByActID(ctx, actID)
+	if err != nil {
+		return ctrl.Result{}, false, fmt.Errorf("failed to get export task: %w", err)
+	}
+
+	var task *vimtypes.TaskInfo
+	for i := range tasks {
+		if tasks[i].DescriptionId == exportTaskDescriptionID {
+			task = &tasks[i]
+			break
+		}
+	}
+
+	if task == nil {
+		// The task may not be registered yet, so only retry the export once
+		// it has had time to be registered.
+		if remaining := waitForTaskTimeout - time.Since(vmExportReq.Status.LastAttemptTime.Time); remaining > 0 {
+			logger.V(5).Info("Export task has not started")
+			return ctrl.Result{RequeueAfter: remaining}, false, nil
+		}
+		logger.Info("Export task was not created, retrying export")
+		return ctrl.Result{}, true, nil
+	}
+
+	logger = logger.WithValues("task", task.Task.Value)
+
+	switch task.State {
+	case vimtypes.TaskInfoStateQueued:
+		conditions.MarkFalse(vmExportReq,
+			vmopv1.VirtualMachineExportRequestConditionExported,
+			vmopv1.UploadTaskQueuedReason,
+			"The export task %s is queued", task.Task.Value)
+		return ctrl.Result{RequeueAfter: exportTaskPollInterval}, false, nil
+
+	case vimtypes.TaskInfoStateRunning:
+		conditions.MarkFalse(vmExportReq,
+			vmopv1.VirtualMachineExportRequestConditionExported,
+			vmopv1.UploadingReason,
+			"Exporting to content library item, task %s is %d%% complete", task.Task.Value, task.Progress)
+		return ctrl.Result{RequeueAfter: exportTaskPollInterval}, false, nil
+
+	case vimtypes.TaskInfoStateSuccess:
+		itemRef, ok := task.Result.(vimtypes.ManagedObjectReference)
+		if !ok || itemRef.Value == "" {
+			// The task's result does not change, so do not retry.
+			r.markExportFailed(ctx, fmt.Errorf("export task %s has an invalid result: %v", task.Task.Value, task.Result))
+			return ctrl.Result{}, false, nil
+		}
+
+		logger.Info("Exported VM to content library item", "itemID", itemRef.Value)
+		vmExportReq.Status.ItemID = itemRef.Value
+		r.markExported(ctx)
+		result, err := r.removeVMExportResourceFromCluster(ctx)
+		return result, false, err
+
+	case vimtypes.TaskInfoStateError:
+		msg := "failed to export VM"
+		if task.Error != nil {
+			msg = task.Error.LocalizedMessage
+		}
+		r.markExportFailed(ctx, errors.New(msg))
+		logger.Info("Export task failed, retrying export")
+		return ctrl.Result{}, true, nil
+	}
+
+	return ctrl.Result{RequeueAfter: exportTaskPollInterval}, false, nil
+}
+
+func (r *Reconciler) startDiskExport(ctx *pkgctx.VirtualMachineExportRequestContext) (ctrl.Result, error) {
+	vmExportReq := ctx.VMExportRequest
+
+	leaseID, files, err := r.VMProvider.StartVirtualMachineDiskExport(ctx, ctx.VM)
+	if err != nil {
+		r.markExportFailed(ctx, err)
+		return ctrl.Result{}, err
+	}
+
+	ttl := urlTTL(vmExportReq)
+	expirationTime := metav1.NewTime(time.Now().Add(ttl))
+
+	ctx.Logger.Info("Started VM disk export", "leaseID", leaseID, "expirationTime", expirationTime)
+	vmExportReq.Status.LeaseID = leaseID
+	vmExportReq.Status.Files = files
+	vmExportReq.Status.ExpirationTime = &expirationTime
+	vmExportReq.Status.Ready = true
+	conditions.MarkTrue(vmExportReq, vmopv1.VirtualMachineExportRequestConditionExported)
+	r.Recorder.EmitEvent(vmExportReq, "Export", nil, false)
+
+	return ctrl.Result{RequeueAfter: min(leaseRenewInterval, ttl)}, nil
+}
+
+// renewDiskExport renews the export lease until the URLs expire, after which
+// the lease is released and the request is complete.
+func (r *Reconciler) renewDiskExport(ctx *pkgctx.VirtualMachineExportRequestContext) (ctrl.Result, error) {
+	vmExportReq := ctx.VMExportRequest
+
+	var remaining time.Duration
+	if t := vmExportReq.Status.ExpirationTime; t != nil {
+		remaining = time.Until(t.Time)
+	}
+
+	if remaining <= 0 {
+		ctx.Logger.Info("VM disk export URLs expired", "leaseID", vmExportReq.Status.LeaseID)
+		r.completeDiskExport(ctx)
+		conditions.MarkFalse(vmExportReq,
+			vmopv1.VirtualMachineExportRequestConditionExported,
+			vmopv1.ExportURLsExpiredReason,
+			"The download URLs have expired")
+		r.markComplete(ctx)
+		return r.removeVMExportResourceFromCluster(ctx)
+	}
+
+	if err := r.VMProvider.RenewVirtualMachineDiskExport(ctx, vmExportReq.Status.LeaseID); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to renew export lease: %w", err)
+	}
+
+	return ctrl.Result{RequeueAfter: min(leaseRenewInterval, remaining)}, nil
+}
+
+// completeDiskExport releases the export lease, if any. An error releasing the
+// lease is not returned since the lease times out once it is no longer renewed.
+func (r *Reconciler) completeDiskExport(ctx *pkgctx.VirtualMachineExportRequestContext) {
+	vmExportReq := ctx.VMExportRequest
+
+	if leaseID := vmExportReq.Status.LeaseID; leaseID != "" {
+		if err := r.VMProvider.CompleteVirtualMachineDiskExport(ctx, leaseID); err != nil {
+			ctx.Logger.Error(err, "failed to complete export lease", "leaseID", leaseID)
+		}
+	}
+
+	vmExportReq.Status.LeaseID = ""
+	vmExportReq.Status.Files = nil
+	vmExportReq.Status.Ready = false
+}
+
+func (r *Reconciler) markExported(ctx *pkgctx.VirtualMachineExportRequestContext) {
+	ctx.VMExportRequest.Status.Ready = true
+	conditions.MarkTrue(ctx.VMExportRequest, vmopv1.VirtualMachineExportRequestConditionExported)
+	r.markComplete(ctx)
+}
+
+func (r *Reconciler) markExportFailed(ctx *pkgctx.VirtualMachineExportRequestContext, err error) {
+	ctx.Logger.Error(err, "failed to export VM")
+	conditions.MarkFalse(ctx.VMExportRequest,
+		vmopv1.VirtualMachineExportRequestConditionExported,
+		vmopv1.ExportFailureReason,
+		err.Error())
+	r.Recorder.EmitEvent(ctx.VMExportRequest, "Export", err, false)
+}
+
+func (r *Reconciler) markComplete(ctx *pkgctx.VirtualMachineExportRequestContext) {
+	conditions.MarkTrue(ctx.VMExportRequest, vmopv1.VirtualMachineExportRequestConditionComplete)
+	ctx.VMExportRequest.Status.CompletionTime = metav1.Now()
+}
+
+// removeVMExportResourceFromCluster deletes the request once its
+// TTLSecondsAfterFinished has elapsed.
+func (r *Reconciler) removeVMExportResourceFromCluster(ctx *pkgctx.VirtualMachineExportRequestContext) (ctrl.Result, error) {
+	vmExportReq := ctx.VMExportRequest
+
+	ttlSecondsAfterFinished := vmExportReq.Spec.TTLSecondsAfterFinished
+	if ttlSecondsAfterFinished == nil {
+		// Skip auto clean up.
+		return ctrl.Result{}, nil
+	}
+
+	if vmExportReq.Status.CompletionTime.IsZero() {
+		vmExportReq.Status.CompletionTime = metav1.Now()
+	}
+
+	if *ttlSecondsAfterFinished > 0 {
+		targetTime := vmExportReq.Status.CompletionTime.Add(time.Duration(*ttlSecondsAfterFinished) * time.Second)
+		if remaining := time.Until(targetTime); remaining > 0 {
+			return ctrl.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	ctx.Logger.Info("Deleting VM Export Request")
+	if err := r.Delete(ctx, vmExportReq); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// exportActID returns the activation ID of the latest attempt to export the
+// VM to the content library item. Like for publish, the number of attempts is
+// appended to the request's UID so each attempt has its own task.
+func exportActID(vmExportReq *vmopv1.VirtualMachineExportRequest) string {
+	return fmt.Sprintf("%s-%d", string(vmExportReq.UID), vmExportReq.Status.Attempts)
+}
+
+// urlTTL returns how long the download URLs of an HTTP export are valid.
+func urlTTL(vmExportReq *vmopv1.VirtualMachineExportRequest) time.Duration {
+	seconds := int64(vmopv1.VirtualMachineExportRequestDefaultURLTTLSeconds)
+	if h := vmExportReq.Spec.Target.HTTP; h != nil && h.URLTTLSeconds != nil {
+		seconds = *h.URLTTLSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,506 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineexportrequest_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware/govmomi/vapi/library"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineexportrequest"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+const finalizerName = "vmoperator.vmware.com/virtualmachineexportrequest"
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.V1Alpha3,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler     *virtualmachineexportrequest.Reconciler
+		fakeVMProvider *providerfake.VMProvider
+
+		vm          *vmopv1.VirtualMachine
+		vmExport    *vmopv1.VirtualMachineExportRequest
+		cl          *imgregv1a1.ContentLibrary
+		vmExportCtx *pkgctx.VirtualMachineExportRequestContext
+	)
+
+	BeforeEach(func() {
+		vm = &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dummy-vm",
+				Namespace: "dummy-ns",
+			},
+			Status: vmopv1.VirtualMachineStatus{
+				UniqueID:   "dummy-id",
+				PowerState: vmopv1.VirtualMachinePowerStateOff,
+			},
+		}
+
+		vmExport = &vmopv1.VirtualMachineExportRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "dummy-vmexport",
+				Namespace:  vm.Namespace,
+				UID:        "dummy-uid",
+				Finalizers: []string{finalizerName},
+			},
+			Spec: vmopv1.VirtualMachineExportRequestSpec{
+				Source: vmopv1.VirtualMachineExportRequestSource{
+					Name: vm.Name,
+				},
+				Target: vmopv1.VirtualMachineExportRequestTarget{
+					Type: vmopv1.VirtualMachineExportTargetTypeHTTP,
+				},
+			},
+		}
+
+		cl = builder.DummyContentLibrary("dummy-cl", vm.Namespace, "dummy-cl-id")
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachineexportrequest.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+			ctx.VMProvider,
+		)
+		fakeVMProvider = ctx.VMProvider.(*providerfake.VMProvider)
+
+		vmExportCtx = &pkgctx.VirtualMachineExportRequestContext{
+			Context:         ctx,
+			Logger:          ctx.Logger.WithName(vmExport.Name),
+			VMExportRequest: vmExport,
+		}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+	})
+
+	Context("ReconcileNormal", func() {
+		BeforeEach(func() {
+			initObjects = append(initObjects, cl, vm, vmExport)
+		})
+
+		When("object does not have finalizer set", func() {
+			BeforeEach(func() {
+				vmExport.Finalizers = nil
+			})
+
+			It("will set finalizer", func() {
+				_, err := reconciler.ReconcileNormal(vmExportCtx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vmExport.GetFinalizers()).To(ContainElement(finalizerName))
+				Expect(vmExport.Status.Conditions).To(BeEmpty())
+			})
+		})
+
+		When("the source VM does not exist", func() {
+			BeforeEach(func() {
+				vmExport.Spec.Source.Name = "other-vm"
+			})
+
+			It("marks the source invalid", func() {
+				_, err := reconciler.ReconcileNormal(vmExportCtx)
+				Expect(err).To(HaveOccurred())
+				Expect(conditions.GetReason(vmExport, vmopv1.VirtualMachineExportRequestConditionSourceValid)).
+					To(Equal(vmopv1.SourceVirtualMachineNotExistReason))
+			})
+		})
+
+		When("the source VM is powered on", func() {
+			BeforeEach(func() {
+				vm.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+			})
+
+			It("marks the source invalid", func() {
+				_, err := reconciler.ReconcileNormal(vmExportCtx)
+				Expect(err).To(HaveOccurred())
+				Expect(conditions.GetReason(vmExport, vmopv1.VirtualMachineExportRequestConditionSourceValid)).
+					To(Equal(vmopv1.SourceVirtualMachineNotPoweredOffReason))
+				Expect(vmExport.Status.LeaseID).To(BeEmpty())
+			})
+		})
+
+		Context("HTTP target", func() {
+			It("starts the disk export and reports the files", func() {
+				result, err := reconciler.ReconcileNormal(vmExportCtx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+				Expect(vmExport.Status.StartTime.IsZero()).To(BeFalse())
+				Expect(vmExport.Status.LeaseID).To(Equal("dummy-lease"))
+				Expect(vmExport.Status.Files).To(HaveLen(1))
+				Expect(vmExport.Status.Files[0].Name).To(Equal("disk-0.vmdk"))
+				Expect(vmExport.Status.ExpirationTime).ToNot(BeNil())
+				Expect(vmExport.Status.ExpirationTime.Time).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+				Expect(vmExport.Status.Ready).To(BeTrue())
+				Expect(conditions.IsTrue(vmExport, vmopv1.VirtualMachineExportRequestConditionSourceValid)).To(BeTrue())
+				Expect(conditions.IsTrue(vmExport, vmopv1.VirtualMachineExportRequestConditionTargetValid)).To(BeTrue())
+				Expect(conditions.IsTrue(vmExport, vmopv1.VirtualMachineExportRequestConditionExported)).To(BeTrue())
+				Expect(conditions.Has(vmExport, vmopv1.VirtualMachineExportRequestConditionComplete)).To(BeFalse())
+			})
+
+			When("the export fails", func() {
+				JustBeforeEach(func() {
+					fakeVMProvider.StartVirtualMachineDiskExportFn = func(
+						_ context.Context,
+						_ *vmopv1.VirtualMachine) (string, []vmopv1.VirtualMachineExportRequestFile, error) {
+
+						return "", nil, errors.New("export error")
+					}
+				})
+
+				It("marks the export failed", func() {
+					_, err := reconciler.ReconcileNormal(vmExportCtx)
+					Expect(err).To(HaveOccurred())
+					Expect(conditions.GetReason(vmExport, vmopv1.VirtualMachineExportRequestConditionExported)).
+						To(Equal(vmopv1.ExportFailureReason))
+					Expect(vmExport.Status.Ready).To(BeFalse())
+				})
+			})
+
+			When("the lease is held", func() {
+				var renewed, completed bool
+
+				BeforeEach(func() {
+					renewed, completed = false, false
+					vmExport.Status.LeaseID = "dummy-lease"
+					vmExport.Status.Files = []vmopv1.VirtualMachineExportRequestFile{{Name: "disk-0.vmdk"}}
+					vmExport.Status.Ready = true
+					conditions.MarkTrue(vmExport, vmopv1.VirtualMachineExportRequestConditionExported)
+				})
+
+				JustBeforeEach(func() {
+					fakeVMProvider.RenewVirtualMachineDiskExportFn = func(_ context.Context, leaseID string) error {
+						Expect(leaseID).To(Equal("dummy-lease"))
+						renewed = true
+						return nil
+					}
+					fakeVMProvider.CompleteVirtualMachineDiskExportFn = func(_ context.Context, leaseID string) error {
+						Expect(leaseID).To(Equal("dummy-lease"))
+						completed = true
+						return nil
+					}
+				})
+
+				When("the URLs have not expired", func() {
+					BeforeEach(func() {
+						vmExport.Status.ExpirationTime = ptr.To(metav1.NewTime(time.Now().Add(time.Hour)))
+					})
+
+					It("renews the lease", func() {
+						result, err := reconciler.ReconcileNormal(vmExportCtx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result.RequeueAfter).To(Equal(time.Minute))
+						Expect(renewed).To(BeTrue())
+						Expect(completed).To(BeFalse())
+						Expect(vmExport.Status.LeaseID).To(Equal("dummy-lease"))
+					})
+				})
+
+				When("the URLs have expired", func() {
+					BeforeEach(func() {
+						vmExport.Status.ExpirationTime = ptr.To(metav1.NewTime(time.Now().Add(-time.Second)))
+					})
+
+					It("completes the lease and the request", func() {
+						_, err := reconciler.ReconcileNormal(vmExportCtx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(renewed).To(BeFalse())
+						Expect(completed).To(BeTrue())
+						Expect(vmExport.Status.LeaseID).To(BeEmpty())
+						Expect(vmExport.Status.Files).To(BeEmpty())
+						Expect(vmExport.Status.Ready).To(BeFalse())
+						Expect(conditions.GetReason(vmExport, vmopv1.VirtualMachineExportRequestConditionExported)).
+							To(Equal(vmopv1.ExportURLsExpiredReason))
+						Expect(conditions.IsTrue(vmExport, vmopv1.VirtualMachineExportRequestConditionComplete)).To(BeTrue())
+						Expect(vmExport.Status.CompletionTime.IsZero()).To(BeFalse())
+					})
+				})
+			})
+		})
+
+		Context("ContentLibraryItem target", func() {
+			BeforeEach(func() {
+				vmExport.Spec.Target = vmopv1.VirtualMachineExportRequestTarget{
+					Type: vmopv1.VirtualMachineExportTargetTypeContentLibraryItem,
+					ContentLibraryItem: &vmopv1.VirtualMachineExportRequestContentLibraryItemTarget{
+						Library: cl.Name,
+					},
+				}
+			})
+
+			It("starts the export to the content library item", func() {
+				var itemName string
+				fakeVMProvider.GetItemFromLibraryByNameFn = func(_ context.Context, _, name string) (*library.Item, error) {
+					itemName = name
+					return nil, nil
+				}
+				actIDs := make(chan string, 1)
+				fakeVMProvider.ExportVirtualMachineToContentLibraryFn = func(
+					_ context.Context,
+					_ *vmopv1.VirtualMachine,
+					_ *vmopv1.VirtualMachineExportRequest,
+					_ *imgregv1a1.ContentLibrary,
+					actID string) (string, error) {
+
+					actIDs <- actID
+					return "dummy-id", nil
+				}
+
+				result, err := reconciler.ReconcileNormal(vmExportCtx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).ToNot(BeZero())
+				Expect(itemName).To(Equal(vm.Name + "-export"))
+				Expect(vmExport.Status.Attempts).To(BeEquivalentTo(1))
+				Expect(vmExport.Status.ItemID).To(BeEmpty())
+				Expect(conditions.GetReason(vmExport, vmopv1.VirtualMachineExportRequestConditionExported)).
+					To(Equal(vmopv1.UploadTaskNotStartedReason))
+				Eventually(actIDs).Should(Receive(Equal("dummy-uid-1")))
+			})
+
+			When("an export was attempted", func() {
+				var (
+					exportCalls int
+					task        vimtypes.TaskInfo
+				)
+
+				BeforeEach(func() {
+					exportCalls = 0
+					vmExport.Status.Attempts = 1
+					vmExport.Status.LastAttemptTime = metav1.Now()
+					task = vimtypes.TaskInfo{
+						Task:          vimtypes.ManagedObjectReference{Type: "Task", Value: "task-1"},
+						DescriptionId: "com.vmware.ovfs.LibraryItem.capture",
+						ActivationId:  "dummy-uid-1",
+					}
+				})
+
+				JustBeforeEach(func() {
+					fakeVMProvider.GetTasksByActIDFn = func(_ context.Context, actID string) ([]vimtypes.TaskInfo, error) {
+						if actID != task.ActivationId || task.State == "" {
+							return nil, nil
+						}
+						return []vimtypes.TaskInfo{task}, nil
+					}
+					fakeVMProvider.ExportVirtualMachineToContentLibraryFn = func(
+						_ context.Context,
+						_ *vmopv1.VirtualMachine,
+						_ *vmopv1.VirtualMachineExportRequest,
+						_ *imgregv1a1.ContentLibrary,
+						_ string) (string, error) {
+
+						exportCalls++
+						return "", nil
+					}
+				})
+
+				When("the task has not been registered", func() {
+					It("waits for the task", func() {
+						result, err := reconciler.ReconcileNormal(vmExportCtx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result.RequeueAfter).ToNot(BeZero())
+						Expect(vmExport.Status.Attempts).To(BeEquivalentTo(1))
+					})
+				})
+
+				When("the task is running", func() {
+					BeforeEach(func() {
+						task.State = vimtypes.TaskInfoStateRunning
+						task.Progress = 50
+					})
+
+					It("reports the progress", func() {
+						result, err := reconciler.ReconcileNormal(vmExportCtx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result.RequeueAfter).ToNot(BeZero())
+						Expect(vmExport.Status.Attempts).To(BeEquivalentTo(1))
+						Expect(conditions.GetReason(vmExport, vmopv1.VirtualMachineExportRequestConditionExported)).
+							To(Equal(vmopv1.UploadingReason))
+						Expect(conditions.GetMessage(vmExport, vmopv1.VirtualMachineExportRequestConditionExported)).
+							To(ContainSubstring("50%"))
+					})
+				})
+
+				When("the task succeeded", func() {
+					BeforeEach(func() {
+						task.State = vimtypes.TaskInfoStateSuccess
+						task.Result = vimtypes.ManagedObjectReference{Type: "ContentLibraryItem", Value: "exported-id"}
+					})
+
+					It("completes the request with the exported item", func() {
+						_, err := reconciler.ReconcileNormal(vmExportCtx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(vmExport.Status.ItemID).To(Equal("exported-id"))
+						Expect(vmExport.Status.Ready).To(BeTrue())
+						Expect(conditions.IsTrue(vmExport, vmopv1.VirtualMachineExportRequestConditionExported)).To(BeTrue())
+						Expect(conditions.IsTrue(vmExport, vmopv1.VirtualMachineExportRequestConditionComplete)).To(BeTrue())
+					})
+				})
+
+				When("the task failed", func() {
+					BeforeEach(func() {
+						task.State = vimtypes.TaskInfoStateError
+						task.Error = &vimtypes.LocalizedMethodFault{LocalizedMessage: "dummy error"}
+					})
+
+					It("retries the export with a new activation ID", func() {
+						_, err := reconciler.ReconcileNormal(vmExportCtx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(vmExport.Status.Attempts).To(BeEquivalentTo(2))
+						Eventually(func() int {
+							fakeVMProvider.Lock()
+							defer fakeVMProvider.Unlock()
+							return exportCalls
+						}).Should(Equal(1))
+					})
+				})
+			})
+
+			When("the library is not specified", func() {
+				BeforeEach(func() {
+					vmExport.Spec.Target.ContentLibraryItem = nil
+				})
+
+				It("marks the target invalid", func() {
+					_, err := reconciler.ReconcileNormal(vmExportCtx)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(conditions.GetReason(vmExport, vmopv1.VirtualMachineExportRequestConditionTargetValid)).
+						To(Equal(vmopv1.TargetInvalidReason))
+					Expect(vmExport.Status.ItemID).To(BeEmpty())
+				})
+			})
+
+			When("the library is not writable", func() {
+				BeforeEach(func() {
+					cl.Spec.Writable = false
+				})
+
+				It("marks the target invalid", func() {
+					_, err := reconciler.ReconcileNormal(vmExportCtx)
+					Expect(err).To(HaveOccurred())
+					Expect(conditions.GetReason(vmExport, vmopv1.VirtualMachineExportRequestConditionTargetValid)).
+						To(Equal(vmopv1.TargetContentLibraryNotWritableReason))
+				})
+			})
+
+			When("an item with the same name exists", func() {
+				var description string
+
+				JustBeforeEach(func() {
+					fakeVMProvider.GetItemFromLibraryByNameFn = func(_ context.Context, _, name string) (*library.Item, error) {
+						return &library.Item{ID: "existing-id", Name: name, Description: &description}, nil
+					}
+				})
+
+				When("the item was not exported by this request", func() {
+					BeforeEach(func() {
+						description = "some other item"
+					})
+
+					It("marks the target invalid", func() {
+						_, err := reconciler.ReconcileNormal(vmExportCtx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(conditions.GetReason(vmExport, vmopv1.VirtualMachineExportRequestConditionTargetValid)).
+							To(Equal(vmopv1.TargetItemAlreadyExistsReason))
+					})
+				})
+
+				When("the item was exported by this request", func() {
+					BeforeEach(func() {
+						description = "virtualmachineexportrequest.vmoperator.vmware.com: dummy-uid\n"
+					})
+
+					It("completes the request with the existing item", func() {
+						_, err := reconciler.ReconcileNormal(vmExportCtx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(vmExport.Status.ItemID).To(Equal("existing-id"))
+						Expect(conditions.IsTrue(vmExport, vmopv1.VirtualMachineExportRequestConditionComplete)).To(BeTrue())
+					})
+				})
+			})
+		})
+
+		When("the request is complete and the TTL has elapsed", func() {
+			BeforeEach(func() {
+				vmExport.Spec.TTLSecondsAfterFinished = ptr.To[int64](0)
+				conditions.MarkTrue(vmExport, vmopv1.VirtualMachineExportRequestConditionComplete)
+				vmExport.Status.CompletionTime = metav1.Now()
+			})
+
+			It("deletes the request", func() {
+				_, err := reconciler.ReconcileNormal(vmExportCtx)
+				Expect(err).NotTo(HaveOccurred())
+
+				obj := &vmopv1.VirtualMachineExportRequest{}
+				err = ctx.Client.Get(ctx, client.ObjectKeyFromObject(vmExport), obj)
+				if err == nil {
+					Expect(obj.DeletionTimestamp.IsZero()).To(BeFalse())
+				}
+			})
+		})
+	})
+
+	Context("ReconcileDelete", func() {
+		var completed bool
+
+		BeforeEach(func() {
+			completed = false
+			vmExport.Status.LeaseID = "dummy-lease"
+			initObjects = append(initObjects, vmExport)
+		})
+
+		JustBeforeEach(func() {
+			fakeVMProvider.CompleteVirtualMachineDiskExportFn = func(_ context.Context, _ string) error {
+				completed = true
+				return errors.New("lease already timed out")
+			}
+		})
+
+		It("completes the lease and removes the finalizer", func() {
+			_, err := reconciler.ReconcileDelete(vmExportCtx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completed).To(BeTrue())
+			Expect(vmExport.Status.LeaseID).To(BeEmpty())
+			Expect(vmExport.GetFinalizers()).ToNot(ContainElement(finalizerName))
+		})
+	})
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
 	clsutil "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/library"
 )
@@ -74,15 +75,17 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		newSRIClientFn: newCacheStorageURIsClientOrDefault(ctx),
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(controlledType).
 		WithOptions(controller.Options{
 			SkipNameValidation: SkipNameValidation,
 		}).
 		WatchesRawSource(source.Channel(
 			cource.FromContextWithBuffer(ctx, "VirtualMachineImageCache", 100),
-			&handler.EnqueueRequestForObject{})).
-		Complete(r)
+			&handler.EnqueueRequestForObject{}))
+
+	return shard.WatchNamespaces(ctx, builder, mgr.GetClient(), &vmopv1.VirtualMachineImageCacheList{}).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(), r))
 }
 
 // reconciler reconciles a VirtualMachineImageCache object.
@@ -133,7 +136,10 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 	return pkgerr.ResultFromError(r.ReconcileNormal(ctx, &obj))
 }
 
-const conditionReasonFailed = "Failed"
+const (
+	conditionReasonFailed       = "Failed"
+	conditionReasonObserverMode = "ObserverMode"
+)
 
 func (r *reconciler) ReconcileNormal(
 	ctx context.Context,
@@ -191,7 +197,24 @@ func (r *reconciler) ReconcileNormal(
 			vmopv1.VirtualMachineImageCacheConditionOVFReady)
 	}
 
-	if len(obj.Spec.Locations) > 0 {
+	switch {
+	case len(obj.Spec.Locations) == 0:
+		// There are no disks to cache.
+	case pkgcfg.FromContext(ctx).ObserverMode:
+		// Syncing the library item and caching its disks write to the
+		// datastores, so they are skipped in observer mode.
+		logger.V(4).Info("Skipping library item and disks since observer mode is enabled")
+		pkgcond.MarkFalse(
+			obj,
+			vmopv1.VirtualMachineImageCacheConditionProviderReady,
+			conditionReasonObserverMode,
+			"Observer mode is enabled")
+		pkgcond.MarkFalse(
+			obj,
+			vmopv1.VirtualMachineImageCacheConditionDisksReady,
+			conditionReasonObserverMode,
+			"Observer mode is enabled")
+	default:
 		// Reconcile the underlying library item.
 		if err := reconcileLibraryItem(ctx, clProv, obj); err != nil {
 			pkgcond.MarkFalse(
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -477,6 +478,56 @@ var _ = Describe(
 					true, "", // Ready
 				),
 			)
+
+			When("observer mode is enabled", func() {
+				BeforeEach(func() {
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.ObserverMode = true
+					})
+				})
+
+				AfterEach(func() {
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.ObserverMode = false
+					})
+				})
+
+				It("should not sync the library item or cache its disks", func() {
+					var makeDirectoryCalls atomic.Int32
+					faker.fakeSRIClient = true
+					faker.makeDirectoryFn = func(
+						context.Context,
+						string,
+						*object.Datacenter,
+						bool) error {
+
+						makeDirectoryCalls.Add(1)
+						return nil
+					}
+
+					obj := getVMICacheObj(
+						nsInfo.Namespace,
+						itemID,
+						itemVersion,
+						vmopv1.VirtualMachineImageCacheLocationSpec{
+							DatacenterID: vcSimCtx.Datacenter.Reference().Value,
+							DatastoreID:  vcSimCtx.Datastore.Reference().Value,
+						})
+					Expect(vcSimCtx.Client.Create(ctx, &obj)).To(Succeed())
+					key := ctrlclient.ObjectKeyFromObject(&obj)
+
+					Eventually(func(g Gomega) {
+						var obj vmopv1.VirtualMachineImageCache
+						g.Expect(vcSimCtx.Client.Get(ctx, key, &obj)).To(Succeed())
+						assertCondTrue(g, obj, cndOVFReady)
+						assertCondFalse(g, obj, cndPrvReady, "ObserverMode", "Observer mode is enabled")
+						assertCondFalse(g, obj, cndDskReady, "ObserverMode", "Observer mode is enabled")
+						g.Expect(obj.Status.Locations).To(BeEmpty())
+					}, 5*time.Second, 1*time.Second).Should(Succeed())
+
+					Expect(makeDirectoryCalls.Load()).To(BeZero())
+				})
+			})
 		})
 
 	})
@@ -542,6 +593,25 @@ type fakeClient struct {
 		item *library.Item,
 		force bool) error
 
+	syncLibraryItemAndWaitFn func(
+		ctx context.Context,
+		item *library.Item,
+		timeout time.Duration) (*library.Item, error)
+
+	uploadLibraryItemFn func(
+		ctx context.Context,
+		item library.Item,
+		files []clprov.UploadFile) (string, error)
+
+	importLibraryItemFn func(
+		ctx context.Context,
+		item library.Item,
+		files []clprov.ImportFile) (string, error)
+
+	listLibraryItemFilesFn func(
+		ctx context.Context,
+		itemID string) ([]library.File, error)
+
 	listLibraryItemStorageFn func(
 		ctx context.Context,
 		itemID string) ([]library.Storage, error)
@@ -573,6 +643,10 @@ func (m *fakeClient) reset() {
 	m.retrieveOvfEnvelopeFromLibraryItemFn = nil
 	m.retrieveOvfEnvelopeByLibraryItemIDFn = nil
 	m.syncLibraryItemFn = nil
+	m.syncLibraryItemAndWaitFn = nil
+	m.uploadLibraryItemFn = nil
+	m.importLibraryItemFn = nil
+	m.listLibraryItemFilesFn = nil
 	m.listLibraryItemStorageFn = nil
 	m.resolveLibraryItemStorageFn = nil
 	m.createLibraryItemFn = nil
@@ -726,6 +800,49 @@ func (m *fakeClient) SyncLibraryItem(
 	return nil
 }
 
+func (m *fakeClient) SyncLibraryItemAndWait(
+	ctx context.Context,
+	item *library.Item,
+	timeout time.Duration) (*library.Item, error) {
+
+	if fn := m.syncLibraryItemAndWaitFn; fn != nil {
+		return fn(ctx, item, timeout)
+	}
+	return item, nil
+}
+
+func (m *fakeClient) UploadLibraryItem(
+	ctx context.Context,
+	item library.Item,
+	files []clprov.UploadFile) (string, error) {
+
+	if fn := m.uploadLibraryItemFn; fn != nil {
+		return fn(ctx, item, files)
+	}
+	return "", nil
+}
+
+func (m *fakeClient) ImportLibraryItem(
+	ctx context.Context,
+	item library.Item,
+	files []clprov.ImportFile) (string, error) {
+
+	if fn := m.importLibraryItemFn; fn != nil {
+		return fn(ctx, item, files)
+	}
+	return "", nil
+}
+
+func (m *fakeClient) ListLibraryItemFiles(
+	ctx context.Context,
+	itemID string) ([]library.File, error) {
+
+	if fn := m.listLibraryItemFilesFn; fn != nil {
+		return fn(ctx, itemID)
+	}
+	return nil, nil
+}
+
 func (m *fakeClient) ListLibraryItemStorage(
 	ctx context.Context,
 	itemID string) ([]library.Storage, error) {
@@ -0,0 +1,415 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineimagecatalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcond "github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
+)
+
+const (
+	// ImagesNotReadyReason is the reason of the catalog's Ready condition
+	// when one or more images are not ready.
+	ImagesNotReadyReason = "ImagesNotReady"
+
+	// ContentSourcesNotReadyReason is the reason of the catalog's Ready
+	// condition when one or more content sources are not ready, ex. their
+	// last sync failed.
+	ContentSourcesNotReadyReason = "ContentSourcesNotReady"
+
+	contentLibraryKind        = "ContentLibrary"
+	clusterContentLibraryKind = "ClusterContentLibrary"
+)
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineImageCatalog{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+	)
+
+	// Every image and content library event results in reconciling the
+	// singleton catalog.
+	toCatalog := handler.EnqueueRequestsFromMapFunc(
+		func(context.Context, ctrlclient.Object) []reconcile.Request {
+			return []reconcile.Request{catalogRequest()}
+		})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Watches(&vmopv1.VirtualMachineImage{}, toCatalog).
+		Watches(&vmopv1.ClusterVirtualMachineImage{}, toCatalog).
+		Watches(&imgregv1a1.ContentLibrary{}, toCatalog).
+		Watches(&imgregv1a1.ClusterContentLibrary{}, toCatalog).
+		// Ensure the catalog is created even if there are no images.
+		WatchesRawSource(source.Func(
+			func(_ context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+				q.Add(catalogRequest())
+				return nil
+			})).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(), r))
+}
+
+func catalogRequest() reconcile.Request {
+	return reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name: vmopv1.VirtualMachineImageCatalogName,
+		},
+	}
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client ctrlclient.Client,
+	logger logr.Logger) *Reconciler {
+
+	return &Reconciler{
+		Context: ctx,
+		Client:  client,
+		Logger:  logger,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineImageCatalog object.
+type Reconciler struct {
+	ctrlclient.Client
+	Context context.Context
+	Logger  logr.Logger
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineimagecatalogs,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineimagecatalogs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineimages,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=clustervirtualmachineimages,verbs=get;list;watch
+// +kubebuilder:rbac:groups=imageregistry.vmware.com,resources=contentlibraries,verbs=get;list;watch
+// +kubebuilder:rbac:groups=imageregistry.vmware.com,resources=clustercontentlibraries,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	if req.Name != vmopv1.VirtualMachineImageCatalogName {
+		// Only the singleton catalog is reconciled.
+		return ctrl.Result{}, nil
+	}
+
+	logger := r.Logger.WithValues("name", req.Name)
+
+	var obj vmopv1.VirtualMachineImageCatalog
+	if err := r.Get(ctx, req.NamespacedName, &obj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		obj.Name = req.Name
+		if err := r.Create(ctx, &obj); err != nil {
+			return ctrl.Result{}, ctrlclient.IgnoreAlreadyExists(err)
+		}
+		logger.Info("Created image catalog")
+	}
+
+	if !obj.DeletionTimestamp.IsZero() {
+		// Noop.
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(&obj, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf(
+			"failed to init patch helper for %s: %w", req.NamespacedName, err)
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, &obj); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			logger.Error(err, "patch failed")
+		}
+	}()
+
+	return ctrl.Result{}, r.ReconcileNormal(ctx, &obj)
+}
+
+func (r *Reconciler) ReconcileNormal(
+	ctx context.Context,
+	obj *vmopv1.VirtualMachineImageCatalog) error {
+
+	var vmiList vmopv1.VirtualMachineImageList
+	if err := r.List(ctx, &vmiList); err != nil {
+		return fmt.Errorf("failed to list images: %w", err)
+	}
+
+	var cvmiList vmopv1.ClusterVirtualMachineImageList
+	if err := r.List(ctx, &cvmiList); err != nil {
+		return fmt.Errorf("failed to list cluster images: %w", err)
+	}
+
+	var clList imgregv1a1.ContentLibraryList
+	if err := r.List(ctx, &clList); err != nil {
+		return fmt.Errorf("failed to list content libraries: %w", err)
+	}
+
+	var cclList imgregv1a1.ClusterContentLibraryList
+	if err := r.List(ctx, &cclList); err != nil {
+		return fmt.Errorf("failed to list cluster content libraries: %w", err)
+	}
+
+	var (
+		status  vmopv1.VirtualMachineImageCatalogStatus
+		sources = map[contentSourceKey]*vmopv1.VirtualMachineImageCatalogContentSourceStatus{}
+	)
+
+	// addContentSource adds the content library's sync status to the status.
+	addContentSource := func(
+		key contentSourceKey,
+		libType imgregv1a1.ContentLibraryType,
+		lastSyncTime metav1.Time,
+		conditions imgregv1a1.Conditions) {
+
+		src := &vmopv1.VirtualMachineImageCatalogContentSourceStatus{
+			Kind:         key.kind,
+			Namespace:    key.namespace,
+			Name:         key.name,
+			Type:         string(libType),
+			LastSyncTime: lastSyncTime,
+		}
+		sources[key] = src
+
+		for _, c := range conditions {
+			if c.Type != imgregv1a1.ReadyCondition {
+				continue
+			}
+			switch c.Status {
+			case corev1.ConditionTrue:
+				src.Ready = true
+			case corev1.ConditionFalse:
+				src.Reason = c.Reason
+				src.Message = c.Message
+				status.RecentErrors = append(status.RecentErrors, vmopv1.VirtualMachineImageCatalogError{
+					Kind:               key.kind,
+					Namespace:          key.namespace,
+					Name:               key.name,
+					Reason:             c.Reason,
+					Message:            c.Message,
+					LastTransitionTime: c.LastTransitionTime,
+				})
+			}
+		}
+	}
+
+	for i := range clList.Items {
+		cl := &clList.Items[i]
+		addContentSource(
+			contentSourceKey{kind: contentLibraryKind, namespace: cl.Namespace, name: cl.Name},
+			cl.Status.Type,
+			cl.Status.LastSyncTime,
+			cl.Status.Conditions)
+	}
+
+	for i := range cclList.Items {
+		ccl := &cclList.Items[i]
+		addContentSource(
+			contentSourceKey{kind: clusterContentLibraryKind, name: ccl.Name},
+			ccl.Status.Type,
+			ccl.Status.LastSyncTime,
+			ccl.Status.Conditions)
+	}
+
+	// addImage updates the status with the provided image and returns true if
+	// the image is ready.
+	addImage := func(
+		kind string,
+		img ctrlclient.Object,
+		imgStatus *vmopv1.VirtualMachineImageStatus) bool {
+
+		ready := pkgcond.IsTrue(imgStatus, vmopv1.ReadyConditionType)
+
+		if key, ok := getContentSourceKey(img); ok {
+			src := sources[key]
+			if src == nil {
+				// The content library no longer exists or is not yet known.
+				src = &vmopv1.VirtualMachineImageCatalogContentSourceStatus{
+					Kind:      key.kind,
+					Namespace: key.namespace,
+					Name:      key.name,
+				}
+				sources[key] = src
+			}
+			src.Images++
+			if ready {
+				src.ReadyImages++
+			}
+		}
+
+		if !ready {
+			imgErr := vmopv1.VirtualMachineImageCatalogError{
+				Kind:      kind,
+				Namespace: img.GetNamespace(),
+				Name:      img.GetName(),
+			}
+			if c := pkgcond.Get(imgStatus, vmopv1.ReadyConditionType); c != nil {
+				imgErr.Reason = c.Reason
+				imgErr.Message = c.Message
+				imgErr.LastTransitionTime = c.LastTransitionTime
+			}
+			status.RecentErrors = append(status.RecentErrors, imgErr)
+		}
+
+		return ready
+	}
+
+	for i := range vmiList.Items {
+		vmi := &vmiList.Items[i]
+		status.Images++
+		if addImage("VirtualMachineImage", vmi, &vmi.Status) {
+			status.ReadyImages++
+		}
+	}
+
+	for i := range cvmiList.Items {
+		cvmi := &cvmiList.Items[i]
+		status.ClusterImages++
+		if addImage("ClusterVirtualMachineImage", cvmi, &cvmi.Status) {
+			status.ReadyClusterImages++
+		}
+	}
+
+	for _, src := range sources {
+		status.ContentSources = append(status.ContentSources, *src)
+	}
+	sort.Slice(status.ContentSources, func(i, j int) bool {
+		a, b := status.ContentSources[i], status.ContentSources[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	sort.SliceStable(status.RecentErrors, func(i, j int) bool {
+		a, b := status.RecentErrors[i], status.RecentErrors[j]
+		if !a.LastTransitionTime.Equal(&b.LastTransitionTime) {
+			return b.LastTransitionTime.Before(&a.LastTransitionTime)
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	if len(status.RecentErrors) > vmopv1.VirtualMachineImageCatalogMaxRecentErrors {
+		status.RecentErrors = status.RecentErrors[:vmopv1.VirtualMachineImageCatalogMaxRecentErrors]
+	}
+
+	status.Conditions = obj.Status.Conditions
+	obj.Status = status
+
+	var sourcesNotReady int
+	for _, src := range status.ContentSources {
+		if src.Reason != "" {
+			sourcesNotReady++
+		}
+	}
+
+	notReady := (status.Images - status.ReadyImages) +
+		(status.ClusterImages - status.ReadyClusterImages)
+
+	switch {
+	case sourcesNotReady > 0:
+		pkgcond.MarkFalse(
+			obj,
+			vmopv1.ReadyConditionType,
+			ContentSourcesNotReadyReason,
+			"%d of %d content sources are not ready and %d of %d images are not ready",
+			sourcesNotReady,
+			len(status.ContentSources),
+			notReady,
+			status.Images+status.ClusterImages)
+	case notReady > 0:
+		pkgcond.MarkFalse(
+			obj,
+			vmopv1.ReadyConditionType,
+			ImagesNotReadyReason,
+			"%d of %d images are not ready",
+			notReady,
+			status.Images+status.ClusterImages)
+	default:
+		pkgcond.MarkTrue(obj, vmopv1.ReadyConditionType)
+	}
+
+	return nil
+}
+
+type contentSourceKey struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// getContentSourceKey returns the content source of the image, as recorded in
+// the image's content library reference annotation.
+func getContentSourceKey(img ctrlclient.Object) (contentSourceKey, bool) {
+	var key contentSourceKey
+
+	data := img.GetAnnotations()[vmopv1.VMIContentLibRefAnnotation]
+	if data == "" {
+		return key, false
+	}
+
+	var ref corev1.TypedLocalObjectReference
+	if err := json.Unmarshal([]byte(data), &ref); err != nil || ref.Name == "" {
+		return key, false
+	}
+
+	key.kind = ref.Kind
+	switch {
+	case strings.EqualFold(ref.Kind, contentLibraryKind):
+		key.kind = contentLibraryKind
+	case strings.EqualFold(ref.Kind, clusterContentLibraryKind):
+		key.kind = clusterContentLibraryKind
+	}
+	key.name = ref.Name
+	if img.GetNamespace() != "" && !strings.EqualFold(ref.Kind, clusterContentLibraryKind) {
+		key.namespace = img.GetNamespace()
+	}
+	if key.kind == "" {
+		key.kind = contentLibraryKind
+		if key.namespace == "" {
+			key.kind = clusterContentLibraryKind
+		}
+	}
+
+	return key, true
+}
@@ -0,0 +1,305 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineimagecatalog_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineimagecatalog"
+	pkgcond "github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const namespaceName = "my-namespace"
+
+	var (
+		ctx         *builder.UnitTestContextForController
+		withObjects []client.Object
+		reconciler  *virtualmachineimagecatalog.Reconciler
+		catalog     *vmopv1.VirtualMachineImageCatalog
+		err         error
+	)
+
+	libRef := func(kind, name string) map[string]string {
+		return map[string]string{
+			vmopv1.VMIContentLibRefAnnotation: fmt.Sprintf(
+				`{"apiGroup":"imageregistry.vmware.com","kind":%q,"name":%q}`,
+				kind, name),
+		}
+	}
+
+	newImageStatus := func(ready bool, lastTransitionTime time.Time) vmopv1.VirtualMachineImageStatus {
+		var status vmopv1.VirtualMachineImageStatus
+		if ready {
+			pkgcond.MarkTrue(&status, vmopv1.ReadyConditionType)
+		} else {
+			pkgcond.MarkFalse(&status, vmopv1.ReadyConditionType, "NotSynced", "item not synced")
+		}
+		status.Conditions[0].LastTransitionTime = metav1.NewTime(lastTransitionTime)
+		return status
+	}
+
+	BeforeEach(func() {
+		withObjects = nil
+		catalog = &vmopv1.VirtualMachineImageCatalog{}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(withObjects...)
+		reconciler = virtualmachineimagecatalog.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger)
+
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name: vmopv1.VirtualMachineImageCatalogName,
+			},
+		})
+	})
+
+	getCatalog := func() {
+		ExpectWithOffset(1, ctx.Client.Get(
+			ctx,
+			client.ObjectKey{Name: vmopv1.VirtualMachineImageCatalogName},
+			catalog)).To(Succeed())
+	}
+
+	When("there are no images", func() {
+		It("creates the catalog", func() {
+			Expect(err).ToNot(HaveOccurred())
+			getCatalog()
+			Expect(catalog.Status.Images).To(BeZero())
+			Expect(catalog.Status.ClusterImages).To(BeZero())
+			Expect(catalog.Status.ContentSources).To(BeEmpty())
+			Expect(catalog.Status.RecentErrors).To(BeEmpty())
+			Expect(pkgcond.IsTrue(catalog, vmopv1.ReadyConditionType)).To(BeTrue())
+		})
+	})
+
+	When("there are images", func() {
+		now := time.Now().Truncate(time.Second)
+
+		BeforeEach(func() {
+			withObjects = append(withObjects,
+				&vmopv1.VirtualMachineImage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   namespaceName,
+						Name:        "vmi-1",
+						Annotations: libRef("ContentLibrary", "cl-1"),
+					},
+					Status: newImageStatus(true, now),
+				},
+				&vmopv1.VirtualMachineImage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   namespaceName,
+						Name:        "vmi-2",
+						Annotations: libRef("ContentLibrary", "cl-1"),
+					},
+					Status: newImageStatus(false, now.Add(-time.Hour)),
+				},
+				&vmopv1.ClusterVirtualMachineImage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "cvmi-1",
+						Annotations: libRef("ClusterContentLibrary", "ccl-1"),
+					},
+					Status: newImageStatus(false, now),
+				},
+				&vmopv1.ClusterVirtualMachineImage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cvmi-2",
+					},
+					Status: newImageStatus(true, now),
+				},
+			)
+		})
+
+		It("aggregates the images' status", func() {
+			Expect(err).ToNot(HaveOccurred())
+			getCatalog()
+
+			Expect(catalog.Status.Images).To(BeEquivalentTo(2))
+			Expect(catalog.Status.ReadyImages).To(BeEquivalentTo(1))
+			Expect(catalog.Status.ClusterImages).To(BeEquivalentTo(2))
+			Expect(catalog.Status.ReadyClusterImages).To(BeEquivalentTo(1))
+
+			Expect(catalog.Status.ContentSources).To(Equal([]vmopv1.VirtualMachineImageCatalogContentSourceStatus{
+				{
+					Kind:        "ClusterContentLibrary",
+					Name:        "ccl-1",
+					Images:      1,
+					ReadyImages: 0,
+				},
+				{
+					Kind:        "ContentLibrary",
+					Namespace:   namespaceName,
+					Name:        "cl-1",
+					Images:      2,
+					ReadyImages: 1,
+				},
+			}))
+
+			Expect(catalog.Status.RecentErrors).To(HaveLen(2))
+			Expect(catalog.Status.RecentErrors[0].Kind).To(Equal("ClusterVirtualMachineImage"))
+			Expect(catalog.Status.RecentErrors[0].Name).To(Equal("cvmi-1"))
+			Expect(catalog.Status.RecentErrors[0].Reason).To(Equal("NotSynced"))
+			Expect(catalog.Status.RecentErrors[0].Message).To(Equal("item not synced"))
+			Expect(catalog.Status.RecentErrors[1].Kind).To(Equal("VirtualMachineImage"))
+			Expect(catalog.Status.RecentErrors[1].Namespace).To(Equal(namespaceName))
+			Expect(catalog.Status.RecentErrors[1].Name).To(Equal("vmi-2"))
+
+			c := pkgcond.Get(catalog, vmopv1.ReadyConditionType)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(virtualmachineimagecatalog.ImagesNotReadyReason))
+			Expect(c.Message).To(Equal("2 of 4 images are not ready"))
+		})
+	})
+
+	When("there are content libraries", func() {
+		now := time.Now().Truncate(time.Second)
+
+		BeforeEach(func() {
+			withObjects = append(withObjects,
+				&imgregv1a1.ContentLibrary{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "cl-1",
+					},
+					Status: imgregv1a1.ContentLibraryStatus{
+						Type:         imgregv1a1.ContentLibraryTypeSubscribed,
+						LastSyncTime: metav1.NewTime(now),
+						Conditions: imgregv1a1.Conditions{
+							{
+								Type:   imgregv1a1.ReadyCondition,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				},
+				&imgregv1a1.ClusterContentLibrary{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "ccl-1",
+					},
+					Status: imgregv1a1.ContentLibraryStatus{
+						Type: imgregv1a1.ContentLibraryTypeSubscribed,
+						Conditions: imgregv1a1.Conditions{
+							{
+								Type:               imgregv1a1.ReadyCondition,
+								Status:             corev1.ConditionFalse,
+								Reason:             "SyncFailed",
+								Message:            "failed to sync the library",
+								LastTransitionTime: metav1.NewTime(now),
+							},
+						},
+					},
+				},
+				&vmopv1.VirtualMachineImage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   namespaceName,
+						Name:        "vmi-1",
+						Annotations: libRef("ContentLibrary", "cl-1"),
+					},
+					Status: newImageStatus(true, now),
+				},
+			)
+		})
+
+		It("reports the content libraries' sync status", func() {
+			Expect(err).ToNot(HaveOccurred())
+			getCatalog()
+
+			Expect(catalog.Status.ContentSources).To(HaveLen(2))
+
+			ccl := catalog.Status.ContentSources[0]
+			Expect(ccl.Kind).To(Equal("ClusterContentLibrary"))
+			Expect(ccl.Name).To(Equal("ccl-1"))
+			Expect(ccl.Type).To(Equal("Subscribed"))
+			Expect(ccl.Ready).To(BeFalse())
+			Expect(ccl.Reason).To(Equal("SyncFailed"))
+			Expect(ccl.Message).To(Equal("failed to sync the library"))
+			Expect(ccl.Images).To(BeZero())
+
+			cl := catalog.Status.ContentSources[1]
+			Expect(cl.Kind).To(Equal("ContentLibrary"))
+			Expect(cl.Namespace).To(Equal(namespaceName))
+			Expect(cl.Name).To(Equal("cl-1"))
+			Expect(cl.Type).To(Equal("Subscribed"))
+			Expect(cl.Ready).To(BeTrue())
+			Expect(cl.LastSyncTime.Time.Equal(now)).To(BeTrue())
+			Expect(cl.Reason).To(BeEmpty())
+			Expect(cl.Images).To(BeEquivalentTo(1))
+			Expect(cl.ReadyImages).To(BeEquivalentTo(1))
+
+			Expect(catalog.Status.RecentErrors).To(HaveLen(1))
+			Expect(catalog.Status.RecentErrors[0].Kind).To(Equal("ClusterContentLibrary"))
+			Expect(catalog.Status.RecentErrors[0].Name).To(Equal("ccl-1"))
+			Expect(catalog.Status.RecentErrors[0].Reason).To(Equal("SyncFailed"))
+			Expect(catalog.Status.RecentErrors[0].Message).To(Equal("failed to sync the library"))
+
+			c := pkgcond.Get(catalog, vmopv1.ReadyConditionType)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(virtualmachineimagecatalog.ContentSourcesNotReadyReason))
+			Expect(c.Message).To(Equal("1 of 2 content sources are not ready and 0 of 1 images are not ready"))
+		})
+	})
+
+	When("there are more errors than are reported", func() {
+		BeforeEach(func() {
+			for i := 0; i < vmopv1.VirtualMachineImageCatalogMaxRecentErrors+5; i++ {
+				withObjects = append(withObjects,
+					&vmopv1.VirtualMachineImage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: namespaceName,
+							Name:      fmt.Sprintf("vmi-%d", i),
+						},
+						Status: newImageStatus(false, time.Now()),
+					})
+			}
+		})
+
+		It("caps the number of recent errors", func() {
+			Expect(err).ToNot(HaveOccurred())
+			getCatalog()
+			Expect(catalog.Status.Images).To(BeEquivalentTo(vmopv1.VirtualMachineImageCatalogMaxRecentErrors + 5))
+			Expect(catalog.Status.RecentErrors).To(HaveLen(vmopv1.VirtualMachineImageCatalogMaxRecentErrors))
+		})
+	})
+
+	When("the request is not for the singleton catalog", func() {
+		It("does not create a catalog", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: "other"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ctx.Client.Get(ctx, client.ObjectKey{Name: "other"}, catalog)).ToNot(Succeed())
+		})
+	})
+}
@@ -0,0 +1,336 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineinventoryreport
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcond "github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
+)
+
+const (
+	virtualMachineImageKind        = "VirtualMachineImage"
+	clusterVirtualMachineImageKind = "ClusterVirtualMachineImage"
+)
+
+// driftConditionTypes are the types of the VM conditions that indicate the
+// VM's observed state has drifted from its desired state when they are false.
+var driftConditionTypes = []string{
+	vmopv1.VirtualMachineClassConfigurationSynced,
+	vmopv1.VirtualMachineConditionDevicesManaged,
+	vmopv1.VirtualMachineEncryptionSynced,
+	vmopv1.VirtualMachinePowerStateSyncedCondition,
+}
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineInventoryReport{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		ctx.VMProvider,
+	)
+
+	// The report is regenerated on a schedule rather than in response to
+	// events on the VMs, so only the report itself is watched.
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		// Ensure the report is created.
+		WatchesRawSource(source.Func(
+			func(_ context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+				q.Add(reportRequest())
+				return nil
+			})).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(), r))
+}
+
+func reportRequest() reconcile.Request {
+	return reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name: vmopv1.VirtualMachineInventoryReportName,
+		},
+	}
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client ctrlclient.Client,
+	logger logr.Logger,
+	vmProvider providers.VirtualMachineProviderInterface) *Reconciler {
+
+	return &Reconciler{
+		Context:    ctx,
+		Client:     client,
+		Logger:     logger,
+		VMProvider: vmProvider,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineInventoryReport object.
+type Reconciler struct {
+	ctrlclient.Client
+	Context    context.Context
+	Logger     logr.Logger
+	VMProvider providers.VirtualMachineProviderInterface
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineinventoryreports,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineinventoryreports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	if req.Name != vmopv1.VirtualMachineInventoryReportName {
+		// Only the singleton report is reconciled.
+		return ctrl.Result{}, nil
+	}
+
+	logger := r.Logger.WithValues("name", req.Name)
+
+	var obj vmopv1.VirtualMachineInventoryReport
+	if err := r.Get(ctx, req.NamespacedName, &obj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		obj.Name = req.Name
+		if err := r.Create(ctx, &obj); err != nil {
+			return ctrl.Result{}, ctrlclient.IgnoreAlreadyExists(err)
+		}
+		logger.Info("Created inventory report")
+	}
+
+	if !obj.DeletionTimestamp.IsZero() {
+		// Noop.
+		return ctrl.Result{}, nil
+	}
+
+	// Patching the report's status results in another reconcile, so do not
+	// regenerate the report until the interval has elapsed.
+	interval := pkgcfg.FromContext(ctx).InventoryReportInterval
+	if last := obj.Status.LastGeneratedTime; !last.IsZero() {
+		if wait := time.Until(last.Add(interval)); wait > 0 {
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+	}
+
+	patchHelper, err := patch.NewHelper(&obj, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf(
+			"failed to init patch helper for %s: %w", req.NamespacedName, err)
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, &obj); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			logger.Error(err, "patch failed")
+		}
+	}()
+
+	if err := r.ReconcileNormal(ctx, &obj); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+func (r *Reconciler) ReconcileNormal(
+	ctx context.Context,
+	obj *vmopv1.VirtualMachineInventoryReport) error {
+
+	var vmList vmopv1.VirtualMachineList
+	if err := r.List(ctx, &vmList); err != nil {
+		return fmt.Errorf("failed to list virtual machines: %w", err)
+	}
+
+	var (
+		status = vmopv1.VirtualMachineInventoryReportStatus{
+			LastGeneratedTime: metav1.Now(),
+		}
+		classes   = map[string]int32{}
+		zones     = map[string]int32{}
+		images    = map[vmopv1.VirtualMachineInventoryReportImage]int32{}
+		uniqueIDs = map[string]struct{}{}
+		vmsByName = map[string]*vmopv1.VirtualMachine{}
+	)
+
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		status.VirtualMachines++
+
+		vmsByName[vm.NamespacedName()] = vm
+		if id := vm.Status.UniqueID; id != "" {
+			uniqueIDs[id] = struct{}{}
+		}
+
+		if vm.Spec.ClassName != "" {
+			classes[vm.Spec.ClassName]++
+		}
+
+		zone := vm.Status.Zone
+		if zone == "" {
+			zone = vm.Labels[topology.KubernetesTopologyZoneLabelKey]
+		}
+		if zone != "" {
+			zones[zone]++
+		}
+
+		if img := vm.Spec.Image; img != nil && img.Name != "" {
+			key := vmopv1.VirtualMachineInventoryReportImage{
+				Kind: img.Kind,
+				Name: img.Name,
+			}
+			if key.Kind == "" {
+				key.Kind = virtualMachineImageKind
+			}
+			if key.Kind != clusterVirtualMachineImageKind {
+				key.Namespace = vm.Namespace
+			}
+			images[key]++
+		}
+
+		var drifted []string
+		for _, t := range driftConditionTypes {
+			if pkgcond.IsFalse(vm, t) {
+				drifted = append(drifted, t)
+			}
+		}
+		if len(drifted) > 0 {
+			status.DriftedVirtualMachines++
+			status.Drifted = append(status.Drifted, vmopv1.VirtualMachineInventoryReportDriftedVM{
+				Namespace:  vm.Namespace,
+				Name:       vm.Name,
+				Conditions: drifted,
+			})
+		}
+	}
+
+	status.Classes = sortedCounts(classes)
+	status.Zones = sortedCounts(zones)
+
+	for key, n := range images {
+		key.VirtualMachines = n
+		status.Images = append(status.Images, key)
+	}
+	sort.Slice(status.Images, func(i, j int) bool {
+		a, b := status.Images[i], status.Images[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	sort.Slice(status.Drifted, func(i, j int) bool {
+		a, b := status.Drifted[i], status.Drifted[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	if len(status.Drifted) > vmopv1.VirtualMachineInventoryReportMaxItems {
+		status.Drifted = status.Drifted[:vmopv1.VirtualMachineInventoryReportMaxItems]
+	}
+
+	status.Conditions = obj.Status.Conditions
+	obj.Status = status
+
+	managedVMs, err := r.VMProvider.ListManagedVirtualMachines(ctx)
+	if err != nil {
+		pkgcond.MarkFalse(
+			obj,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineInventoryReportListFailedReason,
+			"%v", err)
+		return nil
+	}
+
+	for _, mvm := range managedVMs {
+		if isOrphaned(mvm, uniqueIDs, vmsByName) {
+			obj.Status.OrphanedVirtualMachines++
+			obj.Status.Orphaned = append(obj.Status.Orphaned, vmopv1.VirtualMachineInventoryReportOrphanedVM{
+				ID:             mvm.ID,
+				Name:           mvm.Name,
+				InstanceUUID:   mvm.InstanceUUID,
+				VirtualMachine: mvm.NamespacedName,
+			})
+		}
+	}
+	sort.Slice(obj.Status.Orphaned, func(i, j int) bool {
+		return obj.Status.Orphaned[i].ID < obj.Status.Orphaned[j].ID
+	})
+	if len(obj.Status.Orphaned) > vmopv1.VirtualMachineInventoryReportMaxItems {
+		obj.Status.Orphaned = obj.Status.Orphaned[:vmopv1.VirtualMachineInventoryReportMaxItems]
+	}
+
+	pkgcond.MarkTrue(obj, vmopv1.ReadyConditionType)
+
+	return nil
+}
+
+// isOrphaned returns true if the managed VM does not belong to any of the
+// VirtualMachine resources. A VM that is not yet known by its ID is not
+// orphaned if the VirtualMachine resource recorded in the VM exists and has
+// not yet been assigned an ID, i.e. the VM is still being created.
+func isOrphaned(
+	mvm providers.ManagedVirtualMachine,
+	uniqueIDs map[string]struct{},
+	vmsByName map[string]*vmopv1.VirtualMachine) bool {
+
+	if _, ok := uniqueIDs[mvm.ID]; ok {
+		return false
+	}
+	if vm, ok := vmsByName[mvm.NamespacedName]; ok && vm.Status.UniqueID == "" {
+		return false
+	}
+	return true
+}
+
+func sortedCounts(m map[string]int32) []vmopv1.VirtualMachineInventoryReportCount {
+	if len(m) == 0 {
+		return nil
+	}
+	counts := make([]vmopv1.VirtualMachineInventoryReportCount, 0, len(m))
+	for name, n := range m {
+		counts = append(counts, vmopv1.VirtualMachineInventoryReportCount{
+			Name:            name,
+			VirtualMachines: n,
+		})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].Name < counts[j].Name
+	})
+	return counts
+}
@@ -0,0 +1,255 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineinventoryreport_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineinventoryreport"
+	pkgcond "github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const (
+		namespaceName = "my-namespace"
+		interval      = time.Hour
+	)
+
+	var (
+		ctx            *builder.UnitTestContextForController
+		withObjects    []client.Object
+		fakeVMProvider *providerfake.VMProvider
+		reconciler     *virtualmachineinventoryreport.Reconciler
+		report         *vmopv1.VirtualMachineInventoryReport
+		result         reconcile.Result
+		err            error
+	)
+
+	newVM := func(name, className, imageKind, imageName, zone, uniqueID string) *vmopv1.VirtualMachine {
+		vm := &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      name,
+			},
+			Spec: vmopv1.VirtualMachineSpec{
+				ClassName: className,
+			},
+			Status: vmopv1.VirtualMachineStatus{
+				Zone:     zone,
+				UniqueID: uniqueID,
+			},
+		}
+		if imageName != "" {
+			vm.Spec.Image = &vmopv1.VirtualMachineImageRef{
+				Kind: imageKind,
+				Name: imageName,
+			}
+		}
+		return vm
+	}
+
+	BeforeEach(func() {
+		withObjects = nil
+		fakeVMProvider = providerfake.NewVMProvider()
+		report = &vmopv1.VirtualMachineInventoryReport{}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(withObjects...)
+		reconciler = virtualmachineinventoryreport.NewReconciler(
+			pkgcfg.UpdateContext(
+				ctx,
+				func(config *pkgcfg.Config) {
+					config.InventoryReportInterval = interval
+				},
+			),
+			ctx.Client,
+			ctx.Logger,
+			fakeVMProvider)
+
+		result, err = reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name: vmopv1.VirtualMachineInventoryReportName,
+			},
+		})
+	})
+
+	getReport := func() {
+		ExpectWithOffset(1, ctx.Client.Get(
+			ctx,
+			client.ObjectKey{Name: vmopv1.VirtualMachineInventoryReportName},
+			report)).To(Succeed())
+	}
+
+	When("there are no VMs", func() {
+		It("creates the report and requeues after the interval", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(interval))
+			getReport()
+			Expect(report.Status.LastGeneratedTime.IsZero()).To(BeFalse())
+			Expect(report.Status.VirtualMachines).To(BeZero())
+			Expect(report.Status.Classes).To(BeEmpty())
+			Expect(report.Status.Zones).To(BeEmpty())
+			Expect(report.Status.Images).To(BeEmpty())
+			Expect(report.Status.Drifted).To(BeEmpty())
+			Expect(report.Status.Orphaned).To(BeEmpty())
+			Expect(pkgcond.IsTrue(report, vmopv1.ReadyConditionType)).To(BeTrue())
+		})
+	})
+
+	When("there are VMs", func() {
+		BeforeEach(func() {
+			vm1 := newVM("vm-1", "small", "VirtualMachineImage", "vmi-1", "zone-a", "vm-101")
+			vm2 := newVM("vm-2", "small", "ClusterVirtualMachineImage", "cvmi-1", "", "vm-102")
+			vm2.Labels = map[string]string{
+				topology.KubernetesTopologyZoneLabelKey: "zone-b",
+			}
+			pkgcond.MarkFalse(vm2, vmopv1.VirtualMachineConditionDevicesManaged, "UnmanagedDevices", "serialport-9000")
+			pkgcond.MarkFalse(vm2, vmopv1.VirtualMachinePowerStateSyncedCondition, "Failed", "power on failed")
+			vm3 := newVM("vm-3", "large", "", "vmi-1", "zone-a", "")
+			pkgcond.MarkTrue(vm3, vmopv1.VirtualMachineConditionDevicesManaged)
+			withObjects = append(withObjects, vm1, vm2, vm3)
+
+			fakeVMProvider.ListManagedVirtualMachinesFn = func(context.Context) ([]providers.ManagedVirtualMachine, error) {
+				return []providers.ManagedVirtualMachine{
+					{ID: "vm-101", Name: "vm-1", NamespacedName: namespaceName + "/vm-1"},
+					{ID: "vm-102", Name: "vm-2", NamespacedName: namespaceName + "/vm-2"},
+					// vm-3 is still being created.
+					{ID: "vm-103", Name: "vm-3", NamespacedName: namespaceName + "/vm-3"},
+					// vm-4 was deleted, but its vSphere VM was left behind.
+					{ID: "vm-104", Name: "vm-4", InstanceUUID: "uuid-4", NamespacedName: namespaceName + "/vm-4"},
+					// vm-1 was recreated, but its previous vSphere VM was left
+					// behind.
+					{ID: "vm-100", Name: "vm-1", NamespacedName: namespaceName + "/vm-1"},
+				}, nil
+			}
+		})
+
+		It("reports the inventory", func() {
+			Expect(err).ToNot(HaveOccurred())
+			getReport()
+
+			Expect(report.Status.VirtualMachines).To(BeEquivalentTo(3))
+			Expect(report.Status.Classes).To(Equal([]vmopv1.VirtualMachineInventoryReportCount{
+				{Name: "large", VirtualMachines: 1},
+				{Name: "small", VirtualMachines: 2},
+			}))
+			Expect(report.Status.Zones).To(Equal([]vmopv1.VirtualMachineInventoryReportCount{
+				{Name: "zone-a", VirtualMachines: 2},
+				{Name: "zone-b", VirtualMachines: 1},
+			}))
+			Expect(report.Status.Images).To(Equal([]vmopv1.VirtualMachineInventoryReportImage{
+				{Kind: "ClusterVirtualMachineImage", Name: "cvmi-1", VirtualMachines: 1},
+				{Kind: "VirtualMachineImage", Namespace: namespaceName, Name: "vmi-1", VirtualMachines: 2},
+			}))
+
+			Expect(report.Status.DriftedVirtualMachines).To(BeEquivalentTo(1))
+			Expect(report.Status.Drifted).To(Equal([]vmopv1.VirtualMachineInventoryReportDriftedVM{
+				{
+					Namespace: namespaceName,
+					Name:      "vm-2",
+					Conditions: []string{
+						vmopv1.VirtualMachineConditionDevicesManaged,
+						vmopv1.VirtualMachinePowerStateSyncedCondition,
+					},
+				},
+			}))
+
+			Expect(report.Status.OrphanedVirtualMachines).To(BeEquivalentTo(2))
+			Expect(report.Status.Orphaned).To(Equal([]vmopv1.VirtualMachineInventoryReportOrphanedVM{
+				{ID: "vm-100", Name: "vm-1", VirtualMachine: namespaceName + "/vm-1"},
+				{ID: "vm-104", Name: "vm-4", InstanceUUID: "uuid-4", VirtualMachine: namespaceName + "/vm-4"},
+			}))
+
+			Expect(pkgcond.IsTrue(report, vmopv1.ReadyConditionType)).To(BeTrue())
+		})
+
+		When("the managed VMs cannot be listed", func() {
+			BeforeEach(func() {
+				fakeVMProvider.ListManagedVirtualMachinesFn = func(context.Context) ([]providers.ManagedVirtualMachine, error) {
+					return nil, errors.New("fake")
+				}
+			})
+
+			It("reports the inventory without the orphaned VMs", func() {
+				Expect(err).ToNot(HaveOccurred())
+				getReport()
+
+				Expect(report.Status.VirtualMachines).To(BeEquivalentTo(3))
+				Expect(report.Status.OrphanedVirtualMachines).To(BeZero())
+				Expect(report.Status.Orphaned).To(BeEmpty())
+
+				c := pkgcond.Get(report, vmopv1.ReadyConditionType)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineInventoryReportListFailedReason))
+				Expect(c.Message).To(Equal("fake"))
+			})
+		})
+	})
+
+	When("the report was generated within the interval", func() {
+		lastGenerated := metav1.NewTime(time.Now().Add(-interval / 2).Truncate(time.Second))
+
+		BeforeEach(func() {
+			withObjects = append(withObjects,
+				&vmopv1.VirtualMachineInventoryReport{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: vmopv1.VirtualMachineInventoryReportName,
+					},
+					Status: vmopv1.VirtualMachineInventoryReportStatus{
+						LastGeneratedTime: lastGenerated,
+					},
+				},
+				newVM("vm-1", "small", "", "", "", ""),
+			)
+		})
+
+		It("does not regenerate the report until the interval elapses", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", interval/2, time.Minute))
+			getReport()
+			Expect(report.Status.LastGeneratedTime.Time).To(BeTemporally("==", lastGenerated.Time))
+			Expect(report.Status.VirtualMachines).To(BeZero())
+		})
+	})
+
+	When("the request is not for the singleton report", func() {
+		It("does not create a report", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: "other"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ctx.Client.Get(ctx, client.ObjectKey{Name: "other"}, report)).ToNot(Succeed())
+		})
+	})
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/patch"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 )
 
 const (
@@ -53,6 +54,12 @@ const (
 	// Wait for 30 seconds to eliminate the last case in a best-effort manner.
 	waitForTaskTimeout = 30 * time.Second
 
+	// publishRetryBaseDelay and publishRetryMaxDelay bound the exponential
+	// backoff between failed publish attempts. The delay doubles with each
+	// attempt, starting from the base delay.
+	publishRetryBaseDelay = 30 * time.Second
+	publishRetryMaxDelay  = 10 * time.Minute
+
 	clItemPrefix          = "clibitem-"
 	ItemParseErrorMessage = "Failed to get the uploaded item ID. This error is unrecoverable." +
 		" Please create a new VirtualMachinePublishRequest to retry VM publishing."
@@ -84,12 +91,15 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		ctx.VMProvider,
 	)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(controlledType).
 		WithOptions(controller.Options{MaxConcurrentReconciles: ctx.MaxConcurrentReconciles}).
 		Watches(&vmopv1.VirtualMachineImage{},
-			handler.EnqueueRequestsFromMapFunc(vmiToVMPubMapperFn(ctx, r.Client))).
-		Complete(r)
+			handler.EnqueueRequestsFromMapFunc(vmiToVMPubMapperFn(ctx, r.Client)))
+
+	return shard.WatchNamespaces(ctx, builder, mgr.GetClient(), &vmopv1.VirtualMachinePublishRequestList{}).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(),
+			metrics.NewSLOReconciler(controllerNameShort, r)))
 }
 
 // vmiToVMPubMapperFn returns a mapper function that can be used to queue a
@@ -174,6 +184,14 @@ func requeueResult(ctx *pkgctx.VirtualMachinePublishRequestContext) ctrl.Result
 		return ctrl.Result{}
 	}
 
+	// Retry a failed publish task once its backoff has elapsed.
+	if conditions.GetReason(vmPubReq, vmopv1.VirtualMachinePublishRequestConditionUploaded) == vmopv1.UploadFailureReason {
+		if remaining := publishRetryRemaining(vmPubReq); remaining > 0 {
+			return ctrl.Result{RequeueAfter: remaining}
+		}
+		return ctrl.Result{Requeue: true}
+	}
+
 	// In case the item is uploaded but VMI is not available, or,
 	// the export task is not submitted to the vCenter task manager,
 	// requeue after a short wait time since we expect these issues to be resolved quickly.
@@ -339,6 +357,12 @@ func (r *Reconciler) removeVMPubResourceFromCluster(ctx *pkgctx.VirtualMachinePu
 		return
 	}
 
+	if vmPublishReq.Status.CompletionTime.IsZero() {
+		// The TTL starts when the request completes, so a request marked
+		// complete without a completion time is given one now.
+		vmPublishReq.Status.CompletionTime = metav1.Now()
+	}
+
 	if *ttlSecondsAfterFinished > 0 {
 		completeTime := vmPublishReq.Status.CompletionTime.Time
 		if time.Since(completeTime) < time.Duration(*ttlSecondsAfterFinished)*time.Second {
@@ -581,6 +605,38 @@ func (r *Reconciler) getPublishRequestTask(ctx *pkgctx.VirtualMachinePublishRequ
 	return publishTask, nil
 }
 
+// cancelPublishRequestTask cancels the current publish task if it has not
+// finished, so deleting an in-progress VirtualMachinePublishRequest stops the
+// capture and upload.
+func (r *Reconciler) cancelPublishRequestTask(ctx *pkgctx.VirtualMachinePublishRequestContext) error {
+	vmPubReq := ctx.VMPublishRequest
+	if vmPubReq.Status.Attempts == 0 ||
+		conditions.IsTrue(vmPubReq, vmopv1.VirtualMachinePublishRequestConditionUploaded) {
+		return nil
+	}
+
+	task, err := r.getPublishRequestTask(ctx)
+	if err != nil || task == nil {
+		return err
+	}
+
+	if task.State != vimtypes.TaskInfoStateQueued && task.State != vimtypes.TaskInfoStateRunning {
+		return nil
+	}
+
+	if !task.Cancelable {
+		ctx.Logger.Info("VM Publish task cannot be cancelled", "task", task.Task.Value)
+		return nil
+	}
+
+	ctx.Logger.Info("Cancelling VM Publish task", "task", task.Task.Value)
+	if err := r.VMProvider.CancelTask(ctx, task.Task); err != nil {
+		return fmt.Errorf("failed to cancel VM Publish task %s: %w", task.Task.Value, err)
+	}
+
+	return nil
+}
+
 // checkPubReqStatusAndShouldRepublish checks the publish request task status, mark Uploaded condition
 // and check if we should re-publish this VM.
 // Returns
@@ -643,22 +699,23 @@ func (r *Reconciler) checkPubReqStatusAndShouldRepublish(ctx *pkgctx.VirtualMach
 		return false, nil
 	}
 
+	logger = logger.WithValues("task", task.Task.Value)
+
 	switch task.State {
 	case vimtypes.TaskInfoStateQueued:
 		logger.V(5).Info("VM Publish task is queued but hasn't started")
 		conditions.MarkFalse(ctx.VMPublishRequest,
 			vmopv1.VirtualMachinePublishRequestConditionUploaded,
 			vmopv1.UploadTaskQueuedReason,
-			"VM Publish task is queued.")
+			"VM Publish task %s is queued.", task.Task.Value)
 		return false, nil
 	case vimtypes.TaskInfoStateRunning:
 		// CreateOVF is still in progress
-		// TODO: Add task Progress info.
 		logger.V(5).Info("VM Publish is still in progress", "progress", task.Progress)
 		conditions.MarkFalse(ctx.VMPublishRequest,
 			vmopv1.VirtualMachinePublishRequestConditionUploaded,
 			vmopv1.UploadingReason,
-			"Uploading item to content library.")
+			"Uploading item to content library, task %s is %d%% complete.", task.Task.Value, task.Progress)
 		return false, nil
 	case vimtypes.TaskInfoStateSuccess:
 		// Publish request succeeds. Update Uploaded condition.
@@ -671,12 +728,21 @@ func (r *Reconciler) checkPubReqStatusAndShouldRepublish(ctx *pkgctx.VirtualMach
 			errMsg = task.Error.LocalizedMessage
 		}
 
-		logger.Error(err, "VM Publish failed, will retry this operation",
-			"actID", task.ActivationId, "descriptionID", task.DescriptionId)
 		conditions.MarkFalse(ctx.VMPublishRequest,
 			vmopv1.VirtualMachinePublishRequestConditionUploaded,
 			vmopv1.UploadFailureReason,
 			errMsg)
+
+		// Back off before retrying so a persistent failure does not result
+		// in a steady stream of publish tasks.
+		if remaining := publishRetryRemaining(ctx.VMPublishRequest); remaining > 0 {
+			logger.Info("VM Publish failed, will retry this operation after backoff",
+				"actID", task.ActivationId, "descriptionID", task.DescriptionId, "retryAfter", remaining)
+			return false, nil
+		}
+
+		logger.Info("VM Publish failed, will retry this operation",
+			"actID", task.ActivationId, "descriptionID", task.DescriptionId)
 		return true, nil
 	}
 
@@ -803,6 +869,17 @@ func (r *Reconciler) updatePublishedItemDescription(ctx *pkgctx.VirtualMachinePu
 	return nil
 }
 
+// publishRetryRemaining returns how long to wait before retrying a failed
+// publish attempt.
+func publishRetryRemaining(vmPub *vmopv1.VirtualMachinePublishRequest) time.Duration {
+	delay := publishRetryBaseDelay
+	for i := int64(1); i < vmPub.Status.Attempts && delay < publishRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, publishRetryMaxDelay)
+	return time.Until(vmPub.Status.LastAttemptTime.Add(delay))
+}
+
 // getPublishRequestActID returns the activation ID we pass down to the content library vAPI.
 // Append .status.attempts to the vmpublishrequest uuid to generate an activation ID.
 // VC doesn't prevent duplicate activation IDs for different requests, but we can have problems
@@ -921,6 +998,11 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachinePublishRequestCon
 func (r *Reconciler) ReconcileDelete(ctx *pkgctx.VirtualMachinePublishRequestContext) (ctrl.Result, error) {
 	if controllerutil.ContainsFinalizer(ctx.VMPublishRequest, finalizerName) ||
 		controllerutil.ContainsFinalizer(ctx.VMPublishRequest, deprecatedFinalizerName) {
+
+		if err := r.cancelPublishRequestTask(ctx); err != nil {
+			return ctrl.Result{}, err
+		}
+
 		r.Metrics.DeleteMetrics(ctx.Logger, ctx.VMPublishRequest.Name, ctx.VMPublishRequest.Namespace)
 		controllerutil.RemoveFinalizer(ctx.VMPublishRequest, finalizerName)
 		controllerutil.RemoveFinalizer(ctx.VMPublishRequest, deprecatedFinalizerName)
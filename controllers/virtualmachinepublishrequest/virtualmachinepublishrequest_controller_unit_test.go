@@ -6,6 +6,7 @@ package virtualmachinepublishrequest_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -598,6 +599,25 @@ func unitTestsReconcile() {
 						return fakeVMProvider.GetVMPublishRequestResult(vmpub)
 					}).Should(Equal(vimtypes.TaskInfoStateSuccess))
 				})
+
+				When("the retry backoff has not elapsed", func() {
+					BeforeEach(func() {
+						vmpub.Status.Attempts = 3
+					})
+
+					It("Should requeue without sending another publish VM request", func() {
+						result, err := reconciler.ReconcileNormal(vmpubCtx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result.RequeueAfter).To(BeNumerically("~", time.Minute, 5*time.Second))
+
+						Expect(conditions.GetReason(vmpub,
+							vmopv1.VirtualMachinePublishRequestConditionUploaded)).To(Equal(vmopv1.UploadFailureReason))
+
+						Consistently(func() bool {
+							return fakeVMProvider.IsPublishVMCalled()
+						}).Should(BeFalse())
+					})
+				})
 			})
 
 			When("Previous request is queued", func() {
@@ -632,6 +652,8 @@ func unitTestsReconcile() {
 							DescriptionId: virtualmachinepublishrequest.TaskDescriptionID,
 							State:         vimtypes.TaskInfoStateRunning,
 							QueueTime:     time.Now(),
+							Task:          vimtypes.ManagedObjectReference{Type: "Task", Value: "task-42"},
+							Progress:      37,
 						}
 						return []vimtypes.TaskInfo{task}, nil
 					}
@@ -643,6 +665,9 @@ func unitTestsReconcile() {
 
 					Expect(conditions.IsTrue(vmpub,
 						vmopv1.VirtualMachinePublishRequestConditionUploaded)).To(BeFalse())
+					c := conditions.Get(vmpub, vmopv1.VirtualMachinePublishRequestConditionUploaded)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Message).To(Equal("Uploading item to content library, task task-42 is 37% complete."))
 
 					Consistently(func() bool {
 						return fakeVMProvider.IsPublishVMCalled()
@@ -679,4 +704,91 @@ func unitTestsReconcile() {
 			})
 		})
 	})
+
+	Context("ReconcileDelete", func() {
+		var (
+			taskState  vimtypes.TaskInfoState
+			cancelable bool
+			cancelled  []vimtypes.ManagedObjectReference
+		)
+
+		BeforeEach(func() {
+			initObjects = append(initObjects, cl, vm, vmpub)
+			vmpub.Finalizers = []string{"vmoperator.vmware.com/virtualmachinepublishrequest"}
+			vmpub.Status.Attempts = 1
+			taskState = vimtypes.TaskInfoStateRunning
+			cancelable = true
+			cancelled = nil
+		})
+
+		JustBeforeEach(func() {
+			fakeVMProvider.Lock()
+			fakeVMProvider.GetTasksByActIDFn = func(ctx context.Context, actID string) ([]vimtypes.TaskInfo, error) {
+				return []vimtypes.TaskInfo{
+					{
+						DescriptionId: virtualmachinepublishrequest.TaskDescriptionID,
+						State:         taskState,
+						Cancelable:    cancelable,
+						Task:          vimtypes.ManagedObjectReference{Type: "Task", Value: "task-42"},
+					},
+				}, nil
+			}
+			fakeVMProvider.CancelTaskFn = func(ctx context.Context, taskRef vimtypes.ManagedObjectReference) error {
+				cancelled = append(cancelled, taskRef)
+				return nil
+			}
+			fakeVMProvider.Unlock()
+		})
+
+		When("the publish task is in progress", func() {
+			It("Should cancel the task and remove the finalizer", func() {
+				_, err := reconciler.ReconcileDelete(vmpubCtx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cancelled).To(ConsistOf(vimtypes.ManagedObjectReference{Type: "Task", Value: "task-42"}))
+				Expect(vmpub.Finalizers).To(BeEmpty())
+			})
+		})
+
+		When("the publish task cannot be cancelled", func() {
+			BeforeEach(func() {
+				cancelable = false
+			})
+
+			It("Should remove the finalizer", func() {
+				_, err := reconciler.ReconcileDelete(vmpubCtx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cancelled).To(BeEmpty())
+				Expect(vmpub.Finalizers).To(BeEmpty())
+			})
+		})
+
+		When("the publish task has finished", func() {
+			BeforeEach(func() {
+				taskState = vimtypes.TaskInfoStateError
+			})
+
+			It("Should remove the finalizer", func() {
+				_, err := reconciler.ReconcileDelete(vmpubCtx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cancelled).To(BeEmpty())
+				Expect(vmpub.Finalizers).To(BeEmpty())
+			})
+		})
+
+		When("cancelling the publish task fails", func() {
+			JustBeforeEach(func() {
+				fakeVMProvider.Lock()
+				fakeVMProvider.CancelTaskFn = func(ctx context.Context, taskRef vimtypes.ManagedObjectReference) error {
+					return errors.New("cancel failed")
+				}
+				fakeVMProvider.Unlock()
+			})
+
+			It("Should return an error and keep the finalizer", func() {
+				_, err := reconciler.ReconcileDelete(vmpubCtx)
+				Expect(err).To(MatchError(ContainSubstring("cancel failed")))
+				Expect(vmpub.Finalizers).ToNot(BeEmpty())
+			})
+		})
+	})
 }
@@ -51,6 +51,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/prober"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	"github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 )
 
 var (
@@ -87,14 +88,16 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
 		record.New(mgr.GetEventRecorderFor(controllerNameLong)))
 
-	return ctrl.NewControllerManagedBy(mgr).
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(controlledType).
 		Owns(&vmopv1.VirtualMachine{}).
 		Watches(&vmopv1.VirtualMachine{},
 			handler.EnqueueRequestsFromMapFunc(r.VMToReplicaSets(ctx)),
 		).
-		WithOptions(controller.Options{MaxConcurrentReconciles: ctx.MaxConcurrentReconciles}).
-		Complete(r)
+		WithOptions(controller.Options{MaxConcurrentReconciles: ctx.MaxConcurrentReconciles})
+
+	return shard.WatchNamespaces(ctx, builder, mgr.GetClient(), &vmopv1.VirtualMachineReplicaSetList{}).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(), r))
 }
 
 // VMToReplicaSets is a mapper function to be used to enqueue requests for
@@ -394,8 +397,9 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineReplicaSetContext
 		replicas = *ctx.ReplicaSet.Spec.Replicas
 	}
 
-	// Queue faster reconciles until all replicas are available.
-	if ctx.ReplicaSet.Status.ReadyReplicas != replicas {
+	// Queue faster reconciles until all replicas are available and updated.
+	if ctx.ReplicaSet.Status.ReadyReplicas != replicas ||
+		ctx.ReplicaSet.Status.UpdatedReplicas != replicas {
 		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
 	}
 
@@ -456,7 +460,8 @@ func getAnnotationsFromReplicaSet(rs *vmopv1.VirtualMachineReplicaSet) map[strin
 	return annotations
 }
 
-// syncReplicas scales VirtualMachine resources up or down.
+// syncReplicas scales VirtualMachine resources up or down, and replaces the
+// outdated ones according to the update strategy.
 func (r *Reconciler) syncReplicas(
 	ctx *pkgctx.VirtualMachineReplicaSetContext,
 	rs *vmopv1.VirtualMachineReplicaSet,
@@ -465,6 +470,11 @@ func (r *Reconciler) syncReplicas(
 	if rs.Spec.Replicas == nil {
 		return fmt.Errorf("the Replicas field in Spec for VirtualMachineReplicaSet %v is nil, this should not be allowed", rs.Name)
 	}
+
+	if inProgress, err := r.rolloutReplicas(ctx, rs, vms); err != nil || inProgress {
+		return err
+	}
+
 	diff := len(vms) - int(*(rs.Spec.Replicas))
 	switch {
 	case diff < 0:
@@ -475,39 +485,7 @@ func (r *Reconciler) syncReplicas(
 			"vmsToBeCreated", diff,
 		)
 
-		var (
-			vmList []*vmopv1.VirtualMachine
-			errs   []error
-		)
-
-		for i := 0; i < diff; i++ {
-			vm := r.getNewVirtualMachine(rs)
-			log := ctx.Logger.WithValues("vm", vm.Name)
-			log.Info("Creating VM", "index", i+1, "totalVMsToBeCreated", diff)
-
-			if err := r.Client.Create(ctx, vm); err != nil {
-				log.Error(err, "Error while creating VirtualMachine")
-				r.Recorder.Warnf(rs, "FailedCreate", "Failed to create VirtualMachine: %v", err)
-				errs = append(errs, err)
-				conditions.MarkFalse(
-					rs,
-					vmopv1.VirtualMachinesCreatedCondition,
-					vmopv1.VirtualMachineCreationFailedReason,
-					err.Error(),
-				)
-				continue
-			}
-
-			log.V(5).Info("Created VM", "index", i+1, "totalVMsToBeCreated", diff)
-			r.Recorder.Eventf(rs, "SuccessfulCreate", "Created vm %q", vm.Name)
-			vmList = append(vmList, vm)
-		}
-
-		if len(errs) > 0 {
-			return apierrorsutil.NewAggregate(errs)
-		}
-
-		return r.waitForVMCreation(ctx, vmList)
+		return r.createVMs(ctx, rs, diff)
 	case diff > 0:
 		ctx.Logger.Info("ReplicaSet is scaling down",
 			"currentReplicas", len(vms),
@@ -521,33 +499,82 @@ func (r *Reconciler) syncReplicas(
 			return err
 		}
 
-		var errs []error
-		vmsToDelete := getMachinesToDeletePrioritized(vms, diff, deletePriorityFunc)
-		for i, vm := range vmsToDelete {
-			log := ctx.Logger.WithValues("vm", vm.Name)
-			if vm.GetDeletionTimestamp().IsZero() {
-				log.Info("Deleting VM to scale down replicaset", "index", i+1, "totalVMsToBeDeleted", diff)
-
-				if err := r.Client.Delete(ctx, vm); err != nil {
-					log.Error(err, "Unable to delete VM")
-					r.Recorder.Warnf(rs, "FailedDelete", "Failed to delete VM %q: %v", vm.Name, err)
-					errs = append(errs, err)
-					continue
-				}
-				log.V(5).Info("Deleted VM", "index", i+1, "totalVMsToBeDeleted", diff)
-				r.Recorder.Eventf(rs, "SuccessfulDelete", "Deleted VM %q", vm.Name)
-			} else {
-				log.Info("Waiting for VM to be deleted", "index", i+1, "totalVMsToBeDeleted", diff)
-			}
+		return r.deleteVMs(ctx, rs, getMachinesToDeletePrioritized(vms, diff, deletePriorityFunc))
+	}
+
+	return nil
+}
+
+// createVMs creates count new VirtualMachines from the replica set's template.
+func (r *Reconciler) createVMs(
+	ctx *pkgctx.VirtualMachineReplicaSetContext,
+	rs *vmopv1.VirtualMachineReplicaSet,
+	count int) error {
+
+	var (
+		vmList []*vmopv1.VirtualMachine
+		errs   []error
+	)
+
+	for i := 0; i < count; i++ {
+		vm := r.getNewVirtualMachine(rs)
+		log := ctx.Logger.WithValues("vm", vm.Name)
+		log.Info("Creating VM", "index", i+1, "totalVMsToBeCreated", count)
+
+		if err := r.Client.Create(ctx, vm); err != nil {
+			log.Error(err, "Error while creating VirtualMachine")
+			r.Recorder.Warnf(rs, "FailedCreate", "Failed to create VirtualMachine: %v", err)
+			errs = append(errs, err)
+			conditions.MarkFalse(
+				rs,
+				vmopv1.VirtualMachinesCreatedCondition,
+				vmopv1.VirtualMachineCreationFailedReason,
+				err.Error(),
+			)
+			continue
 		}
 
-		if len(errs) > 0 {
-			return apierrorsutil.NewAggregate(errs)
+		log.V(5).Info("Created VM", "index", i+1, "totalVMsToBeCreated", count)
+		r.Recorder.Eventf(rs, "SuccessfulCreate", "Created vm %q", vm.Name)
+		vmList = append(vmList, vm)
+	}
+
+	if len(errs) > 0 {
+		return apierrorsutil.NewAggregate(errs)
+	}
+
+	return r.waitForVMCreation(ctx, vmList)
+}
+
+// deleteVMs deletes the provided VirtualMachines.
+func (r *Reconciler) deleteVMs(
+	ctx *pkgctx.VirtualMachineReplicaSetContext,
+	rs *vmopv1.VirtualMachineReplicaSet,
+	vmsToDelete []*vmopv1.VirtualMachine) error {
+
+	var errs []error
+	for i, vm := range vmsToDelete {
+		log := ctx.Logger.WithValues("vm", vm.Name)
+		if vm.GetDeletionTimestamp().IsZero() {
+			log.Info("Deleting VM", "index", i+1, "totalVMsToBeDeleted", len(vmsToDelete))
+
+			if err := r.Client.Delete(ctx, vm); err != nil {
+				log.Error(err, "Unable to delete VM")
+				r.Recorder.Warnf(rs, "FailedDelete", "Failed to delete VM %q: %v", vm.Name, err)
+				errs = append(errs, err)
+				continue
+			}
+			log.V(5).Info("Deleted VM", "index", i+1, "totalVMsToBeDeleted", len(vmsToDelete))
+			r.Recorder.Eventf(rs, "SuccessfulDelete", "Deleted VM %q", vm.Name)
+		} else {
+			log.Info("Waiting for VM to be deleted", "index", i+1, "totalVMsToBeDeleted", len(vmsToDelete))
 		}
-		return r.waitForVMDeletion(ctx, vmsToDelete)
 	}
 
-	return nil
+	if len(errs) > 0 {
+		return apierrorsutil.NewAggregate(errs)
+	}
+	return r.waitForVMDeletion(ctx, vmsToDelete)
 }
 
 func (r *Reconciler) waitForVMDeletion(ctx *pkgctx.VirtualMachineReplicaSetContext, vmList []*vmopv1.VirtualMachine) error {
@@ -606,6 +633,7 @@ func (r *Reconciler) updateStatus(
 	// in the template.
 	fullyLabeledReplicasCount := 0
 	readyReplicasCount := 0
+	updatedReplicasCount := 0
 	desiredReplicas := *rs.Spec.Replicas
 	// Create a selector from labels since that is significantly faster at scale
 	// and initializing a selector directly.
@@ -621,16 +649,21 @@ func (r *Reconciler) updateStatus(
 		// For now, we count all replicas as ready and available.
 		readyReplicasCount++
 
+		if vm.DeletionTimestamp.IsZero() && !isVMOutdated(rs, vm) {
+			updatedReplicasCount++
+		}
 	}
 
 	newStatus.Replicas = int32(len(filteredVMs))
 	newStatus.FullyLabeledReplicas = int32(fullyLabeledReplicasCount)
 	newStatus.ReadyReplicas = int32(readyReplicasCount)
+	newStatus.UpdatedReplicas = int32(updatedReplicasCount)
 
 	// Copy the newly calculated status into the VirtualMachineReplicaSet.
 	if rs.Status.Replicas != newStatus.Replicas ||
 		rs.Status.FullyLabeledReplicas != newStatus.FullyLabeledReplicas ||
 		rs.Status.ReadyReplicas != newStatus.ReadyReplicas ||
+		rs.Status.UpdatedReplicas != newStatus.UpdatedReplicas ||
 		rs.Generation != rs.Status.ObservedGeneration {
 
 		ctx.Logger.Info("Updating status",
@@ -641,6 +674,8 @@ func (r *Reconciler) updateStatus(
 			"fullyLabeledReplicaCountNew", newStatus.FullyLabeledReplicas,
 			"readyReplicasOld", rs.Status.ReadyReplicas,
 			"readyReplicasNew", newStatus.ReadyReplicas,
+			"updatedReplicasOld", rs.Status.UpdatedReplicas,
+			"updatedReplicasNew", newStatus.UpdatedReplicas,
 			"observedGenerationOld", rs.Status.ObservedGeneration,
 			"observedGenerationNew", newStatus.ObservedGeneration)
 
@@ -686,5 +721,12 @@ func (r *Reconciler) updateStatus(
 		// This means that we have sufficient number of VirtualMachine objects.
 		conditions.MarkTrue(rs, vmopv1.VirtualMachinesCreatedCondition)
 	}
+
+	// The VirtualMachinesUpdated condition is marked false by the rollout
+	// while there are outdated replicas.
+	if len(getOutdatedVMs(rs, filteredVMs)) == 0 {
+		conditions.MarkTrue(rs, vmopv1.VirtualMachinesUpdatedCondition)
+	}
+
 	// TODO: Set aggregate condition based on the condition of the individual Virtual Machines
 }
@@ -0,0 +1,243 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinereplicaset_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	vmopv1common "github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinereplicaset"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Rollout",
+		Label(
+			testlabels.Controller,
+			testlabels.V1Alpha3,
+		),
+		unitTestsRollout,
+	)
+}
+
+func unitTestsRollout() {
+	const (
+		oldClass = "old-class"
+		newClass = "new-class"
+	)
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler *virtualmachinereplicaset.Reconciler
+		rsCtx      *pkgctx.VirtualMachineReplicaSetContext
+		rs         *vmopv1.VirtualMachineReplicaSet
+	)
+
+	newReplica := func(name, className string) *vmopv1.VirtualMachine {
+		vm := &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: rs.Namespace,
+				Labels: map[string]string{
+					"appname":                                "db",
+					vmopv1.VirtualMachineReplicaSetNameLabel: rs.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(rs, vmopv1.GroupVersion.WithKind("VirtualMachineReplicaSet")),
+				},
+			},
+			Spec: vmopv1.VirtualMachineSpec{
+				ImageName: "dummy-image",
+				ClassName: className,
+			},
+		}
+		conditions.MarkTrue(vm, vmopv1.VirtualMachineConditionCreated)
+		return vm
+	}
+
+	listReplicas := func() []vmopv1.VirtualMachine {
+		list := &vmopv1.VirtualMachineList{}
+		ExpectWithOffset(1, ctx.Client.List(ctx, list, client.InNamespace(rs.Namespace))).To(Succeed())
+		return list.Items
+	}
+
+	countByClass := func(vms []vmopv1.VirtualMachine, className string) int {
+		var n int
+		for i := range vms {
+			if vms[i].Spec.ClassName == className {
+				n++
+			}
+		}
+		return n
+	}
+
+	markAllCreated := func() {
+		for _, vm := range listReplicas() {
+			vm := vm
+			conditions.MarkTrue(&vm, vmopv1.VirtualMachineConditionCreated)
+			ExpectWithOffset(1, ctx.Client.Status().Update(ctx, &vm)).To(Succeed())
+		}
+	}
+
+	BeforeEach(func() {
+		replicas := int32(2)
+		rs = &vmopv1.VirtualMachineReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "dummy-rs",
+				Namespace:  "dummy-ns",
+				UID:        "dummy-rs-uid",
+				Finalizers: []string{finalizerName},
+			},
+			Spec: vmopv1.VirtualMachineReplicaSetSpec{
+				Replicas: &replicas,
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"appname": "db"},
+				},
+				Template: vmopv1.VirtualMachineTemplateSpec{
+					ObjectMeta: vmopv1common.ObjectMeta{
+						Labels: map[string]string{"appname": "db"},
+					},
+					Spec: vmopv1.VirtualMachineSpec{
+						ImageName: "dummy-image",
+						ClassName: newClass,
+					},
+				},
+			},
+		}
+		initObjects = []client.Object{
+			rs,
+			newReplica("vm-1", oldClass),
+			newReplica("vm-2", oldClass),
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachinereplicaset.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+		)
+		rsCtx = &pkgctx.VirtualMachineReplicaSetContext{
+			Context:    ctx,
+			Logger:     ctx.Logger,
+			ReplicaSet: rs,
+		}
+	})
+
+	AfterEach(func() {
+		ctx = nil
+		initObjects = nil
+	})
+
+	When("the strategy is RollingUpdate", func() {
+		It("surges a new replica before deleting an outdated one", func() {
+			_, err := reconciler.ReconcileNormal(rsCtx)
+			Expect(err).ToNot(HaveOccurred())
+
+			vms := listReplicas()
+			Expect(vms).To(HaveLen(3))
+			Expect(countByClass(vms, oldClass)).To(Equal(2))
+			Expect(countByClass(vms, newClass)).To(Equal(1))
+			Expect(conditions.IsFalse(rs, vmopv1.VirtualMachinesUpdatedCondition)).To(BeTrue())
+			Expect(conditions.GetReason(rs, vmopv1.VirtualMachinesUpdatedCondition)).To(Equal(vmopv1.RollingUpdateReason))
+
+			By("not deleting an outdated replica until the new one is available", func() {
+				_, err := reconciler.ReconcileNormal(rsCtx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(listReplicas()).To(HaveLen(3))
+			})
+
+			By("deleting an outdated replica once the new one is available", func() {
+				markAllCreated()
+				_, err := reconciler.ReconcileNormal(rsCtx)
+				Expect(err).ToNot(HaveOccurred())
+
+				vms := listReplicas()
+				Expect(countByClass(vms, oldClass)).To(Equal(1))
+				Expect(countByClass(vms, newClass)).To(Equal(1))
+			})
+
+			By("replacing the remaining outdated replica", func() {
+				for i := 0; i < 3; i++ {
+					markAllCreated()
+					_, err := reconciler.ReconcileNormal(rsCtx)
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				vms := listReplicas()
+				Expect(vms).To(HaveLen(2))
+				Expect(countByClass(vms, newClass)).To(Equal(2))
+				Expect(rs.Status.UpdatedReplicas).To(Equal(int32(2)))
+				Expect(conditions.IsTrue(rs, vmopv1.VirtualMachinesUpdatedCondition)).To(BeTrue())
+			})
+		})
+
+		When("maxUnavailable is 1 and maxSurge is 0", func() {
+			BeforeEach(func() {
+				rs.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineReplicaSetRollingUpdate{
+					MaxUnavailable: ptrTo(intstr.FromInt32(1)),
+					MaxSurge:       ptrTo(intstr.FromInt32(0)),
+				}
+			})
+
+			It("deletes one outdated replica at a time without surging", func() {
+				_, err := reconciler.ReconcileNormal(rsCtx)
+				Expect(err).ToNot(HaveOccurred())
+
+				vms := listReplicas()
+				Expect(vms).To(HaveLen(1))
+				Expect(countByClass(vms, oldClass)).To(Equal(1))
+			})
+		})
+	})
+
+	When("the strategy is Recreate", func() {
+		BeforeEach(func() {
+			rs.Spec.Strategy.Type = vmopv1.VirtualMachineReplicaSetUpdateStrategyTypeRecreate
+		})
+
+		It("deletes all outdated replicas before creating new ones", func() {
+			_, err := reconciler.ReconcileNormal(rsCtx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listReplicas()).To(BeEmpty())
+			Expect(conditions.GetReason(rs, vmopv1.VirtualMachinesUpdatedCondition)).To(Equal(vmopv1.RecreateReason))
+
+			_, err = reconciler.ReconcileNormal(rsCtx)
+			Expect(err).ToNot(HaveOccurred())
+
+			vms := listReplicas()
+			Expect(vms).To(HaveLen(2))
+			Expect(countByClass(vms, newClass)).To(Equal(2))
+		})
+	})
+
+	When("the replicas are up-to-date", func() {
+		BeforeEach(func() {
+			rs.Spec.Template.Spec.ClassName = oldClass
+		})
+
+		It("does not replace any replicas", func() {
+			_, err := reconciler.ReconcileNormal(rsCtx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listReplicas()).To(HaveLen(2))
+			Expect(rs.Status.UpdatedReplicas).To(Equal(int32(2)))
+			Expect(conditions.IsTrue(rs, vmopv1.VirtualMachinesUpdatedCondition)).To(BeTrue())
+		})
+	})
+}
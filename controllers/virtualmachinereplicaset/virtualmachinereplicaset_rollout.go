@@ -0,0 +1,227 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinereplicaset
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+)
+
+var (
+	defaultMaxSurge       = intstr.FromInt32(1)
+	defaultMaxUnavailable = intstr.FromInt32(0)
+)
+
+// isVMOutdated returns true if the VM's class or image does not match the
+// ones in the replica set's template.
+func isVMOutdated(
+	rs *vmopv1.VirtualMachineReplicaSet,
+	vm *vmopv1.VirtualMachine) bool {
+
+	tmpl := rs.Spec.Template.Spec
+
+	if tmpl.ClassName != vm.Spec.ClassName {
+		return true
+	}
+
+	tmplKind, tmplName := imageKindAndName(tmpl)
+	vmKind, vmName := imageKindAndName(vm.Spec)
+
+	if tmplName != vmName {
+		return true
+	}
+
+	// The kind is only compared when specified in the template since the VM's
+	// mutation webhook resolves the kind from the image name.
+	return tmplKind != "" && tmplKind != vmKind
+}
+
+func imageKindAndName(spec vmopv1.VirtualMachineSpec) (string, string) {
+	if i := spec.Image; i != nil && i.Name != "" {
+		return i.Kind, i.Name
+	}
+	return "", spec.ImageName
+}
+
+// isVMAvailable returns true if the VM is not being deleted, has been
+// created, and, if it has a readiness probe, is ready. This matches when the
+// VM is included in the endpoints of a VirtualMachineService.
+func isVMAvailable(vm *vmopv1.VirtualMachine) bool {
+	if !vm.DeletionTimestamp.IsZero() {
+		return false
+	}
+
+	if probe := vm.Spec.ReadinessProbe; probe != nil &&
+		(probe.TCPSocket != nil || probe.GuestHeartbeat != nil || len(probe.GuestInfo) != 0) {
+
+		return conditions.IsTrue(vm, vmopv1.ReadyConditionType)
+	}
+
+	return conditions.IsTrue(vm, vmopv1.VirtualMachineConditionCreated)
+}
+
+// getOutdatedVMs returns the VMs that are not being deleted and whose class or
+// image does not match the replica set's template.
+func getOutdatedVMs(
+	rs *vmopv1.VirtualMachineReplicaSet,
+	vms []*vmopv1.VirtualMachine) []*vmopv1.VirtualMachine {
+
+	var outdated []*vmopv1.VirtualMachine
+	for _, vm := range vms {
+		if vm.DeletionTimestamp.IsZero() && isVMOutdated(rs, vm) {
+			outdated = append(outdated, vm)
+		}
+	}
+	return outdated
+}
+
+// resolveRollingUpdate returns the maximum number of VMs that may be created
+// above, and be unavailable below, the desired number of replicas.
+func resolveRollingUpdate(rs *vmopv1.VirtualMachineReplicaSet) (int, int, error) {
+	var (
+		maxSurge       = defaultMaxSurge
+		maxUnavailable = defaultMaxUnavailable
+		replicas       int
+	)
+
+	if ru := rs.Spec.Strategy.RollingUpdate; ru != nil {
+		if ru.MaxSurge != nil {
+			maxSurge = *ru.MaxSurge
+		}
+		if ru.MaxUnavailable != nil {
+			maxUnavailable = *ru.MaxUnavailable
+		}
+	}
+	if rs.Spec.Replicas != nil {
+		replicas = int(*rs.Spec.Replicas)
+	}
+
+	surge, err := intstr.GetScaledValueFromIntOrPercent(&maxSurge, replicas, true)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maxSurge: %w", err)
+	}
+	unavailable, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, replicas, false)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maxUnavailable: %w", err)
+	}
+
+	// Ensure the rollout can make progress.
+	if surge == 0 && unavailable == 0 {
+		unavailable = 1
+	}
+
+	return surge, unavailable, nil
+}
+
+// rolloutReplicas replaces the outdated VMs according to the replica set's
+// update strategy. It returns true if the rollout is in progress, in which
+// case the VMs should not otherwise be scaled.
+func (r *Reconciler) rolloutReplicas(
+	ctx *pkgctx.VirtualMachineReplicaSetContext,
+	rs *vmopv1.VirtualMachineReplicaSet,
+	vms []*vmopv1.VirtualMachine) (bool, error) {
+
+	outdated := getOutdatedVMs(rs, vms)
+	if len(outdated) == 0 {
+		return false, nil
+	}
+
+	if rs.Spec.Strategy.Type == vmopv1.VirtualMachineReplicaSetUpdateStrategyTypeRecreate {
+		// Delete all of the outdated VMs. New VMs are created by the regular
+		// scale up once the outdated VMs no longer exist.
+		ctx.Logger.Info("ReplicaSet is recreating outdated VMs", "outdatedVMs", len(outdated))
+		conditions.MarkFalse(
+			rs,
+			vmopv1.VirtualMachinesUpdatedCondition,
+			vmopv1.RecreateReason,
+			"Recreating %d outdated VirtualMachines",
+			len(outdated))
+
+		return true, r.deleteVMs(ctx, rs, outdated)
+	}
+
+	maxSurge, maxUnavailable, err := resolveRollingUpdate(rs)
+	if err != nil {
+		return true, err
+	}
+
+	var (
+		desired   = int(*rs.Spec.Replicas)
+		available int
+		updated   int
+	)
+
+	for _, vm := range vms {
+		if isVMAvailable(vm) {
+			available++
+		}
+		if vm.DeletionTimestamp.IsZero() && !isVMOutdated(rs, vm) {
+			updated++
+		}
+	}
+
+	ctx.Logger.Info("ReplicaSet is rolling out updated VMs",
+		"desiredReplicas", desired,
+		"currentReplicas", len(vms),
+		"updatedReplicas", updated,
+		"availableReplicas", available,
+		"outdatedReplicas", len(outdated),
+		"maxSurge", maxSurge,
+		"maxUnavailable", maxUnavailable)
+
+	conditions.MarkFalse(
+		rs,
+		vmopv1.VirtualMachinesUpdatedCondition,
+		vmopv1.RollingUpdateReason,
+		"Rolling update in progress (%d of %d replicas updated)",
+		updated,
+		desired)
+
+	// Create new VMs up to the surge limit. VMs that are being deleted still
+	// count against the limit since they may still be using resources.
+	if n := min(desired+maxSurge-len(vms), desired-updated); n > 0 {
+		if err := r.createVMs(ctx, rs, n); err != nil {
+			return true, err
+		}
+	}
+
+	// Delete outdated VMs without the number of available VMs dropping below
+	// the minimum. Outdated VMs that are not available are deleted first since
+	// doing so does not reduce the number of available VMs.
+	sort.SliceStable(outdated, func(i, j int) bool {
+		ai, aj := isVMAvailable(outdated[i]), isVMAvailable(outdated[j])
+		if ai != aj {
+			return !ai
+		}
+		return outdated[i].Name < outdated[j].Name
+	})
+
+	var (
+		minAvailable = desired - maxUnavailable
+		vmsToDelete  []*vmopv1.VirtualMachine
+	)
+
+	for _, vm := range outdated {
+		if isVMAvailable(vm) {
+			if available-1 < minAvailable {
+				break
+			}
+			available--
+		}
+		vmsToDelete = append(vmsToDelete, vm)
+	}
+
+	if len(vmsToDelete) == 0 {
+		return true, nil
+	}
+
+	return true, r.deleteVMs(ctx, rs, vmsToDelete)
+}
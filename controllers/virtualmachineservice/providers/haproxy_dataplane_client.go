@@ -0,0 +1,308 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const haproxyConfigurationPath = "/v2/services/haproxy/configuration"
+
+// haproxyProxy is the HAProxy configuration that realizes a single port of a
+// VirtualMachineService: a frontend that binds to the load balancer IP and
+// port, and a backend with a server for each of the Service's endpoints.
+type haproxyProxy struct {
+	Frontend haproxyFrontend
+	Bind     haproxyBind
+	Backend  haproxyBackend
+	Servers  []haproxyServer
+}
+
+type haproxyFrontend struct {
+	Name           string `json:"name"`
+	Mode           string `json:"mode,omitempty"`
+	DefaultBackend string `json:"default_backend,omitempty"`
+}
+
+type haproxyBind struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Port    int32  `json:"port"`
+}
+
+type haproxyBackend struct {
+	Name    string          `json:"name"`
+	Mode    string          `json:"mode,omitempty"`
+	Balance *haproxyBalance `json:"balance,omitempty"`
+}
+
+type haproxyBalance struct {
+	Algorithm string `json:"algorithm"`
+}
+
+type haproxyServer struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Port    int32  `json:"port"`
+}
+
+// haproxyDataPlaneClient is a minimal client for version 2 of the HAProxy
+// Data Plane API.
+type haproxyDataPlaneClient struct {
+	baseURL    string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// SyncProxies updates the HAProxy configuration so the proxies whose names
+// start with prefix match the desired proxies. The configuration is only
+// changed, and HAProxy reloaded, when the proxies differ.
+func (c *haproxyDataPlaneClient) SyncProxies(
+	ctx context.Context,
+	prefix string,
+	desired []haproxyProxy) error {
+
+	current, err := c.getProxies(ctx, prefix)
+	if err != nil {
+		return err
+	}
+
+	for i := range desired {
+		if len(desired[i].Servers) == 0 {
+			desired[i].Servers = nil
+		}
+		slices.SortFunc(desired[i].Servers, func(a, b haproxyServer) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+	}
+	sortProxies(desired)
+
+	if len(current) == len(desired) && (len(current) == 0 || reflect.DeepEqual(current, desired)) {
+		return nil
+	}
+
+	var version int64
+	if err := c.do(ctx, http.MethodGet, "/version", nil, nil, &version); err != nil {
+		return err
+	}
+
+	var txn struct {
+		ID string `json:"id"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/v2/services/haproxy/transactions",
+		url.Values{"version": []string{strconv.FormatInt(version, 10)}}, nil, &txn); err != nil {
+		return err
+	}
+
+	if err := c.replaceProxies(ctx, txn.ID, current, desired); err != nil {
+		_ = c.do(ctx, http.MethodDelete, "/v2/services/haproxy/transactions/"+txn.ID, nil, nil, nil)
+		return err
+	}
+
+	return c.do(ctx, http.MethodPut, "/v2/services/haproxy/transactions/"+txn.ID, nil, nil, nil)
+}
+
+func (c *haproxyDataPlaneClient) replaceProxies(
+	ctx context.Context,
+	txnID string,
+	current, desired []haproxyProxy) error {
+
+	txn := url.Values{"transaction_id": []string{txnID}}
+
+	for _, p := range current {
+		if p.Frontend.Name != "" {
+			if err := c.do(ctx, http.MethodDelete, "/frontends/"+p.Frontend.Name, txn, nil, nil); err != nil {
+				return err
+			}
+		}
+		if p.Backend.Name != "" {
+			if err := c.do(ctx, http.MethodDelete, "/backends/"+p.Backend.Name, txn, nil, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, p := range desired {
+		if err := c.do(ctx, http.MethodPost, "/backends", txn, p.Backend, nil); err != nil {
+			return err
+		}
+		for _, s := range p.Servers {
+			q := url.Values{"transaction_id": []string{txnID}, "backend": []string{p.Backend.Name}}
+			if err := c.do(ctx, http.MethodPost, "/servers", q, s, nil); err != nil {
+				return err
+			}
+		}
+		if err := c.do(ctx, http.MethodPost, "/frontends", txn, p.Frontend, nil); err != nil {
+			return err
+		}
+		q := url.Values{"transaction_id": []string{txnID}, "frontend": []string{p.Frontend.Name}}
+		if err := c.do(ctx, http.MethodPost, "/binds", q, p.Bind, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getProxies returns the proxies whose frontend or backend name starts with
+// prefix, sorted by name.
+func (c *haproxyDataPlaneClient) getProxies(
+	ctx context.Context,
+	prefix string) ([]haproxyProxy, error) {
+
+	var frontends struct {
+		Data []haproxyFrontend `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/frontends", nil, nil, &frontends); err != nil {
+		return nil, err
+	}
+
+	var backends struct {
+		Data []haproxyBackend `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/backends", nil, nil, &backends); err != nil {
+		return nil, err
+	}
+
+	proxies := map[string]*haproxyProxy{}
+	for _, f := range frontends.Data {
+		if !strings.HasPrefix(f.Name, prefix) {
+			continue
+		}
+
+		var binds struct {
+			Data []haproxyBind `json:"data"`
+		}
+		if err := c.do(ctx, http.MethodGet, "/binds",
+			url.Values{"frontend": []string{f.Name}}, nil, &binds); err != nil {
+			return nil, err
+		}
+
+		// A frontend that does not have exactly one bind is left with an
+		// empty bind so it does not match and is recreated.
+		p := &haproxyProxy{Frontend: f}
+		if len(binds.Data) == 1 {
+			p.Bind = binds.Data[0]
+		}
+		proxies[f.Name] = p
+	}
+
+	for _, b := range backends.Data {
+		if !strings.HasPrefix(b.Name, prefix) {
+			continue
+		}
+
+		var servers struct {
+			Data []haproxyServer `json:"data"`
+		}
+		if err := c.do(ctx, http.MethodGet, "/servers",
+			url.Values{"backend": []string{b.Name}}, nil, &servers); err != nil {
+			return nil, err
+		}
+		slices.SortFunc(servers.Data, func(a, b haproxyServer) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		// The backend of a proxy is named after its frontend.
+		name := strings.TrimSuffix(b.Name, haproxyBackendSuffix)
+		p, ok := proxies[name]
+		if !ok {
+			p = &haproxyProxy{}
+			proxies[name] = p
+		}
+		p.Backend = b
+		if len(servers.Data) > 0 {
+			p.Servers = servers.Data
+		}
+	}
+
+	result := make([]haproxyProxy, 0, len(proxies))
+	for _, p := range proxies {
+		result = append(result, *p)
+	}
+	sortProxies(result)
+
+	return result, nil
+}
+
+func sortProxies(proxies []haproxyProxy) {
+	slices.SortFunc(proxies, func(a, b haproxyProxy) int {
+		return strings.Compare(
+			a.Frontend.Name+a.Backend.Name,
+			b.Frontend.Name+b.Backend.Name)
+	})
+}
+
+// do sends a request to the configuration endpoint of the Data Plane API, or
+// to path if it is an absolute API path.
+func (c *haproxyDataPlaneClient) do(
+	ctx context.Context,
+	method, path string,
+	query url.Values,
+	in, out any) error {
+
+	if !strings.HasPrefix(path, "/v2/") {
+		path = haproxyConfigurationPath + path
+	}
+
+	u := strings.TrimSuffix(c.baseURL, "/") + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("HAProxy Data Plane API request %s %s failed: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("HAProxy Data Plane API request %s %s failed: %s: %s",
+			method, path, resp.Status, strings.TrimSpace(string(data)))
+	}
+
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("failed to decode HAProxy Data Plane API response for %s %s: %w",
+				method, path, err)
+		}
+	}
+
+	return nil
+}
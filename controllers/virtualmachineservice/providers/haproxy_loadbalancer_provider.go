@@ -0,0 +1,301 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineservice/utils"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+)
+
+const (
+	// LabelServiceLoadBalancerProvider is the label placed on a Service to
+	// indicate which load balancer implementation realizes the Service.
+	LabelServiceLoadBalancerProvider = "vmoperator.vmware.com/loadbalancer-provider"
+
+	// HAProxyDataPlaneAPISecretName is the name of the Secret in the pod's
+	// namespace with the "username" and "password", and optionally the
+	// "ca.crt", used to connect to the HAProxy Data Plane API.
+	HAProxyDataPlaneAPISecretName = "vmoperator-haproxy-dataplane-api"
+
+	haproxyNamePrefix    = "vmop"
+	haproxyBackendSuffix = "_backend"
+	haproxyMode          = "tcp"
+	haproxyAlgorithm     = "roundrobin"
+	haproxyBindName      = "vip"
+
+	haproxyRequestTimeout = 30 * time.Second
+)
+
+type HAProxyLoadbalancerProvider struct {
+	client    ctrlclient.Client
+	apiReader ctrlclient.Reader
+
+	mu          sync.Mutex
+	dpClient    *haproxyDataPlaneClient
+	dpClientKey string
+}
+
+// HAProxyLoadBalancerProvider returns a HAProxyLoadbalancerProvider instance
+// that is used when the VirtualMachineService's load balancer is realized by
+// HAProxy, ex. vSphere networking without NSX-T. The load balancer is
+// realized by configuring HAProxy with its Data Plane API.
+func HAProxyLoadBalancerProvider(
+	client ctrlclient.Client,
+	apiReader ctrlclient.Reader) *HAProxyLoadbalancerProvider {
+
+	return &HAProxyLoadbalancerProvider{
+		client:    client,
+		apiReader: apiReader,
+	}
+}
+
+// EnsureLoadBalancer configures HAProxy with a frontend that listens on the
+// VirtualMachineService's loadBalancerIP for each of its ports, and a backend
+// with the addresses of the Service's Endpoints. The Service's load balancer
+// status is set to the loadBalancerIP once HAProxy is configured.
+//
+// The HAProxy load balancer is not able to preserve the client source IP, so
+// the Local externalTrafficPolicy is not supported. Since the Data Plane API
+// does not allocate IP addresses, the loadBalancerIP is required.
+func (hl *HAProxyLoadbalancerProvider) EnsureLoadBalancer(ctx context.Context, vmService *vmopv1.VirtualMachineService) error {
+	if vmService == nil {
+		return nil
+	}
+
+	if etp := vmService.Annotations[utils.AnnotationServiceExternalTrafficPolicyKey]; corev1.ServiceExternalTrafficPolicyType(etp) == corev1.ServiceExternalTrafficPolicyTypeLocal {
+		return fmt.Errorf("externalTrafficPolicy %q is not supported by the %s load balancer", etp, HAProxyLoadBalancer)
+	}
+
+	ip := vmService.Spec.LoadBalancerIP
+	if ip == "" {
+		return fmt.Errorf("loadBalancerIP is required by the %s load balancer", HAProxyLoadBalancer)
+	}
+	if net.ParseIP(ip) == nil {
+		return fmt.Errorf("invalid loadBalancerIP %q", ip)
+	}
+
+	for _, p := range vmService.Spec.Ports {
+		if p.Protocol != "" && corev1.Protocol(p.Protocol) != corev1.ProtocolTCP {
+			return fmt.Errorf("protocol %q of port %q is not supported by the %s load balancer",
+				p.Protocol, p.Name, HAProxyLoadBalancer)
+		}
+	}
+
+	endpoints := &corev1.Endpoints{}
+	if err := hl.client.Get(ctx, ctrlclient.ObjectKeyFromObject(vmService), endpoints); ctrlclient.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	dpClient, err := hl.getDataPlaneClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := dpClient.SyncProxies(ctx, haproxyProxyPrefix(vmService), haproxyProxies(vmService, endpoints)); err != nil {
+		return fmt.Errorf("failed to configure HAProxy: %w", err)
+	}
+
+	return hl.updateServiceStatus(ctx, vmService)
+}
+
+// DeleteLoadBalancer removes the HAProxy frontends and backends of the
+// VirtualMachineService.
+func (hl *HAProxyLoadbalancerProvider) DeleteLoadBalancer(ctx context.Context, vmService *vmopv1.VirtualMachineService) error {
+	dpClient, err := hl.getDataPlaneClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := dpClient.SyncProxies(ctx, haproxyProxyPrefix(vmService), nil); err != nil {
+		return fmt.Errorf("failed to configure HAProxy: %w", err)
+	}
+
+	return nil
+}
+
+// GetServiceLabels provides the intended HAProxy specific labels on Service.
+// The responsibility is left to the caller to actually set them.
+func (hl *HAProxyLoadbalancerProvider) GetServiceLabels(ctx context.Context, vmService *vmopv1.VirtualMachineService) (map[string]string, error) {
+	return map[string]string{
+		LabelServiceLoadBalancerProvider: HAProxyLoadBalancer,
+	}, nil
+}
+
+// GetToBeRemovedServiceLabels provides the to be removed HAProxy specific
+// labels on Service. The NSX-T service proxy label is always removed since
+// HAProxy is used in place of NSX-T. The responsibility is left to the caller
+// to actually clear them.
+func (hl *HAProxyLoadbalancerProvider) GetToBeRemovedServiceLabels(ctx context.Context, vmService *vmopv1.VirtualMachineService) (map[string]string, error) {
+	return map[string]string{
+		LabelServiceProxyName: NSXTServiceProxy,
+	}, nil
+}
+
+// GetServiceAnnotations provides the intended HAProxy specific annotations on
+// Service. There are currently none.
+func (hl *HAProxyLoadbalancerProvider) GetServiceAnnotations(ctx context.Context, vmService *vmopv1.VirtualMachineService) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+// GetToBeRemovedServiceAnnotations provides the to be removed HAProxy specific
+// annotations on Service. The NSX-T health check annotation is always removed
+// since it has no meaning to HAProxy. The responsibility is left to the caller
+// to actually clear them.
+func (hl *HAProxyLoadbalancerProvider) GetToBeRemovedServiceAnnotations(ctx context.Context, vmService *vmopv1.VirtualMachineService) (map[string]string, error) {
+	return map[string]string{
+		ServiceLoadBalancerHealthCheckNodePortTagKey: "",
+	}, nil
+}
+
+// updateServiceStatus sets the ingress of the Service's load balancer status
+// to the loadBalancerIP, from where it is copied to the VirtualMachineService.
+func (hl *HAProxyLoadbalancerProvider) updateServiceStatus(ctx context.Context, vmService *vmopv1.VirtualMachineService) error {
+	service := &corev1.Service{}
+	if err := hl.client.Get(ctx, ctrlclient.ObjectKeyFromObject(vmService), service); err != nil {
+		// The status is set when the Service is reconciled after it is created.
+		return ctrlclient.IgnoreNotFound(err)
+	}
+
+	ingress := []corev1.LoadBalancerIngress{{IP: vmService.Spec.LoadBalancerIP}}
+	if apiequality.Semantic.DeepEqual(service.Status.LoadBalancer.Ingress, ingress) {
+		return nil
+	}
+
+	service.Status.LoadBalancer.Ingress = ingress
+	return hl.client.Status().Update(ctx, service)
+}
+
+// getDataPlaneClient returns a client for the HAProxy Data Plane API. The
+// client is reused until the configured URL or the credentials change.
+func (hl *HAProxyLoadbalancerProvider) getDataPlaneClient(ctx context.Context) (*haproxyDataPlaneClient, error) {
+	cfg := pkgcfg.FromContext(ctx)
+	if cfg.HAProxyDataPlaneAPIURL == "" {
+		return nil, errors.New("the HAProxy Data Plane API URL is not configured")
+	}
+
+	secret := &corev1.Secret{}
+	secretKey := ctrlclient.ObjectKey{Namespace: cfg.PodNamespace, Name: HAProxyDataPlaneAPISecretName}
+	if err := hl.apiReader.Get(ctx, secretKey, secret); err != nil {
+		return nil, fmt.Errorf("failed to get HAProxy Data Plane API secret %s: %w", secretKey, err)
+	}
+
+	username, password, caCert := secret.Data["username"], secret.Data["password"], secret.Data["ca.crt"]
+
+	h := sha256.New()
+	for _, b := range [][]byte{[]byte(cfg.HAProxyDataPlaneAPIURL), username, password, caCert} {
+		_, _ = h.Write([]byte(strconv.Itoa(len(b))))
+		_, _ = h.Write(b)
+	}
+	key := string(h.Sum(nil))
+
+	hl.mu.Lock()
+	defer hl.mu.Unlock()
+
+	if hl.dpClient != nil && hl.dpClientKey == key {
+		return hl.dpClient, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(caCert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to parse ca.crt in HAProxy Data Plane API secret %s", secretKey)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	hl.dpClient = &haproxyDataPlaneClient{
+		baseURL:  cfg.HAProxyDataPlaneAPIURL,
+		username: string(username),
+		password: string(password),
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   haproxyRequestTimeout,
+		},
+	}
+	hl.dpClientKey = key
+
+	return hl.dpClient, nil
+}
+
+// haproxyProxyPrefix returns the prefix of the names of the VirtualMachine
+// Service's HAProxy frontends and backends. Since Kubernetes names do not
+// contain underscores, the prefix is unique to the VirtualMachineService.
+func haproxyProxyPrefix(vmService *vmopv1.VirtualMachineService) string {
+	return fmt.Sprintf("%s_%s_%s_", haproxyNamePrefix, vmService.Namespace, vmService.Name)
+}
+
+// haproxyProxies returns the HAProxy frontends and backends that realize the
+// VirtualMachineService. The backend servers of a port are the addresses of
+// the Endpoints' port with the same name.
+func haproxyProxies(
+	vmService *vmopv1.VirtualMachineService,
+	endpoints *corev1.Endpoints) []haproxyProxy {
+
+	proxies := make([]haproxyProxy, 0, len(vmService.Spec.Ports))
+
+	for _, port := range vmService.Spec.Ports {
+		name := haproxyProxyPrefix(vmService) + strconv.Itoa(int(port.Port))
+		backendName := name + haproxyBackendSuffix
+
+		proxy := haproxyProxy{
+			Frontend: haproxyFrontend{
+				Name:           name,
+				Mode:           haproxyMode,
+				DefaultBackend: backendName,
+			},
+			Bind: haproxyBind{
+				Name:    haproxyBindName,
+				Address: vmService.Spec.LoadBalancerIP,
+				Port:    port.Port,
+			},
+			Backend: haproxyBackend{
+				Name:    backendName,
+				Mode:    haproxyMode,
+				Balance: &haproxyBalance{Algorithm: haproxyAlgorithm},
+			},
+		}
+
+		for _, subset := range endpoints.Subsets {
+			for _, epPort := range subset.Ports {
+				if epPort.Name != port.Name {
+					continue
+				}
+				for _, addr := range subset.Addresses {
+					proxy.Servers = append(proxy.Servers, haproxyServer{
+						Name:    fmt.Sprintf("%s_%d", addr.IP, epPort.Port),
+						Address: addr.IP,
+						Port:    epPort.Port,
+					})
+				}
+			}
+		}
+
+		proxies = append(proxies, proxy)
+	}
+
+	return proxies
+}
@@ -0,0 +1,383 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineservice/utils"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+// fakeDataPlane is an in-memory HAProxy Data Plane API. Changes are applied
+// immediately rather than when the transaction is committed.
+type fakeDataPlane struct {
+	sync.Mutex
+	frontends map[string]haproxyFrontend
+	binds     map[string][]haproxyBind
+	backends  map[string]haproxyBackend
+	servers   map[string][]haproxyServer
+	commits   int
+}
+
+func newFakeDataPlane() *fakeDataPlane {
+	return &fakeDataPlane{
+		frontends: map[string]haproxyFrontend{},
+		binds:     map[string][]haproxyBind{},
+		backends:  map[string]haproxyBackend{},
+		servers:   map[string][]haproxyServer{},
+	}
+}
+
+func (f *fakeDataPlane) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	if u, p, ok := r.BasicAuth(); !ok || u != "admin" || p != "password" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	write := func(v any) {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	decode := func(v any) {
+		_ = json.NewDecoder(r.Body).Decode(v)
+	}
+
+	const txnPath = "/v2/services/haproxy/transactions"
+	path := strings.TrimPrefix(r.URL.Path, haproxyConfigurationPath)
+	q := r.URL.Query()
+
+	switch {
+	case r.URL.Path == txnPath && r.Method == http.MethodPost:
+		write(map[string]string{"id": "txn"})
+	case strings.HasPrefix(r.URL.Path, txnPath+"/"):
+		if r.Method == http.MethodPut {
+			f.commits++
+		}
+	case path == "/version":
+		write(f.commits)
+	case path == "/frontends" && r.Method == http.MethodGet:
+		var data []haproxyFrontend
+		for _, v := range f.frontends {
+			data = append(data, v)
+		}
+		write(map[string]any{"data": data})
+	case path == "/frontends" && r.Method == http.MethodPost:
+		var v haproxyFrontend
+		decode(&v)
+		f.frontends[v.Name] = v
+	case strings.HasPrefix(path, "/frontends/") && r.Method == http.MethodDelete:
+		name := strings.TrimPrefix(path, "/frontends/")
+		delete(f.frontends, name)
+		delete(f.binds, name)
+	case path == "/binds" && r.Method == http.MethodGet:
+		write(map[string]any{"data": f.binds[q.Get("frontend")]})
+	case path == "/binds" && r.Method == http.MethodPost:
+		var v haproxyBind
+		decode(&v)
+		f.binds[q.Get("frontend")] = append(f.binds[q.Get("frontend")], v)
+	case path == "/backends" && r.Method == http.MethodGet:
+		var data []haproxyBackend
+		for _, v := range f.backends {
+			data = append(data, v)
+		}
+		write(map[string]any{"data": data})
+	case path == "/backends" && r.Method == http.MethodPost:
+		var v haproxyBackend
+		decode(&v)
+		f.backends[v.Name] = v
+	case strings.HasPrefix(path, "/backends/") && r.Method == http.MethodDelete:
+		name := strings.TrimPrefix(path, "/backends/")
+		delete(f.backends, name)
+		delete(f.servers, name)
+	case path == "/servers" && r.Method == http.MethodGet:
+		write(map[string]any{"data": f.servers[q.Get("backend")]})
+	case path == "/servers" && r.Method == http.MethodPost:
+		var v haproxyServer
+		decode(&v)
+		f.servers[q.Get("backend")] = append(f.servers[q.Get("backend")], v)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+var _ = Describe(
+	"HAProxy Loadbalancer Provider",
+	Label(testlabels.Controller, testlabels.V1Alpha3),
+	func() {
+		const (
+			podNamespace = "vmop-system"
+			frontendName = "vmop_dummy_dummy-vmservice_22"
+			backendName  = frontendName + "_backend"
+		)
+
+		var (
+			ctx        context.Context
+			dataPlane  *fakeDataPlane
+			server     *httptest.Server
+			k8sClient  ctrlclient.Client
+			initObjs   []ctrlclient.Object
+			vmService  *vmopv1.VirtualMachineService
+			endpoints  *corev1.Endpoints
+			service    *corev1.Service
+			lbProvider *HAProxyLoadbalancerProvider
+		)
+
+		BeforeEach(func() {
+			dataPlane = newFakeDataPlane()
+			server = httptest.NewServer(dataPlane)
+
+			ctx = pkgcfg.NewContextWithDefaultConfig()
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.PodNamespace = podNamespace
+				config.HAProxyDataPlaneAPIURL = server.URL
+			})
+
+			vmService = &vmopv1.VirtualMachineService{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "dummy-vmservice",
+					Namespace:   dummyNamespace,
+					Annotations: make(map[string]string),
+				},
+				Spec: vmopv1.VirtualMachineServiceSpec{
+					Type:           vmopv1.VirtualMachineServiceTypeLoadBalancer,
+					LoadBalancerIP: "192.168.1.10",
+					Ports: []vmopv1.VirtualMachineServicePort{
+						{
+							Name:       "ssh",
+							Protocol:   "TCP",
+							Port:       22,
+							TargetPort: 2222,
+						},
+					},
+				},
+			}
+
+			endpoints = &corev1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      vmService.Name,
+					Namespace: vmService.Namespace,
+				},
+				Subsets: []corev1.EndpointSubset{
+					{
+						Addresses: []corev1.EndpointAddress{
+							{IP: "10.0.0.2"},
+							{IP: "10.0.0.1"},
+						},
+						Ports: []corev1.EndpointPort{
+							{Name: "ssh", Port: 2222},
+						},
+					},
+				},
+			}
+
+			service = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      vmService.Name,
+					Namespace: vmService.Namespace,
+				},
+			}
+
+			initObjs = []ctrlclient.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      HAProxyDataPlaneAPISecretName,
+						Namespace: podNamespace,
+					},
+					Data: map[string][]byte{
+						"username": []byte("admin"),
+						"password": []byte("password"),
+					},
+				},
+				endpoints,
+				service,
+			}
+		})
+
+		JustBeforeEach(func() {
+			k8sClient = builder.NewFakeClient(initObjs...)
+			lbProvider = HAProxyLoadBalancerProvider(k8sClient, k8sClient)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		Context("EnsureLoadBalancer", func() {
+			It("should configure a frontend and backend for each port", func() {
+				Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(Succeed())
+
+				Expect(dataPlane.commits).To(Equal(1))
+				Expect(dataPlane.frontends).To(HaveKeyWithValue(frontendName, haproxyFrontend{
+					Name:           frontendName,
+					Mode:           "tcp",
+					DefaultBackend: backendName,
+				}))
+				Expect(dataPlane.binds[frontendName]).To(ConsistOf(haproxyBind{
+					Name:    "vip",
+					Address: "192.168.1.10",
+					Port:    22,
+				}))
+				Expect(dataPlane.backends).To(HaveKey(backendName))
+				Expect(dataPlane.servers[backendName]).To(ConsistOf(
+					haproxyServer{Name: "10.0.0.1_2222", Address: "10.0.0.1", Port: 2222},
+					haproxyServer{Name: "10.0.0.2_2222", Address: "10.0.0.2", Port: 2222},
+				))
+
+				Expect(k8sClient.Get(ctx, ctrlclient.ObjectKeyFromObject(service), service)).To(Succeed())
+				Expect(service.Status.LoadBalancer.Ingress).To(ConsistOf(corev1.LoadBalancerIngress{IP: "192.168.1.10"}))
+			})
+
+			It("should not change the configuration when it is up to date", func() {
+				Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(Succeed())
+				Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(Succeed())
+				Expect(dataPlane.commits).To(Equal(1))
+			})
+
+			It("should update the backend servers when the endpoints change", func() {
+				Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(Succeed())
+
+				endpoints.Subsets[0].Addresses = endpoints.Subsets[0].Addresses[:1]
+				Expect(k8sClient.Update(ctx, endpoints)).To(Succeed())
+
+				Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(Succeed())
+				Expect(dataPlane.commits).To(Equal(2))
+				Expect(dataPlane.servers[backendName]).To(ConsistOf(
+					haproxyServer{Name: "10.0.0.2_2222", Address: "10.0.0.2", Port: 2222},
+				))
+			})
+
+			It("should not change the proxies of other services", func() {
+				dataPlane.frontends["other"] = haproxyFrontend{Name: "other"}
+				Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(Succeed())
+				Expect(dataPlane.frontends).To(HaveKey("other"))
+			})
+
+			When("the endpoints do not exist", func() {
+				BeforeEach(func() {
+					initObjs = initObjs[:1]
+				})
+
+				It("should configure a backend without servers", func() {
+					Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(Succeed())
+					Expect(dataPlane.backends).To(HaveKey(backendName))
+					Expect(dataPlane.servers[backendName]).To(BeEmpty())
+				})
+			})
+
+			When("etp is Local", func() {
+				BeforeEach(func() {
+					vmService.Annotations[utils.AnnotationServiceExternalTrafficPolicyKey] = string(corev1.ServiceExternalTrafficPolicyTypeLocal)
+				})
+
+				It("should return an error", func() {
+					Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(MatchError(ContainSubstring("is not supported")))
+				})
+			})
+
+			When("loadBalancerIP is not set", func() {
+				BeforeEach(func() {
+					vmService.Spec.LoadBalancerIP = ""
+				})
+
+				It("should return an error", func() {
+					Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(MatchError(ContainSubstring("loadBalancerIP is required")))
+				})
+			})
+
+			When("loadBalancerIP is invalid", func() {
+				BeforeEach(func() {
+					vmService.Spec.LoadBalancerIP = "not-an-ip"
+				})
+
+				It("should return an error", func() {
+					Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(MatchError(ContainSubstring("invalid loadBalancerIP")))
+				})
+			})
+
+			When("a port is UDP", func() {
+				BeforeEach(func() {
+					vmService.Spec.Ports[0].Protocol = "UDP"
+				})
+
+				It("should return an error", func() {
+					Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(MatchError(ContainSubstring(`protocol "UDP"`)))
+				})
+			})
+
+			When("the Data Plane API URL is not configured", func() {
+				BeforeEach(func() {
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.HAProxyDataPlaneAPIURL = ""
+					})
+				})
+
+				It("should return an error", func() {
+					Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(MatchError(ContainSubstring("URL is not configured")))
+				})
+			})
+
+			When("the Data Plane API credentials are wrong", func() {
+				BeforeEach(func() {
+					initObjs[0].(*corev1.Secret).Data["password"] = []byte("wrong")
+				})
+
+				It("should return an error", func() {
+					Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(MatchError(ContainSubstring("401 Unauthorized")))
+				})
+			})
+		})
+
+		Context("DeleteLoadBalancer", func() {
+			It("should remove the frontends and backends", func() {
+				Expect(lbProvider.EnsureLoadBalancer(ctx, vmService)).To(Succeed())
+				Expect(lbProvider.DeleteLoadBalancer(ctx, vmService)).To(Succeed())
+				Expect(dataPlane.frontends).To(BeEmpty())
+				Expect(dataPlane.backends).To(BeEmpty())
+				Expect(dataPlane.commits).To(Equal(2))
+			})
+		})
+
+		Context("GetServiceLabels", func() {
+			It("should return the provider label", func() {
+				labels, err := lbProvider.GetServiceLabels(ctx, vmService)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(labels).To(HaveKeyWithValue(LabelServiceLoadBalancerProvider, HAProxyLoadBalancer))
+			})
+		})
+
+		Context("GetToBeRemovedServiceLabels", func() {
+			It("should remove the NSX-T ServiceProxyName label", func() {
+				labels, err := lbProvider.GetToBeRemovedServiceLabels(ctx, vmService)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(labels).To(HaveKey(LabelServiceProxyName))
+			})
+		})
+
+		Context("GetToBeRemovedServiceAnnotations", func() {
+			It("should remove the NSX-T health check annotation", func() {
+				annotations, err := lbProvider.GetToBeRemovedServiceAnnotations(ctx, vmService)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(annotations).To(HaveKey(ServiceLoadBalancerHealthCheckNodePortTagKey))
+			})
+		})
+	})
@@ -6,6 +6,7 @@ package providers
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -16,7 +17,8 @@ import (
 )
 
 const (
-	NSXTLoadBalancer = "nsx-t-lb"
+	NSXTLoadBalancer    = "nsx-t-lb"
+	HAProxyLoadBalancer = "haproxy"
 
 	ServiceLoadBalancerHealthCheckNodePortTagKey = "ncp/healthCheckNodePort"
 	NSXTServiceProxy                             = "nsx-t"
@@ -30,6 +32,10 @@ const (
 type LoadbalancerProvider interface {
 	EnsureLoadBalancer(ctx context.Context, vmService *vmopv1.VirtualMachineService) error
 
+	// DeleteLoadBalancer removes the load balancer, if any, that was realized
+	// for a VirtualMachineService when it is deleted.
+	DeleteLoadBalancer(ctx context.Context, vmService *vmopv1.VirtualMachineService) error
+
 	// GetServiceLabels returns the labels, if any, to place on a Service.
 	// This is applicable when VirtualMachineService is translated to a
 	// Service and we would like to apply the provider specific labels
@@ -66,8 +72,14 @@ type LoadbalancerProvider interface {
 }
 
 func GetLoadbalancerProviderByType(mgr manager.Manager, providerType string) (LoadbalancerProvider, error) {
-	if providerType == NSXTLoadBalancer {
+	switch providerType {
+	case NSXTLoadBalancer:
 		return NsxtLoadBalancerProvider(), nil
+	case HAProxyLoadBalancer:
+		if mgr == nil {
+			return nil, fmt.Errorf("the %s load balancer requires a manager", HAProxyLoadBalancer)
+		}
+		return HAProxyLoadBalancerProvider(mgr.GetClient(), mgr.GetAPIReader()), nil
 	}
 	return NoopLoadbalancerProvider{}, nil
 }
@@ -78,6 +90,10 @@ func (NoopLoadbalancerProvider) EnsureLoadBalancer(context.Context, *vmopv1.Virt
 	return nil
 }
 
+func (NoopLoadbalancerProvider) DeleteLoadBalancer(context.Context, *vmopv1.VirtualMachineService) error {
+	return nil
+}
+
 func (NoopLoadbalancerProvider) GetServiceLabels(ctx context.Context, vmService *vmopv1.VirtualMachineService) (map[string]string, error) {
 	return nil, nil
 }
@@ -106,6 +122,12 @@ func (nl *NsxtLoadbalancerProvider) EnsureLoadBalancer(ctx context.Context, vmSe
 	return nil
 }
 
+// DeleteLoadBalancer is a no-op since NCP removes the load balancer when the
+// Service is deleted.
+func (nl *NsxtLoadbalancerProvider) DeleteLoadBalancer(ctx context.Context, vmService *vmopv1.VirtualMachineService) error {
+	return nil
+}
+
 // GetServiceLabels provides the intended NSX-T specific labels on Service. The
 // responsibility is left to the caller to actually set them.
 func (nl *NsxtLoadbalancerProvider) GetServiceLabels(ctx context.Context, vmService *vmopv1.VirtualMachineService) (map[string]string, error) {
@@ -131,6 +153,9 @@ func (nl *NsxtLoadbalancerProvider) GetToBeRemovedServiceLabels(ctx context.Cont
 		res[LabelServiceProxyName] = NSXTServiceProxy
 	}
 
+	// The Service is not realized by HAProxy.
+	res[LabelServiceLoadBalancerProvider] = ""
+
 	return res, nil
 }
 
@@ -43,6 +43,11 @@ var _ = Describe(
 				Expect(lbProvider).ToNot(BeNil())
 			})
 
+			It("should return an error for the haproxy load balancer provider without a manager", func() {
+				_, err := GetLoadbalancerProviderByType(nil, HAProxyLoadBalancer)
+				Expect(err).To(MatchError(ContainSubstring("requires a manager")))
+			})
+
 			It("should successfully get a noop loadbalancer provider", func() {
 				lbProvider, err := GetLoadbalancerProviderByType(nil, "")
 				Expect(err).NotTo(HaveOccurred())
@@ -7,4 +7,33 @@ package utils
 const (
 	AnnotationServiceExternalTrafficPolicyKey = "virtualmachineservice.vmoperator.vmware.com/service.externalTrafficPolicy"
 	AnnotationServiceHealthCheckNodePortKey   = "virtualmachineservice.vmoperator.vmware.com/service.healthCheckNodePort"
+
+	// AnnotationServiceEndpointsPowerStatePolicyKey is the annotation on a
+	// VirtualMachineService that controls how selected VMs that are not
+	// powered on are represented in the Service's Endpoints. The value must
+	// be one of the EndpointsPowerStatePolicy constants. When the annotation
+	// is absent or has an unknown value, EndpointsPowerStatePolicyInclude is
+	// used.
+	AnnotationServiceEndpointsPowerStatePolicyKey = "virtualmachineservice.vmoperator.vmware.com/endpoints.powerStatePolicy"
+
+	// AnnotationServiceEndpointsHostnamesKey is the annotation on a
+	// VirtualMachineService that, when "true", sets the hostname of each VM's
+	// address in the Service's Endpoints to the VM's host name. Together with
+	// the external-dns.alpha.kubernetes.io/hostname annotation on a headless
+	// Service, this lets ExternalDNS register a record for each selected VM.
+	AnnotationServiceEndpointsHostnamesKey = "virtualmachineservice.vmoperator.vmware.com/endpoints.hostnames"
+)
+
+const (
+	// EndpointsPowerStatePolicyInclude includes VMs in the Endpoints
+	// regardless of their power state. This is the default.
+	EndpointsPowerStatePolicyInclude = "Include"
+
+	// EndpointsPowerStatePolicyNotReady includes powered off and suspended
+	// VMs in the Endpoints' NotReadyAddresses.
+	EndpointsPowerStatePolicyNotReady = "NotReady"
+
+	// EndpointsPowerStatePolicyExclude removes powered off and suspended VMs
+	// from the Endpoints.
+	EndpointsPowerStatePolicyExclude = "Exclude"
 )
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -16,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -32,6 +34,8 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/patch"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/externaldns"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 )
 
@@ -73,7 +77,7 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		lbProvider,
 	)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(controlledType).
 		WithOptions(controller.Options{MaxConcurrentReconciles: ctx.MaxConcurrentReconciles}).
 		Watches(&corev1.Service{},
@@ -81,8 +85,10 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		Watches(&corev1.Endpoints{},
 			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &vmopv1.VirtualMachineService{})).
 		Watches(&vmopv1.VirtualMachine{},
-			handler.EnqueueRequestsFromMapFunc(r.virtualMachineToVirtualMachineServiceMapper())).
-		Complete(r)
+			handler.EnqueueRequestsFromMapFunc(r.virtualMachineToVirtualMachineServiceMapper()))
+
+	return shard.WatchNamespaces(ctx, builder, mgr.GetClient(), &vmopv1.VirtualMachineServiceList{}).
+		Complete(shard.NewReconciler(ctx, mgr.GetClient(), r))
 }
 
 func NewReconciler(
@@ -174,6 +180,13 @@ func (r *ReconcileVirtualMachineService) ReconcileDelete(ctx *pkgctx.VirtualMach
 			return err
 		}
 
+		if ctx.VMService.Spec.Type == vmopv1.VirtualMachineServiceTypeLoadBalancer {
+			if err := r.loadbalancerProvider.DeleteLoadBalancer(ctx, ctx.VMService); err != nil {
+				ctx.Logger.Error(err, "Failed to delete load balancer for VM Service")
+				return err
+			}
+		}
+
 		ctx.Logger.Info("Delete VirtualMachineService")
 		r.recorder.EmitEvent(ctx.VMService, OpDelete, nil, false)
 		controllerutil.RemoveFinalizer(ctx.VMService, finalizerName)
@@ -283,9 +296,9 @@ func (r *ReconcileVirtualMachineService) reconcileVMService(ctx *pkgctx.VirtualM
 }
 
 // virtualMachineToVirtualMachineServiceMapper returns a mapper function that returns reconcile requests for
-// VirtualMachineServices that select a given VM via label selectors.
-// TODO: The VM's labels could have been changed so this should also return VirtualMachineServices that the
-// VM is currently an Endpoint for, because otherwise the VM won't be removed in a timely manner.
+// VirtualMachineServices that select a given VM via label selectors, as well as the VirtualMachineServices
+// whose Endpoints currently reference the VM. The latter ensures a VM whose labels have changed is removed
+// from the Endpoints in a timely manner.
 func (r *ReconcileVirtualMachineService) virtualMachineToVirtualMachineServiceMapper() func(_ context.Context, o client.Object) []reconcile.Request {
 	return func(_ context.Context, o client.Object) []reconcile.Request {
 		vm := o.(*vmopv1.VirtualMachine)
@@ -295,6 +308,17 @@ func (r *ReconcileVirtualMachineService) virtualMachineToVirtualMachineServiceMa
 			return nil
 		}
 
+		referencingRequests, err := r.getVirtualMachineServicesReferencingVirtualMachine(context.Background(), vm)
+		if err != nil {
+			return nil
+		}
+
+		for _, req := range referencingRequests {
+			if !slices.Contains(reconcileRequests, req) {
+				reconcileRequests = append(reconcileRequests, req)
+			}
+		}
+
 		if len(reconcileRequests) != 0 && r.log.V(4).Enabled() {
 			logger := r.log.WithValues("VirtualMachine", client.ObjectKey{Namespace: vm.Namespace, Name: vm.Name})
 			for _, r := range reconcileRequests {
@@ -377,9 +401,38 @@ func (r *ReconcileVirtualMachineService) setServiceAnnotationsAndLabels(
 		}
 	}
 
+	setServiceExternalDNSHostname(ctx, service)
+
 	return nil
 }
 
+// setServiceExternalDNSHostname sets the ExternalDNS hostname annotation on a
+// LoadBalancer Service when an ExternalDNS domain is configured, so that
+// ExternalDNS creates records for the load balancer's addresses. A hostname
+// annotation on the VirtualMachineService takes precedence.
+func setServiceExternalDNSHostname(
+	ctx *pkgctx.VirtualMachineServiceContext,
+	service *corev1.Service) {
+
+	vmService := ctx.VMService
+	if _, ok := vmService.Annotations[externaldns.HostnameAnnotationKey]; ok {
+		return
+	}
+
+	domain := pkgcfg.FromContext(ctx).ExternalDNSDomain
+	if domain == "" || vmService.Spec.Type != vmopv1.VirtualMachineServiceTypeLoadBalancer {
+		if v, ok := service.Annotations[externaldns.HostnameAnnotationKey]; ok {
+			ctx.Logger.V(5).Info("Removing annotation from Service",
+				"key", externaldns.HostnameAnnotationKey, "value", v)
+			delete(service.Annotations, externaldns.HostnameAnnotationKey)
+		}
+		return
+	}
+
+	service.Annotations[externaldns.HostnameAnnotationKey] =
+		externaldns.ServiceHostname(vmService.Name, vmService.Namespace, domain)
+}
+
 func (r *ReconcileVirtualMachineService) createOrUpdateService(ctx *pkgctx.VirtualMachineServiceContext) (*corev1.Service, error) {
 	ctx.Logger.V(5).Info("Reconciling k8s Service")
 	defer ctx.Logger.V(5).Info("Finished reconciling k8s Service")
@@ -538,6 +591,51 @@ func (r *ReconcileVirtualMachineService) getVirtualMachineServicesSelectingVirtu
 	return matchingVMServices, nil
 }
 
+// getVirtualMachineServicesReferencingVirtualMachine returns reconcile requests for the
+// VirtualMachineServices whose Endpoints have an address that targets the VM.
+func (r *ReconcileVirtualMachineService) getVirtualMachineServicesReferencingVirtualMachine(
+	ctx context.Context,
+	lookupVM *vmopv1.VirtualMachine) ([]reconcile.Request, error) {
+
+	if lookupVM.UID == "" {
+		return nil, nil
+	}
+
+	endpointsList := &corev1.EndpointsList{}
+	if err := r.List(ctx, endpointsList, client.InNamespace(lookupVM.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var requests []reconcile.Request
+	for _, endpoints := range endpointsList.Items {
+		owner := metav1.GetControllerOf(&endpoints)
+		if owner == nil || owner.Kind != "VirtualMachineService" {
+			continue
+		}
+
+		if endpointsReferenceUID(endpoints.Subsets, lookupVM.UID) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKey{Namespace: endpoints.Namespace, Name: owner.Name},
+			})
+		}
+	}
+
+	return requests, nil
+}
+
+func endpointsReferenceUID(subsets []corev1.EndpointSubset, uid types.UID) bool {
+	for _, subset := range subsets {
+		for _, addrs := range [][]corev1.EndpointAddress{subset.Addresses, subset.NotReadyAddresses} {
+			for _, epa := range addrs {
+				if epa.TargetRef != nil && epa.TargetRef.UID == uid {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // createOrUpdateEndpoints updates the Endpoints for VirtualMachineService.
 func (r *ReconcileVirtualMachineService) createOrUpdateEndpoints(ctx *pkgctx.VirtualMachineServiceContext, service *corev1.Service) error {
 	ctx.Logger.V(5).Info("Updating VirtualMachineService Endpoints")
@@ -599,6 +697,31 @@ func findVMPortNum(_ *vmopv1.VirtualMachine, port intstr.IntOrString, _ corev1.P
 	return 0, fmt.Errorf("no matching port on VM")
 }
 
+// endpointsPowerStatePolicy returns the policy used to determine how VMs that
+// are not powered on are represented in the Endpoints.
+func endpointsPowerStatePolicy(vmService *vmopv1.VirtualMachineService) string {
+	switch v := vmService.Annotations[utils.AnnotationServiceEndpointsPowerStatePolicyKey]; v {
+	case utils.EndpointsPowerStatePolicyNotReady, utils.EndpointsPowerStatePolicyExclude:
+		return v
+	default:
+		return utils.EndpointsPowerStatePolicyInclude
+	}
+}
+
+// endpointsHostname returns the host name of the VM to use in its
+// EndpointAddress, or an empty string if the host name is not a valid DNS
+// label.
+func endpointsHostname(vm *vmopv1.VirtualMachine) string {
+	hostName := vm.Name
+	if vm.Spec.Network != nil && vm.Spec.Network.HostName != "" {
+		hostName = vm.Spec.Network.HostName
+	}
+	if errs := validation.IsDNS1123Label(hostName); len(errs) > 0 {
+		return ""
+	}
+	return hostName
+}
+
 // generateSubsetsForService generates Endpoints subsets for a given Service.
 func (r *ReconcileVirtualMachineService) generateSubsetsForService(
 	ctx *pkgctx.VirtualMachineServiceContext,
@@ -612,9 +735,13 @@ func (r *ReconcileVirtualMachineService) generateSubsetsForService(
 	var subsets = make([]corev1.EndpointSubset, 0, len(vmList.Items))
 	var vmInSubsetsMap map[types.UID]struct{}
 
+	powerStatePolicy := endpointsPowerStatePolicy(ctx.VMService)
+	setHostnames := ctx.VMService.Annotations[utils.AnnotationServiceEndpointsHostnamesKey] == "true"
+
 	for i := range vmList.Items {
 		vm := vmList.Items[i]
 		logger := ctx.Logger.WithValues("virtualMachine", vm.NamespacedName())
+		notPoweredOn := false
 
 		if !vm.DeletionTimestamp.IsZero() {
 			logger.Info("Skipping VM marked for deletion")
@@ -637,6 +764,16 @@ func (r *ReconcileVirtualMachineService) generateSubsetsForService(
 			continue
 		}
 
+		if vm.Status.PowerState != "" && vm.Status.PowerState != vmopv1.VirtualMachinePowerStateOn {
+			switch powerStatePolicy {
+			case utils.EndpointsPowerStatePolicyExclude:
+				logger.Info("Skipping VM that is not powered on", "powerState", vm.Status.PowerState)
+				continue
+			case utils.EndpointsPowerStatePolicyNotReady:
+				notPoweredOn = true
+			}
+		}
+
 		// If the VM has a ReadinessProbe and Ready condition, ready is a reflection of the condition
 		// status. If the VM has a ReadinessProbe but no condition, we assume that the prober just
 		// hasn't run against the VM yet, so infer the VM's readiness if it was previously in the EP;
@@ -659,6 +796,10 @@ func (r *ReconcileVirtualMachineService) generateSubsetsForService(
 			}
 		}
 
+		if notPoweredOn {
+			ready = false
+		}
+
 		epa := corev1.EndpointAddress{
 			IP: vmIP,
 			TargetRef: &corev1.ObjectReference{
@@ -674,6 +815,10 @@ func (r *ReconcileVirtualMachineService) generateSubsetsForService(
 			},
 		}
 
+		if setHostnames {
+			epa.Hostname = endpointsHostname(&vm)
+		}
+
 		// Populate the EP subset for this VM. We create one subset for each VM, and then our
 		// caller will repack the subsets that have identical ports.
 		subset := corev1.EndpointSubset{}
@@ -21,8 +21,10 @@ import (
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineservice/providers"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineservice/utils"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/externaldns"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
@@ -257,6 +259,63 @@ func unitTestsReconcile() {
 			})
 		})
 
+		Context("ExternalDNS hostname annotation", func() {
+			var (
+				service *corev1.Service
+				domain  string
+			)
+
+			BeforeEach(func() {
+				service = &corev1.Service{}
+				domain = "vm.example.com"
+			})
+
+			JustBeforeEach(func() {
+				pkgcfg.SetContext(vmServiceCtx, func(config *pkgcfg.Config) {
+					config.ExternalDNSDomain = domain
+				})
+				err := reconciler.ReconcileNormal(vmServiceCtx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ctx.Client.Get(ctx, objKey, service)).To(Succeed())
+			})
+
+			It("Sets the hostname annotation on a LoadBalancer Service", func() {
+				Expect(service.Annotations).To(HaveKeyWithValue(
+					externaldns.HostnameAnnotationKey, "dummy-vm-service.dummy-ns.vm.example.com"))
+			})
+
+			When("VirtualMachineService has a hostname annotation", func() {
+				BeforeEach(func() {
+					vmService.Annotations[externaldns.HostnameAnnotationKey] = "my-svc.example.com"
+				})
+
+				It("Uses the VirtualMachineService's hostname", func() {
+					Expect(service.Annotations).To(HaveKeyWithValue(
+						externaldns.HostnameAnnotationKey, "my-svc.example.com"))
+				})
+			})
+
+			When("VirtualMachineService is not a LoadBalancer", func() {
+				BeforeEach(func() {
+					vmService.Spec.Type = vmopv1.VirtualMachineServiceTypeClusterIP
+				})
+
+				It("Does not set the hostname annotation", func() {
+					Expect(service.Annotations).ToNot(HaveKey(externaldns.HostnameAnnotationKey))
+				})
+			})
+
+			When("ExternalDNS domain is not configured", func() {
+				BeforeEach(func() {
+					domain = ""
+				})
+
+				It("Does not set the hostname annotation", func() {
+					Expect(service.Annotations).ToNot(HaveKey(externaldns.HostnameAnnotationKey))
+				})
+			})
+		})
+
 		Context("Service Exists", func() {
 			var service *corev1.Service
 
@@ -575,6 +634,99 @@ func unitTestsReconcile() {
 				})
 			})
 
+			Context("When a matching VM is not powered on", func() {
+				BeforeEach(func() {
+					vm1.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+					vm2.Status.PowerState = vmopv1.VirtualMachinePowerStateOff
+					initObjects = append(initObjects, vm1, vm2, vm3)
+				})
+
+				It("Included in Addresses by default", func() {
+					Expect(endpoints.Subsets).To(HaveLen(1))
+					subset := endpoints.Subsets[0]
+					Expect(subset.Addresses).To(HaveLen(2))
+					assertEPAddrFromVM(subset.Addresses[0], vm1)
+					assertEPAddrFromVM(subset.Addresses[1], vm2)
+					Expect(subset.NotReadyAddresses).To(BeEmpty())
+				})
+
+				When("Power state policy is NotReady", func() {
+					BeforeEach(func() {
+						vmService.Annotations[utils.AnnotationServiceEndpointsPowerStatePolicyKey] = utils.EndpointsPowerStatePolicyNotReady
+					})
+
+					It("Included in NotReadyAddresses", func() {
+						Expect(endpoints.Subsets).To(HaveLen(1))
+						subset := endpoints.Subsets[0]
+						Expect(subset.Addresses).To(HaveLen(1))
+						assertEPAddrFromVM(subset.Addresses[0], vm1)
+						Expect(subset.NotReadyAddresses).To(HaveLen(1))
+						assertEPAddrFromVM(subset.NotReadyAddresses[0], vm2)
+					})
+				})
+
+				When("Power state policy is Exclude", func() {
+					BeforeEach(func() {
+						vm2.Status.PowerState = vmopv1.VirtualMachinePowerStateSuspended
+						vmService.Annotations[utils.AnnotationServiceEndpointsPowerStatePolicyKey] = utils.EndpointsPowerStatePolicyExclude
+					})
+
+					It("Not included in Subsets", func() {
+						Expect(endpoints.Subsets).To(HaveLen(1))
+						subset := endpoints.Subsets[0]
+						Expect(subset.Addresses).To(HaveLen(1))
+						assertEPAddrFromVM(subset.Addresses[0], vm1)
+						Expect(subset.NotReadyAddresses).To(BeEmpty())
+					})
+				})
+			})
+
+			Context("When hostnames are enabled", func() {
+				BeforeEach(func() {
+					vm2.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+						HostName: "my-host",
+					}
+					initObjects = append(initObjects, vm1, vm2, vm3)
+				})
+
+				It("Hostnames are not set by default", func() {
+					Expect(endpoints.Subsets).To(HaveLen(1))
+					subset := endpoints.Subsets[0]
+					Expect(subset.Addresses).To(HaveLen(2))
+					Expect(subset.Addresses[0].Hostname).To(BeEmpty())
+					Expect(subset.Addresses[1].Hostname).To(BeEmpty())
+				})
+
+				When("Hostnames annotation is true", func() {
+					BeforeEach(func() {
+						vmService.Annotations[utils.AnnotationServiceEndpointsHostnamesKey] = "true"
+					})
+
+					It("Addresses have the VMs' hostnames", func() {
+						Expect(endpoints.Subsets).To(HaveLen(1))
+						subset := endpoints.Subsets[0]
+						Expect(subset.Addresses).To(HaveLen(2))
+						assertEPAddrFromVM(subset.Addresses[0], vm1)
+						Expect(subset.Addresses[0].Hostname).To(Equal(vm1.Name))
+						assertEPAddrFromVM(subset.Addresses[1], vm2)
+						Expect(subset.Addresses[1].Hostname).To(Equal("my-host"))
+					})
+
+					When("VM hostname is not a valid DNS label", func() {
+						BeforeEach(func() {
+							vm2.Spec.Network.HostName = "my.host"
+						})
+
+						It("Address does not have a hostname", func() {
+							Expect(endpoints.Subsets).To(HaveLen(1))
+							subset := endpoints.Subsets[0]
+							Expect(subset.Addresses).To(HaveLen(2))
+							Expect(subset.Addresses[1].Hostname).To(BeEmpty())
+						})
+					})
+				})
+			})
+
 			Context("When VMs have Readiness Probe", func() {
 				BeforeEach(func() {
 					vm1.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
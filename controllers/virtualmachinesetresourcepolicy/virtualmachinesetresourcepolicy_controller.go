@@ -23,6 +23,7 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/patch"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
 )
 
 const (
@@ -51,7 +52,9 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		&topologyv1.Zone{},
 		handler.EnqueueRequestsFromMapFunc(zoneToNamespaceVMSRP(mgr.GetClient())))
 
-	return builder.Complete(r)
+	builder = shard.WatchNamespaces(ctx, builder, mgr.GetClient(), &vmopv1.VirtualMachineSetResourcePolicyList{})
+
+	return builder.Complete(shard.NewReconciler(ctx, mgr.GetClient(), r))
 }
 
 func NewReconciler(
@@ -39,6 +39,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/mem"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/watcher"
 	"github.com/vmware-tanzu/vm-operator/services"
 	"github.com/vmware-tanzu/vm-operator/webhooks"
@@ -78,6 +79,8 @@ func main() {
 
 	initLogging()
 
+	initOVFDiskCache()
+
 	initMemStats()
 
 	initFeatures()
@@ -90,6 +93,10 @@ func main() {
 
 	initWebhookServer()
 
+	if pkgcfg.FromContext(ctx).ObserverMode {
+		setupLog.Info("Observer mode is enabled, no mutating vSphere operations will be performed")
+	}
+
 	setupLog.Info("Starting controller manager")
 	sigHandler := ctrlsig.SetupSignalHandler()
 	if err := mgr.Start(sigHandler); err != nil {
@@ -133,9 +140,15 @@ func initContext() {
 	ctx = cource.WithContext(ctx)
 	ctx = watcher.WithContext(ctx)
 	ctx = ovfcache.WithContext(ctx)
+	ctx = vmopv1util.WithImageResolver(ctx, vmopv1util.ImageResolverMaxEntries)
 }
 
 func initRateLimiting() {
+	pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+		config.RateLimitQPS = rateLimiterQPS
+		config.RateLimitBurst = rateLimiterBurst
+	})
+
 	if rateLimiterQPS == 0 && rateLimiterBurst == 0 {
 		return
 	}
@@ -282,6 +295,20 @@ func initLogging() {
 	ctrl.SetLogger(klog.Background())
 }
 
+func initOVFDiskCache() {
+	dir := defaultConfig.OVFCacheDir
+	if dir == "" {
+		return
+	}
+	if err := ovfcache.SetDiskCache(ctx, dir, defaultConfig.OVFCacheMaxItems); err != nil {
+		setupLog.Error(err, "Failed to initialize OVF disk cache", "dir", dir)
+		os.Exit(1)
+	}
+	setupLog.Info("Initialized OVF disk cache",
+		"dir", dir,
+		"maxItems", defaultConfig.OVFCacheMaxItems)
+}
+
 func waitForWebhookCertificates() {
 	setupLog.Info("Waiting for webhook certificates")
 	waitOnCertsStartTime := time.Now()
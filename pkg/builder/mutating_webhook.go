@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -21,6 +22,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 )
 
@@ -100,11 +102,20 @@ type mutatingWebhookHandler struct {
 	Mutator
 }
 
-func (h *mutatingWebhookHandler) Handle(_ context.Context, req admission.Request) admission.Response {
+func (h *mutatingWebhookHandler) Handle(_ context.Context, req admission.Request) (resp admission.Response) {
 	if h.Mutator == nil {
 		panic("mutator should never be nil")
 	}
 
+	defer func(start time.Time) {
+		metrics.NewWebhookMetrics().RegisterAdmission(
+			metrics.WebhookTypeMutating,
+			h.For().Kind,
+			string(req.Operation),
+			resp,
+			time.Since(start))
+	}(time.Now())
+
 	var (
 		obj, oldObj *unstructured.Unstructured
 	)
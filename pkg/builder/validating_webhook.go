@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -21,6 +22,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 )
 
@@ -107,11 +109,20 @@ type validatingWebhookHandler struct {
 	Validator
 }
 
-func (h *validatingWebhookHandler) Handle(_ context.Context, req admission.Request) admission.Response {
+func (h *validatingWebhookHandler) Handle(_ context.Context, req admission.Request) (resp admission.Response) {
 	if h.Validator == nil {
 		panic("validator should never be nil")
 	}
 
+	defer func(start time.Time) {
+		metrics.NewWebhookMetrics().RegisterAdmission(
+			metrics.WebhookTypeValidating,
+			h.For().Kind,
+			string(req.Operation),
+			resp,
+			time.Since(start))
+	}(time.Now())
+
 	var (
 		obj, oldObj *unstructured.Unstructured
 	)
@@ -14,11 +14,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	pkgbuilder "github.com/vmware-tanzu/vm-operator/pkg/builder"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	pkgctxfake "github.com/vmware-tanzu/vm-operator/pkg/context/fake"
+	pkgmetrics "github.com/vmware-tanzu/vm-operator/pkg/metrics"
 )
 
 var _ = Describe("NewValidatingWebhook", func() {
@@ -203,6 +205,53 @@ var _ = Describe("NewValidatingWebhook", func() {
 					})
 				})
 			})
+
+			Context("metrics", func() {
+				getCount := func(decision, reason string) float64 {
+					families, err := ctrlmetrics.Registry.Gather()
+					ExpectWithOffset(1, err).ToNot(HaveOccurred())
+					for _, f := range families {
+						if f.GetName() != "vmservice_webhook_admission_total" {
+							continue
+						}
+						for _, m := range f.GetMetric() {
+							labels := map[string]string{}
+							for _, l := range m.GetLabel() {
+								labels[l.GetName()] = l.GetValue()
+							}
+							if labels["webhook_type"] == pkgmetrics.WebhookTypeValidating &&
+								labels["kind"] == obj.GetObjectKind().GroupVersionKind().Kind &&
+								labels["operation"] == string(admissionv1.Create) &&
+								labels["decision"] == decision &&
+								labels["reason"] == reason {
+								return m.GetCounter().GetValue()
+							}
+						}
+					}
+					return 0
+				}
+
+				When("the request is allowed", func() {
+					BeforeEach(func() {
+						res = getAdmissionResponseOkay()
+					})
+					It("should count the request as allowed", func() {
+						before := getCount(pkgmetrics.AdmissionDecisionAllowed, "")
+						wh.Handle(ctx, getAdmissionRequest(obj, admissionv1.Create))
+						Expect(getCount(pkgmetrics.AdmissionDecisionAllowed, "")).To(Equal(before + 1))
+					})
+				})
+
+				When("the request is denied", func() {
+					It("should count the request as denied with the reason", func() {
+						before := getCount(pkgmetrics.AdmissionDecisionDenied, "Bad Request")
+						req := getAdmissionRequest(obj, admissionv1.Create)
+						req.AdmissionRequest.Object.Raw = []byte{0}
+						wh.Handle(ctx, req)
+						Expect(getCount(pkgmetrics.AdmissionDecisionDenied, "Bad Request")).To(Equal(before + 1))
+					})
+				})
+			})
 		})
 	})
 
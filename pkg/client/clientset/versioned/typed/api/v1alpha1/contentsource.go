@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ContentSourcesGetter has a method to return a ContentSourceInterface.
+// A group's client should implement this interface.
+type ContentSourcesGetter interface {
+	ContentSources() ContentSourceInterface
+}
+
+// ContentSourceInterface has methods to work with ContentSource resources.
+type ContentSourceInterface interface {
+	Create(ctx context.Context, contentSource *v1alpha1.ContentSource, opts v1.CreateOptions) (*v1alpha1.ContentSource, error)
+	Update(ctx context.Context, contentSource *v1alpha1.ContentSource, opts v1.UpdateOptions) (*v1alpha1.ContentSource, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, contentSource *v1alpha1.ContentSource, opts v1.UpdateOptions) (*v1alpha1.ContentSource, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ContentSource, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ContentSourceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ContentSource, err error)
+	ContentSourceExpansion
+}
+
+// contentSources implements ContentSourceInterface
+type contentSources struct {
+	*gentype.ClientWithList[*v1alpha1.ContentSource, *v1alpha1.ContentSourceList]
+}
+
+// newContentSources returns a ContentSources
+func newContentSources(c *VmoperatorV1alpha1Client) *contentSources {
+	return &contentSources{
+		gentype.NewClientWithList[*v1alpha1.ContentSource, *v1alpha1.ContentSourceList](
+			"contentsources",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *v1alpha1.ContentSource { return &v1alpha1.ContentSource{} },
+			func() *v1alpha1.ContentSourceList { return &v1alpha1.ContentSourceList{} }),
+	}
+}
@@ -0,0 +1,55 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ContentSourceBindingsGetter has a method to return a ContentSourceBindingInterface.
+// A group's client should implement this interface.
+type ContentSourceBindingsGetter interface {
+	ContentSourceBindings(namespace string) ContentSourceBindingInterface
+}
+
+// ContentSourceBindingInterface has methods to work with ContentSourceBinding resources.
+type ContentSourceBindingInterface interface {
+	Create(ctx context.Context, contentSourceBinding *v1alpha1.ContentSourceBinding, opts v1.CreateOptions) (*v1alpha1.ContentSourceBinding, error)
+	Update(ctx context.Context, contentSourceBinding *v1alpha1.ContentSourceBinding, opts v1.UpdateOptions) (*v1alpha1.ContentSourceBinding, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ContentSourceBinding, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ContentSourceBindingList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ContentSourceBinding, err error)
+	ContentSourceBindingExpansion
+}
+
+// contentSourceBindings implements ContentSourceBindingInterface
+type contentSourceBindings struct {
+	*gentype.ClientWithList[*v1alpha1.ContentSourceBinding, *v1alpha1.ContentSourceBindingList]
+}
+
+// newContentSourceBindings returns a ContentSourceBindings
+func newContentSourceBindings(c *VmoperatorV1alpha1Client, namespace string) *contentSourceBindings {
+	return &contentSourceBindings{
+		gentype.NewClientWithList[*v1alpha1.ContentSourceBinding, *v1alpha1.ContentSourceBindingList](
+			"contentsourcebindings",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha1.ContentSourceBinding { return &v1alpha1.ContentSourceBinding{} },
+			func() *v1alpha1.ContentSourceBindingList { return &v1alpha1.ContentSourceBindingList{} }),
+	}
+}
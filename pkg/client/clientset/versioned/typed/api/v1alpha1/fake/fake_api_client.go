@@ -0,0 +1,76 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/typed/api/v1alpha1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeVmoperatorV1alpha1 struct {
+	*testing.Fake
+}
+
+func (c *FakeVmoperatorV1alpha1) ClusterVirtualMachineImages() v1alpha1.ClusterVirtualMachineImageInterface {
+	return &FakeClusterVirtualMachineImages{c}
+}
+
+func (c *FakeVmoperatorV1alpha1) ContentLibraryProviders() v1alpha1.ContentLibraryProviderInterface {
+	return &FakeContentLibraryProviders{c}
+}
+
+func (c *FakeVmoperatorV1alpha1) ContentSources() v1alpha1.ContentSourceInterface {
+	return &FakeContentSources{c}
+}
+
+func (c *FakeVmoperatorV1alpha1) ContentSourceBindings(namespace string) v1alpha1.ContentSourceBindingInterface {
+	return &FakeContentSourceBindings{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha1) HTTPContentProviders() v1alpha1.HTTPContentProviderInterface {
+	return &FakeHTTPContentProviders{c}
+}
+
+func (c *FakeVmoperatorV1alpha1) VirtualMachines(namespace string) v1alpha1.VirtualMachineInterface {
+	return &FakeVirtualMachines{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha1) VirtualMachineClasses(namespace string) v1alpha1.VirtualMachineClassInterface {
+	return &FakeVirtualMachineClasses{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha1) VirtualMachineClassBindings(namespace string) v1alpha1.VirtualMachineClassBindingInterface {
+	return &FakeVirtualMachineClassBindings{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha1) VirtualMachineImages(namespace string) v1alpha1.VirtualMachineImageInterface {
+	return &FakeVirtualMachineImages{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha1) VirtualMachinePublishRequests(namespace string) v1alpha1.VirtualMachinePublishRequestInterface {
+	return &FakeVirtualMachinePublishRequests{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha1) VirtualMachineServices(namespace string) v1alpha1.VirtualMachineServiceInterface {
+	return &FakeVirtualMachineServices{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha1) VirtualMachineSetResourcePolicies(namespace string) v1alpha1.VirtualMachineSetResourcePolicyInterface {
+	return &FakeVirtualMachineSetResourcePolicies{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha1) WebConsoleRequests(namespace string) v1alpha1.WebConsoleRequestInterface {
+	return &FakeWebConsoleRequests{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeVmoperatorV1alpha1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,33 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+type ClusterVirtualMachineImageExpansion interface{}
+
+type ContentLibraryProviderExpansion interface{}
+
+type ContentSourceExpansion interface{}
+
+type ContentSourceBindingExpansion interface{}
+
+type HTTPContentProviderExpansion interface{}
+
+type VirtualMachineExpansion interface{}
+
+type VirtualMachineClassExpansion interface{}
+
+type VirtualMachineClassBindingExpansion interface{}
+
+type VirtualMachineImageExpansion interface{}
+
+type VirtualMachinePublishRequestExpansion interface{}
+
+type VirtualMachineServiceExpansion interface{}
+
+type VirtualMachineSetResourcePolicyExpansion interface{}
+
+type WebConsoleRequestExpansion interface{}
@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// HTTPContentProvidersGetter has a method to return a HTTPContentProviderInterface.
+// A group's client should implement this interface.
+type HTTPContentProvidersGetter interface {
+	HTTPContentProviders() HTTPContentProviderInterface
+}
+
+// HTTPContentProviderInterface has methods to work with HTTPContentProvider resources.
+type HTTPContentProviderInterface interface {
+	Create(ctx context.Context, hTTPContentProvider *v1alpha1.HTTPContentProvider, opts v1.CreateOptions) (*v1alpha1.HTTPContentProvider, error)
+	Update(ctx context.Context, hTTPContentProvider *v1alpha1.HTTPContentProvider, opts v1.UpdateOptions) (*v1alpha1.HTTPContentProvider, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, hTTPContentProvider *v1alpha1.HTTPContentProvider, opts v1.UpdateOptions) (*v1alpha1.HTTPContentProvider, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.HTTPContentProvider, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.HTTPContentProviderList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.HTTPContentProvider, err error)
+	HTTPContentProviderExpansion
+}
+
+// hTTPContentProviders implements HTTPContentProviderInterface
+type hTTPContentProviders struct {
+	*gentype.ClientWithList[*v1alpha1.HTTPContentProvider, *v1alpha1.HTTPContentProviderList]
+}
+
+// newHTTPContentProviders returns a HTTPContentProviders
+func newHTTPContentProviders(c *VmoperatorV1alpha1Client) *hTTPContentProviders {
+	return &hTTPContentProviders{
+		gentype.NewClientWithList[*v1alpha1.HTTPContentProvider, *v1alpha1.HTTPContentProviderList](
+			"httpcontentproviders",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *v1alpha1.HTTPContentProvider { return &v1alpha1.HTTPContentProvider{} },
+			func() *v1alpha1.HTTPContentProviderList { return &v1alpha1.HTTPContentProviderList{} }),
+	}
+}
@@ -0,0 +1,55 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineClassBindingsGetter has a method to return a VirtualMachineClassBindingInterface.
+// A group's client should implement this interface.
+type VirtualMachineClassBindingsGetter interface {
+	VirtualMachineClassBindings(namespace string) VirtualMachineClassBindingInterface
+}
+
+// VirtualMachineClassBindingInterface has methods to work with VirtualMachineClassBinding resources.
+type VirtualMachineClassBindingInterface interface {
+	Create(ctx context.Context, virtualMachineClassBinding *v1alpha1.VirtualMachineClassBinding, opts v1.CreateOptions) (*v1alpha1.VirtualMachineClassBinding, error)
+	Update(ctx context.Context, virtualMachineClassBinding *v1alpha1.VirtualMachineClassBinding, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineClassBinding, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.VirtualMachineClassBinding, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.VirtualMachineClassBindingList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineClassBinding, err error)
+	VirtualMachineClassBindingExpansion
+}
+
+// virtualMachineClassBindings implements VirtualMachineClassBindingInterface
+type virtualMachineClassBindings struct {
+	*gentype.ClientWithList[*v1alpha1.VirtualMachineClassBinding, *v1alpha1.VirtualMachineClassBindingList]
+}
+
+// newVirtualMachineClassBindings returns a VirtualMachineClassBindings
+func newVirtualMachineClassBindings(c *VmoperatorV1alpha1Client, namespace string) *virtualMachineClassBindings {
+	return &virtualMachineClassBindings{
+		gentype.NewClientWithList[*v1alpha1.VirtualMachineClassBinding, *v1alpha1.VirtualMachineClassBindingList](
+			"virtualmachineclassbindings",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha1.VirtualMachineClassBinding { return &v1alpha1.VirtualMachineClassBinding{} },
+			func() *v1alpha1.VirtualMachineClassBindingList { return &v1alpha1.VirtualMachineClassBindingList{} }),
+	}
+}
@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// WebConsoleRequestsGetter has a method to return a WebConsoleRequestInterface.
+// A group's client should implement this interface.
+type WebConsoleRequestsGetter interface {
+	WebConsoleRequests(namespace string) WebConsoleRequestInterface
+}
+
+// WebConsoleRequestInterface has methods to work with WebConsoleRequest resources.
+type WebConsoleRequestInterface interface {
+	Create(ctx context.Context, webConsoleRequest *v1alpha1.WebConsoleRequest, opts v1.CreateOptions) (*v1alpha1.WebConsoleRequest, error)
+	Update(ctx context.Context, webConsoleRequest *v1alpha1.WebConsoleRequest, opts v1.UpdateOptions) (*v1alpha1.WebConsoleRequest, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, webConsoleRequest *v1alpha1.WebConsoleRequest, opts v1.UpdateOptions) (*v1alpha1.WebConsoleRequest, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.WebConsoleRequest, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.WebConsoleRequestList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WebConsoleRequest, err error)
+	WebConsoleRequestExpansion
+}
+
+// webConsoleRequests implements WebConsoleRequestInterface
+type webConsoleRequests struct {
+	*gentype.ClientWithList[*v1alpha1.WebConsoleRequest, *v1alpha1.WebConsoleRequestList]
+}
+
+// newWebConsoleRequests returns a WebConsoleRequests
+func newWebConsoleRequests(c *VmoperatorV1alpha1Client, namespace string) *webConsoleRequests {
+	return &webConsoleRequests{
+		gentype.NewClientWithList[*v1alpha1.WebConsoleRequest, *v1alpha1.WebConsoleRequestList](
+			"webconsolerequests",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha1.WebConsoleRequest { return &v1alpha1.WebConsoleRequest{} },
+			func() *v1alpha1.WebConsoleRequestList { return &v1alpha1.WebConsoleRequestList{} }),
+	}
+}
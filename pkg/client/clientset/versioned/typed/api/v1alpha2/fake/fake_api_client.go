@@ -0,0 +1,56 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/typed/api/v1alpha2"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeVmoperatorV1alpha2 struct {
+	*testing.Fake
+}
+
+func (c *FakeVmoperatorV1alpha2) ClusterVirtualMachineImages() v1alpha2.ClusterVirtualMachineImageInterface {
+	return &FakeClusterVirtualMachineImages{c}
+}
+
+func (c *FakeVmoperatorV1alpha2) VirtualMachines(namespace string) v1alpha2.VirtualMachineInterface {
+	return &FakeVirtualMachines{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha2) VirtualMachineClasses(namespace string) v1alpha2.VirtualMachineClassInterface {
+	return &FakeVirtualMachineClasses{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha2) VirtualMachineImages(namespace string) v1alpha2.VirtualMachineImageInterface {
+	return &FakeVirtualMachineImages{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha2) VirtualMachinePublishRequests(namespace string) v1alpha2.VirtualMachinePublishRequestInterface {
+	return &FakeVirtualMachinePublishRequests{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha2) VirtualMachineServices(namespace string) v1alpha2.VirtualMachineServiceInterface {
+	return &FakeVirtualMachineServices{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha2) VirtualMachineSetResourcePolicies(namespace string) v1alpha2.VirtualMachineSetResourcePolicyInterface {
+	return &FakeVirtualMachineSetResourcePolicies{c, namespace}
+}
+
+func (c *FakeVmoperatorV1alpha2) VirtualMachineWebConsoleRequests(namespace string) v1alpha2.VirtualMachineWebConsoleRequestInterface {
+	return &FakeVirtualMachineWebConsoleRequests{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeVmoperatorV1alpha2) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
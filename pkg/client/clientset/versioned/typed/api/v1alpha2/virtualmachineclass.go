@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"context"
+
+	v1alpha2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineClassesGetter has a method to return a VirtualMachineClassInterface.
+// A group's client should implement this interface.
+type VirtualMachineClassesGetter interface {
+	VirtualMachineClasses(namespace string) VirtualMachineClassInterface
+}
+
+// VirtualMachineClassInterface has methods to work with VirtualMachineClass resources.
+type VirtualMachineClassInterface interface {
+	Create(ctx context.Context, virtualMachineClass *v1alpha2.VirtualMachineClass, opts v1.CreateOptions) (*v1alpha2.VirtualMachineClass, error)
+	Update(ctx context.Context, virtualMachineClass *v1alpha2.VirtualMachineClass, opts v1.UpdateOptions) (*v1alpha2.VirtualMachineClass, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineClass *v1alpha2.VirtualMachineClass, opts v1.UpdateOptions) (*v1alpha2.VirtualMachineClass, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha2.VirtualMachineClass, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha2.VirtualMachineClassList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha2.VirtualMachineClass, err error)
+	VirtualMachineClassExpansion
+}
+
+// virtualMachineClasses implements VirtualMachineClassInterface
+type virtualMachineClasses struct {
+	*gentype.ClientWithList[*v1alpha2.VirtualMachineClass, *v1alpha2.VirtualMachineClassList]
+}
+
+// newVirtualMachineClasses returns a VirtualMachineClasses
+func newVirtualMachineClasses(c *VmoperatorV1alpha2Client, namespace string) *virtualMachineClasses {
+	return &virtualMachineClasses{
+		gentype.NewClientWithList[*v1alpha2.VirtualMachineClass, *v1alpha2.VirtualMachineClassList](
+			"virtualmachineclasses",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha2.VirtualMachineClass { return &v1alpha2.VirtualMachineClass{} },
+			func() *v1alpha2.VirtualMachineClassList { return &v1alpha2.VirtualMachineClassList{} }),
+	}
+}
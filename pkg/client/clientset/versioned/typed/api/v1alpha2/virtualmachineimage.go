@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"context"
+
+	v1alpha2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineImagesGetter has a method to return a VirtualMachineImageInterface.
+// A group's client should implement this interface.
+type VirtualMachineImagesGetter interface {
+	VirtualMachineImages(namespace string) VirtualMachineImageInterface
+}
+
+// VirtualMachineImageInterface has methods to work with VirtualMachineImage resources.
+type VirtualMachineImageInterface interface {
+	Create(ctx context.Context, virtualMachineImage *v1alpha2.VirtualMachineImage, opts v1.CreateOptions) (*v1alpha2.VirtualMachineImage, error)
+	Update(ctx context.Context, virtualMachineImage *v1alpha2.VirtualMachineImage, opts v1.UpdateOptions) (*v1alpha2.VirtualMachineImage, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineImage *v1alpha2.VirtualMachineImage, opts v1.UpdateOptions) (*v1alpha2.VirtualMachineImage, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha2.VirtualMachineImage, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha2.VirtualMachineImageList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha2.VirtualMachineImage, err error)
+	VirtualMachineImageExpansion
+}
+
+// virtualMachineImages implements VirtualMachineImageInterface
+type virtualMachineImages struct {
+	*gentype.ClientWithList[*v1alpha2.VirtualMachineImage, *v1alpha2.VirtualMachineImageList]
+}
+
+// newVirtualMachineImages returns a VirtualMachineImages
+func newVirtualMachineImages(c *VmoperatorV1alpha2Client, namespace string) *virtualMachineImages {
+	return &virtualMachineImages{
+		gentype.NewClientWithList[*v1alpha2.VirtualMachineImage, *v1alpha2.VirtualMachineImageList](
+			"virtualmachineimages",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha2.VirtualMachineImage { return &v1alpha2.VirtualMachineImage{} },
+			func() *v1alpha2.VirtualMachineImageList { return &v1alpha2.VirtualMachineImageList{} }),
+	}
+}
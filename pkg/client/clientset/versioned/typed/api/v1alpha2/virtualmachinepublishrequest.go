@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"context"
+
+	v1alpha2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachinePublishRequestsGetter has a method to return a VirtualMachinePublishRequestInterface.
+// A group's client should implement this interface.
+type VirtualMachinePublishRequestsGetter interface {
+	VirtualMachinePublishRequests(namespace string) VirtualMachinePublishRequestInterface
+}
+
+// VirtualMachinePublishRequestInterface has methods to work with VirtualMachinePublishRequest resources.
+type VirtualMachinePublishRequestInterface interface {
+	Create(ctx context.Context, virtualMachinePublishRequest *v1alpha2.VirtualMachinePublishRequest, opts v1.CreateOptions) (*v1alpha2.VirtualMachinePublishRequest, error)
+	Update(ctx context.Context, virtualMachinePublishRequest *v1alpha2.VirtualMachinePublishRequest, opts v1.UpdateOptions) (*v1alpha2.VirtualMachinePublishRequest, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachinePublishRequest *v1alpha2.VirtualMachinePublishRequest, opts v1.UpdateOptions) (*v1alpha2.VirtualMachinePublishRequest, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha2.VirtualMachinePublishRequest, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha2.VirtualMachinePublishRequestList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha2.VirtualMachinePublishRequest, err error)
+	VirtualMachinePublishRequestExpansion
+}
+
+// virtualMachinePublishRequests implements VirtualMachinePublishRequestInterface
+type virtualMachinePublishRequests struct {
+	*gentype.ClientWithList[*v1alpha2.VirtualMachinePublishRequest, *v1alpha2.VirtualMachinePublishRequestList]
+}
+
+// newVirtualMachinePublishRequests returns a VirtualMachinePublishRequests
+func newVirtualMachinePublishRequests(c *VmoperatorV1alpha2Client, namespace string) *virtualMachinePublishRequests {
+	return &virtualMachinePublishRequests{
+		gentype.NewClientWithList[*v1alpha2.VirtualMachinePublishRequest, *v1alpha2.VirtualMachinePublishRequestList](
+			"virtualmachinepublishrequests",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha2.VirtualMachinePublishRequest { return &v1alpha2.VirtualMachinePublishRequest{} },
+			func() *v1alpha2.VirtualMachinePublishRequestList { return &v1alpha2.VirtualMachinePublishRequestList{} }),
+	}
+}
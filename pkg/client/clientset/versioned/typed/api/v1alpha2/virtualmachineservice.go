@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"context"
+
+	v1alpha2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineServicesGetter has a method to return a VirtualMachineServiceInterface.
+// A group's client should implement this interface.
+type VirtualMachineServicesGetter interface {
+	VirtualMachineServices(namespace string) VirtualMachineServiceInterface
+}
+
+// VirtualMachineServiceInterface has methods to work with VirtualMachineService resources.
+type VirtualMachineServiceInterface interface {
+	Create(ctx context.Context, virtualMachineService *v1alpha2.VirtualMachineService, opts v1.CreateOptions) (*v1alpha2.VirtualMachineService, error)
+	Update(ctx context.Context, virtualMachineService *v1alpha2.VirtualMachineService, opts v1.UpdateOptions) (*v1alpha2.VirtualMachineService, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineService *v1alpha2.VirtualMachineService, opts v1.UpdateOptions) (*v1alpha2.VirtualMachineService, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha2.VirtualMachineService, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha2.VirtualMachineServiceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha2.VirtualMachineService, err error)
+	VirtualMachineServiceExpansion
+}
+
+// virtualMachineServices implements VirtualMachineServiceInterface
+type virtualMachineServices struct {
+	*gentype.ClientWithList[*v1alpha2.VirtualMachineService, *v1alpha2.VirtualMachineServiceList]
+}
+
+// newVirtualMachineServices returns a VirtualMachineServices
+func newVirtualMachineServices(c *VmoperatorV1alpha2Client, namespace string) *virtualMachineServices {
+	return &virtualMachineServices{
+		gentype.NewClientWithList[*v1alpha2.VirtualMachineService, *v1alpha2.VirtualMachineServiceList](
+			"virtualmachineservices",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha2.VirtualMachineService { return &v1alpha2.VirtualMachineService{} },
+			func() *v1alpha2.VirtualMachineServiceList { return &v1alpha2.VirtualMachineServiceList{} }),
+	}
+}
@@ -0,0 +1,59 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"context"
+
+	v1alpha2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineSetResourcePoliciesGetter has a method to return a VirtualMachineSetResourcePolicyInterface.
+// A group's client should implement this interface.
+type VirtualMachineSetResourcePoliciesGetter interface {
+	VirtualMachineSetResourcePolicies(namespace string) VirtualMachineSetResourcePolicyInterface
+}
+
+// VirtualMachineSetResourcePolicyInterface has methods to work with VirtualMachineSetResourcePolicy resources.
+type VirtualMachineSetResourcePolicyInterface interface {
+	Create(ctx context.Context, virtualMachineSetResourcePolicy *v1alpha2.VirtualMachineSetResourcePolicy, opts v1.CreateOptions) (*v1alpha2.VirtualMachineSetResourcePolicy, error)
+	Update(ctx context.Context, virtualMachineSetResourcePolicy *v1alpha2.VirtualMachineSetResourcePolicy, opts v1.UpdateOptions) (*v1alpha2.VirtualMachineSetResourcePolicy, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineSetResourcePolicy *v1alpha2.VirtualMachineSetResourcePolicy, opts v1.UpdateOptions) (*v1alpha2.VirtualMachineSetResourcePolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha2.VirtualMachineSetResourcePolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha2.VirtualMachineSetResourcePolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha2.VirtualMachineSetResourcePolicy, err error)
+	VirtualMachineSetResourcePolicyExpansion
+}
+
+// virtualMachineSetResourcePolicies implements VirtualMachineSetResourcePolicyInterface
+type virtualMachineSetResourcePolicies struct {
+	*gentype.ClientWithList[*v1alpha2.VirtualMachineSetResourcePolicy, *v1alpha2.VirtualMachineSetResourcePolicyList]
+}
+
+// newVirtualMachineSetResourcePolicies returns a VirtualMachineSetResourcePolicies
+func newVirtualMachineSetResourcePolicies(c *VmoperatorV1alpha2Client, namespace string) *virtualMachineSetResourcePolicies {
+	return &virtualMachineSetResourcePolicies{
+		gentype.NewClientWithList[*v1alpha2.VirtualMachineSetResourcePolicy, *v1alpha2.VirtualMachineSetResourcePolicyList](
+			"virtualmachinesetresourcepolicies",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha2.VirtualMachineSetResourcePolicy { return &v1alpha2.VirtualMachineSetResourcePolicy{} },
+			func() *v1alpha2.VirtualMachineSetResourcePolicyList {
+				return &v1alpha2.VirtualMachineSetResourcePolicyList{}
+			}),
+	}
+}
@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"context"
+
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ClusterVirtualMachineImagesGetter has a method to return a ClusterVirtualMachineImageInterface.
+// A group's client should implement this interface.
+type ClusterVirtualMachineImagesGetter interface {
+	ClusterVirtualMachineImages() ClusterVirtualMachineImageInterface
+}
+
+// ClusterVirtualMachineImageInterface has methods to work with ClusterVirtualMachineImage resources.
+type ClusterVirtualMachineImageInterface interface {
+	Create(ctx context.Context, clusterVirtualMachineImage *v1alpha3.ClusterVirtualMachineImage, opts v1.CreateOptions) (*v1alpha3.ClusterVirtualMachineImage, error)
+	Update(ctx context.Context, clusterVirtualMachineImage *v1alpha3.ClusterVirtualMachineImage, opts v1.UpdateOptions) (*v1alpha3.ClusterVirtualMachineImage, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, clusterVirtualMachineImage *v1alpha3.ClusterVirtualMachineImage, opts v1.UpdateOptions) (*v1alpha3.ClusterVirtualMachineImage, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha3.ClusterVirtualMachineImage, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha3.ClusterVirtualMachineImageList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha3.ClusterVirtualMachineImage, err error)
+	ClusterVirtualMachineImageExpansion
+}
+
+// clusterVirtualMachineImages implements ClusterVirtualMachineImageInterface
+type clusterVirtualMachineImages struct {
+	*gentype.ClientWithList[*v1alpha3.ClusterVirtualMachineImage, *v1alpha3.ClusterVirtualMachineImageList]
+}
+
+// newClusterVirtualMachineImages returns a ClusterVirtualMachineImages
+func newClusterVirtualMachineImages(c *VmoperatorV1alpha3Client) *clusterVirtualMachineImages {
+	return &clusterVirtualMachineImages{
+		gentype.NewClientWithList[*v1alpha3.ClusterVirtualMachineImage, *v1alpha3.ClusterVirtualMachineImageList](
+			"clustervirtualmachineimages",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *v1alpha3.ClusterVirtualMachineImage { return &v1alpha3.ClusterVirtualMachineImage{} },
+			func() *v1alpha3.ClusterVirtualMachineImageList { return &v1alpha3.ClusterVirtualMachineImageList{} }),
+	}
+}
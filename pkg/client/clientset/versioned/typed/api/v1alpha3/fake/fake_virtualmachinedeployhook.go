@@ -0,0 +1,122 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeVirtualMachineDeployHooks implements VirtualMachineDeployHookInterface
+type FakeVirtualMachineDeployHooks struct {
+	Fake *FakeVmoperatorV1alpha3
+	ns   string
+}
+
+var virtualmachinedeployhooksResource = v1alpha3.SchemeGroupVersion.WithResource("virtualmachinedeployhooks")
+
+var virtualmachinedeployhooksKind = v1alpha3.SchemeGroupVersion.WithKind("VirtualMachineDeployHook")
+
+// Get takes name of the virtualMachineDeployHook, and returns the corresponding virtualMachineDeployHook object, and an error if there is any.
+func (c *FakeVirtualMachineDeployHooks) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha3.VirtualMachineDeployHook, err error) {
+	emptyResult := &v1alpha3.VirtualMachineDeployHook{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetActionWithOptions(virtualmachinedeployhooksResource, c.ns, name, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha3.VirtualMachineDeployHook), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineDeployHooks that match those selectors.
+func (c *FakeVirtualMachineDeployHooks) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha3.VirtualMachineDeployHookList, err error) {
+	emptyResult := &v1alpha3.VirtualMachineDeployHookList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewListActionWithOptions(virtualmachinedeployhooksResource, virtualmachinedeployhooksKind, c.ns, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha3.VirtualMachineDeployHookList{ListMeta: obj.(*v1alpha3.VirtualMachineDeployHookList).ListMeta}
+	for _, item := range obj.(*v1alpha3.VirtualMachineDeployHookList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineDeployHooks.
+func (c *FakeVirtualMachineDeployHooks) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchActionWithOptions(virtualmachinedeployhooksResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineDeployHook and creates it.  Returns the server's representation of the virtualMachineDeployHook, and an error, if there is any.
+func (c *FakeVirtualMachineDeployHooks) Create(ctx context.Context, virtualMachineDeployHook *v1alpha3.VirtualMachineDeployHook, opts v1.CreateOptions) (result *v1alpha3.VirtualMachineDeployHook, err error) {
+	emptyResult := &v1alpha3.VirtualMachineDeployHook{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateActionWithOptions(virtualmachinedeployhooksResource, c.ns, virtualMachineDeployHook, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha3.VirtualMachineDeployHook), err
+}
+
+// Update takes the representation of a virtualMachineDeployHook and updates it. Returns the server's representation of the virtualMachineDeployHook, and an error, if there is any.
+func (c *FakeVirtualMachineDeployHooks) Update(ctx context.Context, virtualMachineDeployHook *v1alpha3.VirtualMachineDeployHook, opts v1.UpdateOptions) (result *v1alpha3.VirtualMachineDeployHook, err error) {
+	emptyResult := &v1alpha3.VirtualMachineDeployHook{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateActionWithOptions(virtualmachinedeployhooksResource, c.ns, virtualMachineDeployHook, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha3.VirtualMachineDeployHook), err
+}
+
+// Delete takes name of the virtualMachineDeployHook and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineDeployHooks) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachinedeployhooksResource, c.ns, name, opts), &v1alpha3.VirtualMachineDeployHook{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineDeployHooks) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionActionWithOptions(virtualmachinedeployhooksResource, c.ns, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha3.VirtualMachineDeployHookList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineDeployHook.
+func (c *FakeVirtualMachineDeployHooks) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha3.VirtualMachineDeployHook, err error) {
+	emptyResult := &v1alpha3.VirtualMachineDeployHook{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(virtualmachinedeployhooksResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha3.VirtualMachineDeployHook), err
+}
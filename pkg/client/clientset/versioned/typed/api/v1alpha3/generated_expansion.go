@@ -0,0 +1,35 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+type ClusterVirtualMachineImageExpansion interface{}
+
+type VirtualMachineExpansion interface{}
+
+type VirtualMachineClassExpansion interface{}
+
+type VirtualMachineDeployHookExpansion interface{}
+
+type VirtualMachineExportRequestExpansion interface{}
+
+type VirtualMachineImageExpansion interface{}
+
+type VirtualMachineImageCacheExpansion interface{}
+
+type VirtualMachineImageCatalogExpansion interface{}
+
+type VirtualMachineInventoryReportExpansion interface{}
+
+type VirtualMachinePublishRequestExpansion interface{}
+
+type VirtualMachineReplicaSetExpansion interface{}
+
+type VirtualMachineServiceExpansion interface{}
+
+type VirtualMachineSetResourcePolicyExpansion interface{}
+
+type VirtualMachineWebConsoleRequestExpansion interface{}
@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"context"
+
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachinesGetter has a method to return a VirtualMachineInterface.
+// A group's client should implement this interface.
+type VirtualMachinesGetter interface {
+	VirtualMachines(namespace string) VirtualMachineInterface
+}
+
+// VirtualMachineInterface has methods to work with VirtualMachine resources.
+type VirtualMachineInterface interface {
+	Create(ctx context.Context, virtualMachine *v1alpha3.VirtualMachine, opts v1.CreateOptions) (*v1alpha3.VirtualMachine, error)
+	Update(ctx context.Context, virtualMachine *v1alpha3.VirtualMachine, opts v1.UpdateOptions) (*v1alpha3.VirtualMachine, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachine *v1alpha3.VirtualMachine, opts v1.UpdateOptions) (*v1alpha3.VirtualMachine, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha3.VirtualMachine, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha3.VirtualMachineList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha3.VirtualMachine, err error)
+	VirtualMachineExpansion
+}
+
+// virtualMachines implements VirtualMachineInterface
+type virtualMachines struct {
+	*gentype.ClientWithList[*v1alpha3.VirtualMachine, *v1alpha3.VirtualMachineList]
+}
+
+// newVirtualMachines returns a VirtualMachines
+func newVirtualMachines(c *VmoperatorV1alpha3Client, namespace string) *virtualMachines {
+	return &virtualMachines{
+		gentype.NewClientWithList[*v1alpha3.VirtualMachine, *v1alpha3.VirtualMachineList](
+			"virtualmachines",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha3.VirtualMachine { return &v1alpha3.VirtualMachine{} },
+			func() *v1alpha3.VirtualMachineList { return &v1alpha3.VirtualMachineList{} }),
+	}
+}
@@ -0,0 +1,55 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"context"
+
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineDeployHooksGetter has a method to return a VirtualMachineDeployHookInterface.
+// A group's client should implement this interface.
+type VirtualMachineDeployHooksGetter interface {
+	VirtualMachineDeployHooks(namespace string) VirtualMachineDeployHookInterface
+}
+
+// VirtualMachineDeployHookInterface has methods to work with VirtualMachineDeployHook resources.
+type VirtualMachineDeployHookInterface interface {
+	Create(ctx context.Context, virtualMachineDeployHook *v1alpha3.VirtualMachineDeployHook, opts v1.CreateOptions) (*v1alpha3.VirtualMachineDeployHook, error)
+	Update(ctx context.Context, virtualMachineDeployHook *v1alpha3.VirtualMachineDeployHook, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineDeployHook, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha3.VirtualMachineDeployHook, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha3.VirtualMachineDeployHookList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha3.VirtualMachineDeployHook, err error)
+	VirtualMachineDeployHookExpansion
+}
+
+// virtualMachineDeployHooks implements VirtualMachineDeployHookInterface
+type virtualMachineDeployHooks struct {
+	*gentype.ClientWithList[*v1alpha3.VirtualMachineDeployHook, *v1alpha3.VirtualMachineDeployHookList]
+}
+
+// newVirtualMachineDeployHooks returns a VirtualMachineDeployHooks
+func newVirtualMachineDeployHooks(c *VmoperatorV1alpha3Client, namespace string) *virtualMachineDeployHooks {
+	return &virtualMachineDeployHooks{
+		gentype.NewClientWithList[*v1alpha3.VirtualMachineDeployHook, *v1alpha3.VirtualMachineDeployHookList](
+			"virtualmachinedeployhooks",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha3.VirtualMachineDeployHook { return &v1alpha3.VirtualMachineDeployHook{} },
+			func() *v1alpha3.VirtualMachineDeployHookList { return &v1alpha3.VirtualMachineDeployHookList{} }),
+	}
+}
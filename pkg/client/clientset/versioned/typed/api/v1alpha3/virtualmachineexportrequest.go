@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"context"
+
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineExportRequestsGetter has a method to return a VirtualMachineExportRequestInterface.
+// A group's client should implement this interface.
+type VirtualMachineExportRequestsGetter interface {
+	VirtualMachineExportRequests(namespace string) VirtualMachineExportRequestInterface
+}
+
+// VirtualMachineExportRequestInterface has methods to work with VirtualMachineExportRequest resources.
+type VirtualMachineExportRequestInterface interface {
+	Create(ctx context.Context, virtualMachineExportRequest *v1alpha3.VirtualMachineExportRequest, opts v1.CreateOptions) (*v1alpha3.VirtualMachineExportRequest, error)
+	Update(ctx context.Context, virtualMachineExportRequest *v1alpha3.VirtualMachineExportRequest, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineExportRequest, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineExportRequest *v1alpha3.VirtualMachineExportRequest, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineExportRequest, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha3.VirtualMachineExportRequest, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha3.VirtualMachineExportRequestList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha3.VirtualMachineExportRequest, err error)
+	VirtualMachineExportRequestExpansion
+}
+
+// virtualMachineExportRequests implements VirtualMachineExportRequestInterface
+type virtualMachineExportRequests struct {
+	*gentype.ClientWithList[*v1alpha3.VirtualMachineExportRequest, *v1alpha3.VirtualMachineExportRequestList]
+}
+
+// newVirtualMachineExportRequests returns a VirtualMachineExportRequests
+func newVirtualMachineExportRequests(c *VmoperatorV1alpha3Client, namespace string) *virtualMachineExportRequests {
+	return &virtualMachineExportRequests{
+		gentype.NewClientWithList[*v1alpha3.VirtualMachineExportRequest, *v1alpha3.VirtualMachineExportRequestList](
+			"virtualmachineexportrequests",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha3.VirtualMachineExportRequest { return &v1alpha3.VirtualMachineExportRequest{} },
+			func() *v1alpha3.VirtualMachineExportRequestList { return &v1alpha3.VirtualMachineExportRequestList{} }),
+	}
+}
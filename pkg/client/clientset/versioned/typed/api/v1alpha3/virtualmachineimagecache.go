@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"context"
+
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineImageCachesGetter has a method to return a VirtualMachineImageCacheInterface.
+// A group's client should implement this interface.
+type VirtualMachineImageCachesGetter interface {
+	VirtualMachineImageCaches(namespace string) VirtualMachineImageCacheInterface
+}
+
+// VirtualMachineImageCacheInterface has methods to work with VirtualMachineImageCache resources.
+type VirtualMachineImageCacheInterface interface {
+	Create(ctx context.Context, virtualMachineImageCache *v1alpha3.VirtualMachineImageCache, opts v1.CreateOptions) (*v1alpha3.VirtualMachineImageCache, error)
+	Update(ctx context.Context, virtualMachineImageCache *v1alpha3.VirtualMachineImageCache, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineImageCache, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineImageCache *v1alpha3.VirtualMachineImageCache, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineImageCache, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha3.VirtualMachineImageCache, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha3.VirtualMachineImageCacheList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha3.VirtualMachineImageCache, err error)
+	VirtualMachineImageCacheExpansion
+}
+
+// virtualMachineImageCaches implements VirtualMachineImageCacheInterface
+type virtualMachineImageCaches struct {
+	*gentype.ClientWithList[*v1alpha3.VirtualMachineImageCache, *v1alpha3.VirtualMachineImageCacheList]
+}
+
+// newVirtualMachineImageCaches returns a VirtualMachineImageCaches
+func newVirtualMachineImageCaches(c *VmoperatorV1alpha3Client, namespace string) *virtualMachineImageCaches {
+	return &virtualMachineImageCaches{
+		gentype.NewClientWithList[*v1alpha3.VirtualMachineImageCache, *v1alpha3.VirtualMachineImageCacheList](
+			"virtualmachineimagecaches",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha3.VirtualMachineImageCache { return &v1alpha3.VirtualMachineImageCache{} },
+			func() *v1alpha3.VirtualMachineImageCacheList { return &v1alpha3.VirtualMachineImageCacheList{} }),
+	}
+}
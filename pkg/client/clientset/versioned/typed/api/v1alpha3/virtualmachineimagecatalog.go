@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"context"
+
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineImageCatalogsGetter has a method to return a VirtualMachineImageCatalogInterface.
+// A group's client should implement this interface.
+type VirtualMachineImageCatalogsGetter interface {
+	VirtualMachineImageCatalogs() VirtualMachineImageCatalogInterface
+}
+
+// VirtualMachineImageCatalogInterface has methods to work with VirtualMachineImageCatalog resources.
+type VirtualMachineImageCatalogInterface interface {
+	Create(ctx context.Context, virtualMachineImageCatalog *v1alpha3.VirtualMachineImageCatalog, opts v1.CreateOptions) (*v1alpha3.VirtualMachineImageCatalog, error)
+	Update(ctx context.Context, virtualMachineImageCatalog *v1alpha3.VirtualMachineImageCatalog, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineImageCatalog, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineImageCatalog *v1alpha3.VirtualMachineImageCatalog, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineImageCatalog, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha3.VirtualMachineImageCatalog, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha3.VirtualMachineImageCatalogList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha3.VirtualMachineImageCatalog, err error)
+	VirtualMachineImageCatalogExpansion
+}
+
+// virtualMachineImageCatalogs implements VirtualMachineImageCatalogInterface
+type virtualMachineImageCatalogs struct {
+	*gentype.ClientWithList[*v1alpha3.VirtualMachineImageCatalog, *v1alpha3.VirtualMachineImageCatalogList]
+}
+
+// newVirtualMachineImageCatalogs returns a VirtualMachineImageCatalogs
+func newVirtualMachineImageCatalogs(c *VmoperatorV1alpha3Client) *virtualMachineImageCatalogs {
+	return &virtualMachineImageCatalogs{
+		gentype.NewClientWithList[*v1alpha3.VirtualMachineImageCatalog, *v1alpha3.VirtualMachineImageCatalogList](
+			"virtualmachineimagecatalogs",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *v1alpha3.VirtualMachineImageCatalog { return &v1alpha3.VirtualMachineImageCatalog{} },
+			func() *v1alpha3.VirtualMachineImageCatalogList { return &v1alpha3.VirtualMachineImageCatalogList{} }),
+	}
+}
@@ -0,0 +1,59 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"context"
+
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineInventoryReportsGetter has a method to return a VirtualMachineInventoryReportInterface.
+// A group's client should implement this interface.
+type VirtualMachineInventoryReportsGetter interface {
+	VirtualMachineInventoryReports() VirtualMachineInventoryReportInterface
+}
+
+// VirtualMachineInventoryReportInterface has methods to work with VirtualMachineInventoryReport resources.
+type VirtualMachineInventoryReportInterface interface {
+	Create(ctx context.Context, virtualMachineInventoryReport *v1alpha3.VirtualMachineInventoryReport, opts v1.CreateOptions) (*v1alpha3.VirtualMachineInventoryReport, error)
+	Update(ctx context.Context, virtualMachineInventoryReport *v1alpha3.VirtualMachineInventoryReport, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineInventoryReport, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineInventoryReport *v1alpha3.VirtualMachineInventoryReport, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineInventoryReport, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha3.VirtualMachineInventoryReport, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha3.VirtualMachineInventoryReportList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha3.VirtualMachineInventoryReport, err error)
+	VirtualMachineInventoryReportExpansion
+}
+
+// virtualMachineInventoryReports implements VirtualMachineInventoryReportInterface
+type virtualMachineInventoryReports struct {
+	*gentype.ClientWithList[*v1alpha3.VirtualMachineInventoryReport, *v1alpha3.VirtualMachineInventoryReportList]
+}
+
+// newVirtualMachineInventoryReports returns a VirtualMachineInventoryReports
+func newVirtualMachineInventoryReports(c *VmoperatorV1alpha3Client) *virtualMachineInventoryReports {
+	return &virtualMachineInventoryReports{
+		gentype.NewClientWithList[*v1alpha3.VirtualMachineInventoryReport, *v1alpha3.VirtualMachineInventoryReportList](
+			"virtualmachineinventoryreports",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *v1alpha3.VirtualMachineInventoryReport { return &v1alpha3.VirtualMachineInventoryReport{} },
+			func() *v1alpha3.VirtualMachineInventoryReportList {
+				return &v1alpha3.VirtualMachineInventoryReportList{}
+			}),
+	}
+}
@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"context"
+
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineReplicaSetsGetter has a method to return a VirtualMachineReplicaSetInterface.
+// A group's client should implement this interface.
+type VirtualMachineReplicaSetsGetter interface {
+	VirtualMachineReplicaSets(namespace string) VirtualMachineReplicaSetInterface
+}
+
+// VirtualMachineReplicaSetInterface has methods to work with VirtualMachineReplicaSet resources.
+type VirtualMachineReplicaSetInterface interface {
+	Create(ctx context.Context, virtualMachineReplicaSet *v1alpha3.VirtualMachineReplicaSet, opts v1.CreateOptions) (*v1alpha3.VirtualMachineReplicaSet, error)
+	Update(ctx context.Context, virtualMachineReplicaSet *v1alpha3.VirtualMachineReplicaSet, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineReplicaSet, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineReplicaSet *v1alpha3.VirtualMachineReplicaSet, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineReplicaSet, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha3.VirtualMachineReplicaSet, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha3.VirtualMachineReplicaSetList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha3.VirtualMachineReplicaSet, err error)
+	VirtualMachineReplicaSetExpansion
+}
+
+// virtualMachineReplicaSets implements VirtualMachineReplicaSetInterface
+type virtualMachineReplicaSets struct {
+	*gentype.ClientWithList[*v1alpha3.VirtualMachineReplicaSet, *v1alpha3.VirtualMachineReplicaSetList]
+}
+
+// newVirtualMachineReplicaSets returns a VirtualMachineReplicaSets
+func newVirtualMachineReplicaSets(c *VmoperatorV1alpha3Client, namespace string) *virtualMachineReplicaSets {
+	return &virtualMachineReplicaSets{
+		gentype.NewClientWithList[*v1alpha3.VirtualMachineReplicaSet, *v1alpha3.VirtualMachineReplicaSetList](
+			"virtualmachinereplicasets",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha3.VirtualMachineReplicaSet { return &v1alpha3.VirtualMachineReplicaSet{} },
+			func() *v1alpha3.VirtualMachineReplicaSetList { return &v1alpha3.VirtualMachineReplicaSetList{} }),
+	}
+}
@@ -0,0 +1,59 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"context"
+
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineSetResourcePoliciesGetter has a method to return a VirtualMachineSetResourcePolicyInterface.
+// A group's client should implement this interface.
+type VirtualMachineSetResourcePoliciesGetter interface {
+	VirtualMachineSetResourcePolicies(namespace string) VirtualMachineSetResourcePolicyInterface
+}
+
+// VirtualMachineSetResourcePolicyInterface has methods to work with VirtualMachineSetResourcePolicy resources.
+type VirtualMachineSetResourcePolicyInterface interface {
+	Create(ctx context.Context, virtualMachineSetResourcePolicy *v1alpha3.VirtualMachineSetResourcePolicy, opts v1.CreateOptions) (*v1alpha3.VirtualMachineSetResourcePolicy, error)
+	Update(ctx context.Context, virtualMachineSetResourcePolicy *v1alpha3.VirtualMachineSetResourcePolicy, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineSetResourcePolicy, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineSetResourcePolicy *v1alpha3.VirtualMachineSetResourcePolicy, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineSetResourcePolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha3.VirtualMachineSetResourcePolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha3.VirtualMachineSetResourcePolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha3.VirtualMachineSetResourcePolicy, err error)
+	VirtualMachineSetResourcePolicyExpansion
+}
+
+// virtualMachineSetResourcePolicies implements VirtualMachineSetResourcePolicyInterface
+type virtualMachineSetResourcePolicies struct {
+	*gentype.ClientWithList[*v1alpha3.VirtualMachineSetResourcePolicy, *v1alpha3.VirtualMachineSetResourcePolicyList]
+}
+
+// newVirtualMachineSetResourcePolicies returns a VirtualMachineSetResourcePolicies
+func newVirtualMachineSetResourcePolicies(c *VmoperatorV1alpha3Client, namespace string) *virtualMachineSetResourcePolicies {
+	return &virtualMachineSetResourcePolicies{
+		gentype.NewClientWithList[*v1alpha3.VirtualMachineSetResourcePolicy, *v1alpha3.VirtualMachineSetResourcePolicyList](
+			"virtualmachinesetresourcepolicies",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha3.VirtualMachineSetResourcePolicy { return &v1alpha3.VirtualMachineSetResourcePolicy{} },
+			func() *v1alpha3.VirtualMachineSetResourcePolicyList {
+				return &v1alpha3.VirtualMachineSetResourcePolicyList{}
+			}),
+	}
+}
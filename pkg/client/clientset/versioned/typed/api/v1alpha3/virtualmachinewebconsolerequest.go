@@ -0,0 +1,59 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"context"
+
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	scheme "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VirtualMachineWebConsoleRequestsGetter has a method to return a VirtualMachineWebConsoleRequestInterface.
+// A group's client should implement this interface.
+type VirtualMachineWebConsoleRequestsGetter interface {
+	VirtualMachineWebConsoleRequests(namespace string) VirtualMachineWebConsoleRequestInterface
+}
+
+// VirtualMachineWebConsoleRequestInterface has methods to work with VirtualMachineWebConsoleRequest resources.
+type VirtualMachineWebConsoleRequestInterface interface {
+	Create(ctx context.Context, virtualMachineWebConsoleRequest *v1alpha3.VirtualMachineWebConsoleRequest, opts v1.CreateOptions) (*v1alpha3.VirtualMachineWebConsoleRequest, error)
+	Update(ctx context.Context, virtualMachineWebConsoleRequest *v1alpha3.VirtualMachineWebConsoleRequest, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineWebConsoleRequest, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineWebConsoleRequest *v1alpha3.VirtualMachineWebConsoleRequest, opts v1.UpdateOptions) (*v1alpha3.VirtualMachineWebConsoleRequest, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha3.VirtualMachineWebConsoleRequest, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha3.VirtualMachineWebConsoleRequestList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha3.VirtualMachineWebConsoleRequest, err error)
+	VirtualMachineWebConsoleRequestExpansion
+}
+
+// virtualMachineWebConsoleRequests implements VirtualMachineWebConsoleRequestInterface
+type virtualMachineWebConsoleRequests struct {
+	*gentype.ClientWithList[*v1alpha3.VirtualMachineWebConsoleRequest, *v1alpha3.VirtualMachineWebConsoleRequestList]
+}
+
+// newVirtualMachineWebConsoleRequests returns a VirtualMachineWebConsoleRequests
+func newVirtualMachineWebConsoleRequests(c *VmoperatorV1alpha3Client, namespace string) *virtualMachineWebConsoleRequests {
+	return &virtualMachineWebConsoleRequests{
+		gentype.NewClientWithList[*v1alpha3.VirtualMachineWebConsoleRequest, *v1alpha3.VirtualMachineWebConsoleRequestList](
+			"virtualmachinewebconsolerequests",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha3.VirtualMachineWebConsoleRequest { return &v1alpha3.VirtualMachineWebConsoleRequest{} },
+			func() *v1alpha3.VirtualMachineWebConsoleRequestList {
+				return &v1alpha3.VirtualMachineWebConsoleRequestList{}
+			}),
+	}
+}
@@ -0,0 +1,78 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	apiv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	versioned "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/vmware-tanzu/vm-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/pkg/client/listers/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineImageInformer provides access to a shared informer and lister for
+// VirtualMachineImages.
+type VirtualMachineImageInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.VirtualMachineImageLister
+}
+
+type virtualMachineImageInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewVirtualMachineImageInformer constructs a new informer for VirtualMachineImage type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewVirtualMachineImageInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredVirtualMachineImageInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredVirtualMachineImageInformer constructs a new informer for VirtualMachineImage type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredVirtualMachineImageInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VmoperatorV1alpha1().VirtualMachineImages(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VmoperatorV1alpha1().VirtualMachineImages(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&apiv1alpha1.VirtualMachineImage{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *virtualMachineImageInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredVirtualMachineImageInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *virtualMachineImageInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apiv1alpha1.VirtualMachineImage{}, f.defaultInformer)
+}
+
+func (f *virtualMachineImageInformer) Lister() v1alpha1.VirtualMachineImageLister {
+	return v1alpha1.NewVirtualMachineImageLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,78 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"context"
+	time "time"
+
+	apiv1alpha2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2"
+	versioned "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/vmware-tanzu/vm-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha2 "github.com/vmware-tanzu/vm-operator/pkg/client/listers/api/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachinePublishRequestInformer provides access to a shared informer and lister for
+// VirtualMachinePublishRequests.
+type VirtualMachinePublishRequestInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha2.VirtualMachinePublishRequestLister
+}
+
+type virtualMachinePublishRequestInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewVirtualMachinePublishRequestInformer constructs a new informer for VirtualMachinePublishRequest type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewVirtualMachinePublishRequestInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredVirtualMachinePublishRequestInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredVirtualMachinePublishRequestInformer constructs a new informer for VirtualMachinePublishRequest type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredVirtualMachinePublishRequestInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VmoperatorV1alpha2().VirtualMachinePublishRequests(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VmoperatorV1alpha2().VirtualMachinePublishRequests(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&apiv1alpha2.VirtualMachinePublishRequest{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *virtualMachinePublishRequestInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredVirtualMachinePublishRequestInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *virtualMachinePublishRequestInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apiv1alpha2.VirtualMachinePublishRequest{}, f.defaultInformer)
+}
+
+func (f *virtualMachinePublishRequestInformer) Lister() v1alpha2.VirtualMachinePublishRequestLister {
+	return v1alpha2.NewVirtualMachinePublishRequestLister(f.Informer().GetIndexer())
+}
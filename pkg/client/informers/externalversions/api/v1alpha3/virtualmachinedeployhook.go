@@ -0,0 +1,78 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"context"
+	time "time"
+
+	apiv1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	versioned "github.com/vmware-tanzu/vm-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/vmware-tanzu/vm-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/pkg/client/listers/api/v1alpha3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineDeployHookInformer provides access to a shared informer and lister for
+// VirtualMachineDeployHooks.
+type VirtualMachineDeployHookInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha3.VirtualMachineDeployHookLister
+}
+
+type virtualMachineDeployHookInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewVirtualMachineDeployHookInformer constructs a new informer for VirtualMachineDeployHook type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewVirtualMachineDeployHookInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredVirtualMachineDeployHookInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredVirtualMachineDeployHookInformer constructs a new informer for VirtualMachineDeployHook type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredVirtualMachineDeployHookInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VmoperatorV1alpha3().VirtualMachineDeployHooks(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VmoperatorV1alpha3().VirtualMachineDeployHooks(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&apiv1alpha3.VirtualMachineDeployHook{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *virtualMachineDeployHookInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredVirtualMachineDeployHookInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *virtualMachineDeployHookInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apiv1alpha3.VirtualMachineDeployHook{}, f.defaultInformer)
+}
+
+func (f *virtualMachineDeployHookInformer) Lister() v1alpha3.VirtualMachineDeployHookLister {
+	return v1alpha3.NewVirtualMachineDeployHookLister(f.Informer().GetIndexer())
+}
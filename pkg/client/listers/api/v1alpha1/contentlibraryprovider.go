@@ -0,0 +1,36 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ContentLibraryProviderLister helps list ContentLibraryProviders.
+// All objects returned here must be treated as read-only.
+type ContentLibraryProviderLister interface {
+	// List lists all ContentLibraryProviders in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ContentLibraryProvider, err error)
+	// Get retrieves the ContentLibraryProvider from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ContentLibraryProvider, error)
+	ContentLibraryProviderListerExpansion
+}
+
+// contentLibraryProviderLister implements the ContentLibraryProviderLister interface.
+type contentLibraryProviderLister struct {
+	listers.ResourceIndexer[*v1alpha1.ContentLibraryProvider]
+}
+
+// NewContentLibraryProviderLister returns a new ContentLibraryProviderLister.
+func NewContentLibraryProviderLister(indexer cache.Indexer) ContentLibraryProviderLister {
+	return &contentLibraryProviderLister{listers.New[*v1alpha1.ContentLibraryProvider](indexer, v1alpha1.Resource("contentlibraryprovider"))}
+}
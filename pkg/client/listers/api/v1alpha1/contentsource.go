@@ -0,0 +1,36 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ContentSourceLister helps list ContentSources.
+// All objects returned here must be treated as read-only.
+type ContentSourceLister interface {
+	// List lists all ContentSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ContentSource, err error)
+	// Get retrieves the ContentSource from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ContentSource, error)
+	ContentSourceListerExpansion
+}
+
+// contentSourceLister implements the ContentSourceLister interface.
+type contentSourceLister struct {
+	listers.ResourceIndexer[*v1alpha1.ContentSource]
+}
+
+// NewContentSourceLister returns a new ContentSourceLister.
+func NewContentSourceLister(indexer cache.Indexer) ContentSourceLister {
+	return &contentSourceLister{listers.New[*v1alpha1.ContentSource](indexer, v1alpha1.Resource("contentsource"))}
+}
@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ContentSourceBindingLister helps list ContentSourceBindings.
+// All objects returned here must be treated as read-only.
+type ContentSourceBindingLister interface {
+	// List lists all ContentSourceBindings in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ContentSourceBinding, err error)
+	// ContentSourceBindings returns an object that can list and get ContentSourceBindings.
+	ContentSourceBindings(namespace string) ContentSourceBindingNamespaceLister
+	ContentSourceBindingListerExpansion
+}
+
+// contentSourceBindingLister implements the ContentSourceBindingLister interface.
+type contentSourceBindingLister struct {
+	listers.ResourceIndexer[*v1alpha1.ContentSourceBinding]
+}
+
+// NewContentSourceBindingLister returns a new ContentSourceBindingLister.
+func NewContentSourceBindingLister(indexer cache.Indexer) ContentSourceBindingLister {
+	return &contentSourceBindingLister{listers.New[*v1alpha1.ContentSourceBinding](indexer, v1alpha1.Resource("contentsourcebinding"))}
+}
+
+// ContentSourceBindings returns an object that can list and get ContentSourceBindings.
+func (s *contentSourceBindingLister) ContentSourceBindings(namespace string) ContentSourceBindingNamespaceLister {
+	return contentSourceBindingNamespaceLister{listers.NewNamespaced[*v1alpha1.ContentSourceBinding](s.ResourceIndexer, namespace)}
+}
+
+// ContentSourceBindingNamespaceLister helps list and get ContentSourceBindings.
+// All objects returned here must be treated as read-only.
+type ContentSourceBindingNamespaceLister interface {
+	// List lists all ContentSourceBindings in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ContentSourceBinding, err error)
+	// Get retrieves the ContentSourceBinding from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ContentSourceBinding, error)
+	ContentSourceBindingNamespaceListerExpansion
+}
+
+// contentSourceBindingNamespaceLister implements the ContentSourceBindingNamespaceLister
+// interface.
+type contentSourceBindingNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1.ContentSourceBinding]
+}
@@ -0,0 +1,36 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// HTTPContentProviderLister helps list HTTPContentProviders.
+// All objects returned here must be treated as read-only.
+type HTTPContentProviderLister interface {
+	// List lists all HTTPContentProviders in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.HTTPContentProvider, err error)
+	// Get retrieves the HTTPContentProvider from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.HTTPContentProvider, error)
+	HTTPContentProviderListerExpansion
+}
+
+// hTTPContentProviderLister implements the HTTPContentProviderLister interface.
+type hTTPContentProviderLister struct {
+	listers.ResourceIndexer[*v1alpha1.HTTPContentProvider]
+}
+
+// NewHTTPContentProviderLister returns a new HTTPContentProviderLister.
+func NewHTTPContentProviderLister(indexer cache.Indexer) HTTPContentProviderLister {
+	return &hTTPContentProviderLister{listers.New[*v1alpha1.HTTPContentProvider](indexer, v1alpha1.Resource("httpcontentprovider"))}
+}
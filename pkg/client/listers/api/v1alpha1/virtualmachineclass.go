@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineClassLister helps list VirtualMachineClasses.
+// All objects returned here must be treated as read-only.
+type VirtualMachineClassLister interface {
+	// List lists all VirtualMachineClasses in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VirtualMachineClass, err error)
+	// VirtualMachineClasses returns an object that can list and get VirtualMachineClasses.
+	VirtualMachineClasses(namespace string) VirtualMachineClassNamespaceLister
+	VirtualMachineClassListerExpansion
+}
+
+// virtualMachineClassLister implements the VirtualMachineClassLister interface.
+type virtualMachineClassLister struct {
+	listers.ResourceIndexer[*v1alpha1.VirtualMachineClass]
+}
+
+// NewVirtualMachineClassLister returns a new VirtualMachineClassLister.
+func NewVirtualMachineClassLister(indexer cache.Indexer) VirtualMachineClassLister {
+	return &virtualMachineClassLister{listers.New[*v1alpha1.VirtualMachineClass](indexer, v1alpha1.Resource("virtualmachineclass"))}
+}
+
+// VirtualMachineClasses returns an object that can list and get VirtualMachineClasses.
+func (s *virtualMachineClassLister) VirtualMachineClasses(namespace string) VirtualMachineClassNamespaceLister {
+	return virtualMachineClassNamespaceLister{listers.NewNamespaced[*v1alpha1.VirtualMachineClass](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachineClassNamespaceLister helps list and get VirtualMachineClasses.
+// All objects returned here must be treated as read-only.
+type VirtualMachineClassNamespaceLister interface {
+	// List lists all VirtualMachineClasses in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VirtualMachineClass, err error)
+	// Get retrieves the VirtualMachineClass from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.VirtualMachineClass, error)
+	VirtualMachineClassNamespaceListerExpansion
+}
+
+// virtualMachineClassNamespaceLister implements the VirtualMachineClassNamespaceLister
+// interface.
+type virtualMachineClassNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1.VirtualMachineClass]
+}
@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineClassBindingLister helps list VirtualMachineClassBindings.
+// All objects returned here must be treated as read-only.
+type VirtualMachineClassBindingLister interface {
+	// List lists all VirtualMachineClassBindings in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VirtualMachineClassBinding, err error)
+	// VirtualMachineClassBindings returns an object that can list and get VirtualMachineClassBindings.
+	VirtualMachineClassBindings(namespace string) VirtualMachineClassBindingNamespaceLister
+	VirtualMachineClassBindingListerExpansion
+}
+
+// virtualMachineClassBindingLister implements the VirtualMachineClassBindingLister interface.
+type virtualMachineClassBindingLister struct {
+	listers.ResourceIndexer[*v1alpha1.VirtualMachineClassBinding]
+}
+
+// NewVirtualMachineClassBindingLister returns a new VirtualMachineClassBindingLister.
+func NewVirtualMachineClassBindingLister(indexer cache.Indexer) VirtualMachineClassBindingLister {
+	return &virtualMachineClassBindingLister{listers.New[*v1alpha1.VirtualMachineClassBinding](indexer, v1alpha1.Resource("virtualmachineclassbinding"))}
+}
+
+// VirtualMachineClassBindings returns an object that can list and get VirtualMachineClassBindings.
+func (s *virtualMachineClassBindingLister) VirtualMachineClassBindings(namespace string) VirtualMachineClassBindingNamespaceLister {
+	return virtualMachineClassBindingNamespaceLister{listers.NewNamespaced[*v1alpha1.VirtualMachineClassBinding](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachineClassBindingNamespaceLister helps list and get VirtualMachineClassBindings.
+// All objects returned here must be treated as read-only.
+type VirtualMachineClassBindingNamespaceLister interface {
+	// List lists all VirtualMachineClassBindings in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VirtualMachineClassBinding, err error)
+	// Get retrieves the VirtualMachineClassBinding from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.VirtualMachineClassBinding, error)
+	VirtualMachineClassBindingNamespaceListerExpansion
+}
+
+// virtualMachineClassBindingNamespaceLister implements the VirtualMachineClassBindingNamespaceLister
+// interface.
+type virtualMachineClassBindingNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1.VirtualMachineClassBinding]
+}
@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachinePublishRequestLister helps list VirtualMachinePublishRequests.
+// All objects returned here must be treated as read-only.
+type VirtualMachinePublishRequestLister interface {
+	// List lists all VirtualMachinePublishRequests in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VirtualMachinePublishRequest, err error)
+	// VirtualMachinePublishRequests returns an object that can list and get VirtualMachinePublishRequests.
+	VirtualMachinePublishRequests(namespace string) VirtualMachinePublishRequestNamespaceLister
+	VirtualMachinePublishRequestListerExpansion
+}
+
+// virtualMachinePublishRequestLister implements the VirtualMachinePublishRequestLister interface.
+type virtualMachinePublishRequestLister struct {
+	listers.ResourceIndexer[*v1alpha1.VirtualMachinePublishRequest]
+}
+
+// NewVirtualMachinePublishRequestLister returns a new VirtualMachinePublishRequestLister.
+func NewVirtualMachinePublishRequestLister(indexer cache.Indexer) VirtualMachinePublishRequestLister {
+	return &virtualMachinePublishRequestLister{listers.New[*v1alpha1.VirtualMachinePublishRequest](indexer, v1alpha1.Resource("virtualmachinepublishrequest"))}
+}
+
+// VirtualMachinePublishRequests returns an object that can list and get VirtualMachinePublishRequests.
+func (s *virtualMachinePublishRequestLister) VirtualMachinePublishRequests(namespace string) VirtualMachinePublishRequestNamespaceLister {
+	return virtualMachinePublishRequestNamespaceLister{listers.NewNamespaced[*v1alpha1.VirtualMachinePublishRequest](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachinePublishRequestNamespaceLister helps list and get VirtualMachinePublishRequests.
+// All objects returned here must be treated as read-only.
+type VirtualMachinePublishRequestNamespaceLister interface {
+	// List lists all VirtualMachinePublishRequests in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VirtualMachinePublishRequest, err error)
+	// Get retrieves the VirtualMachinePublishRequest from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.VirtualMachinePublishRequest, error)
+	VirtualMachinePublishRequestNamespaceListerExpansion
+}
+
+// virtualMachinePublishRequestNamespaceLister implements the VirtualMachinePublishRequestNamespaceLister
+// interface.
+type virtualMachinePublishRequestNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1.VirtualMachinePublishRequest]
+}
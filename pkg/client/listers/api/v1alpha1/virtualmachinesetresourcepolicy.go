@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineSetResourcePolicyLister helps list VirtualMachineSetResourcePolicies.
+// All objects returned here must be treated as read-only.
+type VirtualMachineSetResourcePolicyLister interface {
+	// List lists all VirtualMachineSetResourcePolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VirtualMachineSetResourcePolicy, err error)
+	// VirtualMachineSetResourcePolicies returns an object that can list and get VirtualMachineSetResourcePolicies.
+	VirtualMachineSetResourcePolicies(namespace string) VirtualMachineSetResourcePolicyNamespaceLister
+	VirtualMachineSetResourcePolicyListerExpansion
+}
+
+// virtualMachineSetResourcePolicyLister implements the VirtualMachineSetResourcePolicyLister interface.
+type virtualMachineSetResourcePolicyLister struct {
+	listers.ResourceIndexer[*v1alpha1.VirtualMachineSetResourcePolicy]
+}
+
+// NewVirtualMachineSetResourcePolicyLister returns a new VirtualMachineSetResourcePolicyLister.
+func NewVirtualMachineSetResourcePolicyLister(indexer cache.Indexer) VirtualMachineSetResourcePolicyLister {
+	return &virtualMachineSetResourcePolicyLister{listers.New[*v1alpha1.VirtualMachineSetResourcePolicy](indexer, v1alpha1.Resource("virtualmachinesetresourcepolicy"))}
+}
+
+// VirtualMachineSetResourcePolicies returns an object that can list and get VirtualMachineSetResourcePolicies.
+func (s *virtualMachineSetResourcePolicyLister) VirtualMachineSetResourcePolicies(namespace string) VirtualMachineSetResourcePolicyNamespaceLister {
+	return virtualMachineSetResourcePolicyNamespaceLister{listers.NewNamespaced[*v1alpha1.VirtualMachineSetResourcePolicy](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachineSetResourcePolicyNamespaceLister helps list and get VirtualMachineSetResourcePolicies.
+// All objects returned here must be treated as read-only.
+type VirtualMachineSetResourcePolicyNamespaceLister interface {
+	// List lists all VirtualMachineSetResourcePolicies in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VirtualMachineSetResourcePolicy, err error)
+	// Get retrieves the VirtualMachineSetResourcePolicy from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.VirtualMachineSetResourcePolicy, error)
+	VirtualMachineSetResourcePolicyNamespaceListerExpansion
+}
+
+// virtualMachineSetResourcePolicyNamespaceLister implements the VirtualMachineSetResourcePolicyNamespaceLister
+// interface.
+type virtualMachineSetResourcePolicyNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1.VirtualMachineSetResourcePolicy]
+}
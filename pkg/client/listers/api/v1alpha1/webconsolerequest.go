@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WebConsoleRequestLister helps list WebConsoleRequests.
+// All objects returned here must be treated as read-only.
+type WebConsoleRequestLister interface {
+	// List lists all WebConsoleRequests in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WebConsoleRequest, err error)
+	// WebConsoleRequests returns an object that can list and get WebConsoleRequests.
+	WebConsoleRequests(namespace string) WebConsoleRequestNamespaceLister
+	WebConsoleRequestListerExpansion
+}
+
+// webConsoleRequestLister implements the WebConsoleRequestLister interface.
+type webConsoleRequestLister struct {
+	listers.ResourceIndexer[*v1alpha1.WebConsoleRequest]
+}
+
+// NewWebConsoleRequestLister returns a new WebConsoleRequestLister.
+func NewWebConsoleRequestLister(indexer cache.Indexer) WebConsoleRequestLister {
+	return &webConsoleRequestLister{listers.New[*v1alpha1.WebConsoleRequest](indexer, v1alpha1.Resource("webconsolerequest"))}
+}
+
+// WebConsoleRequests returns an object that can list and get WebConsoleRequests.
+func (s *webConsoleRequestLister) WebConsoleRequests(namespace string) WebConsoleRequestNamespaceLister {
+	return webConsoleRequestNamespaceLister{listers.NewNamespaced[*v1alpha1.WebConsoleRequest](s.ResourceIndexer, namespace)}
+}
+
+// WebConsoleRequestNamespaceLister helps list and get WebConsoleRequests.
+// All objects returned here must be treated as read-only.
+type WebConsoleRequestNamespaceLister interface {
+	// List lists all WebConsoleRequests in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WebConsoleRequest, err error)
+	// Get retrieves the WebConsoleRequest from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.WebConsoleRequest, error)
+	WebConsoleRequestNamespaceListerExpansion
+}
+
+// webConsoleRequestNamespaceLister implements the WebConsoleRequestNamespaceLister
+// interface.
+type webConsoleRequestNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1.WebConsoleRequest]
+}
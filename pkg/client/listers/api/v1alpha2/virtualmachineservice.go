@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineServiceLister helps list VirtualMachineServices.
+// All objects returned here must be treated as read-only.
+type VirtualMachineServiceLister interface {
+	// List lists all VirtualMachineServices in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha2.VirtualMachineService, err error)
+	// VirtualMachineServices returns an object that can list and get VirtualMachineServices.
+	VirtualMachineServices(namespace string) VirtualMachineServiceNamespaceLister
+	VirtualMachineServiceListerExpansion
+}
+
+// virtualMachineServiceLister implements the VirtualMachineServiceLister interface.
+type virtualMachineServiceLister struct {
+	listers.ResourceIndexer[*v1alpha2.VirtualMachineService]
+}
+
+// NewVirtualMachineServiceLister returns a new VirtualMachineServiceLister.
+func NewVirtualMachineServiceLister(indexer cache.Indexer) VirtualMachineServiceLister {
+	return &virtualMachineServiceLister{listers.New[*v1alpha2.VirtualMachineService](indexer, v1alpha2.Resource("virtualmachineservice"))}
+}
+
+// VirtualMachineServices returns an object that can list and get VirtualMachineServices.
+func (s *virtualMachineServiceLister) VirtualMachineServices(namespace string) VirtualMachineServiceNamespaceLister {
+	return virtualMachineServiceNamespaceLister{listers.NewNamespaced[*v1alpha2.VirtualMachineService](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachineServiceNamespaceLister helps list and get VirtualMachineServices.
+// All objects returned here must be treated as read-only.
+type VirtualMachineServiceNamespaceLister interface {
+	// List lists all VirtualMachineServices in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha2.VirtualMachineService, err error)
+	// Get retrieves the VirtualMachineService from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha2.VirtualMachineService, error)
+	VirtualMachineServiceNamespaceListerExpansion
+}
+
+// virtualMachineServiceNamespaceLister implements the VirtualMachineServiceNamespaceLister
+// interface.
+type virtualMachineServiceNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha2.VirtualMachineService]
+}
@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineWebConsoleRequestLister helps list VirtualMachineWebConsoleRequests.
+// All objects returned here must be treated as read-only.
+type VirtualMachineWebConsoleRequestLister interface {
+	// List lists all VirtualMachineWebConsoleRequests in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha2.VirtualMachineWebConsoleRequest, err error)
+	// VirtualMachineWebConsoleRequests returns an object that can list and get VirtualMachineWebConsoleRequests.
+	VirtualMachineWebConsoleRequests(namespace string) VirtualMachineWebConsoleRequestNamespaceLister
+	VirtualMachineWebConsoleRequestListerExpansion
+}
+
+// virtualMachineWebConsoleRequestLister implements the VirtualMachineWebConsoleRequestLister interface.
+type virtualMachineWebConsoleRequestLister struct {
+	listers.ResourceIndexer[*v1alpha2.VirtualMachineWebConsoleRequest]
+}
+
+// NewVirtualMachineWebConsoleRequestLister returns a new VirtualMachineWebConsoleRequestLister.
+func NewVirtualMachineWebConsoleRequestLister(indexer cache.Indexer) VirtualMachineWebConsoleRequestLister {
+	return &virtualMachineWebConsoleRequestLister{listers.New[*v1alpha2.VirtualMachineWebConsoleRequest](indexer, v1alpha2.Resource("virtualmachinewebconsolerequest"))}
+}
+
+// VirtualMachineWebConsoleRequests returns an object that can list and get VirtualMachineWebConsoleRequests.
+func (s *virtualMachineWebConsoleRequestLister) VirtualMachineWebConsoleRequests(namespace string) VirtualMachineWebConsoleRequestNamespaceLister {
+	return virtualMachineWebConsoleRequestNamespaceLister{listers.NewNamespaced[*v1alpha2.VirtualMachineWebConsoleRequest](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachineWebConsoleRequestNamespaceLister helps list and get VirtualMachineWebConsoleRequests.
+// All objects returned here must be treated as read-only.
+type VirtualMachineWebConsoleRequestNamespaceLister interface {
+	// List lists all VirtualMachineWebConsoleRequests in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha2.VirtualMachineWebConsoleRequest, err error)
+	// Get retrieves the VirtualMachineWebConsoleRequest from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha2.VirtualMachineWebConsoleRequest, error)
+	VirtualMachineWebConsoleRequestNamespaceListerExpansion
+}
+
+// virtualMachineWebConsoleRequestNamespaceLister implements the VirtualMachineWebConsoleRequestNamespaceLister
+// interface.
+type virtualMachineWebConsoleRequestNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha2.VirtualMachineWebConsoleRequest]
+}
@@ -0,0 +1,36 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ClusterVirtualMachineImageLister helps list ClusterVirtualMachineImages.
+// All objects returned here must be treated as read-only.
+type ClusterVirtualMachineImageLister interface {
+	// List lists all ClusterVirtualMachineImages in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.ClusterVirtualMachineImage, err error)
+	// Get retrieves the ClusterVirtualMachineImage from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha3.ClusterVirtualMachineImage, error)
+	ClusterVirtualMachineImageListerExpansion
+}
+
+// clusterVirtualMachineImageLister implements the ClusterVirtualMachineImageLister interface.
+type clusterVirtualMachineImageLister struct {
+	listers.ResourceIndexer[*v1alpha3.ClusterVirtualMachineImage]
+}
+
+// NewClusterVirtualMachineImageLister returns a new ClusterVirtualMachineImageLister.
+func NewClusterVirtualMachineImageLister(indexer cache.Indexer) ClusterVirtualMachineImageLister {
+	return &clusterVirtualMachineImageLister{listers.New[*v1alpha3.ClusterVirtualMachineImage](indexer, v1alpha3.Resource("clustervirtualmachineimage"))}
+}
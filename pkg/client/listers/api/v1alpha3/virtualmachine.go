@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineLister helps list VirtualMachines.
+// All objects returned here must be treated as read-only.
+type VirtualMachineLister interface {
+	// List lists all VirtualMachines in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachine, err error)
+	// VirtualMachines returns an object that can list and get VirtualMachines.
+	VirtualMachines(namespace string) VirtualMachineNamespaceLister
+	VirtualMachineListerExpansion
+}
+
+// virtualMachineLister implements the VirtualMachineLister interface.
+type virtualMachineLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachine]
+}
+
+// NewVirtualMachineLister returns a new VirtualMachineLister.
+func NewVirtualMachineLister(indexer cache.Indexer) VirtualMachineLister {
+	return &virtualMachineLister{listers.New[*v1alpha3.VirtualMachine](indexer, v1alpha3.Resource("virtualmachine"))}
+}
+
+// VirtualMachines returns an object that can list and get VirtualMachines.
+func (s *virtualMachineLister) VirtualMachines(namespace string) VirtualMachineNamespaceLister {
+	return virtualMachineNamespaceLister{listers.NewNamespaced[*v1alpha3.VirtualMachine](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachineNamespaceLister helps list and get VirtualMachines.
+// All objects returned here must be treated as read-only.
+type VirtualMachineNamespaceLister interface {
+	// List lists all VirtualMachines in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachine, err error)
+	// Get retrieves the VirtualMachine from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha3.VirtualMachine, error)
+	VirtualMachineNamespaceListerExpansion
+}
+
+// virtualMachineNamespaceLister implements the VirtualMachineNamespaceLister
+// interface.
+type virtualMachineNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachine]
+}
@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineDeployHookLister helps list VirtualMachineDeployHooks.
+// All objects returned here must be treated as read-only.
+type VirtualMachineDeployHookLister interface {
+	// List lists all VirtualMachineDeployHooks in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineDeployHook, err error)
+	// VirtualMachineDeployHooks returns an object that can list and get VirtualMachineDeployHooks.
+	VirtualMachineDeployHooks(namespace string) VirtualMachineDeployHookNamespaceLister
+	VirtualMachineDeployHookListerExpansion
+}
+
+// virtualMachineDeployHookLister implements the VirtualMachineDeployHookLister interface.
+type virtualMachineDeployHookLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineDeployHook]
+}
+
+// NewVirtualMachineDeployHookLister returns a new VirtualMachineDeployHookLister.
+func NewVirtualMachineDeployHookLister(indexer cache.Indexer) VirtualMachineDeployHookLister {
+	return &virtualMachineDeployHookLister{listers.New[*v1alpha3.VirtualMachineDeployHook](indexer, v1alpha3.Resource("virtualmachinedeployhook"))}
+}
+
+// VirtualMachineDeployHooks returns an object that can list and get VirtualMachineDeployHooks.
+func (s *virtualMachineDeployHookLister) VirtualMachineDeployHooks(namespace string) VirtualMachineDeployHookNamespaceLister {
+	return virtualMachineDeployHookNamespaceLister{listers.NewNamespaced[*v1alpha3.VirtualMachineDeployHook](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachineDeployHookNamespaceLister helps list and get VirtualMachineDeployHooks.
+// All objects returned here must be treated as read-only.
+type VirtualMachineDeployHookNamespaceLister interface {
+	// List lists all VirtualMachineDeployHooks in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineDeployHook, err error)
+	// Get retrieves the VirtualMachineDeployHook from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha3.VirtualMachineDeployHook, error)
+	VirtualMachineDeployHookNamespaceListerExpansion
+}
+
+// virtualMachineDeployHookNamespaceLister implements the VirtualMachineDeployHookNamespaceLister
+// interface.
+type virtualMachineDeployHookNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineDeployHook]
+}
@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineExportRequestLister helps list VirtualMachineExportRequests.
+// All objects returned here must be treated as read-only.
+type VirtualMachineExportRequestLister interface {
+	// List lists all VirtualMachineExportRequests in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineExportRequest, err error)
+	// VirtualMachineExportRequests returns an object that can list and get VirtualMachineExportRequests.
+	VirtualMachineExportRequests(namespace string) VirtualMachineExportRequestNamespaceLister
+	VirtualMachineExportRequestListerExpansion
+}
+
+// virtualMachineExportRequestLister implements the VirtualMachineExportRequestLister interface.
+type virtualMachineExportRequestLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineExportRequest]
+}
+
+// NewVirtualMachineExportRequestLister returns a new VirtualMachineExportRequestLister.
+func NewVirtualMachineExportRequestLister(indexer cache.Indexer) VirtualMachineExportRequestLister {
+	return &virtualMachineExportRequestLister{listers.New[*v1alpha3.VirtualMachineExportRequest](indexer, v1alpha3.Resource("virtualmachineexportrequest"))}
+}
+
+// VirtualMachineExportRequests returns an object that can list and get VirtualMachineExportRequests.
+func (s *virtualMachineExportRequestLister) VirtualMachineExportRequests(namespace string) VirtualMachineExportRequestNamespaceLister {
+	return virtualMachineExportRequestNamespaceLister{listers.NewNamespaced[*v1alpha3.VirtualMachineExportRequest](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachineExportRequestNamespaceLister helps list and get VirtualMachineExportRequests.
+// All objects returned here must be treated as read-only.
+type VirtualMachineExportRequestNamespaceLister interface {
+	// List lists all VirtualMachineExportRequests in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineExportRequest, err error)
+	// Get retrieves the VirtualMachineExportRequest from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha3.VirtualMachineExportRequest, error)
+	VirtualMachineExportRequestNamespaceListerExpansion
+}
+
+// virtualMachineExportRequestNamespaceLister implements the VirtualMachineExportRequestNamespaceLister
+// interface.
+type virtualMachineExportRequestNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineExportRequest]
+}
@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineImageLister helps list VirtualMachineImages.
+// All objects returned here must be treated as read-only.
+type VirtualMachineImageLister interface {
+	// List lists all VirtualMachineImages in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineImage, err error)
+	// VirtualMachineImages returns an object that can list and get VirtualMachineImages.
+	VirtualMachineImages(namespace string) VirtualMachineImageNamespaceLister
+	VirtualMachineImageListerExpansion
+}
+
+// virtualMachineImageLister implements the VirtualMachineImageLister interface.
+type virtualMachineImageLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineImage]
+}
+
+// NewVirtualMachineImageLister returns a new VirtualMachineImageLister.
+func NewVirtualMachineImageLister(indexer cache.Indexer) VirtualMachineImageLister {
+	return &virtualMachineImageLister{listers.New[*v1alpha3.VirtualMachineImage](indexer, v1alpha3.Resource("virtualmachineimage"))}
+}
+
+// VirtualMachineImages returns an object that can list and get VirtualMachineImages.
+func (s *virtualMachineImageLister) VirtualMachineImages(namespace string) VirtualMachineImageNamespaceLister {
+	return virtualMachineImageNamespaceLister{listers.NewNamespaced[*v1alpha3.VirtualMachineImage](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachineImageNamespaceLister helps list and get VirtualMachineImages.
+// All objects returned here must be treated as read-only.
+type VirtualMachineImageNamespaceLister interface {
+	// List lists all VirtualMachineImages in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineImage, err error)
+	// Get retrieves the VirtualMachineImage from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha3.VirtualMachineImage, error)
+	VirtualMachineImageNamespaceListerExpansion
+}
+
+// virtualMachineImageNamespaceLister implements the VirtualMachineImageNamespaceLister
+// interface.
+type virtualMachineImageNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineImage]
+}
@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineImageCacheLister helps list VirtualMachineImageCaches.
+// All objects returned here must be treated as read-only.
+type VirtualMachineImageCacheLister interface {
+	// List lists all VirtualMachineImageCaches in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineImageCache, err error)
+	// VirtualMachineImageCaches returns an object that can list and get VirtualMachineImageCaches.
+	VirtualMachineImageCaches(namespace string) VirtualMachineImageCacheNamespaceLister
+	VirtualMachineImageCacheListerExpansion
+}
+
+// virtualMachineImageCacheLister implements the VirtualMachineImageCacheLister interface.
+type virtualMachineImageCacheLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineImageCache]
+}
+
+// NewVirtualMachineImageCacheLister returns a new VirtualMachineImageCacheLister.
+func NewVirtualMachineImageCacheLister(indexer cache.Indexer) VirtualMachineImageCacheLister {
+	return &virtualMachineImageCacheLister{listers.New[*v1alpha3.VirtualMachineImageCache](indexer, v1alpha3.Resource("virtualmachineimagecache"))}
+}
+
+// VirtualMachineImageCaches returns an object that can list and get VirtualMachineImageCaches.
+func (s *virtualMachineImageCacheLister) VirtualMachineImageCaches(namespace string) VirtualMachineImageCacheNamespaceLister {
+	return virtualMachineImageCacheNamespaceLister{listers.NewNamespaced[*v1alpha3.VirtualMachineImageCache](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachineImageCacheNamespaceLister helps list and get VirtualMachineImageCaches.
+// All objects returned here must be treated as read-only.
+type VirtualMachineImageCacheNamespaceLister interface {
+	// List lists all VirtualMachineImageCaches in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineImageCache, err error)
+	// Get retrieves the VirtualMachineImageCache from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha3.VirtualMachineImageCache, error)
+	VirtualMachineImageCacheNamespaceListerExpansion
+}
+
+// virtualMachineImageCacheNamespaceLister implements the VirtualMachineImageCacheNamespaceLister
+// interface.
+type virtualMachineImageCacheNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineImageCache]
+}
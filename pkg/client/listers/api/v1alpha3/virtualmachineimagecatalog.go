@@ -0,0 +1,36 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineImageCatalogLister helps list VirtualMachineImageCatalogs.
+// All objects returned here must be treated as read-only.
+type VirtualMachineImageCatalogLister interface {
+	// List lists all VirtualMachineImageCatalogs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineImageCatalog, err error)
+	// Get retrieves the VirtualMachineImageCatalog from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha3.VirtualMachineImageCatalog, error)
+	VirtualMachineImageCatalogListerExpansion
+}
+
+// virtualMachineImageCatalogLister implements the VirtualMachineImageCatalogLister interface.
+type virtualMachineImageCatalogLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineImageCatalog]
+}
+
+// NewVirtualMachineImageCatalogLister returns a new VirtualMachineImageCatalogLister.
+func NewVirtualMachineImageCatalogLister(indexer cache.Indexer) VirtualMachineImageCatalogLister {
+	return &virtualMachineImageCatalogLister{listers.New[*v1alpha3.VirtualMachineImageCatalog](indexer, v1alpha3.Resource("virtualmachineimagecatalog"))}
+}
@@ -0,0 +1,36 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineInventoryReportLister helps list VirtualMachineInventoryReports.
+// All objects returned here must be treated as read-only.
+type VirtualMachineInventoryReportLister interface {
+	// List lists all VirtualMachineInventoryReports in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineInventoryReport, err error)
+	// Get retrieves the VirtualMachineInventoryReport from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha3.VirtualMachineInventoryReport, error)
+	VirtualMachineInventoryReportListerExpansion
+}
+
+// virtualMachineInventoryReportLister implements the VirtualMachineInventoryReportLister interface.
+type virtualMachineInventoryReportLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineInventoryReport]
+}
+
+// NewVirtualMachineInventoryReportLister returns a new VirtualMachineInventoryReportLister.
+func NewVirtualMachineInventoryReportLister(indexer cache.Indexer) VirtualMachineInventoryReportLister {
+	return &virtualMachineInventoryReportLister{listers.New[*v1alpha3.VirtualMachineInventoryReport](indexer, v1alpha3.Resource("virtualmachineinventoryreport"))}
+}
@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	v1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VirtualMachineReplicaSetLister helps list VirtualMachineReplicaSets.
+// All objects returned here must be treated as read-only.
+type VirtualMachineReplicaSetLister interface {
+	// List lists all VirtualMachineReplicaSets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineReplicaSet, err error)
+	// VirtualMachineReplicaSets returns an object that can list and get VirtualMachineReplicaSets.
+	VirtualMachineReplicaSets(namespace string) VirtualMachineReplicaSetNamespaceLister
+	VirtualMachineReplicaSetListerExpansion
+}
+
+// virtualMachineReplicaSetLister implements the VirtualMachineReplicaSetLister interface.
+type virtualMachineReplicaSetLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineReplicaSet]
+}
+
+// NewVirtualMachineReplicaSetLister returns a new VirtualMachineReplicaSetLister.
+func NewVirtualMachineReplicaSetLister(indexer cache.Indexer) VirtualMachineReplicaSetLister {
+	return &virtualMachineReplicaSetLister{listers.New[*v1alpha3.VirtualMachineReplicaSet](indexer, v1alpha3.Resource("virtualmachinereplicaset"))}
+}
+
+// VirtualMachineReplicaSets returns an object that can list and get VirtualMachineReplicaSets.
+func (s *virtualMachineReplicaSetLister) VirtualMachineReplicaSets(namespace string) VirtualMachineReplicaSetNamespaceLister {
+	return virtualMachineReplicaSetNamespaceLister{listers.NewNamespaced[*v1alpha3.VirtualMachineReplicaSet](s.ResourceIndexer, namespace)}
+}
+
+// VirtualMachineReplicaSetNamespaceLister helps list and get VirtualMachineReplicaSets.
+// All objects returned here must be treated as read-only.
+type VirtualMachineReplicaSetNamespaceLister interface {
+	// List lists all VirtualMachineReplicaSets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha3.VirtualMachineReplicaSet, err error)
+	// Get retrieves the VirtualMachineReplicaSet from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha3.VirtualMachineReplicaSet, error)
+	VirtualMachineReplicaSetNamespaceListerExpansion
+}
+
+// virtualMachineReplicaSetNamespaceLister implements the VirtualMachineReplicaSetNamespaceLister
+// interface.
+type virtualMachineReplicaSetNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha3.VirtualMachineReplicaSet]
+}
@@ -23,9 +23,13 @@ type Config struct {
 
 	ContainerNode bool
 
-	ContentAPIWait  time.Duration
 	JSONExtraConfig string
 
+	// ContentDownloadTimeout is the timeout for each attempt to download a
+	// file from a content library item, ex. an OVF descriptor.
+	// Defaults to 5 minutes.
+	ContentDownloadTimeout time.Duration
+
 	// DefaultVMClassControllerName is the default value for the
 	// VirtualMachineClass field spec.controllerName.
 	//
@@ -73,10 +77,29 @@ type Config struct {
 	// Defaults to 10 seconds.
 	SyncImageRequeueDelay time.Duration
 
-	NetworkProviderType  NetworkProviderType
-	VSphereNetworking    bool
+	// SyncLibraryItemTimeout is how long to wait for a subscribed content
+	// library item whose content is downloaded on-demand to be synced before
+	// deploying a VM from the item.
+	// Defaults to 30 minutes.
+	SyncLibraryItemTimeout time.Duration
+
+	NetworkProviderType NetworkProviderType
+	VSphereNetworking   bool
+
+	// LoadBalancerProvider is the name of the provider used to realize
+	// VirtualMachineServices of type LoadBalancer. The valid values are
+	// "nsx-t-lb" and "haproxy". When empty, the NSX-T provider is used if
+	// the network provider is NSX-T or VPC, otherwise a no-op provider is
+	// used.
 	LoadBalancerProvider string
 
+	// HAProxyDataPlaneAPIURL is the URL of the HAProxy Data Plane API, ex.
+	// https://10.0.0.2:5556, that is used to realize VirtualMachineServices of
+	// type LoadBalancer when LoadBalancerProvider is "haproxy". The credentials
+	// are read from the vmoperator-haproxy-dataplane-api Secret in the pod's
+	// namespace.
+	HAProxyDataPlaneAPIURL string
+
 	PodName               string
 	PodNamespace          string
 	PodServiceAccountName string
@@ -90,6 +113,15 @@ type Config struct {
 	// GoDocs for the Config type.
 	PrivilegedUsers string
 
+	// RestClientTimeout is the timeout for each request made with the vSphere
+	// REST client, ex. content library calls, so a hung vAPI endpoint does not
+	// block a reconcile indefinitely. It does not apply to SOAP requests,
+	// such as waiting on tasks, nor to requests that have their own deadline,
+	// such as downloading content library files, nor to uploading files to
+	// content library items.
+	// Defaults to 2 minutes.
+	RestClientTimeout time.Duration
+
 	ProfilerAddr                 string
 	RateLimitBurst               int
 	RateLimitQPS                 int
@@ -143,6 +175,186 @@ type Config struct {
 	//
 	// Defaults to "direct".
 	FastDeployMode string
+
+	// VMNameConflictPolicy determines how a conflict is resolved between the
+	// name of a VM being created and the name of an existing vSphere VM in the
+	// same folder, ex. when the folder is shared by multiple Supervisors.
+	//
+	// The valid values are "fail" and "suffix." If the value is:
+	//
+	//   - "fail," then the VM is not created until the conflict is resolved.
+	//   - "suffix," then the vSphere VM is created with the VM's name suffixed
+	//     with a hash of the VM's UID.
+	//   - anything else, then "fail" is used.
+	//
+	// Defaults to "fail".
+	VMNameConflictPolicy string
+
+	// MinSupportedHardwareVersion is the minimum virtual hardware version
+	// allowed for newly created VMs. A new VM without a minimum hardware
+	// version is created with this version, a new VM with an older minimum
+	// hardware version is rejected, and an existing VM with an older hardware
+	// version has the UpgradeRecommended condition.
+	//
+	// Defaults to 0, which means there is no minimum.
+	MinSupportedHardwareVersion int
+
+	// MaxContentLibraryDownloads is the maximum number of OVF envelopes that may
+	// be downloaded from content library concurrently, so a full sync of a large
+	// library does not saturate the vCenter vAPI endpoint. A value that is not
+	// positive means there is no limit.
+	//
+	// Defaults to 10.
+	MaxContentLibraryDownloads int
+
+	// SessionWarmupConcurrency is the number of namespaces whose vSphere
+	// inventory is resolved in parallel when the manager starts, so the first
+	// VM created in a namespace does not pay the cost of the session and
+	// inventory resolution. A value that is not positive disables the warm up.
+	SessionWarmupConcurrency int
+
+	// GuestCustomizationPolicy is the guest customization policy used for VMs
+	// that do not specify one with spec.bootstrap.guestCustomizationPolicy. It
+	// may be Enabled, Disabled, or Auto. If empty or any other value, then Auto
+	// is used.
+	GuestCustomizationPolicy string
+
+	// ExternalDNSDomain is the DNS domain under which ExternalDNS records are
+	// emitted for LoadBalancer VirtualMachineServices and opted-in VMs. When
+	// empty, no ExternalDNS annotations or DNSEndpoints are created.
+	ExternalDNSDomain string
+
+	// DeviceDriftPolicy is the policy for devices on a VM that are not
+	// represented in its spec, ex. USB controllers, serial ports, or raw disks
+	// that were added out-of-band. It may be Report, which surfaces the devices
+	// in the VM's DevicesManaged condition, or Strict, which also removes them.
+	// If empty, then the devices are not audited.
+	DeviceDriftPolicy string
+
+	// ReconfigureBatchWindow is how long the reconfigure of an existing VM is
+	// deferred so that spec changes made in quick succession are coalesced into
+	// a single reconfigure task. The reconfigure is deferred for at most this
+	// long after it was first needed, and is never deferred while a VM is being
+	// created. If zero, then VMs are reconfigured immediately.
+	ReconfigureBatchWindow time.Duration
+
+	// VMFolderPathTemplate is the path of the Folder, relative to the VM's
+	// namespace Folder, in which new VMs are created, ex.
+	// "{cluster}/{resource-policy}". The placeholders {cluster}, {zone},
+	// {namespace}, and {resource-policy} are replaced with the VM's values, and
+	// a path element that is empty after replacing them is omitted. Folders
+	// that do not exist are created. If empty, then VMs are created in their
+	// namespace's Folder, or their ResourcePolicy's Folder.
+	VMFolderPathTemplate string
+
+	// OVFCacheDir is the directory in which the OVF envelopes downloaded from
+	// content library items are cached, so they are not downloaded again after
+	// the operator restarts. The directory may be on a PersistentVolume mounted
+	// into the operator's pod. If empty, then OVF envelopes are only cached in
+	// memory.
+	OVFCacheDir string
+
+	// OVFCacheMaxItems is the maximum number of OVF envelopes cached in the
+	// OVFCacheDir. The least recently used envelopes are removed when the cache
+	// exceeds this number.
+	//
+	// Defaults to 1000.
+	OVFCacheMaxItems int
+
+	// ReconcileBudget is how long a VM reconcile may spend calling the provider
+	// before the progress made so far is persisted and the VM is requeued. The
+	// budget is checked between provider calls, so a call is never interrupted.
+	// A value of zero disables the budget.
+	ReconcileBudget time.Duration
+
+	// MaxVirtualMachinesPerNamespace is the maximum number of VirtualMachines
+	// that may be created in a namespace. A value of zero means there is no
+	// limit.
+	MaxVirtualMachinesPerNamespace int
+
+	// MaxVirtualMachinesPerZone is the maximum number of VirtualMachines that
+	// may be created in a zone, across all namespaces. A value of zero means
+	// there is no limit.
+	MaxVirtualMachinesPerZone int
+
+	// ChargebackLabelKeys is a comma-delimited list of VM label keys that are
+	// chargeback dimensions. The values of these labels are reported with the
+	// VM's allocated resource metrics and mirrored as vSphere tags on the VM.
+	ChargebackLabelKeys string
+
+	// ShardCount is the number of shards the namespaces are divided into when
+	// multiple, active controller managers are deployed. Each manager
+	// reconciles the resources in the namespaces of its shard, and the manager
+	// for the first shard reconciles the cluster-scoped resources. A value of
+	// zero or one disables sharding.
+	ShardCount int
+
+	// ShardIndex is the zero-based index of the shard owned by this controller
+	// manager when ShardCount is greater than one.
+	ShardIndex int
+
+	// DiagnosticsAddr is the address the authenticated diagnostics endpoint binds
+	// to. The endpoint exposes pprof and a sanitized dump of the VM provider
+	// state, and is served with TLS using the webhook server's certificate. An
+	// empty value disables the endpoint.
+	DiagnosticsAddr string
+
+	// ObserverMode may be set to true to run the controllers in a read-only mode
+	// where they reconcile the status of resources but do not perform any
+	// mutating vSphere operations, ex. creating, reconfiguring, powering on/off,
+	// or deleting VMs. This is useful to evaluate VM Operator against an
+	// existing environment before granting it write permissions.
+	//
+	// Defaults to false.
+	ObserverMode bool
+
+	// StalledTaskThreshold is how long a vSphere task for a VM may be queued
+	// or running before it is considered stalled. The VM is marked with the
+	// TaskStalled condition and requeued instead of being updated while it
+	// has a stalled task. A value of zero disables the detection of stalled
+	// tasks.
+	StalledTaskThreshold time.Duration
+
+	// MaxPowerStateAttempts is the number of consecutive attempts made to
+	// change a VM's power state to its desired power state before the change
+	// is considered to have failed. Once the attempts are exhausted, the VM is
+	// marked with the VirtualMachinePowerStateSynced condition and the change
+	// is not retried until the VM's desired power state changes. A value of
+	// zero means the change is always retried.
+	//
+	// Defaults to 0.
+	MaxPowerStateAttempts int
+
+	// PowerStateAttemptDelay is the minimum amount of time between the
+	// attempts to change a VM's power state. It has no impact if
+	// MaxPowerStateAttempts is zero.
+	//
+	// Defaults to 1 minute.
+	PowerStateAttemptDelay time.Duration
+
+	// DeployHookJobImages is a comma-delimited list of the container images
+	// a VirtualMachineDeployHook's Job may run. Since the Jobs are created by
+	// VM Operator rather than by the user that created the hook, a Job hook
+	// whose image is not in this list fails.
+	DeployHookJobImages string
+
+	// DeployHookJobServiceAccounts is a comma-delimited list of the names of
+	// the service accounts a VirtualMachineDeployHook's Job may run as. A Job
+	// hook that specifies a service account not in this list fails. A Job
+	// hook that does not specify a service account runs as the namespace's
+	// default service account.
+	DeployHookJobServiceAccounts string
+
+	// DeployHookWebhookHosts is a comma-delimited list of the hosts, ex.
+	// "hooks.example.com" or "hooks.example.com:8443", a
+	// VirtualMachineDeployHook's webhook may call. Since the webhooks are
+	// called from VM Operator, a webhook hook whose host is not in this list
+	// fails.
+	DeployHookWebhookHosts string
+
+	// InventoryReportInterval is how often the VirtualMachineInventoryReport
+	// is regenerated. A value of zero disables the report.
+	InventoryReportInterval time.Duration
 }
 
 // GetMaxDeployThreadsOnProvider returns MaxDeployThreadsOnProvider if it is >0
@@ -174,6 +386,16 @@ type FeatureStates struct {
 	BringYourOwnEncryptionKey bool // FSS_WCP_VMSERVICE_BYOK
 	SVAsyncUpgrade            bool // FSS_WCP_SUPERVISOR_ASYNC_UPGRADE
 	FastDeploy                bool // FSS_WCP_VMSERVICE_FAST_DEPLOY
+	// VCTaskTagging includes the namespace, name, and UID of the originating
+	// object in the operation ID of vSphere requests, and tags the tasks
+	// submitted by the requests with the operation ID.
+	VCTaskTagging bool // VC_TASK_TAGGING_ENABLED
+	// CancelStalledTasks cancels a VM's stalled tasks when they are
+	// cancelable. It has no impact if StalledTaskThreshold is zero.
+	CancelStalledTasks bool // CANCEL_STALLED_TASKS
+	// DeployHooks runs the VirtualMachineDeployHook resources that apply to a
+	// VM before it is deployed and after it is first powered on.
+	DeployHooks bool // DEPLOY_HOOKS_ENABLED
 }
 
 type InstanceStorage struct {
@@ -210,3 +432,14 @@ const (
 	NetworkProviderTypeVDS   NetworkProviderType = "VSPHERE_NETWORK"
 	NetworkProviderTypeVPC   NetworkProviderType = "NSXT_VPC"
 )
+
+const (
+	GuestCustomizationPolicyEnabled  = "Enabled"
+	GuestCustomizationPolicyDisabled = "Disabled"
+	GuestCustomizationPolicyAuto     = "Auto"
+)
+
+const (
+	DeviceDriftPolicyReport = "Report"
+	DeviceDriftPolicyStrict = "Strict"
+)
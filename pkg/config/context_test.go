@@ -78,10 +78,10 @@ var _ = Describe("Context", func() {
 				out := pkgcfg.JoinContext(
 					context.Background(),
 					pkgcfg.WithConfig(pkgcfg.Config{
-						ContentAPIWait: 100 * time.Hour,
+						ContentDownloadTimeout: 100 * time.Hour,
 					}))
 				Expect(out).ToNot(BeNil())
-				Expect(pkgcfg.FromContext(out).ContentAPIWait).To(Equal(100 * time.Hour))
+				Expect(pkgcfg.FromContext(out).ContentDownloadTimeout).To(Equal(100 * time.Hour))
 			})
 		})
 	})
@@ -121,15 +121,15 @@ var _ = Describe("Context", func() {
 		When("All arguments are valid", func() {
 			It("Should update the config in the context", func() {
 				ctx := pkgcfg.WithConfig(pkgcfg.Config{
-					ContainerNode:  true,
-					ContentAPIWait: 0,
+					ContainerNode:          true,
+					ContentDownloadTimeout: 0,
 				})
 				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
 					config.ContainerNode = false
-					config.ContentAPIWait = 100 * time.Hour
+					config.ContentDownloadTimeout = 100 * time.Hour
 				})
 				Expect(pkgcfg.FromContext(ctx).ContainerNode).To(BeFalse())
-				Expect(pkgcfg.FromContext(ctx).ContentAPIWait).To(Equal(100 * time.Hour))
+				Expect(pkgcfg.FromContext(ctx).ContentDownloadTimeout).To(Equal(100 * time.Hour))
 			})
 		})
 	})
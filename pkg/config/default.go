@@ -22,7 +22,7 @@ func Default() Config {
 		BuildType:    pkg.BuildType,
 
 		ContainerNode:                false,
-		ContentAPIWait:               1 * time.Second,
+		ContentDownloadTimeout:       5 * time.Minute,
 		DefaultVMClassControllerName: "vmoperator.vmware.com/vsphere",
 		Features: FeatureStates{
 			InstanceStorage:            true,
@@ -38,14 +38,17 @@ func Default() Config {
 		},
 		LeaderElectionID:             defaultPrefix + "controller-manager-runtime",
 		MaxCreateVMsOnProvider:       80,
+		PowerStateAttemptDelay:       1 * time.Minute,
 		MaxConcurrentReconciles:      1,
 		AsyncSignalEnabled:           true,
 		AsyncCreateEnabled:           true,
 		MemStatsPeriod:               10 * time.Minute,
 		FastDeployMode:               pkgconst.FastDeployModeDirect,
+		VMNameConflictPolicy:         pkgconst.VMNameConflictPolicyFail,
 		CreateVMRequeueDelay:         10 * time.Second,
 		PoweredOnVMHasIPRequeueDelay: 10 * time.Second,
 		SyncImageRequeueDelay:        10 * time.Second,
+		SyncLibraryItemTimeout:       30 * time.Minute,
 		NetworkProviderType:          NetworkProviderTypeNamed,
 		PodName:                      defaultPrefix + "controller-manager",
 		PodNamespace:                 defaultPrefix + "system",
@@ -53,6 +56,7 @@ func Default() Config {
 		ProfilerAddr:                 ":8073",
 		RateLimitBurst:               1000,
 		RateLimitQPS:                 500,
+		RestClientTimeout:            2 * time.Minute,
 		SyncPeriod:                   10 * time.Minute,
 		WatchNamespace:               "",
 		WebhookServiceContainerPort:  9878,
@@ -61,5 +65,7 @@ func Default() Config {
 		WebhookSecretName:            defaultPrefix + "webhook-server-cert",
 		WebhookSecretNamespace:       defaultPrefix + "system",
 		WebhookSecretVolumeMountPath: "/tmp/k8s-webhook-server/serving-certs",
+		OVFCacheMaxItems:             1000,
+		MaxContentLibraryDownloads:   10,
 	}
 }
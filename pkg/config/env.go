@@ -18,21 +18,50 @@ func FromEnv() Config {
 	config := Default()
 
 	setString(env.JSONExtraConfig, &config.JSONExtraConfig)
-	setDuration(env.ContentAPIWaitDuration, &config.ContentAPIWait)
+	setDuration(env.ContentDownloadTimeout, &config.ContentDownloadTimeout)
 	setString(env.DefaultVMClassControllerName, &config.DefaultVMClassControllerName)
 	setInt(env.MaxCreateVMsOnProvider, &config.MaxCreateVMsOnProvider)
 	setDuration(env.CreateVMRequeueDelay, &config.CreateVMRequeueDelay)
 	setDuration(env.PoweredOnVMHasIPRequeueDelay, &config.PoweredOnVMHasIPRequeueDelay)
 	setDuration(env.SyncImageRequeueDelay, &config.SyncImageRequeueDelay)
+	setDuration(env.SyncLibraryItemTimeout, &config.SyncLibraryItemTimeout)
 	setNetworkProviderType(env.NetworkProviderType, &config.NetworkProviderType)
 	setString(env.LoadBalancerProvider, &config.LoadBalancerProvider)
+	setString(env.HAProxyDataPlaneAPIURL, &config.HAProxyDataPlaneAPIURL)
 	setBool(env.VSphereNetworking, &config.VSphereNetworking)
 	setStringSlice(env.PrivilegedUsers, &config.PrivilegedUsers)
+	setDuration(env.RestClientTimeout, &config.RestClientTimeout)
 	setBool(env.LogSensitiveData, &config.LogSensitiveData)
 	setBool(env.AsyncSignalEnabled, &config.AsyncSignalEnabled)
 	setBool(env.AsyncCreateEnabled, &config.AsyncCreateEnabled)
 	setDuration(env.MemStatsPeriod, &config.MemStatsPeriod)
 	setString(env.FastDeployMode, &config.FastDeployMode)
+	setString(env.VMNameConflictPolicy, &config.VMNameConflictPolicy)
+	setInt(env.MinSupportedHardwareVersion, &config.MinSupportedHardwareVersion)
+	setInt(env.MaxContentLibraryDownloads, &config.MaxContentLibraryDownloads)
+	setInt(env.SessionWarmupConcurrency, &config.SessionWarmupConcurrency)
+	setGuestCustomizationPolicy(env.GuestCustomizationPolicy, &config.GuestCustomizationPolicy)
+	setString(env.ExternalDNSDomain, &config.ExternalDNSDomain)
+	setString(env.DeviceDriftPolicy, &config.DeviceDriftPolicy)
+	setDuration(env.ReconfigureBatchWindow, &config.ReconfigureBatchWindow)
+	setString(env.VMFolderPathTemplate, &config.VMFolderPathTemplate)
+	setString(env.OVFCacheDir, &config.OVFCacheDir)
+	setInt(env.OVFCacheMaxItems, &config.OVFCacheMaxItems)
+	setDuration(env.ReconcileBudget, &config.ReconcileBudget)
+	setInt(env.MaxVirtualMachinesPerNamespace, &config.MaxVirtualMachinesPerNamespace)
+	setInt(env.MaxVirtualMachinesPerZone, &config.MaxVirtualMachinesPerZone)
+	setStringSlice(env.ChargebackLabelKeys, &config.ChargebackLabelKeys)
+	setInt(env.ShardCount, &config.ShardCount)
+	setInt(env.ShardIndex, &config.ShardIndex)
+	setString(env.DiagnosticsAddr, &config.DiagnosticsAddr)
+	setBool(env.ObserverMode, &config.ObserverMode)
+	setDuration(env.StalledTaskThreshold, &config.StalledTaskThreshold)
+	setInt(env.MaxPowerStateAttempts, &config.MaxPowerStateAttempts)
+	setDuration(env.PowerStateAttemptDelay, &config.PowerStateAttemptDelay)
+	setStringSlice(env.DeployHookJobImages, &config.DeployHookJobImages)
+	setStringSlice(env.DeployHookJobServiceAccounts, &config.DeployHookJobServiceAccounts)
+	setStringSlice(env.DeployHookWebhookHosts, &config.DeployHookWebhookHosts)
+	setDuration(env.InventoryReportInterval, &config.InventoryReportInterval)
 
 	setDuration(env.InstanceStoragePVPlacementFailedTTL, &config.InstanceStorage.PVPlacementFailedTTL)
 	setFloat64(env.InstanceStorageJitterMaxFactor, &config.InstanceStorage.JitterMaxFactor)
@@ -67,6 +96,9 @@ func FromEnv() Config {
 	setBool(env.FSSVMIncrementalRestore, &config.Features.VMIncrementalRestore)
 	setBool(env.FSSBringYourOwnEncryptionKey, &config.Features.BringYourOwnEncryptionKey)
 	setBool(env.FSSFastDeploy, &config.Features.FastDeploy)
+	setBool(env.VCTaskTaggingEnabled, &config.Features.VCTaskTagging)
+	setBool(env.CancelStalledTasks, &config.Features.CancelStalledTasks)
+	setBool(env.DeployHooksEnabled, &config.Features.DeployHooks)
 	setBool(env.FSSSVAsyncUpgrade, &config.Features.SVAsyncUpgrade)
 	if !config.Features.SVAsyncUpgrade {
 		// When SVAsyncUpgrade is enabled, we'll later use the capability CM to determine if
@@ -116,6 +148,15 @@ func setNetworkProviderType(n env.VarName, p *NetworkProviderType) {
 	}
 }
 
+func setGuestCustomizationPolicy(n env.VarName, p *string) {
+	switch v := os.Getenv(n.String()); v {
+	case GuestCustomizationPolicyEnabled,
+		GuestCustomizationPolicyDisabled,
+		GuestCustomizationPolicyAuto:
+		*p = v
+	}
+}
+
 func setString(n env.VarName, p *string) {
 	if v := os.Getenv(n.String()); v != "" {
 		*p = v
@@ -19,16 +19,45 @@ const (
 	CreateVMRequeueDelay
 	PoweredOnVMHasIPRequeueDelay
 	SyncImageRequeueDelay
+	SyncLibraryItemTimeout
 	PrivilegedUsers
+	RestClientTimeout
 	NetworkProviderType
 	LoadBalancerProvider
+	HAProxyDataPlaneAPIURL
 	VSphereNetworking
-	ContentAPIWaitDuration
+	ContentDownloadTimeout
 	JSONExtraConfig
 	LogSensitiveData
 	AsyncSignalEnabled
 	AsyncCreateEnabled
 	FastDeployMode
+	VMNameConflictPolicy
+	MinSupportedHardwareVersion
+	MaxContentLibraryDownloads
+	SessionWarmupConcurrency
+	GuestCustomizationPolicy
+	ExternalDNSDomain
+	DeviceDriftPolicy
+	ReconfigureBatchWindow
+	VMFolderPathTemplate
+	OVFCacheDir
+	OVFCacheMaxItems
+	ReconcileBudget
+	MaxVirtualMachinesPerNamespace
+	MaxVirtualMachinesPerZone
+	ChargebackLabelKeys
+	ShardCount
+	ShardIndex
+	DiagnosticsAddr
+	ObserverMode
+	StalledTaskThreshold
+	MaxPowerStateAttempts
+	PowerStateAttemptDelay
+	DeployHookJobImages
+	DeployHookJobServiceAccounts
+	DeployHookWebhookHosts
+	InventoryReportInterval
 	InstanceStoragePVPlacementFailedTTL
 	InstanceStorageJitterMaxFactor
 	InstanceStorageSeedRequeueDuration
@@ -62,6 +91,9 @@ const (
 	FSSBringYourOwnEncryptionKey
 	FSSSVAsyncUpgrade
 	FSSFastDeploy
+	VCTaskTaggingEnabled
+	CancelStalledTasks
+	DeployHooksEnabled
 	_varNameEnd
 )
 
@@ -99,16 +131,22 @@ func (n VarName) String() string {
 		return "POWERED_ON_VM_HAS_IP_REQUEUE_DELAY"
 	case SyncImageRequeueDelay:
 		return "SYNC_IMAGE_REQUEUE_DELAY"
+	case SyncLibraryItemTimeout:
+		return "SYNC_LIBRARY_ITEM_TIMEOUT"
 	case PrivilegedUsers:
 		return "PRIVILEGED_USERS"
+	case RestClientTimeout:
+		return "REST_CLIENT_TIMEOUT"
 	case NetworkProviderType:
 		return "NETWORK_PROVIDER"
 	case LoadBalancerProvider:
 		return "LB_PROVIDER"
+	case HAProxyDataPlaneAPIURL:
+		return "HAPROXY_DATAPLANE_API_URL"
 	case VSphereNetworking:
 		return "VSPHERE_NETWORKING"
-	case ContentAPIWaitDuration:
-		return "CONTENT_API_WAIT_SECS"
+	case ContentDownloadTimeout:
+		return "CONTENT_DOWNLOAD_TIMEOUT"
 	case JSONExtraConfig:
 		return "JSON_EXTRA_CONFIG"
 	case LogSensitiveData:
@@ -119,6 +157,58 @@ func (n VarName) String() string {
 		return "ASYNC_CREATE_ENABLED"
 	case FastDeployMode:
 		return "FAST_DEPLOY_MODE"
+	case VMNameConflictPolicy:
+		return "VM_NAME_CONFLICT_POLICY"
+	case MinSupportedHardwareVersion:
+		return "MIN_SUPPORTED_HARDWARE_VERSION"
+	case MaxContentLibraryDownloads:
+		return "MAX_CONTENT_LIBRARY_DOWNLOADS"
+	case SessionWarmupConcurrency:
+		return "SESSION_WARMUP_CONCURRENCY"
+	case GuestCustomizationPolicy:
+		return "GUEST_CUSTOMIZATION_POLICY"
+	case ExternalDNSDomain:
+		return "EXTERNAL_DNS_DOMAIN"
+	case DeviceDriftPolicy:
+		return "DEVICE_DRIFT_POLICY"
+	case ReconfigureBatchWindow:
+		return "RECONFIGURE_BATCH_WINDOW"
+	case VMFolderPathTemplate:
+		return "VM_FOLDER_PATH_TEMPLATE"
+	case OVFCacheDir:
+		return "OVF_CACHE_DIR"
+	case OVFCacheMaxItems:
+		return "OVF_CACHE_MAX_ITEMS"
+	case ReconcileBudget:
+		return "RECONCILE_BUDGET"
+	case MaxVirtualMachinesPerNamespace:
+		return "MAX_VMS_PER_NAMESPACE"
+	case MaxVirtualMachinesPerZone:
+		return "MAX_VMS_PER_ZONE"
+	case ChargebackLabelKeys:
+		return "CHARGEBACK_LABEL_KEYS"
+	case ShardCount:
+		return "SHARD_COUNT"
+	case ShardIndex:
+		return "SHARD_INDEX"
+	case DiagnosticsAddr:
+		return "DIAGNOSTICS_ADDR"
+	case ObserverMode:
+		return "OBSERVER_MODE"
+	case StalledTaskThreshold:
+		return "STALLED_TASK_THRESHOLD"
+	case MaxPowerStateAttempts:
+		return "MAX_POWER_STATE_ATTEMPTS"
+	case PowerStateAttemptDelay:
+		return "POWER_STATE_ATTEMPT_DELAY"
+	case DeployHookJobImages:
+		return "DEPLOY_HOOK_JOB_IMAGES"
+	case DeployHookJobServiceAccounts:
+		return "DEPLOY_HOOK_JOB_SERVICE_ACCOUNTS"
+	case DeployHookWebhookHosts:
+		return "DEPLOY_HOOK_WEBHOOK_HOSTS"
+	case InventoryReportInterval:
+		return "INVENTORY_REPORT_INTERVAL"
 	case InstanceStoragePVPlacementFailedTTL:
 		return "INSTANCE_STORAGE_PV_PLACEMENT_FAILED_TTL"
 	case InstanceStorageJitterMaxFactor:
@@ -185,6 +275,12 @@ func (n VarName) String() string {
 		return "FSS_WCP_SUPERVISOR_ASYNC_UPGRADE"
 	case FSSFastDeploy:
 		return "FSS_WCP_VMSERVICE_FAST_DEPLOY"
+	case VCTaskTaggingEnabled:
+		return "VC_TASK_TAGGING_ENABLED"
+	case CancelStalledTasks:
+		return "CANCEL_STALLED_TASKS"
+	case DeployHooksEnabled:
+		return "DEPLOY_HOOKS_ENABLED"
 	}
 	panic("unknown environment variable")
 }
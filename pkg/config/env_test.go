@@ -67,7 +67,6 @@ var _ = Describe(
 					Expect(os.Setenv("NETWORK_PROVIDER", "103")).To(Succeed())
 					Expect(os.Setenv("LB_PROVIDER", "104")).To(Succeed())
 					Expect(os.Setenv("VSPHERE_NETWORKING", "true")).To(Succeed())
-					Expect(os.Setenv("CONTENT_API_WAIT_SECS", "105s")).To(Succeed())
 					Expect(os.Setenv("JSON_EXTRA_CONFIG", "106")).To(Succeed())
 					Expect(os.Setenv("INSTANCE_STORAGE_PV_PLACEMENT_FAILED_TTL", "107h")).To(Succeed())
 					Expect(os.Setenv("INSTANCE_STORAGE_JITTER_MAX_FACTOR", "108.0")).To(Succeed())
@@ -81,6 +80,7 @@ var _ = Describe(
 					Expect(os.Setenv("ASYNC_SIGNAL_ENABLED", "false")).To(Succeed())
 					Expect(os.Setenv("ASYNC_CREATE_ENABLED", "false")).To(Succeed())
 					Expect(os.Setenv("FAST_DEPLOY_MODE", pkgconst.FastDeployModeLinked)).To(Succeed())
+					Expect(os.Setenv("VM_NAME_CONFLICT_POLICY", pkgconst.VMNameConflictPolicySuffix)).To(Succeed())
 					Expect(os.Setenv("LEADER_ELECTION_ID", "115")).To(Succeed())
 					Expect(os.Setenv("POD_NAME", "116")).To(Succeed())
 					Expect(os.Setenv("POD_NAMESPACE", "117")).To(Succeed())
@@ -108,6 +108,38 @@ var _ = Describe(
 					Expect(os.Setenv("POWERED_ON_VM_HAS_IP_REQUEUE_DELAY", "126h")).To(Succeed())
 					Expect(os.Setenv("MEM_STATS_PERIOD", "127h")).To(Succeed())
 					Expect(os.Setenv("SYNC_IMAGE_REQUEUE_DELAY", "128h")).To(Succeed())
+					Expect(os.Setenv("SYNC_LIBRARY_ITEM_TIMEOUT", "129h")).To(Succeed())
+					Expect(os.Setenv("CONTENT_DOWNLOAD_TIMEOUT", "130h")).To(Succeed())
+					Expect(os.Setenv("REST_CLIENT_TIMEOUT", "131h")).To(Succeed())
+					Expect(os.Setenv("MIN_SUPPORTED_HARDWARE_VERSION", "132")).To(Succeed())
+					Expect(os.Setenv("VC_TASK_TAGGING_ENABLED", "true")).To(Succeed())
+					Expect(os.Setenv("MAX_CONTENT_LIBRARY_DOWNLOADS", "133")).To(Succeed())
+					Expect(os.Setenv("SESSION_WARMUP_CONCURRENCY", "134")).To(Succeed())
+					Expect(os.Setenv("GUEST_CUSTOMIZATION_POLICY", "Disabled")).To(Succeed())
+					Expect(os.Setenv("EXTERNAL_DNS_DOMAIN", "vm.example.com")).To(Succeed())
+					Expect(os.Setenv("DEVICE_DRIFT_POLICY", "136")).To(Succeed())
+					Expect(os.Setenv("RECONFIGURE_BATCH_WINDOW", "137h")).To(Succeed())
+					Expect(os.Setenv("VM_FOLDER_PATH_TEMPLATE", "138")).To(Succeed())
+					Expect(os.Setenv("OVF_CACHE_DIR", "139")).To(Succeed())
+					Expect(os.Setenv("OVF_CACHE_MAX_ITEMS", "140")).To(Succeed())
+					Expect(os.Setenv("RECONCILE_BUDGET", "141h")).To(Succeed())
+					Expect(os.Setenv("MAX_VMS_PER_NAMESPACE", "142")).To(Succeed())
+					Expect(os.Setenv("MAX_VMS_PER_ZONE", "143")).To(Succeed())
+					Expect(os.Setenv("CHARGEBACK_LABEL_KEYS", "144")).To(Succeed())
+					Expect(os.Setenv("SHARD_COUNT", "145")).To(Succeed())
+					Expect(os.Setenv("SHARD_INDEX", "146")).To(Succeed())
+					Expect(os.Setenv("DIAGNOSTICS_ADDR", "147")).To(Succeed())
+					Expect(os.Setenv("OBSERVER_MODE", "true")).To(Succeed())
+					Expect(os.Setenv("STALLED_TASK_THRESHOLD", "148h")).To(Succeed())
+					Expect(os.Setenv("CANCEL_STALLED_TASKS", "true")).To(Succeed())
+					Expect(os.Setenv("MAX_POWER_STATE_ATTEMPTS", "149")).To(Succeed())
+					Expect(os.Setenv("POWER_STATE_ATTEMPT_DELAY", "155h")).To(Succeed())
+					Expect(os.Setenv("DEPLOY_HOOKS_ENABLED", "true")).To(Succeed())
+					Expect(os.Setenv("DEPLOY_HOOK_JOB_IMAGES", "151")).To(Succeed())
+					Expect(os.Setenv("DEPLOY_HOOK_JOB_SERVICE_ACCOUNTS", "152")).To(Succeed())
+					Expect(os.Setenv("DEPLOY_HOOK_WEBHOOK_HOSTS", "153")).To(Succeed())
+					Expect(os.Setenv("INVENTORY_REPORT_INTERVAL", "150h")).To(Succeed())
+					Expect(os.Setenv("HAPROXY_DATAPLANE_API_URL", "154")).To(Succeed())
 				})
 				It("Should return a default config overridden by the environment", func() {
 					Expect(config).To(BeComparableTo(pkgcfg.Config{
@@ -116,8 +148,8 @@ var _ = Describe(
 						PrivilegedUsers:              "102",
 						NetworkProviderType:          "103",
 						LoadBalancerProvider:         "104",
+						HAProxyDataPlaneAPIURL:       "154",
 						VSphereNetworking:            true,
-						ContentAPIWait:               105 * time.Second,
 						JSONExtraConfig:              "106",
 						InstanceStorage: pkgcfg.InstanceStorage{
 							PVPlacementFailedTTL: 107 * time.Hour,
@@ -133,6 +165,7 @@ var _ = Describe(
 						AsyncSignalEnabled:           false,
 						AsyncCreateEnabled:           false,
 						FastDeployMode:               pkgconst.FastDeployModeLinked,
+						VMNameConflictPolicy:         pkgconst.VMNameConflictPolicySuffix,
 						LeaderElectionID:             "115",
 						PodName:                      "116",
 						PodNamespace:                 "117",
@@ -157,15 +190,55 @@ var _ = Describe(
 							SVAsyncUpgrade:            false, // Capability gate so tested below
 							WorkloadDomainIsolation:   true,
 							FastDeploy:                true,
+							VCTaskTagging:             true,
+							CancelStalledTasks:        true,
+							DeployHooks:               true,
 						},
-						CreateVMRequeueDelay:         125 * time.Hour,
-						PoweredOnVMHasIPRequeueDelay: 126 * time.Hour,
-						MemStatsPeriod:               127 * time.Hour,
-						SyncImageRequeueDelay:        128 * time.Hour,
+						CreateVMRequeueDelay:           125 * time.Hour,
+						PoweredOnVMHasIPRequeueDelay:   126 * time.Hour,
+						MemStatsPeriod:                 127 * time.Hour,
+						SyncImageRequeueDelay:          128 * time.Hour,
+						SyncLibraryItemTimeout:         129 * time.Hour,
+						ContentDownloadTimeout:         130 * time.Hour,
+						RestClientTimeout:              131 * time.Hour,
+						MinSupportedHardwareVersion:    132,
+						MaxContentLibraryDownloads:     133,
+						SessionWarmupConcurrency:       134,
+						GuestCustomizationPolicy:       "Disabled",
+						ExternalDNSDomain:              "vm.example.com",
+						DeviceDriftPolicy:              "136",
+						ReconfigureBatchWindow:         137 * time.Hour,
+						VMFolderPathTemplate:           "138",
+						OVFCacheDir:                    "139",
+						OVFCacheMaxItems:               140,
+						ReconcileBudget:                141 * time.Hour,
+						MaxVirtualMachinesPerNamespace: 142,
+						MaxVirtualMachinesPerZone:      143,
+						ChargebackLabelKeys:            "144",
+						ShardCount:                     145,
+						ShardIndex:                     146,
+						DiagnosticsAddr:                "147",
+						ObserverMode:                   true,
+						StalledTaskThreshold:           148 * time.Hour,
+						MaxPowerStateAttempts:          149,
+						PowerStateAttemptDelay:         155 * time.Hour,
+						DeployHookJobImages:            "151",
+						DeployHookJobServiceAccounts:   "152",
+						DeployHookWebhookHosts:         "153",
+						InventoryReportInterval:        150 * time.Hour,
 					}))
 				})
 			})
 
+			When("The guest customization policy is invalid", func() {
+				BeforeEach(func() {
+					Expect(os.Setenv("GUEST_CUSTOMIZATION_POLICY", "disabled")).To(Succeed())
+				})
+				It("Should ignore the policy", func() {
+					Expect(config.GuestCustomizationPolicy).To(BeEmpty())
+				})
+			})
+
 			When("SV Async Upgrade Enabled", func() {
 				BeforeEach(func() {
 					Expect(os.Setenv("FSS_WCP_SUPERVISOR_ASYNC_UPGRADE", "true")).To(Succeed())
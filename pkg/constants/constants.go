@@ -27,6 +27,12 @@ const (
 	// at which an object was first created.
 	CreatedAtSchemaVersionAnnotationKey = createdAtPrefix + "schema-version"
 
+	// UpgradedAtBuildVersionAnnotationKey is set on VirtualMachine objects
+	// created by a different build version once the one-time upgrade
+	// normalization of the object has been run by the build version that is
+	// the value of this annotation.
+	UpgradedAtBuildVersionAnnotationKey = "vmoperator.vmware.com/upgraded-at-build-version"
+
 	// MinSupportedHWVersionForPVC is the supported virtual hardware version for
 	// persistent volumes.
 	MinSupportedHWVersionForPVC = vimtypes.VMX15
@@ -73,4 +79,123 @@ const (
 	// FastDeployModeLinked is a fast deploy mode. See FastDeployAnnotationKey
 	// for more information.
 	FastDeployModeLinked = "linked"
+
+	// VMNameConflictPolicyFail is a policy for resolving a conflict between
+	// the name of a VM being created and an existing VM in the same folder.
+	// The VM is not created until the conflict is resolved.
+	VMNameConflictPolicyFail = "fail"
+
+	// VMNameConflictPolicySuffix is a policy for resolving a conflict between
+	// the name of a VM being created and an existing VM in the same folder.
+	// The VM is created with a name suffixed with a hash of the VM's UID.
+	VMNameConflictPolicySuffix = "suffix"
+
+	// StrictValidationLabelKey is applied to a namespace to opt the VMs in the
+	// namespace into the strict validation profile. The profile is enabled
+	// when the value of the label is "true." When enabled, a VM must:
+	//
+	//   - specify a resource policy
+	//   - use an encryption storage class
+	//   - not use named networks
+	StrictValidationLabelKey = "vmoperator.vmware.com/strict-validation"
+
+	// DefaultResourcePolicyAnnotationKey is applied to a namespace to name the
+	// VirtualMachineSetResourcePolicy in the namespace that is assigned to VMs
+	// created in the namespace without spec.reserved.resourcePolicyName. This
+	// allows the folder, resource pool, and cluster module governance of the
+	// policy to apply to the namespace's VMs by default.
+	DefaultResourcePolicyAnnotationKey = "vmoperator.vmware.com/default-resource-policy"
+
+	// DefaultNamedNetworkAnnotationKey is applied to VirtualMachine resources
+	// whose network interfaces were defaulted to the named network from the
+	// provider ConfigMap. The value is the name of that network. Interfaces
+	// that use this network follow the default network when it is migrated.
+	DefaultNamedNetworkAnnotationKey = "vmoperator.vmware.com/default-named-network"
+
+	// NetworkMigrationWindowAnnotationKey is applied to VirtualMachine
+	// resources to opt them into having the network interfaces that use the
+	// default named network re-backed to the provider ConfigMap's current
+	// default network. The value is a maintenance window in the form
+	// "<start>/<end>," where both times are RFC3339, ex.
+	// 2025-01-01T00:00:00Z/2025-01-01T04:00:00Z. Interfaces are only
+	// re-backed while the current time is within the window.
+	NetworkMigrationWindowAnnotationKey = "vmoperator.vmware.com/network-migration-window"
+
+	// MigratedNamedNetworkAnnotationKey is applied to VirtualMachine resources
+	// by VM Operator after the network interfaces that use the default named
+	// network are re-backed. The value is the name of the network to which the
+	// interfaces are now connected.
+	MigratedNamedNetworkAnnotationKey = "vmoperator.vmware.com/migrated-named-network"
+
+	// ExternalDNSRecordAnnotationKey may be applied to VirtualMachine
+	// resources with the value "true" to have VM Operator emit an ExternalDNS
+	// DNSEndpoint with A/AAAA records for the VM's primary IP addresses. The
+	// records are only emitted when the ExternalDNS domain is configured.
+	ExternalDNSRecordAnnotationKey = "vmoperator.vmware.com/external-dns-record"
+
+	// RequiredCapabilitiesAnnotationKey may be applied to VirtualMachineClass
+	// and VirtualMachineImage resources to declare the cluster capabilities
+	// required to deploy a VM from them. The value is a comma-separated list
+	// of the capabilities "vsan," "vtpm," and "pmem." The vTPM and PMem
+	// capabilities are also required implicitly when the class or image
+	// specifies a vTPM or NVDIMM device.
+	RequiredCapabilitiesAnnotationKey = "vmoperator.vmware.com/required-capabilities"
+
+	// ReconfigureSnapshotAnnotationKey may be applied to VirtualMachine
+	// resources to opt them into having a snapshot taken before a risky
+	// reconfigure, ex. a change to the VM's hardware or encryption. The value
+	// is how long the snapshot is kept after a successful reconfigure, ex.
+	// "2h." A value that is not a duration uses a grace period of one hour.
+	// The snapshot may be used to roll back the change if it breaks the guest.
+	ReconfigureSnapshotAnnotationKey = "vmoperator.vmware.com/snapshot-before-reconfigure"
+
+	// ReconfigureSnapshotRefAnnotationKey is applied to VirtualMachine
+	// resources by VM Operator when a snapshot was taken before a
+	// reconfigure. The value is the managed object ID of the snapshot.
+	ReconfigureSnapshotRefAnnotationKey = "vmoperator.vmware.com/reconfigure-snapshot"
+
+	// ReconfigureSnapshotTimeAnnotationKey is applied to VirtualMachine
+	// resources by VM Operator with the RFC3339 time of the last successful
+	// reconfigure after the snapshot was taken. The snapshot is deleted once
+	// the grace period has elapsed since this time.
+	ReconfigureSnapshotTimeAnnotationKey = "vmoperator.vmware.com/reconfigure-snapshot-time"
+
+	// PreferredSiteAnnotationKey may be applied to VirtualMachine and
+	// VirtualMachineSetResourcePolicy resources to express the preferred site,
+	// i.e. the vSAN fault domain, a VM should be placed in when deployed to a
+	// vSAN stretched cluster. The value on the VM takes precedence over the
+	// value on its resource policy. When no host in the cluster belongs to the
+	// site, the hint is ignored.
+	PreferredSiteAnnotationKey = "vmoperator.vmware.com/preferred-site"
+
+	// SiteStoragePoliciesAnnotationKey may be applied to StorageClass
+	// resources to map sites to the storage policy used for VMs that prefer
+	// that site, ex. "site-a=<policy ID>,site-b=<policy ID>." This allows a
+	// site affinity storage policy to be selected for a VM deployed to a vSAN
+	// stretched cluster.
+	SiteStoragePoliciesAnnotationKey = "vmoperator.vmware.com/site-storage-policies"
+
+	// GuestCustomizationRetryAnnotationKey is applied to VirtualMachine
+	// resources by VM Operator when the guest customization is retried with a
+	// regenerated identity because it failed due to a duplicate identity. The
+	// value is the suffix appended to the VM's hostname and Cloud-Init
+	// instance ID. The customization is only retried once, so the presence of
+	// this annotation prevents subsequent retries.
+	GuestCustomizationRetryAnnotationKey = "vmoperator.vmware.com/guest-customization-retry"
+
+	// PowerStateAttemptsAnnotationKey is applied to VirtualMachine resources
+	// by VM Operator to record the consecutive, failed attempts to change the
+	// VM's power state. The value is the desired power state, the number of
+	// attempts, and the RFC3339 time of the last attempt, ex.
+	// "PoweredOff/3/2025-01-01T00:00:00Z". The annotation is removed once the VM's
+	// observed power state matches its desired power state. Removing the
+	// annotation resets the VM's power state retry budget.
+	PowerStateAttemptsAnnotationKey = "vmoperator.vmware.com/power-state-attempts"
+
+	// ChargebackTagsAnnotationKey is applied to VirtualMachine resources by VM
+	// Operator to record the comma-delimited names of the chargeback tags
+	// attached to the VM. The tags are only synced to vSphere when the tags
+	// for the VM's labels differ from this value. Removing the annotation
+	// causes the tags to be synced again.
+	ChargebackTagsAnnotationKey = "vmoperator.vmware.com/chargeback-tags"
 )
@@ -0,0 +1,67 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package budget
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+)
+
+type contextKeyType uint8
+
+const contextKeyValue contextKeyType = 0
+
+// ExceededError is returned by Check when the budget is exceeded. The error
+// wraps a RequeueError so the request is requeued.
+type ExceededError struct {
+	// Completed describes the progress made before the budget was exceeded.
+	Completed string
+}
+
+func (e ExceededError) Error() string {
+	return fmt.Sprintf("reconcile budget exceeded after %s", e.Completed)
+}
+
+func (e ExceededError) Unwrap() error {
+	return pkgerr.RequeueError{}
+}
+
+// WithContext returns a new context with a budget of the provided duration,
+// starting now. A duration that is not greater than zero means there is no
+// budget.
+func WithContext(parent context.Context, budget time.Duration) context.Context {
+	if parent == nil {
+		panic("context is nil")
+	}
+	if budget <= 0 {
+		return parent
+	}
+	return context.WithValue(parent, contextKeyValue, time.Now().Add(budget))
+}
+
+// Remaining returns how much of the budget is remaining. False is returned if
+// the context does not have a budget.
+func Remaining(ctx context.Context) (time.Duration, bool) {
+	if ctx == nil {
+		panic("context is nil")
+	}
+	deadline, ok := ctx.Value(contextKeyValue).(time.Time)
+	if !ok {
+		return 0, false
+	}
+	return time.Until(deadline), true
+}
+
+// Check returns an ExceededError if the context's budget is exceeded. The
+// completed argument describes the progress made so far, ex. "create".
+func Check(ctx context.Context, completed string) error {
+	if remaining, ok := Remaining(ctx); ok && remaining <= 0 {
+		return ExceededError{Completed: completed}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package budget_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ctxbudget "github.com/vmware-tanzu/vm-operator/pkg/context/budget"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+)
+
+var _ = Describe("Check", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	When("ctx is nil", func() {
+		It("should panic", func() {
+			fn := func() {
+				//nolint:staticcheck // SA1012 the nil context is the test.
+				_ = ctxbudget.Check(nil, "create")
+			}
+			Expect(fn).To(PanicWith("context is nil"))
+		})
+	})
+
+	When("there is no budget", func() {
+		BeforeEach(func() {
+			ctx = ctxbudget.WithContext(ctx, 0)
+		})
+		It("should not return an error", func() {
+			_, ok := ctxbudget.Remaining(ctx)
+			Expect(ok).To(BeFalse())
+			Expect(ctxbudget.Check(ctx, "create")).To(Succeed())
+		})
+	})
+
+	When("the budget is not exceeded", func() {
+		BeforeEach(func() {
+			ctx = ctxbudget.WithContext(ctx, time.Hour)
+		})
+		It("should not return an error", func() {
+			remaining, ok := ctxbudget.Remaining(ctx)
+			Expect(ok).To(BeTrue())
+			Expect(remaining).To(BeNumerically("~", time.Hour, time.Minute))
+			Expect(ctxbudget.Check(ctx, "create")).To(Succeed())
+		})
+	})
+
+	When("the budget is exceeded", func() {
+		BeforeEach(func() {
+			ctx = ctxbudget.WithContext(ctx, time.Nanosecond)
+			time.Sleep(time.Millisecond)
+		})
+		It("should return an error that requeues the request", func() {
+			err := ctxbudget.Check(ctx, "create")
+			Expect(err).To(MatchError("reconcile budget exceeded after create"))
+			Expect(err).To(MatchError(pkgerr.RequeueError{}))
+
+			result, err := pkgerr.ResultFromError(err)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Requeue).To(BeTrue())
+		})
+	})
+})
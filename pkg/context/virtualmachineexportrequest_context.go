@@ -0,0 +1,33 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+)
+
+// VirtualMachineExportRequestContext is the context used for VirtualMachineExportRequestControllers.
+type VirtualMachineExportRequestContext struct {
+	context.Context
+	Logger          logr.Logger
+	VMExportRequest *vmopv1.VirtualMachineExportRequest
+	VM              *vmopv1.VirtualMachine
+	ContentLibrary  *imgregv1a1.ContentLibrary
+	// SkipPatch indicates whether we should skip patching the object after
+	// reconcile because Status is updated separately when an export to a
+	// content library item is started.
+	SkipPatch bool
+}
+
+func (v *VirtualMachineExportRequestContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.VMExportRequest.GroupVersionKind(), v.VMExportRequest.Namespace, v.VMExportRequest.Name)
+}
@@ -0,0 +1,278 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnostics
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+)
+
+const (
+	// PprofPath is the path prefix of the pprof handlers.
+	PprofPath = "/debug/pprof/"
+
+	// StatePath is the path of the provider state dump.
+	StatePath = "/debug/vmoperator/state"
+
+	workqueueDepthMetric                 = "workqueue_depth"
+	workqueueLongestRunningProcessMetric = "workqueue_longest_running_processor_seconds"
+	workqueueNameLabel                   = "name"
+)
+
+// State is the response body of the state dump.
+type State struct {
+	// Provider is the sanitized state of the VM provider.
+	Provider providers.Diagnostics `json:"provider"`
+
+	// ProviderError is set if the provider state could not be fully
+	// collected.
+	ProviderError string `json:"providerError,omitempty"`
+
+	// QueueDepths is the depth of each controller's work queue.
+	QueueDepths map[string]float64 `json:"queueDepths,omitempty"`
+
+	// LongestRunningProcessorSeconds is how long the longest running
+	// reconcile of each controller has been running.
+	LongestRunningProcessorSeconds map[string]float64 `json:"longestRunningProcessorSeconds,omitempty"`
+}
+
+// Server is an authenticated diagnostics server. Requests must include a
+// bearer token that is authenticated with a TokenReview and authorized for
+// the request's path with a SubjectAccessReview.
+//
+// The server uses TLS with the certificate in CertDir so the bearer tokens
+// are not sent in the clear. If CertDir is empty, the server must be bound to
+// a loopback address.
+type Server struct {
+	Addr       string
+	CertDir    string
+	KubeClient ctrlclient.Client
+	VMProvider providers.VirtualMachineProviderInterface
+	Gatherer   prometheus.Gatherer
+}
+
+// NewServer creates a new diagnostics server.
+func NewServer(
+	addr, certDir string,
+	client ctrlclient.Client,
+	vmProvider providers.VirtualMachineProviderInterface,
+	gatherer prometheus.Gatherer) (*Server, error) {
+
+	if addr == "" {
+		return nil, errors.New("server addr cannot be empty")
+	}
+	if certDir == "" && !isLoopback(addr) {
+		return nil, fmt.Errorf("server addr %q must be a loopback address without a cert dir", addr)
+	}
+
+	return &Server{
+		Addr:       addr,
+		CertDir:    certDir,
+		KubeClient: client,
+		VMProvider: vmProvider,
+		Gatherer:   gatherer,
+	}, nil
+}
+
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Handler returns the server's authenticated handler.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(PprofPath, pprof.Index)
+	mux.HandleFunc(PprofPath+"cmdline", pprof.Cmdline)
+	mux.HandleFunc(PprofPath+"profile", pprof.Profile)
+	mux.HandleFunc(PprofPath+"symbol", pprof.Symbol)
+	mux.HandleFunc(PprofPath+"trace", pprof.Trace)
+	mux.HandleFunc(StatePath, s.HandleState)
+
+	return s.authorize(mux)
+}
+
+// Start runs the server until the context is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              s.Addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	if s.CertDir != "" {
+		// Watch the certificate so it is reloaded when it is rotated.
+		watcher, err := certwatcher.New(
+			filepath.Join(s.CertDir, "tls.crt"),
+			filepath.Join(s.CertDir, "tls.key"))
+		if err != nil {
+			return fmt.Errorf("failed to load diagnostics server certificate: %w", err)
+		}
+		go func() {
+			if err := watcher.Start(ctx); err != nil {
+				ctrllog.Log.Error(err, "Error watching diagnostics server certificate.")
+			}
+		}()
+		server.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: watcher.GetCertificate,
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	var err error
+	if server.TLSConfig != nil {
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// NeedLeaderElection returns false so the diagnostics are available from
+// every replica, including those not currently leading.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// HandleState writes the sanitized provider state and work queue depths.
+func (s *Server) HandleState(w http.ResponseWriter, r *http.Request) {
+	var state State
+
+	if s.VMProvider != nil {
+		diag, err := s.VMProvider.Diagnostics(r.Context())
+		if err != nil {
+			state.ProviderError = err.Error()
+		}
+		state.Provider = diag
+	}
+
+	if s.Gatherer != nil {
+		families, err := s.Gatherer.Gather()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, mf := range families {
+			var dst *map[string]float64
+			switch mf.GetName() {
+			case workqueueDepthMetric:
+				dst = &state.QueueDepths
+			case workqueueLongestRunningProcessMetric:
+				dst = &state.LongestRunningProcessorSeconds
+			default:
+				continue
+			}
+			for _, m := range mf.GetMetric() {
+				for _, l := range m.GetLabel() {
+					if l.GetName() != workqueueNameLabel {
+						continue
+					}
+					if *dst == nil {
+						*dst = map[string]float64{}
+					}
+					(*dst)[l.GetValue()] = m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(state); err != nil {
+		ctrllog.Log.WithName(r.URL.Path).Error(err, "Error encoding diagnostics state.")
+	}
+}
+
+func (s *Server) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := ctrllog.Log.WithName(r.URL.Path)
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			http.Error(w, "missing bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		tr := &authnv1.TokenReview{
+			Spec: authnv1.TokenReviewSpec{
+				Token: token,
+			},
+		}
+		if err := s.KubeClient.Create(r.Context(), tr); err != nil {
+			logger.Error(err, "Error creating TokenReview.")
+			http.Error(w, "failed to authenticate", http.StatusInternalServerError)
+			return
+		}
+		if !tr.Status.Authenticated {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		extra := map[string]authzv1.ExtraValue{}
+		for k, v := range tr.Status.User.Extra {
+			extra[k] = authzv1.ExtraValue(v)
+		}
+		sar := &authzv1.SubjectAccessReview{
+			Spec: authzv1.SubjectAccessReviewSpec{
+				User:   tr.Status.User.Username,
+				UID:    tr.Status.User.UID,
+				Groups: tr.Status.User.Groups,
+				Extra:  extra,
+				NonResourceAttributes: &authzv1.NonResourceAttributes{
+					Path: r.URL.Path,
+					Verb: strings.ToLower(r.Method),
+				},
+			},
+		}
+		if err := s.KubeClient.Create(r.Context(), sar); err != nil {
+			logger.Error(err, "Error creating SubjectAccessReview.")
+			http.Error(w, "failed to authorize", http.StatusInternalServerError)
+			return
+		}
+		if !sar.Status.Allowed {
+			logger.Info("Denied diagnostics request.", "user", tr.Status.User.Username)
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,203 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnostics_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/diagnostics"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func serverUnitTests() {
+
+	const (
+		validToken = "valid-token"
+		username   = "system:serviceaccount:ns:debugger"
+	)
+
+	var (
+		allowed    bool
+		vmProvider *providerfake.VMProvider
+		registry   *prometheus.Registry
+		server     *diagnostics.Server
+		req        *http.Request
+		rec        *httptest.ResponseRecorder
+		sarSpec    authzv1.SubjectAccessReviewSpec
+	)
+
+	BeforeEach(func() {
+		allowed = true
+		sarSpec = authzv1.SubjectAccessReviewSpec{}
+		vmProvider = providerfake.NewVMProvider()
+		registry = prometheus.NewRegistry()
+
+		client := builder.NewFakeClientWithInterceptors(interceptor.Funcs{
+			Create: func(
+				ctx context.Context,
+				client ctrlclient.WithWatch,
+				obj ctrlclient.Object,
+				opts ...ctrlclient.CreateOption) error {
+
+				switch o := obj.(type) {
+				case *authnv1.TokenReview:
+					if o.Spec.Token == validToken {
+						o.Status.Authenticated = true
+						o.Status.User.Username = username
+					}
+					return nil
+				case *authzv1.SubjectAccessReview:
+					sarSpec = o.Spec
+					o.Status.Allowed = allowed
+					return nil
+				}
+				return client.Create(ctx, obj, opts...)
+			},
+		})
+
+		var err error
+		server, err = diagnostics.NewServer(":0", "/certs", client, vmProvider, registry)
+		Expect(err).ToNot(HaveOccurred())
+
+		req = httptest.NewRequest(http.MethodGet, diagnostics.StatePath, nil)
+		req.Header.Set("Authorization", "Bearer "+validToken)
+		rec = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		server.Handler().ServeHTTP(rec, req)
+	})
+
+	Context("NewServer", func() {
+		It("should return an error when addr is empty", func() {
+			_, err := diagnostics.NewServer("", "/certs", nil, nil, nil)
+			Expect(err).To(MatchError("server addr cannot be empty"))
+		})
+
+		DescribeTable("should only allow a loopback addr without a cert dir",
+			func(addr string, expectErr bool) {
+				_, err := diagnostics.NewServer(addr, "", nil, nil, nil)
+				if expectErr {
+					Expect(err).To(MatchError(fmt.Sprintf(
+						"server addr %q must be a loopback address without a cert dir", addr)))
+				} else {
+					Expect(err).ToNot(HaveOccurred())
+				}
+			},
+			Entry("localhost", "localhost:8443", false),
+			Entry("IPv4 loopback", "127.0.0.1:8443", false),
+			Entry("IPv6 loopback", "[::1]:8443", false),
+			Entry("all interfaces", ":8443", true),
+			Entry("pod IP", "10.0.0.1:8443", true),
+		)
+	})
+
+	Context("Start", func() {
+		It("should return an error when the cert dir has no certificate", func() {
+			err := server.Start(context.Background())
+			Expect(err).To(MatchError(ContainSubstring("failed to load diagnostics server certificate")))
+		})
+	})
+
+	When("the request has no bearer token", func() {
+		BeforeEach(func() {
+			req.Header.Del("Authorization")
+		})
+		It("should return 401", func() {
+			Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	When("the bearer token is not valid", func() {
+		BeforeEach(func() {
+			req.Header.Set("Authorization", "Bearer bogus")
+		})
+		It("should return 401", func() {
+			Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	When("the user is not authorized", func() {
+		BeforeEach(func() {
+			allowed = false
+		})
+		It("should return 403", func() {
+			Expect(rec.Code).To(Equal(http.StatusForbidden))
+			Expect(sarSpec.User).To(Equal(username))
+			Expect(sarSpec.NonResourceAttributes).ToNot(BeNil())
+			Expect(sarSpec.NonResourceAttributes.Path).To(Equal(diagnostics.StatePath))
+			Expect(sarSpec.NonResourceAttributes.Verb).To(Equal("get"))
+		})
+	})
+
+	When("the user is authorized", func() {
+		BeforeEach(func() {
+			depth := prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{Name: "workqueue_depth"},
+				[]string{"name"})
+			depth.WithLabelValues("virtualmachine").Set(3)
+			registry.MustRegister(depth)
+
+			vmProvider.DiagnosticsFn = func(_ context.Context) (providers.Diagnostics, error) {
+				return providers.Diagnostics{
+					Session: providers.SessionDiagnostics{
+						Cached: true,
+						Active: true,
+						Host:   "vc.local",
+					},
+					InFlightTasks: []providers.TaskDiagnostics{
+						{
+							ID:       "task-1",
+							State:    "running",
+							Progress: 42,
+						},
+					},
+				}, errors.New("partial")
+			}
+		})
+
+		It("should return the state", func() {
+			Expect(rec.Code).To(Equal(http.StatusOK))
+
+			var state diagnostics.State
+			Expect(json.Unmarshal(rec.Body.Bytes(), &state)).To(Succeed())
+			Expect(state.Provider.Session.Cached).To(BeTrue())
+			Expect(state.Provider.Session.Active).To(BeTrue())
+			Expect(state.Provider.Session.Host).To(Equal("vc.local"))
+			Expect(state.Provider.InFlightTasks).To(HaveLen(1))
+			Expect(state.Provider.InFlightTasks[0].ID).To(Equal("task-1"))
+			Expect(state.Provider.InFlightTasks[0].Progress).To(BeEquivalentTo(42))
+			Expect(state.ProviderError).To(Equal("partial"))
+			Expect(state.QueueDepths).To(HaveKeyWithValue("virtualmachine", 3.0))
+		})
+
+		When("the request is for pprof", func() {
+			BeforeEach(func() {
+				req = httptest.NewRequest(http.MethodGet, diagnostics.PprofPath, nil)
+				req.Header.Set("Authorization", "Bearer "+validToken)
+			})
+			It("should return the pprof index", func() {
+				Expect(rec.Code).To(Equal(http.StatusOK))
+				Expect(rec.Body.String()).To(ContainSubstring("goroutine"))
+			})
+		})
+	})
+}
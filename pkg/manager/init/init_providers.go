@@ -5,11 +5,15 @@
 package manager
 
 import (
+	"context"
 	"fmt"
 
 	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/diagnostics"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 )
@@ -21,5 +25,38 @@ func InitializeProviders(
 	vmProviderName := fmt.Sprintf("%s/%s/vmProvider", ctx.Namespace, ctx.Name)
 	recorder := record.New(mgr.GetEventRecorderFor(vmProviderName))
 	ctx.VMProvider = vsphere.NewVSphereVMProviderFromClient(ctx, mgr.GetClient(), recorder)
+
+	if pkgcfg.FromContext(ctx).SessionWarmupConcurrency > 0 {
+		// Warm up the provider once the manager is started so the caches
+		// used to find the namespaces are synced. A failure to warm up is
+		// not fatal since the work is otherwise done on demand.
+		vmProvider := ctx.VMProvider
+		if err := mgr.Add(ctrlmgr.RunnableFunc(func(rctx context.Context) error {
+			rctx = pkgcfg.JoinContext(rctx, ctx)
+			if err := vsphere.WarmUp(rctx, vmProvider); err != nil {
+				ctx.Logger.Error(err, "failed to warm up vSphere provider")
+			}
+			return nil
+		})); err != nil {
+			return fmt.Errorf("failed to add vSphere provider warm up: %w", err)
+		}
+	}
+
+	if addr := pkgcfg.FromContext(ctx).DiagnosticsAddr; addr != "" {
+		// The server uses the webhook server's certificate.
+		server, err := diagnostics.NewServer(
+			addr,
+			pkgcfg.FromContext(ctx).WebhookSecretVolumeMountPath,
+			mgr.GetClient(),
+			ctx.VMProvider,
+			ctrlmetrics.Registry)
+		if err != nil {
+			return err
+		}
+		if err := mgr.Add(server); err != nil {
+			return fmt.Errorf("failed to add diagnostics server: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -7,9 +7,11 @@ package manager
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,7 +39,10 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
 // Manager is a VM Operator controller manager.
@@ -53,7 +58,15 @@ func New(ctx context.Context, opts Options) (Manager, error) {
 	// Ensure the default options are set.
 	opts.defaults()
 
+	// Each shard elects its own leader so the managers for different shards
+	// are active at the same time.
+	opts.LeaderElectionID = shard.LeaderElectionID(ctx, opts.LeaderElectionID)
+
+	// Ensure the config reflects the options used to create the manager.
+	pkgcfg.SetContext(ctx, opts.UpdateConfig)
+
 	_ = clientgoscheme.AddToScheme(opts.Scheme)
+	_ = apiextensionsv1.AddToScheme(opts.Scheme)
 	_ = ncpv1alpha1.AddToScheme(opts.Scheme)
 	_ = cnsv1alpha1.AddToScheme(opts.Scheme)
 	_ = netopv1alpha1.AddToScheme(opts.Scheme)
@@ -95,6 +108,9 @@ func New(ctx context.Context, opts Options) (Manager, error) {
 		},
 		Metrics: metricsserver.Options{
 			BindAddress: opts.MetricsAddr,
+			ExtraHandlers: map[string]http.Handler{
+				metrics.OpenMetricsPath: metrics.NewOpenMetricsHandler(),
+			},
 		},
 		WebhookServer: webhook.NewServer(webhook.Options{
 			CertDir: opts.WebhookSecretVolumeMountPath,
@@ -111,6 +127,38 @@ func New(ctx context.Context, opts Options) (Manager, error) {
 		return nil, fmt.Errorf("unable to create manager: %w", err)
 	}
 
+	// Index the VMs by their MAC addresses so duplicate MAC addresses may be
+	// found across all namespaces by the VirtualMachine controller and
+	// validation webhook.
+	if err := vmopv1util.IndexMACAddress(ctx, mgr.GetFieldIndexer()); err != nil {
+		return nil, fmt.Errorf("failed to index VirtualMachine MAC addresses: %w", err)
+	}
+
+	// Index the VMs by the Secret and ConfigMap resources referenced by their
+	// bootstrap spec so the VirtualMachine controller only handles the events
+	// for the referenced resources.
+	if err := vmopv1util.IndexBootstrapResource(ctx, mgr.GetFieldIndexer()); err != nil {
+		return nil, fmt.Errorf("failed to index VirtualMachine bootstrap resources: %w", err)
+	}
+
+	// Index the VMs by their image so the VirtualMachine controller may find
+	// the VMs that use an image when the image changes.
+	if err := vmopv1util.IndexVirtualMachineImage(ctx, mgr.GetFieldIndexer()); err != nil {
+		return nil, fmt.Errorf("failed to index VirtualMachine images: %w", err)
+	}
+
+	// Index the images by status.name so ResolveImageName may look up the
+	// images by their status.name with the manager's cached client.
+	if err := vmopv1util.IndexImageStatusName(ctx, mgr.GetFieldIndexer()); err != nil {
+		return nil, fmt.Errorf("failed to index image status names: %w", err)
+	}
+
+	// Forget the image names remembered by the image resolver when the images
+	// with those names change.
+	if err := vmopv1util.AddImageResolverEventHandlers(ctx, mgr.GetCache()); err != nil {
+		return nil, fmt.Errorf("failed to add image resolver event handlers: %w", err)
+	}
+
 	// Prefix the logger with the pod name.
 	logger := opts.Logger.WithName(opts.PodName)
 
@@ -17,6 +17,7 @@ import (
 
 	// +kubebuilder:scaffold:imports
 
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 )
 
@@ -143,6 +144,26 @@ type Options struct {
 	AddToManager AddToManagerFunc
 }
 
+// UpdateConfig updates the provided Config with the values from the options
+// so components that read the Config from the context observe the same values
+// as the manager, ex. when the values were specified as flags.
+func (o Options) UpdateConfig(config *pkgcfg.Config) {
+	config.ContainerNode = o.ContainerNode
+	config.LeaderElectionID = o.LeaderElectionID
+	config.MaxConcurrentReconciles = o.MaxConcurrentReconciles
+	config.PodName = o.PodName
+	config.PodNamespace = o.PodNamespace
+	config.PodServiceAccountName = o.PodServiceAccountName
+	config.SyncPeriod = o.SyncPeriod
+	config.WatchNamespace = o.WatchNamespace
+	config.WebhookServiceContainerPort = o.WebhookServiceContainerPort
+	config.WebhookServiceName = o.WebhookServiceName
+	config.WebhookServiceNamespace = o.WebhookServiceNamespace
+	config.WebhookSecretName = o.WebhookSecretName
+	config.WebhookSecretNamespace = o.WebhookSecretNamespace
+	config.WebhookSecretVolumeMountPath = o.WebhookSecretVolumeMountPath
+}
+
 func (o *Options) defaults() {
 	if o.Logger == nil {
 		o.Logger = &ctrllog.Log
@@ -160,6 +181,10 @@ func (o *Options) defaults() {
 		o.PodServiceAccountName = DefaultPodServiceAccountName
 	}
 
+	if o.LeaderElectionID == "" {
+		o.LeaderElectionID = DefaultLeaderElectionID
+	}
+
 	if o.SyncPeriod == 0 {
 		o.SyncPeriod = DefaultSyncPeriod
 	}
@@ -17,11 +17,15 @@ func integTests() {
 	Describe("Cache", Ordered, Label(testlabels.EnvTest), cacheTests)
 }
 
+func unitTests() {
+	Describe("Options", optionsTests)
+}
+
 var suite = builder.NewTestSuite()
 
 func TestManager(t *testing.T) {
 	suite.SetManagerNewCacheFunc(newCacheProxy)
-	suite.Register(t, "Manager Suite", integTests, nil)
+	suite.Register(t, "Manager Suite", integTests, unitTests)
 }
 
 var _ = BeforeSuite(suite.BeforeSuite)
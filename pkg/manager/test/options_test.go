@@ -0,0 +1,66 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgmgr "github.com/vmware-tanzu/vm-operator/pkg/manager"
+)
+
+func optionsTests() {
+	Describe("UpdateConfig", func() {
+		It("should update the config with the options", func() {
+			ctx := pkgcfg.NewContextWithDefaultConfig()
+
+			opts := pkgmgr.Options{
+				ContainerNode:                true,
+				LeaderElectionID:             "my-leader-election-id",
+				MaxConcurrentReconciles:      5,
+				PodName:                      "my-pod",
+				PodNamespace:                 "my-pod-namespace",
+				PodServiceAccountName:        "my-service-account",
+				SyncPeriod:                   time.Hour,
+				WatchNamespace:               "my-watch-namespace",
+				WebhookServiceContainerPort:  1234,
+				WebhookServiceName:           "my-webhook-service",
+				WebhookServiceNamespace:      "my-webhook-service-namespace",
+				WebhookSecretName:            "my-webhook-secret",
+				WebhookSecretNamespace:       "my-webhook-secret-namespace",
+				WebhookSecretVolumeMountPath: "/my/webhook/secret",
+			}
+			pkgcfg.SetContext(ctx, opts.UpdateConfig)
+
+			config := pkgcfg.FromContext(ctx)
+			Expect(config.ContainerNode).To(BeTrue())
+			Expect(config.LeaderElectionID).To(Equal("my-leader-election-id"))
+			Expect(config.MaxConcurrentReconciles).To(Equal(5))
+			Expect(config.PodName).To(Equal("my-pod"))
+			Expect(config.PodNamespace).To(Equal("my-pod-namespace"))
+			Expect(config.PodServiceAccountName).To(Equal("my-service-account"))
+			Expect(config.SyncPeriod).To(Equal(time.Hour))
+			Expect(config.WatchNamespace).To(Equal("my-watch-namespace"))
+			Expect(config.WebhookServiceContainerPort).To(Equal(1234))
+			Expect(config.WebhookServiceName).To(Equal("my-webhook-service"))
+			Expect(config.WebhookServiceNamespace).To(Equal("my-webhook-service-namespace"))
+			Expect(config.WebhookSecretName).To(Equal("my-webhook-secret"))
+			Expect(config.WebhookSecretNamespace).To(Equal("my-webhook-secret-namespace"))
+			Expect(config.WebhookSecretVolumeMountPath).To(Equal("/my/webhook/secret"))
+		})
+
+		It("should not change the config values that are not options", func() {
+			ctx := pkgcfg.NewContextWithDefaultConfig()
+			expected := pkgcfg.FromContext(ctx).SyncLibraryItemTimeout
+
+			pkgcfg.SetContext(ctx, pkgmgr.Options{}.UpdateConfig)
+
+			Expect(pkgcfg.FromContext(ctx).SyncLibraryItemTimeout).To(Equal(expected))
+		})
+	})
+}
@@ -19,8 +19,9 @@ var (
 )
 
 type ContentLibraryItemMetrics struct {
-	vmiResourceResolve *prometheus.GaugeVec
-	vmiContentSync     *prometheus.GaugeVec
+	vmiResourceResolve  *prometheus.GaugeVec
+	vmiContentSync      *prometheus.GaugeVec
+	vmiProviderItemSize *prometheus.GaugeVec
 }
 
 // NewContentLibraryItemMetrics initializes a singleton and registers all the defined metrics.
@@ -45,11 +46,22 @@ func NewContentLibraryItemMetrics() *ContentLibraryItemMetrics {
 				vmiNameLabel,
 				vmiNamespaceLabel,
 			}),
+			vmiProviderItemSize: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "vmi",
+				Name:      "provider_item_size_bytes",
+				Help:      "Size in bytes on storage of the library item that backs the VMImage",
+			}, []string{
+				vmiNameLabel,
+				vmiNamespaceLabel,
+				contentLibraryNameLabel,
+			}),
 		}
 
 		metrics.Registry.MustRegister(
 			clItemMetrics.vmiResourceResolve,
 			clItemMetrics.vmiContentSync,
+			clItemMetrics.vmiProviderItemSize,
 		)
 	})
 
@@ -84,11 +96,31 @@ func (m *ContentLibraryItemMetrics) RegisterVMIContentSync(logger logr.Logger, v
 	logger.V(5).WithValues("labels", labels).Info("Set metrics for VMImage content sync status")
 }
 
+// RegisterVMIProviderItemSize registers the size of the library item that
+// backs the vmi. The library name is included as a label so the size of a
+// library may be aggregated from the sizes of its items.
+func (m *ContentLibraryItemMetrics) RegisterVMIProviderItemSize(
+	logger logr.Logger,
+	vmiName, ns, libraryName string,
+	sizeInBytes int64) {
+
+	labels := getVMIMetricsLabels(vmiName, ns)
+
+	// Remove the existing metric in case the item's library has changed.
+	m.vmiProviderItemSize.DeletePartialMatch(labels)
+
+	labels[contentLibraryNameLabel] = libraryName
+	m.vmiProviderItemSize.With(labels).Set(float64(sizeInBytes))
+
+	logger.V(5).WithValues("labels", labels).Info("Set metrics for VMImage provider item size")
+}
+
 // DeleteMetrics deletes all the related ContentLibraryItem metrics from the given name and namespace.
 func (m *ContentLibraryItemMetrics) DeleteMetrics(logger logr.Logger, vmiName, ns string) {
 	labels := getVMIMetricsLabels(vmiName, ns)
 	m.vmiResourceResolve.Delete(labels)
 	m.vmiContentSync.Delete(labels)
+	m.vmiProviderItemSize.DeletePartialMatch(labels)
 
 	logger.V(5).WithValues("labels", labels).Info("Deleted all VMImage related Metrics")
 }
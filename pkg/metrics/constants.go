@@ -16,8 +16,26 @@ const (
 	phaseLabel           = "phase"
 	specLabel            = "spec"
 	statusLabel          = "status"
+	dimensionLabel       = "dimension"
+	valueLabel           = "value"
+	resourceLabel        = "resource"
 
 	// VMImage related metrics labels (from image registry service).
 	vmiNameLabel      = "vmi_name"
 	vmiNamespaceLabel = "vmi_namespace"
+
+	// ContentLibrary related metrics labels.
+	contentLibraryNameLabel = "content_library_name"
+
+	// Webhook related metrics labels.
+	webhookTypeLabel = "webhook_type"
+	kindLabel        = "kind"
+	operationLabel   = "operation"
+	decisionLabel    = "decision"
+	reasonLabel      = "reason"
+
+	// SLO related metrics labels.
+	controllerLabel = "controller"
+	resultLabel     = "result"
+	sloLabel        = "slo"
 )
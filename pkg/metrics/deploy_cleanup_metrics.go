@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	deployCleanupMetricsOnce sync.Once
+	deployCleanupMetrics     *DeployCleanupMetrics
+)
+
+type DeployCleanupMetrics struct {
+	deployCleanup *prometheus.CounterVec
+}
+
+// NewDeployCleanupMetrics initializes a singleton and registers all the defined metrics.
+func NewDeployCleanupMetrics() *DeployCleanupMetrics {
+	deployCleanupMetricsOnce.Do(func() {
+		deployCleanupMetrics = &DeployCleanupMetrics{
+			deployCleanup: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "vm",
+				Name:      "deploy_cleanup_total",
+				Help:      "Number of VMs left behind by failed deploys that were removed from vSphere",
+			}, []string{
+				statusLabel,
+			}),
+		}
+
+		metrics.Registry.MustRegister(
+			deployCleanupMetrics.deployCleanup,
+		)
+	})
+
+	return deployCleanupMetrics
+}
+
+// RegisterDeployCleanup increments the number of VMs left behind by a failed
+// deploy that were, or if success is false failed to be, removed.
+func (m *DeployCleanupMetrics) RegisterDeployCleanup(logger logr.Logger, success bool) {
+	status := "succeeded"
+	if !success {
+		status = "failed"
+	}
+	labels := prometheus.Labels{statusLabel: status}
+	m.deployCleanup.With(labels).Inc()
+
+	logger.V(5).WithValues("labels", labels).Info("Incremented metrics for deploy cleanup")
+}
@@ -0,0 +1,217 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// OpenMetricsPath is the path of the metrics server endpoint that serves
+	// the metrics in the OpenMetrics format, which, unlike the default
+	// /metrics endpoint, includes the exemplars.
+	OpenMetricsPath = "/metrics/openmetrics"
+
+	// ProviderOperationClone is the operation label value of VMs created by
+	// cloning a VM or template from inventory.
+	ProviderOperationClone = "clone"
+
+	// ProviderOperationDeploy is the operation label value of VMs created by
+	// deploying a Content Library item.
+	ProviderOperationDeploy = "deploy"
+
+	// SLOResultSuccess is the result label value of successful operations.
+	SLOResultSuccess = "success"
+
+	// SLOResultError is the result label value of failed operations.
+	SLOResultError = "error"
+
+	// SLOGood is the slo label value of operations that succeeded within
+	// their objective.
+	SLOGood = "good"
+
+	// SLOBad is the slo label value of operations that failed or exceeded
+	// their objective.
+	SLOBad = "bad"
+)
+
+// SLOLatencyBuckets are the histogram buckets, in seconds, of the SLO latency
+// metrics. The buckets range from sub-second, for the API calls of a single
+// reconcile, to 30 minutes, for the clone or deploy of a large image.
+var SLOLatencyBuckets = []float64{
+	0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600, 900, 1200, 1800,
+}
+
+// SLOObjectives are the latency objectives of the provider operations. An
+// operation that succeeds within its objective is counted as a good event,
+// otherwise it is counted as a bad event.
+var SLOObjectives = map[string]time.Duration{
+	ProviderOperationClone:  5 * time.Minute,
+	ProviderOperationDeploy: 10 * time.Minute,
+}
+
+var (
+	sloMetricsOnce sync.Once
+	sloMetrics     *SLOMetrics
+)
+
+type SLOMetrics struct {
+	reconcileDuration         *prometheus.HistogramVec
+	providerOperationDuration *prometheus.HistogramVec
+	providerOperationEvents   *prometheus.CounterVec
+}
+
+// NewSLOMetrics initializes a singleton and registers all the defined metrics.
+func NewSLOMetrics() *SLOMetrics {
+	sloMetricsOnce.Do(func() {
+		sloMetrics = &SLOMetrics{
+			reconcileDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "slo",
+				Name:      "reconcile_duration_seconds",
+				Help:      "Time taken by a controller to reconcile a resource",
+				Buckets:   SLOLatencyBuckets,
+			}, []string{
+				controllerLabel,
+				resultLabel,
+			}),
+			providerOperationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "slo",
+				Name:      "provider_operation_duration_seconds",
+				Help:      "Time taken by a provider operation, ex. clone or deploy, against vSphere",
+				Buckets:   SLOLatencyBuckets,
+			}, []string{
+				operationLabel,
+				resultLabel,
+			}),
+			providerOperationEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "slo",
+				Name:      "provider_operation_events_total",
+				Help:      "Number of provider operations that were good or bad with respect to their latency objective",
+			}, []string{
+				operationLabel,
+				sloLabel,
+			}),
+		}
+
+		metrics.Registry.MustRegister(
+			sloMetrics.reconcileDuration,
+			sloMetrics.providerOperationDuration,
+			sloMetrics.providerOperationEvents,
+		)
+	})
+
+	return sloMetrics
+}
+
+// RegisterReconcile observes the time taken by the controller to reconcile a
+// resource.
+func (m *SLOMetrics) RegisterReconcile(
+	controller string,
+	duration time.Duration,
+	err error) {
+
+	m.reconcileDuration.With(prometheus.Labels{
+		controllerLabel: controller,
+		resultLabel:     sloResult(err),
+	}).Observe(duration.Seconds())
+}
+
+// RegisterProviderOperation observes the time taken by the provider operation
+// and counts whether the operation was good or bad with respect to its
+// objective. The name and namespace of the VM are recorded as an exemplar so
+// a slow operation may be traced back to the VM.
+func (m *SLOMetrics) RegisterProviderOperation(
+	logger logr.Logger,
+	operation, vmNamespace, vmName string,
+	duration time.Duration,
+	err error) {
+
+	result := sloResult(err)
+	observer := m.providerOperationDuration.With(prometheus.Labels{
+		operationLabel: operation,
+		resultLabel:    result,
+	})
+	if eo, ok := observer.(prometheus.ExemplarObserver); ok {
+		eo.ObserveWithExemplar(duration.Seconds(), sloExemplar(vmNamespace, vmName))
+	} else {
+		observer.Observe(duration.Seconds())
+	}
+
+	slo := SLOGood
+	if objective, ok := SLOObjectives[operation]; err != nil || (ok && duration > objective) {
+		slo = SLOBad
+	}
+	m.providerOperationEvents.With(prometheus.Labels{
+		operationLabel: operation,
+		sloLabel:       slo,
+	}).Inc()
+
+	logger.V(5).Info("Observed provider operation latency",
+		"operation", operation, "result", result, "slo", slo, "duration", duration)
+}
+
+// sloExemplar returns the exemplar labels for the VM, truncating the VM's
+// name so the labels do not exceed the maximum length of an exemplar.
+func sloExemplar(vmNamespace, vmName string) prometheus.Labels {
+	n := prometheus.ExemplarMaxRunes -
+		len(vmNamespaceLabel) - len(vmNamespace) - len(vmNameLabel)
+	if n < 0 {
+		n = 0
+	}
+	if len(vmName) > n {
+		vmName = vmName[:n]
+	}
+	return prometheus.Labels{
+		vmNamespaceLabel: vmNamespace,
+		vmNameLabel:      vmName,
+	}
+}
+
+func sloResult(err error) string {
+	if err != nil {
+		return SLOResultError
+	}
+	return SLOResultSuccess
+}
+
+// NewSLOReconciler returns a reconciler that observes the time taken by the
+// provided reconciler to reconcile each request.
+func NewSLOReconciler(
+	controller string,
+	r reconcile.Reconciler) reconcile.Reconciler {
+
+	m := NewSLOMetrics()
+
+	return reconcile.Func(func(
+		ctx context.Context,
+		req reconcile.Request) (reconcile.Result, error) {
+
+		start := time.Now()
+		result, err := r.Reconcile(ctx, req)
+		m.RegisterReconcile(controller, time.Since(start), err)
+		return result, err
+	})
+}
+
+// NewOpenMetricsHandler returns a handler that serves the metrics in the
+// OpenMetrics format so the exemplars are included.
+func NewOpenMetricsHandler() http.Handler {
+	return promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{
+		ErrorHandling:     promhttp.HTTPErrorOnError,
+		EnableOpenMetrics: true,
+	})
+}
@@ -26,6 +26,8 @@ type VMMetrics struct {
 	statusPhase           *prometheus.GaugeVec
 	powerState            *prometheus.GaugeVec
 	statusIP              *prometheus.GaugeVec
+	chargebackInfo        *prometheus.GaugeVec
+	allocatedResource     *prometheus.GaugeVec
 }
 
 func NewVMMetrics() *VMMetrics {
@@ -60,6 +62,22 @@ func NewVMMetrics() *VMMetrics {
 					Help:      "IP address assignment status of a VM resource"},
 				[]string{vmNameLabel, vmNamespaceLabel},
 			),
+
+			chargebackInfo: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_chargeback_info",
+					Help:      "Chargeback dimensions of a VM resource, for joining with the allocated resource metrics"},
+				[]string{vmNameLabel, vmNamespaceLabel, dimensionLabel, valueLabel},
+			),
+
+			allocatedResource: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_allocated_resource",
+					Help:      "CPUs, memory bytes, and storage bytes allocated to a VM resource"},
+				[]string{vmNameLabel, vmNamespaceLabel, resourceLabel},
+			),
 		}
 
 		metrics.Registry.MustRegister(
@@ -67,6 +85,8 @@ func NewVMMetrics() *VMMetrics {
 			vmMetrics.statusPhase,
 			vmMetrics.powerState,
 			vmMetrics.statusIP,
+			vmMetrics.chargebackInfo,
+			vmMetrics.allocatedResource,
 		)
 	})
 
@@ -102,6 +122,59 @@ func (vmm *VMMetrics) DeleteMetrics(vmCtx *pkgctx.VirtualMachineContext) {
 
 	// Delete the 'vm.status.ip' metrics.
 	vmm.statusIP.DeletePartialMatch(labels)
+
+	// Delete the chargeback metrics.
+	vmm.chargebackInfo.DeletePartialMatch(labels)
+	vmm.allocatedResource.DeletePartialMatch(labels)
+}
+
+// RegisterVMChargebackMetrics reports the VM's chargeback dimensions, which
+// are the values of the VM's labels with the provided keys, and the resources
+// allocated to the VM. The class may be nil if it is not known.
+func (vmm *VMMetrics) RegisterVMChargebackMetrics(
+	vmCtx *pkgctx.VirtualMachineContext,
+	keys []string,
+	vmClass *vmopv1.VirtualMachineClass) {
+
+	vm := vmCtx.VM
+	vmCtx.Logger.V(5).Info("Adding chargeback metrics for VM")
+
+	// Delete the previous metrics to address any VM label update.
+	labels := prometheus.Labels{
+		vmNameLabel:      vm.Name,
+		vmNamespaceLabel: vm.Namespace,
+	}
+	vmm.chargebackInfo.DeletePartialMatch(labels)
+	vmm.allocatedResource.DeletePartialMatch(labels)
+
+	for _, k := range keys {
+		v, ok := vm.Labels[k]
+		if !ok {
+			continue
+		}
+		vmm.chargebackInfo.With(prometheus.Labels{
+			vmNameLabel:      vm.Name,
+			vmNamespaceLabel: vm.Namespace,
+			dimensionLabel:   k,
+			valueLabel:       v,
+		}).Set(1)
+	}
+
+	setAllocated := func(resource string, value float64) {
+		vmm.allocatedResource.With(prometheus.Labels{
+			vmNameLabel:      vm.Name,
+			vmNamespaceLabel: vm.Namespace,
+			resourceLabel:    resource,
+		}).Set(value)
+	}
+
+	if vmClass != nil {
+		setAllocated("cpu", float64(vmClass.Spec.Hardware.Cpus))
+		setAllocated("memory", vmClass.Spec.Hardware.Memory.AsApproximateFloat64())
+	}
+	if s := vm.Status.Storage; s != nil && s.Usage != nil && s.Usage.Total != nil {
+		setAllocated("storage", s.Usage.Total.AsApproximateFloat64())
+	}
 }
 
 func (vmm *VMMetrics) registerVMStatusConditions(vmCtx *pkgctx.VirtualMachineContext) {
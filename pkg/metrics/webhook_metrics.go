@@ -0,0 +1,129 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	// WebhookTypeMutating is the webhook type label value of mutation
+	// webhooks.
+	WebhookTypeMutating = "mutating"
+
+	// WebhookTypeValidating is the webhook type label value of validation
+	// webhooks.
+	WebhookTypeValidating = "validating"
+
+	// AdmissionDecisionAllowed is the decision label value of requests that
+	// were allowed without changes.
+	AdmissionDecisionAllowed = "allowed"
+
+	// AdmissionDecisionDenied is the decision label value of requests that
+	// were denied.
+	AdmissionDecisionDenied = "denied"
+
+	// AdmissionDecisionMutated is the decision label value of requests that
+	// were allowed with changes.
+	AdmissionDecisionMutated = "mutated"
+)
+
+var (
+	webhookMetricsOnce sync.Once
+	webhookMetrics     *WebhookMetrics
+)
+
+type WebhookMetrics struct {
+	requestDuration *prometheus.HistogramVec
+	admissionTotal  *prometheus.CounterVec
+}
+
+// NewWebhookMetrics initializes a singleton and registers all the defined metrics.
+func NewWebhookMetrics() *WebhookMetrics {
+	webhookMetricsOnce.Do(func() {
+		webhookMetrics = &WebhookMetrics{
+			requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "webhook",
+				Name:      "request_duration_seconds",
+				Help:      "Time taken by a webhook to handle an admission request",
+				Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+			}, []string{
+				webhookTypeLabel,
+				kindLabel,
+				operationLabel,
+			}),
+			admissionTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "webhook",
+				Name:      "admission_total",
+				Help:      "Number of admission requests by decision and reason",
+			}, []string{
+				webhookTypeLabel,
+				kindLabel,
+				operationLabel,
+				decisionLabel,
+				reasonLabel,
+			}),
+		}
+
+		metrics.Registry.MustRegister(
+			webhookMetrics.requestDuration,
+			webhookMetrics.admissionTotal,
+		)
+	})
+
+	return webhookMetrics
+}
+
+// RegisterAdmission observes the time taken to handle an admission request
+// and increments the number of requests with the response's decision. The
+// reason of a denied request is the text of its HTTP status code, ex.
+// "Unprocessable Entity" for a request that failed validation, so that the
+// reason does not include the request specific message.
+func (m *WebhookMetrics) RegisterAdmission(
+	webhookType, kind, operation string,
+	resp admission.Response,
+	duration time.Duration) {
+
+	m.requestDuration.With(prometheus.Labels{
+		webhookTypeLabel: webhookType,
+		kindLabel:        kind,
+		operationLabel:   operation,
+	}).Observe(duration.Seconds())
+
+	m.admissionTotal.With(prometheus.Labels{
+		webhookTypeLabel: webhookType,
+		kindLabel:        kind,
+		operationLabel:   operation,
+		decisionLabel:    AdmissionDecision(resp),
+		reasonLabel:      admissionReason(resp),
+	}).Inc()
+}
+
+// AdmissionDecision returns the decision label value of the response.
+func AdmissionDecision(resp admission.Response) string {
+	switch {
+	case !resp.Allowed:
+		return AdmissionDecisionDenied
+	case len(resp.Patches) > 0 || len(resp.Patch) > 0:
+		return AdmissionDecisionMutated
+	default:
+		return AdmissionDecisionAllowed
+	}
+}
+
+func admissionReason(resp admission.Response) string {
+	if resp.Allowed || resp.Result == nil {
+		return ""
+	}
+	return http.StatusText(int(resp.Result.Code))
+}
@@ -18,6 +18,7 @@ import (
 	proberctx "github.com/vmware-tanzu/vm-operator/pkg/prober/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/prober/probe"
 	vmoprecord "github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/deployhook"
 )
 
 const (
@@ -81,7 +82,7 @@ func (w *readinessWorker) CreateProbeContext(vm *vmopv1.VirtualMachine) (*prober
 // sets the ReadyCondition in vm status if the new condition status is a transition.
 func (w *readinessWorker) ProcessProbeResult(ctx *proberctx.ProbeContext, res probe.Result, resErr error) error {
 	vm := ctx.VM
-	condition := w.getCondition(res, resErr)
+	condition := deployhook.GateReadyCondition(vm, w.getCondition(res, resErr))
 
 	// We only send event when either the condition type is added or its status changes, not
 	// if either its reason, severity, or message changes.
@@ -0,0 +1,16 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+// DeviceAuditResult describes the devices on a VM that are not represented in
+// its spec or VM class.
+type DeviceAuditResult struct {
+	// Unmanaged are the names of the unmanaged devices that remain on the VM.
+	Unmanaged []string
+
+	// Removed are the names of the unmanaged devices that were removed from
+	// the VM.
+	Removed []string
+}
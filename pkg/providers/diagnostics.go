@@ -0,0 +1,50 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"time"
+)
+
+// Diagnostics is a sanitized snapshot of a provider's internal state that is
+// used to diagnose stuck reconciles. It must never contain credentials.
+type Diagnostics struct {
+	// Session describes the cached vSphere session, if any.
+	Session SessionDiagnostics `json:"session"`
+
+	// InFlightTasks are the queued and running vSphere tasks started by the
+	// provider's session.
+	InFlightTasks []TaskDiagnostics `json:"inFlightTasks,omitempty"`
+
+	// CachedResourcePoolOwners is the number of cached ResourcePool to
+	// cluster mappings.
+	CachedResourcePoolOwners int `json:"cachedResourcePoolOwners"`
+
+	// MinCPUFrequency is the cached minimum CPU frequency in MHz.
+	MinCPUFrequency uint64 `json:"minCPUFrequency"`
+}
+
+// SessionDiagnostics describes a cached vSphere session.
+type SessionDiagnostics struct {
+	// Cached is true if the provider has a cached vSphere client.
+	Cached bool `json:"cached"`
+
+	// Active is true if the cached vSphere client's session is still valid.
+	Active bool `json:"active"`
+
+	// Host is the vCenter address the client is connected to.
+	Host string `json:"host,omitempty"`
+}
+
+// TaskDiagnostics describes a queued or running vSphere task.
+type TaskDiagnostics struct {
+	ID          string     `json:"id"`
+	Description string     `json:"description,omitempty"`
+	Entity      string     `json:"entity,omitempty"`
+	State       string     `json:"state"`
+	Progress    int32      `json:"progress,omitempty"`
+	QueueTime   time.Time  `json:"queueTime"`
+	StartTime   *time.Time `json:"startTime,omitempty"`
+}
@@ -0,0 +1,103 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"errors"
+
+	apierrorsutil "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// ErrorReason is a machine-readable reason a provider operation failed. The
+// reason is used as the reason of the VM's failed condition so higher-level
+// automation can act on the failure without parsing the message.
+type ErrorReason string
+
+const (
+	// ErrorReasonQuotaExceeded indicates there are not enough resources, such
+	// as CPU, memory, or storage, available to the VM.
+	ErrorReasonQuotaExceeded ErrorReason = "QuotaExceeded"
+
+	// ErrorReasonImageNotFound indicates the image used to deploy the VM does
+	// not exist.
+	ErrorReasonImageNotFound ErrorReason = "ImageNotFound"
+
+	// ErrorReasonNetworkNotReady indicates the VM's network interfaces are
+	// not yet ready.
+	ErrorReasonNetworkNotReady ErrorReason = "NetworkNotReady"
+
+	// ErrorReasonPlacementFailed indicates a placement could not be found for
+	// the VM.
+	ErrorReasonPlacementFailed ErrorReason = "PlacementFailed"
+
+	// ErrorReasonCapabilityMismatch indicates the image or class requires a
+	// capability, such as vTPM or PMem, that none of the placement candidate
+	// clusters and hosts have.
+	ErrorReasonCapabilityMismatch ErrorReason = "CapabilityMismatch"
+
+	// ErrorReasonVCUnavailable indicates vCenter could not be reached.
+	ErrorReasonVCUnavailable ErrorReason = "VCUnavailable"
+)
+
+// Error is an error returned by a provider with the reason the operation
+// failed.
+type Error struct {
+	Reason ErrorReason
+	Err    error
+}
+
+// NewError returns the error with the specified reason. Nil is returned if
+// err is nil. If err already has a reason, then err is returned as-is since
+// the original reason is more specific.
+func NewError(reason ErrorReason, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := ReasonFromError(err); ok {
+		return err
+	}
+	return Error{Reason: reason, Err: err}
+}
+
+func (e Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e Error) Unwrap() error {
+	return e.Err
+}
+
+// ReasonFromError returns the reason of the first provider Error in err's
+// tree, including the errors in an aggregate error.
+func ReasonFromError(err error) (ErrorReason, bool) {
+	if err == nil {
+		return "", false
+	}
+
+	var dst Error
+	if errors.As(err, &dst) {
+		return dst.Reason, true
+	}
+
+	var agg apierrorsutil.Aggregate
+	if errors.As(err, &agg) {
+		for _, e := range agg.Errors() {
+			if reason, ok := ReasonFromError(e); ok {
+				return reason, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// ConditionReason returns the reason of the provider Error in err's tree for
+// use as a condition reason, or defaultReason if there is no such error.
+func ConditionReason(err error, defaultReason string) string {
+	if reason, ok := ReasonFromError(err); ok {
+		return string(reason)
+	}
+	return defaultReason
+}
@@ -0,0 +1,91 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package providers_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apierrorsutil "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+)
+
+var _ = Describe("Error", func() {
+
+	It("returns nil for a nil error", func() {
+		Expect(providers.NewError(providers.ErrorReasonQuotaExceeded, nil)).To(BeNil())
+	})
+
+	It("has the message of and unwraps to the original error", func() {
+		orig := errors.New("hello")
+		err := providers.NewError(providers.ErrorReasonVCUnavailable, orig)
+		Expect(err).To(MatchError("hello"))
+		Expect(errors.Is(err, orig)).To(BeTrue())
+	})
+
+	It("keeps the original reason", func() {
+		err := providers.NewError(
+			providers.ErrorReasonPlacementFailed,
+			providers.NewError(providers.ErrorReasonQuotaExceeded, errors.New("hello")))
+		reason, ok := providers.ReasonFromError(err)
+		Expect(ok).To(BeTrue())
+		Expect(reason).To(Equal(providers.ErrorReasonQuotaExceeded))
+	})
+
+	DescribeTable("ReasonFromError",
+		func(err error, expReason providers.ErrorReason, expOK bool) {
+			reason, ok := providers.ReasonFromError(err)
+			Expect(ok).To(Equal(expOK))
+			Expect(reason).To(Equal(expReason))
+		},
+
+		Entry("nil", nil, providers.ErrorReason(""), false),
+
+		Entry("not a provider error", errors.New("hello"), providers.ErrorReason(""), false),
+
+		Entry(
+			"provider error",
+			providers.NewError(providers.ErrorReasonImageNotFound, errors.New("hello")),
+			providers.ErrorReasonImageNotFound,
+			true,
+		),
+
+		Entry(
+			"wrapped provider error",
+			fmt.Errorf("hi: %w",
+				providers.NewError(providers.ErrorReasonNetworkNotReady, errors.New("hello"))),
+			providers.ErrorReasonNetworkNotReady,
+			true,
+		),
+
+		Entry(
+			"provider error in an aggregate",
+			apierrorsutil.NewAggregate([]error{
+				errors.New("hello"),
+				providers.NewError(providers.ErrorReasonImageNotFound, errors.New("world")),
+			}),
+			providers.ErrorReasonImageNotFound,
+			true,
+		),
+	)
+
+	DescribeTable("ConditionReason",
+		func(err error, expReason string) {
+			Expect(providers.ConditionReason(err, "Error")).To(Equal(expReason))
+		},
+
+		Entry("not a provider error", errors.New("hello"), "Error"),
+
+		Entry(
+			"provider error",
+			providers.NewError(providers.ErrorReasonPlacementFailed, errors.New("hello")),
+			"PlacementFailed",
+		),
+	)
+})
@@ -9,16 +9,21 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/google/uuid"
 	"github.com/vmware/govmomi/vapi/library"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcond "github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	vsclient "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
 )
 
@@ -39,6 +44,15 @@ type funcs struct {
 	GetVirtualMachinePropertiesFn      func(ctx context.Context, vm *vmopv1.VirtualMachine, propertyPaths []string) (map[string]any, error)
 	GetVirtualMachineWebMKSTicketFn    func(ctx context.Context, vm *vmopv1.VirtualMachine, pubKey string) (string, error)
 	GetVirtualMachineHardwareVersionFn func(ctx context.Context, vm *vmopv1.VirtualMachine) (vimtypes.HardwareVersion, error)
+	AuditVirtualMachineDevicesFn       func(ctx context.Context, vm *vmopv1.VirtualMachine, prune bool) (providers.DeviceAuditResult, error)
+	ExportVirtualMachineFn             func(ctx context.Context, moID, namespace string) (*vmopv1.VirtualMachine, *vmopv1.VirtualMachineClass, error)
+	ListManagedVirtualMachinesFn       func(ctx context.Context) ([]providers.ManagedVirtualMachine, error)
+
+	ExportVirtualMachineToContentLibraryFn func(ctx context.Context, vm *vmopv1.VirtualMachine,
+		vmExport *vmopv1.VirtualMachineExportRequest, cl *imgregv1a1.ContentLibrary, actID string) (string, error)
+	StartVirtualMachineDiskExportFn    func(ctx context.Context, vm *vmopv1.VirtualMachine) (string, []vmopv1.VirtualMachineExportRequestFile, error)
+	RenewVirtualMachineDiskExportFn    func(ctx context.Context, leaseID string) error
+	CompleteVirtualMachineDiskExportFn func(ctx context.Context, leaseID string) error
 
 	// ListItemsFromContentLibraryFn              func(ctx context.Context, contentLibrary *vmopv1.ContentLibraryProvider) ([]string, error)
 	// GetVirtualMachineImageFromContentLibraryFn func(ctx context.Context, contentLibrary *vmopv1.ContentLibraryProvider, itemID string,
@@ -46,6 +60,7 @@ type funcs struct {
 
 	GetItemFromLibraryByNameFn func(ctx context.Context, contentLibrary, itemName string) (*library.Item, error)
 	UpdateContentLibraryItemFn func(ctx context.Context, itemID, newName string, newDescription *string) error
+	ImportContentLibraryItemFn func(ctx context.Context, contentLibrary, itemName, sourceURL, sha256 string) error
 	SyncVirtualMachineImageFn  func(ctx context.Context, cli, vmi client.Object) error
 
 	UpdateVcPNIDFn  func(ctx context.Context, vcPNID, vcPort string) error
@@ -55,8 +70,10 @@ type funcs struct {
 	IsVirtualMachineSetResourcePolicyReadyFn        func(ctx context.Context, azName string, rp *vmopv1.VirtualMachineSetResourcePolicy) (bool, error)
 	DeleteVirtualMachineSetResourcePolicyFn         func(ctx context.Context, rp *vmopv1.VirtualMachineSetResourcePolicy) error
 	ComputeCPUMinFrequencyFn                        func(ctx context.Context) error
+	DiagnosticsFn                                   func(ctx context.Context) (providers.Diagnostics, error)
 
 	GetTasksByActIDFn func(ctx context.Context, actID string) (tasksInfo []vimtypes.TaskInfo, retErr error)
+	CancelTaskFn      func(ctx context.Context, taskRef vimtypes.ManagedObjectReference) error
 
 	DoesProfileSupportEncryptionFn func(ctx context.Context, profileID string) (bool, error)
 	VSphereClientFn                func(context.Context) (*vsclient.Client, error)
@@ -69,9 +86,33 @@ type VMProvider struct {
 	resourcePolicyMap map[client.ObjectKey]*vmopv1.VirtualMachineSetResourcePolicy
 	vmPubMap          map[string]vimtypes.TaskInfoState
 
+	// libraryItems are the items to which VMs were published, keyed by the
+	// library ID and item name, when conformance is true.
+	libraryItems map[libraryItemKey]*library.Item
+
+	// conformance, when true, simulates creating and updating VMs as the
+	// vSphere provider does, so the provider passes the conformance tests.
+	// It is only enabled by NewConformanceVMProvider.
+	conformance bool
+
+	// k8sClient is used to look up the images of the VMs that are created
+	// when conformance is true.
+	k8sClient client.Client
+	nextVMID  int
+	nextID    int
+
 	isPublishVMCalled bool
 }
 
+type libraryItemKey struct {
+	libraryID string
+	itemName  string
+}
+
+// ObserverModeReason is the reason of the VM's Created condition when the
+// VM is not created because observer mode is enabled.
+const ObserverModeReason = "ObserverMode"
+
 var _ providers.VirtualMachineProviderInterface = &VMProvider{}
 
 func (s *VMProvider) Reset() {
@@ -82,6 +123,7 @@ func (s *VMProvider) Reset() {
 	s.vmMap = make(map[client.ObjectKey]*vmopv1.VirtualMachine)
 	s.resourcePolicyMap = make(map[client.ObjectKey]*vmopv1.VirtualMachineSetResourcePolicy)
 	s.vmPubMap = make(map[string]vimtypes.TaskInfoState)
+	s.libraryItems = make(map[libraryItemKey]*library.Item)
 	s.isPublishVMCalled = false
 }
 
@@ -91,6 +133,9 @@ func (s *VMProvider) CreateOrUpdateVirtualMachine(ctx context.Context, vm *vmopv
 	if s.CreateOrUpdateVirtualMachineFn != nil {
 		return s.CreateOrUpdateVirtualMachineFn(ctx, vm)
 	}
+	if s.conformance {
+		return s.createOrUpdateVM(ctx, vm)
+	}
 	s.addToVMMap(vm)
 	return nil
 }
@@ -101,6 +146,9 @@ func (s *VMProvider) CreateOrUpdateVirtualMachineAsync(ctx context.Context, vm *
 	if s.CreateOrUpdateVirtualMachineAsyncFn != nil {
 		return s.CreateOrUpdateVirtualMachineAsyncFn(ctx, vm)
 	}
+	if s.conformance {
+		return nil, s.createOrUpdateVM(ctx, vm)
+	}
 	s.addToVMMap(vm)
 	return nil, nil
 }
@@ -125,6 +173,9 @@ func (s *VMProvider) PublishVirtualMachine(ctx context.Context, vm *vmopv1.Virtu
 	if s.PublishVirtualMachineFn != nil {
 		return s.PublishVirtualMachineFn(ctx, vm, vmPub, cl, actID)
 	}
+	if s.conformance {
+		return s.publishVM(ctx, vm, vmPub, cl, actID)
+	}
 
 	s.AddToVMPublishMap(actID, vimtypes.TaskInfoStateSuccess)
 	return "dummy-id", nil
@@ -136,6 +187,11 @@ func (s *VMProvider) GetVirtualMachineGuestHeartbeat(ctx context.Context, vm *vm
 	if s.GetVirtualMachineGuestHeartbeatFn != nil {
 		return s.GetVirtualMachineGuestHeartbeatFn(ctx, vm)
 	}
+	if s.conformance {
+		if err := s.getVM(vm); err != nil {
+			return "", err
+		}
+	}
 	return "", nil
 }
 
@@ -158,6 +214,12 @@ func (s *VMProvider) GetVirtualMachineWebMKSTicket(ctx context.Context, vm *vmop
 	if s.GetVirtualMachineWebMKSTicketFn != nil {
 		return s.GetVirtualMachineWebMKSTicketFn(ctx, vm, pubKey)
 	}
+	if s.conformance {
+		if err := s.getVM(vm); err != nil {
+			return "", err
+		}
+		return uuid.NewString(), nil
+	}
 	return "", nil
 }
 
@@ -167,9 +229,101 @@ func (s *VMProvider) GetVirtualMachineHardwareVersion(ctx context.Context, vm *v
 	if s.GetVirtualMachineHardwareVersionFn != nil {
 		return s.GetVirtualMachineHardwareVersionFn(ctx, vm)
 	}
+	if s.conformance {
+		if err := s.getVM(vm); err != nil {
+			return 0, err
+		}
+	}
 	return vimtypes.VMX15, nil
 }
 
+func (s *VMProvider) AuditVirtualMachineDevices(ctx context.Context, vm *vmopv1.VirtualMachine, prune bool) (providers.DeviceAuditResult, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.AuditVirtualMachineDevicesFn != nil {
+		return s.AuditVirtualMachineDevicesFn(ctx, vm, prune)
+	}
+	return providers.DeviceAuditResult{}, nil
+}
+
+func (s *VMProvider) ExportVirtualMachine(ctx context.Context, moID, namespace string) (*vmopv1.VirtualMachine, *vmopv1.VirtualMachineClass, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.ExportVirtualMachineFn != nil {
+		return s.ExportVirtualMachineFn(ctx, moID, namespace)
+	}
+	return nil, nil, nil
+}
+
+func (s *VMProvider) ListManagedVirtualMachines(ctx context.Context) ([]providers.ManagedVirtualMachine, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.ListManagedVirtualMachinesFn != nil {
+		return s.ListManagedVirtualMachinesFn(ctx)
+	}
+
+	var vms []providers.ManagedVirtualMachine
+	for key, vm := range s.vmMap {
+		vms = append(vms, providers.ManagedVirtualMachine{
+			ID:             vm.Status.UniqueID,
+			Name:           vm.Name,
+			InstanceUUID:   vm.Status.InstanceUUID,
+			NamespacedName: key.String(),
+		})
+	}
+	return vms, nil
+}
+
+func (s *VMProvider) ExportVirtualMachineToContentLibrary(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	vmExport *vmopv1.VirtualMachineExportRequest,
+	cl *imgregv1a1.ContentLibrary,
+	actID string) (string, error) {
+
+	s.Lock()
+	defer s.Unlock()
+	if s.ExportVirtualMachineToContentLibraryFn != nil {
+		return s.ExportVirtualMachineToContentLibraryFn(ctx, vm, vmExport, cl, actID)
+	}
+	return "dummy-id", nil
+}
+
+func (s *VMProvider) StartVirtualMachineDiskExport(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine) (string, []vmopv1.VirtualMachineExportRequestFile, error) {
+
+	s.Lock()
+	defer s.Unlock()
+	if s.StartVirtualMachineDiskExportFn != nil {
+		return s.StartVirtualMachineDiskExportFn(ctx, vm)
+	}
+	return "dummy-lease", []vmopv1.VirtualMachineExportRequestFile{
+		{
+			Name: "disk-0.vmdk",
+			URL:  "https://dummy-host/nfc/dummy-lease/disk-0.vmdk",
+		},
+	}, nil
+}
+
+func (s *VMProvider) RenewVirtualMachineDiskExport(ctx context.Context, leaseID string) error {
+	s.Lock()
+	defer s.Unlock()
+	if s.RenewVirtualMachineDiskExportFn != nil {
+		return s.RenewVirtualMachineDiskExportFn(ctx, leaseID)
+	}
+	return nil
+}
+
+func (s *VMProvider) CompleteVirtualMachineDiskExport(ctx context.Context, leaseID string) error {
+	s.Lock()
+	defer s.Unlock()
+	if s.CompleteVirtualMachineDiskExportFn != nil {
+		return s.CompleteVirtualMachineDiskExportFn(ctx, leaseID)
+	}
+	return nil
+}
+
 func (s *VMProvider) CreateOrUpdateVirtualMachineSetResourcePolicy(ctx context.Context, resourcePolicy *vmopv1.VirtualMachineSetResourcePolicy) error {
 	s.Lock()
 	defer s.Unlock()
@@ -177,6 +331,9 @@ func (s *VMProvider) CreateOrUpdateVirtualMachineSetResourcePolicy(ctx context.C
 	if s.CreateOrUpdateVirtualMachineSetResourcePolicyFn != nil {
 		return s.CreateOrUpdateVirtualMachineSetResourcePolicyFn(ctx, resourcePolicy)
 	}
+	if s.conformance && pkgcfg.FromContext(ctx).ObserverMode {
+		return nil
+	}
 	s.addToResourcePolicyMap(resourcePolicy)
 
 	return nil
@@ -205,6 +362,9 @@ func (s *VMProvider) DeleteVirtualMachineSetResourcePolicy(ctx context.Context,
 	if s.DeleteVirtualMachineSetResourcePolicyFn != nil {
 		return s.DeleteVirtualMachineSetResourcePolicyFn(ctx, resourcePolicy)
 	}
+	if s.conformance && pkgcfg.FromContext(ctx).ObserverMode {
+		return nil
+	}
 	s.deleteFromResourcePolicyMap(resourcePolicy)
 
 	return nil
@@ -220,6 +380,16 @@ func (s *VMProvider) ComputeCPUMinFrequency(ctx context.Context) error {
 	return nil
 }
 
+func (s *VMProvider) Diagnostics(ctx context.Context) (providers.Diagnostics, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.DiagnosticsFn != nil {
+		return s.DiagnosticsFn(ctx)
+	}
+
+	return providers.Diagnostics{}, nil
+}
+
 func (s *VMProvider) UpdateVcPNID(ctx context.Context, vcPNID, vcPort string) error {
 	s.Lock()
 	defer s.Unlock()
@@ -272,6 +442,9 @@ func (s *VMProvider) SyncVirtualMachineImage(ctx context.Context, cli, vmi clien
 	if s.SyncVirtualMachineImageFn != nil {
 		return s.SyncVirtualMachineImageFn(ctx, cli, vmi)
 	}
+	if s.conformance {
+		return syncVMI(cli, vmi)
+	}
 
 	return nil
 }
@@ -284,6 +457,9 @@ func (s *VMProvider) GetItemFromLibraryByName(ctx context.Context,
 	if s.GetItemFromLibraryByNameFn != nil {
 		return s.GetItemFromLibraryByNameFn(ctx, contentLibrary, itemName)
 	}
+	if s.conformance {
+		return s.libraryItems[libraryItemKey{libraryID: contentLibrary, itemName: itemName}], nil
+	}
 
 	return nil, nil
 }
@@ -298,6 +474,19 @@ func (s *VMProvider) UpdateContentLibraryItem(ctx context.Context, itemID, newNa
 	return nil
 }
 
+func (s *VMProvider) ImportContentLibraryItem(
+	ctx context.Context,
+	contentLibrary, itemName, sourceURL, sha256 string) error {
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.ImportContentLibraryItemFn != nil {
+		return s.ImportContentLibraryItemFn(ctx, contentLibrary, itemName, sourceURL, sha256)
+	}
+	return nil
+}
+
 func (s *VMProvider) GetTasksByActID(ctx context.Context, actID string) (tasksInfo []vimtypes.TaskInfo, retErr error) {
 	s.Lock()
 	defer s.Unlock()
@@ -320,6 +509,170 @@ func (s *VMProvider) GetTasksByActID(ctx context.Context, actID string) (tasksIn
 	return []vimtypes.TaskInfo{task1}, nil
 }
 
+func (s *VMProvider) CancelTask(ctx context.Context, taskRef vimtypes.ManagedObjectReference) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.CancelTaskFn != nil {
+		return s.CancelTaskFn(ctx, taskRef)
+	}
+	return nil
+}
+
+// createOrUpdateVM simulates creating or updating the VM, populating the VM's
+// status as the vSphere provider would.
+func (s *VMProvider) createOrUpdateVM(ctx context.Context, vm *vmopv1.VirtualMachine) error {
+	if _, ok := s.vmMap[client.ObjectKeyFromObject(vm)]; !ok {
+		if pkgcfg.FromContext(ctx).ObserverMode {
+			pkgcond.MarkFalse(
+				vm,
+				vmopv1.VirtualMachineConditionCreated,
+				ObserverModeReason,
+				"VM is not created in observer mode")
+			return nil
+		}
+		if err := s.getImage(ctx, vm); err != nil {
+			return err
+		}
+	}
+
+	s.addToVMMap(vm)
+
+	if vm.Status.UniqueID == "" {
+		s.nextVMID++
+		vm.Status.UniqueID = fmt.Sprintf("vm-%d", s.nextVMID)
+	}
+	if vm.Status.BiosUUID == "" {
+		vm.Status.BiosUUID = vm.Spec.BiosUUID
+		if vm.Status.BiosUUID == "" {
+			vm.Status.BiosUUID = uuid.NewString()
+		}
+	}
+	if vm.Status.InstanceUUID == "" {
+		vm.Status.InstanceUUID = vm.Spec.InstanceUUID
+		if vm.Status.InstanceUUID == "" {
+			vm.Status.InstanceUUID = uuid.NewString()
+		}
+	}
+	if vm.Spec.PowerState != "" {
+		vm.Status.PowerState = vm.Spec.PowerState
+	}
+	pkgcond.MarkTrue(vm, vmopv1.VirtualMachineConditionCreated)
+
+	return nil
+}
+
+// getImage returns an ImageNotFound error if the VM's image does not exist.
+func (s *VMProvider) getImage(ctx context.Context, vm *vmopv1.VirtualMachine) error {
+	if vm.Spec.Image == nil {
+		return nil
+	}
+
+	var (
+		obj client.Object
+		key = client.ObjectKey{
+			Namespace: vm.Namespace,
+			Name:      vm.Spec.Image.Name,
+		}
+	)
+	switch vm.Spec.Image.Kind {
+	case "ClusterVirtualMachineImage":
+		key.Namespace = ""
+		obj = &vmopv1.ClusterVirtualMachineImage{}
+	default:
+		obj = &vmopv1.VirtualMachineImage{}
+	}
+
+	if err := s.k8sClient.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return providers.NewError(providers.ErrorReasonImageNotFound, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// getVM returns an error if the VM was not created.
+func (s *VMProvider) getVM(vm *vmopv1.VirtualMachine) error {
+	if _, ok := s.vmMap[client.ObjectKeyFromObject(vm)]; !ok {
+		return fmt.Errorf("VM %s does not exist", vm.NamespacedName())
+	}
+	return nil
+}
+
+// publishVM simulates publishing the VM to a new item in the content library.
+func (s *VMProvider) publishVM(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	vmPub *vmopv1.VirtualMachinePublishRequest,
+	cl *imgregv1a1.ContentLibrary,
+	actID string) (string, error) {
+
+	if pkgcfg.FromContext(ctx).ObserverMode {
+		return "", providers.ErrObserverMode
+	}
+	if err := s.getVM(vm); err != nil {
+		return "", err
+	}
+
+	key := libraryItemKey{
+		libraryID: string(cl.Spec.UUID),
+		itemName:  vmPub.Status.TargetRef.Item.Name,
+	}
+	if _, ok := s.libraryItems[key]; ok {
+		return "", fmt.Errorf("item %s already exists in library %s", key.itemName, key.libraryID)
+	}
+
+	s.nextID++
+	item := &library.Item{
+		ID:        fmt.Sprintf("item-%d", s.nextID),
+		Name:      key.itemName,
+		LibraryID: key.libraryID,
+		Type:      library.ItemTypeOVF,
+	}
+	s.libraryItems[key] = item
+	s.AddToVMPublishMap(actID, vimtypes.TaskInfoStateSuccess)
+
+	return item.ID, nil
+}
+
+// syncVMI simulates populating the image's status from an OVF library item.
+func syncVMI(cli, vmi client.Object) error {
+	var itemType imgregv1a1.ContentLibraryItemType
+	switch cli := cli.(type) {
+	case *imgregv1a1.ContentLibraryItem:
+		itemType = cli.Status.Type
+	case *imgregv1a1.ClusterContentLibraryItem:
+		itemType = cli.Status.Type
+	default:
+		return fmt.Errorf("unexpected content library item K8s object type %T", cli)
+	}
+
+	if itemType != imgregv1a1.ContentLibraryItemTypeOvf {
+		return nil
+	}
+
+	var status *vmopv1.VirtualMachineImageStatus
+	switch vmi := vmi.(type) {
+	case *vmopv1.VirtualMachineImage:
+		status = &vmi.Status
+	case *vmopv1.ClusterVirtualMachineImage:
+		status = &vmi.Status
+	default:
+		return fmt.Errorf("unexpected image K8s object type %T", vmi)
+	}
+
+	capacity := resource.MustParse("10Gi")
+	status.Disks = []vmopv1.VirtualMachineImageDiskInfo{
+		{
+			Capacity: &capacity,
+		},
+	}
+	status.HardwareVersion = ptr.To(int32(vimtypes.VMX15))
+
+	return nil
+}
+
 func (s *VMProvider) addToVMMap(vm *vmopv1.VirtualMachine) {
 	objectKey := client.ObjectKey{
 		Namespace: vm.Namespace,
@@ -404,10 +757,25 @@ func NewVMProvider() *VMProvider {
 		vmMap:             map[client.ObjectKey]*vmopv1.VirtualMachine{},
 		resourcePolicyMap: map[client.ObjectKey]*vmopv1.VirtualMachineSetResourcePolicy{},
 		vmPubMap:          map[string]vimtypes.TaskInfoState{},
+		libraryItems:      map[libraryItemKey]*library.Item{},
 	}
 	return &provider
 }
 
+// NewConformanceVMProvider returns a fake provider that passes the provider
+// conformance tests in test/conformance. Unlike the provider returned by
+// NewVMProvider, it populates the status of the VMs it creates or updates,
+// honors observer mode, returns errors for VMs it did not create, simulates
+// publishing VMs and syncing images, and uses the client to look up the images of the VMs
+// it creates, so creating a VM whose image does not exist fails with an
+// ImageNotFound error.
+func NewConformanceVMProvider(k8sClient client.Client) *VMProvider {
+	provider := NewVMProvider()
+	provider.conformance = true
+	provider.k8sClient = k8sClient
+	return provider
+}
+
 func SetCreateOrUpdateFunction(
 	ctx context.Context,
 	provider *VMProvider,
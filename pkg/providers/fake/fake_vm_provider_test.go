@@ -0,0 +1,95 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package fake_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+	"github.com/vmware-tanzu/vm-operator/test/conformance"
+)
+
+const namespace = "fake-provider-ns"
+
+type harness struct {
+	ctx       context.Context
+	k8sClient client.Client
+	provider  *providerfake.VMProvider
+	vms       int
+}
+
+func (h *harness) Context() context.Context {
+	return h.ctx
+}
+
+func (h *harness) Provider() providers.VirtualMachineProviderInterface {
+	return h.provider
+}
+
+func (h *harness) NewVirtualMachine() *vmopv1.VirtualMachine {
+	h.vms++
+	return builder.DummyBasicVirtualMachine(fmt.Sprintf("vm-%d", h.vms), namespace)
+}
+
+// NewVirtualMachineWithErrorReason returns a provider that fails to create
+// VMs with the reason, since the fake provider has no vSphere environment in
+// which to induce the error.
+func (h *harness) NewVirtualMachineWithErrorReason(
+	reason providers.ErrorReason) (providers.VirtualMachineProviderInterface, *vmopv1.VirtualMachine) {
+
+	provider := providerfake.NewConformanceVMProvider(h.k8sClient)
+	provider.CreateOrUpdateVirtualMachineFn = func(
+		_ context.Context,
+		_ *vmopv1.VirtualMachine) error {
+
+		return providers.NewError(reason, errors.New("simulated error"))
+	}
+	return provider, h.NewVirtualMachine()
+}
+
+func (h *harness) ContentLibrary() *imgregv1a1.ContentLibrary {
+	return builder.DummyContentLibrary("fake-cl", namespace, string(uuid.NewUUID()))
+}
+
+func (h *harness) NewContentLibraryItem(
+	itemType imgregv1a1.ContentLibraryItemType) *imgregv1a1.ContentLibraryItem {
+
+	return &imgregv1a1.ContentLibraryItem{
+		Spec: imgregv1a1.ContentLibraryItemSpec{
+			UUID: uuid.NewUUID(),
+		},
+		Status: imgregv1a1.ContentLibraryItemStatus{
+			Type: itemType,
+		},
+	}
+}
+
+func (h *harness) AvailabilityZoneNames() []string {
+	return []string{"fake-zone"}
+}
+
+var _ = conformance.DescribeProvider("Conformance", func() conformance.Harness {
+	image := builder.DummyVirtualMachineImage(builder.DummyVMIName)
+	image.Namespace = namespace
+
+	k8sClient := builder.NewFakeClient(image)
+
+	return &harness{
+		ctx:       pkgcfg.NewContextWithDefaultConfig(),
+		k8sClient: k8sClient,
+		provider:  providerfake.NewConformanceVMProvider(k8sClient),
+	}
+})
@@ -0,0 +1,23 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+// ManagedVirtualMachine describes a VM on the underlying infrastructure that
+// was created by VM Operator.
+type ManagedVirtualMachine struct {
+	// ID is the ID of the VM, ex. the managed object ID of a vSphere VM.
+	ID string
+
+	// Name is the name of the VM.
+	Name string
+
+	// InstanceUUID is the instance UUID of the VM.
+	InstanceUUID string
+
+	// NamespacedName is the namespace and name, in the format
+	// "namespace/name", of the VirtualMachine resource that created the VM.
+	// It is empty if the VM does not record the VirtualMachine resource.
+	NamespacedName string
+}
@@ -28,6 +28,11 @@ var (
 	// CreateOrUpdateVirtualMachine and DeleteVirtualMachine functions when
 	// the VM is still being reconciled in a background thread.
 	ErrReconcileInProgress = errors.New("reconcile already in progress")
+
+	// ErrObserverMode is returned from the functions that would otherwise
+	// perform a mutating operation when the controller manager is running in
+	// the read-only observer mode.
+	ErrObserverMode = errors.New("mutating operations are disabled in observer mode")
 )
 
 // VirtualMachineProviderInterface is a pluggable interface for VM Providers.
@@ -42,6 +47,43 @@ type VirtualMachineProviderInterface interface {
 	GetVirtualMachineWebMKSTicket(ctx context.Context, vm *vmopv1.VirtualMachine, pubKey string) (string, error)
 	GetVirtualMachineHardwareVersion(ctx context.Context, vm *vmopv1.VirtualMachine) (vimtypes.HardwareVersion, error)
 
+	// AuditVirtualMachineDevices returns the names of the devices on the VM
+	// that are not represented in its spec or VM class, ex. USB controllers,
+	// serial ports, or raw disks that were added out-of-band. If prune is
+	// true, then the devices other than disks are also removed from the VM.
+	AuditVirtualMachineDevices(ctx context.Context, vm *vmopv1.VirtualMachine, prune bool) (DeviceAuditResult, error)
+
+	// ExportVirtualMachine returns the VirtualMachine and VirtualMachineClass
+	// resources that most closely match the existing vSphere VM with the
+	// provided managed object ID.
+	ExportVirtualMachine(ctx context.Context, moID, namespace string) (*vmopv1.VirtualMachine, *vmopv1.VirtualMachineClass, error)
+
+	// ListManagedVirtualMachines returns the VMs on the underlying
+	// infrastructure that were created by VM Operator for this Supervisor's
+	// namespaces, including the VMs that no longer have a VirtualMachine
+	// resource.
+	ListManagedVirtualMachines(ctx context.Context) ([]ManagedVirtualMachine, error)
+
+	// ExportVirtualMachineToContentLibrary exports the powered-off VM as an
+	// OVF to a new item in the content library and returns the item's ID. The
+	// export's task is tagged with actID so it may be found with
+	// GetTasksByActID.
+	ExportVirtualMachineToContentLibrary(ctx context.Context, vm *vmopv1.VirtualMachine,
+		vmExport *vmopv1.VirtualMachineExportRequest, cl *imgregv1a1.ContentLibrary, actID string) (string, error)
+
+	// StartVirtualMachineDiskExport acquires an export lease for the
+	// powered-off VM and returns the lease's ID and the files, i.e. the VM's
+	// disks, that may be downloaded while the lease is held.
+	StartVirtualMachineDiskExport(ctx context.Context,
+		vm *vmopv1.VirtualMachine) (string, []vmopv1.VirtualMachineExportRequestFile, error)
+
+	// RenewVirtualMachineDiskExport prevents the export lease from timing out.
+	RenewVirtualMachineDiskExport(ctx context.Context, leaseID string) error
+
+	// CompleteVirtualMachineDiskExport releases the export lease, after which
+	// the files may no longer be downloaded.
+	CompleteVirtualMachineDiskExport(ctx context.Context, leaseID string) error
+
 	CreateOrUpdateVirtualMachineSetResourcePolicy(ctx context.Context, resourcePolicy *vmopv1.VirtualMachineSetResourcePolicy) error
 	IsVirtualMachineSetResourcePolicyReady(ctx context.Context, availabilityZoneName string, resourcePolicy *vmopv1.VirtualMachineSetResourcePolicy) (bool, error)
 	DeleteVirtualMachineSetResourcePolicy(ctx context.Context, resourcePolicy *vmopv1.VirtualMachineSetResourcePolicy) error
@@ -51,11 +93,21 @@ type VirtualMachineProviderInterface interface {
 	ResetVcClient(ctx context.Context)
 	ComputeCPUMinFrequency(ctx context.Context) error
 
+	// Diagnostics returns a sanitized snapshot of the provider's state. It
+	// does not create a vSphere session if one is not already cached.
+	Diagnostics(ctx context.Context) (Diagnostics, error)
+
 	GetItemFromLibraryByName(ctx context.Context, contentLibrary, itemName string) (*library.Item, error)
 	UpdateContentLibraryItem(ctx context.Context, itemID, newName string, newDescription *string) error
+
+	// ImportContentLibraryItem imports the OVF or OVA file at the source URL
+	// into the content library item with the specified name, creating the
+	// item if it does not exist. The file's SHA-256 checksum is optional.
+	ImportContentLibraryItem(ctx context.Context, contentLibrary, itemName, sourceURL, sha256 string) error
 	SyncVirtualMachineImage(ctx context.Context, cli, vmi ctrlclient.Object) error
 
 	GetTasksByActID(ctx context.Context, actID string) (tasksInfo []vimtypes.TaskInfo, retErr error)
+	CancelTask(ctx context.Context, taskRef vimtypes.ManagedObjectReference) error
 
 	// DoesProfileSupportEncryption returns true if the specified profile
 	// supports encryption by checking whether or not the underlying policy
@@ -6,7 +6,9 @@ package client
 
 import (
 	"context"
+	"fmt"
 
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
 )
@@ -22,14 +24,18 @@ func NewClient(
 	ctx context.Context,
 	config *config.VSphereVMProviderConfig) (*Client, error) {
 
+	pkgConfig := pkgcfg.FromContext(ctx)
+
 	c, err := client.NewClient(ctx, client.Config{
-		Host:       config.VcPNID,
-		Port:       config.VcPort,
-		Username:   config.VcCreds.Username,
-		Password:   config.VcCreds.Password,
-		CAFilePath: config.CAFilePath,
-		Insecure:   config.InsecureSkipTLSVerify,
-		Datacenter: config.Datacenter,
+		Host:        config.VcPNID,
+		Port:        config.VcPort,
+		Username:    config.VcCreds.Username,
+		Password:    config.VcCreds.Password,
+		CAFilePath:  config.CAFilePath,
+		Insecure:    config.InsecureSkipTLSVerify,
+		Datacenter:  config.Datacenter,
+		UserAgent:   UserAgent(pkgConfig),
+		RestTimeout: pkgConfig.RestClientTimeout,
 	})
 
 	if err != nil {
@@ -42,6 +48,18 @@ func NewClient(
 	}, nil
 }
 
+// UserAgent returns the user agent used by the vSphere clients so calls made
+// by VM Operator may be attributed in the vCenter logs, ex.
+// "vm-operator/v1.8.6 (commit abc123; vmware-system-vmop/vmop-controller-manager-0)".
+func UserAgent(cfg pkgcfg.Config) string {
+	version := cfg.BuildVersion
+	if version == "" {
+		version = "unknown"
+	}
+	return fmt.Sprintf("vm-operator/%s (commit %s; %s/%s)",
+		version, cfg.BuildCommit, cfg.PodNamespace, cfg.PodName)
+}
+
 func (c *Client) Config() *config.VSphereVMProviderConfig {
 	return c.config
 }
@@ -119,6 +119,22 @@ var _ = Describe("Client", Label(testlabels.VCSim), Ordered /* Avoided race for
 				Expect(err).ToNot(HaveOccurred())
 				Expect(c).ToNot(BeNil())
 			})
+
+			It("should identify itself with the user agent", func() {
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.BuildVersion = "v1.2.3"
+					config.BuildCommit = "abc123"
+					config.PodNamespace = "my-namespace"
+					config.PodName = "my-pod"
+				})
+
+				c, err := client.NewClient(ctx, cfg)
+				Expect(err).ToNot(HaveOccurred())
+
+				const expected = "vm-operator/v1.2.3 (commit abc123; my-namespace/my-pod)"
+				Expect(c.VimClient().UserAgent).To(Equal(expected))
+				Expect(c.RestClient().UserAgent).To(Equal(expected))
+			})
 		})
 
 		When("username and password are invalid", func() {
@@ -6,6 +6,7 @@ package config
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -38,6 +39,12 @@ type VSphereVMProviderConfig struct {
 	ResourcePool string
 	Folder       string
 
+	// ZoneContentLibraries maps the name of a zone to the UUID of the content
+	// library co-located with the zone's clusters. When an image's library
+	// item has a same named item in the zone's library, the VM is deployed
+	// from the zone's library to avoid a cross-site OVF transfer.
+	ZoneContentLibraries map[string]string
+
 	// Only set in simulated testing env.
 	Datastore string
 	Network   string
@@ -60,6 +67,11 @@ const (
 	useInventoryKey          = "UseInventoryAsContentSource"
 	insecureSkipTLSVerifyKey = "InsecureSkipTLSVerify"
 	caFilePathKey            = "CAFilePath"
+	zoneContentLibrariesKey  = "ZoneContentLibraries"
+
+	// DefaultNamedNetwork is the default network used by the named network
+	// provider when the provider ConfigMap does not specify one.
+	DefaultNamedNetwork = "VM Network"
 
 	NetworkConfigMapName = "vmoperator-network-config"
 	NameserversKey       = "nameservers"    // Key in the NetworkConfigMapName.
@@ -116,6 +128,13 @@ func ConfigMapToProviderConfig( //nolint: revive // Ignore linter error about st
 		caFilePath = ca
 	}
 
+	var zoneContentLibraries map[string]string
+	if v := configMap.Data[zoneContentLibrariesKey]; v != "" {
+		if err := json.Unmarshal([]byte(v), &zoneContentLibraries); err != nil {
+			return nil, fmt.Errorf("unable to parse value of ZoneContentLibraries: %w", err)
+		}
+	}
+
 	ret := &VSphereVMProviderConfig{
 		VcPNID:                      vcPNID,
 		VcPort:                      vcPort,
@@ -129,6 +148,7 @@ func ConfigMapToProviderConfig( //nolint: revive // Ignore linter error about st
 		UseInventoryAsContentSource: useInventory,
 		InsecureSkipTLSVerify:       insecureSkipTLSVerify,
 		CAFilePath:                  caFilePath,
+		ZoneContentLibraries:        zoneContentLibraries,
 	}
 
 	return ret, nil
@@ -192,6 +212,24 @@ func getProviderConfigMap(
 	return configMap, nil
 }
 
+// GetDefaultNamedNetwork returns the name of the default network used by the
+// named network provider.
+func GetDefaultNamedNetwork(
+	ctx context.Context,
+	client ctrlclient.Client) (string, error) {
+
+	configMap, err := getProviderConfigMap(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	if name := configMap.Data[networkNameKey]; name != "" {
+		return name, nil
+	}
+
+	return DefaultNamedNetwork, nil
+}
+
 // GetProviderConfig returns a provider config constructed from vSphere Provider ConfigMap in the VM Operator namespace.
 func GetProviderConfig(
 	ctx context.Context,
@@ -231,6 +269,10 @@ func setConfigMapData(configMap *corev1.ConfigMap, config *VSphereVMProviderConf
 	configMap.Data[useInventoryKey] = strconv.FormatBool(config.UseInventoryAsContentSource)
 	configMap.Data[caFilePathKey] = config.CAFilePath
 	configMap.Data[insecureSkipTLSVerifyKey] = strconv.FormatBool(config.InsecureSkipTLSVerify)
+	if len(config.ZoneContentLibraries) > 0 {
+		data, _ := json.Marshal(config.ZoneContentLibraries)
+		configMap.Data[zoneContentLibrariesKey] = string(data)
+	}
 }
 
 // ProviderConfigToConfigMap returns the ConfigMap for the config.
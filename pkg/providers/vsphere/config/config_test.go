@@ -159,6 +159,39 @@ func configTests() {
 			Expect(searchSuffixes).To(ConsistOf("vmware.com", "google.com"))
 		})
 	})
+
+	Describe("GetDefaultNamedNetwork", func() {
+		var cm *corev1.ConfigMap
+
+		JustBeforeEach(func() {
+			// Note that NewTestContextForVCSim() creates this CM.
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      config.ProviderConfigMapName,
+					Namespace: ctx.PodNamespace,
+				},
+			}
+			Expect(ctx.Client.Get(ctx, ctrlclient.ObjectKeyFromObject(cm), cm)).To(Succeed())
+		})
+
+		It("returns the network from the ConfigMap", func() {
+			cm.Data["Network"] = "my-network"
+			Expect(ctx.Client.Update(ctx, cm)).To(Succeed())
+
+			name, err := config.GetDefaultNamedNetwork(ctx, ctx.Client)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("my-network"))
+		})
+
+		It("returns the default network when the ConfigMap does not have one", func() {
+			delete(cm.Data, "Network")
+			Expect(ctx.Client.Update(ctx, cm)).To(Succeed())
+
+			name, err := config.GetDefaultNamedNetwork(ctx, ctx.Client)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal(config.DefaultNamedNetwork))
+		})
+	})
 }
 
 var _ = Describe("ConfigMapToProviderConfig", func() {
@@ -226,6 +259,37 @@ var _ = Describe("ConfigMapToProviderConfig", func() {
 		})
 	})
 
+	Context("ZoneContentLibraries", func() {
+		It("ZoneContentLibraries is unset in configMap", func() {
+			providerConfig, err := config.ConfigMapToProviderConfig(configMap, providerCreds)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(providerConfig.ZoneContentLibraries).To(BeEmpty())
+		})
+
+		Context("ZoneContentLibraries is set in configMap", func() {
+			BeforeEach(func() {
+				providerConfigIn.ZoneContentLibraries = map[string]string{
+					"zone-1": "library-1",
+					"zone-2": "library-2",
+				}
+			})
+
+			It("ZoneContentLibraries is set in config", func() {
+				providerConfig, err := config.ConfigMapToProviderConfig(configMap, providerCreds)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(providerConfig.ZoneContentLibraries).To(Equal(providerConfigIn.ZoneContentLibraries))
+			})
+		})
+
+		Context("ZoneContentLibraries is invalid in configMap", func() {
+			It("returns an error", func() {
+				configMap.Data["ZoneContentLibraries"] = "zone-1=library-1"
+				_, err := config.ConfigMapToProviderConfig(configMap, providerCreds)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("Tests for TLS configuration", func() {
 
 		Context("when no TLS configuration is specified", func() {
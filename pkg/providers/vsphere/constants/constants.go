@@ -102,6 +102,12 @@ const (
 	// VPCAttachmentRef annotation key is for VPC SubnetPort to get virtual machine name.
 	VPCAttachmentRef = "nsx.vmware.com/attachment_ref"
 
+	// SecurityRulesAnnotation is the annotation key on a VirtualMachine whose
+	// value is a JSON list of L3/L4 firewall rules for the VM's network
+	// interfaces. The rules are realized as a NSX SecurityPolicy when the
+	// network provider is VPC.
+	SecurityRulesAnnotation = pkg.VMOperatorKey + "/security-rules"
+
 	// XsiNamespace indicates the XML scheme instance namespace.
 	XsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
 	// ConfigSpecProviderXML indicates XML as the config spec transport type for virtual machine deployment.
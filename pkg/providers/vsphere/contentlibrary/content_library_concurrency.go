@@ -0,0 +1,104 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package contentlibrary
+
+import (
+	"context"
+	"sync"
+)
+
+// downloadLimiter limits the number of concurrent downloads from content
+// library. The limiter is shared by all providers since they all use the same
+// vCenter session.
+var downloadLimiter = &limiter{}
+
+// limiter is a semaphore whose size may change. Callers that acquired the
+// semaphore before the size changed release it to the semaphore they acquired.
+type limiter struct {
+	mu  sync.Mutex
+	sem chan struct{}
+}
+
+// acquire blocks until the semaphore is acquired or the context is done. The
+// returned function releases the semaphore. If limit is not positive, then
+// there is no limit.
+func (l *limiter) acquire(ctx context.Context, limit int) (func(), error) {
+	if limit <= 0 {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	if l.sem == nil || cap(l.sem) != limit {
+		l.sem = make(chan struct{}, limit)
+	}
+	sem := l.sem
+	l.mu.Unlock()
+
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// ovfDownloadCalls coalesces the concurrent downloads of the same library
+// item's OVF.
+var ovfDownloadCalls = &callGroup{}
+
+type call struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// callGroup ensures there is only one in-flight call for a key. Callers for a
+// key that already has an in-flight call wait for and share its result.
+type callGroup struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+// do executes fn if there is not an in-flight call for key, otherwise it waits
+// for the in-flight call to complete. The result of fn is returned along with
+// whether the result was shared with another caller. The returned data must
+// not be modified since it is shared by the callers.
+//
+// The context passed to fn is detached from the cancellation of the caller
+// that started the call, since other callers may be waiting for its result. A
+// caller whose context is done stops waiting and returns the context's error,
+// but the call continues for the other callers.
+func (g *callGroup) do(
+	ctx context.Context,
+	key string,
+	fn func(context.Context) ([]byte, error)) ([]byte, bool, error) {
+
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = map[string]*call{}
+	}
+	c, shared := g.calls[key]
+	if !shared {
+		c = &call{done: make(chan struct{})}
+		g.calls[key] = c
+
+		go func() {
+			c.data, c.err = fn(context.WithoutCancel(ctx))
+
+			g.mu.Lock()
+			delete(g.calls, key)
+			g.mu.Unlock()
+			close(c.done)
+		}()
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.data, shared, c.err
+	case <-ctx.Done():
+		return nil, shared, ctx.Err()
+	}
+}
@@ -0,0 +1,188 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package contentlibrary
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("limiter", func() {
+	var (
+		ctx context.Context
+		l   *limiter
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		l = &limiter{}
+	})
+
+	It("should not limit when the limit is not positive", func() {
+		for i := 0; i < 5; i++ {
+			_, err := l.acquire(ctx, 0)
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should block once the limit is reached until released", func() {
+		release1, err := l.acquire(ctx, 2)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = l.acquire(ctx, 2)
+		Expect(err).ToNot(HaveOccurred())
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err = l.acquire(cancelCtx, 2)
+		Expect(err).To(MatchError(context.Canceled))
+
+		release1()
+		_, err = l.acquire(ctx, 2)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should use the new limit when it changes", func() {
+		_, err := l.acquire(ctx, 1)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = l.acquire(ctx, 2)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cap(l.sem)).To(Equal(2))
+	})
+})
+
+// waitCountingContext counts the calls to Done so a test can tell when the
+// callers of callGroup.do are waiting for the result of a call.
+type waitCountingContext struct {
+	context.Context
+	waits *atomic.Int32
+}
+
+func (c waitCountingContext) Done() <-chan struct{} {
+	c.waits.Add(1)
+	return c.Context.Done()
+}
+
+var _ = Describe("callGroup", func() {
+	var (
+		ctx   context.Context
+		waits *atomic.Int32
+		g     *callGroup
+	)
+
+	BeforeEach(func() {
+		waits = &atomic.Int32{}
+		ctx = waitCountingContext{Context: context.Background(), waits: waits}
+		g = &callGroup{}
+	})
+
+	It("should coalesce concurrent calls for the same key", func() {
+		const numCallers = 5
+
+		var (
+			calls   atomic.Int32
+			shared  atomic.Int32
+			unblock = make(chan struct{})
+			wg      sync.WaitGroup
+			results = make([][]byte, numCallers)
+		)
+
+		fn := func(context.Context) ([]byte, error) {
+			calls.Add(1)
+			<-unblock
+			return []byte("ovf"), nil
+		}
+
+		for i := 0; i < numCallers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				data, s, err := g.do(ctx, "item", fn)
+				Expect(err).ToNot(HaveOccurred())
+				if s {
+					shared.Add(1)
+				}
+				results[i] = data
+			}(i)
+		}
+
+		// Wait for all of the callers to wait for the call's result.
+		Eventually(waits.Load).Should(Equal(int32(numCallers)))
+
+		close(unblock)
+		wg.Wait()
+
+		Expect(calls.Load()).To(Equal(int32(1)))
+		Expect(shared.Load()).To(Equal(int32(numCallers - 1)))
+		for i := range results {
+			Expect(results[i]).To(Equal([]byte("ovf")))
+		}
+		Expect(g.calls).To(BeEmpty())
+	})
+
+	It("should not coalesce calls for different keys", func() {
+		var calls int
+		fn := func(context.Context) ([]byte, error) {
+			calls++
+			return nil, errors.New("fake")
+		}
+
+		_, shared, err := g.do(ctx, "item-1", fn)
+		Expect(err).To(MatchError("fake"))
+		Expect(shared).To(BeFalse())
+		_, shared, err = g.do(ctx, "item-2", fn)
+		Expect(err).To(MatchError("fake"))
+		Expect(shared).To(BeFalse())
+		Expect(calls).To(Equal(2))
+	})
+
+	It("should not cancel the call when the caller that started it gives up", func() {
+		var (
+			unblock = make(chan struct{})
+			fnErr   = make(chan error, 1)
+		)
+
+		fn := func(ctx context.Context) ([]byte, error) {
+			<-unblock
+			fnErr <- ctx.Err()
+			return []byte("ovf"), nil
+		}
+
+		cancelCtx, cancel := context.WithCancel(context.Background())
+		leaderCtx := waitCountingContext{Context: cancelCtx, waits: waits}
+		leaderDone := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(leaderDone)
+			_, shared, err := g.do(leaderCtx, "item", fn)
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(shared).To(BeFalse())
+		}()
+		Eventually(waits.Load).Should(Equal(int32(1)))
+
+		cancel()
+		Eventually(leaderDone).Should(BeClosed())
+
+		followerDone := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(followerDone)
+			data, shared, err := g.do(ctx, "item", fn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shared).To(BeTrue())
+			Expect(data).To(Equal([]byte("ovf")))
+		}()
+		Eventually(waits.Load).Should(Equal(int32(2)))
+
+		close(unblock)
+		Eventually(followerDone).Should(BeClosed())
+		Expect(<-fnErr).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,174 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package contentlibrary
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"  //nolint:gosec // used to validate content library checksums
+	"crypto/sha1" //nolint:gosec // used to validate content library checksums
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vim25/soap"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// restSessionHeader is the header used to authenticate requests with the
+// session of the REST client.
+const restSessionHeader = "vmware-api-session-id"
+
+// downloadBackoff is the backoff between the attempts to download a file from
+// a library item.
+var downloadBackoff = wait.Backoff{
+	Duration: 1 * time.Second,
+	Factor:   2,
+	Jitter:   0.1,
+	Steps:    5,
+}
+
+// downloadFile downloads the prepared file from a library item. A failed
+// download is retried with backoff and, if the server supports it, resumed
+// from where the previous attempt left off. Each attempt is limited to the
+// provider's download timeout. If the file has a checksum, then the checksum
+// of the downloaded content must match or the file is downloaded again.
+func (cs *provider) downloadFile(
+	ctx context.Context,
+	logger logr.Logger,
+	file *library.DownloadFile) ([]byte, error) {
+
+	fileURL, err := url.Parse(file.DownloadEndpoint.URI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid download endpoint for file %s: %w", file.Name, err)
+	}
+
+	var (
+		buf     bytes.Buffer
+		lastErr error
+	)
+
+	err = wait.ExponentialBackoffWithContext(ctx, downloadBackoff, func(ctx context.Context) (bool, error) {
+		if lastErr != nil {
+			logger.Info("Retrying file download",
+				"fileName", file.Name, "offset", buf.Len(), "lastErr", lastErr.Error())
+		}
+
+		if lastErr = cs.downloadFileAttempt(ctx, fileURL, &buf); lastErr != nil {
+			// Log message used by VMC LINT. Refer to before making changes
+			log.Error(lastErr, "Error occurred when downloading file", "url", fileURL)
+			return false, nil
+		}
+
+		if file.Size > 0 && int64(buf.Len()) != file.Size {
+			lastErr = fmt.Errorf("downloaded %d bytes, expected %d", buf.Len(), file.Size)
+			buf.Reset()
+			return false, nil
+		}
+
+		if lastErr = validateChecksum(buf.Bytes(), file.Checksum); lastErr != nil {
+			buf.Reset()
+			return false, nil
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		if lastErr != nil && wait.Interrupted(err) {
+			err = lastErr
+		}
+		return nil, fmt.Errorf("failed to download file %s: %w", file.Name, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// downloadFileAttempt downloads the file into buf, requesting only the bytes
+// not already in buf. If the server does not honor the range request, then buf
+// is reset and the entire file is downloaded.
+func (cs *provider) downloadFileAttempt(
+	ctx context.Context,
+	fileURL *url.URL,
+	buf *bytes.Buffer) error {
+
+	if cs.downloadTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cs.downloadTimeout)
+		defer cancel()
+	}
+
+	c := cs.libMgr.Client
+
+	// The REST client's Download function fails on a partial response, so the
+	// request is made directly with the same authentication header.
+	param := soap.DefaultDownload
+	param.Headers = map[string]string{
+		restSessionHeader: c.SessionID(),
+	}
+	if buf.Len() > 0 {
+		param.Headers["Range"] = fmt.Sprintf("bytes=%d-", buf.Len())
+	}
+
+	res, err := c.DownloadRequest(ctx, fileURL, &param)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		buf.Reset()
+	case http.StatusPartialContent:
+	default:
+		return fmt.Errorf("download(%s): %s", fileURL, res.Status)
+	}
+
+	_, err = io.Copy(buf, res.Body)
+	return err
+}
+
+// validateChecksum returns an error if the checksum of data does not match the
+// expected checksum. Nil is returned if there is no expected checksum or its
+// algorithm is not supported.
+func validateChecksum(data []byte, checksum *library.Checksum) error {
+	if checksum == nil || checksum.Checksum == "" {
+		return nil
+	}
+
+	var h hash.Hash
+	switch strings.ToUpper(checksum.Algorithm) {
+	case "", "SHA1":
+		h = sha1.New() //nolint:gosec // used to validate content library checksums
+	case "MD5":
+		h = md5.New() //nolint:gosec // used to validate content library checksums
+	case "SHA256":
+		h = sha256.New()
+	case "SHA512":
+		h = sha512.New()
+	default:
+		return nil
+	}
+
+	_, _ = h.Write(data)
+
+	if actual := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(actual, checksum.Checksum) {
+		return fmt.Errorf("%s checksum mismatch: expected %s, got %s",
+			checksum.Algorithm, checksum.Checksum, actual)
+	}
+
+	return nil
+}
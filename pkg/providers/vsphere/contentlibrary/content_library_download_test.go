@@ -0,0 +1,137 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package contentlibrary
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-logr/logr"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var _ = Describe("downloadFile", func() {
+	var (
+		ctx      context.Context
+		content  []byte
+		requests atomic.Int32
+		handler  http.HandlerFunc
+		server   *httptest.Server
+		file     *library.DownloadFile
+		cs       *provider
+		saved    wait.Backoff
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		content = bytes.Repeat([]byte("<Envelope/>"), 1024)
+		requests.Store(0)
+
+		saved = downloadBackoff
+		downloadBackoff = wait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}
+
+		sum := sha256.Sum256(content)
+		file = &library.DownloadFile{
+			Name: "item.ovf",
+			Size: int64(len(content)),
+			Checksum: &library.Checksum{
+				Algorithm: "SHA256",
+				Checksum:  hex.EncodeToString(sum[:]),
+			},
+		}
+
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			http.ServeContent(w, r, file.Name, time.Time{}, bytes.NewReader(content))
+		}
+	})
+
+	JustBeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			handler(w, r)
+		}))
+
+		u, err := url.Parse(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		vimClient := &vim25.Client{Client: soap.NewClient(u, true)}
+
+		cs = newProvider(rest.NewClient(vimClient))
+		file.DownloadEndpoint = &library.TransferEndpoint{URI: server.URL + "/item.ovf"}
+	})
+
+	AfterEach(func() {
+		server.Close()
+		downloadBackoff = saved
+	})
+
+	It("downloads the file and validates its checksum", func() {
+		data, err := cs.downloadFile(ctx, logr.Discard(), file)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal(content))
+		Expect(requests.Load()).To(BeEquivalentTo(1))
+	})
+
+	When("the first attempt fails after a partial download", func() {
+		BeforeEach(func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				if requests.Load() == 1 {
+					// Send half of the content and then drop the connection.
+					w.Header().Set("Content-Length", "999999")
+					_, _ = w.Write(content[:len(content)/2])
+					panic(http.ErrAbortHandler)
+				}
+				Expect(r.Header.Get("Range")).To(Equal("bytes=5632-"))
+				http.ServeContent(w, r, file.Name, time.Time{}, bytes.NewReader(content))
+			}
+		})
+
+		It("resumes the download", func() {
+			data, err := cs.downloadFile(ctx, logr.Discard(), file)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal(content))
+			Expect(requests.Load()).To(BeEquivalentTo(2))
+		})
+	})
+
+	When("the checksum does not match", func() {
+		BeforeEach(func() {
+			file.Checksum.Checksum = "0123"
+		})
+
+		It("retries and then returns an error", func() {
+			_, err := cs.downloadFile(ctx, logr.Discard(), file)
+			Expect(err).To(MatchError(ContainSubstring("checksum mismatch")))
+			Expect(requests.Load()).To(BeEquivalentTo(3))
+		})
+	})
+
+	When("the server returns an error", func() {
+		BeforeEach(func() {
+			handler = func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		})
+
+		It("retries and then returns an error", func() {
+			_, err := cs.downloadFile(ctx, logr.Discard(), file)
+			Expect(err).To(MatchError(ContainSubstring("503")))
+			Expect(requests.Load()).To(BeEquivalentTo(3))
+		})
+	})
+})
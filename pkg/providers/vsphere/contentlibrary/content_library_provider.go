@@ -5,11 +5,9 @@
 package contentlibrary
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"io"
-	"net/url"
-	"os"
 	"path/filepath"
 	"time"
 
@@ -22,7 +20,6 @@ import (
 	"github.com/vmware/govmomi/vapi/library"
 	"github.com/vmware/govmomi/vapi/library/finder"
 	"github.com/vmware/govmomi/vapi/rest"
-	"github.com/vmware/govmomi/vim25/soap"
 
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/util"
@@ -38,6 +35,10 @@ type Provider interface {
 	RetrieveOvfEnvelopeFromLibraryItem(ctx context.Context, item *library.Item) (*ovf.Envelope, error)
 	RetrieveOvfEnvelopeByLibraryItemID(ctx context.Context, itemID string) (*ovf.Envelope, error)
 	SyncLibraryItem(ctx context.Context, item *library.Item, force bool) error
+	SyncLibraryItemAndWait(ctx context.Context, item *library.Item, timeout time.Duration) (*library.Item, error)
+	UploadLibraryItem(ctx context.Context, item library.Item, files []UploadFile) (string, error)
+	ImportLibraryItem(ctx context.Context, item library.Item, files []ImportFile) (string, error)
+	ListLibraryItemFiles(ctx context.Context, itemID string) ([]library.File, error)
 	ListLibraryItemStorage(ctx context.Context, itemID string) ([]library.Storage, error)
 	ResolveLibraryItemStorage(ctx context.Context, datacenter *object.Datacenter, storage []library.Storage) error
 
@@ -46,14 +47,53 @@ type Provider interface {
 }
 
 type provider struct {
-	libMgr        *library.Manager
-	retryInterval time.Duration
+	libMgr          *library.Manager
+	downloadTimeout time.Duration
+	maxDownloads    int
 }
 
 const (
-	DefaultContentLibAPIWaitSecs = 5
+	// DefaultSyncLibraryItemTimeout is used by SyncLibraryItemAndWait when the
+	// provided timeout is not positive.
+	DefaultSyncLibraryItemTimeout = 30 * time.Minute
+
+	// DefaultContentDownloadTimeout is the default timeout for each attempt
+	// to download a file from a library item.
+	DefaultContentDownloadTimeout = 5 * time.Minute
+
+	// prepareDownloadFileTimeout is how long to wait for a file in a download
+	// session to be prepared.
+	prepareDownloadFileTimeout = 5 * time.Minute
+
+	// completeUpdateSessionTimeout is how long to wait for a completed update
+	// session to be done.
+	completeUpdateSessionTimeout = 5 * time.Minute
 )
 
+// waitBackoff is the backoff between checks of the state of a library item or
+// session. The delay grows from 100ms until it is capped at 5s.
+var waitBackoff = wait.Backoff{
+	Duration: 100 * time.Millisecond,
+	Factor:   2,
+	Jitter:   0.1,
+	Steps:    10,
+	Cap:      5 * time.Second,
+}
+
+// waitForState invokes the condition with the delay between invocations
+// backing off per waitBackoff, until the condition returns true or an error,
+// or until the timeout elapses or the context is done.
+func waitForState(
+	ctx context.Context,
+	timeout time.Duration,
+	condition wait.ConditionWithContextFunc) error {
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return waitBackoff.DelayFunc().Until(ctx, true, false, condition)
+}
+
 func IsSupportedDeployType(t string) bool {
 	switch t {
 	case library.ItemTypeVMTX, library.ItemTypeOVF:
@@ -65,20 +105,19 @@ func IsSupportedDeployType(t string) bool {
 }
 
 func NewProvider(ctx context.Context, restClient *rest.Client) Provider {
-	var waitSeconds int
-	if w := pkgcfg.FromContext(ctx).ContentAPIWait; w > 0 {
-		waitSeconds = int(w.Seconds())
-	} else {
-		waitSeconds = DefaultContentLibAPIWaitSecs
+	p := newProvider(restClient)
+	if t := pkgcfg.FromContext(ctx).ContentDownloadTimeout; t > 0 {
+		p.downloadTimeout = t
 	}
+	p.maxDownloads = pkgcfg.FromContext(ctx).MaxContentLibraryDownloads
 
-	return NewProviderWithWaitSec(restClient, waitSeconds)
+	return p
 }
 
-func NewProviderWithWaitSec(restClient *rest.Client, waitSeconds int) Provider {
+func newProvider(restClient *rest.Client) *provider {
 	return &provider{
-		libMgr:        library.NewManager(restClient),
-		retryInterval: time.Duration(waitSeconds) * time.Second,
+		libMgr:          library.NewManager(restClient),
+		downloadTimeout: DefaultContentDownloadTimeout,
 	}
 }
 
@@ -172,21 +211,43 @@ func (cs *provider) RetrieveOvfEnvelopeByLibraryItemID(ctx context.Context, item
 	return cs.RetrieveOvfEnvelopeFromLibraryItem(ctx, libItem)
 }
 
-func readerFromURL(ctx context.Context, c *rest.Client, url *url.URL) (io.ReadCloser, error) {
-	p := soap.DefaultDownload
-	readerStream, _, err := c.Download(ctx, url, &p)
+// RetrieveOvfEnvelopeFromLibraryItem downloads the supported file from content library.
+// parses the downloaded ovf and returns the OVF Envelope descriptor for consumption.
+// Concurrent retrievals of the same item's content are coalesced into a single
+// download, and the number of concurrent downloads is limited by
+// MaxContentLibraryDownloads. Each caller parses its own OVF Envelope from the
+// shared download, so callers may modify the returned OVF Envelope.
+func (cs *provider) RetrieveOvfEnvelopeFromLibraryItem(ctx context.Context, item *library.Item) (*ovf.Envelope, error) {
+	key := item.ID + ":" + item.ContentVersion
+	downloadedFileContent, shared, err := ovfDownloadCalls.do(ctx, key, func(ctx context.Context) ([]byte, error) {
+		release, err := downloadLimiter.acquire(ctx, cs.maxDownloads)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return cs.downloadOvfFromLibraryItem(ctx, item)
+	})
+	if shared {
+		log.V(4).Info("shared OVF download for library item",
+			"itemID", item.ID, "itemName", item.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The file's checksum, if any, was validated by the download, so an error
+	// here means the OVF itself is invalid.
+	envelope, err := ovf.Unmarshal(bytes.NewReader(downloadedFileContent))
 	if err != nil {
-		// Log message used by VMC LINT. Refer to before making changes
-		log.Error(err, "Error occurred when downloading file", "url", url)
+		log.Error(err, "error parsing the OVF envelope", "itemID", item.ID, "itemName", item.Name)
 		return nil, err
 	}
 
-	return readerStream, nil
+	return envelope, nil
 }
 
-// RetrieveOvfEnvelopeFromLibraryItem downloads the supported file from content library.
-// parses the downloaded ovf and returns the OVF Envelope descriptor for consumption.
-func (cs *provider) RetrieveOvfEnvelopeFromLibraryItem(ctx context.Context, item *library.Item) (*ovf.Envelope, error) {
+func (cs *provider) downloadOvfFromLibraryItem(ctx context.Context, item *library.Item) ([]byte, error) {
 	// Create a download session for the file referred to by item id.
 	sessionID, err := cs.libMgr.CreateLibraryItemDownloadSession(ctx, library.Session{LibraryItemID: item.ID})
 	if err != nil {
@@ -203,30 +264,20 @@ func (cs *provider) RetrieveOvfEnvelopeFromLibraryItem(ctx context.Context, item
 	}()
 
 	// Download ovf from the library item.
-	fileURL, err := cs.generateDownloadURLForLibraryItem(ctx, logger, sessionID, item)
+	file, err := cs.prepareDownloadFileForLibraryItem(ctx, logger, sessionID, item)
 	if err != nil {
 		return nil, err
 	}
 
-	downloadedFileContent, err := readerFromURL(ctx, cs.libMgr.Client, fileURL)
+	downloadedFileContent, err := cs.downloadFile(ctx, logger, file)
 	if err != nil {
 		logger.Error(err, "error downloading file from library item")
 		return nil, err
 	}
 
 	logger.V(4).Info("downloaded library item")
-	defer func() {
-		_ = downloadedFileContent.Close()
-	}()
-
-	// OVF file is validated during upload, err here can be internet error.
-	envelope, err := ovf.Unmarshal(downloadedFileContent)
-	if err != nil {
-		logger.Error(err, "error parsing the OVF envelope")
-		return nil, err
-	}
 
-	return envelope, nil
+	return downloadedFileContent, nil
 }
 
 // UpdateLibraryItem updates the content library item's name and description.
@@ -260,73 +311,66 @@ func (cs *provider) SyncLibraryItem(
 	return cs.libMgr.SyncLibraryItem(ctx, item, force)
 }
 
-// Only used in testing.
-func (cs *provider) CreateLibraryItem(ctx context.Context, libraryItem library.Item, path string) error {
-	log.Info("Creating Library Item", "item", libraryItem, "path", path)
+// SyncLibraryItemAndWait syncs a subscribed library item whose content is not
+// cached, ex. an item in a library that downloads content on-demand, and waits
+// up to the timeout for the item's content to be cached. The item is returned
+// as-is if its content is already cached, otherwise the synced item is
+// returned.
+func (cs *provider) SyncLibraryItemAndWait(
+	ctx context.Context,
+	item *library.Item,
+	timeout time.Duration) (*library.Item, error) {
 
-	itemID, err := cs.libMgr.CreateLibraryItem(ctx, libraryItem)
-	if err != nil {
-		return err
+	if item.Cached {
+		return item, nil
 	}
-
-	sessionID, err := cs.libMgr.CreateLibraryItemUpdateSession(ctx, library.Session{LibraryItemID: itemID})
-	if err != nil {
-		return err
+	if timeout <= 0 {
+		timeout = DefaultSyncLibraryItemTimeout
 	}
 
-	// Update Library item with library file "ovf"
-	uploadFunc := func(c *rest.Client, path string) error {
-		f, err := os.Open(filepath.Clean(path))
-		if err != nil {
-			return err
-		}
-		defer func() {
-			_ = f.Close()
-		}()
-
-		fi, err := f.Stat()
-		if err != nil {
-			return err
-		}
-
-		info := library.UpdateFile{
-			Name:       filepath.Base(path),
-			SourceType: "PUSH",
-			Size:       fi.Size(),
-		}
+	logger := logr.FromContextOrDiscard(ctx).WithValues("itemID", item.ID, "itemName", item.Name)
+	logger.Info("Syncing library item that is not cached")
 
-		update, err := cs.libMgr.AddLibraryItemFile(ctx, sessionID, info)
-		if err != nil {
-			return err
-		}
+	if err := cs.libMgr.SyncLibraryItem(ctx, item, true); err != nil {
+		return nil, fmt.Errorf("failed to sync library item %s: %w", item.ID, err)
+	}
 
-		u, err := url.Parse(update.UploadEndpoint.URI)
+	var syncedItem *library.Item
+	err := waitForState(ctx, timeout, func(ctx context.Context) (bool, error) {
+		i, err := cs.libMgr.GetLibraryItem(ctx, item.ID)
 		if err != nil {
-			return err
+			return false, err
 		}
+		syncedItem = i
+		return i.Cached, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed waiting for library item %s to be synced: %w", item.ID, err)
+	}
 
-		p := soap.DefaultUpload
-		p.ContentLength = info.Size
+	logger.Info("Synced library item")
 
-		return c.Upload(ctx, f, u, &p)
-	}
+	return syncedItem, nil
+}
 
-	if err = uploadFunc(cs.libMgr.Client, path); err != nil {
-		return err
-	}
+// Only used in testing.
+func (cs *provider) CreateLibraryItem(ctx context.Context, libraryItem library.Item, path string) error {
+	log.Info("Creating Library Item", "item", libraryItem, "path", path)
 
-	return cs.libMgr.CompleteLibraryItemUpdateSession(ctx, sessionID)
+	_, err := cs.UploadLibraryItem(ctx, libraryItem, []UploadFile{{Path: path}})
+	return err
 }
 
-// generateDownloadURLForLibraryItem downloads the file from content library in 3 steps:
-// 1. list the available files and downloads only the ovf files based on filename suffix
-// 2. prepare the download session and fetch the url to be used for download
-// 3. download the file.
-func (cs *provider) generateDownloadURLForLibraryItem(
+// prepareDownloadFileForLibraryItem prepares the file to download from content
+// library in 2 steps:
+// 1. list the available files and pick the ovf file based on filename suffix
+// 2. prepare the file in the download session and wait for its download
+// endpoint.
+func (cs *provider) prepareDownloadFileForLibraryItem(
 	ctx context.Context,
 	logger logr.Logger,
 	sessionID string,
-	item *library.Item) (*url.URL, error) {
+	item *library.Item) (*library.DownloadFile, error) {
 
 	// List the files available for download in the library item.
 	files, err := cs.libMgr.ListLibraryItemDownloadSessionFile(ctx, sessionID)
@@ -355,8 +399,8 @@ func (cs *provider) generateDownloadURLForLibraryItem(
 
 	// Content library api to prepare a file for download guarantees eventual end state of either
 	// ERROR or PREPARED in order to avoid posting too many requests to the api.
-	var fileURL string
-	err = wait.PollUntilContextCancel(ctx, cs.retryInterval, true, func(_ context.Context) (bool, error) {
+	var file *library.DownloadFile
+	err = waitForState(ctx, prepareDownloadFileTimeout, func(ctx context.Context) (bool, error) {
 		downloadSessResp, err := cs.libMgr.GetLibraryItemDownloadSession(ctx, sessionID)
 		if err != nil {
 			return false, err
@@ -384,8 +428,8 @@ func (cs *provider) generateDownloadURLForLibraryItem(
 			return false, fmt.Errorf("prepared file for download does not have endpoint")
 		}
 
-		fileURL = info.DownloadEndpoint.URI
-		log.V(4).Info("Downloaded file", "fileURL", fileURL)
+		file = info
+		log.V(4).Info("Prepared file for download", "fileURL", info.DownloadEndpoint.URI)
 		return true, nil
 	})
 
@@ -393,7 +437,14 @@ func (cs *provider) generateDownloadURLForLibraryItem(
 		return nil, err
 	}
 
-	return url.Parse(fileURL)
+	return file, nil
+}
+
+func (cs *provider) ListLibraryItemFiles(
+	ctx context.Context,
+	itemID string) ([]library.File, error) {
+
+	return cs.libMgr.ListLibraryItemFiles(ctx, itemID)
 }
 
 func (cs *provider) ListLibraryItemStorage(
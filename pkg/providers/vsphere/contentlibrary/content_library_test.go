@@ -5,8 +5,12 @@
 package contentlibrary_test
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -76,6 +80,156 @@ func clTests() {
 				ovfEnvelope, err := clProvider.RetrieveOvfEnvelopeFromLibraryItem(ctx, item)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(ovfEnvelope).ToNot(BeNil())
+
+				By("Returning a separate OVF for each caller", func() {
+					otherEnvelope, err := clProvider.RetrieveOvfEnvelopeFromLibraryItem(ctx, item)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(otherEnvelope).To(Equal(ovfEnvelope))
+					Expect(otherEnvelope).ToNot(BeIdenticalTo(ovfEnvelope))
+				})
+			})
+
+			It("Syncs an item in an on-demand subscribed library", func() {
+				item, err := clProvider.GetLibraryItemID(ctx, ctx.ContentLibraryItemID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(item.Cached).To(BeFalse())
+
+				syncedItem, err := clProvider.SyncLibraryItemAndWait(ctx, item, time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(syncedItem).ToNot(BeNil())
+				Expect(syncedItem.ID).To(Equal(item.ID))
+				Expect(syncedItem.Cached).To(BeTrue())
+
+				By("Returning a cached item as-is", func() {
+					item, err := clProvider.SyncLibraryItemAndWait(ctx, syncedItem, time.Minute)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(item).To(BeIdenticalTo(syncedItem))
+				})
+			})
+		})
+
+		Context("UploadLibraryItem", func() {
+			var (
+				filePath string
+				item     library.Item
+			)
+
+			BeforeEach(func() {
+				f, err := os.CreateTemp("", "upload-*.ovf")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = f.WriteString("<Envelope/>")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(f.Close()).To(Succeed())
+				filePath = f.Name()
+			})
+
+			JustBeforeEach(func() {
+				item = library.Item{
+					Name:      "uploaded-item",
+					Type:      library.ItemTypeOVF,
+					LibraryID: ctx.LocalContentLibraryID,
+				}
+			})
+
+			AfterEach(func() {
+				Expect(os.Remove(filePath)).To(Succeed())
+			})
+
+			It("creates the item and uploads the files", func() {
+				itemID, err := clProvider.UploadLibraryItem(ctx, item, []contentlibrary.UploadFile{{Path: filePath}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(itemID).ToNot(BeEmpty())
+
+				libItem, err := clProvider.GetLibraryItemID(ctx, itemID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(libItem.Name).To(Equal(item.Name))
+
+				By("reusing the item when uploading again", func() {
+					itemID2, err := clProvider.UploadLibraryItem(ctx, item, []contentlibrary.UploadFile{{Path: filePath}})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(itemID2).To(Equal(itemID))
+				})
+			})
+
+			It("resumes the item's active update session", func() {
+				libMgr := library.NewManager(ctx.RestClient)
+
+				itemID, err := libMgr.CreateLibraryItem(ctx, item)
+				Expect(err).ToNot(HaveOccurred())
+				sessionID, err := libMgr.CreateLibraryItemUpdateSession(ctx, library.Session{LibraryItemID: itemID})
+				Expect(err).ToNot(HaveOccurred())
+
+				itemID2, err := clProvider.UploadLibraryItem(ctx, item, []contentlibrary.UploadFile{{Name: "item.ovf", Path: filePath}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(itemID2).To(Equal(itemID))
+
+				session, err := libMgr.GetLibraryItemUpdateSession(ctx, sessionID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(session.State).To(Equal("DONE"))
+
+				files, err := libMgr.ListLibraryItemUpdateSessionFile(ctx, sessionID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(files).To(HaveLen(1))
+				Expect(files[0].Name).To(Equal("item.ovf"))
+			})
+		})
+
+		Context("ImportLibraryItem", func() {
+			var (
+				server *httptest.Server
+				pulls  atomic.Int32
+				item   library.Item
+			)
+
+			BeforeEach(func() {
+				pulls.Store(0)
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == http.MethodGet {
+						pulls.Add(1)
+					}
+					_, _ = w.Write([]byte("<Envelope/>"))
+				}))
+			})
+
+			JustBeforeEach(func() {
+				item = library.Item{
+					Name:      "imported-item",
+					Type:      library.ItemTypeOVF,
+					LibraryID: ctx.LocalContentLibraryID,
+				}
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			It("creates the item and imports the files", func() {
+				itemID, err := clProvider.ImportLibraryItem(ctx, item, []contentlibrary.ImportFile{
+					{Name: "item.ovf", URL: server.URL + "/item.ovf"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(itemID).ToNot(BeEmpty())
+
+				libItem, err := clProvider.GetLibraryItemID(ctx, itemID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(libItem.Name).To(Equal(item.Name))
+				Expect(pulls.Load()).To(BeEquivalentTo(1))
+
+				By("not importing the item again", func() {
+					itemID2, err := clProvider.ImportLibraryItem(ctx, item, []contentlibrary.ImportFile{
+						{Name: "item.ovf", URL: server.URL + "/item.ovf"},
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(itemID2).To(Equal(itemID))
+					Expect(pulls.Load()).To(BeEquivalentTo(1))
+				})
+			})
+
+			It("returns an error when the checksum of a file does not match", func() {
+				_, err := clProvider.ImportLibraryItem(ctx, item, []contentlibrary.ImportFile{
+					{Name: "item.ovf", URL: server.URL + "/item.ovf", SHA256: "invalid"},
+				})
+				Expect(err).To(HaveOccurred())
 			})
 		})
 
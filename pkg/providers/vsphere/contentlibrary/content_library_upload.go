@@ -0,0 +1,379 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package contentlibrary
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vim25/soap"
+
+	vsclient "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
+)
+
+const (
+	updateSessionStateActive   = "ACTIVE"
+	updateSessionStateDone     = "DONE"
+	updateSessionStateError    = "ERROR"
+	updateSessionStateCanceled = "CANCELED"
+
+	updateFileStatusReady = "READY"
+	updateFileStatusError = "ERROR"
+
+	// importFileTimeout is how long to wait for a file being imported into a
+	// library item to be transferred.
+	importFileTimeout = 30 * time.Minute
+)
+
+// UploadFile is a local file to upload to a library item.
+type UploadFile struct {
+	// Name is the name of the file in the library item. If empty, the base
+	// name of Path is used.
+	Name string
+
+	// Path is the path of the local file.
+	Path string
+}
+
+func (f UploadFile) name() string {
+	if f.Name != "" {
+		return f.Name
+	}
+	return filepath.Base(f.Path)
+}
+
+// UploadLibraryItem uploads the files to the library item with the specified
+// name in the specified library, creating the item if it does not exist. The
+// ID of the item is returned.
+//
+// Uploads are resumable. If the item already has an active update session,
+// ex. because an earlier upload was interrupted by an operator restart, then
+// that session is used and files it has already received are not uploaded
+// again.
+func (cs *provider) UploadLibraryItem(
+	ctx context.Context,
+	item library.Item,
+	files []UploadFile) (string, error) {
+
+	logger := logr.FromContextOrDiscard(ctx).WithValues(
+		"libraryID", item.LibraryID, "itemName", item.Name)
+
+	itemID, err := cs.getOrCreateLibraryItem(ctx, item)
+	if err != nil {
+		return "", err
+	}
+	logger = logger.WithValues("itemID", itemID)
+
+	sessionID, err := cs.getOrCreateUpdateSession(ctx, itemID)
+	if err != nil {
+		return "", err
+	}
+	logger = logger.WithValues("sessionID", sessionID)
+
+	sessionFiles, err := cs.libMgr.ListLibraryItemUpdateSessionFile(ctx, sessionID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list files in update session %s: %w", sessionID, err)
+	}
+
+	for _, f := range files {
+		name := f.name()
+
+		var sessionFile *library.UpdateFile
+		for i := range sessionFiles {
+			if sessionFiles[i].Name == name {
+				sessionFile = &sessionFiles[i]
+				break
+			}
+		}
+
+		if sessionFile != nil && sessionFile.Status == updateFileStatusReady {
+			logger.V(4).Info("Skipping file already uploaded to library item", "fileName", name)
+			continue
+		}
+
+		logger.Info("Uploading file to library item", "fileName", name)
+		if err := cs.uploadFile(ctx, sessionID, sessionFile, name, f.Path); err != nil {
+			return "", fmt.Errorf("failed to upload file %s to library item %s: %w", name, itemID, err)
+		}
+	}
+
+	if err := cs.completeUpdateSession(ctx, sessionID); err != nil {
+		return "", err
+	}
+
+	logger.Info("Uploaded library item")
+
+	return itemID, nil
+}
+
+// ImportFile is a remote file to import into a library item.
+type ImportFile struct {
+	// Name is the name of the file in the library item.
+	Name string
+
+	// URL is the URL from which the file is pulled.
+	URL string
+
+	// SHA256 is the optional SHA-256 checksum of the file.
+	SHA256 string
+}
+
+// ImportLibraryItem imports the files to the library item with the specified
+// name in the specified library, creating the item if it does not exist. Unlike
+// UploadLibraryItem, the files are pulled from their URLs by vSphere. The ID of
+// the item is returned.
+//
+// Like uploads, imports are resumable. Files the item's active update session
+// is already pulling are not added to the session again. Please note, an item
+// that already has content is not imported again.
+func (cs *provider) ImportLibraryItem(
+	ctx context.Context,
+	item library.Item,
+	files []ImportFile) (string, error) {
+
+	logger := logr.FromContextOrDiscard(ctx).WithValues(
+		"libraryID", item.LibraryID, "itemName", item.Name)
+
+	itemID, err := cs.getOrCreateLibraryItem(ctx, item)
+	if err != nil {
+		return "", err
+	}
+	logger = logger.WithValues("itemID", itemID)
+
+	// The files of an item are not visible until its update session is done,
+	// and an imported OVA is stored as the OVF and disk files it contains, so
+	// any files mean the item was already imported.
+	itemFiles, err := cs.libMgr.ListLibraryItemFiles(ctx, itemID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list files in library item %s: %w", itemID, err)
+	}
+	if len(itemFiles) > 0 {
+		logger.V(4).Info("Skipping library item that was already imported")
+		return itemID, nil
+	}
+
+	sessionID, err := cs.getOrCreateUpdateSession(ctx, itemID)
+	if err != nil {
+		return "", err
+	}
+	logger = logger.WithValues("sessionID", sessionID)
+
+	sessionFiles, err := cs.libMgr.ListLibraryItemUpdateSessionFile(ctx, sessionID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list files in update session %s: %w", sessionID, err)
+	}
+
+	for _, f := range files {
+		var sessionFile *library.UpdateFile
+		for i := range sessionFiles {
+			if sessionFiles[i].Name == f.Name {
+				sessionFile = &sessionFiles[i]
+				break
+			}
+		}
+
+		if sessionFile != nil && sessionFile.Status == updateFileStatusError {
+			// Remove the file so it is pulled again.
+			if err := cs.libMgr.RemoveLibraryItemUpdateSessionFile(ctx, sessionID, f.Name); err != nil {
+				return "", fmt.Errorf("failed to remove file %s from update session %s: %w",
+					f.Name, sessionID, err)
+			}
+			sessionFile = nil
+		}
+
+		if sessionFile == nil {
+			var checksum []library.Checksum
+			if f.SHA256 != "" {
+				checksum = append(checksum, library.Checksum{
+					Algorithm: "SHA256",
+					Checksum:  f.SHA256,
+				})
+			}
+
+			logger.Info("Importing file to library item", "fileName", f.Name, "url", f.URL)
+			if _, err := cs.libMgr.AddLibraryItemFileFromURI(ctx, sessionID, f.Name, f.URL, checksum...); err != nil {
+				return "", fmt.Errorf("failed to import file %s to library item %s: %w", f.Name, itemID, err)
+			}
+		}
+
+		if err := cs.waitForFileTransfer(ctx, sessionID, f.Name); err != nil {
+			return "", err
+		}
+	}
+
+	if err := cs.completeUpdateSession(ctx, sessionID); err != nil {
+		return "", err
+	}
+
+	logger.Info("Imported library item")
+
+	return itemID, nil
+}
+
+// waitForFileTransfer waits for the file being pulled by the update session to
+// be transferred.
+func (cs *provider) waitForFileTransfer(
+	ctx context.Context,
+	sessionID, fileName string) error {
+
+	return waitForState(ctx, importFileTimeout, func(ctx context.Context) (bool, error) {
+		file, err := cs.libMgr.GetLibraryItemUpdateSessionFile(ctx, sessionID, fileName)
+		if err != nil {
+			return false, err
+		}
+
+		switch file.Status {
+		case updateFileStatusReady:
+			return true, nil
+		case updateFileStatusError:
+			if file.ErrorMessage != nil {
+				return false, fmt.Errorf("failed to import file %s in update session %s: %w",
+					fileName, sessionID, file.ErrorMessage)
+			}
+			return false, fmt.Errorf("failed to import file %s in update session %s",
+				fileName, sessionID)
+		}
+
+		return false, nil
+	})
+}
+
+// getOrCreateLibraryItem returns the ID of the library item with the same name
+// in the library, creating the item if it does not exist.
+func (cs *provider) getOrCreateLibraryItem(
+	ctx context.Context,
+	item library.Item) (string, error) {
+
+	ids, err := cs.libMgr.FindLibraryItems(ctx, library.FindItem{
+		LibraryID: item.LibraryID,
+		Name:      item.Name,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to find library item %s: %w", item.Name, err)
+	}
+	if len(ids) > 0 {
+		return ids[0], nil
+	}
+
+	id, err := cs.libMgr.CreateLibraryItem(ctx, item)
+	if err != nil {
+		return "", fmt.Errorf("failed to create library item %s: %w", item.Name, err)
+	}
+
+	return id, nil
+}
+
+// getOrCreateUpdateSession returns the ID of the item's active update session,
+// creating a new session if there is not one.
+func (cs *provider) getOrCreateUpdateSession(
+	ctx context.Context,
+	itemID string) (string, error) {
+
+	ids, err := cs.libMgr.ListLibraryItemUpdateSession(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list update sessions: %w", err)
+	}
+
+	for _, id := range ids {
+		session, err := cs.libMgr.GetLibraryItemUpdateSession(ctx, id)
+		if err != nil {
+			// The session may have expired since it was listed.
+			continue
+		}
+		if session.LibraryItemID == itemID && session.State == updateSessionStateActive {
+			return id, nil
+		}
+	}
+
+	id, err := cs.libMgr.CreateLibraryItemUpdateSession(ctx, library.Session{LibraryItemID: itemID})
+	if err != nil {
+		return "", fmt.Errorf("failed to create update session for library item %s: %w", itemID, err)
+	}
+
+	return id, nil
+}
+
+// uploadFile uploads the local file to the update session. If the session
+// already has the file, then the file is uploaded again to the same endpoint.
+func (cs *provider) uploadFile(
+	ctx context.Context,
+	sessionID string,
+	sessionFile *library.UpdateFile,
+	name, path string) error {
+
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if sessionFile == nil || sessionFile.UploadEndpoint == nil {
+		sessionFile, err = cs.libMgr.AddLibraryItemFile(ctx, sessionID, library.UpdateFile{
+			Name:       name,
+			SourceType: "PUSH",
+			Size:       fi.Size(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	u, err := url.Parse(sessionFile.UploadEndpoint.URI)
+	if err != nil {
+		return err
+	}
+
+	p := soap.DefaultUpload
+	p.ContentLength = fi.Size()
+
+	// The duration of the upload depends on the size of the file, so it is
+	// not limited to the timeout of the other REST requests.
+	return cs.libMgr.Client.Upload(vsclient.WithoutRestTimeout(ctx), f, u, &p)
+}
+
+// completeUpdateSession completes the update session and waits for the session
+// to be done. Completing the session is asynchronous, so the item's content
+// may not be used until the session is done.
+func (cs *provider) completeUpdateSession(
+	ctx context.Context,
+	sessionID string) error {
+
+	if err := cs.libMgr.CompleteLibraryItemUpdateSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to complete update session %s: %w", sessionID, err)
+	}
+
+	return waitForState(ctx, completeUpdateSessionTimeout, func(ctx context.Context) (bool, error) {
+		session, err := cs.libMgr.GetLibraryItemUpdateSession(ctx, sessionID)
+		if err != nil {
+			return false, err
+		}
+
+		switch session.State {
+		case updateSessionStateDone:
+			return true, nil
+		case updateSessionStateError, updateSessionStateCanceled:
+			if session.ErrorMessage != nil {
+				return false, fmt.Errorf("update session %s is %s: %w",
+					sessionID, session.State, session.ErrorMessage)
+			}
+			return false, fmt.Errorf("update session %s is %s", sessionID, session.State)
+		}
+
+		return false, nil
+	})
+}
@@ -5,7 +5,9 @@
 package contentlibrary
 
 import (
+	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -17,10 +19,21 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
 )
 
 var vmxRe = regexp.MustCompile(`vmx-(\d+)`)
 
+const (
+	// ovfPCIPassthruResourceSubType is the resource sub-type of the OVF
+	// virtual hardware items that are PCI passthrough devices.
+	ovfPCIPassthruResourceSubType = "vmware.pciPassthru"
+
+	// ovfVGPUConfigKey is the key of the config entry of an OVF PCI
+	// passthrough item that specifies the item's vGPU profile.
+	ovfVGPUConfigKey = "vgpu"
+)
+
 // ParseVirtualHardwareVersion parses the virtual hardware version
 // For eg. "vmx-15" returns 15.
 func ParseVirtualHardwareVersion(vmxVersion string) int32 {
@@ -60,6 +73,9 @@ func UpdateVmiWithOvfEnvelope(obj client.Object, ovfEnvelope ovf.Envelope) {
 			} else {
 				conditions.Delete(setter, vmopv1.VirtualMachineImageV1Alpha1CompatibleCondition)
 			}
+
+			updateDeployableWithoutInputCondition(setter, ovfEnvelope.VirtualSystem)
+			updateExtraConfigCompatibleCondition(setter, ovfEnvelope.VirtualSystem)
 		}
 	}
 
@@ -70,6 +86,74 @@ func UpdateVmiWithOvfEnvelope(obj client.Object, ovfEnvelope ovf.Envelope) {
 	}
 }
 
+// updateDeployableWithoutInputCondition sets the image's DeployableWithoutInput
+// condition to false if the image has a EULA or user configurable OVF
+// properties without default values, otherwise to true.
+func updateDeployableWithoutInputCondition(
+	setter conditions.Setter,
+	ovfVirtualSystem *ovf.VirtualSystem) {
+
+	var requiredProps []string
+	for _, product := range ovfVirtualSystem.Product {
+		for _, prop := range product.Property {
+			if prop.UserConfigurable != nil && *prop.UserConfigurable && prop.Default == nil {
+				requiredProps = append(requiredProps, prop.Key)
+			}
+		}
+	}
+
+	var msgs []string
+	if len(ovfVirtualSystem.Eula) > 0 {
+		msgs = append(msgs, "The image has a EULA that must be accepted")
+	}
+	if len(requiredProps) > 0 {
+		msgs = append(msgs, fmt.Sprintf("The OVF properties without default values must be specified: %s",
+			strings.Join(requiredProps, ", ")))
+	}
+
+	switch {
+	case len(ovfVirtualSystem.Eula) > 0:
+		conditions.MarkFalse(
+			setter,
+			vmopv1.VirtualMachineImageDeployableWithoutInputCondition,
+			vmopv1.VirtualMachineImageEULARequiredReason,
+			"%s", strings.Join(msgs, ". "))
+	case len(requiredProps) > 0:
+		conditions.MarkFalse(
+			setter,
+			vmopv1.VirtualMachineImageDeployableWithoutInputCondition,
+			vmopv1.VirtualMachineImageOVFPropertiesRequiredReason,
+			"%s", strings.Join(msgs, ". "))
+	default:
+		conditions.MarkTrue(setter, vmopv1.VirtualMachineImageDeployableWithoutInputCondition)
+	}
+}
+
+// updateExtraConfigCompatibleCondition sets the image's ExtraConfigCompatible
+// condition to false if any of the extraConfig keys the image requires are
+// managed by VM Operator, or to true if none of them are. The condition is
+// removed if the image does not require any extraConfig keys.
+func updateExtraConfigCompatibleCondition(
+	setter conditions.Setter,
+	ovfVirtualSystem *ovf.VirtualSystem) {
+
+	extraConfig, conflicts := getRequiredExtraConfigFromOvf(ovfVirtualSystem)
+
+	switch {
+	case len(conflicts) > 0:
+		conditions.MarkFalse(
+			setter,
+			vmopv1.VirtualMachineImageExtraConfigCompatibleCondition,
+			vmopv1.VirtualMachineImageExtraConfigConflictReason,
+			"The required extraConfig keys are managed by VM Operator and will not be set: %s",
+			strings.Join(conflicts, ", "))
+	case len(extraConfig) > 0:
+		conditions.MarkTrue(setter, vmopv1.VirtualMachineImageExtraConfigCompatibleCondition)
+	default:
+		conditions.Delete(setter, vmopv1.VirtualMachineImageExtraConfigCompatibleCondition)
+	}
+}
+
 func initImageStatusFromOVFVirtualSystem(
 	imageStatus *vmopv1.VirtualMachineImageStatus,
 	ovfVirtualSystem *ovf.VirtualSystem) {
@@ -98,6 +182,7 @@ func initImageStatusFromOVFVirtualSystem(
 	}
 
 	// Use hardware section info from the VM image, if one exists.
+	imageStatus.RecommendedResources = nil
 	if virtualHW := ovfVirtualSystem.VirtualHardware; len(virtualHW) > 0 {
 		imageStatus.Firmware = getFirmwareType(virtualHW[0])
 
@@ -107,6 +192,8 @@ func initImageStatusFromOVFVirtualSystem(
 				imageStatus.HardwareVersion = &ver
 			}
 		}
+
+		imageStatus.RecommendedResources = getRecommendedResources(virtualHW[0])
 	}
 
 	imageStatus.OVFProperties = nil
@@ -135,6 +222,9 @@ func initImageStatusFromOVFVirtualSystem(
 			imageStatus.VMwareSystemProperties = append(imageStatus.VMwareSystemProperties, prop)
 		}
 	}
+
+	imageStatus.ExtraConfig, _ = getRequiredExtraConfigFromOvf(ovfVirtualSystem)
+	imageStatus.VGPUDevices = getVGPUDevicesFromOvf(ovfVirtualSystem)
 }
 
 func populateImageStatusFromOVFDiskSection(imageStatus *vmopv1.VirtualMachineImageStatus, diskSection *ovf.DiskSection) {
@@ -176,6 +266,75 @@ func getVmwareSystemPropertiesFromOvf(ovfVirtualSystem *ovf.VirtualSystem) map[s
 	return properties
 }
 
+// getRequiredExtraConfigFromOvf returns the ExtraConfig entries, sorted by
+// key, from the OVF's virtual hardware sections that are marked as required,
+// ex. GPU driver hints or device placeholders. The entries whose keys are
+// managed by VM Operator are not returned, and their keys are returned as the
+// conflicts.
+func getRequiredExtraConfigFromOvf(
+	ovfVirtualSystem *ovf.VirtualSystem) ([]common.KeyValuePair, []string) {
+
+	var (
+		extraConfig []common.KeyValuePair
+		conflicts   []string
+	)
+
+	if ovfVirtualSystem != nil {
+		for _, virtualHardware := range ovfVirtualSystem.VirtualHardware {
+			for _, config := range virtualHardware.ExtraConfig {
+				if config.Required == nil || !*config.Required {
+					continue
+				}
+				if pkgutil.IsOperatorManagedExtraConfigKey(config.Key) {
+					conflicts = append(conflicts, config.Key)
+					continue
+				}
+				extraConfig = append(extraConfig, common.KeyValuePair{
+					Key:   config.Key,
+					Value: config.Value,
+				})
+			}
+		}
+	}
+
+	slices.SortStableFunc(extraConfig, func(a, b common.KeyValuePair) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	extraConfig = slices.CompactFunc(extraConfig, func(a, b common.KeyValuePair) bool {
+		return a.Key == b.Key
+	})
+	slices.Sort(conflicts)
+
+	return extraConfig, slices.Compact(conflicts)
+}
+
+// getVGPUDevicesFromOvf returns the vGPU devices from the OVF's virtual
+// hardware sections, i.e. the PCI passthrough items that have a vGPU profile.
+func getVGPUDevicesFromOvf(ovfVirtualSystem *ovf.VirtualSystem) []vmopv1.VGPUDevice {
+	var devices []vmopv1.VGPUDevice
+
+	if ovfVirtualSystem != nil {
+		for _, virtualHardware := range ovfVirtualSystem.VirtualHardware {
+			for _, item := range virtualHardware.Item {
+				if item.ResourceType == nil || *item.ResourceType != ovf.Other ||
+					item.ResourceSubType == nil ||
+					!strings.EqualFold(*item.ResourceSubType, ovfPCIPassthruResourceSubType) {
+					continue
+				}
+				for _, config := range item.Config {
+					if config.Key == ovfVGPUConfigKey && config.Value != "" {
+						devices = append(devices, vmopv1.VGPUDevice{
+							ProfileName: config.Value,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return devices
+}
+
 // isOVFV1Alpha1Compatible checks if the image has VMOperatorV1Alpha1ExtraConfigKey
 // set to VMOperatorV1Alpha1ConfigReady in it's the ExtraConfig.
 func isOVFV1Alpha1Compatible(ovfVirtualSystem *ovf.VirtualSystem) bool {
@@ -201,6 +360,45 @@ func getFirmwareType(hardware ovf.VirtualHardwareSection) string {
 	return ""
 }
 
+// getRecommendedResources returns the CPU and memory resources from the
+// processor and memory items in the virtual hardware section of the OVF, or
+// nil if the section does not have either.
+func getRecommendedResources(hardware ovf.VirtualHardwareSection) *vmopv1.VirtualMachineImageResourceInfo {
+	var res vmopv1.VirtualMachineImageResourceInfo
+
+	for _, item := range hardware.Item {
+		if item.ResourceType == nil || item.VirtualQuantity == nil {
+			continue
+		}
+
+		quantity := int64(*item.VirtualQuantity)
+		if quantity == 0 {
+			continue
+		}
+
+		switch *item.ResourceType {
+		case ovf.Processor:
+			res.Cpus = quantity
+		case ovf.Memory:
+			// Memory is in MB when the allocation units are not specified.
+			units := int64(1024 * 1024)
+			if item.AllocationUnits != nil {
+				units = ovf.ParseCapacityAllocationUnits(*item.AllocationUnits)
+			}
+			if units > 0 {
+				memory := quantity * units
+				res.Memory = resource.NewQuantity(memory, getQuantityFormat(memory))
+			}
+		}
+	}
+
+	if res.Cpus == 0 && res.Memory == nil {
+		return nil
+	}
+
+	return &res
+}
+
 // getQuantityFormat returns the appropriate resource.Format based upon the divisibility of the input val.
 func getQuantityFormat(val int64) resource.Format {
 	format := resource.DecimalSI
@@ -9,8 +9,10 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/vmware/govmomi/ovf"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/contentlibrary"
@@ -105,6 +107,22 @@ var _ = Describe("UpdateVmiWithOvfEnvelope", func() {
 								VirtualSystemType: ptr.To("vmx-10"),
 							},
 						},
+
+						Item: []ovf.ResourceAllocationSettingData{
+							{
+								CIMResourceAllocationSettingData: ovf.CIMResourceAllocationSettingData{
+									ResourceType:    ptr.To(ovf.Processor),
+									VirtualQuantity: ptr.To[uint](2),
+								},
+							},
+							{
+								CIMResourceAllocationSettingData: ovf.CIMResourceAllocationSettingData{
+									ResourceType:    ptr.To(ovf.Memory),
+									AllocationUnits: ptr.To("byte * 2^20"),
+									VirtualQuantity: ptr.To[uint](4096),
+								},
+							},
+						},
 					},
 				},
 			},
@@ -169,12 +187,30 @@ var _ = Describe("UpdateVmiWithOvfEnvelope", func() {
 
 		Expect(conditions.Has(image, vmopv1.VirtualMachineImageV1Alpha1CompatibleCondition)).To(BeFalse())
 
+		Expect(image.Status.ExtraConfig).To(BeEmpty())
+		Expect(image.Status.VGPUDevices).To(BeEmpty())
+		Expect(conditions.Has(image, vmopv1.VirtualMachineImageExtraConfigCompatibleCondition)).To(BeFalse())
+
 		Expect(image.Status.Disks).To(HaveLen(2))
 		Expect(image.Status.Disks[0].Size.String()).To(Equal("18304Ki"))
 		Expect(image.Status.Disks[0].Capacity.String()).To(Equal("30Mi"))
 
 		Expect(image.Status.Disks[1].Size.String()).To(Equal("0"))
 		Expect(image.Status.Disks[1].Capacity.String()).To(Equal("10Gi"))
+
+		Expect(image.Status.RecommendedResources).ToNot(BeNil())
+		Expect(image.Status.RecommendedResources.Cpus).To(Equal(int64(2)))
+		Expect(image.Status.RecommendedResources.Memory.String()).To(Equal("4Gi"))
+	})
+
+	When("the virtual hardware does not have processor or memory items", func() {
+		BeforeEach(func() {
+			ovfEnvelope.VirtualSystem.VirtualHardware[0].Item = nil
+		})
+
+		It("does not set the recommended resources", func() {
+			Expect(image.Status.RecommendedResources).To(BeNil())
+		})
 	})
 
 	It("Repeated UpdateVmiWithOvfEnvelope should not duplicated items", func() {
@@ -187,6 +223,161 @@ var _ = Describe("UpdateVmiWithOvfEnvelope", func() {
 		Expect(image).To(Equal(savedImage))
 	})
 
+	It("DeployableWithoutInput condition is true", func() {
+		Expect(conditions.IsTrue(image, vmopv1.VirtualMachineImageDeployableWithoutInputCondition)).To(BeTrue())
+	})
+
+	Context("Image has user configurable OVF properties without default values", func() {
+		BeforeEach(func() {
+			product := &ovfEnvelope.VirtualSystem.Product[0]
+			product.Property = append(product.Property,
+				ovf.Property{
+					Key:              "required-key",
+					Type:             ovfStringType,
+					UserConfigurable: ptr.To(true),
+				},
+				ovf.Property{
+					Key:  "not-user-configurable-key",
+					Type: ovfStringType,
+				},
+			)
+		})
+
+		It("DeployableWithoutInput condition is false", func() {
+			c := conditions.Get(image, vmopv1.VirtualMachineImageDeployableWithoutInputCondition)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineImageOVFPropertiesRequiredReason))
+			Expect(c.Message).To(Equal("The OVF properties without default values must be specified: required-key"))
+		})
+
+		Context("Image has a EULA", func() {
+			BeforeEach(func() {
+				ovfEnvelope.VirtualSystem.Eula = []ovf.EulaSection{{License: "license"}}
+			})
+
+			It("DeployableWithoutInput condition is false", func() {
+				c := conditions.Get(image, vmopv1.VirtualMachineImageDeployableWithoutInputCondition)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineImageEULARequiredReason))
+				Expect(c.Message).To(Equal("The image has a EULA that must be accepted. " +
+					"The OVF properties without default values must be specified: required-key"))
+			})
+		})
+	})
+
+	Context("Image has required extraConfig", func() {
+		BeforeEach(func() {
+			ovfEnvelope.VirtualSystem.VirtualHardware[0].ExtraConfig = append(ovfEnvelope.VirtualSystem.VirtualHardware[0].ExtraConfig,
+				ovf.Config{
+					Key:      "pciPassthru0.cfg.enable_uvm",
+					Value:    "1",
+					Required: ptr.To(true),
+				},
+				ovf.Config{
+					Key:      "hypervisor.cpuid.v0",
+					Value:    "FALSE",
+					Required: ptr.To(true),
+				},
+				ovf.Config{
+					Key:   "not-required",
+					Value: "value",
+				},
+			)
+		})
+
+		It("ExtraConfig is the required keys sorted by key", func() {
+			Expect(image.Status.ExtraConfig).To(Equal([]common.KeyValuePair{
+				{Key: "hypervisor.cpuid.v0", Value: "FALSE"},
+				{Key: "pciPassthru0.cfg.enable_uvm", Value: "1"},
+			}))
+		})
+
+		It("ExtraConfigCompatible condition is true", func() {
+			Expect(conditions.IsTrue(image, vmopv1.VirtualMachineImageExtraConfigCompatibleCondition)).To(BeTrue())
+		})
+
+		Context("Some of the required keys are managed by VM Operator", func() {
+			BeforeEach(func() {
+				ovfEnvelope.VirtualSystem.VirtualHardware[0].ExtraConfig = append(ovfEnvelope.VirtualSystem.VirtualHardware[0].ExtraConfig,
+					ovf.Config{
+						Key:      constants.PCIPassthruMMIOSizeExtraConfigKey,
+						Value:    "1024",
+						Required: ptr.To(true),
+					},
+					ovf.Config{
+						Key:      "guestinfo.foo",
+						Value:    "bar",
+						Required: ptr.To(true),
+					},
+				)
+			})
+
+			It("ExtraConfig excludes the conflicting keys", func() {
+				Expect(image.Status.ExtraConfig).To(Equal([]common.KeyValuePair{
+					{Key: "hypervisor.cpuid.v0", Value: "FALSE"},
+					{Key: "pciPassthru0.cfg.enable_uvm", Value: "1"},
+				}))
+			})
+
+			It("ExtraConfigCompatible condition is false", func() {
+				c := conditions.Get(image, vmopv1.VirtualMachineImageExtraConfigCompatibleCondition)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineImageExtraConfigConflictReason))
+				Expect(c.Message).To(Equal("The required extraConfig keys are managed by VM Operator " +
+					"and will not be set: guestinfo.foo, pciPassthru.64bitMMIOSizeGB"))
+			})
+		})
+	})
+
+	Context("Image has vGPU devices", func() {
+		BeforeEach(func() {
+			ovfEnvelope.VirtualSystem.VirtualHardware[0].Item = append(ovfEnvelope.VirtualSystem.VirtualHardware[0].Item,
+				ovf.ResourceAllocationSettingData{
+					CIMResourceAllocationSettingData: ovf.CIMResourceAllocationSettingData{
+						ElementName:     "PCI device 0",
+						ResourceType:    ptr.To(ovf.Other),
+						ResourceSubType: ptr.To("vmware.pciPassthru"),
+					},
+					Config: []ovf.Config{
+						{
+							Key:   "vgpu",
+							Value: "grid_t4-4q",
+						},
+					},
+				},
+				ovf.ResourceAllocationSettingData{
+					CIMResourceAllocationSettingData: ovf.CIMResourceAllocationSettingData{
+						ElementName:     "PCI device 1",
+						ResourceType:    ptr.To(ovf.Other),
+						ResourceSubType: ptr.To("vmware.pciPassthru"),
+					},
+				},
+				ovf.ResourceAllocationSettingData{
+					CIMResourceAllocationSettingData: ovf.CIMResourceAllocationSettingData{
+						ElementName:     "Video card",
+						ResourceType:    ptr.To(ovf.Other),
+						ResourceSubType: ptr.To("vmware.videocard"),
+					},
+					Config: []ovf.Config{
+						{
+							Key:   "vgpu",
+							Value: "not-a-vgpu",
+						},
+					},
+				},
+			)
+		})
+
+		It("VGPUDevices is the PCI passthrough items with a vGPU profile", func() {
+			Expect(image.Status.VGPUDevices).To(Equal([]vmopv1.VGPUDevice{
+				{ProfileName: "grid_t4-4q"},
+			}))
+		})
+	})
+
 	Context("Image is V1Alpha1Compatible", func() {
 		BeforeEach(func() {
 			ovfEnvelope.VirtualSystem.VirtualHardware[0].ExtraConfig = append(ovfEnvelope.VirtualSystem.VirtualHardware[0].ExtraConfig,
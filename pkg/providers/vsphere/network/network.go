@@ -7,12 +7,12 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"strings"
 	"time"
 
-	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
@@ -31,11 +31,20 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	"github.com/vmware-tanzu/vm-operator/pkg"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
+// Finder looks up networks in the vSphere inventory. It is satisfied by both
+// *find.Finder and the client's inventory cache.
+type Finder interface {
+	Network(ctx context.Context, path string) (object.NetworkReference, error)
+}
+
 type NetworkInterfaceResults struct {
 	Results []NetworkInterfaceResult
 }
@@ -78,6 +87,10 @@ const (
 
 	// VMNameLabel is the label put on a network interface CR that identifies its VM by name.
 	VMNameLabel = pkg.VMOperatorKey + "/vm-name"
+
+	// VMInterfaceNameLabel is the label put on a network interface CR that identifies the
+	// name of the VM's network interface.
+	VMInterfaceNameLabel = pkg.VMOperatorKey + "/vm-interface-name"
 )
 
 var (
@@ -85,6 +98,30 @@ var (
 	RetryTimeout = 15 * time.Second
 )
 
+var (
+	// ErrVirtualNetworkNotFound is returned when an interface's NCP
+	// VirtualNetwork does not exist.
+	ErrVirtualNetworkNotFound = errors.New("virtual network does not exist")
+
+	// ErrVirtualNetworkNotReady is returned when an interface's NCP
+	// VirtualNetwork has not been realized.
+	ErrVirtualNetworkNotReady = errors.New("virtual network is not ready")
+)
+
+const (
+	// VirtualNetworkNotFoundReason is the NetworkReady condition reason when
+	// an interface's NCP VirtualNetwork does not exist.
+	VirtualNetworkNotFoundReason = "VirtualNetworkNotFound"
+
+	// VirtualNetworkNotReadyReason is the NetworkReady condition reason when
+	// an interface's NCP VirtualNetwork has not been realized.
+	VirtualNetworkNotReadyReason = "VirtualNetworkNotReady"
+
+	// virtualNetworkReadyConditionType is the type of the condition NCP sets
+	// on a VirtualNetwork once it has been realized by NSX-T.
+	virtualNetworkReadyConditionType = "Ready"
+)
+
 // CreateAndWaitForNetworkInterfaces creates the appropriate CRs for the VM's network
 // interfaces, and then waits for them to be reconciled by NCP (NSX-T) or NetOP (VDS).
 //
@@ -113,7 +150,7 @@ func CreateAndWaitForNetworkInterfaces(
 	vmCtx pkgctx.VirtualMachineContext,
 	client ctrlclient.Client,
 	vimClient *vim25.Client,
-	finder *find.Finder,
+	finder Finder,
 	clusterMoRef *vimtypes.ManagedObjectReference,
 	networkSpec *vmopv1.VirtualMachineNetworkSpec) (NetworkInterfaceResults, error) {
 
@@ -168,6 +205,12 @@ func CreateAndWaitForNetworkInterfaces(
 	// unused network interface CRDs so they can be deleted after they're removed from the VM
 	// via Reconfigure, instead of delaying that until the VM is deleted via GC.
 
+	if networkType == pkgcfg.NetworkProviderTypeVPC {
+		if err := ReconcileSecurityPolicy(vmCtx, client, networkSpec); err != nil {
+			return NetworkInterfaceResults{}, err
+		}
+	}
+
 	return NetworkInterfaceResults{
 		Results: results,
 	}, nil
@@ -215,6 +258,13 @@ func applyInterfaceSpecToResult(
 		}
 	}
 
+	// The MAC address provided by the network takes precedence since the
+	// network may filter on it. Otherwise, the assigned MAC address results in
+	// a Manual address type instead of a vSphere generated one.
+	if result.MacAddress == "" && interfaceSpec.MACAddr != "" {
+		result.MacAddress = vmopv1util.NormalizeMACAddress(interfaceSpec.MACAddr)
+	}
+
 	result.Name = interfaceSpec.Name
 	result.GuestDeviceName = interfaceSpec.GuestDeviceName
 	if result.GuestDeviceName == "" {
@@ -246,7 +296,7 @@ func applyInterfaceSpecToResult(
 
 func createNamedNetworkInterface(
 	vmCtx pkgctx.VirtualMachineContext,
-	finder *find.Finder,
+	finder Finder,
 	interfaceSpec *vmopv1.VirtualMachineNetworkInterfaceSpec) (*NetworkInterfaceResult, error) {
 
 	var (
@@ -266,6 +316,8 @@ func createNamedNetworkInterface(
 		return nil, fmt.Errorf("network name is required")
 	}
 
+	networkRefName = NamedNetworkName(vmCtx.VM, networkRefName)
+
 	backing, err := finder.Network(vmCtx, networkRefName)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find named network %q: %w", networkRefName, err)
@@ -277,6 +329,20 @@ func createNamedNetworkInterface(
 	}, nil
 }
 
+// NamedNetworkName returns the name of the named network to which an interface
+// that specifies networkName is connected. This is networkName unless the
+// interface uses the VM's default named network and the VM's interfaces have
+// been migrated to a new default network.
+func NamedNetworkName(vm *vmopv1.VirtualMachine, networkName string) string {
+	if networkName != vm.Annotations[pkgconst.DefaultNamedNetworkAnnotationKey] {
+		return networkName
+	}
+	if migrated := vm.Annotations[pkgconst.MigratedNamedNetworkAnnotationKey]; migrated != "" {
+		return migrated
+	}
+	return networkName
+}
+
 // NetOPCRName returns the name to be used for the NetOP NetworkInterface CR.
 func NetOPCRName(vmName, networkName, interfaceName string, isV1A1 bool) string {
 	var name string
@@ -488,6 +554,15 @@ func createNCPNetworkInterface(
 		return nil, fmt.Errorf("network kind %q is not supported for NCP", kind)
 	}
 
+	// The VirtualNetwork is only checked when the VM is created, so an
+	// existing VM's interfaces continue to be reconciled if the VirtualNetwork
+	// later becomes unavailable.
+	if networkRefName != "" && ctxop.IsCreate(vmCtx) {
+		if err := CheckVirtualNetworkReady(vmCtx, client, vmCtx.VM.Namespace, networkRefName); err != nil {
+			return nil, err
+		}
+	}
+
 	vnetIf := &ncpv1alpha1.VirtualNetworkInterface{}
 	vnetIfKey := types.NamespacedName{
 		Namespace: vmCtx.VM.Namespace,
@@ -650,6 +725,7 @@ func createVPCNetworkInterface(
 			vpcSubnetPort.Labels = map[string]string{}
 		}
 		vpcSubnetPort.Labels[VMNameLabel] = vmCtx.VM.Name
+		vpcSubnetPort.Labels[VMInterfaceNameLabel] = interfaceSpec.Name
 		if vpcSubnetPort.Annotations == nil {
 			vpcSubnetPort.Annotations = make(map[string]string)
 		}
@@ -758,6 +834,36 @@ func waitForReadyVPCSubnetPort(
 	return subnetPort, nil
 }
 
+// CheckVirtualNetworkReady returns an error that wraps ErrVirtualNetworkNotFound
+// if the NCP VirtualNetwork does not exist, or ErrVirtualNetworkNotReady if the
+// VirtualNetwork has not been realized by NSX-T. Interfaces created on such a
+// network would otherwise fail much later with an opaque backing error.
+func CheckVirtualNetworkReady(
+	ctx context.Context,
+	client ctrlclient.Client,
+	namespace, name string) error {
+
+	vnet := &ncpv1alpha1.VirtualNetwork{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, vnet); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", ErrVirtualNetworkNotFound, name)
+		}
+		return err
+	}
+
+	for _, cond := range vnet.Status.Conditions {
+		if cond.Type != virtualNetworkReadyConditionType {
+			continue
+		}
+		if cond.Status == string(corev1.ConditionTrue) {
+			return nil
+		}
+		return fmt.Errorf("%w: %s: %s - %s", ErrVirtualNetworkNotReady, name, cond.Reason, cond.Message)
+	}
+
+	return fmt.Errorf("%w: %s", ErrVirtualNetworkNotReady, name)
+}
+
 func waitForReadyNCPNetworkInterface(
 	vmCtx pkgctx.VirtualMachineContext,
 	client ctrlclient.Client,
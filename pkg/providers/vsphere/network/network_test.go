@@ -21,8 +21,10 @@ import (
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
@@ -42,10 +44,12 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 		results     network.NetworkInterfaceResults
 		err         error
 		initObjects []client.Object
+		isCreate    bool
 	)
 
 	BeforeEach(func() {
 		testConfig = builder.VCSimTestConfig{}
+		isCreate = true
 
 		vm = &vmopv1.VirtualMachine{
 			ObjectMeta: metav1.ObjectMeta{
@@ -61,10 +65,15 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 		ctx = suite.NewTestContextForVCSim(testConfig, initObjects...)
 
 		vmCtx = pkgctx.VirtualMachineContext{
-			Context: ctx,
+			Context: ctxop.WithContext(ctx),
 			Logger:  suite.GetLogger().WithName("network_test"),
 			VM:      vm,
 		}
+		if isCreate {
+			ctxop.MarkCreate(vmCtx)
+		} else {
+			ctxop.MarkUpdate(vmCtx)
+		}
 
 		results, err = network.CreateAndWaitForNetworkInterfaces(
 			vmCtx,
@@ -124,6 +133,7 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 						{
 							Name:            "my-network-interface",
 							GuestDeviceName: "eth42",
+							MACAddr:         "00:50:56:2A:BB:CC",
 							Network:         &common.PartialObjectRef{Name: networkName},
 							Addresses: []string{
 								"172.42.1.100/24",
@@ -164,6 +174,10 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 						Expect(result.GuestDeviceName).To(Equal("eth42"))
 					})
 
+					By("has expected MAC address", func() {
+						Expect(result.MacAddress).To(Equal("00:50:56:2a:bb:cc"))
+					})
+
 					Expect(result.DHCP4).To(BeFalse())
 					Expect(result.DHCP6).To(BeFalse())
 
@@ -433,9 +447,28 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 			macAddress    = "01-23-45-67-89-AB-CD-EF"
 		)
 
+		var (
+			vnet *ncpv1alpha1.VirtualNetwork
+		)
+
 		BeforeEach(func() {
 			network.RetryTimeout = 1 * time.Second
 			testConfig.WithNetworkEnv = builder.NetworkEnvNSXT
+
+			vnet = &ncpv1alpha1.VirtualNetwork{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      networkName,
+					Namespace: vm.Namespace,
+				},
+				Status: ncpv1alpha1.VirtualNetworkStatus{
+					Conditions: []ncpv1alpha1.VirtualNetworkCondition{
+						{
+							Type:   "Ready",
+							Status: "True",
+						},
+					},
+				},
+			}
 		})
 
 		Context("Simulate workflow", func() {
@@ -448,6 +481,55 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 						},
 					},
 				}
+				initObjects = append(initObjects, vnet)
+			})
+
+			When("the VirtualNetwork does not exist", func() {
+				BeforeEach(func() {
+					initObjects = nil
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError(network.ErrVirtualNetworkNotFound))
+					Expect(err.Error()).To(ContainSubstring(networkName))
+					Expect(results.Results).To(BeEmpty())
+				})
+
+				When("the VM is updated", func() {
+					BeforeEach(func() {
+						isCreate = false
+					})
+
+					It("does not check the VirtualNetwork", func() {
+						Expect(err).ToNot(MatchError(network.ErrVirtualNetworkNotFound))
+						Expect(err).To(MatchError(ContainSubstring("network interface is not ready yet")))
+					})
+				})
+			})
+
+			When("the VirtualNetwork only has a condition whose type contains Ready", func() {
+				BeforeEach(func() {
+					vnet.Status.Conditions[0].Type = "PreReady"
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError(network.ErrVirtualNetworkNotReady))
+					Expect(results.Results).To(BeEmpty())
+				})
+			})
+
+			When("the VirtualNetwork is not realized", func() {
+				BeforeEach(func() {
+					vnet.Status.Conditions[0].Status = "False"
+					vnet.Status.Conditions[0].Reason = "RealizationFailed"
+					vnet.Status.Conditions[0].Message = "segment error"
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError(network.ErrVirtualNetworkNotReady))
+					Expect(err.Error()).To(ContainSubstring("RealizationFailed - segment error"))
+					Expect(results.Results).To(BeEmpty())
+				})
 			})
 
 			It("returns success", func() {
@@ -691,6 +773,7 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 					Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(subnetPort), subnetPort)).To(Succeed())
 					Expect(subnetPort.Spec.SubnetSet).To(Equal(networkName))
 					Expect(subnetPort.Annotations).To(HaveKeyWithValue(constants.VPCAttachmentRef, annotationVal))
+					Expect(subnetPort.Labels).To(HaveKeyWithValue(network.VMInterfaceNameLabel, interfaceName))
 
 					subnetPort.Status.Attachment.ID = interfaceID
 					subnetPort.Status.NetworkInterfaceConfig.MACAddress = macAddress
@@ -759,3 +842,36 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 		})
 	})
 })
+
+var _ = Describe("NamedNetworkName", func() {
+	var (
+		vm *vmopv1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		vm = builder.DummyBasicVirtualMachine("vm", "ns")
+		vm.Annotations = map[string]string{
+			pkgconst.DefaultNamedNetworkAnnotationKey: "old-network",
+		}
+	})
+
+	When("the VM's network has not been migrated", func() {
+		It("returns the network name", func() {
+			Expect(network.NamedNetworkName(vm, "old-network")).To(Equal("old-network"))
+		})
+	})
+
+	When("the VM's network has been migrated", func() {
+		BeforeEach(func() {
+			vm.Annotations[pkgconst.MigratedNamedNetworkAnnotationKey] = "new-network"
+		})
+
+		It("returns the migrated network name for the default network", func() {
+			Expect(network.NamedNetworkName(vm, "old-network")).To(Equal("new-network"))
+		})
+
+		It("returns the network name for other networks", func() {
+			Expect(network.NamedNetworkName(vm, "other-network")).To(Equal("other-network"))
+		})
+	})
+})
@@ -0,0 +1,245 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+// SecurityRule is a L3/L4 firewall rule for one of a VM's network interfaces.
+// A list of these rules is specified in JSON as the value of the VM's
+// constants.SecurityRulesAnnotation annotation.
+type SecurityRule struct {
+	// Name is the optional display name of the rule.
+	Name string `json:"name,omitempty"`
+
+	// Interface is the name of the VM's network interface to which the rule
+	// applies. The SecurityPolicy selects VMs rather than their interfaces,
+	// so the rule is enforced on all of the VM's VPC network interfaces.
+	Interface string `json:"interface"`
+
+	// Direction is either Ingress or Egress.
+	Direction string `json:"direction"`
+
+	// Action is one of Allow, Drop, or Reject. Defaults to Allow.
+	Action string `json:"action,omitempty"`
+
+	// Protocol is either TCP or UDP. Defaults to TCP.
+	Protocol string `json:"protocol,omitempty"`
+
+	// Port is the port, or start of the port range, to match. When zero, all
+	// ports are matched.
+	Port int32 `json:"port,omitempty"`
+
+	// EndPort is the optional end of the port range.
+	EndPort int32 `json:"endPort,omitempty"`
+
+	// CIDRs is the list of peer IP blocks. For ingress rules these are the
+	// sources and for egress rules these are the destinations. When empty,
+	// all peers are matched.
+	CIDRs []string `json:"cidrs,omitempty"`
+}
+
+// SecurityPolicyName returns the name of the VM's SecurityPolicy.
+func SecurityPolicyName(vmName string) string {
+	return vmName + "-security-rules"
+}
+
+// ParseSecurityRules parses and validates the VM's security rules annotation
+// against the VM's network interfaces.
+func ParseSecurityRules(
+	vm *vmopv1.VirtualMachine,
+	networkSpec *vmopv1.VirtualMachineNetworkSpec) ([]SecurityRule, error) {
+
+	v, ok := vm.Annotations[constants.SecurityRulesAnnotation]
+	if !ok || v == "" {
+		return nil, nil
+	}
+
+	var rules []SecurityRule
+	if err := json.Unmarshal([]byte(v), &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse %s annotation: %w", constants.SecurityRulesAnnotation, err)
+	}
+
+	var interfaceNames []string
+	if networkSpec != nil {
+		for _, ifSpec := range networkSpec.Interfaces {
+			interfaceNames = append(interfaceNames, ifSpec.Name)
+		}
+	}
+
+	for i := range rules {
+		r := &rules[i]
+
+		if !slices.Contains(interfaceNames, r.Interface) {
+			return nil, fmt.Errorf("security rule %d: unknown network interface %q", i, r.Interface)
+		}
+
+		switch vpcv1alpha1.RuleDirection(r.Direction) {
+		case vpcv1alpha1.RuleDirectionIngress, vpcv1alpha1.RuleDirectionIn,
+			vpcv1alpha1.RuleDirectionEgress, vpcv1alpha1.RuleDirectionOut:
+		default:
+			return nil, fmt.Errorf("security rule %d: invalid direction %q", i, r.Direction)
+		}
+
+		switch vpcv1alpha1.RuleAction(r.Action) {
+		case "":
+			r.Action = string(vpcv1alpha1.RuleActionAllow)
+		case vpcv1alpha1.RuleActionAllow, vpcv1alpha1.RuleActionDrop, vpcv1alpha1.RuleActionReject:
+		default:
+			return nil, fmt.Errorf("security rule %d: invalid action %q", i, r.Action)
+		}
+
+		switch corev1.Protocol(r.Protocol) {
+		case "":
+			r.Protocol = string(corev1.ProtocolTCP)
+		case corev1.ProtocolTCP, corev1.ProtocolUDP:
+		default:
+			return nil, fmt.Errorf("security rule %d: invalid protocol %q", i, r.Protocol)
+		}
+
+		if r.Port < 0 || r.Port > 65535 || r.EndPort < 0 || r.EndPort > 65535 {
+			return nil, fmt.Errorf("security rule %d: port out of range", i)
+		}
+		if r.EndPort != 0 && r.EndPort < r.Port {
+			return nil, fmt.Errorf("security rule %d: endPort %d is less than port %d", i, r.EndPort, r.Port)
+		}
+
+		for _, cidr := range r.CIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return nil, fmt.Errorf("security rule %d: invalid CIDR %q", i, cidr)
+			}
+		}
+	}
+
+	return rules, nil
+}
+
+// ReconcileSecurityPolicy creates, updates, or deletes the NSX SecurityPolicy
+// that realizes the VM's security rules. The SecurityPolicy selects the VM by
+// the VMNameLabel, which is added to the VM's labels while it has rules.
+func ReconcileSecurityPolicy(
+	vmCtx pkgctx.VirtualMachineContext,
+	client ctrlclient.Client,
+	networkSpec *vmopv1.VirtualMachineNetworkSpec) error {
+
+	rules, err := ParseSecurityRules(vmCtx.VM, networkSpec)
+	if err != nil {
+		return err
+	}
+
+	securityPolicy := &vpcv1alpha1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SecurityPolicyName(vmCtx.VM.Name),
+			Namespace: vmCtx.VM.Namespace,
+		},
+	}
+
+	if len(rules) == 0 {
+		delete(vmCtx.VM.Labels, VMNameLabel)
+
+		// Only delete the SecurityPolicy if it exists so the VMs without rules
+		// do not issue a delete on every reconcile.
+		if err := client.Get(vmCtx, ctrlclient.ObjectKeyFromObject(securityPolicy), securityPolicy); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to get SecurityPolicy: %w", err)
+		}
+		if err := client.Delete(vmCtx, securityPolicy); ctrlclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete SecurityPolicy: %w", err)
+		}
+		return nil
+	}
+
+	// The VMSelector matches the labels of the VM, not those of its
+	// SubnetPorts, so the VM must have the label the SecurityPolicy selects.
+	if vmCtx.VM.Labels == nil {
+		vmCtx.VM.Labels = map[string]string{}
+	}
+	vmCtx.VM.Labels[VMNameLabel] = vmCtx.VM.Name
+
+	_, err = controllerutil.CreateOrPatch(vmCtx, client, securityPolicy, func() error {
+		if err := controllerutil.SetOwnerReference(vmCtx.VM, securityPolicy, client.Scheme()); err != nil {
+			return err
+		}
+		if securityPolicy.Labels == nil {
+			securityPolicy.Labels = map[string]string{}
+		}
+		securityPolicy.Labels[VMNameLabel] = vmCtx.VM.Name
+
+		securityPolicy.Spec.AppliedTo = []vpcv1alpha1.SecurityPolicyTarget{
+			{
+				VMSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{VMNameLabel: vmCtx.VM.Name},
+				},
+			},
+		}
+		securityPolicy.Spec.Rules = make([]vpcv1alpha1.SecurityPolicyRule, 0, len(rules))
+		for _, r := range rules {
+			securityPolicy.Spec.Rules = append(securityPolicy.Spec.Rules, securityRuleToPolicyRule(r))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or patch SecurityPolicy: %w", err)
+	}
+
+	return nil
+}
+
+// securityRuleToPolicyRule returns the SecurityPolicy rule for r. The rule
+// does not have its own AppliedTo so it applies to the VM selected by the
+// SecurityPolicy.
+func securityRuleToPolicyRule(r SecurityRule) vpcv1alpha1.SecurityPolicyRule {
+	rule := vpcv1alpha1.SecurityPolicyRule{
+		Name:      r.Name,
+		Action:    ptr.To(vpcv1alpha1.RuleAction(r.Action)),
+		Direction: ptr.To(vpcv1alpha1.RuleDirection(r.Direction)),
+	}
+
+	port := vpcv1alpha1.SecurityPolicyPort{
+		Protocol: corev1.Protocol(r.Protocol),
+		EndPort:  int(r.EndPort),
+	}
+	if r.Port != 0 {
+		port.Port = intstr.FromInt32(r.Port)
+	}
+	rule.Ports = []vpcv1alpha1.SecurityPolicyPort{port}
+
+	if len(r.CIDRs) > 0 {
+		peer := vpcv1alpha1.SecurityPolicyPeer{}
+		for _, cidr := range r.CIDRs {
+			peer.IPBlocks = append(peer.IPBlocks, vpcv1alpha1.IPBlock{CIDR: cidr})
+		}
+
+		switch vpcv1alpha1.RuleDirection(r.Direction) {
+		case vpcv1alpha1.RuleDirectionIngress, vpcv1alpha1.RuleDirectionIn:
+			rule.Sources = []vpcv1alpha1.SecurityPolicyPeer{peer}
+		default:
+			rule.Destinations = []vpcv1alpha1.SecurityPolicyPeer{peer}
+		}
+	}
+
+	return rule
+}
@@ -0,0 +1,180 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package network_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("ReconcileSecurityPolicy", Label(testlabels.NSXT), func() {
+
+	var (
+		vmCtx       pkgctx.VirtualMachineContext
+		vm          *vmopv1.VirtualMachine
+		networkSpec *vmopv1.VirtualMachineNetworkSpec
+		k8sClient   client.Client
+		deletes     int
+		err         error
+	)
+
+	BeforeEach(func() {
+		vm = &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "sp-test-vm",
+				Namespace:   "sp-test-ns",
+				Annotations: map[string]string{},
+			},
+		}
+		networkSpec = &vmopv1.VirtualMachineNetworkSpec{
+			Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+				{Name: "eth0"},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		deletes = 0
+		k8sClient = builder.NewFakeClientWithInterceptors(interceptor.Funcs{
+			Delete: func(
+				ctx context.Context,
+				client client.WithWatch,
+				obj client.Object,
+				opts ...client.DeleteOption) error {
+
+				deletes++
+				return client.Delete(ctx, obj, opts...)
+			},
+		}, vm)
+		vmCtx = pkgctx.VirtualMachineContext{
+			Context: context.Background(),
+			Logger:  suite.GetLogger().WithName("securitypolicy_test"),
+			VM:      vm,
+		}
+		err = network.ReconcileSecurityPolicy(vmCtx, k8sClient, networkSpec)
+	})
+
+	getSecurityPolicy := func() (*vpcv1alpha1.SecurityPolicy, error) {
+		sp := &vpcv1alpha1.SecurityPolicy{}
+		key := client.ObjectKey{Namespace: vm.Namespace, Name: network.SecurityPolicyName(vm.Name)}
+		return sp, k8sClient.Get(vmCtx, key, sp)
+	}
+
+	When("VM does not have the annotation", func() {
+		It("does not create a SecurityPolicy", func() {
+			Expect(err).ToNot(HaveOccurred())
+			_, err := getSecurityPolicy()
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("does not delete the SecurityPolicy that does not exist", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deletes).To(BeZero())
+		})
+	})
+
+	When("VM has valid rules", func() {
+		BeforeEach(func() {
+			vm.Annotations[constants.SecurityRulesAnnotation] = `[
+				{"interface":"eth0","direction":"Ingress","port":22,"cidrs":["10.0.0.0/8"]},
+				{"interface":"eth0","direction":"Egress","action":"Drop","protocol":"UDP"}
+			]`
+		})
+
+		It("creates the SecurityPolicy", func() {
+			Expect(err).ToNot(HaveOccurred())
+			sp, err := getSecurityPolicy()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sp.Labels).To(HaveKeyWithValue(network.VMNameLabel, vm.Name))
+			Expect(sp.OwnerReferences).To(HaveLen(1))
+			Expect(sp.Spec.AppliedTo).To(HaveLen(1))
+			Expect(sp.Spec.AppliedTo[0].VMSelector.MatchLabels).To(HaveKeyWithValue(network.VMNameLabel, vm.Name))
+			Expect(sp.Spec.Rules).To(HaveLen(2))
+
+			ingress := sp.Spec.Rules[0]
+			Expect(*ingress.Direction).To(Equal(vpcv1alpha1.RuleDirectionIngress))
+			Expect(*ingress.Action).To(Equal(vpcv1alpha1.RuleActionAllow))
+			Expect(ingress.AppliedTo).To(BeEmpty())
+			Expect(ingress.Ports).To(HaveLen(1))
+			Expect(ingress.Ports[0].Protocol).To(Equal(corev1.ProtocolTCP))
+			Expect(ingress.Ports[0].Port.IntValue()).To(Equal(22))
+			Expect(ingress.Sources).To(HaveLen(1))
+			Expect(ingress.Sources[0].IPBlocks).To(ConsistOf(vpcv1alpha1.IPBlock{CIDR: "10.0.0.0/8"}))
+			Expect(ingress.Destinations).To(BeEmpty())
+
+			egress := sp.Spec.Rules[1]
+			Expect(*egress.Direction).To(Equal(vpcv1alpha1.RuleDirectionEgress))
+			Expect(*egress.Action).To(Equal(vpcv1alpha1.RuleActionDrop))
+			Expect(egress.Ports[0].Protocol).To(Equal(corev1.ProtocolUDP))
+			Expect(egress.Sources).To(BeEmpty())
+		})
+
+		It("selects the VM", func() {
+			Expect(err).ToNot(HaveOccurred())
+			sp, err := getSecurityPolicy()
+			Expect(err).ToNot(HaveOccurred())
+
+			selector, err := metav1.LabelSelectorAsSelector(sp.Spec.AppliedTo[0].VMSelector)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selector.Matches(labels.Set(vm.Labels))).To(BeTrue())
+
+			otherVM := &vmopv1.VirtualMachine{}
+			otherVM.Labels = map[string]string{network.VMNameLabel: "other-vm"}
+			Expect(selector.Matches(labels.Set(otherVM.Labels))).To(BeFalse())
+		})
+
+		When("the annotation is removed", func() {
+			It("deletes the SecurityPolicy", func() {
+				Expect(err).ToNot(HaveOccurred())
+				_, err := getSecurityPolicy()
+				Expect(err).ToNot(HaveOccurred())
+
+				delete(vm.Annotations, constants.SecurityRulesAnnotation)
+				Expect(network.ReconcileSecurityPolicy(vmCtx, k8sClient, networkSpec)).To(Succeed())
+				_, err = getSecurityPolicy()
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				Expect(deletes).To(Equal(1))
+				Expect(vm.Labels).ToNot(HaveKey(network.VMNameLabel))
+
+				Expect(network.ReconcileSecurityPolicy(vmCtx, k8sClient, networkSpec)).To(Succeed())
+				Expect(deletes).To(Equal(1))
+			})
+		})
+	})
+
+	DescribeTable("VM has invalid rules",
+		func(annotation, expectedErr string) {
+			vm.Annotations[constants.SecurityRulesAnnotation] = annotation
+			_, err := network.ParseSecurityRules(vm, networkSpec)
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("invalid JSON", `{`, "failed to parse"),
+		Entry("unknown interface", `[{"interface":"eth1","direction":"Ingress"}]`, "unknown network interface"),
+		Entry("invalid direction", `[{"interface":"eth0","direction":"Sideways"}]`, "invalid direction"),
+		Entry("invalid action", `[{"interface":"eth0","direction":"In","action":"Maybe"}]`, "invalid action"),
+		Entry("invalid protocol", `[{"interface":"eth0","direction":"In","protocol":"ICMP"}]`, "invalid protocol"),
+		Entry("invalid port range", `[{"interface":"eth0","direction":"In","port":80,"endPort":70}]`, "less than port"),
+		Entry("invalid CIDR", `[{"interface":"eth0","direction":"In","cidrs":["10.0.0.0"]}]`, "invalid CIDR"),
+	)
+})
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
@@ -19,6 +18,12 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/util"
 )
 
+// Finder looks up datastores in the vSphere inventory. It is satisfied by both
+// *find.Finder and the client's inventory cache.
+type Finder interface {
+	Datastore(ctx context.Context, path string) (*object.Datastore, error)
+}
+
 // Recommendation is the info about a placement recommendation.
 type Recommendation struct {
 	PoolMoRef  vimtypes.ManagedObjectReference
@@ -61,7 +66,7 @@ func relocateSpecToRecommendation(
 
 func clusterPlacementActionToRecommendation(
 	ctx context.Context,
-	finder *find.Finder,
+	finder Finder,
 	action vimtypes.ClusterClusterInitialPlacementAction) (*Recommendation, error) {
 
 	r := Recommendation{
@@ -182,11 +187,13 @@ func CloneVMRelocateSpec(
 func PlaceVMForCreate(
 	vmCtx pkgctx.VirtualMachineContext,
 	cluster *object.ClusterComputeResource,
-	configSpec vimtypes.VirtualMachineConfigSpec) ([]Recommendation, error) {
+	configSpec vimtypes.VirtualMachineConfigSpec,
+	hosts []vimtypes.ManagedObjectReference) ([]Recommendation, error) {
 
 	placementSpec := vimtypes.PlacementSpec{
 		PlacementType: string(vimtypes.PlacementSpecPlacementTypeCreate),
 		ConfigSpec:    &configSpec,
+		Hosts:         hosts,
 	}
 
 	vmCtx.Logger.V(4).Info("PlaceVMForCreate request", "placementSpec", vimtypes.ToString(placementSpec))
@@ -221,7 +228,7 @@ func PlaceVMForCreate(
 func ClusterPlaceVMForCreate(
 	vmCtx pkgctx.VirtualMachineContext,
 	vcClient *vim25.Client,
-	finder *find.Finder,
+	finder Finder,
 	resourcePoolsMoRefs []vimtypes.ManagedObjectReference,
 	configSpec vimtypes.VirtualMachineConfigSpec,
 	needHostPlacement, needDatastorePlacement bool) ([]Recommendation, error) {
@@ -10,7 +10,6 @@ import (
 	"math/rand"
 	"strings"
 
-	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/vim25"
@@ -23,8 +22,10 @@ import (
 	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vcenter"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
 	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
@@ -37,6 +38,19 @@ type Constraints struct {
 	// will further be filtered by.
 	Zones sets.Set[string]
 
+	// ExcludedZones when non-empty is the set of zone names that are removed from the possible
+	// placement candidates, ex. because they already have the maximum number of VMs.
+	ExcludedZones sets.Set[string]
+
+	// PreferredSite when non-empty is the name of the site, i.e. the vSAN fault domain of a
+	// stretched cluster, whose hosts the VM should be placed on. The hint is ignored for a
+	// cluster that does not have any hosts in the site.
+	PreferredSite string
+
+	// RequiredCapabilities when non-empty is the set of capabilities, ex. vSAN, that the cluster
+	// and host of the VM must have. Candidates without the capabilities are removed.
+	RequiredCapabilities []virtualmachine.Capability
+
 	// TODO: ClusterModules?
 }
 
@@ -234,11 +248,13 @@ func rpMoIDToCluster(
 }
 
 // getPlacementRecommendations calls DRS PlaceVM to determine clusters suitable for placement.
+// When site is non-empty, PlaceVM is limited to the hosts of each cluster that are in the site.
 func getPlacementRecommendations(
 	vmCtx pkgctx.VirtualMachineContext,
 	vcClient *vim25.Client,
 	candidates map[string][]string,
-	configSpec vimtypes.VirtualMachineConfigSpec) map[string][]Recommendation {
+	configSpec vimtypes.VirtualMachineConfigSpec,
+	site string) map[string][]Recommendation {
 
 	recommendations := map[string][]Recommendation{}
 
@@ -252,7 +268,21 @@ func getPlacementRecommendations(
 				continue
 			}
 
-			recs, err := PlaceVMForCreate(vmCtx, cluster, configSpec)
+			var hosts []vimtypes.ManagedObjectReference
+			if site != "" {
+				hosts, err = vcenter.GetClusterHostsInSite(vmCtx, cluster, site)
+				if err != nil {
+					vmCtx.Logger.Error(err, "failed to get cluster hosts in site", "zone", zoneName,
+						"clusterMoID", cluster.Reference().Value, "site", site)
+					continue
+				}
+				if len(hosts) == 0 {
+					vmCtx.Logger.Info("No cluster hosts in preferred site, ignoring site", "zone", zoneName,
+						"clusterMoID", cluster.Reference().Value, "site", site)
+				}
+			}
+
+			recs, err := PlaceVMForCreate(vmCtx, cluster, configSpec, hosts)
 			if err != nil {
 				vmCtx.Logger.Error(err, "PlaceVM failed", "zone", zoneName,
 					"clusterMoID", cluster.Reference().Value, "rpMoID", rpMoID)
@@ -286,7 +316,7 @@ func getPlacementRecommendations(
 func getZonalPlacementRecommendations(
 	vmCtx pkgctx.VirtualMachineContext,
 	vcClient *vim25.Client,
-	finder *find.Finder,
+	finder Finder,
 	candidates map[string][]string,
 	configSpec vimtypes.VirtualMachineConfigSpec,
 	needHostPlacement, needDatastorePlacement bool) map[string][]Recommendation {
@@ -311,7 +341,7 @@ func getZonalPlacementRecommendations(
 			// This is a hack until PlaceVmsXCluster() supports instance storage disks.
 			vmCtx.Logger.Info("Falling back into non-zonal placement since the only candidate needs host selected",
 				"rpMoID", candidateRPMoRefs[0].Value)
-			return getPlacementRecommendations(vmCtx, vcClient, candidates, configSpec)
+			return getPlacementRecommendations(vmCtx, vcClient, candidates, configSpec, "")
 		}
 
 		recs = append(recs, Recommendation{
@@ -370,14 +400,19 @@ func Placement(
 	vmCtx pkgctx.VirtualMachineContext,
 	client ctrlclient.Client,
 	vcClient *vim25.Client,
-	finder *find.Finder,
+	finder Finder,
 	configSpec vimtypes.VirtualMachineConfigSpec,
 	constraints Constraints) (*Result, error) {
 
 	curResult := doesVMNeedPlacement(vmCtx)
+	needSitePlacement := constraints.PreferredSite != "" && curResult.HostMoRef == nil
+	needCapabilityPlacement := len(constraints.RequiredCapabilities) > 0 && curResult.HostMoRef == nil
+
 	if !curResult.needZonePlacement &&
 		!curResult.needHostPlacement &&
-		!curResult.needDatastorePlacement {
+		!curResult.needDatastorePlacement &&
+		!needSitePlacement &&
+		!needCapabilityPlacement {
 
 		// VM does not require any type of placement, so we can return early.
 		return &curResult, nil
@@ -424,9 +459,50 @@ func Placement(
 		candidates = allowedCandidates
 	}
 
+	if constraints.ExcludedZones.Len() > 0 {
+		for zoneName := range candidates {
+			if constraints.ExcludedZones.Has(zoneName) {
+				delete(candidates, zoneName)
+			}
+		}
+
+		if len(candidates) == 0 {
+			return nil, fmt.Errorf("no placement candidates available after excluding zones: %s",
+				strings.Join(sets.List(constraints.ExcludedZones), ","))
+		}
+	}
+
+	if needCapabilityPlacement {
+		candidates, err = filterCandidatesByCapabilities(
+			vmCtx,
+			vcClient,
+			candidates,
+			constraints.RequiredCapabilities)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(candidates) == 0 {
+			return nil, providers.NewError(
+				providers.ErrorReasonCapabilityMismatch,
+				fmt.Errorf("no placement candidates available with the required capabilities: %v",
+					constraints.RequiredCapabilities))
+		}
+	}
+
 	// TBD: May want to get the host for vGPU and other passthru devices too.
 	var recommendations map[string][]Recommendation
-	if curResult.needZonePlacement {
+	switch {
+	case needSitePlacement:
+		// PlaceVmsXCluster() cannot be limited to a set of hosts so use PlaceVM on each
+		// candidate cluster when the VM prefers a site.
+		recommendations = getPlacementRecommendations(
+			vmCtx,
+			vcClient,
+			candidates,
+			configSpec,
+			constraints.PreferredSite)
+	case curResult.needZonePlacement:
 		recommendations = getZonalPlacementRecommendations(
 			vmCtx,
 			vcClient,
@@ -435,8 +511,19 @@ func Placement(
 			configSpec,
 			curResult.needHostPlacement,
 			curResult.needDatastorePlacement)
-	} else /* needHostPlacement or needDatastorePlacement */ {
-		recommendations = getPlacementRecommendations(vmCtx, vcClient, candidates, configSpec)
+	default: /* needHostPlacement, needDatastorePlacement, or needCapabilityPlacement */
+		recommendations = getPlacementRecommendations(vmCtx, vcClient, candidates, configSpec, "")
+	}
+	recommendations = filterUnavailableHostRecommendations(vmCtx, vcClient, recommendations)
+	if needCapabilityPlacement {
+		recommendations, err = filterHostRecommendationsByCapabilities(
+			vmCtx,
+			vcClient,
+			recommendations,
+			constraints.RequiredCapabilities)
+		if err != nil {
+			return nil, err
+		}
 	}
 	if len(recommendations) == 0 {
 		return nil, fmt.Errorf("no placement recommendations available")
@@ -466,6 +553,158 @@ func Placement(
 	return &result, nil
 }
 
+// filterUnavailableHostRecommendations removes the recommendations for hosts
+// that are in maintenance mode, disconnected, or not responding. A zone is
+// removed when none of its recommendations remain.
+func filterUnavailableHostRecommendations(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcClient *vim25.Client,
+	recommendations map[string][]Recommendation) map[string][]Recommendation {
+
+	var (
+		hostMoRefs []vimtypes.ManagedObjectReference
+		seen       = map[string]struct{}{}
+	)
+	for _, recs := range recommendations {
+		for _, rec := range recs {
+			if rec.HostMoRef == nil {
+				continue
+			}
+			if _, ok := seen[rec.HostMoRef.Value]; !ok {
+				seen[rec.HostMoRef.Value] = struct{}{}
+				hostMoRefs = append(hostMoRefs, *rec.HostMoRef)
+			}
+		}
+	}
+
+	if len(hostMoRefs) == 0 {
+		return recommendations
+	}
+
+	unavailable, err := vcenter.GetUnavailableHosts(vmCtx, vcClient, hostMoRefs)
+	if err != nil {
+		vmCtx.Logger.Error(err, "failed to get unavailable hosts, not filtering recommendations")
+		return recommendations
+	}
+
+	if len(unavailable) == 0 {
+		return recommendations
+	}
+
+	filtered := map[string][]Recommendation{}
+	for zoneName, recs := range recommendations {
+		for _, rec := range recs {
+			if rec.HostMoRef != nil {
+				if reason, ok := unavailable[rec.HostMoRef.Value]; ok {
+					vmCtx.Logger.Info("Ignoring placement recommendation for unavailable host",
+						"zone", zoneName, "hostMoID", rec.HostMoRef.Value, "reason", reason)
+					continue
+				}
+			}
+			filtered[zoneName] = append(filtered[zoneName], rec)
+		}
+	}
+
+	return filtered
+}
+
+// filterCandidatesByCapabilities removes the candidate resource pools whose
+// cluster does not have the required capabilities. A zone is removed when none
+// of its candidates remain.
+func filterCandidatesByCapabilities(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcClient *vim25.Client,
+	candidates map[string][]string,
+	required []virtualmachine.Capability) (map[string][]string, error) {
+
+	clusterCaps := map[vimtypes.ManagedObjectReference]vcenter.Capabilities{}
+	filtered := map[string][]string{}
+
+	for zoneName, rpMoIDs := range candidates {
+		for _, rpMoID := range rpMoIDs {
+			rpMoRef := vimtypes.ManagedObjectReference{Type: "ResourcePool", Value: rpMoID}
+
+			cluster, err := rpMoIDToCluster(vmCtx, vcClient, rpMoRef)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get CCR from RP %s: %w", rpMoID, err)
+			}
+
+			caps, ok := clusterCaps[cluster.Reference()]
+			if !ok {
+				caps, err = vcenter.GetClusterCapabilities(vmCtx, cluster)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get capabilities of cluster %s: %w",
+						cluster.Reference().Value, err)
+				}
+				clusterCaps[cluster.Reference()] = caps
+			}
+
+			if missing := virtualmachine.MissingCapabilities(required, caps); len(missing) > 0 {
+				vmCtx.Logger.Info("Removed candidate cluster without the required capabilities",
+					"zone", zoneName, "clusterMoID", cluster.Reference().Value, "rpMoID", rpMoID,
+					"missing", missing)
+				continue
+			}
+
+			filtered[zoneName] = append(filtered[zoneName], rpMoID)
+		}
+	}
+
+	return filtered, nil
+}
+
+// filterHostRecommendationsByCapabilities removes the recommendations for hosts
+// that do not have the required capabilities. A zone is removed when none of
+// its recommendations remain.
+func filterHostRecommendationsByCapabilities(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcClient *vim25.Client,
+	recommendations map[string][]Recommendation,
+	required []virtualmachine.Capability) (map[string][]Recommendation, error) {
+
+	var (
+		hostMoRefs []vimtypes.ManagedObjectReference
+		seen       = map[string]struct{}{}
+	)
+	for _, recs := range recommendations {
+		for _, rec := range recs {
+			if rec.HostMoRef == nil {
+				continue
+			}
+			if _, ok := seen[rec.HostMoRef.Value]; !ok {
+				seen[rec.HostMoRef.Value] = struct{}{}
+				hostMoRefs = append(hostMoRefs, *rec.HostMoRef)
+			}
+		}
+	}
+
+	if len(hostMoRefs) == 0 {
+		return recommendations, nil
+	}
+
+	hostCaps, err := vcenter.GetHostsCapabilities(vmCtx, vcClient, hostMoRefs)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := map[string][]Recommendation{}
+	for zoneName, recs := range recommendations {
+		for _, rec := range recs {
+			if rec.HostMoRef != nil {
+				missing := virtualmachine.MissingCapabilities(required, hostCaps[rec.HostMoRef.Value])
+				if len(missing) > 0 {
+					vmCtx.Logger.Info("Ignoring placement recommendation for host without the required capabilities",
+						"zone", zoneName, "hostMoID", rec.HostMoRef.Value, "missing", missing)
+					continue
+				}
+			}
+			filtered[zoneName] = append(filtered[zoneName], rec)
+		}
+	}
+
+	return filtered, nil
+}
+
 func getDatastoreProperties(
 	vmCtx pkgctx.VirtualMachineContext,
 	vcClient *vim25.Client,
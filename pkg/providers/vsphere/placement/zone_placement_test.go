@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	vimcrypto "github.com/vmware/govmomi/crypto"
 	"github.com/vmware/govmomi/simulator"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,8 +21,10 @@ import (
 	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/placement"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
 	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
@@ -295,6 +298,25 @@ func vcSimPlacement() {
 				})
 			})
 
+			Context("Excluded Zone Constraints", func() {
+				Context("All zones are excluded", func() {
+					It("returns error", func() {
+						constraints.ExcludedZones = sets.New(ctx.ZoneNames...)
+						_, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+						Expect(err).To(MatchError(HavePrefix("no placement candidates available after excluding zones: ")))
+					})
+				})
+
+				Context("A zone is excluded", func() {
+					It("returns success", func() {
+						constraints.ExcludedZones = sets.New(ctx.ZoneNames[0])
+						result, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(result.ZoneName).To(BeElementOf(ctx.ZoneNames[1:]))
+					})
+				})
+			})
+
 			Context("Instance Storage Placement", func() {
 
 				BeforeEach(func() {
@@ -337,6 +359,26 @@ func vcSimPlacement() {
 					Expect(result.PoolMoRef.Value).To(Equal(nsRP.Reference().Value))
 				})
 
+				When("all hosts are in maintenance mode", func() {
+					JustBeforeEach(func() {
+						for _, hostEnt := range simulator.Map.All("HostSystem") {
+							simulator.Map.WithLock(
+								simulator.SpoofContext(),
+								hostEnt.Reference(),
+								func() {
+									host := simulator.Map.Get(hostEnt.Reference()).(*simulator.HostSystem)
+									host.Runtime.InMaintenanceMode = true
+								})
+						}
+					})
+
+					It("returns an error", func() {
+						_, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("no placement recommendations available"))
+					})
+				})
+
 				Context("VM is in child RP via ResourcePolicy", func() {
 					It("returns success", func() {
 						resourcePolicy, _ := ctx.CreateVirtualMachineSetResourcePolicy("my-child-rp", nsInfo)
@@ -558,6 +600,137 @@ func vcSimPlacement() {
 		})
 	})
 
+	Describe("Preferred Site Placement", func() {
+		const siteName = "site-a"
+
+		var siteHosts []vimtypes.ManagedObjectReference
+
+		BeforeEach(func() {
+			testConfig = builder.VCSimTestConfig{
+				WithoutWorkloadDomainIsolation: true,
+			}
+		})
+
+		JustBeforeEach(func() {
+			siteHosts = nil
+
+			// vcsim's PlaceVM only supports a host list that includes all the cluster's
+			// hosts so place all of them in the site.
+			for _, hostEnt := range simulator.Map.All("HostSystem") {
+				simulator.Map.WithLock(
+					simulator.SpoofContext(),
+					hostEnt.Reference(),
+					func() {
+						host := simulator.Map.Get(hostEnt.Reference()).(*simulator.HostSystem)
+						host.Config.VsanHostConfig = &vimtypes.VsanHostConfigInfo{
+							FaultDomainInfo: &vimtypes.VsanHostFaultDomainInfo{
+								Name: siteName,
+							},
+						}
+					})
+				siteHosts = append(siteHosts, hostEnt.Reference())
+			}
+
+			constraints.PreferredSite = siteName
+		})
+
+		It("returns success with a host in the site", func() {
+			result, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result.ZonePlacement).To(BeTrue())
+			Expect(result.ZoneName).To(BeElementOf(ctx.ZoneNames))
+			Expect(result.InstanceStoragePlacement).To(BeFalse())
+			Expect(result.HostMoRef).ToNot(BeNil())
+			Expect(*result.HostMoRef).To(BeElementOf(siteHosts))
+
+			nsRP := ctx.GetResourcePoolForNamespace(vm.Namespace, result.ZoneName, "")
+			Expect(nsRP).ToNot(BeNil())
+			Expect(result.PoolMoRef.Value).To(Equal(nsRP.Reference().Value))
+		})
+
+		Context("zone already assigned", func() {
+			JustBeforeEach(func() {
+				vm.Labels[topology.KubernetesTopologyZoneLabelKey] = ctx.ZoneNames[0]
+			})
+
+			It("returns success with a host in the site", func() {
+				result, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result.ZoneName).To(Equal(ctx.ZoneNames[0]))
+				Expect(result.HostMoRef).ToNot(BeNil())
+				Expect(*result.HostMoRef).To(BeElementOf(siteHosts))
+
+				nsRP := ctx.GetResourcePoolForNamespace(vm.Namespace, result.ZoneName, "")
+				Expect(nsRP).ToNot(BeNil())
+				Expect(result.PoolMoRef.Value).To(Equal(nsRP.Reference().Value))
+			})
+		})
+
+		Context("no hosts are in the site", func() {
+			JustBeforeEach(func() {
+				constraints.PreferredSite = "site-b"
+			})
+
+			It("ignores the site and returns success", func() {
+				result, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result.ZoneName).To(BeElementOf(ctx.ZoneNames))
+				Expect(result.HostMoRef).ToNot(BeNil())
+			})
+		})
+	})
+
+	Describe("Required Capabilities Placement", func() {
+
+		BeforeEach(func() {
+			constraints.RequiredCapabilities = []virtualmachine.Capability{virtualmachine.CapabilityVTPM}
+		})
+
+		When("no cluster has the required capabilities", func() {
+			It("returns a capability mismatch error", func() {
+				_, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+				Expect(err).To(MatchError("no placement candidates available with the required capabilities: [vtpm]"))
+				reason, ok := providers.ReasonFromError(err)
+				Expect(ok).To(BeTrue())
+				Expect(reason).To(Equal(providers.ErrorReasonCapabilityMismatch))
+			})
+		})
+
+		When("the clusters have the required capabilities", func() {
+			JustBeforeEach(func() {
+				m := vimcrypto.NewManagerKmip(ctx.VCClient.Client)
+				Expect(m.MarkDefault(ctx, ctx.NativeKeyProviderID)).To(Succeed())
+			})
+
+			It("returns success", func() {
+				result, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.ZonePlacement).To(BeTrue())
+				Expect(result.ZoneName).To(BeElementOf(ctx.ZoneNames))
+				Expect(result.PoolMoRef).ToNot(BeZero())
+			})
+
+			Context("zone already assigned", func() {
+				JustBeforeEach(func() {
+					vm.Labels[topology.KubernetesTopologyZoneLabelKey] = ctx.ZoneNames[0]
+				})
+
+				It("returns success with a resource pool in the zone", func() {
+					result, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.ZoneName).To(Equal(ctx.ZoneNames[0]))
+
+					nsRP := ctx.GetResourcePoolForNamespace(vm.Namespace, result.ZoneName, "")
+					Expect(nsRP).ToNot(BeNil())
+					Expect(result.PoolMoRef.Value).To(Equal(nsRP.Reference().Value))
+				})
+			})
+		})
+	})
+
 	Describe("When FSS_WCP_VMSERVICE_FAST_DEPLOY enabled", func() {
 		BeforeEach(func() {
 			pkgcfg.SetContext(parentCtx, func(config *pkgcfg.Config) {
@@ -17,13 +17,27 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	pkgtask "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
 	vmutil "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/vm"
 )
 
+// VirtualMachinePropertiesSelector is the set of properties retrieved when
+// the VirtualMachine's cached properties are loaded.
+var VirtualMachinePropertiesSelector = []string{
+	"config",
+	"guest",
+	"runtime",
+	"summary",
+}
+
 type VirtualMachine struct {
 	Name             string
 	vcVirtualMachine *object.VirtualMachine
 	logger           logr.Logger
+
+	// moVM is the cached snapshot of the VM's properties. It is nil until the
+	// properties are loaded, and is reset when the VM is changed.
+	moVM *mo.VirtualMachine
 }
 
 var log = logf.Log.WithName("vmresource")
@@ -36,6 +50,18 @@ func NewVMFromObject(objVM *object.VirtualMachine) *VirtualMachine {
 	}
 }
 
+// NewVMFromObjectWithProperties returns a VirtualMachine whose cached
+// properties are seeded with the provided, already retrieved properties. The
+// properties must include those in VirtualMachinePropertiesSelector.
+func NewVMFromObjectWithProperties(
+	objVM *object.VirtualMachine,
+	moVM mo.VirtualMachine) *VirtualMachine {
+
+	vm := NewVMFromObject(objVM)
+	vm.moVM = &moVM
+	return vm
+}
+
 func (vm *VirtualMachine) VcVM() *object.VirtualMachine {
 	return vm.vcVirtualMachine
 }
@@ -52,12 +78,15 @@ func (vm *VirtualMachine) Create(ctx context.Context, folder *object.Folder, poo
 		return err
 	}
 
+	pkgtask.Tag(ctx, createTask)
+
 	result, err := createTask.WaitForResult(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("create VM %q task failed: %w", vm.Name, err)
 	}
 
 	vm.vcVirtualMachine = object.NewVirtualMachine(folder.Client(), result.Result.(vimtypes.ManagedObjectReference))
+	vm.Invalidate()
 	return nil
 }
 
@@ -69,6 +98,8 @@ func (vm *VirtualMachine) Clone(ctx context.Context, folder *object.Folder, clon
 		return nil, err
 	}
 
+	pkgtask.Tag(ctx, cloneTask)
+
 	result, err := cloneTask.WaitForResult(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("clone VM task failed: %w", err)
@@ -93,6 +124,10 @@ func (vm *VirtualMachine) Reconfigure(
 		return nil, err
 	}
 
+	pkgtask.Tag(ctx, reconfigureTask)
+
+	defer vm.Invalidate()
+
 	taskInfo, err := reconfigureTask.WaitForResult(ctx, nil)
 	if err != nil {
 		return taskInfo, fmt.Errorf("reconfigure VM task failed: %w", err)
@@ -112,6 +147,37 @@ func (vm *VirtualMachine) GetProperties(ctx context.Context, properties []string
 	return &o, nil
 }
 
+// Refresh retrieves the properties in VirtualMachinePropertiesSelector with a
+// single call and caches them.
+func (vm *VirtualMachine) Refresh(ctx context.Context) error {
+	moVM, err := vm.GetProperties(ctx, VirtualMachinePropertiesSelector)
+	if err != nil {
+		return err
+	}
+
+	vm.moVM = moVM
+	return nil
+}
+
+// Invalidate discards the cached properties so they are retrieved again the
+// next time they are needed. Invalidate should be called after the VM is
+// changed by something other than this VirtualMachine's methods.
+func (vm *VirtualMachine) Invalidate() {
+	vm.moVM = nil
+}
+
+// Properties returns the VM's cached properties, retrieving them if they have
+// not been loaded.
+func (vm *VirtualMachine) Properties(ctx context.Context) (*mo.VirtualMachine, error) {
+	if vm.moVM == nil {
+		if err := vm.Refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return vm.moVM, nil
+}
+
 func (vm *VirtualMachine) ReferenceValue() string {
 	vm.logger.V(5).Info("Get ReferenceValue")
 	return vm.vcVirtualMachine.Reference().Value
@@ -155,6 +221,8 @@ func (vm *VirtualMachine) SetPowerState(
 
 	ctxop.MarkUpdate(ctx)
 
+	defer vm.Invalidate()
+
 	_, err := vmutil.SetAndWaitOnPowerState(
 		ctx,
 		vm.VcVM().Client(),
@@ -180,21 +248,26 @@ func (vm *VirtualMachine) SetPowerState(
 // GetVirtualDevices returns the VMs VirtualDeviceList.
 func (vm *VirtualMachine) GetVirtualDevices(ctx context.Context) (object.VirtualDeviceList, error) {
 	vm.logger.V(5).Info("GetVirtualDevices")
-	deviceList, err := vm.vcVirtualMachine.Device(ctx)
+	moVM, err := vm.Properties(ctx)
 	if err != nil {
 		vm.logger.Error(err, "Failed to get devices for VM")
 		return nil, err
 	}
 
-	return deviceList, err
+	// See GoVmomi's VirtualMachine::Device() explanation for this check.
+	if moVM.Config == nil {
+		return nil, fmt.Errorf("VM config is not available, connectionState=%s",
+			moVM.Summary.Runtime.ConnectionState)
+	}
+
+	return object.VirtualDeviceList(moVM.Config.Hardware.Device), nil
 }
 
 // GetVirtualDisks returns the list of VMs vmdks.
 func (vm *VirtualMachine) GetVirtualDisks(ctx context.Context) (object.VirtualDeviceList, error) {
 	vm.logger.V(5).Info("GetVirtualDisks")
-	deviceList, err := vm.vcVirtualMachine.Device(ctx)
+	deviceList, err := vm.GetVirtualDevices(ctx)
 	if err != nil {
-		vm.logger.Error(err, "Failed to get devices for VM")
 		return nil, err
 	}
 
@@ -203,9 +276,8 @@ func (vm *VirtualMachine) GetVirtualDisks(ctx context.Context) (object.VirtualDe
 
 func (vm *VirtualMachine) GetNetworkDevices(ctx context.Context) (object.VirtualDeviceList, error) {
 	vm.logger.V(4).Info("GetNetworkDevices")
-	devices, err := vm.vcVirtualMachine.Device(ctx)
+	devices, err := vm.GetVirtualDevices(ctx)
 	if err != nil {
-		vm.logger.Error(err, "Failed to get devices for VM")
 		return nil, err
 	}
 
@@ -223,6 +295,10 @@ func (vm *VirtualMachine) Customize(ctx context.Context, spec vimtypes.Customiza
 		return err
 	}
 
+	pkgtask.Tag(ctx, customizeTask)
+
+	defer vm.Invalidate()
+
 	taskInfo, err := customizeTask.WaitForResult(ctx, nil)
 	if err != nil {
 		vm.logger.Error(err, "Failed to wait for the result of Customize VM")
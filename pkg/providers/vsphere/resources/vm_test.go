@@ -0,0 +1,115 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package resources_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
+	res "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/resources"
+)
+
+var _ = Describe("VirtualMachine", Label(testlabels.VCSim), func() {
+
+	getVM := func(ctx context.Context, c *vim25.Client) *object.VirtualMachine {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	// addNIC adds a NIC to the VM without going through the VM resource.
+	addNIC := func(ctx context.Context, vm *object.VirtualMachine) {
+		network, err := find.NewFinder(vm.Client()).Network(ctx, "VM Network")
+		Expect(err).ToNot(HaveOccurred())
+		backing, err := network.EthernetCardBackingInfo(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		nic, err := object.EthernetCardTypes().CreateEthernetCard("vmxnet3", backing)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.AddDevice(ctx, nic)).To(Succeed())
+	}
+
+	It("lazily loads and caches the properties", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vcVM := getVM(ctx, c)
+			resVM := res.NewVMFromObject(vcVM)
+
+			nics, err := resVM.GetNetworkDevices(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nics).To(HaveLen(1))
+
+			addNIC(ctx, vcVM)
+
+			// The cached properties are still used.
+			nics, err = resVM.GetNetworkDevices(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nics).To(HaveLen(1))
+
+			resVM.Invalidate()
+			nics, err = resVM.GetNetworkDevices(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nics).To(HaveLen(2))
+
+			disks, err := resVM.GetVirtualDisks(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(disks).To(HaveLen(1))
+		})
+	})
+
+	It("uses the seeded properties", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vcVM := getVM(ctx, c)
+			resVM := res.NewVMFromObjectWithProperties(vcVM, mo.VirtualMachine{
+				Summary: vimtypes.VirtualMachineSummary{
+					Runtime: vimtypes.VirtualMachineRuntimeInfo{
+						PowerState: vimtypes.VirtualMachinePowerStateSuspended,
+					},
+				},
+			})
+
+			moVM, err := resVM.Properties(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(moVM.Summary.Runtime.PowerState).To(Equal(vimtypes.VirtualMachinePowerStateSuspended))
+
+			_, err = resVM.GetVirtualDevices(ctx)
+			Expect(err).To(MatchError(ContainSubstring("VM config is not available")))
+
+			Expect(resVM.Refresh(ctx)).To(Succeed())
+			moVM, err = resVM.Properties(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(moVM.Summary.Runtime.PowerState).To(Equal(vimtypes.VirtualMachinePowerStatePoweredOn))
+		})
+	})
+
+	It("invalidates the properties when the VM is changed", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vcVM := getVM(ctx, c)
+			resVM := res.NewVMFromObject(vcVM)
+
+			moVM, err := resVM.Properties(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(moVM.Config.Annotation).To(BeEmpty())
+
+			_, err = resVM.Reconfigure(ctxop.WithContext(ctx), &vimtypes.VirtualMachineConfigSpec{
+				Annotation: "hello",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			moVM, err = resVM.Properties(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(moVM.Config.Annotation).To(Equal("hello"))
+		})
+	})
+})
@@ -14,6 +14,7 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/internal"
 	res "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/resources"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
 	pkgclient "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
 )
 
@@ -22,6 +23,20 @@ type Session struct {
 	K8sClient    ctrlclient.Client
 	Finder       *find.Finder
 	ClusterMoRef types.ManagedObjectReference
+
+	// Inventory caches the inventory lookups made while updating the VM. It
+	// outlives the Session so steady-state updates do not traverse the
+	// inventory.
+	Inventory *pkgclient.InventoryCache
+
+	// DefaultNamedNetwork is the name of the default network used by the
+	// named network provider. It is only set when the VM may be migrated to
+	// the default network.
+	DefaultNamedNetwork string
+
+	// CdromImages are the content library items of the images of the VM's
+	// CD-ROMs.
+	CdromImages virtualmachine.CdromImages
 }
 
 func (s *Session) invokeFsrVirtualMachine(vmCtx pkgctx.VirtualMachineContext, resVM *res.VirtualMachine) error {
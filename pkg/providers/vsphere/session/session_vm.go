@@ -6,11 +6,14 @@ package session
 
 import (
 	"fmt"
+	"path"
 
 	"github.com/vmware/govmomi/object"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 )
 
 func updateVirtualDiskDeviceChanges(
@@ -70,3 +73,212 @@ func updateVirtualDiskDeviceChanges(
 
 	return deviceChanges, nil
 }
+
+// dataDiskFileName returns the name of the file, relative to the VM's home
+// directory, that backs the VM's data disk.
+func dataDiskFileName(vmName, diskName string) string {
+	return fmt.Sprintf("%s-data-%s.vmdk", vmName, diskName)
+}
+
+// addDataDiskDeviceChanges returns the device changes that create the VM's
+// data disks that do not already exist. A data disk exists if the VM has a
+// disk backed by the data disk's file in the VM's home directory. The devices
+// are the VM's devices, including any controllers being added by the same
+// reconfigure. The storageClassToPolicyID map is used to look up the storage
+// policy applied to each new disk that specifies a storage class, otherwise
+// the VM's storage profile is applied.
+func addDataDiskDeviceChanges(
+	vmCtx pkgctx.VirtualMachineContext,
+	config *vimtypes.VirtualMachineConfigInfo,
+	devices object.VirtualDeviceList,
+	storageClassToPolicyID map[string]string,
+	storageProfileID string) ([]vimtypes.BaseVirtualDeviceConfigSpec, error) {
+
+	advanced := vmCtx.VM.Spec.Advanced
+	if advanced == nil || len(advanced.DataDisks) == 0 {
+		return nil, nil
+	}
+
+	var vmPath object.DatastorePath
+	if !vmPath.FromString(config.Files.VmPathName) {
+		return nil, fmt.Errorf("failed to parse VM path %q", config.Files.VmPathName)
+	}
+
+	existingFiles := map[string]struct{}{}
+	for _, d := range devices.SelectByType((*vimtypes.VirtualDisk)(nil)) {
+		if fb, ok := d.GetVirtualDevice().Backing.(vimtypes.BaseVirtualDeviceFileBackingInfo); ok {
+			existingFiles[fb.GetVirtualDeviceFileBackingInfo().FileName] = struct{}{}
+		}
+	}
+
+	var deviceChanges []vimtypes.BaseVirtualDeviceConfigSpec
+	for _, dataDisk := range advanced.DataDisks {
+		diskPath := object.DatastorePath{
+			Datastore: vmPath.Datastore,
+			Path:      path.Join(path.Dir(vmPath.Path), dataDiskFileName(vmCtx.VM.Name, dataDisk.Name)),
+		}
+		fileName := diskPath.String()
+
+		if _, ok := existingFiles[fileName]; ok {
+			continue
+		}
+
+		controller := pickDataDiskController(devices)
+		if controller == nil {
+			// Add a new paravirtual SCSI controller for the data disks.
+			scsiController, err := devices.CreateSCSIController("pvscsi")
+			if err != nil {
+				return nil, fmt.Errorf("failed to create SCSI controller for data disk %q: %w", dataDisk.Name, err)
+			}
+			devices = append(devices, scsiController)
+			deviceChanges = append(deviceChanges, &vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device:    scsiController,
+			})
+			controller = scsiController.(vimtypes.BaseVirtualController)
+		}
+
+		disk := devices.CreateDisk(controller, vimtypes.ManagedObjectReference{}, fileName)
+		disk.CapacityInBytes = dataDisk.Capacity.Value()
+		setDataDiskProvisioning(disk, dataDisk.ProvisioningMode, advanced.DefaultVolumeProvisioningMode)
+
+		// Add the disk to the list so the next disk is assigned a different
+		// unit number.
+		devices = append(devices, disk)
+
+		deviceSpec := &vimtypes.VirtualDeviceConfigSpec{
+			Operation:     vimtypes.VirtualDeviceConfigSpecOperationAdd,
+			FileOperation: vimtypes.VirtualDeviceConfigSpecFileOperationCreate,
+			Device:        disk,
+		}
+
+		storageClass, policyID := dataDisk.StorageClass, storageProfileID
+		if storageClass != "" {
+			policyID = storageClassToPolicyID[storageClass]
+		} else {
+			storageClass = vmCtx.VM.Spec.StorageClass
+		}
+		if policyID != "" {
+			deviceSpec.Profile = []vimtypes.BaseVirtualMachineProfileSpec{
+				&vimtypes.VirtualMachineDefinedProfileSpec{
+					ProfileId: policyID,
+				},
+			}
+		}
+
+		vmCtx.Logger.Info("Adding data disk",
+			"name", dataDisk.Name,
+			"fileName", fileName,
+			"capacity", dataDisk.Capacity.String(),
+			"storageClass", storageClass)
+
+		deviceChanges = append(deviceChanges, deviceSpec)
+	}
+
+	return deviceChanges, nil
+}
+
+// pickDataDiskController returns the SCSI, NVME, or SATA controller, in that
+// order of preference, with a free slot for a new disk. Nil is returned if
+// there is no such controller.
+func pickDataDiskController(devices object.VirtualDeviceList) vimtypes.BaseVirtualController {
+	for _, kind := range []vimtypes.BaseVirtualController{
+		(*vimtypes.VirtualSCSIController)(nil),
+		(*vimtypes.VirtualNVMEController)(nil),
+		(*vimtypes.VirtualSATAController)(nil),
+	} {
+		if c := devices.PickController(kind); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+func setDataDiskProvisioning(
+	disk *vimtypes.VirtualDisk,
+	mode, defaultMode vmopv1.VirtualMachineVolumeProvisioningMode) {
+
+	backing, ok := disk.Backing.(*vimtypes.VirtualDiskFlatVer2BackingInfo)
+	if !ok {
+		return
+	}
+
+	if mode == "" {
+		mode = defaultMode
+	}
+
+	switch mode {
+	case vmopv1.VirtualMachineVolumeProvisioningModeThick:
+		backing.ThinProvisioned = ptr.To(false)
+	case vmopv1.VirtualMachineVolumeProvisioningModeThickEagerZero:
+		backing.ThinProvisioned = ptr.To(false)
+		backing.EagerlyScrub = ptr.To(true)
+	default:
+		backing.ThinProvisioned = ptr.To(true)
+	}
+}
+
+// SCSIControllerDeviceChanges returns the device changes that set the bus
+// sharing mode of the SCSI controllers described by the provided specs. A
+// controller that does not exist is added as a paravirtual SCSI controller
+// with the specified bus number. The returned device list includes the added
+// controllers.
+func SCSIControllerDeviceChanges(
+	devices object.VirtualDeviceList,
+	controllers []vmopv1.VirtualMachineSCSIControllerSpec) (object.VirtualDeviceList, []vimtypes.BaseVirtualDeviceConfigSpec, error) {
+
+	var deviceChanges []vimtypes.BaseVirtualDeviceConfigSpec
+
+	for _, c := range controllers {
+		sharedBus := scsiSharingFromMode(c.SharingMode)
+
+		var existing vimtypes.BaseVirtualDevice
+		for _, d := range devices.SelectByType((*vimtypes.VirtualSCSIController)(nil)) {
+			if sc, ok := d.(vimtypes.BaseVirtualSCSIController); ok &&
+				sc.GetVirtualSCSIController().BusNumber == c.BusNumber {
+
+				existing = d
+				break
+			}
+		}
+
+		if existing != nil {
+			sc := existing.(vimtypes.BaseVirtualSCSIController).GetVirtualSCSIController()
+			if sc.SharedBus != sharedBus {
+				sc.SharedBus = sharedBus
+				deviceChanges = append(deviceChanges, &vimtypes.VirtualDeviceConfigSpec{
+					Operation: vimtypes.VirtualDeviceConfigSpecOperationEdit,
+					Device:    existing,
+				})
+			}
+			continue
+		}
+
+		controller, err := devices.CreateSCSIController("pvscsi")
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create SCSI controller with bus number %d: %w", c.BusNumber, err)
+		}
+		sc := controller.(vimtypes.BaseVirtualSCSIController).GetVirtualSCSIController()
+		sc.BusNumber = c.BusNumber
+		sc.SharedBus = sharedBus
+
+		devices = append(devices, controller)
+		deviceChanges = append(deviceChanges, &vimtypes.VirtualDeviceConfigSpec{
+			Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+			Device:    controller,
+		})
+	}
+
+	return devices, deviceChanges, nil
+}
+
+func scsiSharingFromMode(mode vmopv1.VirtualMachineSCSIControllerSharingMode) vimtypes.VirtualSCSISharing {
+	switch mode {
+	case vmopv1.VirtualMachineSCSIControllerSharingModePhysical:
+		return vimtypes.VirtualSCSISharingPhysicalSharing
+	case vmopv1.VirtualMachineSCSIControllerSharingModeVirtual:
+		return vimtypes.VirtualSCSISharingVirtualSharing
+	default:
+		return vimtypes.VirtualSCSISharingNoSharing
+	}
+}
@@ -0,0 +1,99 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package session_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/session"
+)
+
+var _ = Describe("SCSIControllerDeviceChanges", func() {
+
+	var (
+		devices     object.VirtualDeviceList
+		controllers []vmopv1.VirtualMachineSCSIControllerSpec
+	)
+
+	BeforeEach(func() {
+		devices = object.VirtualDeviceList{
+			&vimtypes.ParaVirtualSCSIController{
+				VirtualSCSIController: vimtypes.VirtualSCSIController{
+					VirtualController: vimtypes.VirtualController{
+						VirtualDevice: vimtypes.VirtualDevice{Key: 1000},
+						BusNumber:     0,
+					},
+					SharedBus: vimtypes.VirtualSCSISharingNoSharing,
+				},
+			},
+		}
+		controllers = nil
+	})
+
+	When("the controllers already have the sharing mode", func() {
+		BeforeEach(func() {
+			controllers = []vmopv1.VirtualMachineSCSIControllerSpec{
+				{BusNumber: 0, SharingMode: vmopv1.VirtualMachineSCSIControllerSharingModeNone},
+			}
+		})
+
+		It("returns no changes", func() {
+			newDevices, changes, err := session.SCSIControllerDeviceChanges(devices, controllers)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+			Expect(newDevices).To(HaveLen(1))
+		})
+	})
+
+	When("an existing controller has a different sharing mode", func() {
+		BeforeEach(func() {
+			controllers = []vmopv1.VirtualMachineSCSIControllerSpec{
+				{BusNumber: 0, SharingMode: vmopv1.VirtualMachineSCSIControllerSharingModePhysical},
+			}
+		})
+
+		It("edits the controller", func() {
+			_, changes, err := session.SCSIControllerDeviceChanges(devices, controllers)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(HaveLen(1))
+
+			spec := changes[0].GetVirtualDeviceConfigSpec()
+			Expect(spec.Operation).To(Equal(vimtypes.VirtualDeviceConfigSpecOperationEdit))
+			Expect(spec.Device.GetVirtualDevice().Key).To(Equal(int32(1000)))
+
+			sc := spec.Device.(vimtypes.BaseVirtualSCSIController).GetVirtualSCSIController()
+			Expect(sc.SharedBus).To(Equal(vimtypes.VirtualSCSISharingPhysicalSharing))
+		})
+	})
+
+	When("a controller does not exist", func() {
+		BeforeEach(func() {
+			controllers = []vmopv1.VirtualMachineSCSIControllerSpec{
+				{BusNumber: 2, SharingMode: vmopv1.VirtualMachineSCSIControllerSharingModeVirtual},
+			}
+		})
+
+		It("adds a paravirtual controller with the bus number", func() {
+			newDevices, changes, err := session.SCSIControllerDeviceChanges(devices, controllers)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(HaveLen(1))
+			Expect(newDevices).To(HaveLen(2))
+
+			spec := changes[0].GetVirtualDeviceConfigSpec()
+			Expect(spec.Operation).To(Equal(vimtypes.VirtualDeviceConfigSpecOperationAdd))
+			Expect(spec.Device).To(BeAssignableToTypeOf(&vimtypes.ParaVirtualSCSIController{}))
+
+			sc := spec.Device.(vimtypes.BaseVirtualSCSIController).GetVirtualSCSIController()
+			Expect(sc.BusNumber).To(Equal(int32(2)))
+			Expect(sc.SharedBus).To(Equal(vimtypes.VirtualSCSISharingVirtualSharing))
+			Expect(sc.Key).To(BeNumerically("<", 0))
+		})
+	})
+})
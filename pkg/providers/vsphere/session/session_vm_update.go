@@ -6,6 +6,7 @@ package session
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"reflect"
@@ -25,6 +26,8 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	ctxbudget "github.com/vmware-tanzu/vm-operator/pkg/context/budget"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/clustermodules"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	network2 "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
@@ -48,6 +51,16 @@ type VMUpdateArgs struct {
 	BootstrapData  vmlifecycle.BootstrapData
 	ConfigSpec     vimtypes.VirtualMachineConfigSpec
 	NetworkResults network2.NetworkInterfaceResults
+
+	// StorageClassToPolicyID maps the name of the storage classes used by the
+	// VM and its data disks to their storage policy IDs.
+	StorageClassToPolicyID map[string]string
+
+	// StorageProfileID is the ID of the storage policy of the VM's storage
+	// class, or the class's site affinity storage policy for the VM's
+	// preferred site. It is the policy applied to data disks that do not
+	// specify a storage class.
+	StorageProfileID string
 }
 
 // VMResizeArgs contains the arguments needed to resize a VM on VC.
@@ -83,6 +96,30 @@ func ethCardMatch(newBaseEthCard, curBaseEthCard vimtypes.BaseVirtualEthernetCar
 	return true
 }
 
+// ethCardBackingMatch returns true if the ethernet card backing is connected
+// to the same network as the expected backing.
+func ethCardBackingMatch(backing, expectedBacking vimtypes.BaseVirtualDeviceBackingInfo) bool {
+	if backing == nil || reflect.TypeOf(backing) != reflect.TypeOf(expectedBacking) {
+		return false
+	}
+
+	// Cribbed from VirtualDeviceList.SelectByBackingInfo().
+	switch a := backing.(type) {
+	case *vimtypes.VirtualEthernetCardNetworkBackingInfo:
+		// This backing is only used in testing.
+		b := expectedBacking.(*vimtypes.VirtualEthernetCardNetworkBackingInfo)
+		return a.DeviceName == b.DeviceName
+	case *vimtypes.VirtualEthernetCardDistributedVirtualPortBackingInfo:
+		b := expectedBacking.(*vimtypes.VirtualEthernetCardDistributedVirtualPortBackingInfo)
+		return a.Port.SwitchUuid == b.Port.SwitchUuid && a.Port.PortgroupKey == b.Port.PortgroupKey
+	case *vimtypes.VirtualEthernetCardOpaqueNetworkBackingInfo:
+		b := expectedBacking.(*vimtypes.VirtualEthernetCardOpaqueNetworkBackingInfo)
+		return a.OpaqueNetworkId == b.OpaqueNetworkId
+	}
+
+	return false
+}
+
 func UpdateEthCardDeviceChanges(
 	ctx context.Context,
 	expectedEthCards object.VirtualDeviceList,
@@ -92,7 +129,6 @@ func UpdateEthCardDeviceChanges(
 	for _, expectedDev := range expectedEthCards {
 		expectedNic := expectedDev.(vimtypes.BaseVirtualEthernetCard)
 		expectedBacking := expectedNic.GetVirtualEthernetCard().Backing
-		expectedBackingType := reflect.TypeOf(expectedBacking)
 
 		var matchingIdx = -1
 
@@ -115,28 +151,7 @@ func UpdateEthCardDeviceChanges(
 				continue
 			}
 
-			db := nic.GetVirtualEthernetCard().Backing
-			if db == nil || reflect.TypeOf(db) != expectedBackingType {
-				continue
-			}
-
-			var backingMatch bool
-
-			// Cribbed from VirtualDeviceList.SelectByBackingInfo().
-			switch a := db.(type) {
-			case *vimtypes.VirtualEthernetCardNetworkBackingInfo:
-				// This backing is only used in testing.
-				b := expectedBacking.(*vimtypes.VirtualEthernetCardNetworkBackingInfo)
-				backingMatch = a.DeviceName == b.DeviceName
-			case *vimtypes.VirtualEthernetCardDistributedVirtualPortBackingInfo:
-				b := expectedBacking.(*vimtypes.VirtualEthernetCardDistributedVirtualPortBackingInfo)
-				backingMatch = a.Port.SwitchUuid == b.Port.SwitchUuid && a.Port.PortgroupKey == b.Port.PortgroupKey
-			case *vimtypes.VirtualEthernetCardOpaqueNetworkBackingInfo:
-				b := expectedBacking.(*vimtypes.VirtualEthernetCardOpaqueNetworkBackingInfo)
-				backingMatch = a.OpaqueNetworkId == b.OpaqueNetworkId
-			}
-
-			if backingMatch {
+			if ethCardBackingMatch(nic.GetVirtualEthernetCard().Backing, expectedBacking) {
 				matchingIdx = idx
 				break
 			}
@@ -534,7 +549,7 @@ func (s *Session) prePowerOnVMConfigSpec(
 	configSpec.DeviceChange = append(configSpec.DeviceChange, pciDeviceChanges...)
 
 	if pkgcfg.FromContext(vmCtx).Features.IsoSupport {
-		cdromDeviceChanges, err := virtualmachine.UpdateCdromDeviceChanges(vmCtx, s.Client.RestClient(), s.K8sClient, virtualDevices)
+		cdromDeviceChanges, err := virtualmachine.UpdateCdromDeviceChanges(vmCtx, s.Client.RestClient(), s.CdromImages, virtualDevices)
 		if err != nil {
 			return nil, false, fmt.Errorf("update CD-ROM device changes error: %w", err)
 		}
@@ -564,6 +579,27 @@ func (s *Session) prePowerOnVMReconfigure(
 		return err
 	}
 
+	devices := object.VirtualDeviceList(config.Hardware.Device)
+	if adv := vmCtx.VM.Spec.Advanced; adv != nil && len(adv.SCSIControllers) > 0 {
+		var scsiDeviceChanges []vimtypes.BaseVirtualDeviceConfigSpec
+		devices, scsiDeviceChanges, err = SCSIControllerDeviceChanges(devices, adv.SCSIControllers)
+		if err != nil {
+			return err
+		}
+		configSpec.DeviceChange = append(configSpec.DeviceChange, scsiDeviceChanges...)
+	}
+
+	dataDiskDeviceChanges, err := addDataDiskDeviceChanges(
+		vmCtx,
+		config,
+		devices,
+		updateArgs.StorageClassToPolicyID,
+		updateArgs.StorageProfileID)
+	if err != nil {
+		return err
+	}
+	configSpec.DeviceChange = append(configSpec.DeviceChange, dataDiskDeviceChanges...)
+
 	if _, err := doReconfigure(
 		logr.NewContext(
 			vmCtx,
@@ -577,6 +613,7 @@ func (s *Session) prePowerOnVMReconfigure(
 
 		return err
 	}
+	resVM.Invalidate()
 
 	if needsResize {
 		vmopv1util.MustSetLastResizedAnnotation(vmCtx.VM, updateArgs.VMClass)
@@ -615,7 +652,7 @@ func (s *Session) ensureNetworkInterfaces(
 		vmCtx,
 		s.K8sClient,
 		s.Client.VimClient(),
-		s.Finder,
+		s.Inventory,
 		&s.ClusterMoRef,
 		networkSpec)
 	if err != nil {
@@ -803,11 +840,20 @@ func (s *Session) poweredOnVMReconfigure(
 	UpdateConfigSpecChangeBlockTracking(vmCtx, config, configSpec, nil, vmCtx.VM.Spec)
 
 	if pkgcfg.FromContext(vmCtx).Features.IsoSupport {
-		if err := virtualmachine.UpdateConfigSpecCdromDeviceConnection(vmCtx, s.Client.RestClient(), s.K8sClient, config, configSpec); err != nil {
+		if err := virtualmachine.UpdateConfigSpecCdromDeviceConnection(vmCtx, s.Client.RestClient(), s.CdromImages, config, configSpec); err != nil {
 			return false, fmt.Errorf("update CD-ROM device connection error: %w", err)
 		}
 	}
 
+	// Only a reconfigure to correct the drift from the VM's spec is deferred
+	// so it is coalesced with the VM's subsequent spec changes.
+	if !apiEquality.Semantic.DeepEqual(*configSpec, vimtypes.VirtualMachineConfigSpec{}) {
+		if err := DeferReconfigure(vmCtx, vmCtx.VM); err != nil {
+			vmCtx.Logger.Info("Deferring reconfigure to batch spec changes", "reason", err.Error())
+			return false, err
+		}
+	}
+
 	refetchProps, err := doReconfigure(
 		logr.NewContext(
 			vmCtx,
@@ -822,6 +868,9 @@ func (s *Session) poweredOnVMReconfigure(
 	if err != nil {
 		return false, err
 	}
+	if refetchProps {
+		resVM.Invalidate()
+	}
 
 	// Special case for CBT: in order for CBT change take effect for a powered
 	// on VM, a checkpoint save/restore is needed. The FSR call allows CBT to
@@ -974,12 +1023,13 @@ func (s *Session) updateVMDesiredPowerStateOff(
 			vmCtx.VM.Spec.PowerOffMode == vmopv1.VirtualMachinePowerOpModeTrySoft
 	}
 	if powerOff {
-		if err := res.NewVMFromObject(vcVM).SetPowerState(
-			logr.NewContext(vmCtx, vmCtx.Logger),
+		// The VM cannot be reconfigured until it is powered off.
+		ok, err := s.transitionPowerState(
+			vmCtx,
+			res.NewVMFromObject(vcVM),
 			existingPowerState,
-			vmCtx.VM.Spec.PowerState,
-			vmCtx.VM.Spec.PowerOffMode); err != nil {
-
+			vmCtx.VM.Spec.PowerOffMode)
+		if err != nil || !ok {
 			return false, err
 		}
 
@@ -1035,11 +1085,12 @@ func (s *Session) updateVMDesiredPowerStateSuspended(
 	)
 
 	if existingPowerState == vmopv1.VirtualMachinePowerStateOn {
-		if err := res.NewVMFromObject(vcVM).SetPowerState(
-			logr.NewContext(vmCtx, vmCtx.Logger),
+		ok, err := s.transitionPowerState(
+			vmCtx,
+			res.NewVMFromObject(vcVM),
 			existingPowerState,
-			vmCtx.VM.Spec.PowerState,
-			vmCtx.VM.Spec.SuspendMode); err != nil {
+			vmCtx.VM.Spec.SuspendMode)
+		if err != nil || !ok {
 			return false, err
 		}
 
@@ -1072,7 +1123,23 @@ func (s *Session) updateVMDesiredPowerStateOn(
 			vmCtx.MoVM.Summary.Runtime.ConnectionState)
 	}
 
-	resVM := res.NewVMFromObject(vcVM)
+	// Seed the VM resource with the properties retrieved at the start of the
+	// update so they are not retrieved again until the VM is changed.
+	resVM := res.NewVMFromObjectWithProperties(vcVM, vmCtx.MoVM)
+
+	if existingPowerState == vmopv1.VirtualMachinePowerStateOn {
+		retried, err := s.retryGuestCustomization(vmCtx, resVM)
+		if err != nil {
+			return refetchProps, err
+		}
+		if retried {
+			// The VM is powered back on below, which reapplies the
+			// customization with the regenerated identity.
+			resVM.Invalidate()
+			refetchProps = true
+			existingPowerState = vmopv1.VirtualMachinePowerStateOff
+		}
+	}
 
 	if existingPowerState == vmopv1.VirtualMachinePowerStateOn {
 		// Check to see if a possible restart is required.
@@ -1099,6 +1166,7 @@ func (s *Session) updateVMDesiredPowerStateOn(
 				return refetchProps, err
 			}
 			if result.AnyChange() {
+				resVM.Invalidate()
 				refetchProps = true
 				lastRestartTime := metav1.NewTime(nextRestartTime)
 				vmCtx.VM.Status.LastRestartTime = &lastRestartTime
@@ -1119,12 +1187,11 @@ func (s *Session) updateVMDesiredPowerStateOn(
 
 	if existingPowerState == vmopv1.VirtualMachinePowerStateSuspended {
 		// A suspended VM cannot be reconfigured.
-		err = resVM.SetPowerState(
-			logr.NewContext(vmCtx, vmCtx.Logger),
+		return s.transitionPowerState(
+			vmCtx,
+			resVM,
 			existingPowerState,
-			vmCtx.VM.Spec.PowerState,
 			vmopv1.VirtualMachinePowerOpModeHard)
-		return err == nil, err
 	}
 
 	updateArgs, err := getUpdateArgsFn()
@@ -1158,12 +1225,17 @@ func (s *Session) updateVMDesiredPowerStateOn(
 		return refetchProps, err
 	}
 
-	err = resVM.SetPowerState(
-		logr.NewContext(vmCtx, vmCtx.Logger),
+	// The VM is powered on by the next reconcile if the budget is exceeded.
+	if err := ctxbudget.Check(vmCtx, "customize"); err != nil {
+		return refetchProps, err
+	}
+
+	poweredOn, err := s.transitionPowerState(
+		vmCtx,
+		resVM,
 		existingPowerState,
-		vmCtx.VM.Spec.PowerState,
 		vmopv1.VirtualMachinePowerOpModeHard)
-	if err != nil {
+	if err != nil || !poweredOn {
 		return refetchProps, err
 	}
 
@@ -1184,10 +1256,43 @@ func (s *Session) UpdateVirtualMachine(
 	var (
 		refetchProps bool
 		updateErr    error
+		migrationErr error
 	)
 
+	switch {
+	case pkgcfg.FromContext(vmCtx).ObserverMode:
+		vmCtx.Logger.V(4).Info("Observer mode is enabled. VirtualMachine is not updated.")
+		// The status is updated from the status properties.
+		refetchProps = true
+
 	// Only update VM's power state when VM is not paused.
-	if !isVMPaused(vmCtx) {
+	case !isVMPaused(vmCtx):
+		migrated, err := s.reconcileNamedNetworkMigration(vmCtx, vcVM)
+		if err != nil {
+			if !errors.As(err, &pkgerr.RequeueError{}) {
+				return fmt.Errorf("migrating named network failed with %w", err)
+			}
+			// The VM is still updated while it waits for the migration
+			// window to open.
+			migrationErr = err
+		}
+		if migrated {
+			// Refetch the devices so the update below sees the new backings.
+			vmCtx.MoVM = mo.VirtualMachine{}
+			if err := vcVM.Properties(
+				vmCtx,
+				vcVM.Reference(),
+				vmlifecycle.VMStatusPropertiesSelector,
+				&vmCtx.MoVM); err != nil {
+
+				return fmt.Errorf("refetching props failed with %w", err)
+			}
+		}
+
+		if err := s.reconcileReconfigureSnapshot(vmCtx, vcVM); err != nil {
+			return fmt.Errorf("removing reconfigure snapshot failed with %w", err)
+		}
+
 		// Translate the VM's current power state into the VM Op power state value.
 		var existingPowerState vmopv1.VirtualMachinePowerState
 		switch vmCtx.MoVM.Summary.Runtime.PowerState {
@@ -1199,6 +1304,12 @@ func (s *Session) UpdateVirtualMachine(
 			existingPowerState = vmopv1.VirtualMachinePowerStateSuspended
 		}
 
+		// The VM's power state retry budget is reset once its observed power
+		// state converges with its desired power state.
+		if existingPowerState == vmCtx.VM.Spec.PowerState {
+			vmlifecycle.MarkPowerStateSynced(vmCtx.VM)
+		}
+
 		switch vmCtx.VM.Spec.PowerState {
 		case vmopv1.VirtualMachinePowerStateOff:
 			refetchProps, updateErr = s.updateVMDesiredPowerStateOff(
@@ -1220,11 +1331,19 @@ func (s *Session) UpdateVirtualMachine(
 				getUpdateArgsFn,
 				existingPowerState)
 		}
-	} else {
+
+	default:
 		vmCtx.Logger.Info("VirtualMachine is paused. PowerState is not updated.")
 		refetchProps, updateErr = defaultReconfigure(vmCtx, s.K8sClient, vcVM)
 	}
 
+	// A deferred update, ex. a reconfigure that is batched, does not prevent
+	// the VM's status from being updated.
+	var requeueErr error
+	if errors.As(updateErr, &pkgerr.RequeueError{}) {
+		requeueErr, updateErr = updateErr, nil
+	}
+
 	if updateErr != nil {
 		updateErr = fmt.Errorf("updating state failed with %w", updateErr)
 	}
@@ -1279,6 +1398,13 @@ func (s *Session) UpdateVirtualMachine(
 		}
 	}
 
+	if updateErr == nil {
+		updateErr = migrationErr
+	}
+	if updateErr == nil {
+		updateErr = requeueErr
+	}
+
 	return updateErr
 }
 
@@ -1368,8 +1494,23 @@ func doReconfigure(
 		return false, nil
 	}
 
+	// Never delete the disk of a volume whose PreventDelete latch is set,
+	// regardless of which part of the reconcile produced the device change.
+	if err := vmopv1util.CheckPreventDeleteDeviceChanges(vm, configSpec.DeviceChange); err != nil {
+		return false, err
+	}
+
+	snapshotRef, err := snapshotBeforeReconfigure(ctx, vm, vcVM, configSpec)
+	if err != nil {
+		EndReconfigureBatch(vm.UID)
+		return false, err
+	}
+
 	resVM := res.NewVMFromObject(vcVM)
 	taskInfo, err := resVM.Reconfigure(ctx, &configSpec)
+	EndReconfigureBatch(vm.UID)
+
+	onReconfigureSnapshotResult(ctx, vm, vcVM, snapshotRef, err)
 
 	UpdateVMGuestIDReconfiguredCondition(vm, configSpec, taskInfo)
 
@@ -0,0 +1,51 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+)
+
+// reconfigureBatches records when the reconfigure of a VM was first deferred,
+// keyed by the VM's UID.
+var reconfigureBatches sync.Map
+
+// DeferReconfigure returns a RequeueError if the reconfigure of the VM should
+// be deferred so it is coalesced with the VM's subsequent spec changes. It is
+// only used for the reconfigure that corrects a powered on VM's drift from its
+// spec, and the rest of the VM's update still happens when it is deferred. The
+// reconfigure is deferred until the ReconfigureBatchWindow has elapsed since
+// it was first deferred, so a VM whose spec keeps changing is still
+// reconfigured. Nil is returned if the reconfigure should happen now.
+func DeferReconfigure(ctx context.Context, vm *vmopv1.VirtualMachine) error {
+	window := pkgcfg.FromContext(ctx).ReconfigureBatchWindow
+	if window <= 0 || ctxop.IsCreate(ctx) {
+		return nil
+	}
+
+	now := time.Now()
+	start, _ := reconfigureBatches.LoadOrStore(vm.UID, now)
+	if remaining := window - now.Sub(start.(time.Time)); remaining > 0 {
+		return pkgerr.RequeueError{After: remaining}
+	}
+
+	return nil
+}
+
+// EndReconfigureBatch forgets when the reconfigure of the VM was first
+// deferred. It is called once the VM has been reconfigured, and when the VM is
+// deleted so the entries of deleted VMs are not leaked.
+func EndReconfigureBatch(uid types.UID) {
+	reconfigureBatches.Delete(uid)
+}
@@ -0,0 +1,86 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package session_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/session"
+)
+
+var _ = Describe("DeferReconfigure", func() {
+
+	var (
+		ctx context.Context
+		vm  *vmopv1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		ctx = ctxop.WithContext(pkgcfg.NewContextWithDefaultConfig())
+		vm = &vmopv1.VirtualMachine{}
+		vm.UID = uuid.NewUUID()
+	})
+
+	It("does not defer the reconfigure when there is no window", func() {
+		Expect(session.DeferReconfigure(ctx, vm)).To(Succeed())
+	})
+
+	When("there is a window", func() {
+		BeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.ReconfigureBatchWindow = time.Hour
+			})
+		})
+
+		It("defers the reconfigure until the window has elapsed", func() {
+			err := session.DeferReconfigure(ctx, vm)
+			var requeueErr pkgerr.RequeueError
+			Expect(err).To(BeAssignableToTypeOf(requeueErr))
+			requeueErr = err.(pkgerr.RequeueError)
+			Expect(requeueErr.After).To(BeNumerically("~", time.Hour, time.Minute))
+
+			err = session.DeferReconfigure(ctx, vm)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(pkgerr.RequeueError).After).To(BeNumerically("<=", requeueErr.After))
+		})
+
+		It("does not defer the reconfigure of a VM that is being created", func() {
+			ctxop.MarkCreate(ctx)
+			Expect(session.DeferReconfigure(ctx, vm)).To(Succeed())
+		})
+	})
+
+	When("the window has elapsed", func() {
+		BeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.ReconfigureBatchWindow = 10 * time.Millisecond
+			})
+		})
+
+		It("does not defer the reconfigure", func() {
+			Expect(session.DeferReconfigure(ctx, vm)).To(HaveOccurred())
+			Eventually(func() error {
+				return session.DeferReconfigure(ctx, vm)
+			}).Should(Succeed())
+		})
+
+		It("defers the reconfigure again once the batch has ended", func() {
+			Expect(session.DeferReconfigure(ctx, vm)).To(HaveOccurred())
+			time.Sleep(20 * time.Millisecond)
+			session.EndReconfigureBatch(vm.UID)
+			Expect(session.DeferReconfigure(ctx, vm)).To(HaveOccurred())
+		})
+	})
+})
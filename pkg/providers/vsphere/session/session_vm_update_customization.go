@@ -0,0 +1,56 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package session
+
+import (
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	res "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/resources"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+	vmoprecord "github.com/vmware-tanzu/vm-operator/pkg/record"
+)
+
+// retryGuestCustomization powers off the VM when its guest customization
+// failed due to a duplicate identity and the VM's retry policy allows the
+// customization to be retried with a regenerated identity. The customization
+// is reapplied with the regenerated identity when the VM is powered back on.
+// True is returned if the VM was powered off.
+func (s *Session) retryGuestCustomization(
+	vmCtx pkgctx.VirtualMachineContext,
+	resVM *res.VirtualMachine) (bool, error) {
+
+	if !vmlifecycle.ShouldRetryGuestCustomization(vmCtx.VM, vmCtx.MoVM.Guest) {
+		return false, nil
+	}
+
+	errMsg := vmCtx.MoVM.Guest.CustomizationInfo.ErrorMsg
+
+	if err := resVM.SetPowerState(
+		logr.NewContext(vmCtx, vmCtx.Logger),
+		vmopv1.VirtualMachinePowerStateOn,
+		vmopv1.VirtualMachinePowerStateOff,
+		vmopv1.VirtualMachinePowerOpModeHard); err != nil {
+
+		return false, err
+	}
+
+	suffix, _, _ := strings.Cut(uuid.NewString(), "-")
+	changes := vmlifecycle.RegenerateGuestCustomizationIdentity(vmCtx.VM, suffix)
+
+	vmCtx.Logger.Info("Retrying failed guest customization with regenerated identity",
+		"error", errMsg, "changes", changes)
+	vmoprecord.FromContext(vmCtx).Eventf(
+		vmCtx.VM,
+		vmlifecycle.GuestCustomizationRetryReason,
+		"Retrying guest customization that failed with %q: %s",
+		errMsg, strings.Join(changes, "; "))
+
+	return true, nil
+}
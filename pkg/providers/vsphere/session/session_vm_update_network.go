@@ -0,0 +1,217 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package session
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	network2 "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	res "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/resources"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+const (
+	// NetworkMigrationPendingReason is the reason for the NetworkMigrated
+	// condition when the VM's interfaces are waiting for the maintenance
+	// window to be migrated.
+	NetworkMigrationPendingReason = "WaitingForMaintenanceWindow"
+
+	// NetworkMigrationInvalidWindowReason is the reason for the
+	// NetworkMigrated condition when the maintenance window cannot be parsed.
+	NetworkMigrationInvalidWindowReason = "InvalidMaintenanceWindow"
+
+	// NetworkMigrationFailedReason is the reason for the NetworkMigrated
+	// condition when the VM's interfaces could not be re-backed.
+	NetworkMigrationFailedReason = "MigrationFailed"
+)
+
+// ParseNetworkMigrationWindow parses the value of the network migration window
+// annotation, which is in the form "<start>/<end>" with both times in RFC3339.
+func ParseNetworkMigrationWindow(value string) (time.Time, time.Time, error) {
+	startStr, endStr, ok := strings.Cut(value, "/")
+	if !ok {
+		return time.Time{}, time.Time{}, fmt.Errorf("window %q is not in the form <start>/<end>", value)
+	}
+
+	start, err := time.Parse(time.RFC3339, strings.TrimSpace(startStr))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid window start: %w", err)
+	}
+	end, err := time.Parse(time.RFC3339, strings.TrimSpace(endStr))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid window end: %w", err)
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("window end %s is not after start %s", endStr, startStr)
+	}
+
+	return start, end, nil
+}
+
+// NamedNetworkMigrationDeviceChanges returns the device changes that re-back
+// the ethernet cards connected to the old backing to the new backing. The
+// cards are edited in place so they keep their MAC address.
+func NamedNetworkMigrationDeviceChanges(
+	currentEthCards object.VirtualDeviceList,
+	oldBacking, newBacking vimtypes.BaseVirtualDeviceBackingInfo) []vimtypes.BaseVirtualDeviceConfigSpec {
+
+	var deviceChanges []vimtypes.BaseVirtualDeviceConfigSpec
+	for _, dev := range currentEthCards {
+		nic, ok := dev.(vimtypes.BaseVirtualEthernetCard)
+		if !ok {
+			continue
+		}
+		if !ethCardBackingMatch(nic.GetVirtualEthernetCard().Backing, oldBacking) {
+			continue
+		}
+
+		nic.GetVirtualEthernetCard().Backing = newBacking
+		deviceChanges = append(deviceChanges, &vimtypes.VirtualDeviceConfigSpec{
+			Device:    dev,
+			Operation: vimtypes.VirtualDeviceConfigSpecOperationEdit,
+		})
+	}
+
+	return deviceChanges
+}
+
+// reconcileNamedNetworkMigration re-backs the VM's network interfaces that use
+// the default named network to the current default network from the provider
+// ConfigMap when the VM has opted in with the network migration window
+// annotation and the current time is within that window. The progress of the
+// migration is reported with the VM's NetworkMigrated condition.
+//
+// Returns true if the VM was reconfigured. A RequeueError is returned if the
+// window has not opened yet so the VM is reconciled when it opens.
+func (s *Session) reconcileNamedNetworkMigration(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcVM *object.VirtualMachine) (bool, error) {
+
+	if pkgcfg.FromContext(vmCtx).NetworkProviderType != pkgcfg.NetworkProviderTypeNamed {
+		return false, nil
+	}
+
+	vm := vmCtx.VM
+
+	window, ok := vm.Annotations[pkgconst.NetworkMigrationWindowAnnotationKey]
+	if !ok {
+		return false, nil
+	}
+	defaultNetwork := vm.Annotations[pkgconst.DefaultNamedNetworkAnnotationKey]
+	if defaultNetwork == "" {
+		return false, nil
+	}
+
+	newNetwork := s.DefaultNamedNetwork
+	if newNetwork == "" {
+		return false, nil
+	}
+
+	curNetwork := network2.NamedNetworkName(vm, defaultNetwork)
+	if curNetwork == newNetwork {
+		return false, nil
+	}
+
+	start, end, err := ParseNetworkMigrationWindow(window)
+	if err != nil {
+		conditions.MarkFalse(
+			vm,
+			vmopv1.VirtualMachineConditionNetworkMigrated,
+			NetworkMigrationInvalidWindowReason,
+			"%v", err)
+		return false, nil
+	}
+
+	if now := time.Now(); now.Before(start) || now.After(end) {
+		conditions.MarkFalse(
+			vm,
+			vmopv1.VirtualMachineConditionNetworkMigrated,
+			NetworkMigrationPendingReason,
+			"Network interfaces will be migrated from %q to %q between %s and %s",
+			curNetwork, newNetwork, start.Format(time.RFC3339), end.Format(time.RFC3339))
+		if now.Before(start) {
+			return false, pkgerr.RequeueError{After: start.Sub(now)}
+		}
+		return false, nil
+	}
+
+	if vmCtx.MoVM.Config == nil {
+		return false, nil
+	}
+
+	markFailed := func(err error) (bool, error) {
+		conditions.MarkFalse(
+			vm,
+			vmopv1.VirtualMachineConditionNetworkMigrated,
+			NetworkMigrationFailedReason,
+			"%v", err)
+		return false, err
+	}
+
+	oldBacking, err := s.getNetworkBacking(vmCtx, curNetwork)
+	if err != nil {
+		return markFailed(err)
+	}
+	newBacking, err := s.getNetworkBacking(vmCtx, newNetwork)
+	if err != nil {
+		return markFailed(err)
+	}
+
+	currentEthCards := object.VirtualDeviceList(vmCtx.MoVM.Config.Hardware.Device).
+		SelectByType((*vimtypes.VirtualEthernetCard)(nil))
+
+	deviceChanges := NamedNetworkMigrationDeviceChanges(currentEthCards, oldBacking, newBacking)
+	if len(deviceChanges) > 0 {
+		vmCtx.Logger.Info("Migrating network interfaces to the default named network",
+			"oldNetwork", curNetwork, "newNetwork", newNetwork, "numInterfaces", len(deviceChanges))
+
+		if err := vmopv1util.CheckPreventDeleteDeviceChanges(vm, deviceChanges); err != nil {
+			return markFailed(err)
+		}
+
+		configSpec := &vimtypes.VirtualMachineConfigSpec{
+			DeviceChange: deviceChanges,
+		}
+		if _, err := res.NewVMFromObject(vcVM).Reconfigure(vmCtx, configSpec); err != nil {
+			return markFailed(fmt.Errorf("failed to re-back network interfaces: %w", err))
+		}
+	}
+
+	vm.Annotations[pkgconst.MigratedNamedNetworkAnnotationKey] = newNetwork
+	c := conditions.TrueCondition(vmopv1.VirtualMachineConditionNetworkMigrated)
+	c.Message = fmt.Sprintf("Migrated %d network interface(s) from %q to %q",
+		len(deviceChanges), curNetwork, newNetwork)
+	conditions.Set(vm, c)
+
+	return len(deviceChanges) > 0, nil
+}
+
+func (s *Session) getNetworkBacking(
+	vmCtx pkgctx.VirtualMachineContext,
+	name string) (vimtypes.BaseVirtualDeviceBackingInfo, error) {
+
+	ref, err := s.Inventory.Network(vmCtx, name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find named network %q: %w", name, err)
+	}
+
+	backing, err := ref.EthernetCardBackingInfo(vmCtx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get backing of named network %q: %w", name, err)
+	}
+
+	return backing, nil
+}
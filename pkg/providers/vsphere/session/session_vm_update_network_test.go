@@ -0,0 +1,118 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package session_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/session"
+)
+
+var _ = Describe("ParseNetworkMigrationWindow", func() {
+
+	It("returns the start and end of the window", func() {
+		start, end, err := session.ParseNetworkMigrationWindow(
+			"2024-01-01T00:00:00Z/2024-01-01T04:00:00Z")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(start).To(Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(end).To(Equal(time.Date(2024, 1, 1, 4, 0, 0, 0, time.UTC)))
+	})
+
+	It("returns an error when the value is not a window", func() {
+		_, _, err := session.ParseNetworkMigrationWindow("2024-01-01T00:00:00Z")
+		Expect(err).To(MatchError(ContainSubstring("is not in the form <start>/<end>")))
+	})
+
+	It("returns an error when the start is invalid", func() {
+		_, _, err := session.ParseNetworkMigrationWindow("now/2024-01-01T04:00:00Z")
+		Expect(err).To(MatchError(ContainSubstring("invalid window start")))
+	})
+
+	It("returns an error when the end is invalid", func() {
+		_, _, err := session.ParseNetworkMigrationWindow("2024-01-01T00:00:00Z/later")
+		Expect(err).To(MatchError(ContainSubstring("invalid window end")))
+	})
+
+	It("returns an error when the end is not after the start", func() {
+		_, _, err := session.ParseNetworkMigrationWindow(
+			"2024-01-01T04:00:00Z/2024-01-01T00:00:00Z")
+		Expect(err).To(MatchError(ContainSubstring("is not after start")))
+	})
+})
+
+var _ = Describe("NamedNetworkMigrationDeviceChanges", func() {
+
+	var (
+		oldBacking   *vimtypes.VirtualEthernetCardNetworkBackingInfo
+		newBacking   *vimtypes.VirtualEthernetCardNetworkBackingInfo
+		otherBacking *vimtypes.VirtualEthernetCardNetworkBackingInfo
+		ethCards     object.VirtualDeviceList
+	)
+
+	newEthCard := func(key int32, backing vimtypes.BaseVirtualDeviceBackingInfo) vimtypes.BaseVirtualDevice {
+		return &vimtypes.VirtualVmxnet3{
+			VirtualVmxnet: vimtypes.VirtualVmxnet{
+				VirtualEthernetCard: vimtypes.VirtualEthernetCard{
+					VirtualDevice: vimtypes.VirtualDevice{
+						Key:     key,
+						Backing: backing,
+					},
+					AddressType: string(vimtypes.VirtualEthernetCardMacTypeGenerated),
+					MacAddress:  "00:50:56:00:00:01",
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		oldBacking = &vimtypes.VirtualEthernetCardNetworkBackingInfo{
+			VirtualDeviceDeviceBackingInfo: vimtypes.VirtualDeviceDeviceBackingInfo{
+				DeviceName: "old-network",
+			},
+			Network: &vimtypes.ManagedObjectReference{Type: "Network", Value: "network-1"},
+		}
+		newBacking = &vimtypes.VirtualEthernetCardNetworkBackingInfo{
+			VirtualDeviceDeviceBackingInfo: vimtypes.VirtualDeviceDeviceBackingInfo{
+				DeviceName: "new-network",
+			},
+			Network: &vimtypes.ManagedObjectReference{Type: "Network", Value: "network-2"},
+		}
+		otherBacking = &vimtypes.VirtualEthernetCardNetworkBackingInfo{
+			VirtualDeviceDeviceBackingInfo: vimtypes.VirtualDeviceDeviceBackingInfo{
+				DeviceName: "other-network",
+			},
+			Network: &vimtypes.ManagedObjectReference{Type: "Network", Value: "network-3"},
+		}
+
+		ethCards = object.VirtualDeviceList{
+			newEthCard(4000, oldBacking),
+			newEthCard(4001, otherBacking),
+		}
+	})
+
+	It("edits the cards on the old network to use the new network", func() {
+		changes := session.NamedNetworkMigrationDeviceChanges(ethCards, oldBacking, newBacking)
+		Expect(changes).To(HaveLen(1))
+
+		spec := changes[0].GetVirtualDeviceConfigSpec()
+		Expect(spec.Operation).To(Equal(vimtypes.VirtualDeviceConfigSpecOperationEdit))
+		Expect(spec.Device.GetVirtualDevice().Key).To(Equal(int32(4000)))
+		Expect(spec.Device.GetVirtualDevice().Backing).To(Equal(newBacking))
+
+		nic := spec.Device.(vimtypes.BaseVirtualEthernetCard).GetVirtualEthernetCard()
+		Expect(nic.MacAddress).To(Equal("00:50:56:00:00:01"))
+	})
+
+	It("returns no changes when no card is on the old network", func() {
+		ethCards = object.VirtualDeviceList{newEthCard(4001, otherBacking)}
+		Expect(session.NamedNetworkMigrationDeviceChanges(ethCards, oldBacking, newBacking)).To(BeEmpty())
+	})
+})
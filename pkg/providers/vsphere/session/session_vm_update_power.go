@@ -0,0 +1,91 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package session
+
+import (
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	res "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/resources"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+	vmoprecord "github.com/vmware-tanzu/vm-operator/pkg/record"
+)
+
+// transitionPowerState changes the VM's observed power state to its desired
+// power state within the VM's power state retry budget. True is returned if
+// the VM is now in its desired power state.
+//
+// Without a retry budget, i.e. MaxPowerStateAttempts is zero, a failed
+// attempt returns the error so the change is retried. Otherwise, a failed
+// attempt is recorded on the VM's PowerStateSynced condition, and the change
+// is retried no sooner than PowerStateAttemptDelay after the failed attempt.
+// Once the attempts exhaust the retry budget, the failure is terminal: an
+// event is emitted, no error is returned, and the change is not attempted
+// again until the VM's desired power state changes.
+func (s *Session) transitionPowerState(
+	vmCtx pkgctx.VirtualMachineContext,
+	resVM *res.VirtualMachine,
+	observedPowerState vmopv1.VirtualMachinePowerState,
+	powerOpMode vmopv1.VirtualMachinePowerOpMode) (bool, error) {
+
+	cfg := pkgcfg.FromContext(vmCtx)
+	maxAttempts := cfg.MaxPowerStateAttempts
+
+	if maxAttempts > 0 {
+		if vmlifecycle.IsPowerStateChangeExhausted(vmCtx.VM, maxAttempts) {
+			vmCtx.Logger.V(4).Info("Skipping power state change with exhausted retry budget",
+				"observedPowerState", observedPowerState,
+				"desiredPowerState", vmCtx.VM.Spec.PowerState)
+			return false, nil
+		}
+
+		if remaining := vmlifecycle.PowerStateAttemptRemaining(vmCtx.VM, cfg.PowerStateAttemptDelay); remaining > 0 {
+			vmCtx.Logger.V(4).Info("Delaying power state change after failed attempt",
+				"observedPowerState", observedPowerState,
+				"desiredPowerState", vmCtx.VM.Spec.PowerState,
+				"remaining", remaining)
+			return false, pkgerr.RequeueError{After: remaining}
+		}
+	}
+
+	err := resVM.SetPowerState(
+		logr.NewContext(vmCtx, vmCtx.Logger),
+		observedPowerState,
+		vmCtx.VM.Spec.PowerState,
+		powerOpMode)
+	if err == nil {
+		vmlifecycle.MarkPowerStateSynced(vmCtx.VM)
+		return true, nil
+	}
+
+	if maxAttempts == 0 {
+		return false, err
+	}
+
+	if !vmlifecycle.RecordPowerStateAttempt(vmCtx.VM, maxAttempts, err) {
+		// The failure is recorded on the VM's condition, so requeue the VM to
+		// retry the change after the delay instead of returning the error.
+		vmCtx.Logger.Error(err, "Power state change failed and will be retried",
+			"observedPowerState", observedPowerState,
+			"desiredPowerState", vmCtx.VM.Spec.PowerState,
+			"retryAfter", cfg.PowerStateAttemptDelay)
+		return false, pkgerr.RequeueError{After: cfg.PowerStateAttemptDelay}
+	}
+
+	vmCtx.Logger.Error(err, "Power state change failed and will not be retried",
+		"observedPowerState", observedPowerState,
+		"desiredPowerState", vmCtx.VM.Spec.PowerState,
+		"attempts", maxAttempts)
+	vmoprecord.FromContext(vmCtx).Warnf(
+		vmCtx.VM,
+		vmopv1.VirtualMachinePowerStateChangeFailedReason,
+		"Failed to change power state from %s to %s after %d attempts: %v",
+		observedPowerState, vmCtx.VM.Spec.PowerState, maxAttempts, err)
+
+	return false, nil
+}
@@ -0,0 +1,252 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+)
+
+const (
+	// ReconfigureSnapshotName is the name of the snapshot taken before a
+	// risky reconfigure.
+	ReconfigureSnapshotName = "vmoperator-pre-reconfigure"
+
+	defaultReconfigureSnapshotGracePeriod = time.Hour
+)
+
+// IsRiskyReconfigure returns true if the ConfigSpec changes the VM's hardware
+// or encryption in a way that may break the guest.
+func IsRiskyReconfigure(configSpec vimtypes.VirtualMachineConfigSpec) bool {
+	if configSpec.NumCPUs != 0 ||
+		configSpec.MemoryMB != 0 ||
+		configSpec.Firmware != "" ||
+		configSpec.Version != "" {
+
+		return true
+	}
+	for i := range configSpec.DeviceChange {
+		if isRiskyDeviceChange(configSpec.DeviceChange[i]) {
+			return true
+		}
+	}
+	switch configSpec.Crypto.(type) {
+	case nil, *vimtypes.CryptoSpecNoOp:
+		return false
+	}
+	return true
+}
+
+// isRiskyDeviceChange returns true if the device change adds a device to or
+// removes a device from the VM. Edits to an existing device, ex. connecting a
+// CD-ROM or changing a NIC's backing, do not change the VM's hardware. Disks,
+// including CNS and FCD volumes, are not considered since they are attached
+// and resized as part of the VM's normal lifecycle, and a snapshot would
+// prevent a volume from being resized or detached.
+func isRiskyDeviceChange(baseSpec vimtypes.BaseVirtualDeviceConfigSpec) bool {
+	spec := baseSpec.GetVirtualDeviceConfigSpec()
+	if spec == nil || spec.Device == nil {
+		return false
+	}
+	if _, ok := spec.Device.(*vimtypes.VirtualDisk); ok {
+		return false
+	}
+	switch spec.Operation {
+	case vimtypes.VirtualDeviceConfigSpecOperationAdd,
+		vimtypes.VirtualDeviceConfigSpecOperationRemove:
+
+		return true
+	}
+	return false
+}
+
+// reconfigureSnapshotGracePeriod returns how long the snapshot is kept after
+// a successful reconfigure and whether the VM opted into the snapshot.
+func reconfigureSnapshotGracePeriod(vm *vmopv1.VirtualMachine) (time.Duration, bool) {
+	v, ok := vm.Annotations[pkgconst.ReconfigureSnapshotAnnotationKey]
+	if !ok {
+		return 0, false
+	}
+	if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+		return d, true
+	}
+	return defaultReconfigureSnapshotGracePeriod, true
+}
+
+// snapshotBeforeReconfigure takes a snapshot of the VM if the VM opted into
+// it and the reconfigure is risky. The snapshot's reference is returned if
+// one was taken. If the VM already has a snapshot from an earlier
+// reconfigure that has not yet been deleted, then that snapshot is kept as
+// the rollback point and a new one is not taken.
+func snapshotBeforeReconfigure(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	vcVM *object.VirtualMachine,
+	configSpec vimtypes.VirtualMachineConfigSpec) (*vimtypes.ManagedObjectReference, error) {
+
+	if _, ok := reconfigureSnapshotGracePeriod(vm); !ok {
+		return nil, nil
+	}
+	if !IsRiskyReconfigure(configSpec) {
+		return nil, nil
+	}
+
+	// There is no guest to break until the VM has been powered on.
+	if vm.Annotations[vmopv1.FirstBootDoneAnnotation] == "" {
+		return nil, nil
+	}
+
+	logger := logr.FromContextOrDiscard(ctx)
+
+	if ref := vm.Annotations[pkgconst.ReconfigureSnapshotRefAnnotationKey]; ref != "" {
+		return &vimtypes.ManagedObjectReference{
+			Type:  "VirtualMachineSnapshot",
+			Value: ref,
+		}, nil
+	}
+
+	// A VM with snapshots cannot be encrypted, decrypted, or deep recrypted.
+	switch configSpec.Crypto.(type) {
+	case *vimtypes.CryptoSpecEncrypt,
+		*vimtypes.CryptoSpecDecrypt,
+		*vimtypes.CryptoSpecDeepRecrypt:
+
+		logger.Info("Skipping snapshot before reconfigure since the " +
+			"encryption change is not supported for a VM with snapshots")
+		return nil, nil
+	}
+
+	// vSphere does not support snapshots of a VM with a shared SCSI bus.
+	if vm.Spec.Advanced.HasSharedSCSIBus() {
+		logger.Info("Skipping snapshot before reconfigure since the VM " +
+			"has a shared SCSI bus")
+		return nil, nil
+	}
+
+	logger.Info("Taking snapshot before reconfigure", "name", ReconfigureSnapshotName)
+
+	t, err := vcVM.CreateSnapshot(
+		ctx,
+		ReconfigureSnapshotName,
+		fmt.Sprintf("Taken by VM Operator before reconfiguring at %s",
+			time.Now().UTC().Format(time.RFC3339)),
+		false,
+		false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot VM before reconfigure: %w", err)
+	}
+	result, err := t.WaitForResult(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot VM before reconfigure: %w", err)
+	}
+	ref, ok := result.Result.(vimtypes.ManagedObjectReference)
+	if !ok {
+		return nil, fmt.Errorf("unexpected snapshot task result: %T", result.Result)
+	}
+
+	if vm.Annotations == nil {
+		vm.Annotations = map[string]string{}
+	}
+	vm.Annotations[pkgconst.ReconfigureSnapshotRefAnnotationKey] = ref.Value
+
+	return &ref, nil
+}
+
+// onReconfigureSnapshotResult records when a reconfigure that was preceded
+// by a snapshot succeeded so the snapshot is deleted after the grace period.
+// A failed reconfigure does not change the VM, so a snapshot that was taken
+// only for it is deleted immediately.
+func onReconfigureSnapshotResult(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	vcVM *object.VirtualMachine,
+	snapshotRef *vimtypes.ManagedObjectReference,
+	reconfigureErr error) {
+
+	if snapshotRef == nil {
+		return
+	}
+
+	if reconfigureErr == nil {
+		vm.Annotations[pkgconst.ReconfigureSnapshotTimeAnnotationKey] =
+			time.Now().UTC().Format(time.RFC3339)
+		return
+	}
+
+	if vm.Annotations[pkgconst.ReconfigureSnapshotTimeAnnotationKey] != "" {
+		// The snapshot was taken before an earlier, successful reconfigure.
+		return
+	}
+
+	if err := removeReconfigureSnapshot(ctx, vm, vcVM); err != nil {
+		logr.FromContextOrDiscard(ctx).Error(err,
+			"Failed to remove snapshot after failed reconfigure")
+	}
+}
+
+// reconcileReconfigureSnapshot deletes the snapshot taken before a reconfigure
+// once the grace period has elapsed since the reconfigure succeeded.
+func (s *Session) reconcileReconfigureSnapshot(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcVM *object.VirtualMachine) error {
+
+	vm := vmCtx.VM
+	if vm.Annotations[pkgconst.ReconfigureSnapshotRefAnnotationKey] == "" {
+		return nil
+	}
+
+	// If the VM opted out, then the snapshot is deleted immediately.
+	gracePeriod, _ := reconfigureSnapshotGracePeriod(vm)
+
+	if v := vm.Annotations[pkgconst.ReconfigureSnapshotTimeAnnotationKey]; v != "" {
+		reconfiguredAt, err := time.Parse(time.RFC3339, v)
+		if err == nil && time.Since(reconfiguredAt) < gracePeriod {
+			return nil
+		}
+	} else if gracePeriod > 0 {
+		// The reconfigure has not yet succeeded.
+		return nil
+	}
+
+	vmCtx.Logger.Info("Removing snapshot taken before reconfigure",
+		"snapshot", vm.Annotations[pkgconst.ReconfigureSnapshotRefAnnotationKey])
+
+	return removeReconfigureSnapshot(vmCtx, vm, vcVM)
+}
+
+func removeReconfigureSnapshot(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	vcVM *object.VirtualMachine) error {
+
+	ref := vm.Annotations[pkgconst.ReconfigureSnapshotRefAnnotationKey]
+
+	// The snapshot may have already been removed or reverted to and removed
+	// out-of-band, in which case there is nothing left to do.
+	if _, err := vcVM.FindSnapshot(ctx, ref); err == nil {
+		consolidate := true
+		t, err := vcVM.RemoveSnapshot(ctx, ref, false, &consolidate)
+		if err != nil {
+			return fmt.Errorf("failed to remove snapshot %s: %w", ref, err)
+		}
+		if err := t.Wait(ctx); err != nil {
+			return fmt.Errorf("failed to remove snapshot %s: %w", ref, err)
+		}
+	}
+
+	delete(vm.Annotations, pkgconst.ReconfigureSnapshotRefAnnotationKey)
+	delete(vm.Annotations, pkgconst.ReconfigureSnapshotTimeAnnotationKey)
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package session_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/session"
+)
+
+var _ = DescribeTable("IsRiskyReconfigure",
+	func(configSpec vimtypes.VirtualMachineConfigSpec, expected bool) {
+		Expect(session.IsRiskyReconfigure(configSpec)).To(Equal(expected))
+	},
+	Entry("empty", vimtypes.VirtualMachineConfigSpec{}, false),
+	Entry("extra config only", vimtypes.VirtualMachineConfigSpec{
+		ExtraConfig: []vimtypes.BaseOptionValue{
+			&vimtypes.OptionValue{Key: "foo", Value: "bar"},
+		},
+	}, false),
+	Entry("crypto no-op", vimtypes.VirtualMachineConfigSpec{
+		Crypto: &vimtypes.CryptoSpecNoOp{},
+	}, false),
+	Entry("cpus", vimtypes.VirtualMachineConfigSpec{NumCPUs: 2}, true),
+	Entry("memory", vimtypes.VirtualMachineConfigSpec{MemoryMB: 1024}, true),
+	Entry("firmware", vimtypes.VirtualMachineConfigSpec{Firmware: "efi"}, true),
+	Entry("hardware version", vimtypes.VirtualMachineConfigSpec{Version: "vmx-21"}, true),
+	Entry("device change without a device", vimtypes.VirtualMachineConfigSpec{
+		DeviceChange: []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{},
+		},
+	}, false),
+	Entry("device added", vimtypes.VirtualMachineConfigSpec{
+		DeviceChange: []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device:    &vimtypes.VirtualTPM{},
+			},
+		},
+	}, true),
+	Entry("device removed", vimtypes.VirtualMachineConfigSpec{
+		DeviceChange: []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationRemove,
+				Device:    &vimtypes.VirtualVmxnet3{},
+			},
+		},
+	}, true),
+	Entry("device edited", vimtypes.VirtualMachineConfigSpec{
+		DeviceChange: []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationEdit,
+				Device:    &vimtypes.VirtualCdrom{},
+			},
+		},
+	}, false),
+	Entry("disk edited", vimtypes.VirtualMachineConfigSpec{
+		DeviceChange: []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationEdit,
+				Device:    &vimtypes.VirtualDisk{CapacityInBytes: 1 << 30},
+			},
+		},
+	}, false),
+	Entry("volume added", vimtypes.VirtualMachineConfigSpec{
+		DeviceChange: []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device: &vimtypes.VirtualDisk{
+					VDiskId: &vimtypes.ID{Id: "fcd-1"},
+				},
+			},
+		},
+	}, false),
+	Entry("crypto shallow recrypt", vimtypes.VirtualMachineConfigSpec{
+		Crypto: &vimtypes.CryptoSpecShallowRecrypt{},
+	}, true),
+)
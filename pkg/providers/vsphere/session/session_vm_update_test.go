@@ -6,6 +6,7 @@ package session_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -22,8 +23,10 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/session"
@@ -1177,6 +1180,7 @@ var _ = Describe("UpdateVirtualMachine", func() {
 			K8sClient:    ctx.Client,
 			Finder:       ctx.Finder,
 			ClusterMoRef: ccr.Reference(),
+			Inventory:    vcClient.Inventory(),
 		}
 	})
 
@@ -1216,6 +1220,35 @@ var _ = Describe("UpdateVirtualMachine", func() {
 				Expect(sess.UpdateVirtualMachine(vmCtx, vcVM, nil, nil)).To(Succeed())
 				assertNoUpdate()
 			})
+
+			When("the network migration window has not opened", func() {
+				var start time.Time
+
+				BeforeEach(func() {
+					start = time.Now().Add(time.Hour).Truncate(time.Second)
+					vm.Annotations = map[string]string{
+						pkgconst.DefaultNamedNetworkAnnotationKey: "VM Network",
+						pkgconst.NetworkMigrationWindowAnnotationKey: fmt.Sprintf("%s/%s",
+							start.Format(time.RFC3339), start.Add(time.Hour).Format(time.RFC3339)),
+					}
+				})
+
+				JustBeforeEach(func() {
+					sess.DefaultNamedNetwork = "DC0_DVPG0"
+				})
+
+				It("should requeue the VM when the window opens", func() {
+					err := sess.UpdateVirtualMachine(vmCtx, vcVM, nil, nil)
+					var requeueErr pkgerr.RequeueError
+					Expect(errors.As(err, &requeueErr)).To(BeTrue())
+					Expect(requeueErr.After).To(BeNumerically("~", time.Until(start), time.Minute))
+					assertNoUpdate()
+
+					c := conditions.Get(vm, vmopv1.VirtualMachineConditionNetworkMigrated)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Reason).To(Equal(session.NetworkMigrationPendingReason))
+				})
+			})
 		})
 
 		When("powering off the VM", func() {
@@ -1630,6 +1663,7 @@ var _ = Describe("UpdateVirtualMachine", func() {
 					for _, obj := range objs {
 						Expect(ctx.Client.Create(ctx, obj)).To(Succeed())
 					}
+					sess.CdromImages = virtualmachine.GetCdromImages(vmCtx, ctx.Client, vmCtx.VM)
 				})
 
 				When("there are CD-ROM device changes", func() {
@@ -22,6 +22,20 @@ type VMStorageData struct {
 	PVCs                   []corev1.PersistentVolumeClaim
 }
 
+// StoragePolicyIDForSite returns the storage policy ID of the StorageClass
+// when used by a VM that prefers the specified site. The StorageClass may map
+// the site to a site affinity storage policy.
+func (d VMStorageData) StoragePolicyIDForSite(
+	storageClassName, site string) (string, error) {
+
+	if site != "" {
+		if sc, ok := d.StorageClasses[storageClassName]; ok {
+			return kubeutil.GetStoragePolicyIDForSite(sc, site)
+		}
+	}
+	return d.StorageClassToPolicyID[storageClassName], nil
+}
+
 func getStorageClassAndPolicyID(
 	vmCtx pkgctx.VirtualMachineContext,
 	client ctrlclient.Client,
@@ -107,5 +121,13 @@ func getVMStorageClassNamesAndPVCs(
 		}
 	}
 
+	if adv := vm.Spec.Advanced; adv != nil {
+		for _, disk := range adv.DataDisks {
+			if disk.StorageClass != "" {
+				scNames = append(scNames, disk.StorageClass)
+			}
+		}
+	}
+
 	return scNames, pvcs, nil
 }
@@ -17,6 +17,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/storage"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
@@ -97,3 +98,45 @@ var _ = Describe("GetVMStorageData", func() {
 		})
 	})
 })
+
+var _ = Describe("StoragePolicyIDForSite", func() {
+
+	var data storage.VMStorageData
+
+	BeforeEach(func() {
+		data = storage.VMStorageData{
+			StorageClasses: map[string]storagev1.StorageClass{
+				"my-class": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-class",
+						Annotations: map[string]string{
+							pkgconst.SiteStoragePoliciesAnnotationKey: "site-a=id-a",
+						},
+					},
+					Parameters: map[string]string{
+						"storagePolicyID": "id1",
+					},
+				},
+			},
+			StorageClassToPolicyID: map[string]string{
+				"my-class": "id1",
+			},
+		}
+	})
+
+	It("returns the class's policy when there is no site", func() {
+		Expect(data.StoragePolicyIDForSite("my-class", "")).To(Equal("id1"))
+	})
+
+	It("returns the site's policy", func() {
+		Expect(data.StoragePolicyIDForSite("my-class", "site-a")).To(Equal("id-a"))
+	})
+
+	It("returns the class's policy when the site does not have a policy", func() {
+		Expect(data.StoragePolicyIDForSite("my-class", "site-b")).To(Equal("id1"))
+	})
+
+	It("returns an empty policy for an unknown class", func() {
+		Expect(data.StoragePolicyIDForSite("other-class", "site-a")).To(BeEmpty())
+	})
+})
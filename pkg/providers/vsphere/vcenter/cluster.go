@@ -6,10 +6,15 @@ package vcenter
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/vmware/govmomi/crypto"
+	"github.com/vmware/govmomi/fault"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
 )
 
 // ClusterMinCPUFreq returns the minimum frequency across all the hosts in the cluster. This is needed to
@@ -43,3 +48,157 @@ func ClusterMinCPUFreq(ctx context.Context, cluster *object.ClusterComputeResour
 
 	return minFreq, nil
 }
+
+// Capabilities are the capabilities of a cluster or host that may be required
+// by a VM's image or class.
+type Capabilities struct {
+	// VSAN is true if the cluster or host has a vSAN datastore.
+	VSAN bool
+
+	// PMem is true if the cluster or host has a persistent memory datastore.
+	PMem bool
+
+	// VTPM is true if there is a default key provider, which is required to
+	// encrypt the VM home of a VM with a vTPM.
+	VTPM bool
+}
+
+// GetClusterCapabilities returns the capabilities of the cluster.
+func GetClusterCapabilities(
+	ctx context.Context,
+	cluster *object.ClusterComputeResource) (Capabilities, error) {
+
+	var cr mo.ComputeResource
+	if err := cluster.Properties(ctx, cluster.Reference(), []string{"datastore"}, &cr); err != nil {
+		return Capabilities{}, err
+	}
+
+	caps, err := getDatastoreCapabilities(ctx, cluster.Client(), cr.Datastore)
+	if err != nil {
+		return Capabilities{}, err
+	}
+
+	if caps.VTPM, err = HasDefaultKeyProvider(ctx, cluster.Client()); err != nil {
+		return Capabilities{}, err
+	}
+
+	return caps, nil
+}
+
+// GetHostsCapabilities returns the capabilities of each of the hosts, keyed
+// by the host's MoID.
+func GetHostsCapabilities(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	hostMoRefs []vimtypes.ManagedObjectReference) (map[string]Capabilities, error) {
+
+	if len(hostMoRefs) == 0 {
+		return nil, nil
+	}
+
+	var hosts []mo.HostSystem
+	pc := property.DefaultCollector(vimClient)
+	if err := pc.Retrieve(ctx, hostMoRefs, []string{"datastore"}, &hosts); err != nil {
+		return nil, fmt.Errorf("failed to get hosts datastores: %w", err)
+	}
+
+	hasKeyProvider, err := HasDefaultKeyProvider(ctx, vimClient)
+	if err != nil {
+		return nil, err
+	}
+
+	hostCaps := make(map[string]Capabilities, len(hosts))
+	for i := range hosts {
+		caps, err := getDatastoreCapabilities(ctx, vimClient, hosts[i].Datastore)
+		if err != nil {
+			return nil, err
+		}
+		caps.VTPM = hasKeyProvider
+		hostCaps[hosts[i].Reference().Value] = caps
+	}
+
+	return hostCaps, nil
+}
+
+// HasDefaultKeyProvider returns true if there is a default key provider.
+func HasDefaultKeyProvider(ctx context.Context, vimClient *vim25.Client) (bool, error) {
+	providerID, err := crypto.NewManagerKmip(vimClient).GetDefaultKmsClusterID(ctx, nil, true)
+	if err != nil {
+		// A RuntimeFault, and not one of its subtypes, is returned when there
+		// is no default key provider.
+		if fault.Is(err, &vimtypes.RuntimeFault{}) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get default key provider: %w", err)
+	}
+
+	return providerID != "", nil
+}
+
+func getDatastoreCapabilities(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	dsMoRefs []vimtypes.ManagedObjectReference) (Capabilities, error) {
+
+	var caps Capabilities
+
+	if len(dsMoRefs) == 0 {
+		return caps, nil
+	}
+
+	var datastores []mo.Datastore
+	pc := property.DefaultCollector(vimClient)
+	if err := pc.Retrieve(ctx, dsMoRefs, []string{"summary.type"}, &datastores); err != nil {
+		return caps, err
+	}
+
+	for i := range datastores {
+		switch vimtypes.HostFileSystemVolumeFileSystemType(datastores[i].Summary.Type) {
+		case vimtypes.HostFileSystemVolumeFileSystemTypeVsan:
+			caps.VSAN = true
+		case vimtypes.HostFileSystemVolumeFileSystemTypePMEM:
+			caps.PMem = true
+		}
+	}
+
+	return caps, nil
+}
+
+// GetClusterHostsInSite returns the available hosts in the cluster that belong
+// to the specified site, i.e. the vSAN fault domain of a stretched cluster.
+// Hosts that are in maintenance mode, disconnected, or not responding are not
+// returned.
+func GetClusterHostsInSite(
+	ctx context.Context,
+	cluster *object.ClusterComputeResource,
+	site string) ([]vimtypes.ManagedObjectReference, error) {
+
+	var cr mo.ComputeResource
+	if err := cluster.Properties(ctx, cluster.Reference(), []string{"host"}, &cr); err != nil {
+		return nil, err
+	}
+
+	if len(cr.Host) == 0 {
+		return nil, nil
+	}
+
+	var hosts []mo.HostSystem
+	pc := property.DefaultCollector(cluster.Client())
+	if err := pc.Retrieve(ctx, cr.Host, []string{"config.vsanHostConfig", "runtime"}, &hosts); err != nil {
+		return nil, err
+	}
+
+	var siteHosts []vimtypes.ManagedObjectReference
+	for i := range hosts {
+		if HostUnavailableReason(hosts[i].Runtime) != "" {
+			continue
+		}
+		if c := hosts[i].Config; c != nil && c.VsanHostConfig != nil {
+			if fd := c.VsanHostConfig.FaultDomainInfo; fd != nil && fd.Name == site {
+				siteHosts = append(siteHosts, hosts[i].Reference())
+			}
+		}
+	}
+
+	return siteHosts, nil
+}
@@ -5,15 +5,24 @@
 package vcenter_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	vimcrypto "github.com/vmware/govmomi/crypto"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vcenter"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
 func clusterTests() {
 	Describe("ClusterMinCPUFreq", minFreq)
+	Describe("GetClusterCapabilities", clusterCapabilities)
+	Describe("GetClusterHostsInSite", clusterHostsInSite)
 }
 
 func minFreq() {
@@ -46,3 +55,137 @@ func minFreq() {
 		})
 	})
 }
+
+func clusterCapabilities() {
+
+	var (
+		ctx *builder.TestContextForVCSim
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewTestContextForVCSim(builder.VCSimTestConfig{})
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	It("returns the capabilities of the cluster", func() {
+		caps, err := vcenter.GetClusterCapabilities(ctx, ctx.GetFirstClusterFromFirstZone())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(caps.VSAN).To(BeFalse())
+		Expect(caps.PMem).To(BeFalse())
+		Expect(caps.VTPM).To(BeFalse())
+	})
+
+	When("there is a default key provider", func() {
+		BeforeEach(func() {
+			m := vimcrypto.NewManagerKmip(ctx.VCClient.Client)
+			Expect(m.MarkDefault(ctx, ctx.NativeKeyProviderID)).To(Succeed())
+		})
+
+		It("returns the cluster has the vTPM capability", func() {
+			caps, err := vcenter.GetClusterCapabilities(ctx, ctx.GetFirstClusterFromFirstZone())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caps.VTPM).To(BeTrue())
+		})
+
+		It("returns the hosts have the vTPM capability", func() {
+			hosts, err := ctx.GetFirstClusterFromFirstZone().Hosts(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hosts).ToNot(BeEmpty())
+
+			hostCaps, err := vcenter.GetHostsCapabilities(ctx, ctx.VCClient.Client,
+				[]vimtypes.ManagedObjectReference{hosts[0].Reference()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hostCaps).To(HaveKeyWithValue(hosts[0].Reference().Value, vcenter.Capabilities{VTPM: true}))
+		})
+	})
+
+	When("the key provider lookup fails", func() {
+		It("returns an error", func() {
+			cancelCtx, cancel := context.WithCancel(ctx)
+			cancel()
+
+			_, err := vcenter.HasDefaultKeyProvider(cancelCtx, ctx.VCClient.Client)
+			Expect(err).To(MatchError(ContainSubstring("failed to get default key provider")))
+		})
+	})
+}
+
+func clusterHostsInSite() {
+
+	var (
+		ctx     *builder.TestContextForVCSim
+		cluster *object.ClusterComputeResource
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewTestContextForVCSim(builder.VCSimTestConfig{})
+		cluster = ctx.GetFirstClusterFromFirstZone()
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		cluster = nil
+	})
+
+	It("returns no hosts when no host is in the site", func() {
+		hosts, err := vcenter.GetClusterHostsInSite(ctx, cluster, "site-a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hosts).To(BeEmpty())
+	})
+
+	When("a host is in the site", func() {
+		var hostRef vimtypes.ManagedObjectReference
+
+		BeforeEach(func() {
+			hosts, err := cluster.Hosts(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hosts).ToNot(BeEmpty())
+			hostRef = hosts[0].Reference()
+
+			simulator.Map.WithLock(
+				simulator.SpoofContext(),
+				hostRef,
+				func() {
+					host := simulator.Map.Get(hostRef).(*simulator.HostSystem)
+					host.Config.VsanHostConfig = &vimtypes.VsanHostConfigInfo{
+						FaultDomainInfo: &vimtypes.VsanHostFaultDomainInfo{
+							Name: "site-a",
+						},
+					}
+				})
+		})
+
+		It("returns the host", func() {
+			hosts, err := vcenter.GetClusterHostsInSite(ctx, cluster, "site-a")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hosts).To(ConsistOf(hostRef))
+
+			hosts, err = vcenter.GetClusterHostsInSite(ctx, cluster, "site-b")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hosts).To(BeEmpty())
+		})
+
+		When("the host is in maintenance mode", func() {
+			BeforeEach(func() {
+				simulator.Map.WithLock(
+					simulator.SpoofContext(),
+					hostRef,
+					func() {
+						host := simulator.Map.Get(hostRef).(*simulator.HostSystem)
+						host.Runtime.InMaintenanceMode = true
+					})
+			})
+
+			It("does not return the host", func() {
+				hosts, err := vcenter.GetClusterHostsInSite(ctx, cluster, "site-a")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(hosts).To(BeEmpty())
+			})
+		})
+	})
+}
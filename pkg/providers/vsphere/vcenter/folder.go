@@ -7,7 +7,10 @@ package vcenter
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/vmware/govmomi/fault"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25"
@@ -88,6 +91,89 @@ func CreateFolder(
 	return childFolder.Reference().Value, nil
 }
 
+// CreateFolderPath creates the Folders in the path under the parent Folder
+// that do not already exist, and returns the MoID of the last Folder in the
+// path.
+func CreateFolderPath(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	parentFolderMoID string,
+	path []string) (string, error) {
+
+	folderMoID := parentFolderMoID
+	for _, name := range path {
+		moID, err := CreateFolder(ctx, vimClient, folderMoID, name)
+		if err != nil {
+			if !fault.Is(err, &vimtypes.DuplicateName{}) {
+				return "", err
+			}
+
+			// The Folder was created concurrently.
+			parentFolder := object.NewFolder(vimClient,
+				vimtypes.ManagedObjectReference{Type: "Folder", Value: folderMoID})
+			folder, err := GetChildFolder(ctx, parentFolder, name)
+			if err != nil {
+				return "", err
+			}
+			moID = folder.Reference().Value
+		}
+		folderMoID = moID
+	}
+
+	return folderMoID, nil
+}
+
+const (
+	// FolderPathTemplateCluster is replaced in a Folder path template with
+	// the name of the VM's cluster.
+	FolderPathTemplateCluster = "cluster"
+
+	// FolderPathTemplateZone is replaced in a Folder path template with the
+	// name of the VM's zone.
+	FolderPathTemplateZone = "zone"
+
+	// FolderPathTemplateNamespace is replaced in a Folder path template with
+	// the VM's namespace.
+	FolderPathTemplateNamespace = "namespace"
+
+	// FolderPathTemplateResourcePolicy is replaced in a Folder path template
+	// with the name of the VM's VirtualMachineSetResourcePolicy.
+	FolderPathTemplateResourcePolicy = "resource-policy"
+)
+
+var folderPathTemplateRx = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// ResolveFolderPathTemplate returns the Folder names in the path template
+// after replacing its placeholders, ex. {namespace}, with the values. A name
+// that is empty after replacing the placeholders is omitted. An error is
+// returned if the template has a placeholder that is not in the values.
+func ResolveFolderPathTemplate(
+	template string,
+	values map[string]string) ([]string, error) {
+
+	var path []string
+	for _, elem := range strings.Split(template, "/") {
+		var unknown []string
+		name := folderPathTemplateRx.ReplaceAllStringFunc(elem, func(p string) string {
+			key := p[1 : len(p)-1]
+			v, ok := values[key]
+			if !ok {
+				unknown = append(unknown, p)
+			}
+			return v
+		})
+		if len(unknown) > 0 {
+			return nil, fmt.Errorf("folder path template %q has unknown placeholders: %s",
+				template, strings.Join(unknown, ", "))
+		}
+		if name = strings.TrimSpace(name); name != "" {
+			path = append(path, name)
+		}
+	}
+
+	return path, nil
+}
+
 // DeleteChildFolder deletes the child Folder under the parent Folder.
 func DeleteChildFolder(
 	ctx context.Context,
@@ -104,6 +104,35 @@ func createDeleteExistsFolder() {
 		})
 	})
 
+	Context("CreateFolderPath", func() {
+		It("creates the Folders in the path", func() {
+			leafMoID, err := vcenter.CreateFolderPath(ctx, ctx.VCClient.Client, parentFolderMoID, []string{"a", "b"})
+			Expect(err).ToNot(HaveOccurred())
+
+			parentFolder := object.NewFolder(ctx.VCClient.Client, vimtypes.ManagedObjectReference{
+				Type:  "Folder",
+				Value: parentFolderMoID,
+			})
+			a, err := vcenter.GetChildFolder(ctx, parentFolder, "a")
+			Expect(err).ToNot(HaveOccurred())
+			b, err := vcenter.GetChildFolder(ctx, a, "b")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.Reference().Value).To(Equal(leafMoID))
+
+			By("NoOp when the Folders already exist", func() {
+				moID, err := vcenter.CreateFolderPath(ctx, ctx.VCClient.Client, parentFolderMoID, []string{"a", "b"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(moID).To(Equal(leafMoID))
+			})
+		})
+
+		It("returns the parent Folder when the path is empty", func() {
+			moID, err := vcenter.CreateFolderPath(ctx, ctx.VCClient.Client, parentFolderMoID, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(moID).To(Equal(parentFolderMoID))
+		})
+	})
+
 	Context("GetChildFolder", func() {
 		It("returns success when child Folder exists", func() {
 			childFolderMoID, err := vcenter.CreateFolder(ctx, ctx.VCClient.Client, parentFolderMoID, "myFolder")
@@ -176,3 +205,23 @@ func createDeleteExistsFolder() {
 		})
 	})
 }
+
+var _ = Describe("ResolveFolderPathTemplate", func() {
+	values := map[string]string{
+		vcenter.FolderPathTemplateCluster:        "cluster-1",
+		vcenter.FolderPathTemplateNamespace:      "my-ns",
+		vcenter.FolderPathTemplateResourcePolicy: "",
+	}
+
+	It("replaces the placeholders", func() {
+		path, err := vcenter.ResolveFolderPathTemplate(
+			"/tanzu/{cluster}/ns-{namespace}/{resource-policy}", values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal([]string{"tanzu", "cluster-1", "ns-my-ns"}))
+	})
+
+	It("returns an error for unknown placeholders", func() {
+		_, err := vcenter.ResolveFolderPathTemplate("tanzu/{team}", values)
+		Expect(err).To(MatchError(ContainSubstring("unknown placeholders: {team}")))
+	})
+})
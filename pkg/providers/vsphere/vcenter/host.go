@@ -10,9 +10,12 @@ import (
 	"strings"
 
 	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 )
 
 // GetESXHostFQDN returns the ESX host's FQDN.
@@ -40,3 +43,46 @@ func GetESXHostFQDN(
 	hostFQDN := strings.TrimSuffix(hostDNSConfig.HostName+"."+hostDNSConfig.DomainName, ".")
 	return strings.ToLower(hostFQDN), nil
 }
+
+// HostUnavailableReason returns the reason the host with the provided runtime
+// info is unavailable, or an empty string if the host is available. A host is
+// unavailable when it is in maintenance mode, disconnected, or not responding.
+func HostUnavailableReason(runtime vimtypes.HostRuntimeInfo) string {
+	switch {
+	case runtime.InMaintenanceMode:
+		return vmopv1.VirtualMachineHostInMaintenanceModeReason
+	case runtime.ConnectionState == vimtypes.HostSystemConnectionStateDisconnected:
+		return vmopv1.VirtualMachineHostDisconnectedReason
+	case runtime.ConnectionState == vimtypes.HostSystemConnectionStateNotResponding:
+		return vmopv1.VirtualMachineHostNotRespondingReason
+	}
+	return ""
+}
+
+// GetUnavailableHosts returns the reason each of the provided hosts is
+// unavailable, keyed by the host's MoID. Available hosts are not included in
+// the returned map.
+func GetUnavailableHosts(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	hostMoRefs []vimtypes.ManagedObjectReference) (map[string]string, error) {
+
+	if len(hostMoRefs) == 0 {
+		return nil, nil
+	}
+
+	var hosts []mo.HostSystem
+	pc := property.DefaultCollector(vimClient)
+	if err := pc.Retrieve(ctx, hostMoRefs, []string{"runtime"}, &hosts); err != nil {
+		return nil, fmt.Errorf("failed to get hosts runtime: %w", err)
+	}
+
+	unavailable := map[string]string{}
+	for i := range hosts {
+		if reason := HostUnavailableReason(hosts[i].Runtime); reason != "" {
+			unavailable[hosts[i].Reference().Value] = reason
+		}
+	}
+
+	return unavailable, nil
+}
@@ -10,12 +10,17 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/vmware/govmomi/simulator"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vcenter"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
 func hostTests() {
 	Describe("GetESXHostFQDN", hostFQDN)
+	Describe("GetUnavailableHosts", unavailableHosts)
 }
 
 func hostFQDN() {
@@ -64,3 +69,63 @@ func hostFQDN() {
 		})
 	})
 }
+
+func unavailableHosts() {
+	var (
+		ctx      *builder.TestContextForVCSim
+		hostRefs []vimtypes.ManagedObjectReference
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewTestContextForVCSim(builder.VCSimTestConfig{})
+
+		hosts, err := ctx.Finder.HostSystemList(ctx, "*")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(len(hosts)).To(BeNumerically(">=", 3))
+
+		hostRefs = nil
+		for _, h := range hosts {
+			hostRefs = append(hostRefs, h.Reference())
+		}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	It("returns no hosts when all hosts are available", func() {
+		unavailable, err := vcenter.GetUnavailableHosts(ctx, ctx.VCClient.Client, hostRefs)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(unavailable).To(BeEmpty())
+	})
+
+	It("returns the hosts that are unavailable", func() {
+		setRuntime := func(ref vimtypes.ManagedObjectReference, fn func(*vimtypes.HostRuntimeInfo)) {
+			simulator.Map.WithLock(
+				simulator.SpoofContext(),
+				ref,
+				func() {
+					fn(&simulator.Map.Get(ref).(*simulator.HostSystem).Runtime)
+				})
+		}
+
+		setRuntime(hostRefs[0], func(r *vimtypes.HostRuntimeInfo) {
+			r.InMaintenanceMode = true
+		})
+		setRuntime(hostRefs[1], func(r *vimtypes.HostRuntimeInfo) {
+			r.ConnectionState = vimtypes.HostSystemConnectionStateDisconnected
+		})
+		setRuntime(hostRefs[2], func(r *vimtypes.HostRuntimeInfo) {
+			r.ConnectionState = vimtypes.HostSystemConnectionStateNotResponding
+		})
+
+		unavailable, err := vcenter.GetUnavailableHosts(ctx, ctx.VCClient.Client, hostRefs)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(unavailable).To(Equal(map[string]string{
+			hostRefs[0].Value: vmopv1.VirtualMachineHostInMaintenanceModeReason,
+			hostRefs[1].Value: vmopv1.VirtualMachineHostDisconnectedReason,
+			hostRefs[2].Value: vmopv1.VirtualMachineHostNotRespondingReason,
+		}))
+	})
+}
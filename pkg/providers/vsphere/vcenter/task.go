@@ -0,0 +1,132 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+)
+
+// IsTaskStalled returns true if the task with the provided info has been
+// queued or running for longer than the threshold. A task is never stalled if
+// the threshold is not positive.
+func IsTaskStalled(info vimtypes.TaskInfo, threshold time.Duration, now time.Time) bool {
+	if threshold <= 0 {
+		return false
+	}
+
+	var since time.Time
+	switch info.State {
+	case vimtypes.TaskInfoStateQueued:
+		since = info.QueueTime
+	case vimtypes.TaskInfoStateRunning:
+		since = info.QueueTime
+		if info.StartTime != nil {
+			since = *info.StartTime
+		}
+	default:
+		return false
+	}
+
+	return !since.IsZero() && now.Sub(since) > threshold
+}
+
+// IsTaskStartedBy returns true if the task with the provided info was started
+// by the user with the provided name, ex. the user of VM Operator's session.
+func IsTaskStartedBy(info vimtypes.TaskInfo, userName string) bool {
+	if userName == "" {
+		return false
+	}
+	r, ok := info.Reason.(*vimtypes.TaskReasonUser)
+	return ok && r.UserName == userName
+}
+
+// GetSessionUserName returns the name of the user of the client's session, as
+// reported in the info of the tasks started by the client.
+func GetSessionUserName(ctx context.Context, vimClient *vim25.Client) (string, error) {
+	userSession, err := session.NewManager(vimClient).UserSession(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user session: %w", err)
+	}
+	if userSession == nil {
+		return "", nil
+	}
+	return userSession.UserName, nil
+}
+
+// GetStalledTasks returns the info of the provided tasks that have been queued
+// or running for longer than the threshold. Tasks that no longer exist are
+// ignored.
+func GetStalledTasks(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	taskMoRefs []vimtypes.ManagedObjectReference,
+	threshold time.Duration) ([]vimtypes.TaskInfo, error) {
+
+	if len(taskMoRefs) == 0 || threshold <= 0 {
+		return nil, nil
+	}
+
+	tasks, err := getTasks(ctx, vimClient, taskMoRefs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks info: %w", err)
+	}
+
+	now := time.Now()
+	var stalled []vimtypes.TaskInfo
+	for i := range tasks {
+		if IsTaskStalled(tasks[i].Info, threshold, now) {
+			stalled = append(stalled, tasks[i].Info)
+		}
+	}
+
+	return stalled, nil
+}
+
+// getTasks returns the tasks with the provided references. A task may be
+// removed after the references are retrieved, which fails the retrieval of
+// all of the tasks, so the tasks are then retrieved one at a time and the
+// tasks that no longer exist are skipped.
+func getTasks(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	taskMoRefs []vimtypes.ManagedObjectReference) ([]mo.Task, error) {
+
+	var tasks []mo.Task
+	pc := property.DefaultCollector(vimClient)
+
+	err := pc.Retrieve(ctx, taskMoRefs, []string{"info"}, &tasks)
+	if err == nil || !isManagedObjectNotFound(err) {
+		return tasks, err
+	}
+
+	tasks = nil
+	for _, ref := range taskMoRefs {
+		var task mo.Task
+		if err := pc.RetrieveOne(ctx, ref, []string{"info"}, &task); err != nil {
+			if isManagedObjectNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+func isManagedObjectNotFound(err error) bool {
+	var f *vimtypes.ManagedObjectNotFound
+	_, ok := fault.As(err, &f)
+	return ok
+}
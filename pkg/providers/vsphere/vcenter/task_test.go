@@ -0,0 +1,176 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vcenter_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/simulator"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vcenter"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("IsTaskStalled", func() {
+	var (
+		now       time.Time
+		threshold time.Duration
+		info      vimtypes.TaskInfo
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		threshold = time.Hour
+		info = vimtypes.TaskInfo{
+			State:     vimtypes.TaskInfoStateRunning,
+			QueueTime: now.Add(-3 * time.Hour),
+		}
+	})
+
+	It("returns false when the threshold is zero", func() {
+		threshold = 0
+		Expect(vcenter.IsTaskStalled(info, threshold, now)).To(BeFalse())
+	})
+
+	It("returns false when the task is complete", func() {
+		info.State = vimtypes.TaskInfoStateSuccess
+		Expect(vcenter.IsTaskStalled(info, threshold, now)).To(BeFalse())
+		info.State = vimtypes.TaskInfoStateError
+		Expect(vcenter.IsTaskStalled(info, threshold, now)).To(BeFalse())
+	})
+
+	It("returns true when the task has been queued beyond the threshold", func() {
+		info.State = vimtypes.TaskInfoStateQueued
+		Expect(vcenter.IsTaskStalled(info, threshold, now)).To(BeTrue())
+	})
+
+	It("returns false when the task has been queued within the threshold", func() {
+		info.State = vimtypes.TaskInfoStateQueued
+		info.QueueTime = now.Add(-time.Minute)
+		Expect(vcenter.IsTaskStalled(info, threshold, now)).To(BeFalse())
+	})
+
+	It("returns true when the task has been running beyond the threshold", func() {
+		start := now.Add(-2 * time.Hour)
+		info.StartTime = &start
+		Expect(vcenter.IsTaskStalled(info, threshold, now)).To(BeTrue())
+	})
+
+	It("returns false when the task started running within the threshold", func() {
+		start := now.Add(-time.Minute)
+		info.StartTime = &start
+		Expect(vcenter.IsTaskStalled(info, threshold, now)).To(BeFalse())
+	})
+})
+
+var _ = Describe("IsTaskStartedBy", func() {
+	info := vimtypes.TaskInfo{
+		Reason: &vimtypes.TaskReasonUser{UserName: "VSPHERE.LOCAL\\vmop-user"},
+	}
+
+	It("returns true when the task was started by the user", func() {
+		Expect(vcenter.IsTaskStartedBy(info, "VSPHERE.LOCAL\\vmop-user")).To(BeTrue())
+	})
+
+	It("returns false when the task was started by another user", func() {
+		Expect(vcenter.IsTaskStartedBy(info, "VSPHERE.LOCAL\\other-user")).To(BeFalse())
+	})
+
+	It("returns false when the user is empty", func() {
+		Expect(vcenter.IsTaskStartedBy(vimtypes.TaskInfo{
+			Reason: &vimtypes.TaskReasonUser{},
+		}, "")).To(BeFalse())
+	})
+
+	It("returns false when the task was not started by a user", func() {
+		Expect(vcenter.IsTaskStartedBy(vimtypes.TaskInfo{
+			Reason: &vimtypes.TaskReasonSystem{},
+		}, "VSPHERE.LOCAL\\vmop-user")).To(BeFalse())
+	})
+})
+
+func taskTests() {
+	Describe("GetStalledTasks", stalledTasks)
+}
+
+func stalledTasks() {
+	var (
+		ctx     *builder.TestContextForVCSim
+		taskRef vimtypes.ManagedObjectReference
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewTestContextForVCSim(builder.VCSimTestConfig{})
+
+		vm, err := ctx.Finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		Expect(err).ToNot(HaveOccurred())
+
+		task, err := vm.PowerOff(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(task.Wait(ctx)).To(Succeed())
+		taskRef = task.Reference()
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	It("returns no tasks when there are no tasks", func() {
+		stalled, err := vcenter.GetStalledTasks(ctx, ctx.VCClient.Client, nil, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stalled).To(BeEmpty())
+	})
+
+	It("returns no tasks when the tasks are complete", func() {
+		stalled, err := vcenter.GetStalledTasks(
+			ctx, ctx.VCClient.Client, []vimtypes.ManagedObjectReference{taskRef}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stalled).To(BeEmpty())
+	})
+
+	It("returns the tasks that are stalled", func() {
+		simulator.Map.WithLock(
+			simulator.SpoofContext(),
+			taskRef,
+			func() {
+				task := simulator.Map.Get(taskRef).(*simulator.Task)
+				start := time.Now().Add(-2 * time.Hour)
+				task.Info.State = vimtypes.TaskInfoStateRunning
+				task.Info.StartTime = &start
+				task.Info.CompleteTime = nil
+			})
+
+		stalled, err := vcenter.GetStalledTasks(
+			ctx, ctx.VCClient.Client, []vimtypes.ManagedObjectReference{taskRef}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stalled).To(HaveLen(1))
+		Expect(stalled[0].Task).To(Equal(taskRef))
+	})
+
+	It("ignores the tasks that no longer exist", func() {
+		simulator.Map.WithLock(
+			simulator.SpoofContext(),
+			taskRef,
+			func() {
+				task := simulator.Map.Get(taskRef).(*simulator.Task)
+				start := time.Now().Add(-2 * time.Hour)
+				task.Info.State = vimtypes.TaskInfoStateRunning
+				task.Info.StartTime = &start
+				task.Info.CompleteTime = nil
+			})
+
+		missing := vimtypes.ManagedObjectReference{Type: "Task", Value: "task-does-not-exist"}
+		stalled, err := vcenter.GetStalledTasks(
+			ctx, ctx.VCClient.Client, []vimtypes.ManagedObjectReference{missing, taskRef}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stalled).To(HaveLen(1))
+		Expect(stalled[0].Task).To(Equal(taskRef))
+	})
+}
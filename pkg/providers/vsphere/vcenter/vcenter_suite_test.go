@@ -21,6 +21,7 @@ func vcSimTests() {
 	Describe("GetVM", Label(testlabels.VCSim), getVMTests)
 	Describe("Host", Label(testlabels.VCSim), hostTests)
 	Describe("ResourcePool", Label(testlabels.VCSim), resourcePoolTests)
+	Describe("Task", Label(testlabels.VCSim), taskTests)
 }
 
 func TestVCenter(t *testing.T) {
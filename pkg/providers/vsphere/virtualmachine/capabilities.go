@@ -0,0 +1,102 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine
+
+import (
+	"slices"
+	"strings"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vcenter"
+)
+
+// Capability is a cluster capability that may be required by a VM's image or
+// class.
+type Capability string
+
+const (
+	CapabilityVSAN Capability = "vsan"
+	CapabilityVTPM Capability = "vtpm"
+	CapabilityPMem Capability = "pmem"
+)
+
+// IsKnownCapability returns true if c is one of the known capabilities.
+func IsKnownCapability(c Capability) bool {
+	switch c {
+	case CapabilityVSAN, CapabilityVTPM, CapabilityPMem:
+		return true
+	}
+	return false
+}
+
+// RequiredCapabilities returns the sorted cluster capabilities required by
+// the devices in the ConfigSpec and by the required capabilities annotation
+// on the provided objects, ex. the VM's class and image.
+func RequiredCapabilities(
+	configSpec vimtypes.VirtualMachineConfigSpec,
+	objs ...metav1.Object) []Capability {
+
+	var required []Capability
+	add := func(c Capability) {
+		if !slices.Contains(required, c) {
+			required = append(required, c)
+		}
+	}
+
+	for _, dc := range configSpec.DeviceChange {
+		spec := dc.GetVirtualDeviceConfigSpec()
+		if spec == nil || spec.Operation == vimtypes.VirtualDeviceConfigSpecOperationRemove {
+			continue
+		}
+		switch spec.Device.(type) {
+		case *vimtypes.VirtualTPM:
+			add(CapabilityVTPM)
+		case *vimtypes.VirtualNVDIMM, *vimtypes.VirtualNVDIMMController:
+			add(CapabilityPMem)
+		}
+	}
+
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		v := obj.GetAnnotations()[constants.RequiredCapabilitiesAnnotationKey]
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.ToLower(strings.TrimSpace(s)); s != "" {
+				add(Capability(s))
+			}
+		}
+	}
+
+	slices.Sort(required)
+	return required
+}
+
+// MissingCapabilities returns the required capabilities the cluster or host
+// does not have. Unknown capabilities are always missing.
+func MissingCapabilities(
+	required []Capability,
+	caps vcenter.Capabilities) []Capability {
+
+	var missing []Capability
+	for _, c := range required {
+		var ok bool
+		switch c {
+		case CapabilityVSAN:
+			ok = caps.VSAN
+		case CapabilityVTPM:
+			ok = caps.VTPM
+		case CapabilityPMem:
+			ok = caps.PMem
+		}
+		if !ok {
+			missing = append(missing, c)
+		}
+	}
+	return missing
+}
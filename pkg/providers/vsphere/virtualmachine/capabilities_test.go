@@ -0,0 +1,114 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vcenter"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
+)
+
+var _ = Describe("RequiredCapabilities", func() {
+
+	var (
+		configSpec vimtypes.VirtualMachineConfigSpec
+		vmClass    *vmopv1.VirtualMachineClass
+		vmImage    *vmopv1.VirtualMachineImage
+	)
+
+	BeforeEach(func() {
+		configSpec = vimtypes.VirtualMachineConfigSpec{}
+		vmClass = &vmopv1.VirtualMachineClass{}
+		vmImage = &vmopv1.VirtualMachineImage{}
+	})
+
+	It("returns nothing when there are no requirements", func() {
+		Expect(virtualmachine.RequiredCapabilities(configSpec, vmClass, vmImage)).To(BeEmpty())
+	})
+
+	It("returns the capabilities required by the devices", func() {
+		configSpec.DeviceChange = []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device:    &vimtypes.VirtualTPM{},
+			},
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device:    &vimtypes.VirtualNVDIMM{},
+			},
+		}
+		Expect(virtualmachine.RequiredCapabilities(configSpec, vmClass, vmImage)).To(Equal([]virtualmachine.Capability{
+			virtualmachine.CapabilityPMem,
+			virtualmachine.CapabilityVTPM,
+		}))
+	})
+
+	It("ignores removed devices", func() {
+		configSpec.DeviceChange = []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationRemove,
+				Device:    &vimtypes.VirtualTPM{},
+			},
+		}
+		Expect(virtualmachine.RequiredCapabilities(configSpec, vmClass, vmImage)).To(BeEmpty())
+	})
+
+	It("returns the capabilities from the annotations", func() {
+		vmClass.ObjectMeta = metav1.ObjectMeta{
+			Annotations: map[string]string{
+				constants.RequiredCapabilitiesAnnotationKey: "vTPM, vsan",
+			},
+		}
+		vmImage.ObjectMeta = metav1.ObjectMeta{
+			Annotations: map[string]string{
+				constants.RequiredCapabilitiesAnnotationKey: "vsan,pmem",
+			},
+		}
+		Expect(virtualmachine.RequiredCapabilities(configSpec, vmClass, vmImage)).To(Equal([]virtualmachine.Capability{
+			virtualmachine.CapabilityPMem,
+			virtualmachine.CapabilityVSAN,
+			virtualmachine.CapabilityVTPM,
+		}))
+	})
+
+	It("ignores nil objects", func() {
+		Expect(virtualmachine.RequiredCapabilities(configSpec, nil)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("MissingCapabilities", func() {
+
+	required := []virtualmachine.Capability{
+		virtualmachine.CapabilityPMem,
+		virtualmachine.CapabilityVSAN,
+		virtualmachine.CapabilityVTPM,
+		"unknown",
+	}
+
+	It("returns the capabilities the cluster does not have", func() {
+		Expect(virtualmachine.MissingCapabilities(required, vcenter.Capabilities{
+			VSAN: true,
+		})).To(Equal([]virtualmachine.Capability{
+			virtualmachine.CapabilityPMem,
+			virtualmachine.CapabilityVTPM,
+			"unknown",
+		}))
+	})
+
+	It("returns only unknown capabilities when the cluster has all of them", func() {
+		Expect(virtualmachine.MissingCapabilities(required, vcenter.Capabilities{
+			VSAN: true,
+			PMem: true,
+			VTPM: true,
+		})).To(Equal([]virtualmachine.Capability{"unknown"}))
+	})
+})
@@ -28,13 +28,60 @@ const (
 	cvmiKind = "Cluster" + vmiKind
 )
 
+// CdromImage is the content library item of the image of a CD-ROM.
+type CdromImage struct {
+	// LibraryItemUUID is the UUID of the image's content library item.
+	LibraryItemUUID string
+
+	// ItemStatus is the status of the image's content library item.
+	ItemStatus imgregv1a1.ContentLibraryItemStatus
+
+	// Err is the error that occurred while getting the image's content
+	// library item. It is returned when the image's CD-ROM is reconciled.
+	Err error
+}
+
+// CdromImages maps the images of a VM's CD-ROMs to their content library
+// items.
+type CdromImages map[vmopv1.VirtualMachineImageRef]CdromImage
+
+// GetCdromImages returns the content library items of the images of the VM's
+// CD-ROMs so the CD-ROMs may be reconciled without getting the images.
+func GetCdromImages(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm *vmopv1.VirtualMachine) CdromImages {
+
+	images := make(CdromImages, len(vm.Spec.Cdrom))
+	for _, specCdrom := range vm.Spec.Cdrom {
+		imageRef := specCdrom.Image
+		if _, ok := images[imageRef]; ok {
+			continue
+		}
+
+		var img CdromImage
+		switch imageRef.Kind {
+		case vmiKind:
+			img.LibraryItemUUID, img.ItemStatus, img.Err = processImage(ctx, k8sClient, imageRef.Name, vm.Namespace)
+		case cvmiKind:
+			img.LibraryItemUUID, img.ItemStatus, img.Err = processImage(ctx, k8sClient, imageRef.Name, "")
+		default:
+			img.Err = fmt.Errorf("unsupported image kind: %q", imageRef.Kind)
+		}
+		images[imageRef] = img
+	}
+
+	return images
+}
+
 // UpdateCdromDeviceChanges reconciles the desired CD-ROM devices specified in
 // VM.Spec.Cdrom with the current CD-ROM devices in the VM. It returns a list of
-// device changes required to update the CD-ROM devices.
+// device changes required to update the CD-ROM devices. The images of the
+// CD-ROMs are returned by GetCdromImages.
 func UpdateCdromDeviceChanges(
 	vmCtx pkgctx.VirtualMachineContext,
 	restClient *rest.Client,
-	k8sClient ctrlclient.Client,
+	images CdromImages,
 	curDevices object.VirtualDeviceList) ([]vimtypes.BaseVirtualDeviceConfigSpec, error) {
 
 	var (
@@ -48,7 +95,7 @@ func UpdateCdromDeviceChanges(
 		imageRef := specCdrom.Image
 		// Sync the content library file if needed to connect the CD-ROM device.
 		syncFile := ptr.Deref(specCdrom.Connected)
-		bFileName, err := getBackingFileNameByImageRef(vmCtx, libManager, k8sClient, syncFile, imageRef)
+		bFileName, err := getBackingFileNameByImageRef(vmCtx, libManager, images, syncFile, imageRef)
 		if err != nil {
 			return nil, fmt.Errorf("error getting backing file name by image ref %s: %w", imageRef, err)
 		}
@@ -119,10 +166,11 @@ func UpdateCdromDeviceChanges(
 
 // UpdateConfigSpecCdromDeviceConnection updates the connection state of the
 // VM's existing CD-ROM devices to match what specifies in VM.Spec.Cdrom list.
+// The images of the CD-ROMs are returned by GetCdromImages.
 func UpdateConfigSpecCdromDeviceConnection(
 	vmCtx pkgctx.VirtualMachineContext,
 	restClient *rest.Client,
-	k8sClient ctrlclient.Client,
+	images CdromImages,
 	config *vimtypes.VirtualMachineConfigInfo,
 	configSpec *vimtypes.VirtualMachineConfigSpec) error {
 
@@ -138,7 +186,7 @@ func UpdateConfigSpecCdromDeviceConnection(
 		imageRef := specCdrom.Image
 		// Sync the content library file if needed to connect the CD-ROM device.
 		syncFile := ptr.Deref(specCdrom.Connected)
-		bFileName, err := getBackingFileNameByImageRef(vmCtx, libManager, k8sClient, syncFile, imageRef)
+		bFileName, err := getBackingFileNameByImageRef(vmCtx, libManager, images, syncFile, imageRef)
 		if err != nil {
 			return fmt.Errorf("error getting backing file name by image ref %s: %w", imageRef, err)
 		}
@@ -173,31 +221,20 @@ func UpdateConfigSpecCdromDeviceConnection(
 func getBackingFileNameByImageRef(
 	vmCtx pkgctx.VirtualMachineContext,
 	libManager *library.Manager,
-	client ctrlclient.Client,
+	images CdromImages,
 	syncFile bool,
 	imageRef vmopv1.VirtualMachineImageRef) (string, error) {
 
-	var (
-		libItemUUID string
-		itemStatus  imgregv1a1.ContentLibraryItemStatus
-		err         error
-	)
-
-	switch imageRef.Kind {
-	case vmiKind:
-		libItemUUID, itemStatus, err = processImage(vmCtx, client, imageRef.Name, vmCtx.VM.Namespace)
-		if err != nil {
-			return "", err
-		}
-	case cvmiKind:
-		libItemUUID, itemStatus, err = processImage(vmCtx, client, imageRef.Name, "")
-		if err != nil {
-			return "", err
-		}
-	default:
-		return "", fmt.Errorf("unsupported image kind: %q", imageRef.Kind)
+	img, ok := images[imageRef]
+	if !ok {
+		return "", fmt.Errorf("image %s is not found", imageRef.Name)
+	}
+	if img.Err != nil {
+		return "", img.Err
 	}
 
+	libItemUUID, itemStatus := img.LibraryItemUUID, img.ItemStatus
+
 	if len(itemStatus.FileInfo) == 0 || itemStatus.FileInfo[0].StorageURI == "" {
 		return "", fmt.Errorf("no storage URI found in the content library item status: %v", itemStatus)
 	}
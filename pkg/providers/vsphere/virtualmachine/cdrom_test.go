@@ -5,6 +5,7 @@
 package virtualmachine_test
 
 import (
+	"context"
 	"path"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -49,6 +50,31 @@ func cdromTests() {
 		pciControllerKey  = int32(100)
 	)
 
+	Context("GetCdromImages", func() {
+
+		It("should return the content library items of the CD-ROMs' images", func() {
+			k8sClient := builder.NewFakeClient(builder.DummyImageAndItemObjectsForCdromBacking(
+				vmiName, ns, vmiKind, vmiFileName, "item-uuid", true, true, true,
+				imgregv1a1.ContentLibraryItemTypeIso)...)
+
+			vm := builder.DummyBasicVirtualMachine(vmName, ns)
+			vmiRef := vmopv1.VirtualMachineImageRef{Name: vmiName, Kind: vmiKind}
+			invalidRef := vmopv1.VirtualMachineImageRef{Name: vmiName, Kind: "invalid-kind"}
+			vm.Spec.Cdrom = []vmopv1.VirtualMachineCdromSpec{
+				{Name: cdromName1, Image: vmiRef},
+				{Name: cdromName2, Image: invalidRef},
+			}
+
+			images := virtualmachine.GetCdromImages(context.Background(), k8sClient, vm)
+			Expect(images).To(HaveLen(2))
+			Expect(images[vmiRef].Err).ToNot(HaveOccurred())
+			Expect(images[vmiRef].LibraryItemUUID).To(Equal("item-uuid"))
+			Expect(images[vmiRef].ItemStatus.FileInfo).To(HaveLen(1))
+			Expect(images[vmiRef].ItemStatus.FileInfo[0].StorageURI).To(Equal(vmiFileName))
+			Expect(images[invalidRef].Err).To(MatchError("unsupported image kind: \"invalid-kind\""))
+		})
+	})
+
 	Context("UpdateCdromDeviceChanges", func() {
 
 		var (
@@ -89,7 +115,7 @@ func cdromTests() {
 			})
 
 			JustBeforeEach(func() {
-				result, resultErr = virtualmachine.UpdateCdromDeviceChanges(vmCtx, restClient, k8sClient, curDevices)
+				result, resultErr = virtualmachine.UpdateCdromDeviceChanges(vmCtx, restClient, virtualmachine.GetCdromImages(vmCtx, k8sClient, vmCtx.VM), curDevices)
 				Expect(resultErr).ToNot(HaveOccurred())
 			})
 
@@ -540,7 +566,7 @@ func cdromTests() {
 			JustBeforeEach(func() {
 				k8sClient = builder.NewFakeClient(k8sInitObjs...)
 
-				result, resultErr = virtualmachine.UpdateCdromDeviceChanges(vmCtx, restClient, k8sClient, curDevices)
+				result, resultErr = virtualmachine.UpdateCdromDeviceChanges(vmCtx, restClient, virtualmachine.GetCdromImages(vmCtx, k8sClient, vmCtx.VM), curDevices)
 				Expect(resultErr).To(HaveOccurred())
 			})
 
@@ -1068,7 +1094,7 @@ func cdromTests() {
 		})
 
 		JustBeforeEach(func() {
-			updateErr = virtualmachine.UpdateConfigSpecCdromDeviceConnection(vmCtx, restClient, k8sClient, configInfo, configSpec)
+			updateErr = virtualmachine.UpdateConfigSpecCdromDeviceConnection(vmCtx, restClient, virtualmachine.GetCdromImages(vmCtx, k8sClient, vmCtx.VM), configInfo, configSpec)
 		})
 
 		Context("Happy Path (no error occurs)", func() {
@@ -1183,7 +1209,7 @@ func cdromTests() {
 			JustBeforeEach(func() {
 				k8sClient = builder.NewFakeClient(k8sInitObjs...)
 
-				updateErr = virtualmachine.UpdateConfigSpecCdromDeviceConnection(vmCtx, restClient, k8sClient, configInfo, configSpec)
+				updateErr = virtualmachine.UpdateConfigSpecCdromDeviceConnection(vmCtx, restClient, virtualmachine.GetCdromImages(vmCtx, k8sClient, vmCtx.VM), configInfo, configSpec)
 			})
 
 			// These test cases are similar to those in UpdateCdromDeviceChanges.
@@ -0,0 +1,166 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vapi/tags"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+)
+
+const (
+	// ChargebackTagCategoryName is the name of the vSphere tag category that
+	// contains the chargeback tags.
+	ChargebackTagCategoryName = "vmservice-chargeback"
+
+	chargebackTagSeparator = "="
+)
+
+// ChargebackTagNames returns the sorted names of the chargeback tags for the
+// provided labels. Each tag is named key=value for a label whose key is one of
+// the chargeback keys.
+func ChargebackTagNames(labels map[string]string, keys []string) []string {
+	var names []string
+	for _, k := range keys {
+		if v, ok := labels[k]; ok {
+			names = append(names, k+chargebackTagSeparator+v)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// SyncChargebackTags attaches the chargeback tags for the provided labels to
+// the VM, and detaches the chargeback tags that no longer match the labels.
+// The tag category and tags are created as needed.
+func SyncChargebackTags(
+	ctx context.Context,
+	restClient *rest.Client,
+	vmRef vimtypes.ManagedObjectReference,
+	labels map[string]string,
+	keys []string) error {
+
+	m := tags.NewManager(restClient)
+
+	attachedTags, err := m.GetAttachedTags(ctx, vmRef)
+	if err != nil {
+		return fmt.Errorf("failed to get attached tags: %w", err)
+	}
+
+	isChargebackTag := func(name string) bool {
+		for _, k := range keys {
+			if strings.HasPrefix(name, k+chargebackTagSeparator) {
+				return true
+			}
+		}
+		return false
+	}
+
+	desired := ChargebackTagNames(labels, keys)
+
+	var (
+		attached []string
+		detach   []string
+	)
+	for _, t := range attachedTags {
+		if !isChargebackTag(t.Name) {
+			continue
+		}
+		if slices.Contains(desired, t.Name) {
+			attached = append(attached, t.Name)
+		} else {
+			detach = append(detach, t.ID)
+		}
+	}
+
+	if len(detach) > 0 {
+		if err := m.DetachMultipleTagsFromObject(ctx, detach, vmRef); err != nil {
+			return fmt.Errorf("failed to detach chargeback tags: %w", err)
+		}
+	}
+
+	if len(attached) == len(desired) {
+		return nil
+	}
+
+	categoryID, err := getOrCreateChargebackCategory(ctx, m)
+	if err != nil {
+		return err
+	}
+
+	var attach []string
+	for _, name := range desired {
+		if slices.Contains(attached, name) {
+			continue
+		}
+		tagID, err := getOrCreateChargebackTag(ctx, m, categoryID, name)
+		if err != nil {
+			return err
+		}
+		attach = append(attach, tagID)
+	}
+
+	if err := m.AttachMultipleTagsToObject(ctx, attach, vmRef); err != nil {
+		return fmt.Errorf("failed to attach chargeback tags: %w", err)
+	}
+
+	return nil
+}
+
+func getOrCreateChargebackCategory(
+	ctx context.Context,
+	m *tags.Manager) (string, error) {
+
+	categories, err := m.GetCategories(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tag categories: %w", err)
+	}
+	for _, c := range categories {
+		if c.Name == ChargebackTagCategoryName {
+			return c.ID, nil
+		}
+	}
+
+	id, err := m.CreateCategory(ctx, &tags.Category{
+		Name:            ChargebackTagCategoryName,
+		Description:     "VM Service chargeback dimensions",
+		Cardinality:     "MULTIPLE",
+		AssociableTypes: []string{"VirtualMachine"},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create tag category %s: %w", ChargebackTagCategoryName, err)
+	}
+	return id, nil
+}
+
+func getOrCreateChargebackTag(
+	ctx context.Context,
+	m *tags.Manager,
+	categoryID, name string) (string, error) {
+
+	categoryTags, err := m.GetTagsForCategory(ctx, categoryID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tags for category %s: %w", categoryID, err)
+	}
+	for _, t := range categoryTags {
+		if t.Name == name {
+			return t.ID, nil
+		}
+	}
+
+	id, err := m.CreateTag(ctx, &tags.Tag{
+		Name:       name,
+		CategoryID: categoryID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create tag %s: %w", name, err)
+	}
+	return id, nil
+}
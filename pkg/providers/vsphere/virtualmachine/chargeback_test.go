@@ -0,0 +1,92 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vapi/tags"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("ChargebackTagNames", func() {
+	It("returns the sorted tag names for the chargeback labels", func() {
+		labels := map[string]string{
+			"team":        "vmop",
+			"cost-center": "1234",
+			"ignored":     "value",
+		}
+		Expect(virtualmachine.ChargebackTagNames(labels, []string{"team", "cost-center", "missing"})).
+			To(Equal([]string{"cost-center=1234", "team=vmop"}))
+	})
+
+	It("returns nil when there are no chargeback labels", func() {
+		Expect(virtualmachine.ChargebackTagNames(map[string]string{"foo": "bar"}, []string{"team"})).
+			To(BeNil())
+	})
+})
+
+func chargebackTests() {
+
+	var (
+		ctx  *builder.TestContextForVCSim
+		vcVM *object.VirtualMachine
+		keys []string
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewTestContextForVCSim(builder.VCSimTestConfig{})
+		keys = []string{"team", "cost-center"}
+
+		var err error
+		vcVM, err = ctx.Finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	attachedTagNames := func() []string {
+		attached, err := tags.NewManager(ctx.RestClient).GetAttachedTags(ctx, vcVM.Reference())
+		Expect(err).ToNot(HaveOccurred())
+		var names []string
+		for _, t := range attached {
+			names = append(names, t.Name)
+		}
+		return names
+	}
+
+	It("attaches and detaches the chargeback tags", func() {
+		labels := map[string]string{
+			"team":        "vmop",
+			"cost-center": "1234",
+		}
+		Expect(virtualmachine.SyncChargebackTags(ctx, ctx.RestClient, vcVM.Reference(), labels, keys)).To(Succeed())
+		Expect(attachedTagNames()).To(ConsistOf("team=vmop", "cost-center=1234"))
+
+		By("syncing again without changes", func() {
+			Expect(virtualmachine.SyncChargebackTags(ctx, ctx.RestClient, vcVM.Reference(), labels, keys)).To(Succeed())
+			Expect(attachedTagNames()).To(ConsistOf("team=vmop", "cost-center=1234"))
+		})
+
+		By("changing and removing labels", func() {
+			labels = map[string]string{
+				"team": "other",
+			}
+			Expect(virtualmachine.SyncChargebackTags(ctx, ctx.RestClient, vcVM.Reference(), labels, keys)).To(Succeed())
+			Expect(attachedTagNames()).To(ConsistOf("team=other"))
+		})
+
+		category, err := tags.NewManager(ctx.RestClient).GetCategory(ctx, virtualmachine.ChargebackTagCategoryName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(category.AssociableTypes).To(ConsistOf("VirtualMachine"))
+	})
+}
@@ -16,6 +16,8 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/paused"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+	pkgtask "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
 	vmutil "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/vm"
 )
 
@@ -34,9 +36,9 @@ func DeleteVirtualMachine(
 	if err := vcVM.Properties(
 		vmCtx,
 		vcVM.Reference(),
-		[]string{"config.extraConfig"}, &vmCtx.MoVM); err != nil {
+		[]string{"config.extraConfig", "config.hardware.device"}, &vmCtx.MoVM); err != nil {
 
-		vmCtx.Logger.Error(err, "failed to fetch config.extraConfig properties of VM for DeleteVirtualMachine")
+		vmCtx.Logger.Error(err, "failed to fetch config properties of VM for DeleteVirtualMachine")
 		return err
 	}
 	// Throw an error to distinguish from successful deletion.
@@ -47,6 +49,18 @@ func DeleteVirtualMachine(
 		vmCtx.VM.Labels[vmopv1.PausedVMLabelKey] = "admin"
 		return ErrorVMPausedByAdmin()
 	}
+	// Destroying the VM deletes all of its attached disks, so the VM is not
+	// destroyed while the disk of a volume with the PreventDelete latch set is
+	// still attached to it.
+	if vmCtx.MoVM.Config != nil {
+		if err := vmopv1util.CheckPreventDeleteDevices(
+			vmCtx.VM,
+			vmCtx.MoVM.Config.Hardware.Device); err != nil {
+
+			return err
+		}
+	}
+
 	if _, err := vmutil.SetAndWaitOnPowerState(
 		logr.NewContext(vmCtx, vmCtx.Logger),
 		vcVM.Client(),
@@ -63,6 +77,8 @@ func DeleteVirtualMachine(
 		return err
 	}
 
+	pkgtask.Tag(vmCtx, t)
+
 	if taskInfo, err := t.WaitForResult(vmCtx); err != nil {
 		if taskInfo != nil {
 			vmCtx.Logger.V(5).Error(err, "destroy VM task failed", "taskInfo", taskInfo)
@@ -10,8 +10,10 @@ import (
 	"github.com/vmware/govmomi/object"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -69,4 +71,39 @@ func deleteTests() {
 
 		Expect(ctx.GetVMFromMoID(moID)).To(BeNil())
 	})
+
+	It("Does not delete VM with an attached disk whose volume has preventDelete set", func() {
+		moID := vcVM.Reference().Value
+
+		devices, err := vcVM.Device(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		disks := devices.SelectByType((*vimtypes.VirtualDisk)(nil))
+		Expect(disks).ToNot(BeEmpty())
+		diskUUID := vmopv1util.VirtualDiskUUID(disks[0].(*vimtypes.VirtualDisk))
+		Expect(diskUUID).ToNot(BeEmpty())
+
+		vmCtx.VM.Spec.Volumes = []vmopv1.VirtualMachineVolume{
+			{
+				Name:          "my-disk",
+				PreventDelete: true,
+			},
+		}
+		vmCtx.VM.Status.Volumes = []vmopv1.VirtualMachineVolumeStatus{
+			{
+				Name:     "my-disk",
+				DiskUUID: diskUUID,
+			},
+		}
+
+		err = virtualmachine.DeleteVirtualMachine(vmCtx, vcVM)
+		Expect(err).To(MatchError(vmopv1util.PreventDeleteError{
+			Volume:   "my-disk",
+			DiskUUID: diskUUID,
+		}))
+		Expect(ctx.GetVMFromMoID(moID)).ToNot(BeNil())
+
+		vmCtx.VM.Spec.Volumes[0].PreventDelete = false
+		Expect(virtualmachine.DeleteVirtualMachine(vmCtx, vcVM)).To(Succeed())
+		Expect(ctx.GetVMFromMoID(moID)).To(BeNil())
+	})
 }
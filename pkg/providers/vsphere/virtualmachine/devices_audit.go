@@ -0,0 +1,141 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine
+
+import (
+	"reflect"
+
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+)
+
+// UnmanagedDevices returns the devices on a VM that are not represented in
+// its spec, which are usually added out-of-band: USB controllers and devices,
+// serial and parallel ports, and raw disks. A device is not returned if it
+// matches a device added by the ConfigSpec of the VM's class, i.e. the class
+// device has the same type and either the same key or a matching backing. Each class device
+// matches at most one of the VM's devices.
+func UnmanagedDevices(
+	devices object.VirtualDeviceList,
+	classConfigSpec vimtypes.VirtualMachineConfigSpec) object.VirtualDeviceList {
+
+	var classDevices []vimtypes.BaseVirtualDevice
+	for _, dc := range classConfigSpec.DeviceChange {
+		spec := dc.GetVirtualDeviceConfigSpec()
+		if spec.Operation != vimtypes.VirtualDeviceConfigSpecOperationAdd || spec.Device == nil {
+			continue
+		}
+		classDevices = append(classDevices, spec.Device)
+	}
+
+	var unmanaged object.VirtualDeviceList
+	for _, d := range devices {
+		if !isUnmanagedDeviceType(d) {
+			continue
+		}
+
+		// Prefer a class device with the same key.
+		idx := -1
+		for i := range classDevices {
+			if reflect.TypeOf(classDevices[i]) == reflect.TypeOf(d) &&
+				classDevices[i].GetVirtualDevice().Key == d.GetVirtualDevice().Key {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			for i := range classDevices {
+				if isMatchingClassDevice(classDevices[i], d) {
+					idx = i
+					break
+				}
+			}
+		}
+
+		if idx < 0 {
+			unmanaged = append(unmanaged, d)
+			continue
+		}
+		classDevices = append(classDevices[:idx], classDevices[idx+1:]...)
+	}
+
+	return unmanaged
+}
+
+// isMatchingClassDevice returns true if the device has the same type as the
+// class device, and the non-empty fields of the class device's backing have
+// the same values in the device's backing.
+func isMatchingClassDevice(classDevice, d vimtypes.BaseVirtualDevice) bool {
+	if reflect.TypeOf(classDevice) != reflect.TypeOf(d) {
+		return false
+	}
+
+	want := classDevice.GetVirtualDevice().Backing
+	got := d.GetVirtualDevice().Backing
+	if want == nil || got == nil {
+		return want == nil && got == nil
+	}
+	if reflect.TypeOf(want) != reflect.TypeOf(got) {
+		return false
+	}
+
+	return isSubset(reflect.ValueOf(want).Elem(), reflect.ValueOf(got).Elem())
+}
+
+// isSubset returns true if the non-zero fields of want are equal to the
+// fields of got.
+func isSubset(want, got reflect.Value) bool {
+	for i := 0; i < want.NumField(); i++ {
+		w, g := want.Field(i), got.Field(i)
+		switch {
+		case !want.Type().Field(i).IsExported(), w.IsZero():
+		case w.Kind() == reflect.Struct:
+			if !isSubset(w, g) {
+				return false
+			}
+		case !reflect.DeepEqual(w.Interface(), g.Interface()):
+			return false
+		}
+	}
+	return true
+}
+
+func isUnmanagedDeviceType(d vimtypes.BaseVirtualDevice) bool {
+	switch d := d.(type) {
+	case *vimtypes.VirtualUSBController,
+		*vimtypes.VirtualUSBXHCIController,
+		*vimtypes.VirtualUSB,
+		*vimtypes.VirtualSerialPort,
+		*vimtypes.VirtualParallelPort:
+		return true
+	case *vimtypes.VirtualDisk:
+		switch d.Backing.(type) {
+		case *vimtypes.VirtualDiskRawDiskMappingVer1BackingInfo,
+			*vimtypes.VirtualDiskRawDiskVer2BackingInfo,
+			*vimtypes.VirtualDiskPartitionedRawDiskVer2BackingInfo:
+			return true
+		}
+	}
+	return false
+}
+
+// UnmanagedDevicesRemoveSpec returns the device changes that remove the
+// devices from a VM. Disks, including raw disks, are never removed since the
+// data on them would no longer be accessible to the guest.
+func UnmanagedDevicesRemoveSpec(
+	devices object.VirtualDeviceList) []vimtypes.BaseVirtualDeviceConfigSpec {
+
+	deviceChanges := make([]vimtypes.BaseVirtualDeviceConfigSpec, 0, len(devices))
+	for _, d := range devices {
+		if _, ok := d.(*vimtypes.VirtualDisk); ok {
+			continue
+		}
+		deviceChanges = append(deviceChanges, &vimtypes.VirtualDeviceConfigSpec{
+			Operation: vimtypes.VirtualDeviceConfigSpecOperationRemove,
+			Device:    d,
+		})
+	}
+	return deviceChanges
+}
@@ -0,0 +1,152 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
+)
+
+var _ = Describe("UnmanagedDevices", func() {
+
+	var (
+		serialPort *vimtypes.VirtualSerialPort
+		usbCtrl    *vimtypes.VirtualUSBXHCIController
+		flatDisk   *vimtypes.VirtualDisk
+		rawDisk    *vimtypes.VirtualDisk
+		devices    object.VirtualDeviceList
+		configSpec vimtypes.VirtualMachineConfigSpec
+	)
+
+	BeforeEach(func() {
+		serialPort = &vimtypes.VirtualSerialPort{
+			VirtualDevice: vimtypes.VirtualDevice{Key: 9000},
+		}
+		usbCtrl = &vimtypes.VirtualUSBXHCIController{
+			VirtualController: vimtypes.VirtualController{
+				VirtualDevice: vimtypes.VirtualDevice{Key: 15000},
+			},
+		}
+		flatDisk = &vimtypes.VirtualDisk{
+			VirtualDevice: vimtypes.VirtualDevice{
+				Key:     2000,
+				Backing: &vimtypes.VirtualDiskFlatVer2BackingInfo{},
+			},
+		}
+		rawDisk = &vimtypes.VirtualDisk{
+			VirtualDevice: vimtypes.VirtualDevice{
+				Key:     2001,
+				Backing: &vimtypes.VirtualDiskRawDiskMappingVer1BackingInfo{},
+			},
+		}
+
+		devices = object.VirtualDeviceList{
+			serialPort,
+			usbCtrl,
+			flatDisk,
+			rawDisk,
+			&vimtypes.VirtualVmxnet3{},
+		}
+		configSpec = vimtypes.VirtualMachineConfigSpec{}
+	})
+
+	It("returns the devices that were added out-of-band", func() {
+		Expect(virtualmachine.UnmanagedDevices(devices, configSpec)).To(Equal(
+			object.VirtualDeviceList{serialPort, usbCtrl, rawDisk}))
+	})
+
+	It("does not return devices of the same type as the class devices", func() {
+		configSpec.DeviceChange = []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device:    &vimtypes.VirtualUSBXHCIController{},
+			},
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device: &vimtypes.VirtualDisk{
+					VirtualDevice: vimtypes.VirtualDevice{
+						Backing: &vimtypes.VirtualDiskRawDiskMappingVer1BackingInfo{},
+					},
+				},
+			},
+		}
+		Expect(virtualmachine.UnmanagedDevices(devices, configSpec)).To(Equal(
+			object.VirtualDeviceList{serialPort}))
+	})
+
+	It("does not return a device with the same key as a class device", func() {
+		configSpec.DeviceChange = []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device: &vimtypes.VirtualSerialPort{
+					VirtualDevice: vimtypes.VirtualDevice{Key: 9000},
+				},
+			},
+		}
+		Expect(virtualmachine.UnmanagedDevices(devices, configSpec)).To(Equal(
+			object.VirtualDeviceList{usbCtrl, rawDisk}))
+	})
+
+	It("returns a device whose backing does not match the class device", func() {
+		rawDisk.Backing = &vimtypes.VirtualDiskRawDiskMappingVer1BackingInfo{
+			LunUuid: "lun-1",
+		}
+		configSpec.DeviceChange = []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device: &vimtypes.VirtualDisk{
+					VirtualDevice: vimtypes.VirtualDevice{
+						Backing: &vimtypes.VirtualDiskRawDiskMappingVer1BackingInfo{
+							LunUuid: "lun-2",
+						},
+					},
+				},
+			},
+		}
+		Expect(virtualmachine.UnmanagedDevices(devices, configSpec)).To(Equal(
+			object.VirtualDeviceList{serialPort, usbCtrl, rawDisk}))
+
+		By("matching when the backing fields of the class device are the same", func() {
+			configSpec.DeviceChange[0].GetVirtualDeviceConfigSpec().Device.GetVirtualDevice().Backing =
+				&vimtypes.VirtualDiskRawDiskMappingVer1BackingInfo{
+					LunUuid: "lun-1",
+				}
+			Expect(virtualmachine.UnmanagedDevices(devices, configSpec)).To(Equal(
+				object.VirtualDeviceList{serialPort, usbCtrl}))
+		})
+	})
+
+	It("matches each class device with only one device", func() {
+		serialPort2 := &vimtypes.VirtualSerialPort{
+			VirtualDevice: vimtypes.VirtualDevice{Key: 9001},
+		}
+		devices = append(devices, serialPort2)
+		configSpec.DeviceChange = []vimtypes.BaseVirtualDeviceConfigSpec{
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device:    &vimtypes.VirtualSerialPort{},
+			},
+		}
+		Expect(virtualmachine.UnmanagedDevices(devices, configSpec)).To(Equal(
+			object.VirtualDeviceList{usbCtrl, rawDisk, serialPort2}))
+	})
+
+	It("returns the device changes that remove the devices other than disks", func() {
+		unmanaged := virtualmachine.UnmanagedDevices(devices, configSpec)
+		deviceChanges := virtualmachine.UnmanagedDevicesRemoveSpec(unmanaged)
+		Expect(deviceChanges).To(HaveLen(2))
+		for i, dc := range deviceChanges {
+			spec := dc.GetVirtualDeviceConfigSpec()
+			Expect(spec.Operation).To(Equal(vimtypes.VirtualDeviceConfigSpecOperationRemove))
+			Expect(spec.FileOperation).To(BeEmpty())
+			Expect(spec.Device).To(Equal(unmanaged[i]))
+		}
+	})
+})
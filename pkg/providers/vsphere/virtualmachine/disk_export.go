@@ -0,0 +1,79 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/govmomi/nfc"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+)
+
+// StartDiskExport acquires an export lease for the VM and returns the lease's
+// ID and the files that may be downloaded while the lease is held. The URLs
+// of the files embed the lease's ticket.
+func StartDiskExport(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcVM *object.VirtualMachine) (string, []vmopv1.VirtualMachineExportRequestFile, error) {
+
+	lease, err := vcVM.Export(vmCtx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to export VM: %w", err)
+	}
+
+	info, err := lease.Wait(vmCtx, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to wait for export lease: %w", err)
+	}
+
+	files := make([]vmopv1.VirtualMachineExportRequestFile, len(info.Items))
+	for i, item := range info.Items {
+		files[i] = vmopv1.VirtualMachineExportRequestFile{
+			Name: item.Path,
+			URL:  item.URL.String(),
+			Size: item.Size,
+		}
+		if i < len(info.DeviceUrl) {
+			files[i].SSLThumbprint = info.DeviceUrl[i].SslThumbprint
+		}
+	}
+
+	leaseID := lease.Reference().Value
+	vmCtx.Logger.Info("Acquired export lease", "leaseID", leaseID, "files", len(files))
+
+	return leaseID, files, nil
+}
+
+// RenewDiskExport reports progress on the export lease so the lease does not
+// time out.
+func RenewDiskExport(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	leaseID string) error {
+
+	return newExportLease(vimClient, leaseID).Progress(ctx, 0)
+}
+
+// CompleteDiskExport releases the export lease.
+func CompleteDiskExport(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	leaseID string) error {
+
+	return newExportLease(vimClient, leaseID).Complete(ctx)
+}
+
+func newExportLease(vimClient *vim25.Client, leaseID string) *nfc.Lease {
+	return nfc.NewLease(vimClient, vimtypes.ManagedObjectReference{
+		Type:  "HttpNfcLease",
+		Value: leaseID,
+	})
+}
@@ -0,0 +1,270 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+)
+
+// ExportPropertiesSelector is the set of VM properties required by
+// ExportVirtualMachine.
+var ExportPropertiesSelector = []string{
+	"config",
+	"network",
+	"runtime.powerState",
+	"summary.config.name",
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// exportDeviceExtraConfigKey matches the extraConfig keys vSphere sets for a
+// VM's virtual devices, ex. "scsi0:0.redo" or "ethernet0.pciSlotNumber".
+var exportDeviceExtraConfigKey = regexp.MustCompile(
+	`^(ethernet|floppy|hpet|ide|nvme|parallel|pciBridge|pciPassthru|sata|scsi|serial|sound|usb|vmci)\d`)
+
+// exportPCIPassthruCfgExtraConfigKey matches the extraConfig keys that
+// configure a PCI passthrough device, ex. "pciPassthru0.cfg.enable_uvm". These
+// are copied to the class along with the device.
+var exportPCIPassthruCfgExtraConfigKey = regexp.MustCompile(`^pciPassthru\d+\.cfg\.`)
+
+// exportSkippedExtraConfigKeyPrefixes are the prefixes of the extraConfig keys
+// that are set by vSphere, VMware Tools, or VM Operator, and must not be
+// copied to the class.
+var exportSkippedExtraConfigKeyPrefixes = []string{
+	"cpuid.",
+	"guestinfo.",
+	"guestOS.",
+	"migrate.",
+	"monitor.",
+	"numa.",
+	"nvram",
+	"sched.",
+	"svga.",
+	"tools.",
+	"toolsInstallManager.",
+	"uuid.",
+	"vc.uuid",
+	"virtualHW.",
+	"vmotion.",
+	"vmservice.",
+	"vmware.tools.",
+}
+
+// exportSkippedExtraConfigKeys are the extraConfig keys that are set by VM
+// Operator, and must not be copied to the class.
+var exportSkippedExtraConfigKeys = map[string]struct{}{
+	constants.EnableDiskUUIDExtraConfigKey:      {},
+	constants.MMPowerOffVMExtraConfigKey:        {},
+	constants.PCIPassthruMMIOExtraConfigKey:     {},
+	constants.PCIPassthruMMIOSizeExtraConfigKey: {},
+}
+
+// ExportArgs are the arguments used to export a vSphere VM.
+type ExportArgs struct {
+	// Namespace is the namespace of the exported resources.
+	Namespace string
+
+	// PortgroupNames maps the key of a distributed port group to its name, so
+	// the network interfaces backed by the port group are exported with the
+	// port group's name.
+	PortgroupNames map[string]string
+
+	// GlobalExtraConfig is the extraConfig that VM Operator sets on all VMs.
+	// These keys are not copied to the class.
+	GlobalExtraConfig map[string]string
+}
+
+// ExportVirtualMachine returns the VirtualMachine and VirtualMachineClass
+// resources that most closely match the provided vSphere VM, so an existing VM
+// may be brought under the management of VM Operator.
+//
+// The class has the VM's CPU, memory, extraConfig, and PCI passthrough
+// devices. The VM has the VM's hardware version, guest ID, UUIDs, power state,
+// network interfaces, and the capacity of its first disk. The VM's other
+// disks are not exported since they must be persistent volumes.
+func ExportVirtualMachine(
+	moVM mo.VirtualMachine,
+	args ExportArgs) (*vmopv1.VirtualMachine, *vmopv1.VirtualMachineClass, error) {
+
+	if moVM.Config == nil {
+		return nil, nil, fmt.Errorf("vm %s does not have a config", moVM.Self.Value)
+	}
+	config := moVM.Config
+
+	name := ExportName(moVM.Summary.Config.Name)
+	if name == "" {
+		name = ExportName(config.Name)
+	}
+	if name == "" {
+		name = ExportName(moVM.Self.Value)
+	}
+
+	devices := object.VirtualDeviceList(config.Hardware.Device)
+
+	classConfigSpec := vimtypes.VirtualMachineConfigSpec{
+		ExtraConfig: exportExtraConfig(config.ExtraConfig, args.GlobalExtraConfig),
+	}
+	for _, d := range devices.SelectByType((*vimtypes.VirtualPCIPassthrough)(nil)) {
+		classConfigSpec.DeviceChange = append(classConfigSpec.DeviceChange,
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device:    d,
+			})
+	}
+
+	rawConfigSpec, err := pkgutil.MarshalConfigSpecToJSON(classConfigSpec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal class configSpec: %w", err)
+	}
+
+	vmClass := &vmopv1.VirtualMachineClass{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: vmopv1.GroupVersion.String(),
+			Kind:       "VirtualMachineClass",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: args.Namespace,
+		},
+		Spec: vmopv1.VirtualMachineClassSpec{
+			Hardware: vmopv1.VirtualMachineClassHardware{
+				Cpus:   int64(config.Hardware.NumCPU),
+				Memory: *resource.NewQuantity(int64(config.Hardware.MemoryMB)*1024*1024, resource.BinarySI),
+			},
+			ConfigSpec: rawConfigSpec,
+		},
+	}
+
+	vm := &vmopv1.VirtualMachine{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: vmopv1.GroupVersion.String(),
+			Kind:       "VirtualMachine",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: args.Namespace,
+		},
+		Spec: vmopv1.VirtualMachineSpec{
+			ClassName:    vmClass.Name,
+			GuestID:      config.GuestId,
+			InstanceUUID: config.InstanceUuid,
+			BiosUUID:     config.Uuid,
+			PowerState:   exportPowerState(moVM.Runtime.PowerState),
+		},
+	}
+
+	if hwVersion, err := vimtypes.ParseHardwareVersion(config.Version); err == nil {
+		vm.Spec.MinHardwareVersion = int32(hwVersion)
+	}
+
+	if disks := devices.SelectByType((*vimtypes.VirtualDisk)(nil)); len(disks) > 0 {
+		disk := disks[0].(*vimtypes.VirtualDisk)
+		vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+			BootDiskCapacity: resource.NewQuantity(disk.CapacityInBytes, resource.BinarySI),
+		}
+	}
+
+	if nics := devices.SelectByType((*vimtypes.VirtualEthernetCard)(nil)); len(nics) > 0 {
+		vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+		for i, nic := range nics {
+			iface := vmopv1.VirtualMachineNetworkInterfaceSpec{
+				Name: fmt.Sprintf("eth%d", i),
+			}
+			if networkName := exportNetworkName(nic, args.PortgroupNames); networkName != "" {
+				iface.Network = &common.PartialObjectRef{
+					Name: networkName,
+				}
+			}
+			vm.Spec.Network.Interfaces = append(vm.Spec.Network.Interfaces, iface)
+		}
+	}
+
+	return vm, vmClass, nil
+}
+
+// ExportName returns the provided vSphere name as a valid Kubernetes resource
+// name.
+func ExportName(name string) string {
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	return strings.Trim(name, "-")
+}
+
+// exportNetworkName returns the name of the network that backs the NIC, or an
+// empty string if the network is not known.
+func exportNetworkName(
+	nic vimtypes.BaseVirtualDevice,
+	portgroupNames map[string]string) string {
+
+	switch backing := nic.GetVirtualDevice().Backing.(type) {
+	case *vimtypes.VirtualEthernetCardNetworkBackingInfo:
+		return ExportName(backing.DeviceName)
+	case *vimtypes.VirtualEthernetCardDistributedVirtualPortBackingInfo:
+		return ExportName(portgroupNames[backing.Port.PortgroupKey])
+	}
+	return ""
+}
+
+// exportExtraConfig returns the extraConfig that is not set by vSphere, VMware
+// Tools, or VM Operator.
+func exportExtraConfig(
+	extraConfig []vimtypes.BaseOptionValue,
+	globalExtraConfig map[string]string) []vimtypes.BaseOptionValue {
+
+	var result []vimtypes.BaseOptionValue
+	for _, bov := range extraConfig {
+		if !exportExtraConfigKey(bov.GetOptionValue().Key, globalExtraConfig) {
+			continue
+		}
+		result = append(result, bov)
+	}
+	return result
+}
+
+func exportExtraConfigKey(key string, globalExtraConfig map[string]string) bool {
+	if _, ok := globalExtraConfig[key]; ok {
+		return false
+	}
+	if _, ok := exportSkippedExtraConfigKeys[key]; ok {
+		return false
+	}
+	if exportPCIPassthruCfgExtraConfigKey.MatchString(key) {
+		return true
+	}
+	if exportDeviceExtraConfigKey.MatchString(key) {
+		return false
+	}
+	for _, prefix := range exportSkippedExtraConfigKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+func exportPowerState(
+	powerState vimtypes.VirtualMachinePowerState) vmopv1.VirtualMachinePowerState {
+
+	switch powerState {
+	case vimtypes.VirtualMachinePowerStatePoweredOn:
+		return vmopv1.VirtualMachinePowerStateOn
+	case vimtypes.VirtualMachinePowerStateSuspended:
+		return vmopv1.VirtualMachinePowerStateSuspended
+	default:
+		return vmopv1.VirtualMachinePowerStateOff
+	}
+}
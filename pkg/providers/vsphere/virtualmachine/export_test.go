@@ -0,0 +1,193 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+)
+
+var _ = Describe("ExportVirtualMachine", func() {
+
+	var (
+		moVM mo.VirtualMachine
+		args virtualmachine.ExportArgs
+	)
+
+	BeforeEach(func() {
+		moVM = mo.VirtualMachine{
+			Config: &vimtypes.VirtualMachineConfigInfo{
+				Name:         "My_VM",
+				Version:      "vmx-19",
+				GuestId:      "vmwarePhoton64Guest",
+				Uuid:         "bios-uuid",
+				InstanceUuid: "instance-uuid",
+				Hardware: vimtypes.VirtualHardware{
+					NumCPU:   2,
+					MemoryMB: 4096,
+					Device: []vimtypes.BaseVirtualDevice{
+						&vimtypes.VirtualDisk{
+							VirtualDevice: vimtypes.VirtualDevice{
+								Key:     2000,
+								Backing: &vimtypes.VirtualDiskFlatVer2BackingInfo{},
+							},
+							CapacityInBytes: 10 * 1024 * 1024 * 1024,
+						},
+						&vimtypes.VirtualVmxnet3{
+							VirtualVmxnet: vimtypes.VirtualVmxnet{
+								VirtualEthernetCard: vimtypes.VirtualEthernetCard{
+									VirtualDevice: vimtypes.VirtualDevice{
+										Key: 4000,
+										Backing: &vimtypes.VirtualEthernetCardNetworkBackingInfo{
+											VirtualDeviceDeviceBackingInfo: vimtypes.VirtualDeviceDeviceBackingInfo{
+												DeviceName: "VM Network",
+											},
+										},
+									},
+								},
+							},
+						},
+						&vimtypes.VirtualVmxnet3{
+							VirtualVmxnet: vimtypes.VirtualVmxnet{
+								VirtualEthernetCard: vimtypes.VirtualEthernetCard{
+									VirtualDevice: vimtypes.VirtualDevice{
+										Key: 4001,
+										Backing: &vimtypes.VirtualEthernetCardDistributedVirtualPortBackingInfo{
+											Port: vimtypes.DistributedVirtualSwitchPortConnection{
+												PortgroupKey: "dvportgroup-11",
+											},
+										},
+									},
+								},
+							},
+						},
+						&vimtypes.VirtualVmxnet3{
+							VirtualVmxnet: vimtypes.VirtualVmxnet{
+								VirtualEthernetCard: vimtypes.VirtualEthernetCard{
+									VirtualDevice: vimtypes.VirtualDevice{
+										Key: 4002,
+										Backing: &vimtypes.VirtualEthernetCardDistributedVirtualPortBackingInfo{
+											Port: vimtypes.DistributedVirtualSwitchPortConnection{
+												PortgroupKey: "dvportgroup-unknown",
+											},
+										},
+									},
+								},
+							},
+						},
+						&vimtypes.VirtualPCIPassthrough{
+							VirtualDevice: vimtypes.VirtualDevice{
+								Key: 13000,
+								Backing: &vimtypes.VirtualPCIPassthroughVmiopBackingInfo{
+									Vgpu: "profile-a",
+								},
+							},
+						},
+					},
+				},
+				ExtraConfig: []vimtypes.BaseOptionValue{
+					&vimtypes.OptionValue{Key: "foo", Value: "bar"},
+					&vimtypes.OptionValue{Key: "pciPassthru0.cfg.enable_uvm", Value: "1"},
+					&vimtypes.OptionValue{Key: "pciPassthru0.pciSlotNumber", Value: "256"},
+					&vimtypes.OptionValue{Key: "guestinfo.userdata", Value: "data"},
+					&vimtypes.OptionValue{Key: "vmservice.namespacedName", Value: "ns/name"},
+					&vimtypes.OptionValue{Key: "nvram", Value: "my-vm.nvram"},
+					&vimtypes.OptionValue{Key: "pciBridge0.present", Value: "TRUE"},
+					&vimtypes.OptionValue{Key: "scsi0:0.redo", Value: ""},
+					&vimtypes.OptionValue{Key: "ethernet0.pciSlotNumber", Value: "192"},
+					&vimtypes.OptionValue{Key: "migrate.hostLog", Value: "my-vm.hlog"},
+					&vimtypes.OptionValue{Key: "vmware.tools.internalversion", Value: "12345"},
+					&vimtypes.OptionValue{Key: "disk.enableUUID", Value: "TRUE"},
+					&vimtypes.OptionValue{Key: "global.key", Value: "global"},
+					&vimtypes.OptionValue{Key: "cpuid.coresPerSocket", Value: "2"},
+				},
+			},
+			Runtime: vimtypes.VirtualMachineRuntimeInfo{
+				PowerState: vimtypes.VirtualMachinePowerStatePoweredOn,
+			},
+		}
+		moVM.Self = vimtypes.ManagedObjectReference{Type: "VirtualMachine", Value: "vm-42"}
+
+		args = virtualmachine.ExportArgs{
+			Namespace: "my-namespace",
+			PortgroupNames: map[string]string{
+				"dvportgroup-11": "DC0_DVPG0",
+			},
+			GlobalExtraConfig: map[string]string{
+				"global.key": "global",
+			},
+		}
+	})
+
+	When("the VM does not have a config", func() {
+		BeforeEach(func() {
+			moVM.Config = nil
+		})
+		It("returns an error", func() {
+			_, _, err := virtualmachine.ExportVirtualMachine(moVM, args)
+			Expect(err).To(MatchError("vm vm-42 does not have a config"))
+		})
+	})
+
+	It("exports the VM and class", func() {
+		vm, vmClass, err := virtualmachine.ExportVirtualMachine(moVM, args)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vmClass.Name).To(Equal("my-vm"))
+		Expect(vmClass.Namespace).To(Equal("my-namespace"))
+		Expect(vmClass.Spec.Hardware.Cpus).To(BeEquivalentTo(2))
+		Expect(vmClass.Spec.Hardware.Memory.Equal(resource.MustParse("4Gi"))).To(BeTrue())
+
+		configSpec, err := pkgutil.UnmarshalConfigSpecFromJSON(vmClass.Spec.ConfigSpec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configSpec.ExtraConfig).To(ConsistOf(
+			&vimtypes.OptionValue{Key: "foo", Value: "bar"},
+			&vimtypes.OptionValue{Key: "pciPassthru0.cfg.enable_uvm", Value: "1"}))
+		Expect(configSpec.DeviceChange).To(HaveLen(1))
+		Expect(configSpec.DeviceChange[0].GetVirtualDeviceConfigSpec().Device).
+			To(BeAssignableToTypeOf(&vimtypes.VirtualPCIPassthrough{}))
+
+		Expect(vm.Name).To(Equal("my-vm"))
+		Expect(vm.Namespace).To(Equal("my-namespace"))
+		Expect(vm.Spec.ClassName).To(Equal("my-vm"))
+		Expect(vm.Spec.GuestID).To(Equal("vmwarePhoton64Guest"))
+		Expect(vm.Spec.BiosUUID).To(Equal("bios-uuid"))
+		Expect(vm.Spec.InstanceUUID).To(Equal("instance-uuid"))
+		Expect(vm.Spec.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+		Expect(vm.Spec.MinHardwareVersion).To(BeEquivalentTo(19))
+		Expect(vm.Spec.Advanced).ToNot(BeNil())
+		Expect(vm.Spec.Advanced.BootDiskCapacity.Equal(resource.MustParse("10Gi"))).To(BeTrue())
+		Expect(vm.Spec.Network).ToNot(BeNil())
+		Expect(vm.Spec.Network.Interfaces).To(HaveLen(3))
+		Expect(vm.Spec.Network.Interfaces[0].Name).To(Equal("eth0"))
+		Expect(vm.Spec.Network.Interfaces[0].Network).ToNot(BeNil())
+		Expect(vm.Spec.Network.Interfaces[0].Network.Name).To(Equal("vm-network"))
+		Expect(vm.Spec.Network.Interfaces[1].Name).To(Equal("eth1"))
+		Expect(vm.Spec.Network.Interfaces[1].Network).ToNot(BeNil())
+		Expect(vm.Spec.Network.Interfaces[1].Network.Name).To(Equal("dc0-dvpg0"))
+		Expect(vm.Spec.Network.Interfaces[2].Name).To(Equal("eth2"))
+		Expect(vm.Spec.Network.Interfaces[2].Network).To(BeNil())
+	})
+})
+
+var _ = Describe("ExportName", func() {
+	DescribeTable("returns a valid resource name",
+		func(name, expected string) {
+			Expect(virtualmachine.ExportName(name)).To(Equal(expected))
+		},
+		Entry("lowercase", "my-vm", "my-vm"),
+		Entry("uppercase", "My-VM", "my-vm"),
+		Entry("invalid characters", "My VM (1)", "my-vm-1"),
+		Entry("leading and trailing invalid characters", "_my.vm_", "my-vm"),
+	)
+})
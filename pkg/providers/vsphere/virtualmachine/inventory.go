@@ -0,0 +1,127 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+)
+
+// ManagedPropertiesSelector is the set of VM properties required by
+// IsManagedByVMOperator and ManagedNamespacedName.
+var ManagedPropertiesSelector = []string{
+	"name",
+	"config.managedBy",
+	"config.instanceUuid",
+	"config.extraConfig",
+}
+
+// ListManagedVirtualMachines returns the vSphere VMs in the provided folders
+// that were created by VM Operator for a VirtualMachine resource in one of the
+// provided namespaces. Since a folder may be shared with another Supervisor,
+// ex. the datacenter's VM folder when VMs are placed with a folder path
+// template, the VMs created for the namespaces of another Supervisor are
+// omitted.
+func ListManagedVirtualMachines(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	folders []vimtypes.ManagedObjectReference,
+	namespaces map[string]struct{}) ([]mo.VirtualMachine, error) {
+
+	var (
+		managed []mo.VirtualMachine
+		seen    = map[string]struct{}{}
+	)
+
+	for _, folder := range folders {
+		moVMs, err := listVirtualMachinesInFolder(ctx, vimClient, folder)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range moVMs {
+			if _, ok := seen[moVMs[i].Self.Value]; ok {
+				continue
+			}
+			seen[moVMs[i].Self.Value] = struct{}{}
+
+			if !IsManagedByVMOperator(moVMs[i]) {
+				continue
+			}
+			namespace, _, _ := strings.Cut(ManagedNamespacedName(moVMs[i]), "/")
+			if _, ok := namespaces[namespace]; ok {
+				managed = append(managed, moVMs[i])
+			}
+		}
+	}
+
+	return managed, nil
+}
+
+func listVirtualMachinesInFolder(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	folder vimtypes.ManagedObjectReference) ([]mo.VirtualMachine, error) {
+
+	cv, err := view.NewManager(vimClient).CreateContainerView(
+		ctx,
+		folder,
+		[]string{"VirtualMachine"},
+		true)
+	if err != nil {
+		if fault.Is(err, &vimtypes.ManagedObjectNotFound{}) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() {
+		_ = cv.Destroy(context.Background())
+	}()
+
+	var moVMs []mo.VirtualMachine
+	if err := cv.Retrieve(
+		ctx,
+		[]string{"VirtualMachine"},
+		ManagedPropertiesSelector,
+		&moVMs); err != nil {
+
+		return nil, err
+	}
+
+	return moVMs, nil
+}
+
+// IsManagedByVMOperator returns true if the vSphere VM was created by VM
+// Operator.
+func IsManagedByVMOperator(moVM mo.VirtualMachine) bool {
+	if moVM.Config == nil || moVM.Config.ManagedBy == nil {
+		return false
+	}
+	mb := moVM.Config.ManagedBy
+	return mb.ExtensionKey == vmopv1.ManagedByExtensionKey &&
+		mb.Type == vmopv1.ManagedByExtensionType
+}
+
+// ManagedNamespacedName returns the namespace and name, in the format
+// "namespace/name", of the VirtualMachine resource recorded in the vSphere
+// VM's ExtraConfig, or an empty string if it is not recorded.
+func ManagedNamespacedName(moVM mo.VirtualMachine) string {
+	if moVM.Config == nil {
+		return ""
+	}
+	v, _ := pkgutil.OptionValues(moVM.Config.ExtraConfig).GetString(
+		constants.ExtraConfigVMServiceNamespacedName)
+	return v
+}
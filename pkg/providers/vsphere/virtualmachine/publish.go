@@ -15,12 +15,15 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
 const (
 	sourceVirtualMachineType = "VirtualMachine"
 
 	itemDescriptionFormat = "virtualmachinepublishrequest.vmoperator.vmware.com: %s\n"
+
+	exportItemDescriptionFormat = "virtualmachineexportrequest.vmoperator.vmware.com: %s\n"
 )
 
 func CreateOVF(
@@ -38,6 +41,42 @@ func CreateOVF(
 		Description: descriptionPrefix + vmPubReq.Status.TargetRef.Item.Description,
 	}
 
+	return createOVF(vmCtx, client, createSpec, cl, actID)
+}
+
+// ExportOVF exports the VM as an OVF to a new item in the content library
+// described by the export request's target.
+func ExportOVF(
+	vmCtx pkgctx.VirtualMachineContext,
+	client *rest.Client,
+	vmExportReq *vmopv1.VirtualMachineExportRequest,
+	cl *imgregv1a1.ContentLibrary,
+	actID string) (string, error) {
+
+	target := vmExportReq.Spec.Target.ContentLibraryItem
+	if target == nil {
+		return "", fmt.Errorf("export request %s/%s does not have a content library item target",
+			vmExportReq.Namespace, vmExportReq.Name)
+	}
+
+	// Like for publish, use a VM Operator specific description so the item
+	// may be linked to the export request.
+	descriptionPrefix := fmt.Sprintf(exportItemDescriptionFormat, string(vmExportReq.UID))
+	createSpec := vcenter.CreateSpec{
+		Name:        vmopv1util.ExportItemName(vmExportReq),
+		Description: descriptionPrefix + target.Description,
+	}
+
+	return createOVF(vmCtx, client, createSpec, cl, actID)
+}
+
+func createOVF(
+	vmCtx pkgctx.VirtualMachineContext,
+	client *rest.Client,
+	createSpec vcenter.CreateSpec,
+	cl *imgregv1a1.ContentLibrary,
+	actID string) (string, error) {
+
 	source := vcenter.ResourceID{
 		Type:  sourceVirtualMachineType,
 		Value: vmCtx.VM.Status.UniqueID,
@@ -55,8 +94,8 @@ func CreateOVF(
 
 	vmCtx.Logger.Info("Creating OVF from VM", "spec", ovf, "actId", actID)
 
-	// Use vmpublish uid as the act id passed down to the content library service, so that we can track
-	// the task status by the act id.
+	// Use the request's uid as the act id passed down to the content library
+	// service, so that we can track the task status by the act id.
 	return vcenter.NewManager(client).CreateOVF(
 		pkgutil.WithVAPIActivationID(vmCtx, client, actID),
 		ovf)
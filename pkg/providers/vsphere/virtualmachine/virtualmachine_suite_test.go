@@ -20,6 +20,7 @@ func vcSimTests() {
 	Describe("Backup", Label(testlabels.VCSim), backupTests)
 	Describe("GuestInfo", Label(testlabels.VCSim), guestInfoTests)
 	Describe("CD-ROM", Label(testlabels.VCSim), cdromTests)
+	Describe("Chargeback", Label(testlabels.VCSim), chargebackTests)
 }
 
 var suite = builder.NewTestSuite()
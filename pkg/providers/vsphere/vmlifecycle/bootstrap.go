@@ -65,8 +65,11 @@ func DoBootstrap(
 	config *vimtypes.VirtualMachineConfigInfo,
 	bootstrapArgs BootstrapArgs) error {
 
+	// The guest customization policy is only a modifier of the bootstrap
+	// provider, so a bootstrap spec with only the policy is treated as if no
+	// provider was specified.
 	bootstrap := vmCtx.VM.Spec.Bootstrap
-	if bootstrap == nil {
+	if !hasBootstrapProvider(bootstrap) {
 		// V1ALPHA1: We had always defaulted to LinuxPrep w/ HwClockUTC=true.
 		// Now, try to just do that on Linux VMs.
 		// Skip if the VM has a CD-ROM as the Linux ISO-type image may not have
@@ -111,6 +114,14 @@ func DoBootstrap(
 		return fmt.Errorf("failed to create bootstrap data: %w", err)
 	}
 
+	if sysPrep != nil && customSpec != nil && GetGuestCustomizationIdentitySuffix(vmCtx.VM) != "" {
+		// Direct Sysprep to generate a new SID when the customization is
+		// retried with a regenerated identity.
+		customSpec.Options = &vimtypes.CustomizationWinOptions{
+			ChangeSID: true,
+		}
+	}
+
 	if configSpec != nil {
 		err := doReconfigure(vmCtx, vcVM, configSpec)
 		if err != nil {
@@ -128,6 +139,13 @@ func DoBootstrap(
 	return nil
 }
 
+func hasBootstrapProvider(bootstrap *vmopv1.VirtualMachineBootstrapSpec) bool {
+	return bootstrap != nil && (bootstrap.CloudInit != nil ||
+		bootstrap.LinuxPrep != nil ||
+		bootstrap.Sysprep != nil ||
+		bootstrap.VAppConfig != nil)
+}
+
 // GetBootstrapArgs returns the information used to bootstrap the VM via
 // one of the many, possible bootstrap engines.
 func GetBootstrapArgs(
@@ -147,13 +165,12 @@ func GetBootstrapArgs(
 	bsa := BootstrapArgs{
 		BootstrapData:  bootstrapData,
 		NetworkResults: networkResults,
-		HostName:       ctx.VM.Name,
+		HostName: SuffixHostName(
+			GetBootstrapHostName(ctx.VM),
+			GetGuestCustomizationIdentitySuffix(ctx.VM)),
 	}
 
 	if networkSpec := ctx.VM.Spec.Network; networkSpec != nil {
-		if networkSpec.HostName != "" {
-			bsa.HostName = networkSpec.HostName
-		}
 		if networkSpec.DomainName != "" {
 			bsa.DomainName = networkSpec.DomainName
 		}
@@ -255,9 +272,18 @@ func doCustomize(
 	config *vimtypes.VirtualMachineConfigInfo,
 	customSpec *vimtypes.CustomizationSpec) error {
 
-	if vmCtx.VM.Annotations[constants.VSphereCustomizationBypassKey] == constants.VSphereCustomizationBypassDisable {
-		vmCtx.Logger.Info("Skipping vsphere customization because of vsphere-customization bypass annotation")
+	switch policy := GetGuestCustomizationPolicy(vmCtx); policy {
+	case vmopv1.GuestCustomizationPolicyDisabled:
+		vmCtx.Logger.Info("Skipping vsphere customization because of guest customization policy",
+			"policy", policy)
 		return nil
+	case vmopv1.GuestCustomizationPolicyEnabled:
+		// Customize even if the VM has the bypass annotation.
+	default:
+		if vmCtx.VM.Annotations[constants.VSphereCustomizationBypassKey] == constants.VSphereCustomizationBypassDisable {
+			vmCtx.Logger.Info("Skipping vsphere customization because of vsphere-customization bypass annotation")
+			return nil
+		}
 	}
 
 	if IsCustomizationPendingExtraConfig(config.ExtraConfig) {
@@ -281,6 +307,33 @@ func doCustomize(
 	return nil
 }
 
+// GetBootstrapHostName returns the hostname used to bootstrap the VM, which is
+// spec.network.hostName if set, otherwise the name of the VM.
+func GetBootstrapHostName(vm *vmopv1.VirtualMachine) string {
+	if networkSpec := vm.Spec.Network; networkSpec != nil && networkSpec.HostName != "" {
+		return networkSpec.HostName
+	}
+	return vm.Name
+}
+
+// GetGuestCustomizationPolicy returns the VM's guest customization policy,
+// falling back to the globally configured policy, and then to Auto if the
+// global policy is empty or invalid.
+func GetGuestCustomizationPolicy(
+	vmCtx pkgctx.VirtualMachineContext) vmopv1.GuestCustomizationPolicy {
+
+	if bs := vmCtx.VM.Spec.Bootstrap; bs != nil && bs.GuestCustomizationPolicy != "" {
+		return bs.GuestCustomizationPolicy
+	}
+	switch p := pkgcfg.FromContext(vmCtx).GuestCustomizationPolicy; p {
+	case pkgcfg.GuestCustomizationPolicyEnabled:
+		return vmopv1.GuestCustomizationPolicyEnabled
+	case pkgcfg.GuestCustomizationPolicyDisabled:
+		return vmopv1.GuestCustomizationPolicyDisabled
+	}
+	return vmopv1.GuestCustomizationPolicyAuto
+}
+
 func IsCustomizationPendingExtraConfig(extraConfig []vimtypes.BaseOptionValue) bool {
 	for _, opt := range extraConfig {
 		if optValue := opt.GetOptionValue(); optValue != nil {
@@ -113,6 +113,10 @@ func BootStrapCloudInit(
 
 	iid := BootStrapCloudInitInstanceID(vmCtx, cloudInitSpec)
 
+	// A new instance ID is used when the customization is retried with a
+	// regenerated identity.
+	iid = suffixInstanceID(iid, GetGuestCustomizationIdentitySuffix(vmCtx.VM))
+
 	metadata, err := GetCloudInitMetadata(
 		iid, bsArgs.HostName, bsArgs.DomainName, netPlan, sshPublicKeys)
 	if err != nil {
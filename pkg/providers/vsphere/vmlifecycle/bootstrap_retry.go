@@ -0,0 +1,135 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle
+
+import (
+	"fmt"
+	"strings"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+)
+
+const (
+	// GuestCustomizationRetryReason is the reason of the event emitted when a
+	// failed guest customization is retried with a regenerated identity.
+	GuestCustomizationRetryReason = "GuestCustomizationRetry"
+
+	// maxHostNameLength is the maximum length of a hostname label.
+	maxHostNameLength = 63
+)
+
+// duplicateIdentityErrors are the, lower-case, fragments of the guest
+// customization error messages that indicate the guest's identity conflicts
+// with another system on the network.
+var duplicateIdentityErrors = []string{
+	"duplicate",
+	"already in use",
+	"already exists",
+}
+
+// IsDuplicateIdentityCustomizationError returns true if the guest
+// customization error message indicates the customization failed because the
+// guest's identity, ex. its hostname or SID, conflicts with another system.
+func IsDuplicateIdentityCustomizationError(msg string) bool {
+	msg = strings.ToLower(msg)
+	for _, s := range duplicateIdentityErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// ShouldRetryGuestCustomization returns true if the VM's guest customization
+// failed due to a duplicate identity and the VM's retry policy allows the
+// customization to be retried with a regenerated identity. The customization
+// is only retried once.
+func ShouldRetryGuestCustomization(
+	vm *vmopv1.VirtualMachine,
+	guestInfo *vimtypes.GuestInfo) bool {
+
+	bs := vm.Spec.Bootstrap
+	if bs == nil ||
+		bs.GuestCustomizationRetryPolicy != vmopv1.GuestCustomizationRetryPolicyRegenerateIdentity {
+		return false
+	}
+	if _, ok := vm.Annotations[pkgconst.GuestCustomizationRetryAnnotationKey]; ok {
+		return false
+	}
+	if guestInfo == nil || guestInfo.CustomizationInfo == nil {
+		return false
+	}
+	ci := guestInfo.CustomizationInfo
+	if ci.CustomizationStatus != string(vimtypes.GuestInfoCustomizationStatusTOOLSDEPLOYPKG_FAILED) {
+		return false
+	}
+	return IsDuplicateIdentityCustomizationError(ci.ErrorMsg)
+}
+
+// RegenerateGuestCustomizationIdentity records the suffix used to regenerate
+// the VM's identity when its guest customization is retried. A description of
+// each change to the VM's identity is returned.
+func RegenerateGuestCustomizationIdentity(
+	vm *vmopv1.VirtualMachine,
+	suffix string) []string {
+
+	if vm.Annotations == nil {
+		vm.Annotations = map[string]string{}
+	}
+	vm.Annotations[pkgconst.GuestCustomizationRetryAnnotationKey] = suffix
+
+	hostName := GetBootstrapHostName(vm)
+	changes := []string{
+		fmt.Sprintf("hostname changed from %q to %q",
+			hostName, SuffixHostName(hostName, suffix)),
+	}
+
+	if bs := vm.Spec.Bootstrap; bs != nil {
+		if ci := bs.CloudInit; ci != nil {
+			iid := ci.InstanceID
+			if iid == "" {
+				iid = string(vm.UID)
+			}
+			changes = append(changes, fmt.Sprintf(
+				"Cloud-Init instance ID changed from %q to %q",
+				iid, suffixInstanceID(iid, suffix)))
+		}
+		if bs.Sysprep != nil {
+			changes = append(changes, "Sysprep generates a new SID")
+		}
+	}
+
+	return changes
+}
+
+// GetGuestCustomizationIdentitySuffix returns the suffix appended to the VM's
+// identity when its guest customization was retried with a regenerated
+// identity. An empty string is returned if the customization was not retried.
+func GetGuestCustomizationIdentitySuffix(vm *vmopv1.VirtualMachine) string {
+	return vm.Annotations[pkgconst.GuestCustomizationRetryAnnotationKey]
+}
+
+// SuffixHostName returns the hostname with the suffix appended, truncating the
+// hostname as needed so the result is still a valid hostname.
+func SuffixHostName(hostName, suffix string) string {
+	if suffix == "" {
+		return hostName
+	}
+	suffix = "-" + suffix
+	if n := maxHostNameLength - len(suffix); len(hostName) > n {
+		hostName = strings.TrimRight(hostName[:n], "-")
+	}
+	return hostName + suffix
+}
+
+func suffixInstanceID(iid, suffix string) string {
+	if suffix == "" {
+		return iid
+	}
+	return iid + "-" + suffix
+}
@@ -0,0 +1,144 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+)
+
+var _ = DescribeTable("IsDuplicateIdentityCustomizationError",
+	func(msg string, expected bool) {
+		Expect(vmlifecycle.IsDuplicateIdentityCustomizationError(msg)).To(Equal(expected))
+	},
+	Entry("empty", "", false),
+	Entry("unrelated error", "Customization failed: no network", false),
+	Entry("duplicate hostname", "Duplicate hostname detected on the network", true),
+	Entry("duplicate SID", "the machine SID is a DUPLICATE", true),
+	Entry("name already in use", "The computer name is already in use", true),
+	Entry("name already exists", "A computer account with this name already exists", true),
+)
+
+var _ = Describe("ShouldRetryGuestCustomization", func() {
+	var (
+		vm        *vmopv1.VirtualMachine
+		guestInfo *vimtypes.GuestInfo
+	)
+
+	BeforeEach(func() {
+		vm = &vmopv1.VirtualMachine{
+			Spec: vmopv1.VirtualMachineSpec{
+				Bootstrap: &vmopv1.VirtualMachineBootstrapSpec{
+					GuestCustomizationRetryPolicy: vmopv1.GuestCustomizationRetryPolicyRegenerateIdentity,
+				},
+			},
+		}
+		guestInfo = &vimtypes.GuestInfo{
+			CustomizationInfo: &vimtypes.GuestInfoCustomizationInfo{
+				CustomizationStatus: string(vimtypes.GuestInfoCustomizationStatusTOOLSDEPLOYPKG_FAILED),
+				ErrorMsg:            "duplicate hostname",
+			},
+		}
+	})
+
+	It("returns true when customization failed due to a duplicate identity", func() {
+		Expect(vmlifecycle.ShouldRetryGuestCustomization(vm, guestInfo)).To(BeTrue())
+	})
+
+	It("returns false when the policy does not allow a retry", func() {
+		vm.Spec.Bootstrap.GuestCustomizationRetryPolicy = vmopv1.GuestCustomizationRetryPolicyNone
+		Expect(vmlifecycle.ShouldRetryGuestCustomization(vm, guestInfo)).To(BeFalse())
+
+		vm.Spec.Bootstrap = nil
+		Expect(vmlifecycle.ShouldRetryGuestCustomization(vm, guestInfo)).To(BeFalse())
+	})
+
+	It("returns false when the customization was already retried", func() {
+		vm.Annotations = map[string]string{
+			pkgconst.GuestCustomizationRetryAnnotationKey: "abc",
+		}
+		Expect(vmlifecycle.ShouldRetryGuestCustomization(vm, guestInfo)).To(BeFalse())
+	})
+
+	It("returns false when the customization did not fail", func() {
+		guestInfo.CustomizationInfo.CustomizationStatus = string(vimtypes.GuestInfoCustomizationStatusTOOLSDEPLOYPKG_SUCCEEDED)
+		Expect(vmlifecycle.ShouldRetryGuestCustomization(vm, guestInfo)).To(BeFalse())
+
+		guestInfo.CustomizationInfo = nil
+		Expect(vmlifecycle.ShouldRetryGuestCustomization(vm, guestInfo)).To(BeFalse())
+		Expect(vmlifecycle.ShouldRetryGuestCustomization(vm, nil)).To(BeFalse())
+	})
+
+	It("returns false when the customization failed for another reason", func() {
+		guestInfo.CustomizationInfo.ErrorMsg = "no network"
+		Expect(vmlifecycle.ShouldRetryGuestCustomization(vm, guestInfo)).To(BeFalse())
+	})
+})
+
+var _ = Describe("RegenerateGuestCustomizationIdentity", func() {
+	var vm *vmopv1.VirtualMachine
+
+	BeforeEach(func() {
+		vm = &vmopv1.VirtualMachine{}
+		vm.Name = "my-vm"
+		vm.UID = "my-uid"
+	})
+
+	It("records the suffix and describes the hostname change", func() {
+		changes := vmlifecycle.RegenerateGuestCustomizationIdentity(vm, "abc")
+		Expect(vm.Annotations).To(HaveKeyWithValue(pkgconst.GuestCustomizationRetryAnnotationKey, "abc"))
+		Expect(vmlifecycle.GetGuestCustomizationIdentitySuffix(vm)).To(Equal("abc"))
+		Expect(changes).To(ConsistOf(`hostname changed from "my-vm" to "my-vm-abc"`))
+	})
+
+	When("the VM uses Cloud-Init", func() {
+		BeforeEach(func() {
+			vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+				HostName: "my-host",
+			}
+			vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+				CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+			}
+		})
+
+		It("describes the instance ID change", func() {
+			changes := vmlifecycle.RegenerateGuestCustomizationIdentity(vm, "abc")
+			Expect(changes).To(ConsistOf(
+				`hostname changed from "my-host" to "my-host-abc"`,
+				`Cloud-Init instance ID changed from "my-uid" to "my-uid-abc"`))
+		})
+	})
+
+	When("the VM uses Sysprep", func() {
+		BeforeEach(func() {
+			vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+				Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{},
+			}
+		})
+
+		It("describes the SID change", func() {
+			changes := vmlifecycle.RegenerateGuestCustomizationIdentity(vm, "abc")
+			Expect(changes).To(ContainElement("Sysprep generates a new SID"))
+		})
+	})
+})
+
+var _ = DescribeTable("SuffixHostName",
+	func(hostName, suffix, expected string) {
+		Expect(vmlifecycle.SuffixHostName(hostName, suffix)).To(Equal(expected))
+	},
+	Entry("no suffix", "my-vm", "", "my-vm"),
+	Entry("suffix", "my-vm", "abc", "my-vm-abc"),
+	Entry("long hostname is truncated", strings.Repeat("a", 63), "abc", strings.Repeat("a", 59)+"-abc"),
+	Entry("truncated hostname does not end with a hyphen", strings.Repeat("a", 58)+"-bbbb", "abc", strings.Repeat("a", 58)+"-abc"),
+)
@@ -5,14 +5,23 @@
 package vmlifecycle_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/mo"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/internal"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
 var _ = Describe("Customization utils", func() {
@@ -202,5 +211,137 @@ var _ = Describe("SanitizeCustomizationSpec", func() {
 	})
 })
 
-// TODO: We should at least a few basic DoBootstrap() tests so we test the overall
-// Reconfigure/Customize flow but the old code didn't.
+var _ = Describe("GetGuestCustomizationPolicy", func() {
+	var (
+		vmCtx pkgctx.VirtualMachineContext
+	)
+
+	BeforeEach(func() {
+		vmCtx = pkgctx.VirtualMachineContext{
+			Context: pkgcfg.NewContext(),
+			VM:      &vmopv1.VirtualMachine{},
+		}
+	})
+
+	It("defaults to Auto", func() {
+		Expect(vmlifecycle.GetGuestCustomizationPolicy(vmCtx)).To(Equal(vmopv1.GuestCustomizationPolicyAuto))
+	})
+
+	When("the global policy is invalid", func() {
+		BeforeEach(func() {
+			vmCtx.Context = pkgcfg.WithContext(context.Background(), pkgcfg.Config{
+				GuestCustomizationPolicy: "disabled",
+			})
+		})
+
+		It("returns Auto", func() {
+			Expect(vmlifecycle.GetGuestCustomizationPolicy(vmCtx)).To(Equal(vmopv1.GuestCustomizationPolicyAuto))
+		})
+	})
+
+	When("there is a global policy", func() {
+		BeforeEach(func() {
+			vmCtx.Context = pkgcfg.WithContext(context.Background(), pkgcfg.Config{
+				GuestCustomizationPolicy: string(vmopv1.GuestCustomizationPolicyDisabled),
+			})
+		})
+
+		It("returns the global policy", func() {
+			Expect(vmlifecycle.GetGuestCustomizationPolicy(vmCtx)).To(Equal(vmopv1.GuestCustomizationPolicyDisabled))
+		})
+
+		When("the VM has a policy", func() {
+			BeforeEach(func() {
+				vmCtx.VM.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+					GuestCustomizationPolicy: vmopv1.GuestCustomizationPolicyEnabled,
+				}
+			})
+
+			It("returns the VM's policy", func() {
+				Expect(vmlifecycle.GetGuestCustomizationPolicy(vmCtx)).To(Equal(vmopv1.GuestCustomizationPolicyEnabled))
+			})
+		})
+	})
+})
+
+var _ = Describe("DoBootstrap", func() {
+	var (
+		ctx    *builder.TestContextForVCSim
+		vmCtx  pkgctx.VirtualMachineContext
+		vcVM   *object.VirtualMachine
+		config *vimtypes.VirtualMachineConfigInfo
+		bsArgs vmlifecycle.BootstrapArgs
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewTestContextForVCSim(builder.VCSimTestConfig{})
+
+		vm := builder.DummyVirtualMachine()
+		vm.Name = "do-bootstrap-test"
+		vm.Spec.Bootstrap = nil
+		vm.Spec.Cdrom = nil
+
+		vmCtx = pkgctx.VirtualMachineContext{
+			Context: ctx,
+			Logger:  suite.GetLogger().WithValues("vmName", vm.Name),
+			VM:      vm,
+		}
+
+		var err error
+		vcVM, err = ctx.Finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		Expect(err).ToNot(HaveOccurred())
+
+		task, err := vcVM.PowerOff(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(task.Wait(ctx)).To(Succeed())
+
+		config = &vimtypes.VirtualMachineConfigInfo{
+			GuestId: string(vimtypes.VirtualMachineGuestOsIdentifierUbuntu64Guest),
+		}
+		bsArgs = vmlifecycle.BootstrapArgs{
+			NetworkResults: network.NetworkInterfaceResults{
+				Results: []network.NetworkInterfaceResult{{DHCP4: true}},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	getPendingCustomization := func() string {
+		var o mo.VirtualMachine
+		Expect(vcVM.Properties(ctx, vcVM.Reference(), []string{"config.tools"}, &o)).To(Succeed())
+		return o.Config.Tools.PendingCustomization
+	}
+
+	It("customizes a Linux VM with LinuxPrep when there is no bootstrap provider", func() {
+		Expect(vmlifecycle.DoBootstrap(vmCtx, vcVM, config, bsArgs)).To(Succeed())
+		Expect(getPendingCustomization()).ToNot(BeEmpty())
+	})
+
+	When("the bootstrap spec only has the guest customization policy", func() {
+		BeforeEach(func() {
+			vmCtx.VM.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+				GuestCustomizationPolicy: vmopv1.GuestCustomizationPolicyEnabled,
+			}
+		})
+
+		It("customizes the VM with LinuxPrep", func() {
+			Expect(vmlifecycle.DoBootstrap(vmCtx, vcVM, config, bsArgs)).To(Succeed())
+			Expect(getPendingCustomization()).ToNot(BeEmpty())
+		})
+
+		When("the policy is Disabled", func() {
+			BeforeEach(func() {
+				vmCtx.VM.Spec.Bootstrap.GuestCustomizationPolicy = vmopv1.GuestCustomizationPolicyDisabled
+			})
+
+			It("does not customize the VM", func() {
+				Expect(vmlifecycle.DoBootstrap(vmCtx, vcVM, config, bsArgs)).To(Succeed())
+				Expect(getPendingCustomization()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -5,13 +5,18 @@
 package vmlifecycle
 
 import (
+	"time"
+
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/vapi/rest"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/progress"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
+	pkgtask "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
 )
 
 // CreateArgs contains the arguments needed to create a VM.
@@ -30,6 +35,26 @@ type CreateArgs struct {
 	Datastores          []DatastoreRef
 	DiskPaths           []string
 	ZoneName            string
+
+	// ZoneContentLibraryID is the UUID of the content library co-located with
+	// the zone the VM is placed in. If the library has an item with the same
+	// name as the image's item, the VM is deployed from that item instead.
+	ZoneContentLibraryID string
+
+	// ProgressFn, if set, is invoked with the progress of the long running
+	// tasks used to create the VM.
+	ProgressFn pkgtask.ProgressFunc
+}
+
+// progressSinkers returns the sinkers to pass to a task's Wait function so
+// the task's progress is reported to ProgressFn.
+func (c *CreateArgs) progressSinkers(phase string) []progress.Sinker {
+	if c.ProgressFn == nil {
+		return nil
+	}
+	return []progress.Sinker{
+		pkgtask.NewProgressSinker(phase, pkgtask.DefaultProgressInterval, c.ProgressFn),
+	}
 }
 
 type DatastoreRef struct {
@@ -50,7 +75,28 @@ func CreateVirtualMachine(
 	restClient *rest.Client,
 	vimClient *vim25.Client,
 	finder *find.Finder,
-	createArgs *CreateArgs) (*vimtypes.ManagedObjectReference, error) {
+	createArgs *CreateArgs) (_ *vimtypes.ManagedObjectReference, retErr error) {
+
+	operation := metrics.ProviderOperationClone
+	if createArgs.UseContentLibrary {
+		operation = metrics.ProviderOperationDeploy
+	}
+	start := time.Now()
+	defer func() {
+		metrics.NewSLOMetrics().RegisterProviderOperation(
+			vmCtx.Logger,
+			operation,
+			vmCtx.VM.Namespace,
+			vmCtx.VM.Name,
+			time.Since(start),
+			retErr)
+	}()
+
+	name, err := ResolveVMName(vmCtx, vimClient, createArgs.FolderMoID, createArgs.ConfigSpec.Name)
+	if err != nil {
+		return nil, err
+	}
+	createArgs.ConfigSpec.Name = name
 
 	if createArgs.UseContentLibrary {
 		return deployFromContentLibrary(vmCtx, restClient, vimClient, createArgs)
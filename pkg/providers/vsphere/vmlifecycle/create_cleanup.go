@@ -0,0 +1,180 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle
+
+import (
+	"fmt"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
+)
+
+// CleanupFailedDeploy removes the VMs a failed deploy of the VM left behind in
+// the folder. A VM in the folder is a leftover if it has the same name as the
+// VM, or the suffixed name from ResolveVMName, and is managed by VM Operator.
+// Since a VM's name is unique in its namespace, and the folder is the
+// namespace's folder, such a VM can only have been created by a deploy of
+// the VM. When the VM's config is available and the VM specifies an instance
+// UUID, the leftover must also have that instance UUID.
+//
+// The config of an inaccessible or orphaned VM is not available, so these VMs
+// are identified by their name and folder alone, and they are unregistered
+// since they cannot be destroyed. The other VMs are destroyed along with
+// their files. VMs that are powered on are never removed since a failed
+// deploy does not power on the VM.
+//
+// The number of VMs that were removed is returned.
+func CleanupFailedDeploy(
+	vmCtx pkgctx.VirtualMachineContext,
+	vimClient *vim25.Client,
+	folderMoID string) (int, error) {
+
+	if folderMoID == "" {
+		return 0, nil
+	}
+
+	leftovers, err := getFailedDeployLeftovers(vmCtx, vimClient, folderMoID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get VMs left by failed deploy: %w", err)
+	}
+
+	var (
+		removed int
+		errs    []error
+		m       = metrics.NewDeployCleanupMetrics()
+	)
+
+	for _, moVM := range leftovers {
+		logger := vmCtx.Logger.WithValues("leftoverVM", moVM.Self.Value)
+
+		if err := removeFailedDeployLeftover(vmCtx, vimClient, moVM); err != nil {
+			logger.Error(err, "Failed to remove VM left by failed deploy")
+			m.RegisterDeployCleanup(logger, false)
+			errs = append(errs, err)
+			continue
+		}
+
+		logger.Info("Removed VM left by failed deploy")
+		m.RegisterDeployCleanup(logger, true)
+		removed++
+	}
+
+	if len(errs) > 0 {
+		return removed, fmt.Errorf("failed to remove VMs left by failed deploy: %v", errs)
+	}
+
+	return removed, nil
+}
+
+func getFailedDeployLeftovers(
+	vmCtx pkgctx.VirtualMachineContext,
+	vimClient *vim25.Client,
+	folderMoID string) ([]mo.VirtualMachine, error) {
+
+	folderRef := vimtypes.ManagedObjectReference{Type: "Folder", Value: folderMoID}
+
+	var moFolder mo.Folder
+	pc := property.DefaultCollector(vimClient)
+	if err := pc.RetrieveOne(vmCtx, folderRef, []string{"childEntity"}, &moFolder); err != nil {
+		return nil, err
+	}
+
+	var vmRefs []vimtypes.ManagedObjectReference
+	for _, ref := range moFolder.ChildEntity {
+		if ref.Type == "VirtualMachine" {
+			vmRefs = append(vmRefs, ref)
+		}
+	}
+	if len(vmRefs) == 0 {
+		return nil, nil
+	}
+
+	var moVMs []mo.VirtualMachine
+	if err := pc.Retrieve(
+		vmCtx,
+		vmRefs,
+		[]string{"name", "config.managedBy", "config.instanceUuid", "runtime"},
+		&moVMs); err != nil {
+
+		return nil, err
+	}
+
+	var leftovers []mo.VirtualMachine
+	for i := range moVMs {
+		if isFailedDeployLeftover(vmCtx.VM, moVMs[i]) {
+			leftovers = append(leftovers, moVMs[i])
+		}
+	}
+
+	return leftovers, nil
+}
+
+func isFailedDeployLeftover(vm *vmopv1.VirtualMachine, moVM mo.VirtualMachine) bool {
+	// The VM may have been deployed with a suffixed name if its name conflicted
+	// with another VM in the folder.
+	if moVM.Name != vm.Name && moVM.Name != suffixVMName(vm.Name, string(vm.UID)) {
+		return false
+	}
+
+	c := moVM.Config
+	if c == nil {
+		// The config of an inaccessible VM is not available, so it is
+		// identified by its name and folder.
+		return isInaccessible(moVM)
+	}
+
+	if mb := c.ManagedBy; mb == nil ||
+		mb.ExtensionKey != vmopv1.ManagedByExtensionKey ||
+		mb.Type != vmopv1.ManagedByExtensionType {
+
+		return false
+	}
+
+	// Only the VM created by the failed deploy has the VM's instance UUID.
+	if vm.Spec.InstanceUUID != "" && c.InstanceUuid != vm.Spec.InstanceUUID {
+		return false
+	}
+
+	if isInaccessible(moVM) {
+		return true
+	}
+
+	return moVM.Runtime.PowerState == vimtypes.VirtualMachinePowerStatePoweredOff
+}
+
+func isInaccessible(moVM mo.VirtualMachine) bool {
+	switch moVM.Runtime.ConnectionState {
+	case vimtypes.VirtualMachineConnectionStateInaccessible,
+		vimtypes.VirtualMachineConnectionStateOrphaned,
+		vimtypes.VirtualMachineConnectionStateInvalid:
+		return true
+	}
+	return false
+}
+
+func removeFailedDeployLeftover(
+	vmCtx pkgctx.VirtualMachineContext,
+	vimClient *vim25.Client,
+	moVM mo.VirtualMachine) error {
+
+	obj := object.NewVirtualMachine(vimClient, moVM.Self)
+
+	if isInaccessible(moVM) {
+		return obj.Unregister(vmCtx)
+	}
+
+	task, err := obj.Destroy(vmCtx)
+	if err != nil {
+		return err
+	}
+	return task.Wait(vmCtx)
+}
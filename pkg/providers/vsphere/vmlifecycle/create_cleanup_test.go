@@ -0,0 +1,180 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+)
+
+var _ = Describe("CleanupFailedDeploy", Label(testlabels.VCSim), func() {
+	const vmName = "my-vm"
+
+	// setUp renames a simulator VM to the name of the VM that failed to
+	// deploy, powers it off, and optionally marks it as managed by VM
+	// Operator. The VM's context and the VM's folder are returned.
+	setUp := func(
+		ctx context.Context,
+		c *vim25.Client,
+		managed bool) (pkgctx.VirtualMachineContext, *object.VirtualMachine, string) {
+
+		finder := find.NewFinder(c)
+		dc, err := finder.DefaultDatacenter(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		finder.SetDatacenter(dc)
+
+		obj, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		Expect(err).ToNot(HaveOccurred())
+
+		task, err := obj.PowerOff(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(task.Wait(ctx)).To(Succeed())
+
+		configSpec := vimtypes.VirtualMachineConfigSpec{Name: vmName}
+		if managed {
+			configSpec.ManagedBy = &vimtypes.ManagedByInfo{
+				ExtensionKey: vmopv1.ManagedByExtensionKey,
+				Type:         vmopv1.ManagedByExtensionType,
+			}
+		}
+		task, err = obj.Reconfigure(ctx, configSpec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(task.Wait(ctx)).To(Succeed())
+
+		folders, err := dc.Folders(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		var moVM mo.VirtualMachine
+		Expect(obj.Properties(ctx, obj.Reference(), []string{"config.instanceUuid"}, &moVM)).To(Succeed())
+
+		vmCtx := pkgctx.VirtualMachineContext{
+			Context: ctx,
+			Logger:  suite.GetLogger(),
+			VM: &vmopv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: vmName,
+				},
+				Spec: vmopv1.VirtualMachineSpec{
+					InstanceUUID: moVM.Config.InstanceUuid,
+				},
+			},
+		}
+
+		return vmCtx, obj, folders.VmFolder.Reference().Value
+	}
+
+	exists := func(ctx context.Context, c *vim25.Client, obj *object.VirtualMachine) bool {
+		_, err := find.NewFinder(c).ObjectReference(ctx, obj.Reference())
+		return err == nil
+	}
+
+	It("removes a powered off VM managed by VM Operator", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vmCtx, obj, folderMoID := setUp(ctx, c, true)
+
+			removed, err := vmlifecycle.CleanupFailedDeploy(vmCtx, c, folderMoID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+			Expect(exists(ctx, c, obj)).To(BeFalse())
+		})
+	})
+
+	It("does not remove a VM not managed by VM Operator", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vmCtx, obj, folderMoID := setUp(ctx, c, false)
+
+			removed, err := vmlifecycle.CleanupFailedDeploy(vmCtx, c, folderMoID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeZero())
+			Expect(exists(ctx, c, obj)).To(BeTrue())
+		})
+	})
+
+	It("does not remove a VM with a different instance UUID", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vmCtx, obj, folderMoID := setUp(ctx, c, true)
+			vmCtx.VM.Spec.InstanceUUID = "not-the-instance-uuid"
+
+			removed, err := vmlifecycle.CleanupFailedDeploy(vmCtx, c, folderMoID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeZero())
+			Expect(exists(ctx, c, obj)).To(BeTrue())
+		})
+	})
+
+	It("removes a VM managed by VM Operator when the VM does not have an instance UUID", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vmCtx, obj, folderMoID := setUp(ctx, c, true)
+			vmCtx.VM.Spec.InstanceUUID = ""
+
+			removed, err := vmlifecycle.CleanupFailedDeploy(vmCtx, c, folderMoID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+			Expect(exists(ctx, c, obj)).To(BeFalse())
+		})
+	})
+
+	It("removes an inaccessible VM without a config", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vmCtx, obj, folderMoID := setUp(ctx, c, false)
+
+			simulator.Map.WithLock(
+				simulator.SpoofContext(),
+				obj.Reference(),
+				func() {
+					simVM := simulator.Map.Get(obj.Reference()).(*simulator.VirtualMachine)
+					simVM.Runtime.ConnectionState = vimtypes.VirtualMachineConnectionStateInaccessible
+					simVM.Config = nil
+				})
+
+			removed, err := vmlifecycle.CleanupFailedDeploy(vmCtx, c, folderMoID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+			Expect(exists(ctx, c, obj)).To(BeFalse())
+		})
+	})
+
+	It("does not remove a powered on VM", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vmCtx, obj, folderMoID := setUp(ctx, c, true)
+
+			task, err := obj.PowerOn(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(task.Wait(ctx)).To(Succeed())
+
+			removed, err := vmlifecycle.CleanupFailedDeploy(vmCtx, c, folderMoID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeZero())
+			Expect(exists(ctx, c, obj)).To(BeTrue())
+		})
+	})
+
+	It("does not remove a VM with a different name", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vmCtx, obj, folderMoID := setUp(ctx, c, true)
+			vmCtx.VM.Name = "other-vm"
+
+			removed, err := vmlifecycle.CleanupFailedDeploy(vmCtx, c, folderMoID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeZero())
+			Expect(exists(ctx, c, obj)).To(BeTrue())
+		})
+	})
+})
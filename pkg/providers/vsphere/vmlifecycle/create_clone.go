@@ -14,6 +14,7 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/placement"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	pkgtask "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
 )
 
 // CloneVMFromInventory creates a new VM by cloning the source VM. This is not reachable/used
@@ -44,7 +45,9 @@ func cloneVMFromInventory(
 		return nil, err
 	}
 
-	result, err := cloneTask.WaitForResult(vmCtx, nil)
+	pkgtask.Tag(vmCtx, cloneTask)
+
+	result, err := cloneTask.WaitForResult(vmCtx, createArgs.progressSinkers("Cloning")...)
 	if err != nil {
 		return nil, fmt.Errorf("clone VM task failed: %w", err)
 	}
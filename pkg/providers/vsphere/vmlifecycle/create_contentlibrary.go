@@ -7,6 +7,7 @@ package vmlifecycle
 import (
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vapi/library"
@@ -31,7 +32,7 @@ func deployOVF(
 	createArgs *CreateArgs) (*vimtypes.ManagedObjectReference, error) {
 
 	deploymentSpec := vcenter.DeploymentSpec{
-		Name:                vmCtx.VM.Name,
+		Name:                createArgs.ConfigSpec.Name,
 		StorageProfileID:    createArgs.StorageProfileID,
 		StorageProvisioning: createArgs.StorageProvisioning,
 		AcceptAllEULA:       true,
@@ -86,7 +87,7 @@ func createVM(
 		vmCtx.Logger.Error(err, "Failed to create VM")
 		return nil, err
 	}
-	taskInfo, err := task.WaitForResultEx(vmCtx)
+	taskInfo, err := task.WaitForResultEx(vmCtx, createArgs.progressSinkers("Creating")...)
 	if err != nil {
 		vmCtx.Logger.Error(err, "Task failed to create VM")
 		return nil, err
@@ -114,6 +115,82 @@ func deployVMTX(
 	return nil, fmt.Errorf("creating VM from VMTX content library type is not supported: %s", item.Name)
 }
 
+// GetZoneLibraryItem returns the item with the same name, type, and content as
+// item in the zone's content library so the VM is deployed from the library
+// that is co-located with its cluster. The original item is returned if there
+// is no such item.
+func GetZoneLibraryItem(
+	vmCtx pkgctx.VirtualMachineContext,
+	contentLibraryProvider contentlibrary.Provider,
+	item *library.Item,
+	zoneLibraryID string) *library.Item {
+
+	if zoneLibraryID == "" || zoneLibraryID == item.LibraryID {
+		return item
+	}
+
+	zoneItem, err := contentLibraryProvider.GetLibraryItem(vmCtx, zoneLibraryID, item.Name, false)
+	if err != nil {
+		vmCtx.Logger.Error(err, "Failed to find item in zone content library",
+			"itemName", item.Name, "zoneLibraryID", zoneLibraryID)
+		return item
+	}
+	if zoneItem == nil || zoneItem.Type != item.Type {
+		return item
+	}
+
+	if err := compareLibraryItemFiles(vmCtx, contentLibraryProvider, item, zoneItem); err != nil {
+		vmCtx.Logger.Info("Not deploying from the zone content library",
+			"itemID", item.ID, "zoneItemID", zoneItem.ID, "zoneLibraryID", zoneLibraryID,
+			"reason", err.Error())
+		return item
+	}
+
+	vmCtx.Logger.Info("Deploying from the zone content library",
+		"itemID", item.ID, "zoneItemID", zoneItem.ID, "zoneLibraryID", zoneLibraryID)
+	return zoneItem
+}
+
+// compareLibraryItemFiles returns an error if the files of zoneItem differ from
+// the files of item. Files are compared by name, and by size and checksum when
+// both items report them.
+func compareLibraryItemFiles(
+	vmCtx pkgctx.VirtualMachineContext,
+	contentLibraryProvider contentlibrary.Provider,
+	item, zoneItem *library.Item) error {
+
+	files, err := contentLibraryProvider.ListLibraryItemFiles(vmCtx, item.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list files of item %s: %w", item.ID, err)
+	}
+	zoneFiles, err := contentLibraryProvider.ListLibraryItemFiles(vmCtx, zoneItem.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list files of item %s: %w", zoneItem.ID, err)
+	}
+
+	zoneFilesByName := make(map[string]library.File, len(zoneFiles))
+	for _, f := range zoneFiles {
+		zoneFilesByName[f.Name] = f
+	}
+
+	for _, f := range files {
+		zf, ok := zoneFilesByName[f.Name]
+		if !ok {
+			return fmt.Errorf("file %s is missing", f.Name)
+		}
+		if f.Size != nil && zf.Size != nil && *f.Size != 0 && *zf.Size != 0 && *f.Size != *zf.Size {
+			return fmt.Errorf("file %s size %d does not match %d", f.Name, *zf.Size, *f.Size)
+		}
+		if c, zc := f.Checksum, zf.Checksum; c != nil && zc != nil &&
+			c.Checksum != "" && zc.Checksum != "" &&
+			strings.EqualFold(c.Algorithm, zc.Algorithm) && !strings.EqualFold(c.Checksum, zc.Checksum) {
+			return fmt.Errorf("file %s checksum does not match", f.Name)
+		}
+	}
+
+	return nil
+}
+
 func deployFromContentLibrary(
 	vmCtx pkgctx.VirtualMachineContext,
 	restClient *rest.Client,
@@ -131,9 +208,33 @@ func deployFromContentLibrary(
 	switch item.Type {
 	case library.ItemTypeOVF:
 		if pkgcfg.FromContext(vmCtx).Features.FastDeploy {
+			// The disks of the zone's item, if any, were already cached when
+			// the source disk paths were resolved.
 			return fastDeploy(vmCtx, vimClient, createArgs)
 		}
-		return deployOVF(vmCtx, restClient, item, createArgs)
+		item = GetZoneLibraryItem(
+			vmCtx,
+			contentLibraryProvider,
+			item,
+			createArgs.ZoneContentLibraryID)
+		// The content of an item in a subscribed library that downloads
+		// content on-demand must be synced before the item can be deployed.
+		item, err = contentLibraryProvider.SyncLibraryItemAndWait(
+			vmCtx,
+			item,
+			pkgcfg.FromContext(vmCtx).SyncLibraryItemTimeout)
+		if err != nil {
+			return nil, err
+		}
+		vmRef, err := deployOVF(vmCtx, restClient, item, createArgs)
+		if err != nil {
+			// A failed deploy may leave behind a VM that prevents the next
+			// deploy from succeeding, so try to remove it now.
+			if _, cleanupErr := CleanupFailedDeploy(vmCtx, vimClient, createArgs.FolderMoID); cleanupErr != nil {
+				vmCtx.Logger.Error(cleanupErr, "Failed to clean up after failed deploy")
+			}
+		}
+		return vmRef, err
 	case library.ItemTypeVMTX:
 		return deployVMTX(vmCtx, restClient, item, createArgs)
 	case library.ItemTypeISO:
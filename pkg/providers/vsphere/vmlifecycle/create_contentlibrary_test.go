@@ -0,0 +1,120 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle_test
+
+import (
+	"path"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/vapi/library"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/contentlibrary"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+	"github.com/vmware-tanzu/vm-operator/test/testutil"
+)
+
+var _ = Describe("GetZoneLibraryItem", Label(testlabels.VCSim), func() {
+
+	var (
+		ctx      *builder.TestContextForVCSim
+		vmCtx    pkgctx.VirtualMachineContext
+		provider contentlibrary.Provider
+		item     *library.Item
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewTestContextForVCSim(builder.VCSimTestConfig{WithContentLibrary: true})
+
+		vmCtx = pkgctx.VirtualMachineContext{
+			Context: ctx,
+			Logger:  suite.GetLogger(),
+			VM:      builder.DummyVirtualMachine(),
+		}
+
+		provider = contentlibrary.NewProvider(ctx, ctx.RestClient)
+
+		var err error
+		item, err = provider.GetLibraryItemID(ctx, ctx.ContentLibraryItemID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item).ToNot(BeNil())
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	When("there is no zone library", func() {
+		It("returns the item", func() {
+			Expect(vmlifecycle.GetZoneLibraryItem(vmCtx, provider, item, "")).To(Equal(item))
+		})
+	})
+
+	When("the zone library is the item's library", func() {
+		It("returns the item", func() {
+			Expect(vmlifecycle.GetZoneLibraryItem(vmCtx, provider, item, item.LibraryID)).To(Equal(item))
+		})
+	})
+
+	When("the zone library has an item with the same name", func() {
+		It("returns the zone library's item", func() {
+			zoneItem := vmlifecycle.GetZoneLibraryItem(vmCtx, provider, item, ctx.LocalContentLibraryID)
+			Expect(zoneItem).ToNot(BeNil())
+			Expect(zoneItem.ID).ToNot(Equal(item.ID))
+			Expect(zoneItem.LibraryID).To(Equal(ctx.LocalContentLibraryID))
+			Expect(zoneItem.Name).To(Equal(item.Name))
+		})
+	})
+
+	When("the zone library has an item with the same name but different content", func() {
+		var zoneLibID string
+
+		BeforeEach(func() {
+			libMgr := library.NewManager(ctx.RestClient)
+
+			var err error
+			zoneLibID, err = libMgr.CreateLibrary(ctx, library.Library{
+				Name: "vmop-zone",
+				Type: "LOCAL",
+				Storage: []library.StorageBacking{
+					{
+						DatastoreID: ctx.Datastore.Reference().Value,
+						Type:        "DATASTORE",
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(builder.CreateContentLibraryItem(
+				ctx,
+				libMgr,
+				library.Item{
+					Name:      item.Name,
+					Type:      item.Type,
+					LibraryID: zoneLibID,
+				},
+				path.Join(
+					testutil.GetRootDirOrDie(),
+					"test", "builder", "testdata",
+					"images", "ttylinux-pc_i486-16.1.iso"),
+			)).ToNot(BeEmpty())
+		})
+
+		It("returns the item", func() {
+			Expect(vmlifecycle.GetZoneLibraryItem(vmCtx, provider, item, zoneLibID)).To(Equal(item))
+		})
+	})
+
+	When("the zone library does not exist", func() {
+		It("returns the item", func() {
+			Expect(vmlifecycle.GetZoneLibraryItem(vmCtx, provider, item, "does-not-exist")).To(Equal(item))
+		})
+	})
+})
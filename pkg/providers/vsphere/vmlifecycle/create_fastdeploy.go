@@ -22,6 +22,7 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	pkgtask "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
 )
 
 func fastDeploy(
@@ -300,6 +301,8 @@ func fastDeployCreateVM(
 		return nil, fmt.Errorf("failed to call create task: %w", err)
 	}
 
+	pkgtask.Tag(ctx, createTask)
+
 	createTaskInfo, err := createTask.WaitForResult(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to wait for create task: %w", err)
@@ -0,0 +1,116 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle
+
+import (
+	"fmt"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+)
+
+const (
+	// maxVMNameLength is the maximum length of a vSphere VM's name.
+	maxVMNameLength = 80
+
+	// vmNameSuffixLength is the length of the hash appended to the name of a
+	// VM when its name conflicts with an existing VM.
+	vmNameSuffixLength = 8
+)
+
+// ResolveVMName returns the name to use for the vSphere VM created for the VM
+// in the specified folder. If a VM with the same name already exists in the
+// folder, the conflict is resolved according to the VMNameConflictPolicy:
+//
+//   - "fail" returns an error.
+//   - "suffix" returns the name suffixed with a hash of the VM's UID, which
+//     is stable across reconciles. An error is returned if a VM with the
+//     suffixed name also exists in the folder.
+func ResolveVMName(
+	vmCtx pkgctx.VirtualMachineContext,
+	vimClient *vim25.Client,
+	folderMoID, name string) (string, error) {
+
+	if folderMoID == "" {
+		return name, nil
+	}
+
+	names, err := getFolderVMNames(vmCtx, vimClient, folderMoID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get names of VMs in folder %s: %w", folderMoID, err)
+	}
+
+	if _, ok := names[name]; !ok {
+		return name, nil
+	}
+
+	if pkgcfg.FromContext(vmCtx).VMNameConflictPolicy != pkgconst.VMNameConflictPolicySuffix {
+		return "", fmt.Errorf("a VM named %q already exists in folder %s", name, folderMoID)
+	}
+
+	suffixedName := suffixVMName(name, string(vmCtx.VM.UID))
+	if _, ok := names[suffixedName]; ok {
+		return "", fmt.Errorf("VMs named %q and %q already exist in folder %s",
+			name, suffixedName, folderMoID)
+	}
+
+	vmCtx.Logger.Info("Resolved VM name conflict",
+		"folder", folderMoID, "name", name, "resolvedName", suffixedName)
+
+	return suffixedName, nil
+}
+
+// suffixVMName returns the name suffixed with a hash of the UID, truncating
+// the name as needed so the result fits within maxVMNameLength.
+func suffixVMName(name, uid string) string {
+	suffix := "-" + pkgutil.SHA1Sum17(uid)[:vmNameSuffixLength]
+	if n := maxVMNameLength - len(suffix); len(name) > n {
+		name = name[:n]
+	}
+	return name + suffix
+}
+
+func getFolderVMNames(
+	vmCtx pkgctx.VirtualMachineContext,
+	vimClient *vim25.Client,
+	folderMoID string) (map[string]struct{}, error) {
+
+	folderRef := vimtypes.ManagedObjectReference{Type: "Folder", Value: folderMoID}
+
+	var moFolder mo.Folder
+	pc := property.DefaultCollector(vimClient)
+	if err := pc.RetrieveOne(vmCtx, folderRef, []string{"childEntity"}, &moFolder); err != nil {
+		return nil, err
+	}
+
+	var vmRefs []vimtypes.ManagedObjectReference
+	for _, ref := range moFolder.ChildEntity {
+		if ref.Type == "VirtualMachine" {
+			vmRefs = append(vmRefs, ref)
+		}
+	}
+	if len(vmRefs) == 0 {
+		return nil, nil
+	}
+
+	var moVMs []mo.VirtualMachine
+	if err := pc.Retrieve(vmCtx, vmRefs, []string{"name"}, &moVMs); err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]struct{}, len(moVMs))
+	for i := range moVMs {
+		names[moVMs[i].Name] = struct{}{}
+	}
+
+	return names, nil
+}
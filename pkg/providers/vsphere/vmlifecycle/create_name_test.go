@@ -0,0 +1,115 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+)
+
+var _ = Describe("ResolveVMName", Label(testlabels.VCSim), func() {
+	// The name of a VM in the simulator's VM folder.
+	const existingVMName = "DC0_H0_VM0"
+
+	setUp := func(
+		ctx context.Context,
+		c *vim25.Client,
+		policy string) (pkgctx.VirtualMachineContext, string) {
+
+		finder := find.NewFinder(c)
+		dc, err := finder.DefaultDatacenter(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		folders, err := dc.Folders(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = pkgcfg.WithContext(ctx, pkgcfg.Config{VMNameConflictPolicy: policy})
+
+		vmCtx := pkgctx.VirtualMachineContext{
+			Context: ctx,
+			Logger:  suite.GetLogger(),
+			VM: &vmopv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: existingVMName,
+					UID:  "my-vm-uid",
+				},
+			},
+		}
+
+		return vmCtx, folders.VmFolder.Reference().Value
+	}
+
+	It("returns the name when there is no conflict", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vmCtx, folderMoID := setUp(ctx, c, pkgconst.VMNameConflictPolicyFail)
+
+			name, err := vmlifecycle.ResolveVMName(vmCtx, c, folderMoID, "my-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("my-vm"))
+		})
+	})
+
+	It("returns an error on conflict when the policy is fail", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vmCtx, folderMoID := setUp(ctx, c, pkgconst.VMNameConflictPolicyFail)
+
+			_, err := vmlifecycle.ResolveVMName(vmCtx, c, folderMoID, existingVMName)
+			Expect(err).To(MatchError(ContainSubstring("already exists")))
+		})
+	})
+
+	It("returns a stable suffixed name on conflict when the policy is suffix", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vmCtx, folderMoID := setUp(ctx, c, pkgconst.VMNameConflictPolicySuffix)
+
+			name, err := vmlifecycle.ResolveVMName(vmCtx, c, folderMoID, existingVMName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(HavePrefix(existingVMName + "-"))
+			Expect(name).To(HaveLen(len(existingVMName) + 9))
+
+			name2, err := vmlifecycle.ResolveVMName(vmCtx, c, folderMoID, existingVMName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name2).To(Equal(name))
+		})
+	})
+
+	It("truncates the suffixed name to the maximum VM name length", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			vmCtx, folderMoID := setUp(ctx, c, pkgconst.VMNameConflictPolicySuffix)
+
+			longName := strings.Repeat("a", 80)
+			name, err := vmlifecycle.ResolveVMName(vmCtx, c, folderMoID, longName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal(longName))
+
+			finder := find.NewFinder(c)
+			obj, err := finder.VirtualMachine(ctx, existingVMName)
+			Expect(err).ToNot(HaveOccurred())
+			task, err := obj.Rename(ctx, longName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(task.Wait(ctx)).To(Succeed())
+
+			name, err = vmlifecycle.ResolveVMName(vmCtx, c, folderMoID, longName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(HaveLen(80))
+			Expect(name).To(HavePrefix(strings.Repeat("a", 71) + "-"))
+		})
+	})
+})
@@ -0,0 +1,108 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+)
+
+// GetPowerStateAttempts returns the number of consecutive, failed attempts to
+// change the VM's power state to its desired power state. Attempts recorded
+// for a different desired power state are not counted.
+func GetPowerStateAttempts(vm *vmopv1.VirtualMachine) int {
+	n, _ := getPowerStateAttempts(vm)
+	return n
+}
+
+// PowerStateAttemptRemaining returns how long to wait before the next attempt
+// to change the VM's power state to its desired power state, so the attempts
+// are at least delay apart. Zero is returned if there is no need to wait.
+func PowerStateAttemptRemaining(vm *vmopv1.VirtualMachine, delay time.Duration) time.Duration {
+	n, lastAttempt := getPowerStateAttempts(vm)
+	if n == 0 || lastAttempt.IsZero() {
+		return 0
+	}
+	return max(time.Until(lastAttempt.Add(delay)), 0)
+}
+
+func getPowerStateAttempts(vm *vmopv1.VirtualMachine) (int, time.Time) {
+	v, ok := vm.Annotations[pkgconst.PowerStateAttemptsAnnotationKey]
+	if !ok {
+		return 0, time.Time{}
+	}
+	parts := strings.SplitN(v, "/", 3)
+	if len(parts) < 2 || parts[0] != string(vm.Spec.PowerState) {
+		return 0, time.Time{}
+	}
+	n, err := strconv.Atoi(parts[1])
+	if err != nil || n < 0 {
+		return 0, time.Time{}
+	}
+	var lastAttempt time.Time
+	if len(parts) == 3 {
+		// An invalid time does not delay the next attempt.
+		lastAttempt, _ = time.Parse(time.RFC3339, parts[2])
+	}
+	return n, lastAttempt
+}
+
+// IsPowerStateChangeExhausted returns true if the attempts to change the VM's
+// power state to its desired power state exhausted the provided retry budget.
+// A maxAttempts value of zero means the budget is never exhausted.
+func IsPowerStateChangeExhausted(vm *vmopv1.VirtualMachine, maxAttempts int) bool {
+	return maxAttempts > 0 && GetPowerStateAttempts(vm) >= maxAttempts
+}
+
+// RecordPowerStateAttempt records a failed attempt to change the VM's power
+// state to its desired power state, and updates the VM's PowerStateSynced
+// condition with the provided error. True is returned if the attempt
+// exhausted the retry budget, i.e. the failure is terminal.
+func RecordPowerStateAttempt(
+	vm *vmopv1.VirtualMachine,
+	maxAttempts int,
+	err error) bool {
+
+	attempts := GetPowerStateAttempts(vm) + 1
+
+	if vm.Annotations == nil {
+		vm.Annotations = map[string]string{}
+	}
+	vm.Annotations[pkgconst.PowerStateAttemptsAnnotationKey] =
+		fmt.Sprintf("%s/%d/%s", vm.Spec.PowerState, attempts, time.Now().UTC().Format(time.RFC3339))
+
+	if maxAttempts > 0 && attempts >= maxAttempts {
+		conditions.MarkFalse(
+			vm,
+			vmopv1.VirtualMachinePowerStateSyncedCondition,
+			vmopv1.VirtualMachinePowerStateChangeFailedReason,
+			"Failed to change power state to %s after %d attempts: %v",
+			vm.Spec.PowerState, attempts, err)
+		return true
+	}
+
+	conditions.MarkFalse(
+		vm,
+		vmopv1.VirtualMachinePowerStateSyncedCondition,
+		vmopv1.VirtualMachinePowerStateChangePendingReason,
+		"Attempt %d to change power state to %s failed: %v",
+		attempts, vm.Spec.PowerState, err)
+
+	return false
+}
+
+// MarkPowerStateSynced resets the VM's power state retry budget and removes
+// the VM's PowerStateSynced condition once the VM's observed power state
+// matches its desired power state.
+func MarkPowerStateSynced(vm *vmopv1.VirtualMachine) {
+	delete(vm.Annotations, pkgconst.PowerStateAttemptsAnnotationKey)
+	conditions.Delete(vm, vmopv1.VirtualMachinePowerStateSyncedCondition)
+}
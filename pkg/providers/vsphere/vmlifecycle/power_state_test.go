@@ -0,0 +1,136 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+)
+
+var _ = Describe("PowerState retry budget", func() {
+	var (
+		vm     *vmopv1.VirtualMachine
+		errGOS = errors.New("guest refused to shut down")
+	)
+
+	BeforeEach(func() {
+		vm = &vmopv1.VirtualMachine{
+			Spec: vmopv1.VirtualMachineSpec{
+				PowerState: vmopv1.VirtualMachinePowerStateOff,
+			},
+		}
+	})
+
+	Context("GetPowerStateAttempts", func() {
+		DescribeTable("returns the attempts for the desired power state",
+			func(annotation string, expected int) {
+				vm.Annotations = map[string]string{
+					pkgconst.PowerStateAttemptsAnnotationKey: annotation,
+				}
+				Expect(vmlifecycle.GetPowerStateAttempts(vm)).To(Equal(expected))
+			},
+			Entry("matching power state", "PoweredOff/3", 3),
+			Entry("matching power state with time", "PoweredOff/3/2025-01-01T00:00:00Z", 3),
+			Entry("different power state", "PoweredOn/3", 0),
+			Entry("missing attempts", "PoweredOff", 0),
+			Entry("invalid attempts", "PoweredOff/x", 0),
+			Entry("negative attempts", "PoweredOff/-1", 0),
+		)
+
+		It("returns zero without the annotation", func() {
+			Expect(vmlifecycle.GetPowerStateAttempts(vm)).To(BeZero())
+		})
+	})
+
+	Context("RecordPowerStateAttempt", func() {
+		It("marks the change pending until the budget is exhausted", func() {
+			Expect(vmlifecycle.RecordPowerStateAttempt(vm, 2, errGOS)).To(BeFalse())
+			Expect(vm.Annotations).To(HaveKeyWithValue(pkgconst.PowerStateAttemptsAnnotationKey, HavePrefix("PoweredOff/1/")))
+			Expect(vmlifecycle.IsPowerStateChangeExhausted(vm, 2)).To(BeFalse())
+
+			c := conditions.Get(vm, vmopv1.VirtualMachinePowerStateSyncedCondition)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachinePowerStateChangePendingReason))
+			Expect(c.Message).To(Equal("Attempt 1 to change power state to PoweredOff failed: guest refused to shut down"))
+
+			Expect(vmlifecycle.RecordPowerStateAttempt(vm, 2, errGOS)).To(BeTrue())
+			Expect(vm.Annotations).To(HaveKeyWithValue(pkgconst.PowerStateAttemptsAnnotationKey, HavePrefix("PoweredOff/2/")))
+			Expect(vmlifecycle.IsPowerStateChangeExhausted(vm, 2)).To(BeTrue())
+
+			c = conditions.Get(vm, vmopv1.VirtualMachinePowerStateSyncedCondition)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachinePowerStateChangeFailedReason))
+			Expect(c.Message).To(Equal("Failed to change power state to PoweredOff after 2 attempts: guest refused to shut down"))
+		})
+
+		It("never exhausts the budget when the maximum attempts is zero", func() {
+			for i := 0; i < 10; i++ {
+				Expect(vmlifecycle.RecordPowerStateAttempt(vm, 0, errGOS)).To(BeFalse())
+			}
+			Expect(vmlifecycle.IsPowerStateChangeExhausted(vm, 0)).To(BeFalse())
+		})
+
+		It("resets the budget when the desired power state changes", func() {
+			Expect(vmlifecycle.RecordPowerStateAttempt(vm, 1, errGOS)).To(BeTrue())
+			vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateSuspended
+			Expect(vmlifecycle.IsPowerStateChangeExhausted(vm, 1)).To(BeFalse())
+		})
+	})
+
+	Context("PowerStateAttemptRemaining", func() {
+		It("does not wait without a failed attempt", func() {
+			Expect(vmlifecycle.PowerStateAttemptRemaining(vm, time.Minute)).To(BeZero())
+		})
+
+		It("waits for the delay after a failed attempt", func() {
+			Expect(vmlifecycle.RecordPowerStateAttempt(vm, 2, errGOS)).To(BeFalse())
+			remaining := vmlifecycle.PowerStateAttemptRemaining(vm, time.Minute)
+			Expect(remaining).To(BeNumerically(">", 50*time.Second))
+			Expect(remaining).To(BeNumerically("<=", time.Minute))
+		})
+
+		It("does not wait once the delay has elapsed", func() {
+			vm.Annotations = map[string]string{
+				pkgconst.PowerStateAttemptsAnnotationKey: "PoweredOff/1/" +
+					time.Now().Add(-2*time.Minute).UTC().Format(time.RFC3339),
+			}
+			Expect(vmlifecycle.PowerStateAttemptRemaining(vm, time.Minute)).To(BeZero())
+		})
+
+		It("does not wait after an attempt for a different power state", func() {
+			Expect(vmlifecycle.RecordPowerStateAttempt(vm, 2, errGOS)).To(BeFalse())
+			vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+			Expect(vmlifecycle.PowerStateAttemptRemaining(vm, time.Minute)).To(BeZero())
+		})
+
+		It("does not wait after an attempt without a time", func() {
+			vm.Annotations = map[string]string{
+				pkgconst.PowerStateAttemptsAnnotationKey: "PoweredOff/1",
+			}
+			Expect(vmlifecycle.PowerStateAttemptRemaining(vm, time.Minute)).To(BeZero())
+		})
+	})
+
+	Context("MarkPowerStateSynced", func() {
+		It("removes the annotation and condition", func() {
+			Expect(vmlifecycle.RecordPowerStateAttempt(vm, 1, errGOS)).To(BeTrue())
+			vmlifecycle.MarkPowerStateSynced(vm)
+			Expect(vm.Annotations).ToNot(HaveKey(pkgconst.PowerStateAttemptsAnnotationKey))
+			Expect(conditions.Get(vm, vmopv1.VirtualMachinePowerStateSyncedCondition)).To(BeNil())
+		})
+	})
+})
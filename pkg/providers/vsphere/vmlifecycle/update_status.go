@@ -35,6 +35,7 @@ import (
 	vmoprecord "github.com/vmware-tanzu/vm-operator/pkg/record"
 	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	"github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/deployhook"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
@@ -85,7 +86,6 @@ func UpdateStatus(
 	}
 
 	var (
-		err     error
 		errs    []error
 		summary = vmCtx.MoVM.Summary
 	)
@@ -96,6 +96,7 @@ func UpdateStatus(
 	vm.Status.InstanceUUID = summary.Config.InstanceUuid
 	hardwareVersion, _ := vimtypes.ParseHardwareVersion(summary.Config.HwVersion)
 	vm.Status.HardwareVersion = int32(hardwareVersion)
+	updateUpgradeRecommendedCondition(vmCtx, vm)
 	updateGuestNetworkStatus(vmCtx.VM, vmCtx.MoVM.Guest)
 	updateStorageStatus(vmCtx.VM, vmCtx.MoVM)
 
@@ -103,8 +104,7 @@ func UpdateStatus(
 		updateProbeStatus(vmCtx, vm, vmCtx.MoVM)
 	}
 
-	vm.Status.Host, err = getRuntimeHostHostname(vmCtx, vcVM, summary.Runtime.Host)
-	if err != nil {
+	if err := updateRuntimeHostStatus(vmCtx, vcVM, summary.Runtime.Host); err != nil {
 		errs = append(errs, err)
 	}
 
@@ -142,15 +142,52 @@ func UpdateStatus(
 	return apierrorsutil.NewAggregate(errs)
 }
 
-func getRuntimeHostHostname(
-	ctx context.Context,
+// updateRuntimeHostStatus updates the VM's host in its status, and the
+// HostReady condition to reflect whether the host is in maintenance mode,
+// disconnected, or not responding so external tooling may react, ex. by
+// migrating the workload.
+func updateRuntimeHostStatus(
+	vmCtx pkgctx.VirtualMachineContext,
 	vcVM *object.VirtualMachine,
-	host *vimtypes.ManagedObjectReference) (string, error) {
+	host *vimtypes.ManagedObjectReference) error {
+
+	vm := vmCtx.VM
 
-	if host != nil {
-		return object.NewHostSystem(vcVM.Client(), *host).ObjectName(ctx)
+	if host == nil {
+		vm.Status.Host = ""
+		conditions.Delete(vm, vmopv1.VirtualMachineConditionHostReady)
+		return nil
 	}
-	return "", nil
+
+	var moHost mo.HostSystem
+	if err := object.NewHostSystem(vcVM.Client(), *host).Properties(
+		vmCtx, *host, []string{"name", "runtime"}, &moHost); err != nil {
+
+		vm.Status.Host = ""
+		return err
+	}
+
+	vm.Status.Host = moHost.Name
+
+	if reason := vcenter.HostUnavailableReason(moHost.Runtime); reason != "" {
+		cond := conditions.FalseCondition(
+			vmopv1.VirtualMachineConditionHostReady,
+			reason,
+			"Host %s is unavailable",
+			moHost.Name)
+
+		// Emit an event when the host becomes unavailable.
+		if c := conditions.Get(vm, cond.Type); c == nil || c.Reason != cond.Reason {
+			vmoprecord.FromContext(vmCtx).Eventf(vm, cond.Reason, cond.Message)
+			vmCtx.Logger.Info("VM host is unavailable", "host", moHost.Name, "reason", reason)
+		}
+
+		conditions.Set(vm, cond)
+	} else {
+		conditions.MarkTrue(vm, vmopv1.VirtualMachineConditionHostReady)
+	}
+
+	return nil
 }
 
 func guestNicInfoToInterfaceStatus(
@@ -739,6 +776,31 @@ func updateGuestNetworkStatus(vm *vmopv1.VirtualMachine, gi *vimtypes.GuestInfo)
 	}
 }
 
+// updateUpgradeRecommendedCondition sets the UpgradeRecommended condition if
+// the VM's hardware version is older than the minimum supported hardware
+// version, ex. because the VM was created before the minimum was raised.
+// Otherwise the condition is removed.
+func updateUpgradeRecommendedCondition(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine) {
+
+	minVersion := int32(pkgcfg.FromContext(ctx).MinSupportedHardwareVersion)
+	if minVersion <= 0 || vm.Status.HardwareVersion == 0 || vm.Status.HardwareVersion >= minVersion {
+		conditions.Delete(vm, vmopv1.VirtualMachineConditionUpgradeRecommended)
+		return
+	}
+
+	conditions.Set(vm, &metav1.Condition{
+		Type:   vmopv1.VirtualMachineConditionUpgradeRecommended,
+		Status: metav1.ConditionTrue,
+		Reason: vmopv1.VirtualMachineHardwareVersionBelowMinimumReason,
+		Message: fmt.Sprintf(
+			"hardware version %d is older than the minimum supported hardware version %d; "+
+				"set spec.minHardwareVersion to %d to upgrade the VM the next time it is powered off",
+			vm.Status.HardwareVersion, minVersion, minVersion),
+	})
+}
+
 // updateStorageStatus updates the status for all storage-related fields.
 func updateStorageStatus(vm *vmopv1.VirtualMachine, moVM mo.VirtualMachine) {
 	updateChangeBlockTracking(vm, moVM)
@@ -998,6 +1060,9 @@ func updateProbeStatus(
 			vmopv1.ReadyConditionType, probeReasonUnknown, resultMsg)
 	}
 
+	// The VM is not ready until its deploy hooks succeed.
+	cond = deployhook.GateReadyCondition(vm, cond)
+
 	// Emit event whe the condition is added or its status changes.
 	if c := conditions.Get(vm, cond.Type); c == nil || c.Status != cond.Status {
 		recorder := vmoprecord.FromContext(ctx)
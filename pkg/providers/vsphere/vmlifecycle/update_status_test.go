@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vim25/mo"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -109,6 +110,60 @@ var _ = Describe("UpdateStatus", func() {
 		})
 	})
 
+	Context("Host", func() {
+		It("sets the host and the HostReady condition", func() {
+			Expect(vmCtx.VM.Status.Host).ToNot(BeEmpty())
+			Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachineConditionHostReady)).To(BeTrue())
+		})
+
+		When("the host is in maintenance mode", func() {
+			var chanRecord chan string
+
+			BeforeEach(func() {
+				chanRecord = make(chan string, 10)
+				vmCtx.Context = record.WithContext(
+					vmCtx.Context,
+					record.New(&apirecord.FakeRecorder{Events: chanRecord}))
+
+				hostRef := *vmCtx.MoVM.Summary.Runtime.Host
+				simulator.Map.WithLock(
+					simulator.SpoofContext(),
+					hostRef,
+					func() {
+						host := simulator.Map.Get(hostRef).(*simulator.HostSystem)
+						host.Runtime.InMaintenanceMode = true
+					})
+			})
+
+			It("marks the HostReady condition false and emits an event", func() {
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionHostReady)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineHostInMaintenanceModeReason))
+				Expect(c.Message).To(ContainSubstring(vmCtx.VM.Status.Host))
+
+				Expect(chanRecord).To(Receive(ContainSubstring(vmopv1.VirtualMachineHostInMaintenanceModeReason)))
+
+				By("not emitting another event when the host remains unavailable", func() {
+					Expect(vmlifecycle.UpdateStatus(vmCtx, ctx.Client, vcVM)).To(Succeed())
+					Expect(chanRecord).ToNot(Receive())
+				})
+			})
+		})
+
+		When("the VM has no host", func() {
+			BeforeEach(func() {
+				vmCtx.MoVM.Summary.Runtime.Host = nil
+				conditions.MarkTrue(vmCtx.VM, vmopv1.VirtualMachineConditionHostReady)
+			})
+
+			It("clears the host and the HostReady condition", func() {
+				Expect(vmCtx.VM.Status.Host).To(BeEmpty())
+				Expect(conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionHostReady)).To(BeNil())
+			})
+		})
+	})
+
 	Context("Network", func() {
 
 		Context("PrimaryIP", func() {
@@ -1384,6 +1439,38 @@ var _ = Describe("UpdateStatus", func() {
 			Expect(status.InstanceUUID).To(Equal(instanceUUID))
 			Expect(status.HardwareVersion).To(Equal(int32(19)))
 		})
+
+		It("does not set the UpgradeRecommended condition", func() {
+			Expect(conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionUpgradeRecommended)).To(BeNil())
+		})
+
+		When("the hardware version is older than the minimum supported hardware version", func() {
+			BeforeEach(func() {
+				pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+					config.MinSupportedHardwareVersion = 20
+				})
+			})
+
+			It("sets the UpgradeRecommended condition", func() {
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionUpgradeRecommended)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionTrue))
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineHardwareVersionBelowMinimumReason))
+			})
+		})
+
+		When("the hardware version is the minimum supported hardware version", func() {
+			BeforeEach(func() {
+				pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+					config.MinSupportedHardwareVersion = 19
+				})
+				conditions.MarkTrue(vmCtx.VM, vmopv1.VirtualMachineConditionUpgradeRecommended)
+			})
+
+			It("removes the UpgradeRecommended condition", func() {
+				Expect(conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConditionUpgradeRecommended)).To(BeNil())
+			})
+		})
 	})
 
 	Context("Misc Status fields", func() {
@@ -8,16 +8,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math/rand"
-	"strings"
+	"net/url"
+	"path"
 	"sync"
 	"sync/atomic"
 
 	"github.com/go-logr/logr"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/ovf"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/session"
 	"github.com/vmware/govmomi/task"
 	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vim25/mo"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,6 +48,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/util"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
 	vsclient "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
+	pkgtask "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
 )
 
 const (
@@ -64,17 +68,62 @@ type vSphereVMProvider struct {
 
 	vcClientLock sync.Mutex
 	vcClient     *vcclient.Client
+
+	// getProviderConfigFn returns the configuration used to connect to
+	// vSphere, including its endpoint and credentials.
+	getProviderConfigFn ProviderConfigFn
+
+	// rpOwners caches the ClusterComputeResource that owns a ResourcePool,
+	// keyed by the ResourcePool's MoID. It is cleared when the vcClient is.
+	rpOwners sync.Map
+
+	// zoneReservationsLock serializes the enforcement of the maximum number of
+	// VMs per zone, and guards zoneReservations.
+	zoneReservationsLock sync.Mutex
+
+	// zoneReservations is the zone each VM was placed in, keyed by the VM's
+	// namespace and name. A reservation counts towards the zone's VMs until
+	// the VM's zone label is in the cache, or the VM is deleted.
+	zoneReservations map[ctrlclient.ObjectKey]string
 }
 
+// ProviderConfigFn returns the configuration used by the provider to connect
+// to vSphere.
+type ProviderConfigFn func(
+	ctx context.Context,
+	client ctrlclient.Client) (*vcconfig.VSphereVMProviderConfig, error)
+
 func NewVSphereVMProviderFromClient(
 	ctx context.Context,
 	client ctrlclient.Client,
 	recorder record.Recorder) providers.VirtualMachineProviderInterface {
 
+	return NewVSphereVMProviderFromClientWithConfigFn(
+		ctx,
+		client,
+		recorder,
+		vcconfig.GetProviderConfig)
+}
+
+// NewVSphereVMProviderFromClientWithConfigFn returns a new vSphere provider
+// that gets the vSphere endpoint and credentials from configFn instead of the
+// provider ConfigMap. This allows the provider to be pointed at a simulated
+// vSphere environment.
+func NewVSphereVMProviderFromClientWithConfigFn(
+	ctx context.Context,
+	client ctrlclient.Client,
+	recorder record.Recorder,
+	configFn ProviderConfigFn) providers.VirtualMachineProviderInterface {
+
+	if configFn == nil {
+		configFn = vcconfig.GetProviderConfig
+	}
+
 	p := &vSphereVMProvider{
-		k8sClient:         client,
-		eventRecorder:     recorder,
-		globalExtraConfig: getExtraConfig(ctx),
+		k8sClient:           client,
+		eventRecorder:       recorder,
+		globalExtraConfig:   getExtraConfig(ctx),
+		getProviderConfigFn: configFn,
 	}
 
 	ovfcache.SetGetter(ctx, p.getOvfEnvelope)
@@ -112,14 +161,14 @@ func (vs *vSphereVMProvider) getVcClient(ctx context.Context) (*vcclient.Client,
 		return vs.vcClient, nil
 	}
 
-	config, err := vcconfig.GetProviderConfig(ctx, vs.k8sClient)
+	config, err := vs.getProviderConfigFn(ctx, vs.k8sClient)
 	if err != nil {
 		return nil, err
 	}
 
 	vcClient, err := vcclient.NewClient(ctx, config)
 	if err != nil {
-		return nil, err
+		return nil, providers.NewError(providers.ErrorReasonVCUnavailable, err)
 	}
 
 	vs.vcClient = vcClient
@@ -147,6 +196,7 @@ func (vs *vSphereVMProvider) clearAndLogoutVcClient(ctx context.Context) {
 	vs.vcClientLock.Lock()
 	vcClient := vs.vcClient
 	vs.vcClient = nil
+	vs.rpOwners.Clear()
 	vs.vcClientLock.Unlock()
 
 	if vcClient != nil {
@@ -192,7 +242,11 @@ func (vs *vSphereVMProvider) SyncVirtualMachineImage(
 		return nil
 	}
 
-	if pkgcfg.FromContext(ctx).Features.FastDeploy {
+	// Fast-deploy caches the image's disks on vSphere, so only the image's OVF
+	// is synced in observer mode.
+	if pkgcfg.FromContext(ctx).Features.FastDeploy &&
+		!pkgcfg.FromContext(ctx).ObserverMode {
+
 		return vs.syncVirtualMachineImageFastDeploy(ctx, vmi, logger, itemID, itemVersion)
 	}
 	return vs.syncVirtualMachineImage(ctx, vmi, itemID, itemVersion)
@@ -330,6 +384,10 @@ func (vs *vSphereVMProvider) GetItemFromLibraryByName(ctx context.Context,
 func (vs *vSphereVMProvider) UpdateContentLibraryItem(ctx context.Context, itemID, newName string, newDescription *string) error {
 	log.V(4).Info("Update Content Library Item", "itemID", itemID)
 
+	if pkgcfg.FromContext(ctx).ObserverMode {
+		return providers.ErrObserverMode
+	}
+
 	client, err := vs.getVcClient(ctx)
 	if err != nil {
 		return err
@@ -339,16 +397,51 @@ func (vs *vSphereVMProvider) UpdateContentLibraryItem(ctx context.Context, itemI
 	return contentLibraryProvider.UpdateLibraryItem(ctx, itemID, newName, newDescription)
 }
 
-func (vs *vSphereVMProvider) getOpID(vm *vmopv1.VirtualMachine, operation string) string {
-	const charset = "0123456789abcdef"
+func (vs *vSphereVMProvider) ImportContentLibraryItem(
+	ctx context.Context,
+	contentLibrary, itemName, sourceURL, sha256 string) error {
+
+	log.V(4).Info("Import Content Library Item",
+		"UUID", contentLibrary, "item name", itemName, "url", sourceURL)
 
-	id := make([]byte, 8)
-	for i := range id {
-		idx := rand.Intn(len(charset)) //nolint:gosec
-		id[i] = charset[idx]
+	if pkgcfg.FromContext(ctx).ObserverMode {
+		return providers.ErrObserverMode
 	}
 
-	return strings.Join([]string{"vmoperator", vm.Name, operation, string(id)}, "-")
+	u, err := url.Parse(sourceURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse url %q: %w", sourceURL, err)
+	}
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	contentLibraryProvider := contentlibrary.NewProvider(ctx, client.RestClient())
+	_, err = contentLibraryProvider.ImportLibraryItem(
+		ctx,
+		library.Item{
+			Name:      itemName,
+			Type:      library.ItemTypeOVF,
+			LibraryID: contentLibrary,
+		},
+		[]contentlibrary.ImportFile{
+			{
+				Name:   path.Base(u.Path),
+				URL:    sourceURL,
+				SHA256: sha256,
+			},
+		})
+	return err
+}
+
+func (vs *vSphereVMProvider) getOpID(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	operation string) string {
+
+	return pkgtask.NewOperationID(ctx, vm.Namespace, vm.Name, string(vm.UID), operation)
 }
 
 func (vs *vSphereVMProvider) getVM(
@@ -478,6 +571,102 @@ func (vs *vSphereVMProvider) GetTasksByActID(ctx context.Context, actID string)
 	return taskList, nil
 }
 
+func (vs *vSphereVMProvider) CancelTask(
+	ctx context.Context,
+	taskRef vimtypes.ManagedObjectReference) error {
+
+	if pkgcfg.FromContext(ctx).ObserverMode {
+		return providers.ErrObserverMode
+	}
+
+	vcClient, err := vs.getVcClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	return object.NewTask(vcClient.VimClient(), taskRef).Cancel(ctx)
+}
+
+func (vs *vSphereVMProvider) Diagnostics(
+	ctx context.Context) (providers.Diagnostics, error) {
+
+	vs.vcClientLock.Lock()
+	vcClient := vs.vcClient
+	diag := providers.Diagnostics{
+		MinCPUFrequency: atomic.LoadUint64(&vs.minCPUFreq),
+	}
+	vs.rpOwners.Range(func(_, _ any) bool {
+		diag.CachedResourcePoolOwners++
+		return true
+	})
+	vs.vcClientLock.Unlock()
+
+	if vcClient == nil {
+		return diag, nil
+	}
+
+	vimClient := vcClient.VimClient()
+	diag.Session.Cached = true
+	diag.Session.Host = vimClient.URL().Host
+
+	userSession, err := session.NewManager(vimClient).UserSession(ctx)
+	if err != nil {
+		return diag, fmt.Errorf("failed to get user session: %w", err)
+	}
+	if userSession == nil {
+		return diag, nil
+	}
+	diag.Session.Active = true
+
+	pc := property.DefaultCollector(vimClient)
+
+	var taskManager mo.TaskManager
+	if err := pc.RetrieveOne(
+		ctx,
+		*vimClient.ServiceContent.TaskManager,
+		[]string{"recentTask"},
+		&taskManager); err != nil {
+
+		return diag, fmt.Errorf("failed to get recent tasks: %w", err)
+	}
+	if len(taskManager.RecentTask) == 0 {
+		return diag, nil
+	}
+
+	var tasks []mo.Task
+	if err := pc.Retrieve(
+		ctx,
+		taskManager.RecentTask,
+		[]string{"info"},
+		&tasks); err != nil {
+
+		return diag, fmt.Errorf("failed to get recent task info: %w", err)
+	}
+
+	for i := range tasks {
+		info := tasks[i].Info
+		if info.State != vimtypes.TaskInfoStateQueued &&
+			info.State != vimtypes.TaskInfoStateRunning {
+			continue
+		}
+
+		td := providers.TaskDiagnostics{
+			ID:          info.Key,
+			Description: info.DescriptionId,
+			State:       string(info.State),
+			Progress:    info.Progress,
+			QueueTime:   info.QueueTime,
+			StartTime:   info.StartTime,
+		}
+		if info.Entity != nil {
+			td.Entity = info.Entity.String()
+		}
+		diag.InFlightTasks = append(diag.InFlightTasks, td)
+	}
+
+	return diag, nil
+}
+
 func (vs *vSphereVMProvider) DoesProfileSupportEncryption(
 	ctx context.Context,
 	profileID string) (bool, error) {
@@ -0,0 +1,181 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	vmopv1common "github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
+	vcconfig "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+	"github.com/vmware-tanzu/vm-operator/test/conformance"
+)
+
+type vcSimHarness struct {
+	ctx        *builder.TestContextForVCSim
+	vmProvider providers.VirtualMachineProviderInterface
+	nsInfo     builder.WorkloadNamespaceInfo
+	vmClass    *vmopv1.VirtualMachineClass
+	vms        int
+}
+
+func (h *vcSimHarness) Context() context.Context {
+	return h.ctx
+}
+
+func (h *vcSimHarness) Provider() providers.VirtualMachineProviderInterface {
+	return h.vmProvider
+}
+
+func (h *vcSimHarness) NewVirtualMachine() *vmopv1.VirtualMachine {
+	h.vms++
+
+	vm := builder.DummyBasicVirtualMachine(fmt.Sprintf("conformance-vm-%d", h.vms), h.nsInfo.Namespace)
+	vm.Spec.ClassName = h.vmClass.Name
+	vm.Spec.ImageName = h.ctx.ContentLibraryImageName
+	vm.Spec.Image.Kind = cvmiKind
+	vm.Spec.Image.Name = h.ctx.ContentLibraryImageName
+	vm.Spec.StorageClass = h.ctx.StorageClassName
+	vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+		Disabled: true,
+	}
+	return vm
+}
+
+// NewVirtualMachineWithErrorReason induces the reason with the VM's class,
+// network, zone, or the provider's vCenter endpoint. vcsim does not report
+// insufficient resources, so QuotaExceeded cannot be induced.
+func (h *vcSimHarness) NewVirtualMachineWithErrorReason(
+	reason providers.ErrorReason) (providers.VirtualMachineProviderInterface, *vmopv1.VirtualMachine) {
+
+	vm := h.NewVirtualMachine()
+
+	switch reason {
+	case providers.ErrorReasonNetworkNotReady:
+		vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+			Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+				{
+					Name: "eth0",
+					Network: &vmopv1common.PartialObjectRef{
+						Name: "conformance-missing-network",
+					},
+				},
+			},
+		}
+	case providers.ErrorReasonPlacementFailed:
+		vm.Labels = map[string]string{
+			topology.KubernetesTopologyZoneLabelKey: "conformance-missing-zone",
+		}
+	case providers.ErrorReasonCapabilityMismatch:
+		vmClass := builder.DummyVirtualMachineClassGenName()
+		vmClass.Namespace = h.nsInfo.Namespace
+		vmClass.Annotations = map[string]string{
+			pkgconst.RequiredCapabilitiesAnnotationKey: "pmem",
+		}
+		Expect(h.ctx.Client.Create(h.ctx, vmClass)).To(Succeed())
+		vm.Spec.ClassName = vmClass.Name
+	case providers.ErrorReasonVCUnavailable:
+		return vsphere.NewVSphereVMProviderFromClientWithConfigFn(
+			h.ctx,
+			h.ctx.Client,
+			h.ctx.Recorder,
+			func(
+				ctx context.Context,
+				client ctrlclient.Client) (*vcconfig.VSphereVMProviderConfig, error) {
+
+				config, err := vcconfig.GetProviderConfig(ctx, client)
+				if err != nil {
+					return nil, err
+				}
+				config.VcPNID = "127.0.0.1"
+				config.VcPort = "1"
+				return config, nil
+			}), vm
+	default:
+		return nil, nil
+	}
+
+	return h.vmProvider, vm
+}
+
+func (h *vcSimHarness) ContentLibrary() *imgregv1a1.ContentLibrary {
+	return builder.DummyContentLibrary(
+		"conformance-cl", h.nsInfo.Namespace, h.ctx.LocalContentLibraryID)
+}
+
+func (h *vcSimHarness) NewContentLibraryItem(
+	itemType imgregv1a1.ContentLibraryItemType) *imgregv1a1.ContentLibraryItem {
+
+	return &imgregv1a1.ContentLibraryItem{
+		Spec: imgregv1a1.ContentLibraryItemSpec{
+			UUID: types.UID(h.ctx.ContentLibraryItemID),
+		},
+		Status: imgregv1a1.ContentLibraryItemStatus{
+			Type: itemType,
+		},
+	}
+}
+
+func (h *vcSimHarness) AvailabilityZoneNames() []string {
+	return h.ctx.ZoneNames
+}
+
+func conformanceTests() {
+
+	var h *vcSimHarness
+
+	JustBeforeEach(func() {
+		parentCtx := ctxop.WithContext(pkgcfg.NewContext())
+		parentCtx = ovfcache.WithContext(parentCtx)
+		parentCtx = cource.WithContext(parentCtx)
+		pkgcfg.SetContext(parentCtx, func(config *pkgcfg.Config) {
+			config.AsyncCreateEnabled = false
+			config.AsyncSignalEnabled = false
+		})
+
+		h = &vcSimHarness{}
+		h.ctx = suite.NewTestContextForVCSimWithParentContext(
+			parentCtx,
+			builder.VCSimTestConfig{
+				WithContentLibrary: true,
+			})
+		pkgcfg.SetContext(h.ctx, func(config *pkgcfg.Config) {
+			config.MaxDeployThreadsOnProvider = 1
+		})
+		h.vmProvider = vsphere.NewVSphereVMProviderFromClient(h.ctx, h.ctx.Client, h.ctx.Recorder)
+		h.nsInfo = h.ctx.CreateWorkloadNamespace()
+
+		h.vmClass = builder.DummyVirtualMachineClassGenName()
+		h.vmClass.Namespace = h.nsInfo.Namespace
+		Expect(h.ctx.Client.Create(h.ctx, h.vmClass)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		h.ctx.AfterEach()
+		h = nil
+	})
+
+	conformance.DescribeProvider("VirtualMachineProviderInterface", func() conformance.Harness {
+		return h
+	})
+}
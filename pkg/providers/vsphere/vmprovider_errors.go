@@ -0,0 +1,69 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere
+
+import (
+	"errors"
+	"net"
+	"net/url"
+
+	"github.com/vmware/govmomi/fault"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcnd "github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+)
+
+// toProviderError returns err with a provider error reason if the reason can
+// be determined from a vSphere fault or a connection error in err's tree.
+// Otherwise err is returned as-is.
+func toProviderError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := providers.ReasonFromError(err); ok {
+		return err
+	}
+
+	var quotaExceeded bool
+	fault.In(err, func(
+		f vimtypes.BaseMethodFault,
+		_ string,
+		_ []vimtypes.LocalizableMessage) bool {
+
+		switch f.(type) {
+		case vimtypes.BaseInsufficientResourcesFault, *vimtypes.NoDiskSpace:
+			quotaExceeded = true
+		}
+		return quotaExceeded
+	})
+	if quotaExceeded {
+		return providers.NewError(providers.ErrorReasonQuotaExceeded, err)
+	}
+
+	var (
+		urlErr *url.Error
+		netErr net.Error
+	)
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return providers.NewError(providers.ErrorReasonVCUnavailable, err)
+	}
+
+	return err
+}
+
+// vmCreateMarkFailed marks the VM's Created condition as false if the reason
+// the create failed is known.
+func vmCreateMarkFailed(vmCtx pkgctx.VirtualMachineContext, err error) {
+	if reason, ok := providers.ReasonFromError(err); ok {
+		pkgcnd.MarkFalse(
+			vmCtx.VM,
+			vmopv1.VirtualMachineConditionCreated,
+			string(reason),
+			err.Error())
+	}
+}
@@ -12,6 +12,7 @@ import (
 	apierrorsutil "k8s.io/apimachinery/pkg/util/errors"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/clustermodules"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vcenter"
 	"github.com/vmware-tanzu/vm-operator/pkg/topology"
@@ -70,11 +71,19 @@ func (vs *vSphereVMProvider) IsVirtualMachineSetResourcePolicyReady(
 }
 
 // CreateOrUpdateVirtualMachineSetResourcePolicy creates if a VirtualMachineSetResourcePolicy doesn't exist, updates otherwise.
+// The policy's child folder and resource pools are created in each of the namespace's zones, and the
+// per-zone readiness is reported in the policy's status.
 func (vs *vSphereVMProvider) CreateOrUpdateVirtualMachineSetResourcePolicy(
 	ctx context.Context,
 	resourcePolicy *vmopv1.VirtualMachineSetResourcePolicy) error {
 
-	folderMoID, rpMoIDs, err := topology.GetNamespaceFolderAndRPMoIDs(ctx, vs.k8sClient, resourcePolicy.Namespace)
+	if pkgcfg.FromContext(ctx).ObserverMode {
+		log.V(4).Info("Skipping update of resource policy since observer mode is enabled",
+			"name", resourcePolicy.NamespacedName())
+		return nil
+	}
+
+	zones, err := topology.GetNamespaceFolderAndRPMoIDsByZone(ctx, vs.k8sClient, resourcePolicy.Namespace)
 	if err != nil {
 		return err
 	}
@@ -85,39 +94,89 @@ func (vs *vSphereVMProvider) CreateOrUpdateVirtualMachineSetResourcePolicy(
 	}
 
 	vimClient := client.VimClient()
-	var errs []error
+	clusterModuleProvider := clustermodules.NewProvider(client.RestClient())
 
-	if folderName := resourcePolicy.Spec.Folder; folderName != "" {
-		if folderMoID != "" {
-			_, err = vcenter.CreateFolder(ctx, vimClient, folderMoID, folderName)
-			if err != nil {
-				errs = append(errs, err)
-			}
-		} else {
-			errs = append(errs, fmt.Errorf("cannot create child folder because Namespace folder not found"))
+	var (
+		errs          []error
+		zoneStatuses  []vmopv1.VirtualMachineSetResourcePolicyZoneStatus
+		childFolders  = map[string]string{}
+		folderErrs    = map[string]error{}
+		clusterModErr = map[string]error{}
+	)
+
+	for _, zone := range zones {
+		zoneStatus := vmopv1.VirtualMachineSetResourcePolicyZoneStatus{
+			Name: zone.ZoneName,
 		}
-	}
+		var zoneErrs []error
 
-	for _, rpMoID := range rpMoIDs {
-		if rpSpec := &resourcePolicy.Spec.ResourcePool; rpSpec.Name != "" {
-			_, err := vcenter.CreateOrUpdateChildResourcePool(ctx, vimClient, rpMoID, rpSpec)
-			if err != nil {
+		if folderName := resourcePolicy.Spec.Folder; folderName != "" {
+			if zone.FolderMoID == "" {
+				err := fmt.Errorf("cannot create child folder because Namespace folder not found")
+				zoneErrs = append(zoneErrs, err)
 				errs = append(errs, err)
+			} else {
+				// Zones may share the namespace folder so only create the child folder once.
+				if _, ok := childFolders[zone.FolderMoID]; !ok {
+					if _, ok := folderErrs[zone.FolderMoID]; !ok {
+						childMoID, err := vcenter.CreateFolder(ctx, vimClient, zone.FolderMoID, folderName)
+						if err != nil {
+							folderErrs[zone.FolderMoID] = err
+							errs = append(errs, err)
+						} else {
+							childFolders[zone.FolderMoID] = childMoID
+						}
+					}
+				}
+				if err := folderErrs[zone.FolderMoID]; err != nil {
+					zoneErrs = append(zoneErrs, err)
+				}
+				zoneStatus.FolderMoID = childFolders[zone.FolderMoID]
 			}
 		}
 
-		if len(resourcePolicy.Spec.ClusterModuleGroups) > 0 {
-			clusterRef, err := vcenter.GetResourcePoolOwnerMoRef(ctx, vimClient, rpMoID)
-			if err == nil {
-				clusterModuleProvider := clustermodules.NewProvider(client.RestClient())
-				err = vs.createClusterModules(ctx, clusterModuleProvider, clusterRef.Reference(), resourcePolicy)
+		for _, rpMoID := range zone.PoolMoIDs {
+			if rpSpec := &resourcePolicy.Spec.ResourcePool; rpSpec.Name != "" {
+				childMoID, err := vcenter.CreateOrUpdateChildResourcePool(ctx, vimClient, rpMoID, rpSpec)
+				if err != nil {
+					zoneErrs = append(zoneErrs, err)
+					errs = append(errs, err)
+				} else {
+					zoneStatus.ResourcePoolMoIDs = append(zoneStatus.ResourcePoolMoIDs, childMoID)
+				}
 			}
-			if err != nil {
-				errs = append(errs, err)
+
+			if len(resourcePolicy.Spec.ClusterModuleGroups) > 0 {
+				clusterRef, err := vcenter.GetResourcePoolOwnerMoRef(ctx, vimClient, rpMoID)
+				if err == nil {
+					// Zones may share a cluster so only create the cluster's modules once.
+					var ok bool
+					if err, ok = clusterModErr[clusterRef.Value]; !ok {
+						err = vs.createClusterModules(ctx, clusterModuleProvider, clusterRef.Reference(), resourcePolicy)
+						clusterModErr[clusterRef.Value] = err
+						if err != nil {
+							errs = append(errs, err)
+						}
+					}
+				} else {
+					errs = append(errs, err)
+				}
+				if err != nil {
+					zoneErrs = append(zoneErrs, err)
+				}
 			}
 		}
+
+		if len(zoneErrs) == 0 {
+			zoneStatus.Ready = true
+		} else {
+			zoneStatus.Message = apierrorsutil.NewAggregate(zoneErrs).Error()
+		}
+		zoneStatuses = append(zoneStatuses, zoneStatus)
 	}
 
+	resourcePolicy.Status.Zones = zoneStatuses
+
 	return apierrorsutil.NewAggregate(errs)
 }
 
@@ -126,7 +185,13 @@ func (vs *vSphereVMProvider) DeleteVirtualMachineSetResourcePolicy(
 	ctx context.Context,
 	resourcePolicy *vmopv1.VirtualMachineSetResourcePolicy) error {
 
-	folderMoID, rpMoIDs, err := topology.GetNamespaceFolderAndRPMoIDs(ctx, vs.k8sClient, resourcePolicy.Namespace)
+	if pkgcfg.FromContext(ctx).ObserverMode {
+		log.V(4).Info("Skipping delete of resource policy since observer mode is enabled",
+			"name", resourcePolicy.NamespacedName())
+		return nil
+	}
+
+	zones, err := topology.GetNamespaceFolderAndRPMoIDsByZone(ctx, vs.k8sClient, resourcePolicy.Namespace)
 	if err != nil {
 		return err
 	}
@@ -139,22 +204,37 @@ func (vs *vSphereVMProvider) DeleteVirtualMachineSetResourcePolicy(
 	vimClient := client.VimClient()
 	var errs []error
 
-	for _, rpMoID := range rpMoIDs {
-		err := vcenter.DeleteChildResourcePool(ctx, vimClient, rpMoID, resourcePolicy.Spec.ResourcePool.Name)
-		if err != nil {
-			errs = append(errs, err)
+	for _, zone := range zones {
+		for _, rpMoID := range zone.PoolMoIDs {
+			err := vcenter.DeleteChildResourcePool(ctx, vimClient, rpMoID, resourcePolicy.Spec.ResourcePool.Name)
+			if err != nil {
+				errs = append(errs, err)
+			}
 		}
 	}
 
 	clusterModuleProvider := clustermodules.NewProvider(client.RestClient())
 	errs = append(errs, vs.deleteClusterModules(ctx, clusterModuleProvider, resourcePolicy)...)
 
-	if folderName := resourcePolicy.Spec.Folder; folderMoID != "" && folderName != "" {
-		if err := vcenter.DeleteChildFolder(ctx, vimClient, folderMoID, folderName); err != nil {
-			errs = append(errs, err)
+	if folderName := resourcePolicy.Spec.Folder; folderName != "" {
+		deleted := map[string]struct{}{}
+		for _, zone := range zones {
+			if zone.FolderMoID == "" {
+				continue
+			}
+			if _, ok := deleted[zone.FolderMoID]; ok {
+				continue
+			}
+			deleted[zone.FolderMoID] = struct{}{}
+
+			if err := vcenter.DeleteChildFolder(ctx, vimClient, zone.FolderMoID, folderName); err != nil {
+				errs = append(errs, err)
+			}
 		}
 	}
 
+	resourcePolicy.Status.Zones = nil
+
 	return apierrorsutil.NewAggregate(errs)
 }
 
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
@@ -228,6 +229,59 @@ func resourcePolicyTests() {
 				Expect(resourcePolicy.Status.ClusterModules).To(Equal(moduleStatus.ClusterModules))
 			})
 
+			It("reports the status of each zone", func() {
+				zones := resourcePolicy.Status.Zones
+				Expect(zones).To(HaveLen(len(ctx.ZoneNames)))
+
+				childFolder, err := ctx.Finder.Folder(ctx, path.Join(nsInfo.Folder.InventoryPath, resourcePolicy.Spec.Folder))
+				Expect(err).ToNot(HaveOccurred())
+
+				for _, zone := range zones {
+					Expect(ctx.ZoneNames).To(ContainElement(zone.Name))
+					Expect(zone.Ready).To(BeTrue())
+					Expect(zone.Message).To(BeEmpty())
+					Expect(zone.FolderMoID).To(Equal(childFolder.Reference().Value))
+					Expect(zone.ResourcePoolMoIDs).To(HaveLen(ctx.ClustersPerZone))
+				}
+			})
+
+			Context("when the zone has no namespace folder", func() {
+				It("reports the zone is not ready", func() {
+					Expect(vmProvider.DeleteVirtualMachineSetResourcePolicy(ctx, resourcePolicy)).To(Succeed())
+					Expect(resourcePolicy.Status.Zones).To(BeEmpty())
+
+					zoneName := ctx.ZoneNames[0]
+					zone := &topologyv1.Zone{}
+					if err := ctx.Client.Get(ctx, client.ObjectKey{Name: zoneName, Namespace: nsInfo.Namespace}, zone); err == nil {
+						zone.Spec.ManagedVMs.FolderMoID = ""
+						Expect(ctx.Client.Update(ctx, zone)).To(Succeed())
+					}
+					az := &topologyv1.AvailabilityZone{}
+					Expect(ctx.Client.Get(ctx, client.ObjectKey{Name: zoneName}, az)).To(Succeed())
+					if nsInfo, ok := az.Spec.Namespaces[resourcePolicy.Namespace]; ok {
+						nsInfo.FolderMoId = ""
+						az.Spec.Namespaces[resourcePolicy.Namespace] = nsInfo
+						Expect(ctx.Client.Update(ctx, az)).To(Succeed())
+					}
+
+					err := vmProvider.CreateOrUpdateVirtualMachineSetResourcePolicy(ctx, resourcePolicy)
+					Expect(err).To(MatchError(ContainSubstring("Namespace folder not found")))
+
+					zones := resourcePolicy.Status.Zones
+					Expect(zones).To(HaveLen(len(ctx.ZoneNames)))
+					for _, zone := range zones {
+						if zone.Name == zoneName {
+							Expect(zone.Ready).To(BeFalse())
+							Expect(zone.Message).To(ContainSubstring("Namespace folder not found"))
+							Expect(zone.FolderMoID).To(BeEmpty())
+							Expect(zone.ResourcePoolMoIDs).To(HaveLen(ctx.ClustersPerZone))
+						} else {
+							Expect(zone.Ready).To(BeTrue())
+						}
+					}
+				})
+			})
+
 			It("successfully able to find the resource policy in each zone", func() {
 				for _, zoneName := range ctx.ZoneNames {
 					exists, err := vmProvider.IsVirtualMachineSetResourcePolicyReady(ctx, zoneName, resourcePolicy)
@@ -55,6 +55,28 @@ func cpuFreqTests() {
 			Expect(vmProvider.ComputeCPUMinFrequency(ctx)).To(Succeed())
 		})
 	})
+
+	Context("Diagnostics", func() {
+		It("returns an inactive session when there is no cached client", func() {
+			diag, err := vmProvider.Diagnostics(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diag.Session.Cached).To(BeFalse())
+			Expect(diag.Session.Active).To(BeFalse())
+			Expect(diag.InFlightTasks).To(BeEmpty())
+		})
+
+		It("returns the cached session without credentials", func() {
+			Expect(vmProvider.ComputeCPUMinFrequency(ctx)).To(Succeed())
+
+			diag, err := vmProvider.Diagnostics(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diag.Session.Cached).To(BeTrue())
+			Expect(diag.Session.Active).To(BeTrue())
+			Expect(diag.Session.Host).ToNot(BeEmpty())
+			Expect(diag.Session.Host).ToNot(ContainSubstring("@"))
+			Expect(diag.MinCPUFrequency).ToNot(BeZero())
+		})
+	})
 }
 
 var _ = Describe("SyncVirtualMachineImage", func() {
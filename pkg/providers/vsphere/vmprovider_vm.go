@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"maps"
 	"math/rand"
+	"slices"
 	"strings"
 	"sync"
 	"text/template"
@@ -20,12 +21,14 @@ import (
 	"github.com/vmware/govmomi/pbm"
 	pbmtypes "github.com/vmware/govmomi/pbm/types"
 	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vapi/library"
 	"github.com/vmware/govmomi/vim25/mo"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apierrorsutil "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -37,13 +40,17 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
 	pkgcnd "github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	ctxbudget "github.com/vmware-tanzu/vm-operator/pkg/context/budget"
 	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
 	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	vcclient "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/client"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/clustermodules"
+	vcconfig "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/contentlibrary"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/placement"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/session"
@@ -56,6 +63,7 @@ import (
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+	pkgtask "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
 	"github.com/vmware-tanzu/vm-operator/pkg/vmconfig"
 )
 
@@ -75,10 +83,16 @@ type VMCreateArgs struct {
 	ChildResourcePoolName string
 	ChildFolderName       string
 	ClusterMoRef          vimtypes.ManagedObjectReference
+	PreferredSite         string
 
 	NetworkResults network.NetworkInterfaceResults
 }
 
+// ObserverModeReason is the reason of the VM's Created condition when the
+// VM is not created because the controller manager is running in observer
+// mode.
+const ObserverModeReason = "ObserverMode"
+
 // TODO: Until we sort out what the Session becomes.
 type vmUpdateArgs = session.VMUpdateArgs
 type vmResizeArgs = session.VMResizeArgs
@@ -125,18 +139,18 @@ func (vs *vSphereVMProvider) createOrUpdateVirtualMachine(
 		return nil, providers.ErrReconcileInProgress
 	}
 
+	opID := vs.getOpID(ctx, vm, "createOrUpdateVM")
 	vmCtx := pkgctx.VirtualMachineContext{
-		Context: context.WithValue(
-			ctx,
-			vimtypes.ID{},
-			vs.getOpID(vm, "createOrUpdateVM"),
-		),
-		Logger: log.WithValues("vmName", vm.NamespacedName()),
-		VM:     vm,
+		Context: pkgtask.WithOperationID(ctx, opID),
+		Logger:  log.WithValues("vmName", vm.NamespacedName(), "opID", opID),
+		VM:      vm,
 	}
 
 	client, err := vs.getVcClient(vmCtx)
 	if err != nil {
+		if !pkgcnd.IsTrue(vm, vmopv1.VirtualMachineConditionCreated) {
+			vmCreateMarkFailed(vmCtx, err)
+		}
 		return nil, err
 	}
 
@@ -162,6 +176,16 @@ func (vs *vSphereVMProvider) createOrUpdateVirtualMachine(
 		return nil, vs.updateVirtualMachine(vmCtx, foundVM, client, nil)
 	}
 
+	if pkgcfg.FromContext(vmCtx).ObserverMode {
+		vmCtx.Logger.Info("Skipping create since observer mode is enabled")
+		pkgcnd.MarkFalse(
+			vm,
+			vmopv1.VirtualMachineConditionCreated,
+			ObserverModeReason,
+			"VM is not created in observer mode")
+		return nil, nil
+	}
+
 	// Mark that this is a create operation.
 	ctxop.MarkCreate(vmCtx)
 
@@ -188,6 +212,7 @@ func (vs *vSphereVMProvider) createOrUpdateVirtualMachine(
 
 		createArgs, err := vs.getCreateArgs(vmCtx, client)
 		if err != nil {
+			vmCreateMarkFailed(vmCtx, err)
 			return nil, err
 		}
 
@@ -196,6 +221,12 @@ func (vs *vSphereVMProvider) createOrUpdateVirtualMachine(
 			return nil, err
 		}
 
+		// If the reconcile budget is exceeded, return so the created VM is
+		// persisted in the status, and customize the VM on the next reconcile.
+		if err := ctxbudget.Check(vmCtx, "create"); err != nil {
+			return nil, err
+		}
+
 		// If the create actually occurred, fall-through to an update
 		// post-reconfigure.
 		return nil, vs.createdVirtualMachineFallthroughUpdate(
@@ -229,6 +260,7 @@ func (vs *vSphereVMProvider) createOrUpdateVirtualMachine(
 		// If getting the create args failed, the concurrent counter needs to be
 		// decremented before returning.
 		cleanupFn()
+		vmCreateMarkFailed(vmCtx, err)
 		return nil, err
 	}
 
@@ -238,6 +270,10 @@ func (vs *vSphereVMProvider) createOrUpdateVirtualMachine(
 	copyOfCtx := vmCtx
 	copyOfCtx.VM = vmCtx.VM.DeepCopy()
 
+	// Since the VM is not being created as part of the reconcile, report the
+	// progress of the create directly to the VM's status.
+	createArgs.ProgressFn = vs.vmCreateProgressFn(copyOfCtx)
+
 	// Start a goroutine to create the VM in the background.
 	chanErr := make(chan error)
 	go vs.createVirtualMachineAsync(
@@ -264,9 +300,12 @@ func (vs *vSphereVMProvider) DeleteVirtualMachine(
 		return providers.ErrReconcileInProgress
 	}
 
+	session.EndReconfigureBatch(vm.UID)
+
+	opID := vs.getOpID(ctx, vm, "deleteVM")
 	vmCtx := pkgctx.VirtualMachineContext{
-		Context: context.WithValue(ctx, vimtypes.ID{}, vs.getOpID(vm, "deleteVM")),
-		Logger:  log.WithValues("vmName", vmNamespacedName),
+		Context: pkgtask.WithOperationID(ctx, opID),
+		Logger:  log.WithValues("vmName", vmNamespacedName, "opID", opID),
 		VM:      vm,
 	}
 
@@ -283,6 +322,12 @@ func (vs *vSphereVMProvider) DeleteVirtualMachine(
 		return nil
 	}
 
+	if pkgcfg.FromContext(vmCtx).ObserverMode {
+		// The vSphere VM is left as-is so only the VM resource is deleted.
+		vmCtx.Logger.Info("Skipping delete since observer mode is enabled")
+		return nil
+	}
+
 	return virtualmachine.DeleteVirtualMachine(vmCtx, vcVM)
 }
 
@@ -302,6 +347,10 @@ func (vs *vSphereVMProvider) PublishVirtualMachine(
 		VM: vm,
 	}
 
+	if pkgcfg.FromContext(ctx).ObserverMode {
+		return "", providers.ErrObserverMode
+	}
+
 	client, err := vs.getVcClient(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get vCenter client: %w", err)
@@ -320,7 +369,7 @@ func (vs *vSphereVMProvider) GetVirtualMachineGuestHeartbeat(
 	vm *vmopv1.VirtualMachine) (vmopv1.GuestHeartbeatStatus, error) {
 
 	vmCtx := pkgctx.VirtualMachineContext{
-		Context: context.WithValue(ctx, vimtypes.ID{}, vs.getOpID(vm, "heartbeat")),
+		Context: pkgtask.WithOperationID(ctx, vs.getOpID(ctx, vm, "heartbeat")),
 		Logger:  log.WithValues("vmName", vm.NamespacedName()),
 		VM:      vm,
 	}
@@ -349,7 +398,7 @@ func (vs *vSphereVMProvider) GetVirtualMachineProperties(
 	propertyPaths []string) (map[string]any, error) {
 
 	vmCtx := pkgctx.VirtualMachineContext{
-		Context: context.WithValue(ctx, vimtypes.ID{}, vs.getOpID(vm, "properties")),
+		Context: pkgtask.WithOperationID(ctx, vs.getOpID(ctx, vm, "properties")),
 		Logger:  log.WithValues("vmName", vm.NamespacedName()),
 		VM:      vm,
 	}
@@ -409,7 +458,7 @@ func (vs *vSphereVMProvider) GetVirtualMachineWebMKSTicket(
 	pubKey string) (string, error) {
 
 	vmCtx := pkgctx.VirtualMachineContext{
-		Context: context.WithValue(ctx, vimtypes.ID{}, vs.getOpID(vm, "webconsole")),
+		Context: pkgtask.WithOperationID(ctx, vs.getOpID(ctx, vm, "webconsole")),
 		Logger:  log.WithValues("vmName", vm.NamespacedName()),
 		VM:      vm,
 	}
@@ -437,7 +486,7 @@ func (vs *vSphereVMProvider) GetVirtualMachineHardwareVersion(
 	vm *vmopv1.VirtualMachine) (vimtypes.HardwareVersion, error) {
 
 	vmCtx := pkgctx.VirtualMachineContext{
-		Context: context.WithValue(ctx, vimtypes.ID{}, vs.getOpID(vm, "hardware-version")),
+		Context: pkgtask.WithOperationID(ctx, vs.getOpID(ctx, vm, "hardware-version")),
 		Logger:  log.WithValues("vmName", vm.NamespacedName()),
 		VM:      vm,
 	}
@@ -461,6 +510,271 @@ func (vs *vSphereVMProvider) GetVirtualMachineHardwareVersion(
 	return vimtypes.ParseHardwareVersion(o.Config.Version)
 }
 
+func (vs *vSphereVMProvider) AuditVirtualMachineDevices(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	prune bool) (providers.DeviceAuditResult, error) {
+
+	vmCtx := pkgctx.VirtualMachineContext{
+		Context: pkgtask.WithOperationID(ctx, vs.getOpID(ctx, vm, "audit-devices")),
+		Logger:  log.WithValues("vmName", vm.NamespacedName()),
+		VM:      vm,
+	}
+
+	// The devices are never removed in observer mode.
+	prune = prune && !pkgcfg.FromContext(ctx).ObserverMode
+
+	// The devices of a VM without a class are not audited since the VM's
+	// class is synthesized from the VM itself.
+	if vm.Spec.ClassName == "" {
+		return providers.DeviceAuditResult{}, nil
+	}
+
+	vmClass, err := getVirtualMachineClassFromClassName(vmCtx, vs.k8sClient)
+	if err != nil {
+		return providers.DeviceAuditResult{}, err
+	}
+
+	var classConfigSpec vimtypes.VirtualMachineConfigSpec
+	if raw := vmClass.Spec.ConfigSpec; len(raw) > 0 {
+		if classConfigSpec, err = GetVMClassConfigSpec(vmCtx, raw); err != nil {
+			return providers.DeviceAuditResult{}, err
+		}
+	}
+
+	client, err := vs.getVcClient(vmCtx)
+	if err != nil {
+		return providers.DeviceAuditResult{}, err
+	}
+
+	vcVM, err := vs.getVM(vmCtx, client, true)
+	if err != nil {
+		return providers.DeviceAuditResult{}, err
+	}
+
+	var o mo.VirtualMachine
+	err = vcVM.Properties(vmCtx, vcVM.Reference(), []string{"config.hardware.device"}, &o)
+	if err != nil {
+		return providers.DeviceAuditResult{}, err
+	}
+	if o.Config == nil {
+		return providers.DeviceAuditResult{}, nil
+	}
+
+	devices := object.VirtualDeviceList(o.Config.Hardware.Device)
+	unmanaged := virtualmachine.UnmanagedDevices(devices, classConfigSpec)
+
+	var result providers.DeviceAuditResult
+	if len(unmanaged) == 0 {
+		return result, nil
+	}
+
+	var deviceChanges []vimtypes.BaseVirtualDeviceConfigSpec
+	if prune {
+		deviceChanges = virtualmachine.UnmanagedDevicesRemoveSpec(unmanaged)
+	}
+
+	removed := map[int32]struct{}{}
+	for _, dc := range deviceChanges {
+		d := dc.GetVirtualDeviceConfigSpec().Device
+		removed[d.GetVirtualDevice().Key] = struct{}{}
+		result.Removed = append(result.Removed, devices.Name(d))
+	}
+	for _, d := range unmanaged {
+		if _, ok := removed[d.GetVirtualDevice().Key]; !ok {
+			result.Unmanaged = append(result.Unmanaged, devices.Name(d))
+		}
+	}
+
+	if len(deviceChanges) > 0 {
+		vmCtx.Logger.Info("Removing unmanaged devices", "devices", result.Removed)
+
+		configSpec := vimtypes.VirtualMachineConfigSpec{
+			DeviceChange: deviceChanges,
+		}
+		if err := vmopv1util.CheckPreventDeleteDeviceChanges(vm, configSpec.DeviceChange); err != nil {
+			return providers.DeviceAuditResult{}, err
+		}
+		task, err := vcVM.Reconfigure(vmCtx, configSpec)
+		if err != nil {
+			return providers.DeviceAuditResult{}, err
+		}
+		if err := task.Wait(vmCtx); err != nil {
+			return providers.DeviceAuditResult{}, fmt.Errorf("failed to remove unmanaged devices: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (vs *vSphereVMProvider) ExportVirtualMachine(
+	ctx context.Context,
+	moID, namespace string) (*vmopv1.VirtualMachine, *vmopv1.VirtualMachineClass, error) {
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vcVM := object.NewVirtualMachine(
+		client.VimClient(),
+		vimtypes.ManagedObjectReference{Type: "VirtualMachine", Value: moID})
+
+	var o mo.VirtualMachine
+	err = vcVM.Properties(ctx, vcVM.Reference(), virtualmachine.ExportPropertiesSelector, &o)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get properties of vm %s: %w", moID, err)
+	}
+
+	var portgroupRefs []vimtypes.ManagedObjectReference
+	for _, ref := range o.Network {
+		if ref.Type == "DistributedVirtualPortgroup" {
+			portgroupRefs = append(portgroupRefs, ref)
+		}
+	}
+
+	portgroupNames := map[string]string{}
+	if len(portgroupRefs) > 0 {
+		var portgroups []mo.DistributedVirtualPortgroup
+		pc := property.DefaultCollector(client.VimClient())
+		if err := pc.Retrieve(ctx, portgroupRefs, []string{"name", "key"}, &portgroups); err != nil {
+			return nil, nil, fmt.Errorf("failed to get port groups of vm %s: %w", moID, err)
+		}
+		for _, pg := range portgroups {
+			portgroupNames[pg.Key] = pg.Name
+		}
+	}
+
+	return virtualmachine.ExportVirtualMachine(o, virtualmachine.ExportArgs{
+		Namespace:         namespace,
+		PortgroupNames:    portgroupNames,
+		GlobalExtraConfig: vs.globalExtraConfig,
+	})
+}
+
+func (vs *vSphereVMProvider) ListManagedVirtualMachines(
+	ctx context.Context) ([]providers.ManagedVirtualMachine, error) {
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the VMs in the folders of this Supervisor's namespaces are listed.
+	nsFolders, err := topology.GetNamespaceFolderMoIDs(ctx, vs.k8sClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace folders: %w", err)
+	}
+
+	var (
+		folders    []vimtypes.ManagedObjectReference
+		namespaces = map[string]struct{}{}
+	)
+	for namespace, folderMoID := range nsFolders {
+		namespaces[namespace] = struct{}{}
+		folder := vimtypes.ManagedObjectReference{Type: "Folder", Value: folderMoID}
+		if !slices.Contains(folders, folder) {
+			folders = append(folders, folder)
+		}
+	}
+
+	moVMs, err := virtualmachine.ListManagedVirtualMachines(
+		ctx, client.VimClient(), folders, namespaces)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list managed vms: %w", err)
+	}
+
+	vms := make([]providers.ManagedVirtualMachine, len(moVMs))
+	for i := range moVMs {
+		vms[i] = providers.ManagedVirtualMachine{
+			ID:             moVMs[i].Self.Value,
+			Name:           moVMs[i].Name,
+			InstanceUUID:   moVMs[i].Config.InstanceUuid,
+			NamespacedName: virtualmachine.ManagedNamespacedName(moVMs[i]),
+		}
+	}
+
+	return vms, nil
+}
+
+func (vs *vSphereVMProvider) ExportVirtualMachineToContentLibrary(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	vmExport *vmopv1.VirtualMachineExportRequest,
+	cl *imgregv1a1.ContentLibrary,
+	actID string) (string, error) {
+
+	vmCtx := pkgctx.VirtualMachineContext{
+		Context: ctx,
+		Logger: log.WithValues("vmName", vm.NamespacedName()).
+			WithValues("clName", fmt.Sprintf("%s/%s", cl.Namespace, cl.Name)).
+			WithValues("vmExportName", fmt.Sprintf("%s/%s", vmExport.Namespace, vmExport.Name)),
+		VM: vm,
+	}
+
+	if pkgcfg.FromContext(ctx).ObserverMode {
+		return "", providers.ErrObserverMode
+	}
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get vCenter client: %w", err)
+	}
+
+	return virtualmachine.ExportOVF(vmCtx, client.RestClient(), vmExport, cl, actID)
+}
+
+func (vs *vSphereVMProvider) StartVirtualMachineDiskExport(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine) (string, []vmopv1.VirtualMachineExportRequestFile, error) {
+
+	vmCtx := pkgctx.VirtualMachineContext{
+		Context: pkgtask.WithOperationID(ctx, vs.getOpID(ctx, vm, "exportDisks")),
+		Logger:  log.WithValues("vmName", vm.NamespacedName()),
+		VM:      vm,
+	}
+
+	if pkgcfg.FromContext(ctx).ObserverMode {
+		return "", nil, providers.ErrObserverMode
+	}
+
+	client, err := vs.getVcClient(vmCtx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	vcVM, err := vs.getVM(vmCtx, client, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return virtualmachine.StartDiskExport(vmCtx, vcVM)
+}
+
+func (vs *vSphereVMProvider) RenewVirtualMachineDiskExport(
+	ctx context.Context,
+	leaseID string) error {
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	return virtualmachine.RenewDiskExport(ctx, client.VimClient(), leaseID)
+}
+
+func (vs *vSphereVMProvider) CompleteVirtualMachineDiskExport(
+	ctx context.Context,
+	leaseID string) error {
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	return virtualmachine.CompleteDiskExport(ctx, client.VimClient(), leaseID)
+}
+
 func (vs *vSphereVMProvider) vmCreatePathName(
 	vmCtx pkgctx.VirtualMachineContext,
 	vcClient *vcclient.Client,
@@ -560,9 +874,15 @@ func (vs *vSphereVMProvider) getCreateArgs(
 	}
 
 	if err := vs.vmCreateGetFolderAndRPMoIDs(vmCtx, vcClient, createArgs); err != nil {
-		return nil, err
+		// The VM's zone, or the resource pool of its resource policy, does
+		// not exist when placement was not done.
+		return nil, providers.NewError(
+			providers.ErrorReasonPlacementFailed,
+			toProviderError(err))
 	}
 
+	vs.vmCreateGetZoneContentLibrary(vmCtx, vcClient, createArgs)
+
 	if pkgcfg.FromContext(vmCtx).Features.FastDeploy {
 		if err := vs.vmCreateGetSourceDiskPaths(vmCtx, vcClient, createArgs); err != nil {
 			return nil, err
@@ -601,11 +921,16 @@ func (vs *vSphereVMProvider) createVirtualMachine(
 		&args.CreateArgs)
 
 	if err != nil {
+		// A cached inventory object that no longer exists should be looked
+		// up again when the VM is next created.
+		vcClient.Inventory().InvalidateOnError(err)
+
+		err = toProviderError(err)
 		ctx.Logger.Error(err, "CreateVirtualMachine failed")
 		pkgcnd.MarkFalse(
 			ctx.VM,
 			vmopv1.VirtualMachineConditionCreated,
-			"Error",
+			providers.ConditionReason(err, "Error"),
 			err.Error())
 
 		if pkgcfg.FromContext(ctx).Features.FastDeploy {
@@ -655,6 +980,8 @@ func (vs *vSphereVMProvider) createVirtualMachineAsync(
 		&args.CreateArgs)
 
 	if vimErr != nil {
+		vcClient.Inventory().InvalidateOnError(vimErr)
+		vimErr = toProviderError(vimErr)
 		ctx.Logger.Error(vimErr, "CreateVirtualMachine failed")
 		chanErr <- vimErr
 	}
@@ -669,7 +996,7 @@ func (vs *vSphereVMProvider) createVirtualMachineAsync(
 				pkgcnd.MarkFalse(
 					ctx.VM,
 					vmopv1.VirtualMachineConditionCreated,
-					"Error",
+					providers.ConditionReason(vimErr, "Error"),
 					vimErr.Error())
 
 				if pkgcfg.FromContext(ctx).Features.FastDeploy {
@@ -705,6 +1032,41 @@ func (vs *vSphereVMProvider) createVirtualMachineAsync(
 	}
 }
 
+// vmCreateProgressFn returns a function that records the progress of the VM's
+// create task in the VM's Created condition.
+func (vs *vSphereVMProvider) vmCreateProgressFn(
+	ctx pkgctx.VirtualMachineContext) pkgtask.ProgressFunc {
+
+	key := ctrlclient.ObjectKeyFromObject(ctx.VM)
+
+	return func(p pkgtask.Progress) {
+		vm := &vmopv1.VirtualMachine{}
+		if err := vs.k8sClient.Get(ctx, key, vm); err != nil {
+			ctx.Logger.Error(err, "Failed to get VM to report create progress")
+			return
+		}
+
+		if pkgcnd.IsTrue(vm, vmopv1.VirtualMachineConditionCreated) {
+			return
+		}
+
+		patch := ctrlclient.MergeFromWithOptions(
+			vm.DeepCopy(),
+			ctrlclient.MergeFromWithOptimisticLock{})
+
+		pkgcnd.MarkFalse(
+			vm,
+			vmopv1.VirtualMachineConditionCreated,
+			vmopv1.VirtualMachineCreateInProgressReason,
+			p.String())
+
+		if err := vs.k8sClient.Status().Patch(ctx, vm, patch); err != nil {
+			ctx.Logger.V(4).Info("Failed to patch VM create progress",
+				"progress", p, "err", err)
+		}
+	}
+}
+
 func (vs *vSphereVMProvider) createdVirtualMachineFallthroughUpdate(
 	vmCtx pkgctx.VirtualMachineContext,
 	vcVM *object.VirtualMachine,
@@ -726,11 +1088,93 @@ var VMUpdatePropertiesSelector = []string{
 	"config",
 	"guest",
 	"layoutEx",
+	"recentTask",
 	"resourcePool",
 	"runtime",
 	"summary",
 }
 
+// stalledTaskRequeueDelay is how long to wait before reconciling a VM again
+// when it has a stalled task.
+const stalledTaskRequeueDelay = time.Minute
+
+// checkStalledTasks returns a RequeueError if any of the VM's recent tasks
+// have been queued or running for longer than the StalledTaskThreshold, since
+// the VM cannot be updated until the task completes. The stalled tasks are
+// cancelled if CancelStalledTasks is enabled and the tasks are cancelable,
+// but only the tasks started by VM Operator, i.e. by the user of its session,
+// are cancelled. The TaskStalled condition is updated to reflect the VM's
+// stalled tasks.
+func (vs *vSphereVMProvider) checkStalledTasks(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcVM *object.VirtualMachine) error {
+
+	cfg := pkgcfg.FromContext(vmCtx)
+	if cfg.StalledTaskThreshold <= 0 {
+		pkgcnd.Delete(vmCtx.VM, vmopv1.VirtualMachineConditionTaskStalled)
+		return nil
+	}
+
+	stalled, err := vcenter.GetStalledTasks(
+		vmCtx,
+		vcVM.Client(),
+		vmCtx.MoVM.RecentTask,
+		cfg.StalledTaskThreshold)
+	if err != nil {
+		return err
+	}
+
+	if len(stalled) == 0 {
+		pkgcnd.Delete(vmCtx.VM, vmopv1.VirtualMachineConditionTaskStalled)
+		return nil
+	}
+
+	cancel := cfg.Features.CancelStalledTasks && !cfg.ObserverMode
+
+	var userName string
+	if cancel {
+		if userName, err = vcenter.GetSessionUserName(vmCtx, vcVM.Client()); err != nil {
+			vmCtx.Logger.Error(err, "failed to get session user to cancel stalled tasks")
+			cancel = false
+		}
+	}
+
+	reason := vmopv1.VirtualMachineTaskInProgressReason
+	descriptions := make([]string, 0, len(stalled))
+
+	for _, info := range stalled {
+		descriptions = append(descriptions,
+			fmt.Sprintf("%s (%s)", info.DescriptionId, info.Task.Value))
+
+		vmCtx.Logger.Info("VM has stalled task",
+			"task", info.Task.Value,
+			"descriptionID", info.DescriptionId,
+			"state", info.State,
+			"queueTime", info.QueueTime)
+
+		if !cancel || !info.Cancelable || !vcenter.IsTaskStartedBy(info, userName) {
+			continue
+		}
+
+		if err := object.NewTask(vcVM.Client(), info.Task).Cancel(vmCtx); err != nil {
+			vmCtx.Logger.Error(err, "failed to cancel stalled task", "task", info.Task.Value)
+			continue
+		}
+
+		reason = vmopv1.VirtualMachineTaskCancelledReason
+	}
+
+	pkgcnd.Set(vmCtx.VM, &metav1.Condition{
+		Type:   vmopv1.VirtualMachineConditionTaskStalled,
+		Status: metav1.ConditionTrue,
+		Reason: reason,
+		Message: fmt.Sprintf("Tasks exceeded the %s threshold: %s",
+			cfg.StalledTaskThreshold, strings.Join(descriptions, ", ")),
+	})
+
+	return pkgerr.RequeueError{After: stalledTaskRequeueDelay}
+}
+
 func (vs *vSphereVMProvider) updateVirtualMachine(
 	vmCtx pkgctx.VirtualMachineContext,
 	vcVM *object.VirtualMachine,
@@ -739,6 +1183,8 @@ func (vs *vSphereVMProvider) updateVirtualMachine(
 
 	vmCtx.Logger.V(4).Info("Updating VirtualMachine")
 
+	var requeueErr error
+
 	{
 		// Hack - create just enough of the Session that's needed for update
 
@@ -756,6 +1202,10 @@ func (vs *vSphereVMProvider) updateVirtualMachine(
 			return fmt.Errorf("VM doesn't have a resourcePool")
 		}
 
+		if err := vs.checkStalledTasks(vmCtx, vcVM); err != nil {
+			return err
+		}
+
 		clusterMoRef, err := vcenter.GetResourcePoolOwnerMoRef(
 			vmCtx,
 			vcVM.Client(),
@@ -769,6 +1219,10 @@ func (vs *vSphereVMProvider) updateVirtualMachine(
 			Client:       vcClient.Client,
 			Finder:       vcClient.Finder(),
 			ClusterMoRef: clusterMoRef,
+			Inventory:    vcClient.Inventory(),
+		}
+		if err := vs.vmUpdateGetSessionArgs(vmCtx, ses); err != nil {
+			return err
 		}
 
 		getUpdateArgsFn := func() (*vmUpdateArgs, error) {
@@ -784,10 +1238,30 @@ func (vs *vSphereVMProvider) updateVirtualMachine(
 
 		err = ses.UpdateVirtualMachine(vmCtx, vcVM, getUpdateArgsFn, getResizeArgsFn)
 		if err != nil {
-			return err
+			if !errors.As(err, &pkgerr.RequeueError{}) {
+				vcClient.Inventory().InvalidateOnError(err)
+				return err
+			}
+			// The VM's update was deferred, ex. to batch its reconfigures, so
+			// the remaining steps still run and the VM is requeued after them.
+			requeueErr = err
 		}
 	}
 
+	if pkgcfg.FromContext(vmCtx).ObserverMode {
+		// Only the status is updated in observer mode.
+		return requeueErr
+	}
+
+	if keys := pkgcfg.StringToSlice(pkgcfg.FromContext(vmCtx).ChargebackLabelKeys); len(keys) > 0 {
+		vs.vmUpdateChargebackTags(vmCtx, vcClient, vcVM, keys)
+	}
+
+	// The VM is backed up on the next reconcile if the budget is exceeded.
+	if err := ctxbudget.Check(vmCtx, "update"); err != nil {
+		return err
+	}
+
 	// Back up the VM at the end after a successful update.  TKG nodes are skipped
 	// from backup unless they specify the annotation to opt into backup.
 	if !kubeutil.HasCAPILabels(vmCtx.VM.Labels) ||
@@ -821,9 +1295,67 @@ func (vs *vSphereVMProvider) updateVirtualMachine(
 		}
 	}
 
+	return requeueErr
+}
+
+// vmUpdateGetSessionArgs sets the resources the Session needs to update the
+// VM regardless of its power state so the Session does not get them itself.
+func (vs *vSphereVMProvider) vmUpdateGetSessionArgs(
+	vmCtx pkgctx.VirtualMachineContext,
+	ses *session.Session) error {
+
+	if pkgcfg.FromContext(vmCtx).NetworkProviderType == pkgcfg.NetworkProviderTypeNamed {
+		if _, ok := vmCtx.VM.Annotations[pkgconst.NetworkMigrationWindowAnnotationKey]; ok {
+			name, err := vcconfig.GetDefaultNamedNetwork(vmCtx, vs.k8sClient)
+			if err != nil {
+				return fmt.Errorf("failed to get default named network: %w", err)
+			}
+			ses.DefaultNamedNetwork = name
+		}
+	}
+
+	if pkgcfg.FromContext(vmCtx).Features.IsoSupport && len(vmCtx.VM.Spec.Cdrom) > 0 {
+		ses.CdromImages = virtualmachine.GetCdromImages(vmCtx, vs.k8sClient, vmCtx.VM)
+	}
+
 	return nil
 }
 
+// vmUpdateChargebackTags syncs the VM's chargeback tags when the tags for the
+// VM's labels differ from the tags last applied to the VM. Chargeback tags are
+// best effort and are synced again on the next reconcile if this fails.
+func (vs *vSphereVMProvider) vmUpdateChargebackTags(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcClient *vcclient.Client,
+	vcVM *object.VirtualMachine,
+	keys []string) {
+
+	desired := strings.Join(virtualmachine.ChargebackTagNames(vmCtx.VM.Labels, keys), ",")
+	if vmCtx.VM.Annotations[pkgconst.ChargebackTagsAnnotationKey] == desired {
+		return
+	}
+
+	if err := virtualmachine.SyncChargebackTags(
+		vmCtx,
+		vcClient.RestClient(),
+		vcVM.Reference(),
+		vmCtx.VM.Labels,
+		keys); err != nil {
+
+		vmCtx.Logger.Error(err, "failed to sync chargeback tags")
+		return
+	}
+
+	if desired == "" {
+		delete(vmCtx.VM.Annotations, pkgconst.ChargebackTagsAnnotationKey)
+		return
+	}
+	if vmCtx.VM.Annotations == nil {
+		vmCtx.VM.Annotations = map[string]string{}
+	}
+	vmCtx.VM.Annotations[pkgconst.ChargebackTagsAnnotationKey] = desired
+}
+
 // vmCreateDoPlacement determines placement of the VM prior to creating the VM on VC.
 func (vs *vSphereVMProvider) vmCreateDoPlacement(
 	vmCtx pkgctx.VirtualMachineContext,
@@ -837,6 +1369,9 @@ func (vs *vSphereVMProvider) vmCreateDoPlacement(
 				vmopv1.VirtualMachineConditionPlacementReady,
 				"NotReady",
 				retErr.Error())
+			retErr = providers.NewError(
+				providers.ErrorReasonPlacementFailed,
+				toProviderError(retErr))
 		}
 	}()
 
@@ -855,16 +1390,25 @@ func (vs *vSphereVMProvider) vmCreateDoPlacement(
 		return err
 	}
 
+	fullZones, err := vs.vmCreateGetFullZones(vmCtx)
+	if err != nil {
+		return err
+	}
+
 	constraints := placement.Constraints{
-		ChildRPName: createArgs.ChildResourcePoolName,
-		Zones:       pvcZones,
+		ChildRPName:   createArgs.ChildResourcePoolName,
+		Zones:         pvcZones,
+		ExcludedZones: fullZones,
+		PreferredSite: createArgs.PreferredSite,
+
+		RequiredCapabilities: vmCreateGetRequiredCapabilities(vmCtx, createArgs),
 	}
 
 	result, err := placement.Placement(
 		vmCtx,
 		vs.k8sClient,
 		vcClient.VimClient(),
-		vcClient.Finder(),
+		vcClient.Inventory(),
 		placementConfigSpec,
 		constraints)
 	if err != nil {
@@ -924,25 +1468,143 @@ func (vs *vSphereVMProvider) vmCreateDoPlacement(
 		}
 	}
 
+	zoneName := vmCtx.VM.Labels[topology.KubernetesTopologyZoneLabelKey]
+	if result.ZonePlacement {
+		zoneName = result.ZoneName
+	}
+	if err := vs.vmCreateReserveZone(vmCtx, zoneName); err != nil {
+		return err
+	}
+
 	pkgcnd.MarkTrue(vmCtx.VM, vmopv1.VirtualMachineConditionPlacementReady)
 
 	return nil
 }
 
+// vmCreateGetFullZones returns the zones that already have the maximum number
+// of VMs per zone, so they are excluded from the VM's placement.
+func (vs *vSphereVMProvider) vmCreateGetFullZones(
+	vmCtx pkgctx.VirtualMachineContext) (sets.Set[string], error) {
+
+	maxVMs := pkgcfg.FromContext(vmCtx).MaxVirtualMachinesPerZone
+	if maxVMs <= 0 {
+		return nil, nil
+	}
+
+	vs.zoneReservationsLock.Lock()
+	defer vs.zoneReservationsLock.Unlock()
+
+	counts, err := vs.getZoneVMCounts(vmCtx, vmCtx.VM)
+	if err != nil {
+		return nil, err
+	}
+
+	fullZones := sets.New[string]()
+	for zoneName, count := range counts {
+		if count >= maxVMs {
+			fullZones.Insert(zoneName)
+		}
+	}
+
+	return fullZones, nil
+}
+
+// vmCreateReserveZone reserves a spot for the VM in the zone it was placed in.
+// An error is returned if the zone already has the maximum number of VMs per
+// zone. The admission webhook enforces the same limit, but only when the zone
+// label is set on create, and against a count that is racy with concurrent
+// creates. Placement, unlike admission, is serialized here and also counts the
+// VMs placed in the zone that do not have the zone label in the cache yet.
+func (vs *vSphereVMProvider) vmCreateReserveZone(
+	vmCtx pkgctx.VirtualMachineContext,
+	zoneName string) error {
+
+	maxVMs := pkgcfg.FromContext(vmCtx).MaxVirtualMachinesPerZone
+	if maxVMs <= 0 || zoneName == "" {
+		return nil
+	}
+
+	vs.zoneReservationsLock.Lock()
+	defer vs.zoneReservationsLock.Unlock()
+
+	counts, err := vs.getZoneVMCounts(vmCtx, vmCtx.VM)
+	if err != nil {
+		return err
+	}
+
+	if counts[zoneName] >= maxVMs {
+		return fmt.Errorf("zone %s already has the maximum of %d VirtualMachines", zoneName, maxVMs)
+	}
+
+	if vs.zoneReservations == nil {
+		vs.zoneReservations = map[ctrlclient.ObjectKey]string{}
+	}
+	vs.zoneReservations[ctrlclient.ObjectKeyFromObject(vmCtx.VM)] = zoneName
+
+	return nil
+}
+
+// getZoneVMCounts returns the number of VMs in each zone, excluding the
+// specified VM. The VMs whose zone label is not in the cache yet are counted
+// in the zone they were reserved in, and the other reservations are removed.
+// The caller must hold zoneReservationsLock.
+func (vs *vSphereVMProvider) getZoneVMCounts(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine) (map[string]int, error) {
+
+	var vmList vmopv1.VirtualMachineList
+	if err := vs.k8sClient.List(ctx, &vmList); err != nil {
+		return nil, err
+	}
+
+	self := ctrlclient.ObjectKeyFromObject(vm)
+
+	vmZones := make(map[ctrlclient.ObjectKey]string, len(vmList.Items))
+	for i := range vmList.Items {
+		if key := ctrlclient.ObjectKeyFromObject(&vmList.Items[i]); key != self {
+			vmZones[key] = vmList.Items[i].Labels[topology.KubernetesTopologyZoneLabelKey]
+		}
+	}
+
+	for key, zoneName := range vs.zoneReservations {
+		if key == self {
+			continue
+		}
+		if curZoneName, ok := vmZones[key]; !ok || curZoneName != "" {
+			// The VM was deleted or its zone label is now in the cache.
+			delete(vs.zoneReservations, key)
+			continue
+		}
+		vmZones[key] = zoneName
+	}
+
+	counts := map[string]int{}
+	for _, zoneName := range vmZones {
+		if zoneName != "" {
+			counts[zoneName]++
+		}
+	}
+
+	return counts, nil
+}
+
 // vmCreateGetFolderAndRPMoIDs gets the MoIDs of the Folder and Resource Pool the VM will be created under.
 func (vs *vSphereVMProvider) vmCreateGetFolderAndRPMoIDs(
 	vmCtx pkgctx.VirtualMachineContext,
 	vcClient *vcclient.Client,
 	createArgs *VMCreateArgs) error {
 
+	var nsFolderMoID string
+
 	if createArgs.ResourcePoolMoID == "" {
 		// We did not do placement so find this namespace/zone ResourcePool and Folder.
 
-		nsFolderMoID, rpMoID, err := topology.GetNamespaceFolderAndRPMoID(vmCtx, vs.k8sClient,
+		folderMoID, rpMoID, err := topology.GetNamespaceFolderAndRPMoID(vmCtx, vs.k8sClient,
 			vmCtx.VM.Labels[topology.KubernetesTopologyZoneLabelKey], vmCtx.VM.Namespace)
 		if err != nil {
 			return err
 		}
+		nsFolderMoID = folderMoID
 
 		// If this VM has a ResourcePolicy ResourcePool, lookup the child ResourcePool under the
 		// namespace/zone's root ResourcePool. This will be the VM's ResourcePool.
@@ -963,10 +1625,11 @@ func (vs *vSphereVMProvider) vmCreateGetFolderAndRPMoIDs(
 
 	} else {
 		// Placement already selected the ResourcePool/Cluster, so we just need this namespace's Folder.
-		nsFolderMoID, err := topology.GetNamespaceFolderMoID(vmCtx, vs.k8sClient, vmCtx.VM.Namespace)
+		folderMoID, err := topology.GetNamespaceFolderMoID(vmCtx, vs.k8sClient, vmCtx.VM.Namespace)
 		if err != nil {
 			return err
 		}
+		nsFolderMoID = folderMoID
 
 		createArgs.FolderMoID = nsFolderMoID
 	}
@@ -986,15 +1649,137 @@ func (vs *vSphereVMProvider) vmCreateGetFolderAndRPMoIDs(
 	}
 
 	// Now that we know the ResourcePool, use that to look up the CCR.
-	clusterMoRef, err := vcenter.GetResourcePoolOwnerMoRef(vmCtx, vcClient.VimClient(), createArgs.ResourcePoolMoID)
+	clusterMoRef, err := vs.getResourcePoolOwnerMoRef(vmCtx, vcClient.VimClient(), createArgs.ResourcePoolMoID)
 	if err != nil {
 		return err
 	}
 	createArgs.ClusterMoRef = clusterMoRef
 
+	// The folder path template, if any, takes precedence over the ResourcePolicy
+	// Folder. The template is resolved under the namespace's Folder so the VM
+	// remains in its namespace's Folder hierarchy.
+	if template := pkgcfg.FromContext(vmCtx).VMFolderPathTemplate; template != "" {
+		folderMoID, err := vs.vmCreateGetTemplateFolderMoID(vmCtx, vcClient, createArgs, nsFolderMoID, template)
+		if err != nil {
+			return fmt.Errorf("failed to create folder from path template: %w", err)
+		}
+		createArgs.FolderMoID = folderMoID
+	}
+
 	return nil
 }
 
+// vmCreateGetZoneContentLibrary sets the content library that is co-located
+// with the zone the VM is placed in, if one is configured.
+func (vs *vSphereVMProvider) vmCreateGetZoneContentLibrary(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcClient *vcclient.Client,
+	createArgs *VMCreateArgs) {
+
+	if !createArgs.UseContentLibrary {
+		return
+	}
+
+	zoneName := createArgs.ZoneName
+	if zoneName == "" {
+		zoneName = vmCtx.VM.Labels[topology.KubernetesTopologyZoneLabelKey]
+	}
+	if zoneName == "" {
+		return
+	}
+
+	if config := vcClient.Config(); config != nil {
+		createArgs.ZoneContentLibraryID = config.ZoneContentLibraries[zoneName]
+	}
+}
+
+// vmCreateGetZoneLibraryItem returns the item in the zone's content library
+// that the VM should be deployed from instead of the image's item, or nil if
+// the image's item should be used.
+func (vs *vSphereVMProvider) vmCreateGetZoneLibraryItem(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcClient *vcclient.Client,
+	createArgs *VMCreateArgs) *library.Item {
+
+	if createArgs.ZoneContentLibraryID == "" || createArgs.ImageStatus.Type != "OVF" {
+		return nil
+	}
+
+	clProvider := contentlibrary.NewProvider(vmCtx, vcClient.RestClient())
+	item, err := clProvider.GetLibraryItemID(vmCtx, createArgs.ImageStatus.ProviderItemID)
+	if err != nil {
+		vmCtx.Logger.Error(err, "Failed to get image library item",
+			"itemID", createArgs.ImageStatus.ProviderItemID)
+		return nil
+	}
+
+	zoneItem := vmlifecycle.GetZoneLibraryItem(
+		vmCtx,
+		clProvider,
+		item,
+		createArgs.ZoneContentLibraryID)
+	if zoneItem.ID == item.ID {
+		return nil
+	}
+	return zoneItem
+}
+
+// vmCreateGetRequiredCapabilities returns the capabilities the cluster and
+// host of the VM must have for the VM's image and class.
+func vmCreateGetRequiredCapabilities(
+	vmCtx pkgctx.VirtualMachineContext,
+	createArgs *VMCreateArgs) []virtualmachine.Capability {
+
+	required := virtualmachine.RequiredCapabilities(
+		createArgs.ConfigSpec,
+		&createArgs.VMClass,
+		createArgs.ImageObj)
+
+	// The key provider of a VM with an EncryptionClass comes from the class,
+	// so a default key provider is not required for its vTPM.
+	if c := vmCtx.VM.Spec.Crypto; c != nil && c.EncryptionClassName != "" {
+		required = slices.DeleteFunc(required, func(r virtualmachine.Capability) bool {
+			return r == virtualmachine.CapabilityVTPM
+		})
+	}
+
+	return required
+}
+
+func (vs *vSphereVMProvider) vmCreateGetTemplateFolderMoID(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcClient *vcclient.Client,
+	createArgs *VMCreateArgs,
+	nsFolderMoID, template string) (string, error) {
+
+	clusterName, err := object.NewClusterComputeResource(
+		vcClient.VimClient(), createArgs.ClusterMoRef).ObjectName(vmCtx)
+	if err != nil {
+		return "", err
+	}
+
+	var resourcePolicyName string
+	if createArgs.ResourcePolicy != nil {
+		resourcePolicyName = createArgs.ResourcePolicy.Name
+	}
+
+	path, err := vcenter.ResolveFolderPathTemplate(template, map[string]string{
+		vcenter.FolderPathTemplateCluster:        clusterName,
+		vcenter.FolderPathTemplateZone:           vmCtx.VM.Labels[topology.KubernetesTopologyZoneLabelKey],
+		vcenter.FolderPathTemplateNamespace:      vmCtx.VM.Namespace,
+		vcenter.FolderPathTemplateResourcePolicy: resourcePolicyName,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return vcenter.CreateFolderPath(
+		vmCtx,
+		vcClient.VimClient(),
+		nsFolderMoID,
+		path)
+}
+
 // vmCreateGetSourceDiskPaths gets paths to the source disk(s) used to create
 // the VM.
 func (vs *vSphereVMProvider) vmCreateGetSourceDiskPaths(
@@ -1013,6 +1798,13 @@ func (vs *vSphereVMProvider) vmCreateGetSourceDiskPaths(
 		itemVersion  = createArgs.ImageStatus.ProviderContentVersion
 	)
 
+	// Cache the disks of the item in the zone's content library when it has
+	// the same content as the image's item.
+	if zoneItem := vs.vmCreateGetZoneLibraryItem(vmCtx, vcClient, createArgs); zoneItem != nil {
+		itemID = zoneItem.ID
+		itemVersion = zoneItem.ContentVersion
+	}
+
 	// Create/patch/get the VirtualMachineImageCache resource.
 	obj := vmopv1.VirtualMachineImageCache{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1284,6 +2076,9 @@ func (vs *vSphereVMProvider) vmCreateGetVirtualMachineImage(
 
 	imageObj, imageSpec, imageStatus, err := GetVirtualMachineImageSpecAndStatus(vmCtx, vs.k8sClient)
 	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return providers.NewError(providers.ErrorReasonImageNotFound, err)
+		}
 		return err
 	}
 
@@ -1331,10 +2126,26 @@ func (vs *vSphereVMProvider) vmCreateGetSetResourcePolicy(
 		createArgs.ChildFolderName = resourcePolicy.Spec.Folder
 		createArgs.ChildResourcePoolName = resourcePolicy.Spec.ResourcePool.Name
 	}
+	createArgs.PreferredSite = getPreferredSite(vmCtx.VM, resourcePolicy)
 
 	return nil
 }
 
+// getPreferredSite returns the VM's preferred site. The VM's preferred site
+// takes precedence over its SetResourcePolicy's.
+func getPreferredSite(
+	vm *vmopv1.VirtualMachine,
+	resourcePolicy *vmopv1.VirtualMachineSetResourcePolicy) string {
+
+	if site := vm.Annotations[pkgconst.PreferredSiteAnnotationKey]; site != "" {
+		return site
+	}
+	if resourcePolicy != nil {
+		return resourcePolicy.Annotations[pkgconst.PreferredSiteAnnotationKey]
+	}
+	return ""
+}
+
 func (vs *vSphereVMProvider) vmCreateGetBootstrap(
 	vmCtx pkgctx.VirtualMachineContext,
 	createArgs *VMCreateArgs) error {
@@ -1388,7 +2199,7 @@ func (vs *vSphereVMProvider) vmCreateGetStoragePrereqs(
 			return err
 		}
 
-		datastore, err := vcClient.Finder().Datastore(vmCtx, cfg.Datastore)
+		datastore, err := vcClient.Inventory().Datastore(vmCtx, cfg.Datastore)
 		if err != nil {
 			pkgcnd.MarkFalse(vmCtx.VM, vmopv1.VirtualMachineConditionStorageReady, "DatastoreNotFound", err.Error())
 			return fmt.Errorf("failed to find Datastore %s: %w", cfg.Datastore, err)
@@ -1404,7 +2215,14 @@ func (vs *vSphereVMProvider) vmCreateGetStoragePrereqs(
 		return err
 	}
 
-	vmStorageProfileID := vmStorage.StorageClassToPolicyID[vmStorageClass]
+	// The StorageClass may specify a site affinity storage policy for VMs that
+	// prefer the site.
+	vmStorageProfileID, err := vmStorage.StoragePolicyIDForSite(vmStorageClass, createArgs.PreferredSite)
+	if err != nil {
+		pkgcnd.MarkFalse(vmCtx.VM, vmopv1.VirtualMachineConditionStorageReady, "InvalidSiteStoragePolicy", err.Error())
+		return err
+	}
+
 	provisioningType, err := virtualmachine.GetDefaultDiskProvisioningType(vmCtx, vcClient, vmStorageProfileID)
 	if err != nil {
 		reason, msg := errToConditionReasonAndMessage(err)
@@ -1435,12 +2253,19 @@ func (vs *vSphereVMProvider) vmCreateDoNetworking(
 		vmCtx,
 		vs.k8sClient,
 		vcClient.VimClient(),
-		vcClient.Finder(),
+		vcClient.Inventory(),
 		nil, // Don't know the CCR yet (needed to resolve backings for NSX-T)
 		networkSpec)
 	if err != nil {
-		pkgcnd.MarkFalse(vmCtx.VM, vmopv1.VirtualMachineConditionNetworkReady, "NotReady", err.Error())
-		return err
+		reason := "NotReady"
+		switch {
+		case errors.Is(err, network.ErrVirtualNetworkNotFound):
+			reason = network.VirtualNetworkNotFoundReason
+		case errors.Is(err, network.ErrVirtualNetworkNotReady):
+			reason = network.VirtualNetworkNotReadyReason
+		}
+		pkgcnd.MarkFalse(vmCtx.VM, vmopv1.VirtualMachineConditionNetworkReady, reason, err.Error())
+		return providers.NewError(providers.ErrorReasonNetworkNotReady, err)
 	}
 
 	createArgs.NetworkResults = results
@@ -1483,6 +2308,11 @@ func (vs *vSphereVMProvider) vmCreateGenConfigSpec(
 		createArgs.ImageStatus,
 		minCPUFreq)
 
+	addImageVGPUDevices(
+		vmCtx,
+		&createArgs.ConfigSpec,
+		createArgs.ImageStatus.VGPUDevices)
+
 	if pkgcfg.FromContext(vmCtx).Features.FastDeploy {
 		if err := vs.vmCreateGenConfigSpecImage(vmCtx, createArgs); err != nil {
 			return err
@@ -1630,11 +2460,94 @@ func (vs *vSphereVMProvider) vmCreateGenConfigSpecImage(
 	return nil
 }
 
+// addImageVGPUDevices adds the vGPU devices the image requires to the
+// ConfigSpec. The image's devices are not added if the ConfigSpec already has
+// vGPU devices from the class, since a VM's vGPU devices must all have the same
+// profile and the class's devices take precedence.
+func addImageVGPUDevices(
+	vmCtx pkgctx.VirtualMachineContext,
+	configSpec *vimtypes.VirtualMachineConfigSpec,
+	imageDevices []vmopv1.VGPUDevice) {
+
+	if len(imageDevices) == 0 {
+		return
+	}
+
+	var (
+		classProfiles []string
+		deviceKey     int32
+	)
+
+	for _, dc := range configSpec.DeviceChange {
+		spec := dc.GetVirtualDeviceConfigSpec()
+		if spec == nil || spec.Device == nil {
+			continue
+		}
+		dev := spec.Device.GetVirtualDevice()
+		if dev.Key < deviceKey {
+			deviceKey = dev.Key
+		}
+		if backing, ok := dev.Backing.(*vimtypes.VirtualPCIPassthroughVmiopBackingInfo); ok {
+			classProfiles = append(classProfiles, backing.Vgpu)
+		}
+	}
+
+	if len(classProfiles) > 0 {
+		vmCtx.Logger.V(4).Info("Class vGPU devices override image vGPU devices",
+			"classProfiles", classProfiles,
+			"imageDevices", imageDevices)
+		return
+	}
+
+	for _, d := range imageDevices {
+		deviceKey--
+		configSpec.DeviceChange = append(
+			configSpec.DeviceChange,
+			&vimtypes.VirtualDeviceConfigSpec{
+				Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+				Device: virtualmachine.CreatePCIPassThroughDevice(
+					deviceKey,
+					&vimtypes.VirtualPCIPassthroughVmiopBackingInfo{
+						Vgpu: d.ProfileName,
+					}),
+			})
+	}
+}
+
 func (vs *vSphereVMProvider) vmCreateGenConfigSpecExtraConfig(
 	vmCtx pkgctx.VirtualMachineContext,
 	createArgs *VMCreateArgs) error {
 
-	ecMap := maps.Clone(vs.globalExtraConfig)
+	ecMap := map[string]string{}
+
+	// The ConfigSpec's current ExtraConfig values came from the class.
+	classECMap := pkgutil.OptionValues(createArgs.ConfigSpec.ExtraConfig).StringMap()
+
+	// Merge the extraConfig the image requires first so the keys from the
+	// global extraConfig and the keys managed by VM Operator take precedence.
+	for _, kv := range createArgs.ImageStatus.ExtraConfig {
+		if pkgutil.IsOperatorManagedExtraConfigKey(kv.Key) {
+			vmCtx.Logger.Info("Skipping image extraConfig key managed by VM Operator",
+				"key", kv.Key)
+			continue
+		}
+		if cv, ok := classECMap[kv.Key]; ok {
+			if cv != kv.Value {
+				vmCtx.Logger.Info("Class extraConfig overrides image extraConfig",
+					"key", kv.Key, "imageValue", kv.Value, "value", cv)
+			}
+			continue
+		}
+		ecMap[kv.Key] = kv.Value
+	}
+
+	for k, v := range vs.globalExtraConfig {
+		if iv, ok := ecMap[k]; ok && iv != v {
+			vmCtx.Logger.Info("Global extraConfig overrides image extraConfig",
+				"key", k, "imageValue", iv, "value", v)
+		}
+		ecMap[k] = v
+	}
 
 	if v, exists := ecMap[constants.ExtraConfigRunContainerKey]; exists {
 		// The local-vcsim config sets the JSON_EXTRA_CONFIG with RUN.container so vcsim
@@ -1815,6 +2728,37 @@ func (vs *vSphereVMProvider) vmUpdateGetArgs(
 		vmopv1.VirtualMachineImageStatus{},
 		updateArgs.MinCPUFreq)
 
+	// The vGPU devices the image required when the VM was deployed are
+	// expected unless the image no longer exists.
+	if img := vmCtx.VM.Spec.Image; img != nil && img.Kind != "" {
+		vmi, err := vmopv1util.GetImage(vmCtx, vs.k8sClient, *img, vmCtx.VM.Namespace)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+			vmCtx.Logger.V(4).Info("Skipping image vGPU devices for missing image",
+				"image", img)
+		}
+		addImageVGPUDevices(vmCtx, &updateArgs.ConfigSpec, vmi.Status.VGPUDevices)
+	}
+
+	if adv := vmCtx.VM.Spec.Advanced; adv != nil && len(adv.DataDisks) > 0 {
+		vmStorage, err := storage.GetVMStorageData(vmCtx, vs.k8sClient)
+		if err != nil {
+			return nil, err
+		}
+		updateArgs.StorageClassToPolicyID = vmStorage.StorageClassToPolicyID
+
+		// The VM's StorageClass may specify a site affinity storage policy for
+		// VMs that prefer a site, which is also applied to its data disks.
+		updateArgs.StorageProfileID, err = vmStorage.StoragePolicyIDForSite(
+			vmCtx.VM.Spec.StorageClass,
+			getPreferredSite(vmCtx.VM, resourcePolicy))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return updateArgs, nil
 }
 
@@ -269,6 +269,22 @@ func vmE2ETests() {
 		})
 
 		JustBeforeEach(func() {
+			vnet := &ncpv1alpha1.VirtualNetwork{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      networkName,
+					Namespace: vm.Namespace,
+				},
+				Status: ncpv1alpha1.VirtualNetworkStatus{
+					Conditions: []ncpv1alpha1.VirtualNetworkCondition{
+						{
+							Type:   "Ready",
+							Status: "True",
+						},
+					},
+				},
+			}
+			Expect(ctx.Client.Create(ctx, vnet)).To(Succeed())
+
 			vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
 				Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
 					{
@@ -281,6 +297,22 @@ func vmE2ETests() {
 			}
 		})
 
+		When("the VirtualNetwork is not realized", func() {
+			JustBeforeEach(func() {
+				vnet := &ncpv1alpha1.VirtualNetwork{}
+				Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: vm.Namespace, Name: networkName}, vnet)).To(Succeed())
+				vnet.Status.Conditions[0].Status = "False"
+				Expect(ctx.Client.Update(ctx, vnet)).To(Succeed())
+			})
+
+			It("marks the network condition with a precise reason", func() {
+				err := createOrUpdateVM(ctx, vmProvider, vm)
+				Expect(err).To(MatchError(network.ErrVirtualNetworkNotReady))
+				Expect(conditions.IsFalse(vm, vmopv1.VirtualMachineConditionNetworkReady)).To(BeTrue())
+				Expect(conditions.GetReason(vm, vmopv1.VirtualMachineConditionNetworkReady)).To(Equal(network.VirtualNetworkNotReadyReason))
+			})
+		})
+
 		Context("Sysprep Bootstrap", func() {
 
 			JustBeforeEach(func() {
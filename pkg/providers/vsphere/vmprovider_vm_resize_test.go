@@ -7,6 +7,7 @@ package vsphere_test
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -20,6 +21,7 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
@@ -212,6 +214,61 @@ func vmResizeTests() {
 					})
 				})
 
+				Context("Snapshot before reconfigure", func() {
+					BeforeEach(func() {
+						vm.Annotations = map[string]string{
+							pkgconst.ReconfigureSnapshotAnnotationKey: "1h",
+						}
+					})
+
+					It("Takes a snapshot that is removed after the grace period", func() {
+						vm.Annotations[vmopv1.FirstBootDoneAnnotation] = "true"
+
+						newCS := configSpec
+						newCS.NumCPUs = 4
+						newVMClass := createVMClass(newCS)
+						vm.Spec.ClassName = newVMClass.Name
+
+						vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+						Expect(err).ToNot(HaveOccurred())
+
+						var o mo.VirtualMachine
+						Expect(vcVM.Properties(ctx, vcVM.Reference(), nil, &o)).To(Succeed())
+						Expect(o.Config.Hardware.NumCPU).To(BeEquivalentTo(newCS.NumCPUs))
+
+						ref := vm.Annotations[pkgconst.ReconfigureSnapshotRefAnnotationKey]
+						Expect(ref).ToNot(BeEmpty())
+						Expect(vm.Annotations).To(HaveKey(pkgconst.ReconfigureSnapshotTimeAnnotationKey))
+						_, err = vcVM.FindSnapshot(ctx, ref)
+						Expect(err).ToNot(HaveOccurred())
+
+						By("Keeping the snapshot during the grace period", func() {
+							Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+							Expect(vm.Annotations).To(HaveKeyWithValue(pkgconst.ReconfigureSnapshotRefAnnotationKey, ref))
+						})
+
+						By("Removing the snapshot after the grace period", func() {
+							vm.Annotations[pkgconst.ReconfigureSnapshotTimeAnnotationKey] =
+								time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+							Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+							Expect(vm.Annotations).ToNot(HaveKey(pkgconst.ReconfigureSnapshotRefAnnotationKey))
+							Expect(vm.Annotations).ToNot(HaveKey(pkgconst.ReconfigureSnapshotTimeAnnotationKey))
+							_, err = vcVM.FindSnapshot(ctx, ref)
+							Expect(err).To(HaveOccurred())
+						})
+					})
+
+					It("Does not take a snapshot before the first boot", func() {
+						newCS := configSpec
+						newCS.NumCPUs = 4
+						newVMClass := createVMClass(newCS)
+						vm.Spec.ClassName = newVMClass.Name
+
+						Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+						Expect(vm.Annotations).ToNot(HaveKey(pkgconst.ReconfigureSnapshotRefAnnotationKey))
+					})
+				})
+
 				Context("CPU/Memory Reservations", func() {
 
 					Context("No reservations", func() {
@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -26,9 +28,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	vimcrypto "github.com/vmware/govmomi/crypto"
+	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vapi/cluster"
 	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/tags"
 	"github.com/vmware/govmomi/vim25/mo"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 
@@ -44,7 +49,9 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vcenter"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
+	vmoprecord "github.com/vmware-tanzu/vm-operator/pkg/record"
 	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
@@ -171,6 +178,144 @@ func vmTests() {
 			vsphere.SkipVMImageCLProviderCheck = false
 		})
 
+		Context("Image extraConfig", func() {
+
+			It("should merge the extraConfig the image requires", func() {
+				clusterVMImage := &vmopv1.ClusterVirtualMachineImage{}
+				Expect(ctx.Client.Get(ctx, client.ObjectKey{Name: vm.Spec.Image.Name}, clusterVMImage)).To(Succeed())
+				clusterVMImage.Status.ExtraConfig = []common.KeyValuePair{
+					{Key: "pciPassthru0.cfg.enable_uvm", Value: "1"},
+					{Key: constants.VMOperatorV1Alpha1ExtraConfigKey, Value: "image-value"},
+				}
+				Expect(ctx.Client.Status().Update(ctx, clusterVMImage)).To(Succeed())
+
+				vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+				Expect(err).ToNot(HaveOccurred())
+
+				var o mo.VirtualMachine
+				Expect(vcVM.Properties(ctx, vcVM.Reference(), []string{"config.extraConfig"}, &o)).To(Succeed())
+
+				ecMap := pkgutil.OptionValues(o.Config.ExtraConfig).StringMap()
+				Expect(ecMap).To(HaveKeyWithValue("pciPassthru0.cfg.enable_uvm", "1"))
+				Expect(ecMap).ToNot(HaveKeyWithValue(constants.VMOperatorV1Alpha1ExtraConfigKey, "image-value"))
+			})
+
+			It("should not override the extraConfig from the class", func() {
+				var w bytes.Buffer
+				Expect(vimtypes.NewJSONEncoder(&w).Encode(&vimtypes.VirtualMachineConfigSpec{
+					ExtraConfig: []vimtypes.BaseOptionValue{
+						&vimtypes.OptionValue{Key: "pciPassthru0.cfg.enable_uvm", Value: "0"},
+					},
+				})).To(Succeed())
+				vmClass.Spec.ConfigSpec = w.Bytes()
+				Expect(ctx.Client.Update(ctx, vmClass)).To(Succeed())
+
+				clusterVMImage := &vmopv1.ClusterVirtualMachineImage{}
+				Expect(ctx.Client.Get(ctx, client.ObjectKey{Name: vm.Spec.Image.Name}, clusterVMImage)).To(Succeed())
+				clusterVMImage.Status.ExtraConfig = []common.KeyValuePair{
+					{Key: "pciPassthru0.cfg.enable_uvm", Value: "1"},
+					{Key: "pciPassthru0.cfg.foo", Value: "bar"},
+				}
+				Expect(ctx.Client.Status().Update(ctx, clusterVMImage)).To(Succeed())
+
+				vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+				Expect(err).ToNot(HaveOccurred())
+
+				var o mo.VirtualMachine
+				Expect(vcVM.Properties(ctx, vcVM.Reference(), []string{"config.extraConfig"}, &o)).To(Succeed())
+
+				ecMap := pkgutil.OptionValues(o.Config.ExtraConfig).StringMap()
+				Expect(ecMap).To(HaveKeyWithValue("pciPassthru0.cfg.enable_uvm", "0"))
+				Expect(ecMap).To(HaveKeyWithValue("pciPassthru0.cfg.foo", "bar"))
+			})
+		})
+
+		Context("Image vGPU devices", func() {
+
+			JustBeforeEach(func() {
+				clusterVMImage := &vmopv1.ClusterVirtualMachineImage{}
+				Expect(ctx.Client.Get(ctx, client.ObjectKey{Name: vm.Spec.Image.Name}, clusterVMImage)).To(Succeed())
+				clusterVMImage.Status.VGPUDevices = []vmopv1.VGPUDevice{
+					{ProfileName: "profile-from-image"},
+				}
+				Expect(ctx.Client.Status().Update(ctx, clusterVMImage)).To(Succeed())
+			})
+
+			getVGPUProfiles := func(vcVM *object.VirtualMachine) []string {
+				var o mo.VirtualMachine
+				Expect(vcVM.Properties(ctx, vcVM.Reference(), []string{"config.hardware.device", "config.extraConfig"}, &o)).To(Succeed())
+
+				var profiles []string
+				for _, dev := range pkgutil.SelectNvidiaVgpu(o.Config.Hardware.Device) {
+					profiles = append(profiles, dev.Backing.(*vimtypes.VirtualPCIPassthroughVmiopBackingInfo).Vgpu)
+				}
+
+				ecMap := pkgutil.OptionValues(o.Config.ExtraConfig).StringMap()
+				Expect(ecMap).To(HaveKeyWithValue(constants.PCIPassthruMMIOExtraConfigKey, constants.ExtraConfigTrue))
+
+				return profiles
+			}
+
+			It("should add the vGPU devices the image requires", func() {
+				vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVGPUProfiles(vcVM)).To(ConsistOf("profile-from-image"))
+			})
+
+			When("the class has vGPU devices", func() {
+				JustBeforeEach(func() {
+					var w bytes.Buffer
+					Expect(vimtypes.NewJSONEncoder(&w).Encode(&vimtypes.VirtualMachineConfigSpec{
+						DeviceChange: []vimtypes.BaseVirtualDeviceConfigSpec{
+							&vimtypes.VirtualDeviceConfigSpec{
+								Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+								Device: &vimtypes.VirtualPCIPassthrough{
+									VirtualDevice: vimtypes.VirtualDevice{
+										Backing: &vimtypes.VirtualPCIPassthroughVmiopBackingInfo{
+											Vgpu: "profile-from-class",
+										},
+									},
+								},
+							},
+						},
+					})).To(Succeed())
+					vmClass.Spec.ConfigSpec = w.Bytes()
+					Expect(ctx.Client.Update(ctx, vmClass)).To(Succeed())
+				})
+
+				It("should only add the class's vGPU devices", func() {
+					vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(getVGPUProfiles(vcVM)).To(ConsistOf("profile-from-class"))
+				})
+			})
+		})
+
+		Context("Required capabilities", func() {
+
+			When("the class requires a capability the cluster does not have", func() {
+				BeforeEach(func() {
+					vmClass.Annotations = map[string]string{
+						pkgconst.RequiredCapabilitiesAnnotationKey: "pmem",
+					}
+				})
+
+				It("should fail with a capability mismatch", func() {
+					err := createOrUpdateVM(ctx, vmProvider, vm)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("no placement candidates available with the required capabilities: [pmem]"))
+					reason, ok := providers.ReasonFromError(err)
+					Expect(ok).To(BeTrue())
+					Expect(reason).To(Equal(providers.ErrorReasonCapabilityMismatch))
+					c := conditions.Get(vm, vmopv1.VirtualMachineConditionCreated)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					Expect(c.Reason).To(Equal(string(providers.ErrorReasonCapabilityMismatch)))
+					Expect(vm.Status.UniqueID).To(BeEmpty())
+				})
+			})
+		})
+
 		Context("VM Class and ConfigSpec", func() {
 
 			var (
@@ -1452,6 +1597,44 @@ func vmTests() {
 								ctx.Datacenter.Reference().Value,
 								ctx.Datastore.Reference().Value)
 						})
+
+						When("the zone's content library has the image's item", func() {
+							var zoneItemID string
+
+							JustBeforeEach(func() {
+								cm := &corev1.ConfigMap{}
+								Expect(ctx.Client.Get(ctx, client.ObjectKey{
+									Namespace: ctx.PodNamespace,
+									Name:      "vsphere.provider.config.vmoperator.vmware.com",
+								}, cm)).To(Succeed())
+								data, err := json.Marshal(map[string]string{
+									zoneName: ctx.LocalContentLibraryID,
+								})
+								Expect(err).ToNot(HaveOccurred())
+								cm.Data["ZoneContentLibraries"] = string(data)
+								Expect(ctx.Client.Update(ctx, cm)).To(Succeed())
+
+								itemIDs, err := library.NewManager(ctx.RestClient).FindLibraryItems(
+									ctx,
+									library.FindItem{
+										LibraryID: ctx.LocalContentLibraryID,
+										Name:      "test-image-ovf",
+									})
+								Expect(err).ToNot(HaveOccurred())
+								Expect(itemIDs).To(HaveLen(1))
+								zoneItemID = itemIDs[0]
+							})
+
+							It("should cache the disks of the zone's item", func() {
+								_, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+								assertVMICNotReady(
+									err,
+									"cached disks not ready",
+									pkgutil.VMIName(zoneItemID),
+									ctx.Datacenter.Reference().Value,
+									ctx.Datastore.Reference().Value)
+							})
+						})
 					})
 
 					When("disks are ready", func() {
@@ -1527,6 +1710,13 @@ func vmTests() {
 						Expect(err).To(MatchError(
 							"clustervirtualmachineimages.vmoperator.vmware.com \"does-not-exist\" not found: " +
 								"clustervirtualmachineimages.vmoperator.vmware.com \"does-not-exist\" not found"))
+						reason, ok := providers.ReasonFromError(err)
+						Expect(ok).To(BeTrue())
+						Expect(reason).To(Equal(providers.ErrorReasonImageNotFound))
+						c := conditions.Get(vm, vmopv1.VirtualMachineConditionCreated)
+						Expect(c).ToNot(BeNil())
+						Expect(c.Status).To(Equal(metav1.ConditionFalse))
+						Expect(c.Reason).To(Equal(string(providers.ErrorReasonImageNotFound)))
 						vm.Spec.Image.Name = imgName
 						Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
 						Expect(vm.Status.UniqueID).ToNot(BeEmpty())
@@ -2287,6 +2477,69 @@ func vmTests() {
 				})
 			})
 
+			When("the maximum number of VMs per zone is set", func() {
+				createZoneVM := func(name, zoneName string) {
+					zoneVM := &vmopv1.VirtualMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: vm.Namespace,
+							Labels: map[string]string{
+								topology.KubernetesTopologyZoneLabelKey: zoneName,
+							},
+						},
+					}
+					Expect(ctx.Client.Create(ctx, zoneVM)).To(Succeed())
+				}
+
+				JustBeforeEach(func() {
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.MaxVirtualMachinesPerZone = 1
+					})
+					delete(vm.Labels, topology.KubernetesTopologyZoneLabelKey)
+				})
+
+				It("creates VM in a zone that is not full", func() {
+					Expect(len(ctx.ZoneNames)).To(BeNumerically(">", 1))
+					for _, zoneName := range ctx.ZoneNames[1:] {
+						createZoneVM("vm-"+zoneName, zoneName)
+					}
+
+					_, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vm.Labels).To(HaveKeyWithValue(topology.KubernetesTopologyZoneLabelKey, ctx.ZoneNames[0]))
+				})
+
+				It("does not create VM when all the zones are full", func() {
+					for _, zoneName := range ctx.ZoneNames {
+						createZoneVM("vm-"+zoneName, zoneName)
+					}
+
+					err := createOrUpdateVM(ctx, vmProvider, vm)
+					Expect(err).To(MatchError(ContainSubstring("no placement candidates available after excluding zones")))
+				})
+
+				It("does not create VM in a zone that is full with VMs placed in it", func() {
+					Expect(len(ctx.ZoneNames)).To(BeNumerically(">", 1))
+					for _, zoneName := range ctx.ZoneNames[1:] {
+						createZoneVM("vm-"+zoneName, zoneName)
+					}
+
+					vm2 := vm.DeepCopy()
+					vm2.Name = "vm-2"
+					vm2.Labels[topology.KubernetesTopologyZoneLabelKey] = ctx.ZoneNames[0]
+
+					By("placing a VM whose zone label is not in the cache yet", func() {
+						Expect(ctx.Client.Create(ctx, vm.DeepCopy())).To(Succeed())
+						Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+						Expect(vm.Labels).To(HaveKeyWithValue(topology.KubernetesTopologyZoneLabelKey, ctx.ZoneNames[0]))
+					})
+
+					err := createOrUpdateVM(ctx, vmProvider, vm2)
+					Expect(err).To(MatchError(ContainSubstring(
+						fmt.Sprintf("zone %s already has the maximum of 1 VirtualMachines", ctx.ZoneNames[0]))))
+				})
+			})
+
 			When("VM zone is constrained by PVC", func() {
 				BeforeEach(func() {
 					// Need to create the PVC before creating the VM.
@@ -2446,6 +2699,231 @@ func vmTests() {
 				Expect(state).To(Equal(vimtypes.VirtualMachinePowerStatePoweredOff))
 			})
 
+			It("Defers the reconfigure of a powered on VM to batch spec changes", func() {
+				vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+
+				// Cause the VM to drift from its spec.
+				task, err := vcVM.Reconfigure(ctx, vimtypes.VirtualMachineConfigSpec{
+					ExtraConfig: []vimtypes.BaseOptionValue{
+						&vimtypes.OptionValue{Key: constants.MMPowerOffVMExtraConfigKey, Value: "true"},
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task.Wait(ctx)).To(Succeed())
+
+				getExtraConfig := func() map[string]string {
+					var o mo.VirtualMachine
+					ExpectWithOffset(1, vcVM.Properties(ctx, vcVM.Reference(), []string{"config.extraConfig"}, &o)).To(Succeed())
+					return pkgutil.OptionValues(o.Config.ExtraConfig).StringMap()
+				}
+
+				ctx.Context = ctxop.WithContext(ctx.Context)
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.ReconfigureBatchWindow = time.Hour
+				})
+
+				err = createOrUpdateVM(ctx, vmProvider, vm)
+				Expect(err).To(BeAssignableToTypeOf(pkgerr.RequeueError{}))
+				Expect(vm.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+				Expect(getExtraConfig()).To(HaveKeyWithValue(constants.MMPowerOffVMExtraConfigKey, "true"))
+
+				By("Reconfiguring the VM once the batch window has elapsed", func() {
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.ReconfigureBatchWindow = 0
+					})
+					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+					Expect(getExtraConfig()).ToNot(HaveKey(constants.MMPowerOffVMExtraConfigKey))
+				})
+			})
+
+			It("Retries guest customization that failed due to a duplicate identity", func() {
+				recorder, events := builder.NewFakeRecorder()
+				ctx.Context = vmoprecord.WithContext(ctx.Context, recorder)
+
+				vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+					GuestCustomizationRetryPolicy: vmopv1.GuestCustomizationRetryPolicyRegenerateIdentity,
+				}
+				vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+				Expect(vm.Annotations).ToNot(HaveKey(pkgconst.GuestCustomizationRetryAnnotationKey))
+
+				simulator.Map.WithLock(
+					simulator.SpoofContext(),
+					vcVM.Reference(),
+					func() {
+						simVM := simulator.Map.Get(vcVM.Reference()).(*simulator.VirtualMachine)
+						simVM.Guest.CustomizationInfo = &vimtypes.GuestInfoCustomizationInfo{
+							CustomizationStatus: string(vimtypes.GuestInfoCustomizationStatusTOOLSDEPLOYPKG_FAILED),
+							ErrorMsg:            "duplicate hostname",
+						}
+					})
+
+				Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+				suffix := vm.Annotations[pkgconst.GuestCustomizationRetryAnnotationKey]
+				Expect(suffix).ToNot(BeEmpty())
+				Expect(events).To(Receive(And(
+					ContainSubstring("GuestCustomizationRetry"),
+					ContainSubstring(vm.Name+"-"+suffix))))
+
+				Expect(vm.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+				state, err := vcVM.PowerState(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(state).To(Equal(vimtypes.VirtualMachinePowerStatePoweredOn))
+
+				By("Only retrying once", func() {
+					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+					Expect(vm.Annotations).To(HaveKeyWithValue(pkgconst.GuestCustomizationRetryAnnotationKey, suffix))
+					Expect(events).ToNot(Receive(ContainSubstring("GuestCustomizationRetry")))
+				})
+			})
+
+			When("observer mode is enabled", func() {
+				JustBeforeEach(func() {
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.ObserverMode = true
+					})
+				})
+
+				It("does not create the VM", func() {
+					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+					Expect(vm.Status.UniqueID).To(BeEmpty())
+
+					c := conditions.Get(vm, vmopv1.VirtualMachineConditionCreated)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					Expect(c.Reason).To(Equal(vsphere.ObserverModeReason))
+				})
+
+				It("updates the status of an existing VM without changing or deleting it", func() {
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.ObserverMode = false
+					})
+					vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vm.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.ObserverMode = true
+					})
+
+					vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+					Expect(vm.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+					state, err := vcVM.PowerState(ctx)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(state).To(Equal(vimtypes.VirtualMachinePowerStatePoweredOn))
+
+					Expect(vmProvider.DeleteVirtualMachine(ctx, vm)).To(Succeed())
+					_, err = vcVM.PowerState(ctx)
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+
+			When("the VM has a stalled task", func() {
+				var (
+					vcVM    *object.VirtualMachine
+					taskRef vimtypes.ManagedObjectReference
+				)
+
+				JustBeforeEach(func() {
+					var err error
+					vcVM, err = createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+					Expect(err).ToNot(HaveOccurred())
+
+					var o mo.VirtualMachine
+					Expect(vcVM.Properties(ctx, vcVM.Reference(), []string{"recentTask"}, &o)).To(Succeed())
+					Expect(o.RecentTask).ToNot(BeEmpty())
+					taskRef = o.RecentTask[0]
+
+					simulator.Map.WithLock(
+						simulator.SpoofContext(),
+						taskRef,
+						func() {
+							task := simulator.Map.Get(taskRef).(*simulator.Task)
+							start := time.Now().Add(-2 * time.Hour)
+							task.Info.State = vimtypes.TaskInfoStateRunning
+							task.Info.StartTime = &start
+							task.Info.CompleteTime = nil
+							task.Info.Cancelable = true
+						})
+
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.StalledTaskThreshold = time.Hour
+					})
+				})
+
+				It("marks the VM and requeues", func() {
+					var requeueErr pkgerr.RequeueError
+					Expect(errors.As(createOrUpdateVM(ctx, vmProvider, vm), &requeueErr)).To(BeTrue())
+					Expect(requeueErr.After).To(Equal(time.Minute))
+
+					c := conditions.Get(vm, vmopv1.VirtualMachineConditionTaskStalled)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionTrue))
+					Expect(c.Reason).To(Equal(vmopv1.VirtualMachineTaskInProgressReason))
+					Expect(c.Message).To(ContainSubstring(taskRef.Value))
+
+					By("Removing the condition once the task is no longer stalled", func() {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.StalledTaskThreshold = 3 * time.Hour
+						})
+						Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+						Expect(conditions.Get(vm, vmopv1.VirtualMachineConditionTaskStalled)).To(BeNil())
+					})
+				})
+
+				When("cancelling stalled tasks is enabled", func() {
+					JustBeforeEach(func() {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.Features.CancelStalledTasks = true
+						})
+					})
+
+					It("does not cancel a task started by another user", func() {
+						var requeueErr pkgerr.RequeueError
+						Expect(errors.As(createOrUpdateVM(ctx, vmProvider, vm), &requeueErr)).To(BeTrue())
+
+						c := conditions.Get(vm, vmopv1.VirtualMachineConditionTaskStalled)
+						Expect(c).ToNot(BeNil())
+						Expect(c.Reason).To(Equal(vmopv1.VirtualMachineTaskInProgressReason))
+
+						var t mo.Task
+						Expect(vcVM.Properties(ctx, taskRef, []string{"info"}, &t)).To(Succeed())
+						Expect(t.Info.Cancelled).To(BeFalse())
+					})
+
+					It("cancels the task started by VM Operator", func() {
+						userName, err := vcenter.GetSessionUserName(ctx, vcVM.Client())
+						Expect(err).ToNot(HaveOccurred())
+						simulator.Map.WithLock(
+							simulator.SpoofContext(),
+							taskRef,
+							func() {
+								task := simulator.Map.Get(taskRef).(*simulator.Task)
+								task.Info.Reason = &vimtypes.TaskReasonUser{UserName: userName}
+							})
+
+						var requeueErr pkgerr.RequeueError
+						Expect(errors.As(createOrUpdateVM(ctx, vmProvider, vm), &requeueErr)).To(BeTrue())
+						Expect(requeueErr.After).To(Equal(time.Minute))
+
+						c := conditions.Get(vm, vmopv1.VirtualMachineConditionTaskStalled)
+						Expect(c).ToNot(BeNil())
+						Expect(c.Reason).To(Equal(vmopv1.VirtualMachineTaskCancelledReason))
+
+						var t mo.Task
+						Expect(vcVM.Properties(ctx, taskRef, []string{"info"}, &t)).To(Succeed())
+						Expect(t.Info.Cancelled).To(BeTrue())
+
+						Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+						Expect(conditions.Get(vm, vmopv1.VirtualMachineConditionTaskStalled)).To(BeNil())
+					})
+				})
+			})
+
 			It("returns error when StorageClass is required but none specified", func() {
 				vm.Spec.StorageClass = ""
 				err := createOrUpdateVM(ctx, vmProvider, vm)
@@ -2692,6 +3170,60 @@ func vmTests() {
 						Expect(disk.CapacityInBytes).To(BeEquivalentTo(newSize.Value()))
 					})
 				})
+
+				Context("Should add data disks", func() {
+					It("Succeeds", func() {
+						if vm.Spec.Advanced == nil {
+							vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{}
+						}
+						vm.Spec.Advanced.DataDisks = []vmopv1.VirtualMachineDataDiskSpec{
+							{
+								Name:     "data-1",
+								Capacity: resource.MustParse("1Gi"),
+							},
+							{
+								Name:             "data-2",
+								Capacity:         resource.MustParse("2Gi"),
+								ProvisioningMode: vmopv1.VirtualMachineVolumeProvisioningModeThickEagerZero,
+							},
+						}
+						vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+						vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+						Expect(err).ToNot(HaveOccurred())
+
+						getDataDisks := func() map[string]*vimtypes.VirtualDisk {
+							var o mo.VirtualMachine
+							ExpectWithOffset(1, vcVM.Properties(ctx, vcVM.Reference(), []string{"config.hardware.device"}, &o)).To(Succeed())
+
+							disks := map[string]*vimtypes.VirtualDisk{}
+							devices := object.VirtualDeviceList(o.Config.Hardware.Device)
+							for _, d := range devices.SelectByType((*vimtypes.VirtualDisk)(nil)) {
+								disk := d.(*vimtypes.VirtualDisk)
+								backing := disk.Backing.(*vimtypes.VirtualDiskFlatVer2BackingInfo)
+								for _, name := range []string{"data-1", "data-2"} {
+									if strings.HasSuffix(backing.FileName, "/"+vm.Name+"-data-"+name+".vmdk") {
+										disks[name] = disk
+									}
+								}
+							}
+							return disks
+						}
+
+						disks := getDataDisks()
+						Expect(disks).To(HaveLen(2))
+						Expect(disks["data-1"].CapacityInBytes).To(BeEquivalentTo(1024 * 1024 * 1024))
+						Expect(disks["data-2"].CapacityInBytes).To(BeEquivalentTo(2 * 1024 * 1024 * 1024))
+						Expect(disks["data-2"].Backing.(*vimtypes.VirtualDiskFlatVer2BackingInfo).EagerlyScrub).To(PointTo(BeTrue()))
+
+						By("not adding the data disks again", func() {
+							vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+							Expect(vmProvider.CreateOrUpdateVirtualMachine(ctx, vm)).To(Succeed())
+							vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+							Expect(vmProvider.CreateOrUpdateVirtualMachine(ctx, vm)).To(Succeed())
+							Expect(getDataDisks()).To(HaveLen(2))
+						})
+					})
+				})
 			})
 
 			Context("CNS Volumes", func() {
@@ -2812,6 +3344,25 @@ func vmTests() {
 				})
 			})
 
+			When("there is a folder path template", func() {
+				JustBeforeEach(func() {
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.VMFolderPathTemplate = "tanzu/{resource-policy}"
+					})
+				})
+
+				It("VM is created in the Folder from the template under the namespace Folder", func() {
+					vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vcVM.InventoryPath).To(HaveSuffix(
+						fmt.Sprintf("/%s/tanzu/%s/%s", nsInfo.Namespace, resourcePolicy.Name, vm.Name)))
+
+					nsFolderPath, err := find.NewFinder(ctx.VCClient.Client).Element(ctx, nsInfo.Folder.Reference())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vcVM.InventoryPath).To(HavePrefix(nsFolderPath.Path + "/"))
+				})
+			})
+
 			It("Cluster Modules", func() {
 				vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
 				Expect(err).ToNot(HaveOccurred())
@@ -2940,6 +3491,222 @@ func vmTests() {
 			})
 		})
 
+		Context("VM device audit", func() {
+			var vcVM *object.VirtualMachine
+
+			JustBeforeEach(func() {
+				var err error
+				vcVM, err = createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+				Expect(err).ToNot(HaveOccurred())
+
+				task, err := vcVM.Reconfigure(ctx, vimtypes.VirtualMachineConfigSpec{
+					DeviceChange: []vimtypes.BaseVirtualDeviceConfigSpec{
+						&vimtypes.VirtualDeviceConfigSpec{
+							Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+							Device: &vimtypes.VirtualSerialPort{
+								VirtualDevice: vimtypes.VirtualDevice{
+									Key: -1,
+									Backing: &vimtypes.VirtualSerialPortFileBackingInfo{
+										VirtualDeviceFileBackingInfo: vimtypes.VirtualDeviceFileBackingInfo{
+											FileName: "[LocalDS_0] serial.log",
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task.Wait(ctx)).To(Succeed())
+			})
+
+			It("returns the unmanaged devices", func() {
+				result, err := vmProvider.AuditVirtualMachineDevices(ctx, vm, false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Unmanaged).To(ConsistOf(HavePrefix("serialport-")))
+				Expect(result.Removed).To(BeEmpty())
+
+				result, err = vmProvider.AuditVirtualMachineDevices(ctx, vm, false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Unmanaged).To(HaveLen(1))
+			})
+
+			It("removes the unmanaged devices", func() {
+				result, err := vmProvider.AuditVirtualMachineDevices(ctx, vm, true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Removed).To(ConsistOf(HavePrefix("serialport-")))
+				Expect(result.Unmanaged).To(BeEmpty())
+
+				result, err = vmProvider.AuditVirtualMachineDevices(ctx, vm, false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Unmanaged).To(BeEmpty())
+			})
+		})
+
+		Context("VM chargeback tags", func() {
+			attachedTagNames := func(vcVM *object.VirtualMachine) []string {
+				attached, err := tags.NewManager(ctx.RestClient).GetAttachedTags(ctx, vcVM.Reference())
+				Expect(err).ToNot(HaveOccurred())
+				var names []string
+				for _, t := range attached {
+					names = append(names, t.Name)
+				}
+				return names
+			}
+
+			BeforeEach(func() {
+				pkgcfg.SetContext(parentCtx, func(config *pkgcfg.Config) {
+					config.ChargebackLabelKeys = "team"
+				})
+				vm.Labels["team"] = "vmop"
+			})
+
+			It("only syncs the tags when they change", func() {
+				vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+				Expect(vm.Annotations).To(HaveKeyWithValue(pkgconst.ChargebackTagsAnnotationKey, "team=vmop"))
+				Expect(attachedTagNames(vcVM)).To(ConsistOf("team=vmop"))
+
+				By("detaching the tag out-of-band", func() {
+					m := tags.NewManager(ctx.RestClient)
+					attached, err := m.GetAttachedTags(ctx, vcVM.Reference())
+					Expect(err).ToNot(HaveOccurred())
+					for _, t := range attached {
+						Expect(m.DetachTag(ctx, t.ID, vcVM.Reference())).To(Succeed())
+					}
+				})
+
+				By("updating the VM without changing its labels", func() {
+					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+					Expect(attachedTagNames(vcVM)).To(BeEmpty())
+				})
+
+				By("changing the VM's labels", func() {
+					vm.Labels["team"] = "other"
+					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+					Expect(vm.Annotations).To(HaveKeyWithValue(pkgconst.ChargebackTagsAnnotationKey, "team=other"))
+					Expect(attachedTagNames(vcVM)).To(ConsistOf("team=other"))
+				})
+
+				By("removing the VM's labels", func() {
+					delete(vm.Labels, "team")
+					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+					Expect(vm.Annotations).ToNot(HaveKey(pkgconst.ChargebackTagsAnnotationKey))
+					Expect(attachedTagNames(vcVM)).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("VM export", func() {
+			It("exports the VM and class", func() {
+				vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+				Expect(err).ToNot(HaveOccurred())
+
+				exportedVM, exportedClass, err := vmProvider.ExportVirtualMachine(
+					ctx, vcVM.Reference().Value, "export-namespace")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exportedVM.Namespace).To(Equal("export-namespace"))
+				Expect(exportedVM.Spec.ClassName).To(Equal(exportedClass.Name))
+				Expect(exportedVM.Spec.InstanceUUID).To(Equal(vm.Status.InstanceUUID))
+				Expect(exportedClass.Spec.Hardware.Cpus).To(BeNumerically(">", 0))
+			})
+
+			It("exports the VM's extraConfig and distributed port group NICs", func() {
+				vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+				Expect(err).ToNot(HaveOccurred())
+
+				network, _ := getDVPG(ctx, dvpgName)
+				backing, err := network.EthernetCardBackingInfo(ctx)
+				Expect(err).ToNot(HaveOccurred())
+
+				task, err := vcVM.Reconfigure(ctx, vimtypes.VirtualMachineConfigSpec{
+					ExtraConfig: []vimtypes.BaseOptionValue{
+						&vimtypes.OptionValue{Key: "foo", Value: "bar"},
+					},
+					DeviceChange: []vimtypes.BaseVirtualDeviceConfigSpec{
+						&vimtypes.VirtualDeviceConfigSpec{
+							Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+							Device: &vimtypes.VirtualVmxnet3{
+								VirtualVmxnet: vimtypes.VirtualVmxnet{
+									VirtualEthernetCard: vimtypes.VirtualEthernetCard{
+										VirtualDevice: vimtypes.VirtualDevice{
+											Key:     -1,
+											Backing: backing,
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task.Wait(ctx)).To(Succeed())
+
+				exportedVM, exportedClass, err := vmProvider.ExportVirtualMachine(
+					ctx, vcVM.Reference().Value, "export-namespace")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(exportedVM.Spec.Network).ToNot(BeNil())
+				Expect(exportedVM.Spec.Network.Interfaces).To(ContainElement(
+					HaveField("Network", HaveValue(HaveField("Name", "dc0-dvpg0")))))
+
+				configSpec, err := pkgutil.UnmarshalConfigSpecFromJSON(exportedClass.Spec.ConfigSpec)
+				Expect(err).ToNot(HaveOccurred())
+				var keys []string
+				for _, bov := range configSpec.ExtraConfig {
+					keys = append(keys, bov.GetOptionValue().Key)
+				}
+				Expect(keys).To(ContainElement("foo"))
+				Expect(keys).ToNot(ContainElement(constants.EnableDiskUUIDExtraConfigKey))
+				Expect(keys).ToNot(ContainElement(constants.ExtraConfigVMServiceNamespacedName))
+				for _, k := range keys {
+					Expect(k).ToNot(HavePrefix("guestinfo."))
+				}
+			})
+
+			It("returns an error when the VM does not exist", func() {
+				_, _, err := vmProvider.ExportVirtualMachine(ctx, "vm-does-not-exist", "export-namespace")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("List managed VMs", func() {
+			It("returns the VMs created by VM Operator", func() {
+				vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+				Expect(err).ToNot(HaveOccurred())
+
+				vms, err := vmProvider.ListManagedVirtualMachines(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vms).To(ConsistOf(providers.ManagedVirtualMachine{
+					ID:             vcVM.Reference().Value,
+					Name:           vm.Name,
+					InstanceUUID:   vm.Status.InstanceUUID,
+					NamespacedName: vm.NamespacedName(),
+				}))
+			})
+
+			It("omits the VMs created for another Supervisor's namespaces", func() {
+				vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+				Expect(err).ToNot(HaveOccurred())
+
+				t, err := vcVM.Reconfigure(ctx, vimtypes.VirtualMachineConfigSpec{
+					ExtraConfig: []vimtypes.BaseOptionValue{
+						&vimtypes.OptionValue{
+							Key:   constants.ExtraConfigVMServiceNamespacedName,
+							Value: "other-supervisor-ns/" + vm.Name,
+						},
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(t.Wait(ctx)).To(Succeed())
+
+				vms, err := vmProvider.ListManagedVirtualMachines(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vms).To(BeEmpty())
+			})
+		})
+
 		Context("Create/Update/Delete ISO backed VirtualMachine", func() {
 			var (
 				vm      *vmopv1.VirtualMachine
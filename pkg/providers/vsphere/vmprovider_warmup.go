@@ -0,0 +1,193 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/vmware/govmomi/vim25"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	apierrorsutil "k8s.io/apimachinery/pkg/util/errors"
+
+	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	vcclient "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/client"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vcenter"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
+)
+
+// WarmUp eagerly creates the provider's vSphere session and resolves the
+// Clusters that own the ResourcePools of every namespace that has VM Operator
+// zones, so the first VM created in a namespace does not pay the cost of doing
+// so. At most
+// SessionWarmupConcurrency namespaces are resolved in parallel.
+//
+// WarmUp is a no-op if SessionWarmupConcurrency is not positive or the
+// provider is not a vSphere provider.
+func WarmUp(
+	ctx context.Context,
+	p providers.VirtualMachineProviderInterface) error {
+
+	concurrency := pkgcfg.FromContext(ctx).SessionWarmupConcurrency
+	if concurrency <= 0 {
+		return nil
+	}
+
+	vs, ok := p.(*vSphereVMProvider)
+	if !ok {
+		return nil
+	}
+
+	return vs.warmUp(ctx, concurrency)
+}
+
+func (vs *vSphereVMProvider) warmUp(ctx context.Context, concurrency int) error {
+	logger := log.WithName("warmup")
+
+	vcClient, err := vs.getVcClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create vSphere session: %w", err)
+	}
+
+	namespaces, err := vs.getZonedNamespaces(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get namespaces: %w", err)
+	}
+
+	logger.Info("Warming up namespaces",
+		"numNamespaces", len(namespaces), "concurrency", concurrency)
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		errs  []error
+		nsCh  = make(chan string)
+		errFn = func(err error) {
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+		}
+	)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ns := range nsCh {
+				if err := vs.warmUpNamespace(ctx, vcClient, ns); err != nil {
+					errFn(fmt.Errorf("failed to warm up namespace %q: %w", ns, err))
+				}
+			}
+		}()
+	}
+
+	for _, ns := range namespaces {
+		select {
+		case nsCh <- ns:
+		case <-ctx.Done():
+		}
+	}
+	close(nsCh)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	logger.Info("Warmed up namespaces", "numNamespaces", len(namespaces))
+
+	return apierrorsutil.NewAggregate(errs)
+}
+
+// warmUpNamespace resolves and caches the Clusters that own the namespace's
+// ResourcePools in each of the namespace's zones. The namespace's Folder is not
+// resolved since VMs are placed in it by its MoID.
+func (vs *vSphereVMProvider) warmUpNamespace(
+	ctx context.Context,
+	vcClient *vcclient.Client,
+	namespace string) error {
+
+	zones, err := topology.GetNamespaceFolderAndRPMoIDsByZone(ctx, vs.k8sClient, namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, z := range zones {
+		for _, rpMoID := range z.PoolMoIDs {
+			if rpMoID == "" {
+				continue
+			}
+			if _, err := vs.getResourcePoolOwnerMoRef(ctx, vcClient.VimClient(), rpMoID); err != nil {
+				return fmt.Errorf("failed to get owner of resource pool %q in zone %q: %w", rpMoID, z.ZoneName, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getZonedNamespaces returns the sorted names of the namespaces that have
+// VM Operator zones.
+func (vs *vSphereVMProvider) getZonedNamespaces(ctx context.Context) ([]string, error) {
+	set := map[string]struct{}{}
+
+	if pkgcfg.FromContext(ctx).Features.WorkloadDomainIsolation {
+		var list topologyv1.ZoneList
+		if err := vs.k8sClient.List(ctx, &list); err != nil {
+			return nil, err
+		}
+		for _, z := range list.Items {
+			set[z.Namespace] = struct{}{}
+		}
+	} else {
+		azs, err := topology.GetAvailabilityZones(ctx, vs.k8sClient)
+		if err != nil {
+			if errors.Is(err, topology.ErrNoAvailabilityZones) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		for _, az := range azs {
+			for ns := range az.Spec.Namespaces {
+				set[ns] = struct{}{}
+			}
+		}
+	}
+
+	namespaces := make([]string, 0, len(set))
+	for ns := range set {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	return namespaces, nil
+}
+
+// getResourcePoolOwnerMoRef returns the ClusterComputeResource that owns the
+// ResourcePool. The owner of a ResourcePool does not change, so the result is
+// cached until the provider's vSphere client is reset.
+func (vs *vSphereVMProvider) getResourcePoolOwnerMoRef(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	rpMoID string) (vimtypes.ManagedObjectReference, error) {
+
+	if v, ok := vs.rpOwners.Load(rpMoID); ok {
+		return v.(vimtypes.ManagedObjectReference), nil
+	}
+
+	ref, err := vcenter.GetResourcePoolOwnerMoRef(ctx, vimClient, rpMoID)
+	if err != nil {
+		return vimtypes.ManagedObjectReference{}, err
+	}
+
+	vs.rpOwners.Store(rpMoID, ref)
+
+	return ref, nil
+}
@@ -0,0 +1,86 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	vsphere "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func warmUpTests() {
+
+	var (
+		testConfig  builder.VCSimTestConfig
+		ctx         *builder.TestContextForVCSim
+		vmProvider  providers.VirtualMachineProviderInterface
+		nsInfo      builder.WorkloadNamespaceInfo
+		concurrency int
+	)
+
+	BeforeEach(func() {
+		testConfig = builder.VCSimTestConfig{}
+		concurrency = 2
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewTestContextForVCSim(testConfig)
+		pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+			config.SessionWarmupConcurrency = concurrency
+		})
+		vmProvider = vsphere.NewVSphereVMProviderFromClient(ctx, ctx.Client, ctx.Recorder)
+		nsInfo = ctx.CreateWorkloadNamespace()
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		vmProvider = nil
+	})
+
+	It("returns success", func() {
+		Expect(vsphere.WarmUp(ctx, vmProvider)).To(Succeed())
+	})
+
+	When("a zone has a resource pool that does not exist", func() {
+		JustBeforeEach(func() {
+			var list topologyv1.ZoneList
+			Expect(ctx.Client.List(ctx, &list)).To(Succeed())
+			Expect(list.Items).ToNot(BeEmpty())
+
+			zone := list.Items[0]
+			Expect(zone.Namespace).To(Equal(nsInfo.Namespace))
+			zone.Spec.ManagedVMs.PoolMoIDs = []string{"resgroup-does-not-exist"}
+			Expect(ctx.Client.Update(ctx, &zone)).To(Succeed())
+		})
+
+		It("returns an error", func() {
+			Expect(vsphere.WarmUp(ctx, vmProvider)).To(
+				MatchError(ContainSubstring("resgroup-does-not-exist")))
+		})
+
+		When("the warm up is disabled", func() {
+			BeforeEach(func() {
+				concurrency = 0
+			})
+
+			It("returns success", func() {
+				Expect(vsphere.WarmUp(ctx, vmProvider)).To(Succeed())
+			})
+		})
+	})
+
+	When("the provider is not a vSphere provider", func() {
+		It("returns success", func() {
+			Expect(vsphere.WarmUp(ctx, providerfake.NewVMProvider())).To(Succeed())
+		})
+	})
+}
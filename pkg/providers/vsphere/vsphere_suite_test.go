@@ -24,12 +24,14 @@ import (
 var suite = builder.NewTestSuite()
 
 func vcSimTests() {
+	Describe("Conformance", conformanceTests)
 	Describe("CPUFreq", cpuFreqTests)
 	Describe("ResourcePolicyTests", resourcePolicyTests)
 	Describe("VirtualMachine", vmTests)
 	Describe("VirtualMachineE2E", vmE2ETests)
 	Describe("VirtualMachineResize", vmResizeTests)
 	Describe("VirtualMachineUtilsTest", vmUtilTests)
+	Describe("WarmUp", warmUpTests)
 }
 
 func TestVSphereProvider(t *testing.T) {
@@ -142,6 +142,63 @@ func GetNamespaceFolderAndRPMoIDs(
 	return folderMoID, rpMoIDs, nil
 }
 
+// NamespaceZoneMoIDs are the Folder and ResourcePool MoIDs for a namespace in
+// a zone.
+type NamespaceZoneMoIDs struct {
+	ZoneName   string
+	FolderMoID string
+	PoolMoIDs  []string
+}
+
+// GetNamespaceFolderAndRPMoIDsByZone returns the Folder and ResourcePool MoIDs
+// for the namespace in each zone.
+func GetNamespaceFolderAndRPMoIDsByZone(
+	ctx context.Context,
+	client ctrlclient.Client,
+	namespace string) ([]NamespaceZoneMoIDs, error) {
+
+	var result []NamespaceZoneMoIDs
+
+	if pkgcfg.FromContext(ctx).Features.WorkloadDomainIsolation {
+		zones, err := GetZones(ctx, client, namespace)
+		// If no Zones found in namespace, do not return err.
+		if err != nil && !errors.Is(err, ErrNoZones) {
+			return nil, err
+		}
+
+		for _, zone := range zones {
+			result = append(result, NamespaceZoneMoIDs{
+				ZoneName:   zone.Name,
+				FolderMoID: zone.Spec.ManagedVMs.FolderMoID,
+				PoolMoIDs:  zone.Spec.ManagedVMs.PoolMoIDs,
+			})
+		}
+
+		return result, nil
+	}
+
+	availabilityZones, err := GetAvailabilityZones(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, az := range availabilityZones {
+		if nsInfo, ok := az.Spec.Namespaces[namespace]; ok {
+			poolMoIDs := nsInfo.PoolMoIDs
+			if len(poolMoIDs) == 0 {
+				poolMoIDs = []string{nsInfo.PoolMoId}
+			}
+			result = append(result, NamespaceZoneMoIDs{
+				ZoneName:   az.Name,
+				FolderMoID: nsInfo.FolderMoId,
+				PoolMoIDs:  poolMoIDs,
+			})
+		}
+	}
+
+	return result, nil
+}
+
 // GetNamespaceFolderMoID returns the FolderMoID for the namespace.
 func GetNamespaceFolderMoID(
 	ctx context.Context,
@@ -179,6 +236,48 @@ func GetNamespaceFolderMoID(
 	return "", fmt.Errorf("unable to get FolderMoID for namespace %s", namespace)
 }
 
+// GetNamespaceFolderMoIDs returns the FolderMoID for each namespace, across all
+// zones, keyed by the name of the namespace.
+func GetNamespaceFolderMoIDs(
+	ctx context.Context,
+	client ctrlclient.Client) (map[string]string, error) {
+
+	folderMoIDs := map[string]string{}
+
+	if pkgcfg.FromContext(ctx).Features.WorkloadDomainIsolation {
+		zoneList := &topologyv1.ZoneList{}
+		if err := client.List(ctx, zoneList); err != nil {
+			return nil, err
+		}
+
+		for _, zone := range zoneList.Items {
+			if _, ok := folderMoIDs[zone.Namespace]; !ok && zone.Spec.ManagedVMs.FolderMoID != "" {
+				folderMoIDs[zone.Namespace] = zone.Spec.ManagedVMs.FolderMoID
+			}
+		}
+
+		return folderMoIDs, nil
+	}
+
+	availabilityZones, err := GetAvailabilityZones(ctx, client)
+	if err != nil {
+		if errors.Is(err, ErrNoAvailabilityZones) {
+			return folderMoIDs, nil
+		}
+		return nil, err
+	}
+
+	for _, az := range availabilityZones {
+		for namespace, nsInfo := range az.Spec.Namespaces {
+			if _, ok := folderMoIDs[namespace]; !ok && nsInfo.FolderMoId != "" {
+				folderMoIDs[namespace] = nsInfo.FolderMoId
+			}
+		}
+	}
+
+	return folderMoIDs, nil
+}
+
 // GetAvailabilityZones returns a list of the AvailabilityZone resources.
 func GetAvailabilityZones(
 	ctx context.Context,
@@ -238,6 +238,25 @@ var _ = Describe("Availability Zones and Zones", func() {
 		}
 	}
 
+	assertGetNamespaceFolderAndRPMoIDsByZoneInvalidNamespaceNoErr := func() {
+		zones, err := topology.GetNamespaceFolderAndRPMoIDsByZone(ctx, client, "invalid")
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, zones).To(BeEmpty())
+	}
+
+	assertGetNamespaceFolderAndRPMoIDsByZoneSuccess := func() {
+		for i := 0; i < numberOfNamespaces; i++ {
+			zones, err := topology.GetNamespaceFolderAndRPMoIDsByZone(ctx, client, fmt.Sprintf("ns-%d", i))
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			ExpectWithOffset(1, zones).To(HaveLen(numberOfAvailabilityZones + numberOfZonesPerNamespace))
+			for _, z := range zones {
+				ExpectWithOffset(1, z.ZoneName).ToNot(BeEmpty())
+				ExpectWithOffset(1, z.FolderMoID).To(Equal(folderMoID))
+				ExpectWithOffset(1, z.PoolMoIDs).To(ConsistOf(poolMoID))
+			}
+		}
+	}
+
 	assertGetNamespaceFolderMoIDInvalidNamespaceErrNotFound := func() {
 		_, err := topology.GetNamespaceFolderMoID(ctx, client, "invalid")
 		ExpectWithOffset(1, err).To(HaveOccurred())
@@ -251,6 +270,21 @@ var _ = Describe("Availability Zones and Zones", func() {
 		}
 	}
 
+	assertGetNamespaceFolderMoIDsSuccess := func() {
+		folders, err := topology.GetNamespaceFolderMoIDs(ctx, client)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, folders).To(HaveLen(numberOfNamespaces))
+		for i := 0; i < numberOfNamespaces; i++ {
+			ExpectWithOffset(1, folders).To(HaveKeyWithValue(fmt.Sprintf("ns-%d", i), folderMoID))
+		}
+	}
+
+	assertGetNamespaceFolderMoIDsEmpty := func() {
+		folders, err := topology.GetNamespaceFolderMoIDs(ctx, client)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, folders).To(BeEmpty())
+	}
+
 	When("Two AvailabilityZone resources exist", func() {
 		BeforeEach(func() {
 			numberOfAvailabilityZones = 2
@@ -293,6 +327,9 @@ var _ = Describe("Availability Zones and Zones", func() {
 					It("Should return the RP and Folder resources", assertGetNamespaceFolderMoIDSuccess)
 				})
 			})
+			Context("GetNamespaceFolderMoIDs", func() {
+				It("Should return the Folder for each namespace", assertGetNamespaceFolderMoIDsSuccess)
+			})
 			Context("GetNamespaceFolderAndRPMoIDs", func() {
 				Context("With an invalid Namespace name", func() {
 					It("Should return NotFound", assertGetNamespaceFolderAndRPMoIDsInvalidNamespaceNoErr)
@@ -301,6 +338,14 @@ var _ = Describe("Availability Zones and Zones", func() {
 					It("Should return the RP and Folder resources", assertGetNamespaceFolderAndRPMoIDsSuccess)
 				})
 			})
+			Context("GetNamespaceFolderAndRPMoIDsByZone", func() {
+				Context("With an invalid Namespace name", func() {
+					It("Should return no zones", assertGetNamespaceFolderAndRPMoIDsByZoneInvalidNamespaceNoErr)
+				})
+				Context("With a valid Namespace name", func() {
+					It("Should return the RP and Folder resources for each zone", assertGetNamespaceFolderAndRPMoIDsByZoneSuccess)
+				})
+			})
 		})
 		When("DevOps Namespaces do not exist", func() {
 			Context("GetAvailabilityZones", func() {
@@ -368,6 +413,9 @@ var _ = Describe("Availability Zones and Zones", func() {
 					It("Should return the RP and Folder resources", assertGetNamespaceFolderMoIDSuccess)
 				})
 			})
+			Context("GetNamespaceFolderMoIDs", func() {
+				It("Should return the Folder for each namespace", assertGetNamespaceFolderMoIDsSuccess)
+			})
 			Context("GetNamespaceFolderAndRPMoIDs", func() {
 				Context("With an invalid Namespace name", func() {
 					It("Should return NotFound", assertGetNamespaceFolderAndRPMoIDsInvalidNamespaceNoErr)
@@ -376,6 +424,14 @@ var _ = Describe("Availability Zones and Zones", func() {
 					It("Should return the RP and Folder resources", assertGetNamespaceFolderAndRPMoIDsSuccess)
 				})
 			})
+			Context("GetNamespaceFolderAndRPMoIDsByZone", func() {
+				Context("With an invalid Namespace name", func() {
+					It("Should return no zones", assertGetNamespaceFolderAndRPMoIDsByZoneInvalidNamespaceNoErr)
+				})
+				Context("With a valid Namespace name", func() {
+					It("Should return the RP and Folder resources for each zone", assertGetNamespaceFolderAndRPMoIDsByZoneSuccess)
+				})
+			})
 		})
 	})
 
@@ -415,6 +471,9 @@ var _ = Describe("Availability Zones and Zones", func() {
 			Context("GetAvailabilityZones", func() {
 				It("Should return an ErrNoAvailabilityZones", assertGetAvailabilityZonesErrNoAvailabilityZones)
 			})
+			Context("GetNamespaceFolderMoIDs", func() {
+				It("Should return no folders", assertGetNamespaceFolderMoIDsEmpty)
+			})
 			Context("GetAvailabilityZone", func() {
 				Context("With topology.DefaultAvailabilityZone", func() {
 					It("Should return an apierrors.NotFound error", assertGetAvailabilityZoneValidNamesErrNotFound)
@@ -0,0 +1,330 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package deployhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+)
+
+// Result describes the result of running a deploy hook.
+type Result uint8
+
+const (
+	// ResultPending indicates the hook has not completed.
+	ResultPending Result = iota
+
+	// ResultSucceeded indicates the hook succeeded.
+	ResultSucceeded
+
+	// ResultFailed indicates the hook failed.
+	ResultFailed
+)
+
+// Runner runs deploy hooks. The webhooks are called in the background, so a
+// Runner tracks the calls in progress and shares the transports used to call
+// the webhooks. A single Runner should be used by a controller.
+type Runner struct {
+	// ctx is the context of the webhook calls, ex. the context of the
+	// controller manager, so the calls are canceled when the manager stops.
+	ctx context.Context
+
+	mu         sync.Mutex
+	transports map[string]*http.Transport
+	calls      map[webhookCallKey]*webhookCall
+}
+
+// NewRunner returns a new Runner whose webhook calls are canceled when ctx is
+// done.
+func NewRunner(ctx context.Context) *Runner {
+	return &Runner{
+		ctx:        ctx,
+		transports: map[string]*http.Transport{},
+		calls:      map[webhookCallKey]*webhookCall{},
+	}
+}
+
+// Forget discards the results of the webhook calls for the VM, ex. when the
+// VM is deleted.
+func (r *Runner) Forget(vm *vmopv1.VirtualMachine) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key := range r.calls {
+		if key.vmUID == string(vm.UID) {
+			delete(r.calls, key)
+		}
+	}
+}
+
+// ConditionType returns the type of the VM condition that reports the results
+// of the VM's deploy hooks with the provided phase.
+func ConditionType(phase vmopv1.VirtualMachineDeployHookPhase) string {
+	if phase == vmopv1.VirtualMachineDeployHookPhasePreDeploy {
+		return vmopv1.VirtualMachinePreDeployHooksSucceededCondition
+	}
+	return vmopv1.VirtualMachinePostFirstBootHooksSucceededCondition
+}
+
+// Applies returns true if the hook applies to the VM, i.e. the hook is in the
+// VM's namespace and either the hook does not specify any images or one of
+// the hook's images is the VM's image.
+func Applies(hook vmopv1.VirtualMachineDeployHook, vm vmopv1.VirtualMachine) bool {
+	if hook.Namespace != vm.Namespace {
+		return false
+	}
+	if len(hook.Spec.ImageNames) == 0 {
+		return true
+	}
+	if vm.Spec.ImageName != "" && slices.Contains(hook.Spec.ImageNames, vm.Spec.ImageName) {
+		return true
+	}
+	return vm.Spec.Image != nil && slices.Contains(hook.Spec.ImageNames, vm.Spec.Image.Name)
+}
+
+// GetHooks returns the hooks with the provided phase that apply to the VM,
+// sorted by name. No hooks are returned if the VirtualMachineDeployHook CRD is
+// not installed.
+//
+// Please note, the PostFirstBoot hooks are limited to the hooks recorded on
+// the VM by RecordPostFirstBootHooks when the VM was deployed.
+func GetHooks(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm vmopv1.VirtualMachine,
+	phase vmopv1.VirtualMachineDeployHookPhase) ([]vmopv1.VirtualMachineDeployHook, error) {
+
+	hooks, err := listHooks(ctx, k8sClient, vm, phase)
+	if err != nil || phase != vmopv1.VirtualMachineDeployHookPhasePostFirstBoot {
+		return hooks, err
+	}
+
+	recorded := strings.Split(vm.Annotations[vmopv1.PostFirstBootHooksAnnotation], ",")
+	return slices.DeleteFunc(hooks, func(h vmopv1.VirtualMachineDeployHook) bool {
+		return !slices.Contains(recorded, h.Name)
+	}), nil
+}
+
+// RecordPostFirstBootHooks records the names of the PostFirstBoot hooks that
+// apply to the VM with the VM's PostFirstBootHooksAnnotation. This should be
+// called before the VM is deployed so that only the hooks that exist when the
+// VM is deployed are run after its first boot, i.e. a new hook is not run for
+// the existing VMs.
+func RecordPostFirstBootHooks(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm *vmopv1.VirtualMachine) error {
+
+	hooks, err := listHooks(ctx, k8sClient, *vm, vmopv1.VirtualMachineDeployHookPhasePostFirstBoot)
+	if err != nil {
+		return err
+	}
+
+	if len(hooks) == 0 {
+		delete(vm.Annotations, vmopv1.PostFirstBootHooksAnnotation)
+		return nil
+	}
+
+	names := make([]string, len(hooks))
+	for i := range hooks {
+		names[i] = hooks[i].Name
+	}
+
+	if vm.Annotations == nil {
+		vm.Annotations = map[string]string{}
+	}
+	vm.Annotations[vmopv1.PostFirstBootHooksAnnotation] = strings.Join(names, ",")
+
+	return nil
+}
+
+func listHooks(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm vmopv1.VirtualMachine,
+	phase vmopv1.VirtualMachineDeployHookPhase) ([]vmopv1.VirtualMachineDeployHook, error) {
+
+	var list vmopv1.VirtualMachineDeployHookList
+	if err := k8sClient.List(ctx, &list, ctrlclient.InNamespace(vm.Namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list VirtualMachineDeployHooks: %w", err)
+	}
+
+	var hooks []vmopv1.VirtualMachineDeployHook
+	for i := range list.Items {
+		if h := list.Items[i]; h.Spec.Phase == phase && Applies(h, vm) {
+			hooks = append(hooks, h)
+		}
+	}
+
+	slices.SortFunc(hooks, func(a, b vmopv1.VirtualMachineDeployHook) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return hooks, nil
+}
+
+// Reconcile runs the hooks with the provided phase that apply to the VM and
+// reports their results with the VM's condition for the phase. True is
+// returned if all of the hooks succeeded or if no hooks apply to the VM.
+//
+// Once all of the hooks succeed, the hooks are not run again for the VM, i.e.
+// a true condition records the hooks for the phase are complete. Otherwise,
+// the hooks that have not succeeded are run again the next time this function
+// is called.
+func (r *Runner) Reconcile(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm *vmopv1.VirtualMachine,
+	phase vmopv1.VirtualMachineDeployHookPhase) (bool, error) {
+
+	conditionType := ConditionType(phase)
+	if conditions.IsTrue(vm, conditionType) {
+		return true, nil
+	}
+
+	hooks, err := GetHooks(ctx, k8sClient, *vm, phase)
+	if err != nil {
+		return false, err
+	}
+	if len(hooks) == 0 {
+		conditions.Delete(vm, conditionType)
+		return true, nil
+	}
+
+	var pending, failed []string
+
+	for i := range hooks {
+		hook := hooks[i]
+
+		result, msg, err := r.run(ctx, k8sClient, vm, hook)
+		if err != nil {
+			result, msg = ResultPending, err.Error()
+		}
+		if result == ResultFailed &&
+			hook.Spec.FailurePolicy == vmopv1.VirtualMachineDeployHookFailurePolicyIgnore {
+
+			result = ResultSucceeded
+		}
+
+		switch result {
+		case ResultPending:
+			pending = append(pending, formatResult(hook.Name, msg))
+		case ResultFailed:
+			failed = append(failed, formatResult(hook.Name, msg))
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		conditions.MarkFalse(
+			vm,
+			conditionType,
+			vmopv1.VirtualMachineDeployHooksFailedReason,
+			"Failed hooks: %s",
+			strings.Join(failed, "; "))
+		return false, nil
+	case len(pending) > 0:
+		conditions.MarkFalse(
+			vm,
+			conditionType,
+			vmopv1.VirtualMachineDeployHooksPendingReason,
+			"Pending hooks: %s",
+			strings.Join(pending, "; "))
+		return false, nil
+	}
+
+	conditions.MarkTrue(vm, conditionType)
+	return true, nil
+}
+
+// IsPending returns true if the results of any of the VM's deploy hooks are
+// reported and not all of the hooks succeeded.
+func IsPending(vm *vmopv1.VirtualMachine) bool {
+	for _, t := range []string{
+		vmopv1.VirtualMachinePreDeployHooksSucceededCondition,
+		vmopv1.VirtualMachinePostFirstBootHooksSucceededCondition,
+	} {
+		if c := conditions.Get(vm, t); c != nil && c.Status != metav1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// GateReadyCondition returns a false Ready condition in place of the provided
+// Ready condition if the condition is true but the VM's deploy hooks are
+// pending. Otherwise the provided condition is returned.
+func GateReadyCondition(
+	vm *vmopv1.VirtualMachine,
+	ready *metav1.Condition) *metav1.Condition {
+
+	if ready == nil || ready.Status != metav1.ConditionTrue || !IsPending(vm) {
+		return ready
+	}
+	return conditions.FalseCondition(
+		vmopv1.ReadyConditionType,
+		vmopv1.VirtualMachineDeployHooksPendingReason,
+		"The VM's deploy hooks have not succeeded")
+}
+
+// UpdateReadyCondition sets the VM's Ready condition to false while the VM's
+// deploy hooks are pending, and removes the false Ready condition set by this
+// function once the hooks succeed so the condition is reported again by the
+// VM's readiness probe.
+func UpdateReadyCondition(vm *vmopv1.VirtualMachine) {
+	ready := conditions.Get(vm, vmopv1.ReadyConditionType)
+
+	if IsPending(vm) {
+		if ready == nil || ready.Status == metav1.ConditionTrue {
+			conditions.MarkFalse(
+				vm,
+				vmopv1.ReadyConditionType,
+				vmopv1.VirtualMachineDeployHooksPendingReason,
+				"The VM's deploy hooks have not succeeded")
+		}
+		return
+	}
+
+	if ready != nil && ready.Reason == vmopv1.VirtualMachineDeployHooksPendingReason {
+		conditions.Delete(vm, vmopv1.ReadyConditionType)
+	}
+}
+
+func (r *Runner) run(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm *vmopv1.VirtualMachine,
+	hook vmopv1.VirtualMachineDeployHook) (Result, string, error) {
+
+	switch {
+	case hook.Spec.Webhook != nil:
+		return r.runWebhook(ctx, vm, hook)
+	case hook.Spec.Job != nil:
+		return runJob(ctx, k8sClient, vm, hook)
+	default:
+		return ResultFailed, "hook does not specify a webhook or job", nil
+	}
+}
+
+func formatResult(name, msg string) string {
+	if msg == "" {
+		return name
+	}
+	return fmt.Sprintf("%s (%s)", name, msg)
+}
@@ -0,0 +1,586 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package deployhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/deployhook"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+const (
+	namespace = "my-ns"
+	jobImage  = "registry.example.com/hook:latest"
+)
+
+func newVM() *vmopv1.VirtualMachine {
+	return &vmopv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "my-vm",
+			UID:       "my-vm-uid",
+		},
+		Spec: vmopv1.VirtualMachineSpec{
+			ImageName: "vmi-123",
+		},
+	}
+}
+
+func newJobHook(name string, phase vmopv1.VirtualMachineDeployHookPhase) *vmopv1.VirtualMachineDeployHook {
+	return &vmopv1.VirtualMachineDeployHook{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: vmopv1.VirtualMachineDeployHookSpec{
+			Phase: phase,
+			Job: &vmopv1.VirtualMachineDeployHookJob{
+				Image: jobImage,
+			},
+		},
+	}
+}
+
+var _ = Describe("Applies", func() {
+	var (
+		vm   *vmopv1.VirtualMachine
+		hook *vmopv1.VirtualMachineDeployHook
+	)
+
+	BeforeEach(func() {
+		vm = newVM()
+		hook = newJobHook("my-hook", vmopv1.VirtualMachineDeployHookPhasePreDeploy)
+	})
+
+	It("applies to all VMs in the namespace without images", func() {
+		Expect(deployhook.Applies(*hook, *vm)).To(BeTrue())
+	})
+
+	It("does not apply to VMs in other namespaces", func() {
+		vm.Namespace = "other-ns"
+		Expect(deployhook.Applies(*hook, *vm)).To(BeFalse())
+	})
+
+	It("applies to VMs deployed from one of its images", func() {
+		hook.Spec.ImageNames = []string{"vmi-456", "vmi-123"}
+		Expect(deployhook.Applies(*hook, *vm)).To(BeTrue())
+
+		vm.Spec.ImageName = ""
+		vm.Spec.Image = &vmopv1.VirtualMachineImageRef{Name: "vmi-456"}
+		Expect(deployhook.Applies(*hook, *vm)).To(BeTrue())
+	})
+
+	It("does not apply to VMs deployed from other images", func() {
+		hook.Spec.ImageNames = []string{"vmi-456"}
+		Expect(deployhook.Applies(*hook, *vm)).To(BeFalse())
+	})
+})
+
+var _ = Describe("JobName", func() {
+	It("returns a valid name for the VM's UID and the hook's name", func() {
+		vm := newVM()
+		hook := newJobHook("my-hook", vmopv1.VirtualMachineDeployHookPhasePreDeploy)
+		name := deployhook.JobName(*vm, *hook)
+		Expect(name).To(HavePrefix("deployhook-"))
+		Expect(validation.IsDNS1035Label(name)).To(BeEmpty())
+		Expect(deployhook.JobName(*vm, *hook)).To(Equal(name))
+
+		By("returning a different name for a recreated VM", func() {
+			other := newVM()
+			other.UID = "my-other-vm-uid"
+			Expect(deployhook.JobName(*other, *hook)).ToNot(Equal(name))
+		})
+
+		By("returning a different name for another hook", func() {
+			other := newJobHook("my-other-hook", vmopv1.VirtualMachineDeployHookPhasePreDeploy)
+			Expect(deployhook.JobName(*vm, *other)).ToNot(Equal(name))
+		})
+	})
+})
+
+var _ = Describe("Reconcile", func() {
+	const phase = vmopv1.VirtualMachineDeployHookPhasePreDeploy
+
+	var (
+		ctx       context.Context
+		runner    *deployhook.Runner
+		k8sClient ctrlclient.Client
+		vm        *vmopv1.VirtualMachine
+		objs      []ctrlclient.Object
+	)
+
+	BeforeEach(func() {
+		ctx = pkgcfg.WithConfig(pkgcfg.Config{
+			DeployHookJobImages:          jobImage,
+			DeployHookJobServiceAccounts: "hook-sa",
+			DeployHookWebhookHosts:       "127.0.0.1",
+		})
+		runner = deployhook.NewRunner(ctx)
+		vm = newVM()
+		objs = nil
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = builder.NewFakeClient(append(objs, vm)...)
+	})
+
+	getCondition := func() *metav1.Condition {
+		return conditions.Get(vm, vmopv1.VirtualMachinePreDeployHooksSucceededCondition)
+	}
+
+	When("no hooks apply to the VM", func() {
+		BeforeEach(func() {
+			hook := newJobHook("other-hook", vmopv1.VirtualMachineDeployHookPhasePostFirstBoot)
+			objs = append(objs, hook)
+			conditions.MarkFalse(vm, vmopv1.VirtualMachinePreDeployHooksSucceededCondition, "stale", "")
+		})
+
+		It("succeeds and removes the condition", func() {
+			ok, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(getCondition()).To(BeNil())
+		})
+	})
+
+	When("a job hook applies to the VM", func() {
+		var hook *vmopv1.VirtualMachineDeployHook
+
+		BeforeEach(func() {
+			hook = newJobHook("my-hook", phase)
+			objs = append(objs, hook)
+		})
+
+		getJob := func() *batchv1.Job {
+			job := &batchv1.Job{}
+			key := ctrlclient.ObjectKey{Namespace: namespace, Name: deployhook.JobName(*vm, *hook)}
+			Expect(k8sClient.Get(ctx, key, job)).To(Succeed())
+			return job
+		}
+
+		setJobCondition := func(t batchv1.JobConditionType, msg string) {
+			job := getJob()
+			job.Status.Conditions = []batchv1.JobCondition{
+				{Type: t, Status: corev1.ConditionTrue, Message: msg},
+			}
+			Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+		}
+
+		It("creates the job and is pending until the job completes", func() {
+			ok, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+
+			c := getCondition()
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksPendingReason))
+			Expect(c.Message).To(Equal("Pending hooks: my-hook (job " + deployhook.JobName(*vm, *hook) + " created)"))
+			Expect(deployhook.IsPending(vm)).To(BeTrue())
+
+			job := getJob()
+			Expect(job.Labels).To(HaveKeyWithValue(deployhook.VMNameLabelKey, vm.Name))
+			Expect(job.Labels).To(HaveKeyWithValue(deployhook.HookNameLabelKey, hook.Name))
+			Expect(metav1.IsControlledBy(job, vm)).To(BeTrue())
+			Expect(job.Spec.Template.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+			Expect(job.Spec.Template.Spec.Containers).To(HaveLen(1))
+			container := job.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal(hook.Spec.Job.Image))
+			Expect(container.Env).To(ContainElements(
+				corev1.EnvVar{Name: "VM_NAME", Value: vm.Name},
+				corev1.EnvVar{Name: "VM_IMAGE_NAME", Value: "vmi-123"},
+				corev1.EnvVar{Name: "HOOK_PHASE", Value: string(phase)},
+			))
+
+			setJobCondition(batchv1.JobComplete, "")
+
+			ok, err = runner.Reconcile(ctx, k8sClient, vm, phase)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(conditions.IsTrue(vm, vmopv1.VirtualMachinePreDeployHooksSucceededCondition)).To(BeTrue())
+			Expect(deployhook.IsPending(vm)).To(BeFalse())
+		})
+
+		It("fails when the job fails", func() {
+			_, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+			Expect(err).ToNot(HaveOccurred())
+			setJobCondition(batchv1.JobFailed, "BackoffLimitExceeded")
+
+			ok, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+
+			c := getCondition()
+			Expect(c).ToNot(BeNil())
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksFailedReason))
+			Expect(c.Message).To(Equal("Failed hooks: my-hook (job " + deployhook.JobName(*vm, *hook) + " failed: BackoffLimitExceeded)"))
+
+			// The failed job is deleted so the hook is run with a new job the
+			// next time the hooks are reconciled.
+			job := &batchv1.Job{}
+			key := ctrlclient.ObjectKey{Namespace: namespace, Name: deployhook.JobName(*vm, *hook)}
+			Expect(ctrlclient.IgnoreNotFound(k8sClient.Get(ctx, key, job))).To(Succeed())
+			Expect(job.Name).To(BeEmpty())
+
+			ok, err = runner.Reconcile(ctx, k8sClient, vm, phase)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+			Expect(getJob().Status.Conditions).To(BeEmpty())
+		})
+
+		When("the job is not controlled by the VM", func() {
+			BeforeEach(func() {
+				objs = append(objs, &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      deployhook.JobName(*vm, *hook),
+					},
+					Status: batchv1.JobStatus{
+						Conditions: []batchv1.JobCondition{
+							{Type: batchv1.JobFailed, Status: corev1.ConditionTrue},
+						},
+					},
+				})
+			})
+
+			It("fails without using or deleting the job", func() {
+				ok, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+
+				c := getCondition()
+				Expect(c).ToNot(BeNil())
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksFailedReason))
+				Expect(c.Message).To(Equal("Failed hooks: my-hook (job " +
+					deployhook.JobName(*vm, *hook) + " is not controlled by the VM)"))
+				Expect(getJob().OwnerReferences).To(BeEmpty())
+			})
+		})
+
+		It("runs the job as an allowed service account", func() {
+			hook.Spec.Job.ServiceAccountName = "hook-sa"
+			Expect(k8sClient.Update(ctx, hook)).To(Succeed())
+
+			_, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getJob().Spec.Template.Spec.ServiceAccountName).To(Equal("hook-sa"))
+		})
+
+		DescribeTable("fails without creating the job when it is not allowed",
+			func(image, serviceAccountName, expectedMessage string) {
+				hook.Spec.Job.Image = image
+				hook.Spec.Job.ServiceAccountName = serviceAccountName
+				Expect(k8sClient.Update(ctx, hook)).To(Succeed())
+
+				ok, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+
+				c := getCondition()
+				Expect(c).ToNot(BeNil())
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksFailedReason))
+				Expect(c.Message).To(Equal("Failed hooks: my-hook (" + expectedMessage + ")"))
+
+				var list batchv1.JobList
+				Expect(k8sClient.List(ctx, &list)).To(Succeed())
+				Expect(list.Items).To(BeEmpty())
+			},
+			Entry("image", "registry.example.com/other:latest", "",
+				`job image "registry.example.com/other:latest" is not allowed`),
+			Entry("service account", jobImage, "admin-sa",
+				`job service account "admin-sa" is not allowed`),
+		)
+
+		When("the failure policy is Ignore", func() {
+			BeforeEach(func() {
+				hook.Spec.FailurePolicy = vmopv1.VirtualMachineDeployHookFailurePolicyIgnore
+			})
+
+			It("succeeds when the job fails", func() {
+				_, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+				Expect(err).ToNot(HaveOccurred())
+				setJobCondition(batchv1.JobFailed, "DeadlineExceeded")
+
+				ok, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+			})
+		})
+	})
+
+	When("a webhook hook applies to the VM", func() {
+		var (
+			server   *httptest.Server
+			hook     *vmopv1.VirtualMachineDeployHook
+			response func(vmopv1.VirtualMachineDeployHookReview) vmopv1.VirtualMachineDeployHookReview
+			requests atomic.Int32
+		)
+
+		BeforeEach(func() {
+			requests.Store(0)
+			response = func(r vmopv1.VirtualMachineDeployHookReview) vmopv1.VirtualMachineDeployHookReview {
+				r.Response = &vmopv1.VirtualMachineDeployHookResponse{
+					UID:     r.Request.UID,
+					Allowed: true,
+				}
+				return r
+			}
+
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				requests.Add(1)
+
+				var review vmopv1.VirtualMachineDeployHookReview
+				Expect(json.NewDecoder(r.Body).Decode(&review)).To(Succeed())
+				Expect(review.Kind).To(Equal(deployhook.ReviewKind))
+				Expect(review.Request).ToNot(BeNil())
+				Expect(review.Request.Hook).To(Equal("my-hook"))
+				Expect(review.Request.Phase).To(Equal(phase))
+				Expect(review.Request.VirtualMachine.Name).To(Equal(vm.Name))
+
+				Expect(json.NewEncoder(w).Encode(response(review))).To(Succeed())
+			}))
+			DeferCleanup(server.Close)
+
+			hook = &vmopv1.VirtualMachineDeployHook{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "my-hook",
+				},
+				Spec: vmopv1.VirtualMachineDeployHookSpec{
+					Phase: phase,
+					Webhook: &vmopv1.VirtualMachineDeployHookWebhook{
+						URL: server.URL,
+						CABundle: pem.EncodeToMemory(&pem.Block{
+							Type:  "CERTIFICATE",
+							Bytes: server.Certificate().Raw,
+						}),
+					},
+				},
+			}
+			objs = append(objs, hook)
+		})
+
+		// reconcileUntilCalled reconciles the hooks until the webhook call
+		// completes. The webhook is called in the background, so the hook is
+		// pending until the call's result is returned by a later reconcile.
+		reconcileUntilCalled := func() bool {
+			var ok bool
+			EventuallyWithOffset(1, func(g Gomega) {
+				var err error
+				ok, err = runner.Reconcile(ctx, k8sClient, vm, phase)
+				g.Expect(err).ToNot(HaveOccurred())
+				c := getCondition()
+				g.Expect(c).ToNot(BeNil())
+				g.Expect(c.Message).ToNot(ContainSubstring("webhook call"))
+			}).Should(Succeed())
+			return ok
+		}
+
+		It("is pending until the webhook call completes", func() {
+			ok, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+
+			c := getCondition()
+			Expect(c).ToNot(BeNil())
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksPendingReason))
+			Expect(c.Message).To(Equal("Pending hooks: my-hook (webhook called)"))
+		})
+
+		It("succeeds when the webhook allows the VM", func() {
+			Expect(reconcileUntilCalled()).To(BeTrue())
+			Expect(requests.Load()).To(BeEquivalentTo(1))
+
+			// The hook is not run again once it succeeds.
+			ok, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(requests.Load()).To(BeEquivalentTo(1))
+		})
+
+		It("fails when the webhook does not allow the VM", func() {
+			response = func(r vmopv1.VirtualMachineDeployHookReview) vmopv1.VirtualMachineDeployHookReview {
+				r.Response = &vmopv1.VirtualMachineDeployHookResponse{
+					UID:     r.Request.UID,
+					Message: "license unavailable",
+				}
+				return r
+			}
+
+			Expect(reconcileUntilCalled()).To(BeFalse())
+
+			c := getCondition()
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksFailedReason))
+			Expect(c.Message).To(Equal("Failed hooks: my-hook (license unavailable)"))
+		})
+
+		It("is pending when the response does not match the request", func() {
+			response = func(r vmopv1.VirtualMachineDeployHookReview) vmopv1.VirtualMachineDeployHookReview {
+				r.Response = &vmopv1.VirtualMachineDeployHookResponse{
+					UID:     "wrong",
+					Allowed: true,
+				}
+				return r
+			}
+
+			Expect(reconcileUntilCalled()).To(BeFalse())
+			Expect(getCondition().Reason).To(Equal(vmopv1.VirtualMachineDeployHooksPendingReason))
+		})
+
+		It("is pending when the server is not trusted", func() {
+			hook.Spec.Webhook.CABundle = nil
+			Expect(k8sClient.Update(ctx, hook)).To(Succeed())
+
+			Expect(reconcileUntilCalled()).To(BeFalse())
+			Expect(requests.Load()).To(BeZero())
+			Expect(getCondition().Reason).To(Equal(vmopv1.VirtualMachineDeployHooksPendingReason))
+		})
+
+		It("is pending when the webhook redirects the request", func() {
+			redirect := httptest.NewTLSServer(http.RedirectHandler(server.URL, http.StatusTemporaryRedirect))
+			DeferCleanup(redirect.Close)
+
+			hook.Spec.Webhook.URL = redirect.URL
+			hook.Spec.Webhook.CABundle = pem.EncodeToMemory(&pem.Block{
+				Type:  "CERTIFICATE",
+				Bytes: redirect.Certificate().Raw,
+			})
+			Expect(k8sClient.Update(ctx, hook)).To(Succeed())
+
+			Expect(reconcileUntilCalled()).To(BeFalse())
+			Expect(requests.Load()).To(BeZero())
+
+			c := getCondition()
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksPendingReason))
+			Expect(c.Message).To(ContainSubstring("webhook returned status 307"))
+		})
+
+		It("fails without calling the webhook when the host is not allowed", func() {
+			ctx = pkgcfg.UpdateContext(ctx, func(config *pkgcfg.Config) {
+				config.DeployHookWebhookHosts = "hooks.example.com"
+			})
+
+			ok, err := runner.Reconcile(ctx, k8sClient, vm, phase)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+			Expect(requests.Load()).To(BeZero())
+
+			c := getCondition()
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksFailedReason))
+			Expect(c.Message).To(MatchRegexp(`Failed hooks: my-hook \(webhook host "127\.0\.0\.1:\d+" is not allowed\)`))
+		})
+	})
+})
+
+var _ = Describe("PostFirstBoot hooks", func() {
+	const phase = vmopv1.VirtualMachineDeployHookPhasePostFirstBoot
+
+	var (
+		ctx       context.Context
+		k8sClient ctrlclient.Client
+		vm        *vmopv1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		ctx = pkgcfg.NewContextWithDefaultConfig()
+		vm = newVM()
+		k8sClient = builder.NewFakeClient(
+			newJobHook("hook-b", phase),
+			newJobHook("hook-a", phase),
+			newJobHook("pre-deploy-hook", vmopv1.VirtualMachineDeployHookPhasePreDeploy))
+	})
+
+	It("records the hooks that apply to the VM", func() {
+		Expect(deployhook.RecordPostFirstBootHooks(ctx, k8sClient, vm)).To(Succeed())
+		Expect(vm.Annotations).To(HaveKeyWithValue(vmopv1.PostFirstBootHooksAnnotation, "hook-a,hook-b"))
+	})
+
+	It("does not record any hooks when none apply to the VM", func() {
+		vm.Namespace = "other-ns"
+		vm.Annotations = map[string]string{vmopv1.PostFirstBootHooksAnnotation: "hook-a"}
+		Expect(deployhook.RecordPostFirstBootHooks(ctx, k8sClient, vm)).To(Succeed())
+		Expect(vm.Annotations).ToNot(HaveKey(vmopv1.PostFirstBootHooksAnnotation))
+	})
+
+	It("returns only the recorded hooks", func() {
+		hooks, err := deployhook.GetHooks(ctx, k8sClient, *vm, phase)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hooks).To(BeEmpty())
+
+		vm.Annotations = map[string]string{vmopv1.PostFirstBootHooksAnnotation: "hook-b"}
+		hooks, err = deployhook.GetHooks(ctx, k8sClient, *vm, phase)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hooks).To(HaveLen(1))
+		Expect(hooks[0].Name).To(Equal("hook-b"))
+	})
+})
+
+var _ = Describe("Ready condition", func() {
+	var vm *vmopv1.VirtualMachine
+
+	BeforeEach(func() {
+		vm = newVM()
+	})
+
+	Context("GateReadyCondition", func() {
+		It("returns the condition when the hooks are not pending", func() {
+			ready := conditions.TrueCondition(vmopv1.ReadyConditionType)
+			Expect(deployhook.GateReadyCondition(vm, ready)).To(BeIdenticalTo(ready))
+		})
+
+		It("returns a false condition when the hooks are pending", func() {
+			conditions.MarkFalse(vm, vmopv1.VirtualMachinePostFirstBootHooksSucceededCondition,
+				vmopv1.VirtualMachineDeployHooksPendingReason, "")
+
+			c := deployhook.GateReadyCondition(vm, conditions.TrueCondition(vmopv1.ReadyConditionType))
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksPendingReason))
+		})
+	})
+
+	Context("UpdateReadyCondition", func() {
+		It("sets Ready false while the hooks are pending and removes it once they succeed", func() {
+			conditions.MarkFalse(vm, vmopv1.VirtualMachinePostFirstBootHooksSucceededCondition,
+				vmopv1.VirtualMachineDeployHooksPendingReason, "")
+			deployhook.UpdateReadyCondition(vm)
+
+			c := conditions.Get(vm, vmopv1.ReadyConditionType)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineDeployHooksPendingReason))
+
+			conditions.MarkTrue(vm, vmopv1.VirtualMachinePostFirstBootHooksSucceededCondition)
+			deployhook.UpdateReadyCondition(vm)
+			Expect(conditions.Get(vm, vmopv1.ReadyConditionType)).To(BeNil())
+		})
+
+		It("does not change a Ready condition set by the readiness probe", func() {
+			conditions.MarkFalse(vm, vmopv1.ReadyConditionType, "NotReady", "")
+			conditions.MarkFalse(vm, vmopv1.VirtualMachinePostFirstBootHooksSucceededCondition,
+				vmopv1.VirtualMachineDeployHooksPendingReason, "")
+			deployhook.UpdateReadyCondition(vm)
+			Expect(conditions.Get(vm, vmopv1.ReadyConditionType).Reason).To(Equal("NotReady"))
+		})
+	})
+})
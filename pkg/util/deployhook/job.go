@@ -0,0 +1,181 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package deployhook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+)
+
+const (
+	// VMNameLabelKey is the label applied to a hook's Job with the name of
+	// the VM that runs the hook.
+	VMNameLabelKey = "deployhook.vmoperator.vmware.com/vm-name"
+
+	// HookNameLabelKey is the label applied to a hook's Job with the name of
+	// the hook.
+	HookNameLabelKey = "deployhook.vmoperator.vmware.com/hook-name"
+
+	// jobContainerName is the name of the container that runs the hook.
+	jobContainerName = "hook"
+)
+
+// JobName returns the name of the Job created to run the hook for the VM. The
+// name is a hash of the VM's UID and the hook's name, so it is a valid name for
+// any VM and hook, and a VM recreated with the same name does not reuse the
+// Jobs of the VM it replaces.
+func JobName(vm vmopv1.VirtualMachine, hook vmopv1.VirtualMachineDeployHook) string {
+	return "deployhook-" + pkgutil.SHA1Sum17(string(vm.UID)+"/"+hook.Name)
+}
+
+func runJob(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm *vmopv1.VirtualMachine,
+	hook vmopv1.VirtualMachineDeployHook) (Result, string, error) {
+
+	if err := checkJobAllowed(ctx, *hook.Spec.Job); err != nil {
+		return ResultFailed, err.Error(), nil
+	}
+
+	job := &batchv1.Job{}
+	key := ctrlclient.ObjectKey{Namespace: vm.Namespace, Name: JobName(*vm, hook)}
+
+	if err := k8sClient.Get(ctx, key, job); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ResultPending, "", fmt.Errorf("failed to get job %s: %w", key.Name, err)
+		}
+
+		job = newJob(*vm, hook, key.Name)
+		if err := controllerutil.SetControllerReference(vm, job, k8sClient.Scheme()); err != nil {
+			return ResultPending, "", err
+		}
+		if err := k8sClient.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+			return ResultPending, "", fmt.Errorf("failed to create job %s: %w", key.Name, err)
+		}
+
+		return ResultPending, fmt.Sprintf("job %s created", key.Name), nil
+	}
+
+	// Do not trust the status of, or delete, a Job that was not created for
+	// the VM.
+	if !metav1.IsControlledBy(job, vm) {
+		return ResultFailed, fmt.Sprintf("job %s is not controlled by the VM", key.Name), nil
+	}
+
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return ResultSucceeded, "", nil
+		case batchv1.JobFailed:
+			// Delete the failed job so the hook is run with a new job the
+			// next time the VM's hooks are reconciled.
+			if err := k8sClient.Delete(
+				ctx,
+				job,
+				ctrlclient.PropagationPolicy(metav1.DeletePropagationBackground)); ctrlclient.IgnoreNotFound(err) != nil {
+
+				return ResultPending, "", fmt.Errorf("failed to delete failed job %s: %w", key.Name, err)
+			}
+			return ResultFailed, fmt.Sprintf("job %s failed: %s", key.Name, c.Message), nil
+		}
+	}
+
+	return ResultPending, fmt.Sprintf("job %s is running", key.Name), nil
+}
+
+// checkJobAllowed returns an error if the job's image or service account is not
+// in the lists of the images and service accounts the administrator allows
+// hooks to use.
+func checkJobAllowed(ctx context.Context, spec vmopv1.VirtualMachineDeployHookJob) error {
+	config := pkgcfg.FromContext(ctx)
+	if !slices.Contains(pkgcfg.StringToSlice(config.DeployHookJobImages), spec.Image) {
+		return fmt.Errorf("job image %q is not allowed", spec.Image)
+	}
+	if sa := spec.ServiceAccountName; sa != "" &&
+		!slices.Contains(pkgcfg.StringToSlice(config.DeployHookJobServiceAccounts), sa) {
+
+		return fmt.Errorf("job service account %q is not allowed", sa)
+	}
+	return nil
+}
+
+func newJob(
+	vm vmopv1.VirtualMachine,
+	hook vmopv1.VirtualMachineDeployHook,
+	name string) *batchv1.Job {
+
+	spec := hook.Spec.Job
+
+	imageName := vm.Spec.ImageName
+	if imageName == "" && vm.Spec.Image != nil {
+		imageName = vm.Spec.Image.Name
+	}
+
+	var primaryIP string
+	if vm.Status.Network != nil {
+		primaryIP = vm.Status.Network.PrimaryIP4
+		if primaryIP == "" {
+			primaryIP = vm.Status.Network.PrimaryIP6
+		}
+	}
+
+	labels := map[string]string{
+		VMNameLabelKey:   vm.Name,
+		HookNameLabelKey: hook.Name,
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: vm.Namespace,
+			Name:      name,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          spec.BackoffLimit,
+			ActiveDeadlineSeconds: spec.ActiveDeadlineSeconds,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: spec.ServiceAccountName,
+					Containers: []corev1.Container{
+						{
+							Name:    jobContainerName,
+							Image:   spec.Image,
+							Command: spec.Command,
+							Args:    spec.Args,
+							Env: []corev1.EnvVar{
+								{Name: "VM_NAME", Value: vm.Name},
+								{Name: "VM_NAMESPACE", Value: vm.Namespace},
+								{Name: "VM_UID", Value: string(vm.UID)},
+								{Name: "VM_IMAGE_NAME", Value: imageName},
+								{Name: "VM_PRIMARY_IP", Value: primaryIP},
+								{Name: "HOOK_PHASE", Value: string(hook.Spec.Phase)},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
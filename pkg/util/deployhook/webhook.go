@@ -0,0 +1,246 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package deployhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+)
+
+const (
+	// ReviewKind is the kind of the object sent to and returned by a webhook.
+	ReviewKind = "VirtualMachineDeployHookReview"
+
+	// defaultWebhookTimeout is the timeout used when a webhook does not
+	// specify one.
+	defaultWebhookTimeout = 10 * time.Second
+
+	// maxWebhookResponseBytes is the maximum size of a webhook's response.
+	maxWebhookResponseBytes = 1 << 20
+
+	// maxWebhookTransports is the maximum number of transports, i.e. the
+	// number of distinct CA bundles, kept by a Runner.
+	maxWebhookTransports = 64
+)
+
+// webhookCallKey identifies a call to a VM's webhook hook.
+type webhookCallKey struct {
+	vmUID string
+	hook  string
+	phase vmopv1.VirtualMachineDeployHookPhase
+}
+
+// webhookCall is the result of a call to a webhook.
+type webhookCall struct {
+	done   bool
+	result Result
+	msg    string
+	err    error
+}
+
+// checkWebhookAllowed returns an error if the webhook's host is not in the list
+// of the hosts the administrator allows webhooks to call.
+func checkWebhookAllowed(ctx context.Context, webhook vmopv1.VirtualMachineDeployHookWebhook) error {
+	u, err := url.Parse(webhook.URL)
+	if err != nil {
+		return fmt.Errorf("webhook url is invalid: %w", err)
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("webhook url scheme %q is not https", u.Scheme)
+	}
+	hosts := pkgcfg.StringToSlice(pkgcfg.FromContext(ctx).DeployHookWebhookHosts)
+	if !slices.Contains(hosts, u.Host) && !slices.Contains(hosts, u.Hostname()) {
+		return fmt.Errorf("webhook host %q is not allowed", u.Host)
+	}
+	return nil
+}
+
+// runWebhook calls the webhook in the background so the reconcile is not
+// blocked on the webhook. The hook is pending until the call completes, and
+// the call's result is returned the next time the hook is run.
+func (r *Runner) runWebhook(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	hook vmopv1.VirtualMachineDeployHook) (Result, string, error) {
+
+	webhook := *hook.Spec.Webhook
+
+	if err := checkWebhookAllowed(ctx, webhook); err != nil {
+		return ResultFailed, err.Error(), nil
+	}
+
+	key := webhookCallKey{
+		vmUID: string(vm.UID),
+		hook:  hook.Name,
+		phase: hook.Spec.Phase,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if call, ok := r.calls[key]; ok {
+		if !call.done {
+			return ResultPending, "webhook call in progress", nil
+		}
+		delete(r.calls, key)
+		return call.result, call.msg, call.err
+	}
+
+	client, err := r.webhookClient(webhook)
+	if err != nil {
+		return ResultFailed, err.Error(), nil
+	}
+
+	timeout := defaultWebhookTimeout
+	if webhook.TimeoutSeconds > 0 {
+		timeout = time.Duration(webhook.TimeoutSeconds) * time.Second
+	}
+
+	call := &webhookCall{}
+	r.calls[key] = call
+
+	vm = vm.DeepCopy()
+	go func() {
+		ctx, cancel := context.WithTimeout(r.ctx, timeout)
+		defer cancel()
+
+		result, msg, err := callWebhook(ctx, client, webhook.URL, vm, hook)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		call.done, call.result, call.msg, call.err = true, result, msg, err
+	}()
+
+	return ResultPending, "webhook called", nil
+}
+
+// webhookClient returns a client that uses the transport shared by the
+// webhooks with the same CA bundle. Redirects are not followed so a webhook
+// cannot redirect the request to a host that is not allowed. Please note, the
+// caller must hold the lock.
+func (r *Runner) webhookClient(webhook vmopv1.VirtualMachineDeployHookWebhook) (*http.Client, error) {
+	key := ""
+	if len(webhook.CABundle) > 0 {
+		key = fmt.Sprintf("%x", sha256.Sum256(webhook.CABundle))
+	}
+
+	transport, ok := r.transports[key]
+	if !ok {
+		tlsConfig := &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+
+		if len(webhook.CABundle) > 0 {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(webhook.CABundle) {
+				return nil, errors.New("webhook caBundle is invalid")
+			}
+			tlsConfig.RootCAs = pool
+		}
+
+		if len(r.transports) >= maxWebhookTransports {
+			for k, t := range r.transports {
+				t.CloseIdleConnections()
+				delete(r.transports, k)
+			}
+		}
+
+		transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		}
+		r.transports[key] = transport
+	}
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}, nil
+}
+
+func callWebhook(
+	ctx context.Context,
+	client *http.Client,
+	url string,
+	vm *vmopv1.VirtualMachine,
+	hook vmopv1.VirtualMachineDeployHook) (Result, string, error) {
+
+	review := vmopv1.VirtualMachineDeployHookReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: vmopv1.GroupVersion.String(),
+			Kind:       ReviewKind,
+		},
+		Request: &vmopv1.VirtualMachineDeployHookRequest{
+			UID:            uuid.NewString(),
+			Hook:           hook.Name,
+			Phase:          hook.Spec.Phase,
+			VirtualMachine: *vm,
+		},
+	}
+
+	body, err := json.Marshal(review)
+	if err != nil {
+		return ResultPending, "", fmt.Errorf("failed to encode review: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return ResultFailed, err.Error(), nil
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return ResultPending, "", fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return ResultPending, "", fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxWebhookResponseBytes))
+	if err != nil {
+		return ResultPending, "", fmt.Errorf("failed to read webhook response: %w", err)
+	}
+
+	var result vmopv1.VirtualMachineDeployHookReview
+	if err := json.Unmarshal(data, &result); err != nil {
+		return ResultPending, "", fmt.Errorf("failed to decode webhook response: %w", err)
+	}
+	if result.Response == nil {
+		return ResultPending, "", errors.New("webhook response is missing")
+	}
+	if result.Response.UID != review.Request.UID {
+		return ResultPending, "", fmt.Errorf(
+			"webhook response uid %q does not match request uid %q",
+			result.Response.UID, review.Request.UID)
+	}
+
+	if !result.Response.Allowed {
+		return ResultFailed, result.Response.Message, nil
+	}
+
+	return ResultSucceeded, "", nil
+}
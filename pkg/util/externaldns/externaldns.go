@@ -0,0 +1,151 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package externaldns
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+)
+
+const (
+	// HostnameAnnotationKey is the annotation ExternalDNS reads from a Service
+	// to create records for the Service's load balancer addresses.
+	HostnameAnnotationKey = "external-dns.alpha.kubernetes.io/hostname"
+
+	// RecordTypeA is the DNSEndpoint record type for IPv4 addresses.
+	RecordTypeA = "A"
+
+	// RecordTypeAAAA is the DNSEndpoint record type for IPv6 addresses.
+	RecordTypeAAAA = "AAAA"
+)
+
+// DNSEndpointGVK is the GroupVersionKind of the ExternalDNS DNSEndpoint CRD.
+var DNSEndpointGVK = schema.GroupVersionKind{
+	Group:   "externaldns.k8s.io",
+	Version: "v1alpha1",
+	Kind:    "DNSEndpoint",
+}
+
+// ServiceHostname returns the DNS name of a VirtualMachineService in the
+// domain.
+func ServiceHostname(name, namespace, domain string) string {
+	return fmt.Sprintf("%s.%s.%s", name, namespace, domain)
+}
+
+// VMHostname returns the DNS name of a VM in the domain.
+func VMHostname(vm *vmopv1.VirtualMachine, domain string) string {
+	return fmt.Sprintf("%s.%s.%s", vm.Name, vm.Namespace, domain)
+}
+
+// ReconcileVMRecords creates or updates the DNSEndpoint with the A/AAAA
+// records for the VM's primary IP addresses when the VM has the ExternalDNS
+// record annotation and the domain is not empty. Otherwise, a DNSEndpoint
+// previously created for the VM is deleted. The DNSEndpoint has the same name
+// as the VM and is owned by the VM.
+//
+// Nothing is done if the DNSEndpoint CRD is not installed.
+func ReconcileVMRecords(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm *vmopv1.VirtualMachine,
+	domain string) error {
+
+	endpoints := vmEndpoints(vm, domain)
+	if len(endpoints) == 0 {
+		return deleteVMRecords(ctx, k8sClient, vm)
+	}
+
+	dnsEndpoint := &unstructured.Unstructured{}
+	dnsEndpoint.SetGroupVersionKind(DNSEndpointGVK)
+	dnsEndpoint.SetNamespace(vm.Namespace)
+	dnsEndpoint.SetName(vm.Name)
+
+	_, err := controllerutil.CreateOrPatch(ctx, k8sClient, dnsEndpoint, func() error {
+		if err := controllerutil.SetControllerReference(vm, dnsEndpoint, k8sClient.Scheme()); err != nil {
+			return err
+		}
+		return unstructured.SetNestedSlice(dnsEndpoint.Object, endpoints, "spec", "endpoints")
+	})
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to create or patch DNSEndpoint: %w", err)
+	}
+
+	return nil
+}
+
+// deleteVMRecords deletes the DNSEndpoint owned by the VM. The DNSEndpoint is
+// read as metadata from the client's cache so VMs without records do not
+// result in a request to the API server.
+func deleteVMRecords(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm *vmopv1.VirtualMachine) error {
+
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(DNSEndpointGVK)
+	if err := k8sClient.Get(ctx, ctrlclient.ObjectKeyFromObject(vm), obj); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get DNSEndpoint: %w", err)
+	}
+
+	if !metav1.IsControlledBy(obj, vm) {
+		return nil
+	}
+
+	if err := k8sClient.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete DNSEndpoint: %w", err)
+	}
+
+	return nil
+}
+
+// vmEndpoints returns the DNSEndpoint endpoints for the VM, or nil if the VM
+// should not have records.
+func vmEndpoints(vm *vmopv1.VirtualMachine, domain string) []interface{} {
+	if domain == "" || vm.Annotations[pkgconst.ExternalDNSRecordAnnotationKey] != "true" {
+		return nil
+	}
+	if vm.Status.Network == nil {
+		return nil
+	}
+
+	dnsName := VMHostname(vm, domain)
+
+	var endpoints []interface{}
+	for _, r := range []struct {
+		recordType string
+		ip         string
+	}{
+		{RecordTypeA, vm.Status.Network.PrimaryIP4},
+		{RecordTypeAAAA, vm.Status.Network.PrimaryIP6},
+	} {
+		if r.ip == "" {
+			continue
+		}
+		endpoints = append(endpoints, map[string]interface{}{
+			"dnsName":    dnsName,
+			"recordType": r.recordType,
+			"targets":    []interface{}{r.ip},
+		})
+	}
+
+	return endpoints
+}
@@ -0,0 +1,158 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package externaldns_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/externaldns"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("ServiceHostname", func() {
+	It("returns the name in the domain", func() {
+		Expect(externaldns.ServiceHostname("my-svc", "my-ns", "vm.example.com")).
+			To(Equal("my-svc.my-ns.vm.example.com"))
+	})
+})
+
+var _ = Describe("ReconcileVMRecords", func() {
+	const domain = "vm.example.com"
+
+	var (
+		ctx       context.Context
+		k8sClient ctrlclient.Client
+		vm        *vmopv1.VirtualMachine
+		domainArg string
+		initObjs  []ctrlclient.Object
+		deletes   int
+	)
+
+	getDNSEndpoint := func() (*unstructured.Unstructured, error) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(externaldns.DNSEndpointGVK)
+		err := k8sClient.Get(ctx, ctrlclient.ObjectKeyFromObject(vm), obj)
+		return obj, err
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		vm = builder.DummyBasicVirtualMachine("my-vm", "my-ns")
+		vm.UID = "my-vm-uid"
+		vm.Annotations = map[string]string{
+			pkgconst.ExternalDNSRecordAnnotationKey: "true",
+		}
+		vm.Status.Network = &vmopv1.VirtualMachineNetworkStatus{
+			PrimaryIP4: "192.168.1.10",
+			PrimaryIP6: "fd00::10",
+		}
+		domainArg = domain
+		initObjs = nil
+		deletes = 0
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = builder.NewFakeClientWithInterceptors(
+			interceptor.Funcs{
+				Delete: func(
+					ctx context.Context,
+					client ctrlclient.WithWatch,
+					obj ctrlclient.Object,
+					opts ...ctrlclient.DeleteOption) error {
+
+					deletes++
+					return client.Delete(ctx, obj, opts...)
+				},
+			},
+			append(initObjs, vm)...)
+		Expect(externaldns.ReconcileVMRecords(ctx, k8sClient, vm, domainArg)).To(Succeed())
+	})
+
+	It("creates a DNSEndpoint with the VM's records", func() {
+		obj, err := getDNSEndpoint()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obj.GetOwnerReferences()).To(HaveLen(1))
+		Expect(obj.GetOwnerReferences()[0].UID).To(Equal(vm.UID))
+
+		endpoints, _, err := unstructured.NestedSlice(obj.Object, "spec", "endpoints")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(endpoints).To(ConsistOf(
+			map[string]interface{}{
+				"dnsName":    "my-vm.my-ns.vm.example.com",
+				"recordType": externaldns.RecordTypeA,
+				"targets":    []interface{}{"192.168.1.10"},
+			},
+			map[string]interface{}{
+				"dnsName":    "my-vm.my-ns.vm.example.com",
+				"recordType": externaldns.RecordTypeAAAA,
+				"targets":    []interface{}{"fd00::10"},
+			},
+		))
+	})
+
+	When("the VM no longer has records", func() {
+		JustBeforeEach(func() {
+			delete(vm.Annotations, pkgconst.ExternalDNSRecordAnnotationKey)
+			Expect(externaldns.ReconcileVMRecords(ctx, k8sClient, vm, domainArg)).To(Succeed())
+		})
+
+		It("deletes the DNSEndpoint", func() {
+			_, err := getDNSEndpoint()
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	When("the domain is empty", func() {
+		BeforeEach(func() {
+			domainArg = ""
+		})
+
+		It("does not create a DNSEndpoint", func() {
+			_, err := getDNSEndpoint()
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("does not delete a DNSEndpoint that does not exist", func() {
+			Expect(deletes).To(BeZero())
+		})
+
+		When("there is a DNSEndpoint not owned by the VM", func() {
+			BeforeEach(func() {
+				obj := &unstructured.Unstructured{}
+				obj.SetGroupVersionKind(externaldns.DNSEndpointGVK)
+				obj.SetNamespace(vm.Namespace)
+				obj.SetName(vm.Name)
+				initObjs = append(initObjs, obj)
+			})
+
+			It("does not delete the DNSEndpoint", func() {
+				_, err := getDNSEndpoint()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deletes).To(BeZero())
+			})
+		})
+	})
+
+	When("the VM does not have an IP", func() {
+		BeforeEach(func() {
+			vm.Status.Network = nil
+		})
+
+		It("does not create a DNSEndpoint", func() {
+			_, err := getDNSEndpoint()
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,46 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"strings"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+)
+
+// operatorManagedExtraConfigPrefixes are the prefixes of the extraConfig keys
+// that are set or reconciled by VM Operator.
+var operatorManagedExtraConfigPrefixes = []string{
+	constants.ExtraConfigGuestInfoPrefix,
+	"vmservice.",
+	"tools.deployPkg.",
+}
+
+// operatorManagedExtraConfigKeys are the extraConfig keys that are set or
+// reconciled by VM Operator.
+var operatorManagedExtraConfigKeys = map[string]struct{}{
+	constants.ExtraConfigRunContainerKey:         {},
+	constants.ExtraConfigReservedProfileID:       {},
+	constants.GOSCIgnoreToolsCheckExtraConfigKey: {},
+	constants.EnableDiskUUIDExtraConfigKey:       {},
+	constants.MMPowerOffVMExtraConfigKey:         {},
+	constants.PCIPassthruMMIOExtraConfigKey:      {},
+	constants.PCIPassthruMMIOSizeExtraConfigKey:  {},
+}
+
+// IsOperatorManagedExtraConfigKey returns true if the provided extraConfig key
+// is set or reconciled by VM Operator, and therefore should not be set from
+// other sources, such as a VM image.
+func IsOperatorManagedExtraConfigKey(key string) bool {
+	if _, ok := operatorManagedExtraConfigKeys[key]; ok {
+		return true
+	}
+	for _, p := range operatorManagedExtraConfigPrefixes {
+		if strings.HasPrefix(key, p) {
+			return true
+		}
+	}
+	return false
+}
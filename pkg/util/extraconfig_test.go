@@ -0,0 +1,26 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+)
+
+var _ = DescribeTable("IsOperatorManagedExtraConfigKey",
+	func(key string, expected bool) {
+		Expect(pkgutil.IsOperatorManagedExtraConfigKey(key)).To(Equal(expected))
+	},
+	Entry("guestinfo key", "guestinfo.userdata", true),
+	Entry("vmservice key", constants.ExtraConfigVMServiceNamespacedName, true),
+	Entry("GOSC pending key", constants.GOSCPendingExtraConfigKey, true),
+	Entry("disk UUID key", constants.EnableDiskUUIDExtraConfigKey, true),
+	Entry("PCI passthru MMIO key", constants.PCIPassthruMMIOSizeExtraConfigKey, true),
+	Entry("GPU driver hint", "pciPassthru0.cfg.enable_uvm", false),
+	Entry("device placeholder", "hypervisor.cpuid.v0", false),
+)
@@ -0,0 +1,352 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package shard
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+)
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;update;patch
+
+const (
+	// LabelKey is the label on a namespace that assigns the namespace to a
+	// shard, overriding the shard derived from the hash of the namespace's
+	// name.
+	LabelKey = "vmoperator.vmware.com/shard"
+
+	// OwnerAnnotationKey is the annotation on a namespace that records the
+	// shard currently reconciling the namespace's resources. A shard claims a
+	// namespace by setting the annotation, and releases it by removing the
+	// annotation, so a namespace that is assigned to a different shard is not
+	// reconciled by the old and new shards at the same time. Removing the
+	// annotation by hand releases a namespace held by a shard that no longer
+	// runs.
+	OwnerAnnotationKey = "vmoperator.vmware.com/shard-owner"
+
+	// handoffRequeueAfter is how long a request is requeued for while the
+	// namespace is handed off from one shard to another.
+	handoffRequeueAfter = 5 * time.Second
+)
+
+// IsEnabled returns true if the namespaces are divided into multiple shards.
+func IsEnabled(ctx context.Context) bool {
+	return pkgcfg.FromContext(ctx).ShardCount > 1
+}
+
+// LeaderElectionID returns the ID used for leader election by the controller
+// manager that owns the configured shard. Each shard has its own leader, so
+// the managers for different shards are active at the same time.
+func LeaderElectionID(ctx context.Context, id string) string {
+	if !IsEnabled(ctx) {
+		return id
+	}
+	return fmt.Sprintf("%s-shard-%d", id, pkgcfg.FromContext(ctx).ShardIndex)
+}
+
+// ForNamespace returns the shard of the provided namespace.
+func ForNamespace(ns corev1.Namespace, shardCount int) (int, error) {
+	if v, ok := ns.Labels[LabelKey]; ok {
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 0 || i >= shardCount {
+			return 0, fmt.Errorf(
+				"invalid shard %q for namespace %s, must be 0-%d",
+				v, ns.Name, shardCount-1)
+		}
+		return i, nil
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(ns.Name))
+	return int(h.Sum32() % uint32(shardCount)), nil //nolint:gosec // shardCount is positive
+}
+
+// Owns returns true if the provided namespace belongs to the shard owned by
+// this controller manager. Cluster-scoped resources, i.e. an empty namespace,
+// are owned by the first shard.
+func Owns(
+	ctx context.Context,
+	k8sClient ctrlclient.Reader,
+	namespace string) (bool, error) {
+
+	if !IsEnabled(ctx) {
+		return true, nil
+	}
+
+	cfg := pkgcfg.FromContext(ctx)
+
+	if namespace == "" {
+		return cfg.ShardIndex == 0, nil
+	}
+
+	var ns corev1.Namespace
+	if err := k8sClient.Get(ctx, ctrlclient.ObjectKey{Name: namespace}, &ns); err != nil {
+		return false, ctrlclient.IgnoreNotFound(err)
+	}
+
+	shard, err := ForNamespace(ns, cfg.ShardCount)
+	if err != nil {
+		return false, err
+	}
+
+	return shard == cfg.ShardIndex, nil
+}
+
+// NewReconciler returns a reconciler that only passes requests for resources
+// in the namespaces owned by this controller manager to the provided
+// reconciler.
+//
+// A namespace assigned to this shard is only reconciled once this shard has
+// claimed the namespace's OwnerAnnotationKey annotation, which is not possible
+// until the shard that previously owned the namespace has released it. A shard
+// releases a namespace assigned to a different shard once it has no requests
+// for the namespace in flight.
+func NewReconciler(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	r reconcile.Reconciler) reconcile.Reconciler {
+
+	if !IsEnabled(ctx) {
+		return r
+	}
+
+	return reconcile.Func(func(
+		reqCtx context.Context,
+		req reconcile.Request) (reconcile.Result, error) {
+
+		// Cluster-scoped resources are always owned by the first shard.
+		if req.Namespace == "" {
+			if pkgcfg.FromContext(ctx).ShardIndex != 0 {
+				return ctrl.Result{}, nil
+			}
+			return r.Reconcile(reqCtx, req)
+		}
+
+		// The request is counted as in flight before the namespace is read,
+		// so the namespace is not released while a request that read the
+		// namespace before it was assigned to a different shard is running.
+		done := inFlight.add(req.Namespace)
+		defer done()
+
+		state, err := handoff(pkgcfg.JoinContext(reqCtx, ctx), k8sClient, req.Namespace)
+		switch {
+		case err != nil:
+			return ctrl.Result{}, err
+		case state == handoffPending:
+			return ctrl.Result{RequeueAfter: handoffRequeueAfter}, nil
+		case state == handoffNotOwned:
+			return ctrl.Result{}, nil
+		}
+
+		return r.Reconcile(reqCtx, req)
+	})
+}
+
+type handoffState int
+
+const (
+	// handoffOwned means this shard owns and holds the namespace.
+	handoffOwned handoffState = iota
+
+	// handoffNotOwned means the namespace is not assigned to this shard, and
+	// this shard does not hold the namespace.
+	handoffNotOwned
+
+	// handoffPending means the namespace is being handed off to or from this
+	// shard.
+	handoffPending
+)
+
+// handoff claims the namespace if it is assigned to this shard and not held by
+// another shard, and releases the namespace if it is assigned to a different
+// shard and held by this shard without any other requests in flight.
+func handoff(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	namespace string) (handoffState, error) {
+
+	var ns corev1.Namespace
+	if err := k8sClient.Get(ctx, ctrlclient.ObjectKey{Name: namespace}, &ns); err != nil {
+		return handoffNotOwned, ctrlclient.IgnoreNotFound(err)
+	}
+
+	cfg := pkgcfg.FromContext(ctx)
+	shard, err := ForNamespace(ns, cfg.ShardCount)
+	if err != nil {
+		return handoffNotOwned, err
+	}
+	owner, held := ownerOf(ns, cfg.ShardCount)
+
+	if shard != cfg.ShardIndex {
+		if !held || owner != cfg.ShardIndex {
+			return handoffNotOwned, nil
+		}
+		if inFlight.count(namespace) > 1 {
+			return handoffPending, nil
+		}
+		if err := setOwner(ctx, k8sClient, &ns, ""); err != nil {
+			return handoffNotOwned, err
+		}
+		logr.FromContextOrDiscard(ctx).Info("Released namespace assigned to another shard",
+			"namespace", namespace, "shard", shard)
+		return handoffNotOwned, nil
+	}
+
+	if held {
+		if owner == cfg.ShardIndex {
+			return handoffOwned, nil
+		}
+		return handoffPending, nil
+	}
+
+	if err := setOwner(ctx, k8sClient, &ns, strconv.Itoa(cfg.ShardIndex)); err != nil {
+		return handoffNotOwned, err
+	}
+	logr.FromContextOrDiscard(ctx).Info("Claimed namespace assigned to this shard",
+		"namespace", namespace)
+	return handoffOwned, nil
+}
+
+// ownerOf returns the shard that holds the namespace. A namespace whose
+// annotation does not name a valid shard, for example because the number of
+// shards was reduced, is not held by any shard.
+func ownerOf(ns corev1.Namespace, shardCount int) (int, bool) {
+	v, ok := ns.Annotations[OwnerAnnotationKey]
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil || i < 0 || i >= shardCount {
+		return 0, false
+	}
+	return i, true
+}
+
+// setOwner sets the namespace's OwnerAnnotationKey annotation to the provided
+// value, or removes the annotation if the value is empty. The update fails
+// with a conflict if the namespace changed since it was read, so two shards
+// cannot both claim the namespace.
+func setOwner(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	ns *corev1.Namespace,
+	value string) error {
+
+	ns = ns.DeepCopy()
+	if value == "" {
+		delete(ns.Annotations, OwnerAnnotationKey)
+	} else {
+		if ns.Annotations == nil {
+			ns.Annotations = map[string]string{}
+		}
+		ns.Annotations[OwnerAnnotationKey] = value
+	}
+	return k8sClient.Update(ctx, ns)
+}
+
+// inFlight counts the requests in flight for each namespace across all of the
+// reconcilers returned by NewReconciler.
+var inFlight = &inFlightRequests{counts: map[string]int{}}
+
+type inFlightRequests struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// add counts a request for the namespace as in flight until the returned
+// function is called.
+func (f *inFlightRequests) add(namespace string) func() {
+	f.mu.Lock()
+	f.counts[namespace]++
+	f.mu.Unlock()
+
+	return func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if f.counts[namespace]--; f.counts[namespace] == 0 {
+			delete(f.counts, namespace)
+		}
+	}
+}
+
+func (f *inFlightRequests) count(namespace string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.counts[namespace]
+}
+
+// NamespaceHandler returns a handler that enqueues the objects in a namespace
+// when the namespace is assigned to a different shard, so the old shard
+// releases the namespace, and when the namespace is released or claimed, so
+// the objects are reconciled by the controller manager that now owns the
+// namespace. The objects are listed with the provided list's type.
+func NamespaceHandler(
+	k8sClient ctrlclient.Reader,
+	list ctrlclient.ObjectList) handler.EventHandler {
+
+	return handler.Funcs{
+		UpdateFunc: func(
+			ctx context.Context,
+			e event.UpdateEvent,
+			q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+
+			if e.ObjectOld.GetLabels()[LabelKey] == e.ObjectNew.GetLabels()[LabelKey] &&
+				e.ObjectOld.GetAnnotations()[OwnerAnnotationKey] == e.ObjectNew.GetAnnotations()[OwnerAnnotationKey] {
+				return
+			}
+
+			namespace := e.ObjectNew.GetName()
+			objs := list.DeepCopyObject().(ctrlclient.ObjectList)
+			if err := k8sClient.List(ctx, objs, ctrlclient.InNamespace(namespace)); err != nil {
+				logr.FromContextOrDiscard(ctx).Error(err,
+					"Failed to list objects in namespace assigned to new shard",
+					"namespace", namespace)
+				return
+			}
+
+			_ = meta.EachListItem(objs, func(o runtime.Object) error {
+				if obj, ok := o.(ctrlclient.Object); ok {
+					q.Add(reconcile.Request{
+						NamespacedName: ctrlclient.ObjectKeyFromObject(obj),
+					})
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// WatchNamespaces adds a watch for namespaces to the builder that enqueues the
+// objects in a namespace when the namespace is assigned to a different shard.
+// The builder is returned as-is if the namespaces are not divided into shards.
+func WatchNamespaces(
+	ctx context.Context,
+	b *builder.Builder,
+	k8sClient ctrlclient.Reader,
+	list ctrlclient.ObjectList) *builder.Builder {
+
+	if !IsEnabled(ctx) {
+		return b
+	}
+	return b.Watches(&corev1.Namespace{}, NamespaceHandler(k8sClient, list))
+}
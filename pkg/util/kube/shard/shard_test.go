@@ -0,0 +1,316 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package shard_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/shard"
+)
+
+var _ = Describe("ForNamespace", func() {
+	It("returns the same shard for the same namespace", func() {
+		ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "my-namespace"}}
+		a, err := shard.ForNamespace(ns, 4)
+		Expect(err).ToNot(HaveOccurred())
+		b, err := shard.ForNamespace(ns, 4)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(a).To(Equal(b))
+		Expect(a).To(BeNumerically("<", 4))
+	})
+
+	It("divides namespaces across all of the shards", func() {
+		shards := map[int]struct{}{}
+		for i := 0; i < 100; i++ {
+			ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns-%d", i)}}
+			s, err := shard.ForNamespace(ns, 4)
+			Expect(err).ToNot(HaveOccurred())
+			shards[s] = struct{}{}
+		}
+		Expect(shards).To(HaveLen(4))
+	})
+
+	It("returns the shard from the namespace label", func() {
+		ns := corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "my-namespace",
+				Labels: map[string]string{shard.LabelKey: "3"},
+			},
+		}
+		Expect(shard.ForNamespace(ns, 4)).To(Equal(3))
+	})
+
+	DescribeTable("returns an error for an invalid namespace label",
+		func(value string) {
+			ns := corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "my-namespace",
+					Labels: map[string]string{shard.LabelKey: value},
+				},
+			}
+			_, err := shard.ForNamespace(ns, 4)
+			Expect(err).To(MatchError(fmt.Sprintf(
+				"invalid shard %q for namespace my-namespace, must be 0-3", value)))
+		},
+		Entry("not a number", "one"),
+		Entry("negative", "-1"),
+		Entry("too large", "4"),
+	)
+})
+
+var _ = Describe("Sharding", func() {
+	var (
+		ctx       context.Context
+		k8sClient ctrlclient.Client
+	)
+
+	BeforeEach(func() {
+		ctx = pkgcfg.NewContext()
+		k8sClient = fake.NewClientBuilder().WithObjects(
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "shard-0",
+					Labels: map[string]string{shard.LabelKey: "0"},
+				},
+			},
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "shard-1",
+					Labels: map[string]string{shard.LabelKey: "1"},
+				},
+			},
+		).Build()
+	})
+
+	When("sharding is disabled", func() {
+		It("owns all namespaces", func() {
+			Expect(shard.IsEnabled(ctx)).To(BeFalse())
+			Expect(shard.LeaderElectionID(ctx, "my-id")).To(Equal("my-id"))
+			Expect(shard.Owns(ctx, k8sClient, "shard-1")).To(BeTrue())
+			Expect(shard.Owns(ctx, k8sClient, "does-not-exist")).To(BeTrue())
+		})
+	})
+
+	When("sharding is enabled", func() {
+		BeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.ShardCount = 2
+				config.ShardIndex = 1
+			})
+		})
+
+		It("owns the namespaces in its shard", func() {
+			Expect(shard.IsEnabled(ctx)).To(BeTrue())
+			Expect(shard.LeaderElectionID(ctx, "my-id")).To(Equal("my-id-shard-1"))
+			Expect(shard.Owns(ctx, k8sClient, "shard-1")).To(BeTrue())
+			Expect(shard.Owns(ctx, k8sClient, "shard-0")).To(BeFalse())
+			Expect(shard.Owns(ctx, k8sClient, "does-not-exist")).To(BeFalse())
+		})
+
+		It("does not own cluster-scoped resources", func() {
+			Expect(shard.Owns(ctx, k8sClient, "")).To(BeFalse())
+		})
+
+		It("only reconciles requests in its shard", func() {
+			var reconciled []string
+			r := shard.NewReconciler(ctx, k8sClient, reconcile.Func(func(
+				_ context.Context, req reconcile.Request) (reconcile.Result, error) {
+				reconciled = append(reconciled, req.Namespace)
+				return reconcile.Result{}, nil
+			}))
+
+			for _, ns := range []string{"shard-0", "shard-1"} {
+				_, err := r.Reconcile(context.Background(), reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: ns, Name: "my-vm"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(reconciled).To(ConsistOf("shard-1"))
+		})
+
+		Context("handoff", func() {
+			var (
+				r          reconcile.Reconciler
+				reconciled []string
+				nested     func()
+			)
+
+			BeforeEach(func() {
+				reconciled = nil
+				nested = nil
+				r = shard.NewReconciler(ctx, k8sClient, reconcile.Func(func(
+					_ context.Context, req reconcile.Request) (reconcile.Result, error) {
+					reconciled = append(reconciled, req.Namespace)
+					if nested != nil {
+						nested()
+					}
+					return reconcile.Result{}, nil
+				}))
+			})
+
+			reconcileNamespace := func(ns string) reconcile.Result {
+				result, err := r.Reconcile(context.Background(), reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: ns, Name: "my-vm"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				return result
+			}
+
+			getOwner := func(name string) string {
+				var ns corev1.Namespace
+				Expect(k8sClient.Get(ctx, ctrlclient.ObjectKey{Name: name}, &ns)).To(Succeed())
+				return ns.Annotations[shard.OwnerAnnotationKey]
+			}
+
+			setNamespace := func(name, label, owner string) {
+				var ns corev1.Namespace
+				Expect(k8sClient.Get(ctx, ctrlclient.ObjectKey{Name: name}, &ns)).To(Succeed())
+				ns.Labels[shard.LabelKey] = label
+				if owner == "" {
+					delete(ns.Annotations, shard.OwnerAnnotationKey)
+				} else {
+					if ns.Annotations == nil {
+						ns.Annotations = map[string]string{}
+					}
+					ns.Annotations[shard.OwnerAnnotationKey] = owner
+				}
+				Expect(k8sClient.Update(ctx, &ns)).To(Succeed())
+			}
+
+			It("claims a namespace in its shard that is not held by another shard", func() {
+				Expect(reconcileNamespace("shard-1")).To(Equal(reconcile.Result{}))
+				Expect(reconciled).To(ConsistOf("shard-1"))
+				Expect(getOwner("shard-1")).To(Equal("1"))
+			})
+
+			It("does not reconcile a namespace in its shard held by another shard", func() {
+				setNamespace("shard-1", "1", "0")
+
+				Expect(reconcileNamespace("shard-1").RequeueAfter).To(BeNumerically(">", 0))
+				Expect(reconciled).To(BeEmpty())
+				Expect(getOwner("shard-1")).To(Equal("0"))
+
+				By("reconciling the namespace once the other shard releases it", func() {
+					setNamespace("shard-1", "1", "")
+					Expect(reconcileNamespace("shard-1")).To(Equal(reconcile.Result{}))
+					Expect(reconciled).To(ConsistOf("shard-1"))
+					Expect(getOwner("shard-1")).To(Equal("1"))
+				})
+			})
+
+			It("claims a namespace held by a shard that does not exist", func() {
+				setNamespace("shard-1", "1", "5")
+
+				Expect(reconcileNamespace("shard-1")).To(Equal(reconcile.Result{}))
+				Expect(reconciled).To(ConsistOf("shard-1"))
+				Expect(getOwner("shard-1")).To(Equal("1"))
+			})
+
+			It("releases a namespace it holds that is assigned to another shard", func() {
+				setNamespace("shard-0", "0", "1")
+
+				Expect(reconcileNamespace("shard-0")).To(Equal(reconcile.Result{}))
+				Expect(reconciled).To(BeEmpty())
+				Expect(getOwner("shard-0")).To(BeEmpty())
+			})
+
+			It("does not release a namespace while it has requests in flight", func() {
+				Expect(reconcileNamespace("shard-1")).To(Equal(reconcile.Result{}))
+				Expect(getOwner("shard-1")).To(Equal("1"))
+
+				var nestedResult reconcile.Result
+				nested = func() {
+					nested = nil
+					setNamespace("shard-1", "0", "1")
+					nestedResult = reconcileNamespace("shard-1")
+				}
+				Expect(reconcileNamespace("shard-1")).To(Equal(reconcile.Result{}))
+				Expect(nestedResult.RequeueAfter).To(BeNumerically(">", 0))
+				Expect(getOwner("shard-1")).To(Equal("1"))
+
+				By("releasing the namespace once the requests complete", func() {
+					Expect(reconcileNamespace("shard-1")).To(Equal(reconcile.Result{}))
+					Expect(getOwner("shard-1")).To(BeEmpty())
+				})
+
+				Expect(reconciled).To(HaveExactElements("shard-1", "shard-1"))
+			})
+		})
+	})
+})
+
+var _ = Describe("NamespaceHandler", func() {
+	var (
+		h     handler.EventHandler
+		queue workqueue.TypedRateLimitingInterface[reconcile.Request]
+		ns    *corev1.Namespace
+	)
+
+	BeforeEach(func() {
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "my-namespace",
+				Labels: map[string]string{shard.LabelKey: "0"},
+			},
+		}
+		k8sClient := fake.NewClientBuilder().WithObjects(
+			ns,
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: "cm-1"}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: "cm-2"}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "cm-3"}},
+		).Build()
+
+		h = shard.NamespaceHandler(k8sClient, &corev1.ConfigMapList{})
+		queue = workqueue.NewTypedRateLimitingQueue(
+			workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		DeferCleanup(queue.ShutDown)
+	})
+
+	It("enqueues the namespace's objects when the namespace's shard changes", func() {
+		newNS := ns.DeepCopy()
+		newNS.Labels[shard.LabelKey] = "1"
+		h.Update(context.Background(), event.UpdateEvent{ObjectOld: ns, ObjectNew: newNS}, queue)
+
+		Expect(queue.Len()).To(Equal(2))
+		var names []string
+		for queue.Len() > 0 {
+			req, _ := queue.Get()
+			Expect(req.Namespace).To(Equal(ns.Name))
+			names = append(names, req.Name)
+			queue.Done(req)
+		}
+		Expect(names).To(ConsistOf("cm-1", "cm-2"))
+	})
+
+	It("enqueues the namespace's objects when the namespace is released", func() {
+		ns.Annotations = map[string]string{shard.OwnerAnnotationKey: "0"}
+		newNS := ns.DeepCopy()
+		delete(newNS.Annotations, shard.OwnerAnnotationKey)
+		h.Update(context.Background(), event.UpdateEvent{ObjectOld: ns, ObjectNew: newNS}, queue)
+		Expect(queue.Len()).To(Equal(2))
+	})
+
+	It("does not enqueue any objects when the namespace's shard does not change", func() {
+		newNS := ns.DeepCopy()
+		newNS.Labels["other"] = "label"
+		h.Update(context.Background(), event.UpdateEvent{ObjectOld: ns, ObjectNew: newNS}, queue)
+		Expect(queue.Len()).To(BeZero())
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -18,6 +19,7 @@ import (
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/internal"
 )
 
@@ -156,6 +158,35 @@ func GetStoragePolicyID(obj storagev1.StorageClass) (string, error) {
 	return policyID, nil
 }
 
+// GetStoragePolicyIDForSite returns the storage policy ID for a given
+// StorageClass when used by a VM that prefers the specified site. If the
+// StorageClass maps the site to a storage policy with the annotation
+// SiteStoragePoliciesAnnotationKey, that policy is returned. Otherwise the
+// StorageClass's storage policy ID is returned.
+func GetStoragePolicyIDForSite(
+	obj storagev1.StorageClass,
+	site string) (string, error) {
+
+	if site != "" {
+		if v := obj.Annotations[pkgconst.SiteStoragePoliciesAnnotationKey]; v != "" {
+			for _, pair := range strings.Split(v, ",") {
+				k, id, ok := strings.Cut(pair, "=")
+				if !ok {
+					return "", fmt.Errorf(
+						"StorageClass %q has invalid site storage policy %q",
+						obj.Name, pair)
+				}
+				if strings.TrimSpace(k) == site {
+					if id = strings.TrimSpace(id); id != "" {
+						return id, nil
+					}
+				}
+			}
+		}
+	}
+	return GetStoragePolicyID(obj)
+}
+
 // SetStoragePolicyID sets the storage policy ID on the given StorageClass.
 // An empty id removes the parameter from the StorageClass.
 func SetStoragePolicyID(obj *storagev1.StorageClass, id string) {
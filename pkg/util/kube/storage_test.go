@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/internal"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
@@ -67,6 +68,39 @@ var _ = DescribeTable("GetStoragePolicyID",
 		}.Error()),
 )
 
+var _ = DescribeTable("GetStoragePolicyIDForSite",
+	func(site, sitePolicies, expPolicyID, expErr string) {
+		obj := storagev1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "my-storage-class",
+			},
+			Parameters: map[string]string{
+				internal.StoragePolicyIDParameter: fakeString,
+			},
+		}
+		if sitePolicies != "" {
+			obj.Annotations = map[string]string{
+				pkgconst.SiteStoragePoliciesAnnotationKey: sitePolicies,
+			}
+		}
+		policyID, err := kubeutil.GetStoragePolicyIDForSite(obj, site)
+		if expErr != "" {
+			Expect(err).To(MatchError(expErr))
+		} else {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policyID).To(Equal(expPolicyID))
+		}
+	},
+	Entry("no site", "", "site-a=policy-a", fakeString, ""),
+	Entry("no site policies", "site-a", "", fakeString, ""),
+	Entry("site has policy", "site-a", "site-a=policy-a, site-b=policy-b", "policy-a", ""),
+	Entry("other site has policy", "site-b", "site-a=policy-a,site-b=policy-b", "policy-b", ""),
+	Entry("site does not have policy", "site-c", "site-a=policy-a", fakeString, ""),
+	Entry("site has empty policy", "site-a", "site-a=", fakeString, ""),
+	Entry("invalid site policies", "site-a", "site-a",
+		"", `StorageClass "my-storage-class" has invalid site storage policy "site-a"`),
+)
+
 var _ = Describe("SetStoragePolicyID", func() {
 	var (
 		obj storagev1.StorageClass
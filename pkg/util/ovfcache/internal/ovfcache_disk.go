@@ -0,0 +1,214 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/ovf"
+)
+
+const diskCacheFileExt = ".json"
+
+// DiskCache is a bounded cache of OVF envelopes stored as files in a
+// directory. Each file has the checksum of the envelope, and a file whose
+// envelope does not match its checksum is removed when read. The least
+// recently used files are removed when the number of files exceeds the
+// maximum.
+type DiskCache struct {
+	dir      string
+	maxItems int
+
+	// mu serializes the eviction of files. The files of the individual items
+	// are protected by the item locks from the context.
+	mu sync.Mutex
+}
+
+type diskCacheFile struct {
+	ItemID         string          `json:"itemID"`
+	ContentVersion string          `json:"contentVersion"`
+	Checksum       string          `json:"checksum"`
+	Envelope       json.RawMessage `json:"envelope"`
+}
+
+// NewDiskCache returns a new DiskCache that stores at most maxItems OVF
+// envelopes in dir. The directory is created if it does not exist.
+func NewDiskCache(dir string, maxItems int) (*DiskCache, error) {
+	if maxItems <= 0 {
+		return nil, fmt.Errorf("invalid max items for ovf disk cache: %d", maxItems)
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create ovf disk cache dir: %w", err)
+	}
+	return &DiskCache{
+		dir:      dir,
+		maxItems: maxItems,
+	}, nil
+}
+
+// Get returns the OVF envelope for the item ID if the cache has the envelope
+// for the content version. Otherwise, nil is returned.
+func (c *DiskCache) Get(
+	itemID, contentVersion string) (*ovf.Envelope, error) {
+
+	fileName := c.fileName(itemID)
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var file diskCacheFile
+	if err := json.Unmarshal(data, &file); err != nil || file.Checksum != checksum(file.Envelope) {
+		// The file is corrupt, so remove it so it is written again.
+		return nil, c.remove(fileName)
+	}
+
+	if file.ItemID != itemID || file.ContentVersion != contentVersion {
+		return nil, nil
+	}
+
+	var env ovf.Envelope
+	if err := json.Unmarshal(file.Envelope, &env); err != nil {
+		return nil, c.remove(fileName)
+	}
+
+	// Record the use of the file for the eviction of the least recently used
+	// files.
+	now := time.Now()
+	if err := os.Chtimes(fileName, now, now); err != nil {
+		return nil, err
+	}
+
+	return &env, nil
+}
+
+// Put stores the OVF envelope for the item ID and content version, and
+// removes the least recently used envelopes if the cache has too many.
+func (c *DiskCache) Put(
+	itemID, contentVersion string,
+	env *ovf.Envelope) error {
+
+	envData, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(diskCacheFile{
+		ItemID:         itemID,
+		ContentVersion: contentVersion,
+		Checksum:       checksum(envData),
+		Envelope:       envData,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file that is renamed so a partially written file
+	// is never read.
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), c.fileName(itemID)); err != nil {
+		return err
+	}
+
+	return c.evict()
+}
+
+// Len returns the number of OVF envelopes in the cache.
+func (c *DiskCache) Len() (int, error) {
+	entries, err := c.entries()
+	return len(entries), err
+}
+
+func (c *DiskCache) fileName(itemID string) string {
+	sum := sha256.Sum256([]byte(itemID))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+diskCacheFileExt)
+}
+
+func (c *DiskCache) entries() ([]fs.FileInfo, error) {
+	dirEntries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]fs.FileInfo, 0, len(dirEntries))
+	for _, e := range dirEntries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), diskCacheFileExt) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		entries = append(entries, info)
+	}
+
+	return entries, nil
+}
+
+func (c *DiskCache) evict() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries, err := c.entries()
+	if err != nil || len(entries) <= c.maxItems {
+		return err
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ModTime().Before(entries[j].ModTime())
+	})
+
+	for _, e := range entries[:len(entries)-c.maxItems] {
+		if err := c.remove(filepath.Join(c.dir, e.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *DiskCache) remove(fileName string) error {
+	if err := os.Remove(fileName); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
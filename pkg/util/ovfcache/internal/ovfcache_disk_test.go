@@ -0,0 +1,154 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package internal_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/ovf"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache/internal"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+var _ = Describe("DiskCache", func() {
+
+	var (
+		dir  string
+		disk *internal.DiskCache
+		env  *ovf.Envelope
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		env = &ovf.Envelope{
+			VirtualSystem: &ovf.VirtualSystem{
+				Content: ovf.Content{ID: "vm"},
+				Product: []ovf.ProductSection{
+					{
+						Property: []ovf.Property{
+							{Key: "key", Default: ptr.To("value")},
+						},
+					},
+				},
+			},
+		}
+
+		var err error
+		disk, err = internal.NewDiskCache(dir, 2)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should return an error when max items is invalid", func() {
+		_, err := internal.NewDiskCache(dir, 0)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return the cached envelope", func() {
+		Expect(disk.Put("item-1", "v1", env)).To(Succeed())
+
+		cached, err := disk.Get("item-1", "v1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cached).To(Equal(env))
+
+		By("from a new cache in the same dir", func() {
+			disk, err = internal.NewDiskCache(dir, 2)
+			Expect(err).ToNot(HaveOccurred())
+			cached, err := disk.Get("item-1", "v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cached).To(Equal(env))
+		})
+	})
+
+	It("should not return the envelope for another content version", func() {
+		Expect(disk.Put("item-1", "v1", env)).To(Succeed())
+
+		cached, err := disk.Get("item-1", "v2")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cached).To(BeNil())
+	})
+
+	It("should remove an envelope that does not match its checksum", func() {
+		Expect(disk.Put("item-1", "v1", env)).To(Succeed())
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+
+		data, err := os.ReadFile(files[0])
+		Expect(err).ToNot(HaveOccurred())
+		data = []byte(string(data[:len(data)-3]) + "x}}")
+		Expect(os.WriteFile(files[0], data, 0o600)).To(Succeed())
+
+		cached, err := disk.Get("item-1", "v1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cached).To(BeNil())
+		Expect(files[0]).ToNot(BeAnExistingFile())
+	})
+
+	It("should remove the least recently used envelopes", func() {
+		Expect(disk.Put("item-1", "v1", env)).To(Succeed())
+		Expect(disk.Put("item-2", "v1", env)).To(Succeed())
+
+		// Age both items, and then use item-1 so item-2 is the least
+		// recently used.
+		files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		Expect(err).ToNot(HaveOccurred())
+		past := time.Now().Add(-time.Hour)
+		for _, f := range files {
+			Expect(os.Chtimes(f, past, past)).To(Succeed())
+		}
+		_, err = disk.Get("item-1", "v1")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(disk.Put("item-3", "v1", env)).To(Succeed())
+		Expect(disk.Len()).To(Equal(2))
+
+		for id, found := range map[string]bool{"item-1": true, "item-2": false, "item-3": true} {
+			cached, err := disk.Get(id, "v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cached != nil).To(Equal(found), fmt.Sprintf("item %s", id))
+		}
+	})
+
+	When("the context has a disk cache", func() {
+		var (
+			ctx      context.Context
+			numCalls int
+		)
+
+		BeforeEach(func() {
+			numCalls = 0
+			ctx = internal.WithContext(context.Background(), 100, time.Hour, time.Hour)
+			internal.SetDiskCache(ctx, disk)
+			internal.SetGetter(ctx, func(ctx context.Context, itemID string) (*ovf.Envelope, error) {
+				numCalls++
+				return env, nil
+			})
+		})
+
+		It("should not download an envelope that is on disk", func() {
+			_, err := internal.GetOVFEnvelope(ctx, "item-1", "v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(numCalls).To(Equal(1))
+
+			// A new context simulates a restart.
+			ctx = internal.WithContext(context.Background(), 100, time.Hour, time.Hour)
+			internal.SetDiskCache(ctx, disk)
+
+			cached, err := internal.GetOVFEnvelope(ctx, "item-1", "v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cached).To(Equal(env))
+			Expect(numCalls).To(Equal(1))
+		})
+	})
+})
@@ -23,6 +23,7 @@ type ContextValueType struct {
 	cache *pkgutil.Cache[VersionedOVFEnvelope]
 	locks *pkgutil.LockPool[string, *sync.RWMutex]
 	getFn GetterFn
+	disk  *DiskCache
 }
 
 type VersionedOVFEnvelope struct {
@@ -118,6 +119,18 @@ func SetGetter(parent context.Context, getter GetterFn) {
 		})
 }
 
+// SetDiskCache assigns to the context the cache used to store OVF envelopes
+// on disk.
+func SetDiskCache(parent context.Context, disk *DiskCache) {
+	ctxgen.SetContext(
+		parent,
+		contextKeyValue,
+		func(curVal ContextValueType) ContextValueType {
+			curVal.disk = disk
+			return curVal
+		})
+}
+
 func GetOVFEnvelope(
 	ctx context.Context,
 	itemID, contentVersion string) (env *ovf.Envelope, err error) {
@@ -150,6 +163,23 @@ func GetOVFEnvelope(
 				return
 			}
 
+			if val.disk != nil {
+				diskEnv, diskErr := val.disk.Get(itemID, contentVersion)
+				if diskErr != nil {
+					logr.FromContextOrDiscard(ctx).Error(diskErr,
+						"Failed to get OVF from disk cache", "itemID", itemID)
+				}
+				if diskEnv != nil {
+					logger.Info("Disk cache item hit, using cached OVF")
+					env = diskEnv
+					val.cache.Put(itemID, VersionedOVFEnvelope{
+						ContentVersion: contentVersion,
+						OvfEnvelope:    env,
+					})
+					return
+				}
+			}
+
 			if val.getFn == nil {
 				err = ErrNoGetter
 				return
@@ -171,6 +201,13 @@ func GetOVFEnvelope(
 			logger.Info("Cache item put",
 				"itemID", itemID,
 				"putResult", putResult)
+
+			if val.disk != nil {
+				if diskErr := val.disk.Put(itemID, contentVersion, env); diskErr != nil {
+					logr.FromContextOrDiscard(ctx).Error(diskErr,
+						"Failed to put OVF in disk cache", "itemID", itemID)
+				}
+			}
 		})
 	return env, err
 
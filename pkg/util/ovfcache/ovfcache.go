@@ -46,6 +46,19 @@ func SetGetter(parent context.Context, getter GetterFn) {
 	internal.SetGetter(parent, getter)
 }
 
+// SetDiskCache assigns to the context a cache that stores at most maxItems OVF
+// envelopes in dir, so the envelopes are not downloaded again after the
+// process restarts. The envelopes are read from the disk cache when they are
+// not in the in-memory cache.
+func SetDiskCache(parent context.Context, dir string, maxItems int) error {
+	disk, err := internal.NewDiskCache(dir, maxItems)
+	if err != nil {
+		return err
+	}
+	internal.SetDiskCache(parent, disk)
+	return nil
+}
+
 // GetOVFEnvelope returns the OVF envelope for the provided item ID, either from
 // the cache or from vSphere. If the item is not in the cache, it will be cached
 // prior to being returned.
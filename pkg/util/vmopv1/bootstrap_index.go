@@ -0,0 +1,71 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+)
+
+// BootstrapResourceField is the field by which VirtualMachine resources are
+// indexed so the VMs that reference a Secret or ConfigMap in their bootstrap
+// spec may be found.
+const BootstrapResourceField = "bootstrapResource"
+
+// IndexBootstrapResource indexes the VirtualMachine resources by
+// BootstrapResourceField. The index is registered once when the manager is
+// created.
+func IndexBootstrapResource(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(
+		ctx,
+		&vmopv1.VirtualMachine{},
+		BootstrapResourceField,
+		VirtualMachineBootstrapResourceIndexFunc)
+}
+
+// VirtualMachineBootstrapResourceIndexFunc returns the BootstrapResourceField
+// index values of a VirtualMachine.
+func VirtualMachineBootstrapResourceIndexFunc(obj client.Object) []string {
+	return BootstrapResourceNames(*obj.(*vmopv1.VirtualMachine))
+}
+
+// BootstrapResourcePredicate returns a predicate that only admits the events
+// for the Secret or ConfigMap resources that are referenced by the bootstrap
+// spec of a VM in the same namespace. The event is admitted if the VMs cannot
+// be listed so a change to the VMs' bootstrap data is not missed.
+func BootstrapResourcePredicate(
+	ctx context.Context,
+	k8sClient client.Client) predicate.Predicate {
+
+	if ctx == nil {
+		panic("context is nil")
+	}
+	if k8sClient == nil {
+		panic("k8sClient is nil")
+	}
+
+	return predicate.NewPredicateFuncs(func(o client.Object) bool {
+		vmList := &vmopv1.VirtualMachineList{}
+		if err := k8sClient.List(
+			ctx,
+			vmList,
+			client.InNamespace(o.GetNamespace()),
+			client.MatchingFields{BootstrapResourceField: o.GetName()},
+			client.Limit(1)); err != nil {
+
+			logr.FromContextOrDiscard(ctx).Error(
+				err,
+				"Failed to list VirtualMachines by bootstrap resource",
+				"name", o.GetName(), "namespace", o.GetNamespace())
+			return true
+		}
+		return len(vmList.Items) > 0
+	})
+}
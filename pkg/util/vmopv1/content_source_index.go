@@ -0,0 +1,45 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+)
+
+// ContentSourceProviderField is the field by which ContentSource resources are
+// indexed so the ContentSources that reference a content provider may be
+// found. The index value is returned by ContentSourceProviderIndexValue.
+const ContentSourceProviderField = "contentSourceProvider"
+
+// IndexContentSourceProvider indexes the ContentSource resources by
+// ContentSourceProviderField. The index is registered once when the
+// ContentSource controller is added to the manager.
+func IndexContentSourceProvider(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(
+		ctx,
+		&vmopv1a1.ContentSource{},
+		ContentSourceProviderField,
+		ContentSourceProviderIndexFunc)
+}
+
+// ContentSourceProviderIndexFunc returns the ContentSourceProviderField index
+// values of a ContentSource.
+func ContentSourceProviderIndexFunc(obj client.Object) []string {
+	ref := obj.(*vmopv1a1.ContentSource).Spec.ProviderRef
+	if ref.Name == "" {
+		return nil
+	}
+	return []string{ContentSourceProviderIndexValue(ref.Kind, ref.Name)}
+}
+
+// ContentSourceProviderIndexValue returns the ContentSourceProviderField index
+// value for the content provider with the provided kind and name.
+func ContentSourceProviderIndexValue(kind, name string) string {
+	return kind + "/" + name
+}
@@ -0,0 +1,45 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+var _ = Describe("IndexContentSourceProvider", func() {
+	It("should index the ContentSources by their provider", func() {
+		indexer := &fakeFieldIndexer{}
+		Expect(vmopv1util.IndexContentSourceProvider(context.Background(), indexer)).To(Succeed())
+		Expect(indexer.fields).To(Equal([]string{
+			"*v1alpha1.ContentSource/contentSourceProvider",
+		}))
+	})
+})
+
+var _ = Describe("ContentSourceProviderIndexFunc", func() {
+	It("should return the kind and name of the ContentSource's provider", func() {
+		cs := &vmopv1a1.ContentSource{
+			Spec: vmopv1a1.ContentSourceSpec{
+				ProviderRef: vmopv1a1.ContentProviderReference{
+					Kind: "HTTPContentProvider",
+					Name: "provider-1",
+				},
+			},
+		}
+		Expect(vmopv1util.ContentSourceProviderIndexFunc(cs)).To(Equal([]string{
+			"HTTPContentProvider/provider-1",
+		}))
+	})
+
+	It("should return nil when the ContentSource does not reference a provider", func() {
+		Expect(vmopv1util.ContentSourceProviderIndexFunc(&vmopv1a1.ContentSource{})).To(BeNil())
+	})
+})
@@ -0,0 +1,30 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+)
+
+// exportItemNameSuffix is appended to the name of the source VM to name the
+// content library item when the item's name is omitted.
+const exportItemNameSuffix = "-export"
+
+// ExportSourceName returns the name of the VM exported by the request.
+func ExportSourceName(vmExportReq *vmopv1.VirtualMachineExportRequest) string {
+	if name := vmExportReq.Spec.Source.Name; name != "" {
+		return name
+	}
+	return vmExportReq.Name
+}
+
+// ExportItemName returns the name of the content library item to which the
+// request exports the VM.
+func ExportItemName(vmExportReq *vmopv1.VirtualMachineExportRequest) string {
+	if t := vmExportReq.Spec.Target.ContentLibraryItem; t != nil && t.Name != "" {
+		return t.Name
+	}
+	return ExportSourceName(vmExportReq) + exportItemNameSuffix
+}
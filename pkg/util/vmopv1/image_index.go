@@ -0,0 +1,49 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+)
+
+// ImageStatusNameField is the field by which VirtualMachineImage and
+// ClusterVirtualMachineImage resources are indexed so ResolveImageName can
+// look up images by their status.name.
+const ImageStatusNameField = "status.name"
+
+// IndexImageStatusName indexes the VirtualMachineImage and
+// ClusterVirtualMachineImage resources by ImageStatusNameField. The index is
+// registered once when the manager is created.
+func IndexImageStatusName(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(
+		ctx,
+		&vmopv1.VirtualMachineImage{},
+		ImageStatusNameField,
+		VirtualMachineImageStatusNameIndexFunc); err != nil {
+
+		return err
+	}
+	return indexer.IndexField(
+		ctx,
+		&vmopv1.ClusterVirtualMachineImage{},
+		ImageStatusNameField,
+		ClusterVirtualMachineImageStatusNameIndexFunc)
+}
+
+// VirtualMachineImageStatusNameIndexFunc returns the ImageStatusNameField
+// index value of a VirtualMachineImage.
+func VirtualMachineImageStatusNameIndexFunc(obj client.Object) []string {
+	return []string{obj.(*vmopv1.VirtualMachineImage).Status.Name}
+}
+
+// ClusterVirtualMachineImageStatusNameIndexFunc returns the
+// ImageStatusNameField index value of a ClusterVirtualMachineImage.
+func ClusterVirtualMachineImageStatusNameIndexFunc(obj client.Object) []string {
+	return []string{obj.(*vmopv1.ClusterVirtualMachineImage).Status.Name}
+}
@@ -0,0 +1,110 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func newImageIndexClient(
+	funcs interceptor.Funcs,
+	objs ...ctrlclient.Object) ctrlclient.Client {
+
+	return fake.NewClientBuilder().WithScheme(builder.NewScheme()).
+		WithIndex(
+			&vmopv1.VirtualMachineImage{},
+			vmopv1util.ImageStatusNameField,
+			vmopv1util.VirtualMachineImageStatusNameIndexFunc).
+		WithIndex(
+			&vmopv1.ClusterVirtualMachineImage{},
+			vmopv1util.ImageStatusNameField,
+			vmopv1util.ClusterVirtualMachineImageStatusNameIndexFunc).
+		WithInterceptorFuncs(funcs).
+		WithObjects(objs...).
+		Build()
+}
+
+type fakeFieldIndexer struct {
+	fields []string
+}
+
+func (f *fakeFieldIndexer) IndexField(
+	_ context.Context,
+	obj ctrlclient.Object,
+	field string,
+	_ ctrlclient.IndexerFunc) error {
+
+	f.fields = append(f.fields, fmt.Sprintf("%T/%s", obj, field))
+	return nil
+}
+
+var _ = Describe("IndexImageStatusName", func() {
+	It("should index the images by their status.name", func() {
+		indexer := &fakeFieldIndexer{}
+		Expect(vmopv1util.IndexImageStatusName(context.Background(), indexer)).To(Succeed())
+		Expect(indexer.fields).To(Equal([]string{
+			"*v1alpha3.VirtualMachineImage/status.name",
+			"*v1alpha3.ClusterVirtualMachineImage/status.name",
+		}))
+	})
+})
+
+// BenchmarkResolveImageName measures resolving the names of images when there
+// are 10k images, which must remain well under the few milliseconds an
+// admission request may take. Please note the fake client filters all of the
+// objects of a kind when listing by an index, so ResolveImageName is much
+// slower than with the manager's cache, which looks up the index.
+func BenchmarkResolveImageName(b *testing.B) {
+	benchmarkResolveImageName(b, context.Background())
+}
+
+func benchmarkResolveImageName(b *testing.B, ctx context.Context) {
+	const (
+		namespace = "my-namespace"
+		numImages = 10000
+		numNames  = 100
+	)
+
+	objs := make([]ctrlclient.Object, 0, numImages)
+	for i := 0; i < numImages; i++ {
+		if i%2 == 0 {
+			img := builder.DummyVirtualMachineImage(fmt.Sprintf("vmi-%d", i))
+			img.Namespace = namespace
+			img.Status.Name = fmt.Sprintf("image-%d", i)
+			objs = append(objs, img)
+		} else {
+			img := builder.DummyClusterVirtualMachineImage(fmt.Sprintf("vmi-%d", i))
+			img.Status.Name = fmt.Sprintf("image-%d", i)
+			objs = append(objs, img)
+		}
+	}
+
+	names := make([]string, numNames)
+	for i := range names {
+		names[i] = fmt.Sprintf("image-%d", i*(numImages/numNames))
+	}
+
+	client := newImageIndexClient(interceptor.Funcs{}, objs...)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := vmopv1util.ResolveImageName(ctx, client, namespace, names[i%numNames]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+)
+
+// ImageResolverMaxEntries is the maximum number of resolved image names that
+// are remembered by the image resolver. The least recently used names are
+// forgotten first.
+const ImageResolverMaxEntries = 1000
+
+type imageResolverContextKey uint8
+
+const imageResolverContextKeyValue imageResolverContextKey = 0
+
+// imageResolver remembers the image to which a status.name was resolved in a
+// namespace, so ResolveImageName does not have to list the images by their
+// status.name again. The remembered names are forgotten when an image with
+// the same status.name is added, updated, or deleted, so a name that becomes
+// ambiguous is detected as soon as the image event is received.
+type imageResolver struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[imageResolverKey]*list.Element
+	lru        *list.List
+
+	// generation is incremented each time names are forgotten so a name that
+	// was resolved concurrently with an image event is not remembered.
+	generation uint64
+}
+
+type imageResolverKey struct {
+	namespace string
+	imgName   string
+}
+
+type imageResolverEntry struct {
+	key    imageResolverKey
+	objKey client.ObjectKey
+}
+
+// WithImageResolver returns a new context with an image resolver that
+// remembers at most maxEntries resolved image names. ResolveImageName uses
+// the resolver when it is in the context.
+func WithImageResolver(parent context.Context, maxEntries int) context.Context {
+	return context.WithValue(
+		parent,
+		imageResolverContextKeyValue,
+		&imageResolver{
+			maxEntries: maxEntries,
+			entries:    map[imageResolverKey]*list.Element{},
+			lru:        list.New(),
+		})
+}
+
+// JoinImageResolverContext returns a context with the image resolver from the
+// left or right, in that order.
+func JoinImageResolverContext(left, right context.Context) context.Context {
+	if getImageResolver(left) != nil {
+		return left
+	}
+	if r := getImageResolver(right); r != nil {
+		return context.WithValue(left, imageResolverContextKeyValue, r)
+	}
+	return left
+}
+
+// AddImageResolverEventHandlers adds handlers to the VirtualMachineImage and
+// ClusterVirtualMachineImage informers that make the context's image resolver
+// forget the names of the images that are added, updated, or deleted. This is
+// a no-op if there is no image resolver in the context.
+func AddImageResolverEventHandlers(
+	ctx context.Context,
+	informers cache.Informers) error {
+
+	r := getImageResolver(ctx)
+	if r == nil {
+		return nil
+	}
+
+	handler := toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			r.forget(imageStatusName(obj))
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			r.forget(imageStatusName(oldObj), imageStatusName(newObj))
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			r.forget(imageStatusName(obj))
+		},
+	}
+
+	for _, obj := range []client.Object{
+		&vmopv1.VirtualMachineImage{},
+		&vmopv1.ClusterVirtualMachineImage{},
+	} {
+		informer, err := informers.GetInformer(ctx, obj)
+		if err != nil {
+			return err
+		}
+		if _, err := informer.AddEventHandler(handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getImageResolver(ctx context.Context) *imageResolver {
+	r, _ := ctx.Value(imageResolverContextKeyValue).(*imageResolver)
+	return r
+}
+
+func imageStatusName(obj any) string {
+	switch tobj := obj.(type) {
+	case *vmopv1.VirtualMachineImage:
+		return tobj.Status.Name
+	case *vmopv1.ClusterVirtualMachineImage:
+		return tobj.Status.Name
+	}
+	return ""
+}
+
+// get returns the image to which imgName was resolved in the namespace. The
+// image is read with the client, and nil is returned if the image no longer
+// exists or no longer has imgName as its status.name.
+func (r *imageResolver) get(
+	ctx context.Context,
+	k8sClient client.Client,
+	namespace, imgName string) client.Object {
+
+	key := imageResolverKey{namespace: namespace, imgName: imgName}
+
+	r.mu.Lock()
+	e, ok := r.entries[key]
+	if ok {
+		r.lru.MoveToFront(e)
+	}
+	r.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	var obj client.Object
+	objKey := e.Value.(imageResolverEntry).objKey
+	if objKey.Namespace == "" {
+		obj = &vmopv1.ClusterVirtualMachineImage{}
+	} else {
+		obj = &vmopv1.VirtualMachineImage{}
+	}
+	if err := k8sClient.Get(ctx, objKey, obj); err != nil ||
+		imageStatusName(obj) != imgName {
+
+		r.forget(imgName)
+		return nil
+	}
+
+	return obj
+}
+
+// add remembers the image to which imgName was resolved in the namespace,
+// unless names were forgotten since the provided generation.
+func (r *imageResolver) add(
+	generation uint64,
+	namespace, imgName string,
+	obj client.Object) {
+
+	key := imageResolverKey{namespace: namespace, imgName: imgName}
+	entry := imageResolverEntry{key: key, objKey: client.ObjectKeyFromObject(obj)}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if generation != r.generation {
+		return
+	}
+
+	if e, ok := r.entries[key]; ok {
+		e.Value = entry
+		r.lru.MoveToFront(e)
+		return
+	}
+
+	r.entries[key] = r.lru.PushFront(entry)
+
+	for r.lru.Len() > r.maxEntries {
+		e := r.lru.Back()
+		delete(r.entries, e.Value.(imageResolverEntry).key)
+		r.lru.Remove(e)
+	}
+}
+
+// getGeneration returns the current generation, which must be provided to
+// add.
+func (r *imageResolver) getGeneration() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.generation
+}
+
+// forget forgets the provided image names in all namespaces.
+func (r *imageResolver) forget(imgNames ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+
+	for _, imgName := range imgNames {
+		if imgName == "" {
+			continue
+		}
+		for key, e := range r.entries {
+			if key.imgName == imgName {
+				delete(r.entries, key)
+				r.lru.Remove(e)
+			}
+		}
+	}
+}
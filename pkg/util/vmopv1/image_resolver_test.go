@@ -0,0 +1,219 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("ResolveImageName with an image resolver", func() {
+
+	const (
+		namespace = "my-namespace"
+		imgName   = "my-image"
+	)
+
+	var (
+		ctx        context.Context
+		k8sClient  client.Client
+		numLists   int
+		maxEntries int
+		vmi        *vmopv1.VirtualMachineImage
+		vmiInf     *controllertest.FakeInformer
+		cvmiInf    *controllertest.FakeInformer
+	)
+
+	BeforeEach(func() {
+		numLists = 0
+		maxEntries = vmopv1util.ImageResolverMaxEntries
+
+		vmi = builder.DummyVirtualMachineImage("vmi-1")
+		vmi.Namespace = namespace
+		vmi.Status.Name = imgName
+	})
+
+	JustBeforeEach(func() {
+		ctx = vmopv1util.WithImageResolver(context.Background(), maxEntries)
+
+		k8sClient = newImageIndexClient(
+			interceptor.Funcs{
+				List: func(
+					ctx context.Context,
+					client client.WithWatch,
+					list client.ObjectList,
+					opts ...client.ListOption) error {
+
+					numLists++
+					return client.List(ctx, list, opts...)
+				},
+			},
+			vmi)
+
+		informers := &informertest.FakeInformers{Scheme: builder.NewScheme()}
+		Expect(vmopv1util.AddImageResolverEventHandlers(ctx, informers)).To(Succeed())
+
+		var err error
+		vmiInf, err = informers.FakeInformerFor(ctx, &vmopv1.VirtualMachineImage{})
+		Expect(err).ToNot(HaveOccurred())
+		cvmiInf, err = informers.FakeInformerFor(ctx, &vmopv1.ClusterVirtualMachineImage{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	resolve := func() (client.Object, error) {
+		return vmopv1util.ResolveImageName(ctx, k8sClient, namespace, imgName)
+	}
+
+	It("should not list the images again for a resolved name", func() {
+		obj, err := resolve()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obj.GetName()).To(Equal(vmi.Name))
+		Expect(numLists).To(Equal(2))
+
+		obj, err = resolve()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obj.GetName()).To(Equal(vmi.Name))
+		Expect(numLists).To(Equal(2))
+	})
+
+	When("an image with the same name is added", func() {
+		It("should return an error that the name is ambiguous", func() {
+			_, err := resolve()
+			Expect(err).ToNot(HaveOccurred())
+
+			cvmi := builder.DummyClusterVirtualMachineImage("vmi-2")
+			cvmi.Status.Name = imgName
+			Expect(k8sClient.Create(ctx, cvmi)).To(Succeed())
+			cvmiInf.Add(cvmi)
+
+			_, err = resolve()
+			Expect(err).To(MatchError(fmt.Sprintf(
+				"multiple VM images exist for %q in namespace and cluster scope", imgName)))
+		})
+	})
+
+	When("the image's name changes", func() {
+		It("should return an error that the image does not exist", func() {
+			_, err := resolve()
+			Expect(err).ToNot(HaveOccurred())
+
+			oldVMI := vmi.DeepCopy()
+			vmi.Status.Name = "my-other-image"
+			Expect(k8sClient.Update(ctx, vmi)).To(Succeed())
+			vmiInf.Update(oldVMI, vmi)
+
+			_, err = resolve()
+			Expect(err).To(MatchError(fmt.Sprintf(
+				"no VM image exists for %q in namespace or cluster scope", imgName)))
+			Expect(numLists).To(Equal(4))
+		})
+	})
+
+	When("the image's name changes before the event is received", func() {
+		It("should not return the image", func() {
+			_, err := resolve()
+			Expect(err).ToNot(HaveOccurred())
+
+			vmi.Status.Name = "my-other-image"
+			Expect(k8sClient.Update(ctx, vmi)).To(Succeed())
+
+			_, err = resolve()
+			Expect(err).To(MatchError(fmt.Sprintf(
+				"no VM image exists for %q in namespace or cluster scope", imgName)))
+		})
+	})
+
+	When("the image is deleted", func() {
+		It("should return an error that the image does not exist", func() {
+			_, err := resolve()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(k8sClient.Delete(ctx, vmi)).To(Succeed())
+			vmiInf.Delete(vmi)
+
+			_, err = resolve()
+			Expect(err).To(MatchError(fmt.Sprintf(
+				"no VM image exists for %q in namespace or cluster scope", imgName)))
+		})
+	})
+
+	When("more names are resolved than the resolver remembers", func() {
+		BeforeEach(func() {
+			maxEntries = 1
+		})
+		It("should forget the least recently resolved name", func() {
+			vmi2 := builder.DummyVirtualMachineImage("vmi-2")
+			vmi2.Namespace = namespace
+			vmi2.Status.Name = "my-other-image"
+			Expect(k8sClient.Create(ctx, vmi2)).To(Succeed())
+
+			_, err := resolve()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = vmopv1util.ResolveImageName(ctx, k8sClient, namespace, vmi2.Status.Name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(numLists).To(Equal(4))
+
+			_, err = resolve()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(numLists).To(Equal(6))
+		})
+	})
+})
+
+var _ = Describe("JoinImageResolverContext", func() {
+	It("should return a context with the resolver from the right", func() {
+		right := vmopv1util.WithImageResolver(context.Background(), 1)
+		ctx := vmopv1util.JoinImageResolverContext(context.Background(), right)
+
+		vmi := builder.DummyVirtualMachineImage("vmi-1")
+		vmi.Namespace = "my-namespace"
+		vmi.Status.Name = "my-image"
+
+		var numLists int
+		k8sClient := newImageIndexClient(
+			interceptor.Funcs{
+				List: func(
+					ctx context.Context,
+					client client.WithWatch,
+					list client.ObjectList,
+					opts ...client.ListOption) error {
+
+					numLists++
+					return client.List(ctx, list, opts...)
+				},
+			},
+			vmi)
+
+		for i := 0; i < 2; i++ {
+			_, err := vmopv1util.ResolveImageName(ctx, k8sClient, vmi.Namespace, vmi.Status.Name)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(numLists).To(Equal(2))
+	})
+})
+
+// BenchmarkResolveImageNameWithImageResolver measures resolving the names of
+// images when there are 10k images and the names are remembered by an image
+// resolver.
+func BenchmarkResolveImageNameWithImageResolver(b *testing.B) {
+	benchmarkResolveImageName(
+		b,
+		vmopv1util.WithImageResolver(
+			context.Background(),
+			vmopv1util.ImageResolverMaxEntries))
+}
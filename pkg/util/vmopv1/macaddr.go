@@ -0,0 +1,126 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"context"
+	"net"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+)
+
+// MACAddressField is the field by which VirtualMachine resources are indexed
+// so the VMs that use a MAC address may be found across all namespaces. The
+// index includes both the MAC addresses assigned in the spec and the MAC
+// addresses observed in the status.
+const MACAddressField = "macAddr"
+
+// IndexMACAddress indexes the VirtualMachine resources by MACAddressField.
+// The index is registered once when the manager is created.
+func IndexMACAddress(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(
+		ctx,
+		&vmopv1.VirtualMachine{},
+		MACAddressField,
+		VirtualMachineMACAddressIndexFunc)
+}
+
+// VirtualMachineMACAddressIndexFunc returns the MACAddressField index values
+// of a VirtualMachine.
+func VirtualMachineMACAddressIndexFunc(obj client.Object) []string {
+	return GetMACAddresses(*obj.(*vmopv1.VirtualMachine))
+}
+
+// NormalizeMACAddress returns the provided MAC address in its canonical,
+// lower-case form, ex. 00:50:56:2a:bb:cc. An empty string is returned if the
+// provided value is not a 48-bit MAC address.
+func NormalizeMACAddress(s string) string {
+	hw, err := net.ParseMAC(s)
+	if err != nil || len(hw) != 6 {
+		return ""
+	}
+	return hw.String()
+}
+
+// GetMACAddresses returns the sorted, normalized MAC addresses assigned to
+// the VM's network interfaces in its spec or observed in its status.
+func GetMACAddresses(vm vmopv1.VirtualMachine) []string {
+	var macs []string
+
+	if vm.Spec.Network != nil {
+		for _, iface := range vm.Spec.Network.Interfaces {
+			if mac := NormalizeMACAddress(iface.MACAddr); mac != "" {
+				macs = append(macs, mac)
+			}
+		}
+	}
+
+	if vm.Status.Network != nil {
+		for _, iface := range vm.Status.Network.Interfaces {
+			if iface.IP == nil {
+				continue
+			}
+			if mac := NormalizeMACAddress(iface.IP.MACAddr); mac != "" {
+				macs = append(macs, mac)
+			}
+		}
+	}
+
+	slices.Sort(macs)
+	return slices.Compact(macs)
+}
+
+// GetDuplicateMACAddresses returns the VMs, other than the provided VM, that
+// use any of the provided MAC addresses. The returned map is keyed by the
+// normalized MAC address, and the values are the namespaced names of the VMs
+// that also use the MAC address. MAC addresses that are not used by another VM
+// are not included in the returned map.
+//
+// The provided client must index the VMs by MACAddressField.
+func GetDuplicateMACAddresses(
+	ctx context.Context,
+	k8sClient client.Client,
+	vm vmopv1.VirtualMachine,
+	macs []string) (map[string][]string, error) {
+
+	var duplicates map[string][]string
+
+	for _, mac := range macs {
+		if mac = NormalizeMACAddress(mac); mac == "" {
+			continue
+		}
+
+		var list vmopv1.VirtualMachineList
+		if err := k8sClient.List(
+			ctx,
+			&list,
+			client.MatchingFields{MACAddressField: mac}); err != nil {
+
+			return nil, err
+		}
+
+		for i := range list.Items {
+			other := list.Items[i]
+			if other.Namespace == vm.Namespace && other.Name == vm.Name {
+				continue
+			}
+			if duplicates == nil {
+				duplicates = map[string][]string{}
+			}
+			duplicates[mac] = append(
+				duplicates[mac],
+				client.ObjectKeyFromObject(&other).String())
+		}
+	}
+
+	for _, names := range duplicates {
+		slices.Sort(names)
+	}
+
+	return duplicates, nil
+}
@@ -0,0 +1,118 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func vmWithMACAddresses(namespace, name string, specMACs, statusMACs []string) *vmopv1.VirtualMachine {
+	vm := &vmopv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+	if len(specMACs) > 0 {
+		vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+		for _, mac := range specMACs {
+			vm.Spec.Network.Interfaces = append(vm.Spec.Network.Interfaces,
+				vmopv1.VirtualMachineNetworkInterfaceSpec{MACAddr: mac})
+		}
+	}
+	if len(statusMACs) > 0 {
+		vm.Status.Network = &vmopv1.VirtualMachineNetworkStatus{}
+		for _, mac := range statusMACs {
+			vm.Status.Network.Interfaces = append(vm.Status.Network.Interfaces,
+				vmopv1.VirtualMachineNetworkInterfaceStatus{
+					IP: &vmopv1.VirtualMachineNetworkInterfaceIPStatus{MACAddr: mac},
+				})
+		}
+	}
+	return vm
+}
+
+var _ = DescribeTable("NormalizeMACAddress",
+	func(in, expected string) {
+		Expect(vmopv1util.NormalizeMACAddress(in)).To(Equal(expected))
+	},
+	Entry("empty", "", ""),
+	Entry("lower-case", "00:50:56:aa:bb:cc", "00:50:56:aa:bb:cc"),
+	Entry("upper-case", "00:50:56:AA:BB:CC", "00:50:56:aa:bb:cc"),
+	Entry("dashes", "00-50-56-aa-bb-cc", "00:50:56:aa:bb:cc"),
+	Entry("invalid", "not-a-mac", ""),
+	Entry("EUI-64", "00:50:56:aa:bb:cc:dd:ee", ""),
+)
+
+var _ = Describe("GetMACAddresses", func() {
+	It("returns no MAC addresses for a VM without a network", func() {
+		Expect(vmopv1util.GetMACAddresses(vmopv1.VirtualMachine{})).To(BeEmpty())
+	})
+
+	It("returns the sorted, unique spec and status MAC addresses", func() {
+		vm := vmWithMACAddresses("ns", "vm",
+			[]string{"00:50:56:AA:BB:CC", "", "invalid"},
+			[]string{"00:50:56:aa:bb:cc", "00:50:56:00:00:01"})
+		Expect(vmopv1util.GetMACAddresses(*vm)).To(Equal([]string{
+			"00:50:56:00:00:01",
+			"00:50:56:aa:bb:cc",
+		}))
+	})
+})
+
+var _ = Describe("IndexMACAddress", func() {
+	It("should index the VMs by their MAC addresses", func() {
+		indexer := &fakeFieldIndexer{}
+		Expect(vmopv1util.IndexMACAddress(context.Background(), indexer)).To(Succeed())
+		Expect(indexer.fields).To(Equal([]string{
+			"*v1alpha3.VirtualMachine/macAddr",
+		}))
+	})
+})
+
+var _ = Describe("GetDuplicateMACAddresses", func() {
+	var (
+		ctx    context.Context
+		client ctrlclient.Client
+		vm     *vmopv1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		vm = vmWithMACAddresses("ns-1", "vm-1", []string{"00:50:56:aa:bb:cc"}, nil)
+		client = builder.NewFakeClient(
+			vm,
+			vmWithMACAddresses("ns-2", "vm-2", nil, []string{"00:50:56:aa:bb:cc"}),
+			vmWithMACAddresses("ns-1", "vm-3", []string{"00:50:56:AA:BB:CC"}, nil),
+			vmWithMACAddresses("ns-1", "vm-4", []string{"00:50:56:00:00:01"}, nil),
+		)
+	})
+
+	It("returns the other VMs that use the MAC addresses", func() {
+		duplicates, err := vmopv1util.GetDuplicateMACAddresses(
+			ctx, client, *vm, []string{"00:50:56:AA:BB:CC", "00:50:56:00:00:02"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(duplicates).To(Equal(map[string][]string{
+			"00:50:56:aa:bb:cc": {"ns-1/vm-3", "ns-2/vm-2"},
+		}))
+	})
+
+	It("returns nothing when no other VM uses the MAC addresses", func() {
+		duplicates, err := vmopv1util.GetDuplicateMACAddresses(
+			ctx, client, *vm, []string{"00:50:56:00:00:02"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(duplicates).To(BeEmpty())
+	})
+})
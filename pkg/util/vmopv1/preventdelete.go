@@ -0,0 +1,132 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"fmt"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+)
+
+// PreventDeleteError is returned when an operation would delete the disk of a
+// volume whose PreventDelete latch is set.
+type PreventDeleteError struct {
+	// Volume is the name of the volume.
+	Volume string
+
+	// DiskUUID is the UUID of the volume's disk.
+	DiskUUID string
+}
+
+func (e PreventDeleteError) Error() string {
+	return fmt.Sprintf(
+		"refusing to delete disk %s of volume %q since the volume has preventDelete set",
+		e.DiskUUID, e.Volume)
+}
+
+// PreventDeleteDiskUUIDs returns a map of the UUIDs of the disks of the VM's
+// volumes whose PreventDelete latch is set to the names of the volumes. The
+// UUIDs are observed from the VM's status, so a volume's disk is not included
+// until the disk's UUID is reported.
+func PreventDeleteDiskUUIDs(vm *vmopv1.VirtualMachine) map[string]string {
+	var latched map[string]struct{}
+	for _, vol := range vm.Spec.Volumes {
+		if vol.PreventDelete {
+			if latched == nil {
+				latched = map[string]struct{}{}
+			}
+			latched[vol.Name] = struct{}{}
+		}
+	}
+	if len(latched) == 0 {
+		return nil
+	}
+
+	diskUUIDs := map[string]string{}
+	for _, vol := range vm.Status.Volumes {
+		if _, ok := latched[vol.Name]; ok && vol.DiskUUID != "" {
+			diskUUIDs[vol.DiskUUID] = vol.Name
+		}
+	}
+	return diskUUIDs
+}
+
+// VirtualDiskUUID returns the UUID of the disk's backing, or an empty string
+// if the backing does not have a UUID.
+func VirtualDiskUUID(disk *vimtypes.VirtualDisk) string {
+	switch tb := disk.Backing.(type) {
+	case *vimtypes.VirtualDiskFlatVer2BackingInfo:
+		return tb.Uuid
+	case *vimtypes.VirtualDiskSeSparseBackingInfo:
+		return tb.Uuid
+	case *vimtypes.VirtualDiskRawDiskMappingVer1BackingInfo:
+		return tb.Uuid
+	case *vimtypes.VirtualDiskSparseVer2BackingInfo:
+		return tb.Uuid
+	case *vimtypes.VirtualDiskRawDiskVer2BackingInfo:
+		return tb.Uuid
+	}
+	return ""
+}
+
+// CheckPreventDeleteDeviceChanges returns a PreventDeleteError if any of the
+// device changes would destroy or replace the files of a disk whose volume has
+// the PreventDelete latch set. Removing such a disk without a file operation,
+// i.e. detaching it, is allowed.
+func CheckPreventDeleteDeviceChanges(
+	vm *vmopv1.VirtualMachine,
+	deviceChanges []vimtypes.BaseVirtualDeviceConfigSpec) error {
+
+	diskUUIDs := PreventDeleteDiskUUIDs(vm)
+	if len(diskUUIDs) == 0 {
+		return nil
+	}
+
+	for _, dc := range deviceChanges {
+		spec := dc.GetVirtualDeviceConfigSpec()
+		if spec.FileOperation != vimtypes.VirtualDeviceConfigSpecFileOperationDestroy &&
+			spec.FileOperation != vimtypes.VirtualDeviceConfigSpecFileOperationReplace {
+			continue
+		}
+		disk, ok := spec.Device.(*vimtypes.VirtualDisk)
+		if !ok {
+			continue
+		}
+		uuid := VirtualDiskUUID(disk)
+		if name, ok := diskUUIDs[uuid]; ok {
+			return PreventDeleteError{Volume: name, DiskUUID: uuid}
+		}
+	}
+
+	return nil
+}
+
+// CheckPreventDeleteDevices returns a PreventDeleteError if any of the devices
+// is the disk of a volume with the PreventDelete latch set. It is used before
+// a VM is destroyed, which deletes all of the disks attached to the VM.
+func CheckPreventDeleteDevices(
+	vm *vmopv1.VirtualMachine,
+	devices []vimtypes.BaseVirtualDevice) error {
+
+	diskUUIDs := PreventDeleteDiskUUIDs(vm)
+	if len(diskUUIDs) == 0 {
+		return nil
+	}
+
+	for _, d := range devices {
+		disk, ok := d.(*vimtypes.VirtualDisk)
+		if !ok {
+			continue
+		}
+		uuid := VirtualDiskUUID(disk)
+		if name, ok := diskUUIDs[uuid]; ok {
+			return PreventDeleteError{Volume: name, DiskUUID: uuid}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+var _ = Describe("PreventDelete", func() {
+	const (
+		latchedUUID   = "6000C29a-0000-0000-0000-000000000001"
+		unlatchedUUID = "6000C29a-0000-0000-0000-000000000002"
+	)
+
+	var vm *vmopv1.VirtualMachine
+
+	newDisk := func(uuid string) *vimtypes.VirtualDisk {
+		return &vimtypes.VirtualDisk{
+			VirtualDevice: vimtypes.VirtualDevice{
+				Backing: &vimtypes.VirtualDiskFlatVer2BackingInfo{
+					Uuid: uuid,
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		vm = &vmopv1.VirtualMachine{
+			Spec: vmopv1.VirtualMachineSpec{
+				Volumes: []vmopv1.VirtualMachineVolume{
+					{
+						Name:          "latched",
+						PreventDelete: true,
+					},
+					{
+						Name: "unlatched",
+					},
+				},
+			},
+			Status: vmopv1.VirtualMachineStatus{
+				Volumes: []vmopv1.VirtualMachineVolumeStatus{
+					{
+						Name:     "latched",
+						DiskUUID: latchedUUID,
+					},
+					{
+						Name:     "unlatched",
+						DiskUUID: unlatchedUUID,
+					},
+				},
+			},
+		}
+	})
+
+	Context("PreventDeleteDiskUUIDs", func() {
+		It("returns the disks of the latched volumes", func() {
+			Expect(vmopv1util.PreventDeleteDiskUUIDs(vm)).To(Equal(map[string]string{
+				latchedUUID: "latched",
+			}))
+		})
+
+		It("returns nothing when no volumes are latched", func() {
+			vm.Spec.Volumes[0].PreventDelete = false
+			Expect(vmopv1util.PreventDeleteDiskUUIDs(vm)).To(BeEmpty())
+		})
+	})
+
+	Context("CheckPreventDeleteDeviceChanges", func() {
+		DescribeTable("device changes",
+			func(uuid string, op vimtypes.VirtualDeviceConfigSpecOperation,
+				fileOp vimtypes.VirtualDeviceConfigSpecFileOperation, prevented bool) {
+
+				err := vmopv1util.CheckPreventDeleteDeviceChanges(vm, []vimtypes.BaseVirtualDeviceConfigSpec{
+					&vimtypes.VirtualDeviceConfigSpec{
+						Operation:     op,
+						FileOperation: fileOp,
+						Device:        newDisk(uuid),
+					},
+				})
+				if prevented {
+					Expect(err).To(MatchError(vmopv1util.PreventDeleteError{
+						Volume:   "latched",
+						DiskUUID: latchedUUID,
+					}))
+				} else {
+					Expect(err).ToNot(HaveOccurred())
+				}
+			},
+			Entry("remove and destroy latched disk", latchedUUID,
+				vimtypes.VirtualDeviceConfigSpecOperationRemove,
+				vimtypes.VirtualDeviceConfigSpecFileOperationDestroy, true),
+			Entry("replace latched disk", latchedUUID,
+				vimtypes.VirtualDeviceConfigSpecOperationEdit,
+				vimtypes.VirtualDeviceConfigSpecFileOperationReplace, true),
+			Entry("detach latched disk", latchedUUID,
+				vimtypes.VirtualDeviceConfigSpecOperationRemove,
+				vimtypes.VirtualDeviceConfigSpecFileOperation(""), false),
+			Entry("remove and destroy unlatched disk", unlatchedUUID,
+				vimtypes.VirtualDeviceConfigSpecOperationRemove,
+				vimtypes.VirtualDeviceConfigSpecFileOperationDestroy, false),
+		)
+	})
+
+	Context("CheckPreventDeleteDevices", func() {
+		It("returns an error when a latched disk is attached", func() {
+			err := vmopv1util.CheckPreventDeleteDevices(vm, []vimtypes.BaseVirtualDevice{
+				newDisk(unlatchedUUID),
+				newDisk(latchedUUID),
+			})
+			Expect(err).To(MatchError(vmopv1util.PreventDeleteError{
+				Volume:   "latched",
+				DiskUUID: latchedUUID,
+			}))
+		})
+
+		It("returns nil when no latched disk is attached", func() {
+			err := vmopv1util.CheckPreventDeleteDevices(vm, []vimtypes.BaseVirtualDevice{
+				newDisk(unlatchedUUID),
+				&vimtypes.VirtualCdrom{},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
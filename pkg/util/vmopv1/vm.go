@@ -53,7 +53,9 @@ func (e ErrImageNotFound) Status() metav1.Status {
 }
 
 // ResolveImageName resolves the provided name of a VM image either to a
-// VirtualMachineImage resource or ClusterVirtualMachineImage resource.
+// VirtualMachineImage resource or ClusterVirtualMachineImage resource. If the
+// context has an image resolver, the resolved status.name is remembered so
+// the images do not need to be listed again to resolve the same name.
 func ResolveImageName(
 	ctx context.Context,
 	k8sClient client.Client,
@@ -98,13 +100,40 @@ func ResolveImageName(
 		return obj, nil
 	}
 
+	r := getImageResolver(ctx)
+	if r == nil {
+		return resolveImageStatusName(ctx, k8sClient, namespace, imgName)
+	}
+
+	if obj := r.get(ctx, k8sClient, namespace, imgName); obj != nil {
+		return obj, nil
+	}
+
+	generation := r.getGeneration()
+	obj, err := resolveImageStatusName(ctx, k8sClient, namespace, imgName)
+	if err != nil {
+		return nil, err
+	}
+	r.add(generation, namespace, imgName, obj)
+
+	return obj, nil
+}
+
+// resolveImageStatusName resolves the provided status.name of a VM image
+// either to a VirtualMachineImage resource or ClusterVirtualMachineImage
+// resource.
+func resolveImageStatusName(
+	ctx context.Context,
+	k8sClient client.Client,
+	namespace, imgName string) (client.Object, error) {
+
 	var obj client.Object
 
 	// Check if a single namespace scope image exists by the status name.
 	var vmiList vmopv1.VirtualMachineImageList
 	if err := k8sClient.List(ctx, &vmiList, client.InNamespace(namespace),
 		client.MatchingFields{
-			"status.name": imgName,
+			ImageStatusNameField: imgName,
 		},
 	); err != nil {
 		return nil, err
@@ -122,7 +151,7 @@ func ResolveImageName(
 	// Check if a single cluster scope image exists by the status name.
 	var cvmiList vmopv1.ClusterVirtualMachineImageList
 	if err := k8sClient.List(ctx, &cvmiList, client.MatchingFields{
-		"status.name": imgName,
+		ImageStatusNameField: imgName,
 	}); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,45 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+)
+
+// VirtualMachineImageField is the field by which VirtualMachine resources are
+// indexed so the VMs that reference an image may be found. The index value is
+// returned by VirtualMachineImageIndexValue.
+const VirtualMachineImageField = "vmImage"
+
+// IndexVirtualMachineImage indexes the VirtualMachine resources by
+// VirtualMachineImageField. The index is registered once when the manager is
+// created.
+func IndexVirtualMachineImage(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(
+		ctx,
+		&vmopv1.VirtualMachine{},
+		VirtualMachineImageField,
+		VirtualMachineImageIndexFunc)
+}
+
+// VirtualMachineImageIndexFunc returns the VirtualMachineImageField index
+// values of a VirtualMachine.
+func VirtualMachineImageIndexFunc(obj client.Object) []string {
+	img := obj.(*vmopv1.VirtualMachine).Spec.Image
+	if img == nil || img.Name == "" {
+		return nil
+	}
+	return []string{VirtualMachineImageIndexValue(img.Kind, img.Name)}
+}
+
+// VirtualMachineImageIndexValue returns the VirtualMachineImageField index
+// value for the image with the provided kind and name.
+func VirtualMachineImageIndexValue(kind, name string) string {
+	return kind + "/" + name
+}
@@ -0,0 +1,45 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+var _ = Describe("IndexVirtualMachineImage", func() {
+	It("should index the VMs by their image", func() {
+		indexer := &fakeFieldIndexer{}
+		Expect(vmopv1util.IndexVirtualMachineImage(context.Background(), indexer)).To(Succeed())
+		Expect(indexer.fields).To(Equal([]string{
+			"*v1alpha3.VirtualMachine/vmImage",
+		}))
+	})
+})
+
+var _ = Describe("VirtualMachineImageIndexFunc", func() {
+	It("should return the kind and name of the VM's image", func() {
+		vm := &vmopv1.VirtualMachine{
+			Spec: vmopv1.VirtualMachineSpec{
+				Image: &vmopv1.VirtualMachineImageRef{
+					Kind: "ClusterVirtualMachineImage",
+					Name: "vmi-1",
+				},
+			},
+		}
+		Expect(vmopv1util.VirtualMachineImageIndexFunc(vm)).To(Equal([]string{
+			"ClusterVirtualMachineImage/vmi-1",
+		}))
+	})
+
+	It("should return nil when the VM does not have an image", func() {
+		Expect(vmopv1util.VirtualMachineImageIndexFunc(&vmopv1.VirtualMachine{})).To(BeNil())
+	})
+})
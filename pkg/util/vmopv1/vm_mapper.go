@@ -0,0 +1,221 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+)
+
+// ImageToVirtualMachineMapper returns a mapper function used to enqueue
+// reconcile requests for VMs in response to an event on the
+// VirtualMachineImage or ClusterVirtualMachineImage resource. The provided
+// client must index the VMs by VirtualMachineImageField.
+func ImageToVirtualMachineMapper(
+	ctx context.Context,
+	k8sClient client.Client) handler.MapFunc {
+
+	return virtualMachineMapper(
+		ctx,
+		k8sClient,
+		"image",
+		func(o client.Object) ([]client.ListOption, func(vmopv1.VirtualMachine) bool) {
+			var opts []client.ListOption
+			var kind string
+			switch o.(type) {
+			case *vmopv1.VirtualMachineImage:
+				kind = vmiKind
+				opts = append(opts, client.InNamespace(o.GetNamespace()))
+			case *vmopv1.ClusterVirtualMachineImage:
+				// VMs in any namespace may use a cluster-scoped image.
+				kind = cvmiKind
+			default:
+				panic(fmt.Sprintf("object is %T", o))
+			}
+			opts = append(opts, client.MatchingFields{
+				VirtualMachineImageField: VirtualMachineImageIndexValue(kind, o.GetName()),
+			})
+			return opts, nil
+		})
+}
+
+// ResourcePolicyToVirtualMachineMapper returns a mapper function used to
+// enqueue reconcile requests for VMs in response to an event on the
+// VirtualMachineSetResourcePolicy resource.
+func ResourcePolicyToVirtualMachineMapper(
+	ctx context.Context,
+	k8sClient client.Client) handler.MapFunc {
+
+	return virtualMachineMapper(
+		ctx,
+		k8sClient,
+		"resource policy",
+		func(o client.Object) ([]client.ListOption, func(vmopv1.VirtualMachine) bool) {
+			if _, ok := o.(*vmopv1.VirtualMachineSetResourcePolicy); !ok {
+				panic(fmt.Sprintf("object is %T", o))
+			}
+			opts := []client.ListOption{client.InNamespace(o.GetNamespace())}
+			return opts, func(vm vmopv1.VirtualMachine) bool {
+				return vm.Spec.Reserved != nil &&
+					vm.Spec.Reserved.ResourcePolicyName == o.GetName()
+			}
+		})
+}
+
+// BootstrapResourceToVirtualMachineMapper returns a mapper function used to
+// enqueue reconcile requests for VMs in response to an event on a Secret or
+// ConfigMap resource that contains a VM's bootstrap data. The object may be
+// the resource's metadata. The provided client must index the VMs by
+// BootstrapResourceField.
+func BootstrapResourceToVirtualMachineMapper(
+	ctx context.Context,
+	k8sClient client.Client) handler.MapFunc {
+
+	return virtualMachineMapper(
+		ctx,
+		k8sClient,
+		"bootstrap resource",
+		func(o client.Object) ([]client.ListOption, func(vmopv1.VirtualMachine) bool) {
+			return []client.ListOption{
+				client.InNamespace(o.GetNamespace()),
+				client.MatchingFields{BootstrapResourceField: o.GetName()},
+			}, nil
+		})
+}
+
+// BootstrapResourceNames returns the names of the Secret or ConfigMap
+// resources referenced by the VM's bootstrap spec.
+func BootstrapResourceNames(vm vmopv1.VirtualMachine) []string {
+	bs := vm.Spec.Bootstrap
+	if bs == nil {
+		return nil
+	}
+
+	var names []string
+	add := func(name string) {
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	if ci := bs.CloudInit; ci != nil {
+		if raw := ci.RawCloudConfig; raw != nil {
+			add(raw.Name)
+		}
+		if cc := ci.CloudConfig; cc != nil {
+			for _, u := range cc.Users {
+				if u.HashedPasswd != nil {
+					add(u.HashedPasswd.Name)
+				}
+				if u.Passwd != nil {
+					add(u.Passwd.Name)
+				}
+			}
+		}
+	}
+
+	if sp := bs.Sysprep; sp != nil {
+		if raw := sp.RawSysprep; raw != nil {
+			add(raw.Name)
+		}
+		if s := sp.Sysprep; s != nil {
+			if gu := s.GUIUnattended; gu != nil && gu.Password != nil {
+				add(gu.Password.Name)
+			}
+			if id := s.Identification; id != nil && id.DomainAdminPassword != nil {
+				add(id.DomainAdminPassword.Name)
+			}
+			if s.UserData.ProductID != nil {
+				add(s.UserData.ProductID.Name)
+			}
+		}
+	}
+
+	if vApp := bs.VAppConfig; vApp != nil {
+		add(vApp.RawProperties)
+		for _, p := range vApp.Properties {
+			if from := p.Value.From; from != nil {
+				add(from.Name)
+			}
+		}
+	}
+
+	return names
+}
+
+// virtualMachineMapper returns a mapper function that enqueues reconcile
+// requests for the VMs listed with the options returned from matchFn. If
+// matchFn also returns a function, only the listed VMs matched by that
+// function are enqueued.
+func virtualMachineMapper(
+	ctx context.Context,
+	k8sClient client.Client,
+	resourceName string,
+	matchFn func(client.Object) ([]client.ListOption, func(vmopv1.VirtualMachine) bool)) handler.MapFunc {
+
+	if ctx == nil {
+		panic("context is nil")
+	}
+	if k8sClient == nil {
+		panic("k8sClient is nil")
+	}
+
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		if ctx == nil {
+			panic("context is nil")
+		}
+		if o == nil {
+			panic("object is nil")
+		}
+
+		opts, match := matchFn(o)
+
+		logger := logr.FromContextOrDiscard(ctx).
+			WithValues("name", o.GetName(), "namespace", o.GetNamespace())
+
+		vmList := &vmopv1.VirtualMachineList{}
+		if err := k8sClient.List(ctx, vmList, opts...); err != nil {
+			if !apierrors.IsNotFound(err) {
+				logger.Error(
+					err,
+					"Failed to list VirtualMachines for "+
+						"reconciliation due to "+resourceName+" watch")
+			}
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for i := range vmList.Items {
+			vm := vmList.Items[i]
+			if match == nil || match(vm) {
+				requests = append(
+					requests,
+					reconcile.Request{
+						NamespacedName: client.ObjectKey{
+							Namespace: vm.Namespace,
+							Name:      vm.Name,
+						},
+					})
+			}
+		}
+
+		if len(requests) > 0 {
+			logger.V(4).Info(
+				"Reconciling VMs due to "+resourceName+" watch",
+				"requests", requests)
+		}
+
+		return requests
+	}
+}
@@ -0,0 +1,340 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	vmopv1common "github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
+	vmopv1sysprep "github.com/vmware-tanzu/vm-operator/api/v1alpha3/sysprep"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("VirtualMachine mappers", func() {
+	const namespaceName = "fake"
+
+	var (
+		ctx       context.Context
+		k8sClient ctrlclient.Client
+		withObjs  []ctrlclient.Object
+		withFuncs interceptor.Funcs
+	)
+
+	newVM := func(namespace, name string) *vmopv1.VirtualMachine {
+		return &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+		}
+	}
+
+	request := func(namespace, name string) reconcile.Request {
+		return reconcile.Request{
+			NamespacedName: ctrlclient.ObjectKey{
+				Namespace: namespace,
+				Name:      name,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		withObjs = nil
+		withFuncs = interceptor.Funcs{}
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = builder.NewFakeClientWithInterceptors(withFuncs, withObjs...)
+	})
+
+	Describe("ImageToVirtualMachineMapper", func() {
+		BeforeEach(func() {
+			vm1 := newVM(namespaceName, "vm-1")
+			vm1.Spec.Image = &vmopv1.VirtualMachineImageRef{
+				Kind: "VirtualMachineImage",
+				Name: "vmi-1",
+			}
+			vm2 := newVM("other", "vm-2")
+			vm2.Spec.Image = &vmopv1.VirtualMachineImageRef{
+				Kind: "ClusterVirtualMachineImage",
+				Name: "vmi-1",
+			}
+			vm3 := newVM("other", "vm-3")
+			vm3.Spec.Image = &vmopv1.VirtualMachineImageRef{
+				Kind: "VirtualMachineImage",
+				Name: "vmi-1",
+			}
+			withObjs = append(withObjs, vm1, vm2, vm3)
+		})
+
+		It("should return requests for VMs that use a namespaced image", func() {
+			mapFn := vmopv1util.ImageToVirtualMachineMapper(ctx, k8sClient)
+			img := &vmopv1.VirtualMachineImage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespaceName,
+					Name:      "vmi-1",
+				},
+			}
+			Expect(mapFn(ctx, img)).To(ConsistOf(request(namespaceName, "vm-1")))
+		})
+
+		It("should return requests for VMs that use a cluster image", func() {
+			mapFn := vmopv1util.ImageToVirtualMachineMapper(ctx, k8sClient)
+			img := &vmopv1.ClusterVirtualMachineImage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "vmi-1",
+				},
+			}
+			Expect(mapFn(ctx, img)).To(ConsistOf(request("other", "vm-2")))
+		})
+
+		When("listing the vms", func() {
+			var listOpts ctrlclient.ListOptions
+
+			BeforeEach(func() {
+				withFuncs.List = func(
+					ctx context.Context,
+					client ctrlclient.WithWatch,
+					list ctrlclient.ObjectList,
+					opts ...ctrlclient.ListOption) error {
+
+					listOpts.ApplyOptions(opts)
+					return client.List(ctx, list, opts...)
+				}
+			})
+
+			It("should list the VMs by the image index", func() {
+				mapFn := vmopv1util.ImageToVirtualMachineMapper(ctx, k8sClient)
+				img := &vmopv1.VirtualMachineImage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "vmi-1",
+					},
+				}
+				Expect(mapFn(ctx, img)).To(HaveLen(1))
+				Expect(listOpts.Namespace).To(Equal(namespaceName))
+				Expect(listOpts.FieldSelector).ToNot(BeNil())
+				Expect(listOpts.FieldSelector.String()).To(Equal(
+					vmopv1util.VirtualMachineImageField + "=VirtualMachineImage/vmi-1"))
+			})
+		})
+
+		It("should panic for another object", func() {
+			mapFn := vmopv1util.ImageToVirtualMachineMapper(ctx, k8sClient)
+			Expect(func() {
+				_ = mapFn(ctx, &vmopv1.VirtualMachine{})
+			}).To(PanicWith("object is *v1alpha3.VirtualMachine"))
+		})
+	})
+
+	Describe("ResourcePolicyToVirtualMachineMapper", func() {
+		BeforeEach(func() {
+			vm1 := newVM(namespaceName, "vm-1")
+			vm1.Spec.Reserved = &vmopv1.VirtualMachineReservedSpec{
+				ResourcePolicyName: "policy-1",
+			}
+			vm2 := newVM(namespaceName, "vm-2")
+			withObjs = append(withObjs, vm1, vm2)
+		})
+
+		It("should return requests for VMs that use the policy", func() {
+			mapFn := vmopv1util.ResourcePolicyToVirtualMachineMapper(ctx, k8sClient)
+			policy := &vmopv1.VirtualMachineSetResourcePolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespaceName,
+					Name:      "policy-1",
+				},
+			}
+			Expect(mapFn(ctx, policy)).To(ConsistOf(request(namespaceName, "vm-1")))
+		})
+
+		When("there is an error listing vms", func() {
+			BeforeEach(func() {
+				withFuncs.List = func(
+					ctx context.Context,
+					client ctrlclient.WithWatch,
+					list ctrlclient.ObjectList,
+					opts ...ctrlclient.ListOption) error {
+
+					return errors.New("fake")
+				}
+			})
+
+			It("should not return any requests", func() {
+				mapFn := vmopv1util.ResourcePolicyToVirtualMachineMapper(ctx, k8sClient)
+				policy := &vmopv1.VirtualMachineSetResourcePolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "policy-1",
+					},
+				}
+				Expect(mapFn(ctx, policy)).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("BootstrapResourceToVirtualMachineMapper", func() {
+		BeforeEach(func() {
+			vm1 := newVM(namespaceName, "vm-1")
+			vm1.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+				CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+					RawCloudConfig: &vmopv1common.SecretKeySelector{
+						Name: "bootstrap-1",
+						Key:  "user-data",
+					},
+				},
+			}
+			vm2 := newVM(namespaceName, "vm-2")
+			withObjs = append(withObjs, vm1, vm2)
+		})
+
+		It("should return requests for VMs that use the resource", func() {
+			mapFn := vmopv1util.BootstrapResourceToVirtualMachineMapper(ctx, k8sClient)
+			obj := &metav1.PartialObjectMetadata{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespaceName,
+					Name:      "bootstrap-1",
+				},
+			}
+			Expect(mapFn(ctx, obj)).To(ConsistOf(request(namespaceName, "vm-1")))
+		})
+
+		When("listing the vms", func() {
+			var listOpts ctrlclient.ListOptions
+
+			BeforeEach(func() {
+				withFuncs.List = func(
+					ctx context.Context,
+					client ctrlclient.WithWatch,
+					list ctrlclient.ObjectList,
+					opts ...ctrlclient.ListOption) error {
+
+					listOpts.ApplyOptions(opts)
+					return client.List(ctx, list, opts...)
+				}
+			})
+
+			It("should list the VMs by the bootstrap resource index", func() {
+				mapFn := vmopv1util.BootstrapResourceToVirtualMachineMapper(ctx, k8sClient)
+				obj := &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "bootstrap-1",
+					},
+				}
+				Expect(mapFn(ctx, obj)).To(HaveLen(1))
+				Expect(listOpts.Namespace).To(Equal(namespaceName))
+				Expect(listOpts.FieldSelector).ToNot(BeNil())
+				Expect(listOpts.FieldSelector.String()).To(Equal(
+					vmopv1util.BootstrapResourceField + "=bootstrap-1"))
+			})
+		})
+	})
+
+	Describe("BootstrapResourcePredicate", func() {
+		newObj := func(namespace, name string) *metav1.PartialObjectMetadata {
+			return &metav1.PartialObjectMetadata{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			vm1 := newVM(namespaceName, "vm-1")
+			vm1.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+				CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+					RawCloudConfig: &vmopv1common.SecretKeySelector{
+						Name: "bootstrap-1",
+						Key:  "user-data",
+					},
+				},
+			}
+			withObjs = append(withObjs, vm1)
+		})
+
+		It("should admit a resource referenced by a VM", func() {
+			p := vmopv1util.BootstrapResourcePredicate(ctx, k8sClient)
+			Expect(p.Generic(event.GenericEvent{Object: newObj(namespaceName, "bootstrap-1")})).To(BeTrue())
+		})
+
+		It("should not admit a resource that is not referenced by a VM", func() {
+			p := vmopv1util.BootstrapResourcePredicate(ctx, k8sClient)
+			Expect(p.Generic(event.GenericEvent{Object: newObj(namespaceName, "bootstrap-2")})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: newObj("other", "bootstrap-1")})).To(BeFalse())
+		})
+
+		When("the VMs cannot be listed", func() {
+			BeforeEach(func() {
+				withFuncs.List = func(
+					ctx context.Context,
+					client ctrlclient.WithWatch,
+					list ctrlclient.ObjectList,
+					opts ...ctrlclient.ListOption) error {
+
+					return errors.New("fake")
+				}
+			})
+
+			It("should admit the resource", func() {
+				p := vmopv1util.BootstrapResourcePredicate(ctx, k8sClient)
+				Expect(p.Generic(event.GenericEvent{Object: newObj(namespaceName, "bootstrap-2")})).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("BootstrapResourceNames", func() {
+		It("should return the names of the bootstrap resources", func() {
+			vm := newVM(namespaceName, "vm-1")
+			vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+				Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{
+					Sysprep: &vmopv1sysprep.Sysprep{
+						GUIUnattended: &vmopv1sysprep.GUIUnattended{
+							Password: &vmopv1sysprep.PasswordSecretKeySelector{
+								Name: "password",
+							},
+						},
+						UserData: vmopv1sysprep.UserData{
+							ProductID: &vmopv1sysprep.ProductIDSecretKeySelector{
+								Name: "product-id",
+							},
+						},
+					},
+				},
+				VAppConfig: &vmopv1.VirtualMachineBootstrapVAppConfigSpec{
+					Properties: []vmopv1common.KeyValueOrSecretKeySelectorPair{
+						{
+							Key: "key",
+							Value: vmopv1common.ValueOrSecretKeySelector{
+								From: &vmopv1common.SecretKeySelector{
+									Name: "password",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(vmopv1util.BootstrapResourceNames(*vm)).To(Equal([]string{"password", "product-id"}))
+		})
+
+		It("should return nil for a VM without bootstrap", func() {
+			Expect(vmopv1util.BootstrapResourceNames(*newVM(namespaceName, "vm-1"))).To(BeNil())
+		})
+	})
+})
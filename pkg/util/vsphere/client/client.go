@@ -34,6 +34,16 @@ type Config struct {
 	CAFilePath string
 	Insecure   bool
 	Datacenter string
+
+	// UserAgent is sent with every SOAP and REST request so calls made by the
+	// client may be attributed in the vCenter logs. When empty, the govmomi
+	// default is used.
+	UserAgent string
+
+	// RestTimeout is the timeout for REST requests, ex. content library calls,
+	// that do not already have a deadline. SOAP requests, including waiting on
+	// tasks, are not affected. When zero, REST requests do not time out.
+	RestTimeout time.Duration
 }
 
 type Client struct {
@@ -45,6 +55,7 @@ type Client struct {
 
 	finder     *find.Finder
 	datacenter *object.Datacenter
+	inventory  *InventoryCache
 }
 
 // NewClient returns a new client.
@@ -73,6 +84,7 @@ func NewClient(ctx context.Context, config Config) (*Client, error) {
 		vimClient:      vimClient,
 		finder:         finder,
 		datacenter:     datacenter,
+		inventory:      NewInventoryCache(vimClient, finder, DefaultInventoryCacheTTL),
 		restClient:     restClient,
 		pbmClient:      pbmClient,
 		sessionManager: sm,
@@ -152,6 +164,7 @@ func newRestClient(
 
 	log.Info("Creating new REST Client", "VcPNID", config.Host, "VcPort", config.Port)
 	restClient := rest.NewClient(vimClient)
+	restClient.Transport = newTimeoutRoundTripper(restClient.Transport, config.RestTimeout)
 
 	userInfo := url.UserPassword(config.Username, config.Password)
 
@@ -186,6 +199,9 @@ func NewVimClient(
 	}
 
 	soapClient := soap.NewClient(soapURL, config.Insecure)
+	if config.UserAgent != "" {
+		soapClient.UserAgent = config.UserAgent
+	}
 	if config.CAFilePath != "" {
 		err = soapClient.SetRootCAs(config.CAFilePath)
 		if err != nil {
@@ -264,6 +280,12 @@ func (c *Client) Finder() *find.Finder {
 	return c.finder
 }
 
+// Inventory returns the cache of inventory lookups made with the client's
+// Finder. The cache is discarded along with the client.
+func (c *Client) Inventory() *InventoryCache {
+	return c.inventory
+}
+
 func (c *Client) Datacenter() *object.Datacenter {
 	return c.datacenter
 }
@@ -113,6 +113,33 @@ var _ = Describe("Client", Label(testlabels.VCSim), Ordered /* Avoided race for
 				Expect(err).ToNot(HaveOccurred())
 				Expect(c).ToNot(BeNil())
 			})
+
+			When("a user agent is specified", func() {
+				JustBeforeEach(func() {
+					config.UserAgent = "vm-operator/v1.2.3"
+				})
+				It("should use the user agent for SOAP and REST requests", func() {
+					c, err := client.NewClient(ctx, config)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(c.VimClient().UserAgent).To(Equal("vm-operator/v1.2.3"))
+					Expect(c.RestClient().UserAgent).To(Equal("vm-operator/v1.2.3"))
+				})
+			})
+
+			When("a REST timeout is specified", func() {
+				JustBeforeEach(func() {
+					config.RestTimeout = time.Minute
+				})
+				It("should connect", func() {
+					c, err := client.NewClient(ctx, config)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(c).ToNot(BeNil())
+
+					s, err := c.RestClient().Session(ctx)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(s).ToNot(BeNil())
+				})
+			})
 		})
 
 		When("username and password are invalid", func() {
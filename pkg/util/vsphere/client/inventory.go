@@ -0,0 +1,218 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+)
+
+// DefaultInventoryCacheTTL is how long a resolved inventory path is cached
+// before it is resolved again with the Finder.
+const DefaultInventoryCacheTTL = 10 * time.Minute
+
+// InventoryCache caches the ManagedObjectReferences resolved by a Finder so
+// repeated lookups of the same inventory path do not traverse the inventory
+// with each reconcile. Entries expire after the cache's TTL, and are removed
+// when a cached reference is found to be stale, ex. a cached network whose
+// backing info cannot be retrieved or a reference in a ManagedObjectNotFound
+// fault passed to InvalidateOnError.
+//
+// Only networks and datastores are looked up by their inventory paths. The
+// datacenter is resolved once when the client is created, and folders and
+// resource pools are referenced by their managed object IDs, so they do not
+// need to be cached. Only successful lookups are cached.
+type InventoryCache struct {
+	finder    *find.Finder
+	vimClient *vim25.Client
+	ttl       time.Duration
+
+	mu      sync.Mutex
+	entries map[inventoryCacheKey]inventoryCacheEntry
+}
+
+type inventoryCacheKey struct {
+	kind string
+	path string
+}
+
+type inventoryCacheEntry struct {
+	ref     vimtypes.ManagedObjectReference
+	path    string
+	expires time.Time
+}
+
+const (
+	inventoryKindNetwork   = "Network"
+	inventoryKindDatastore = "Datastore"
+)
+
+// NewInventoryCache returns a new InventoryCache that resolves cache misses
+// with the provided Finder. A non-positive ttl uses DefaultInventoryCacheTTL.
+func NewInventoryCache(
+	vimClient *vim25.Client,
+	finder *find.Finder,
+	ttl time.Duration) *InventoryCache {
+
+	if ttl <= 0 {
+		ttl = DefaultInventoryCacheTTL
+	}
+
+	return &InventoryCache{
+		finder:    finder,
+		vimClient: vimClient,
+		ttl:       ttl,
+		entries:   map[inventoryCacheKey]inventoryCacheEntry{},
+	}
+}
+
+// Network returns the network at the specified inventory path.
+func (c *InventoryCache) Network(
+	ctx context.Context,
+	path string) (object.NetworkReference, error) {
+
+	if e, ok := c.get(inventoryKindNetwork, path); ok {
+		if n, ok := object.NewReference(c.vimClient, e.ref).(object.NetworkReference); ok {
+			setInventoryPath(n, e.path)
+			return cachedNetwork{NetworkReference: n, cache: c, path: path}, nil
+		}
+	}
+
+	n, err := c.finder.Network(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.put(inventoryKindNetwork, path, n.Reference(), n.GetInventoryPath())
+
+	return cachedNetwork{NetworkReference: n, cache: c, path: path}, nil
+}
+
+// cachedNetwork is a network returned by the cache. The network is removed
+// from the cache if its backing info cannot be retrieved, ex. because it was
+// deleted, so the next lookup resolves the path again.
+type cachedNetwork struct {
+	object.NetworkReference
+	cache *InventoryCache
+	path  string
+}
+
+func (n cachedNetwork) EthernetCardBackingInfo(
+	ctx context.Context) (vimtypes.BaseVirtualDeviceBackingInfo, error) {
+
+	backing, err := n.NetworkReference.EthernetCardBackingInfo(ctx)
+	if err != nil {
+		n.cache.Invalidate(n.path)
+	}
+	return backing, err
+}
+
+// Datastore returns the datastore at the specified inventory path.
+func (c *InventoryCache) Datastore(
+	ctx context.Context,
+	path string) (*object.Datastore, error) {
+
+	if e, ok := c.get(inventoryKindDatastore, path); ok {
+		ds := object.NewDatastore(c.vimClient, e.ref)
+		ds.InventoryPath = e.path
+		return ds, nil
+	}
+
+	ds, err := c.finder.Datastore(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.put(inventoryKindDatastore, path, ds.Reference(), ds.InventoryPath)
+
+	return ds, nil
+}
+
+// Invalidate removes any cached references for the specified inventory path.
+func (c *InventoryCache) Invalidate(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k := range c.entries {
+		if k.path == path {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// InvalidateRef removes any cached entries that resolved to the specified
+// ManagedObjectReference.
+func (c *InventoryCache) InvalidateRef(ref vimtypes.ManagedObjectReference) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, e := range c.entries {
+		if e.ref == ref {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// InvalidateOnError removes any cached entries that resolved to the object
+// that was not found if the provided error is a ManagedObjectNotFound fault.
+func (c *InventoryCache) InvalidateOnError(err error) {
+	var notFound *vimtypes.ManagedObjectNotFound
+	if _, ok := fault.As(err, &notFound); ok {
+		c.InvalidateRef(notFound.Obj)
+	}
+}
+
+// Len returns the number of cached references.
+func (c *InventoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+func (c *InventoryCache) get(kind, path string) (inventoryCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	k := inventoryCacheKey{kind: kind, path: path}
+	e, ok := c.entries[k]
+	if !ok {
+		return inventoryCacheEntry{}, false
+	}
+	if time.Now().After(e.expires) {
+		delete(c.entries, k)
+		return inventoryCacheEntry{}, false
+	}
+
+	return e, true
+}
+
+func (c *InventoryCache) put(
+	kind, path string,
+	ref vimtypes.ManagedObjectReference,
+	inventoryPath string) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[inventoryCacheKey{kind: kind, path: path}] = inventoryCacheEntry{
+		ref:     ref,
+		path:    inventoryPath,
+		expires: time.Now().Add(c.ttl),
+	}
+}
+
+func setInventoryPath(n object.NetworkReference, p string) {
+	if s, ok := n.(interface{ SetInventoryPath(string) }); ok {
+		s.SetInventoryPath(p)
+	}
+}
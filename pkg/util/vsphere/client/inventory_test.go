@@ -0,0 +1,160 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
+)
+
+var _ = Describe("InventoryCache", Label(testlabels.VCSim), func() {
+
+	// rename renames the inventory object so that looking it up by its old
+	// path with a Finder fails.
+	rename := func(ctx context.Context, c *vim25.Client, obj object.Reference, name string) {
+		task, err := object.NewCommon(c, obj.Reference()).Rename(ctx, name)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(task.Wait(ctx)).To(Succeed())
+	}
+
+	newCache := func(ctx context.Context, c *vim25.Client, ttl time.Duration) *client.InventoryCache {
+		finder := find.NewFinder(c)
+		dc, err := finder.DefaultDatacenter(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		finder.SetDatacenter(dc)
+		return client.NewInventoryCache(c, finder, ttl)
+	}
+
+	It("caches network lookups until invalidated", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			cache := newCache(ctx, c, time.Hour)
+
+			n1, err := cache.Network(ctx, "DC0_DVPG0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cache.Len()).To(Equal(1))
+
+			rename(ctx, c, n1, "renamed-pg")
+
+			n2, err := cache.Network(ctx, "DC0_DVPG0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n2.Reference()).To(Equal(n1.Reference()))
+			Expect(n2.GetInventoryPath()).To(Equal(n1.GetInventoryPath()))
+			_, err = n2.EthernetCardBackingInfo(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			cache.Invalidate("DC0_DVPG0")
+			Expect(cache.Len()).To(BeZero())
+
+			_, err = cache.Network(ctx, "DC0_DVPG0")
+			Expect(err).To(HaveOccurred())
+			Expect(cache.Len()).To(BeZero())
+		})
+	})
+
+	It("caches datastore lookups", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			cache := newCache(ctx, c, time.Hour)
+
+			ds1, err := cache.Datastore(ctx, "LocalDS_0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cache.Len()).To(Equal(1))
+
+			ds2, err := cache.Datastore(ctx, "LocalDS_0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ds2.Reference()).To(Equal(ds1.Reference()))
+			Expect(ds2.InventoryPath).To(Equal(ds1.InventoryPath))
+			Expect(cache.Len()).To(Equal(1))
+
+			_, err = cache.Datastore(ctx, "does-not-exist")
+			Expect(err).To(HaveOccurred())
+			Expect(cache.Len()).To(Equal(1))
+		})
+	})
+
+	It("removes lookups that resolved to an invalidated reference", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			cache := newCache(ctx, c, time.Hour)
+
+			n, err := cache.Network(ctx, "DC0_DVPG0")
+			Expect(err).ToNot(HaveOccurred())
+
+			rename(ctx, c, n, "renamed-pg")
+			cache.InvalidateRef(n.Reference())
+
+			_, err = cache.Network(ctx, "DC0_DVPG0")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("expires cached lookups after the TTL", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			cache := newCache(ctx, c, time.Millisecond)
+
+			n, err := cache.Network(ctx, "DC0_DVPG0")
+			Expect(err).ToNot(HaveOccurred())
+
+			rename(ctx, c, n, "renamed-pg")
+			time.Sleep(10 * time.Millisecond)
+
+			_, err = cache.Network(ctx, "DC0_DVPG0")
+			Expect(err).To(HaveOccurred())
+			Expect(cache.Len()).To(BeZero())
+		})
+	})
+
+	It("removes a network whose backing info cannot be retrieved", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			cache := newCache(ctx, c, time.Hour)
+
+			n, err := cache.Network(ctx, "DC0_DVPG0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cache.Len()).To(Equal(1))
+
+			simulator.Map.Remove(simulator.SpoofContext(), n.Reference())
+
+			_, err = n.EthernetCardBackingInfo(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(cache.Len()).To(BeZero())
+		})
+	})
+
+	It("removes the lookups for an object that was not found", func() {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			cache := newCache(ctx, c, time.Hour)
+
+			ds, err := cache.Datastore(ctx, "LocalDS_0")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = cache.Network(ctx, "DC0_DVPG0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cache.Len()).To(Equal(2))
+
+			cache.InvalidateOnError(errors.New("some other error"))
+			Expect(cache.Len()).To(Equal(2))
+
+			cache.InvalidateOnError(fmt.Errorf("failed to create vm: %w",
+				task.Error{
+					LocalizedMethodFault: &vimtypes.LocalizedMethodFault{
+						Fault: &vimtypes.ManagedObjectNotFound{Obj: ds.Reference()},
+					},
+				}))
+			Expect(cache.Len()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,75 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// timeoutRoundTripper limits requests that do not already have a deadline to
+// the specified timeout. The timeout includes reading the response body.
+type timeoutRoundTripper struct {
+	roundTripper http.RoundTripper
+	timeout      time.Duration
+}
+
+func newTimeoutRoundTripper(
+	rt http.RoundTripper,
+	timeout time.Duration) http.RoundTripper {
+
+	if timeout <= 0 {
+		return rt
+	}
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &timeoutRoundTripper{roundTripper: rt, timeout: timeout}
+}
+
+type noRestTimeoutKey struct{}
+
+// WithoutRestTimeout returns a context whose REST requests are not limited to
+// the client's REST timeout, ex. to transfer a large file whose duration
+// depends on its size. The requests are still canceled with the context.
+func WithoutRestTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRestTimeoutKey{}, true)
+}
+
+func (t *timeoutRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if _, ok := req.Context().Deadline(); ok {
+		return t.roundTripper.RoundTrip(req)
+	}
+	if ok, _ := req.Context().Value(noRestTimeoutKey{}).(bool); ok {
+		return t.roundTripper.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	res, err := t.roundTripper.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The context must not be canceled until the caller is done reading the
+	// response body.
+	res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+
+	return res, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
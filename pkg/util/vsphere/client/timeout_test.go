@@ -0,0 +1,104 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("timeoutRoundTripper", func() {
+	var (
+		server  *httptest.Server
+		delay   time.Duration
+		release chan struct{}
+		client  *http.Client
+	)
+
+	BeforeEach(func() {
+		delay = 0
+		release = make(chan struct{})
+	})
+
+	JustBeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-time.After(delay):
+			case <-release:
+			case <-r.Context().Done():
+			}
+			_, _ = w.Write([]byte("ok"))
+		}))
+		client = &http.Client{
+			Transport: newTimeoutRoundTripper(http.DefaultTransport, 100*time.Millisecond),
+		}
+	})
+
+	AfterEach(func() {
+		close(release)
+		server.Close()
+	})
+
+	It("returns the original round tripper when the timeout is zero", func() {
+		Expect(newTimeoutRoundTripper(http.DefaultTransport, 0)).To(BeIdenticalTo(http.DefaultTransport))
+	})
+
+	It("completes requests that finish within the timeout", func() {
+		res, err := client.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		data, err := io.ReadAll(res.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("ok"))
+	})
+
+	When("the server hangs", func() {
+		BeforeEach(func() {
+			delay = time.Hour
+		})
+
+		It("fails requests without a deadline", func() {
+			_, err := client.Get(server.URL) //nolint:bodyclose
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+		})
+
+		It("does not change the deadline of requests that have one", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+			defer cancel()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			start := time.Now()
+			_, err = client.Do(req) //nolint:bodyclose
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+			Expect(time.Since(start)).To(BeNumerically(">=", 300*time.Millisecond))
+		})
+
+		It("does not time out requests whose context is without the timeout", func() {
+			go func() {
+				time.Sleep(300 * time.Millisecond)
+				release <- struct{}{}
+			}()
+
+			req, err := http.NewRequestWithContext(
+				WithoutRestTimeout(context.Background()), http.MethodGet, server.URL, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := client.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+			defer res.Body.Close()
+			data, err := io.ReadAll(res.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("ok"))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"context"
+	"strings"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+)
+
+const opIDPrefix = "vmoperator"
+
+// NewOperationID returns the ID for an operation on the object with the
+// specified namespace, name, and UID. The ID ends with the object's UID so
+// the requests made for the object may be correlated across reconciles and
+// restarts of the controller, and so the ID still identifies the object after
+// it is deleted and recreated with the same name.
+//
+// When the VCTaskTagging feature is enabled, the ID also includes the
+// namespace of the object so the tasks submitted by the operation can be
+// mapped back to the Kubernetes object by VI admins.
+func NewOperationID(
+	ctx context.Context,
+	namespace, name, uid, operation string) string {
+
+	parts := []string{opIDPrefix, name, operation}
+	if pkgcfg.FromContext(ctx).Features.VCTaskTagging {
+		parts[1] = namespace + "/" + name
+	}
+	if uid != "" {
+		parts = append(parts, uid)
+	}
+
+	return strings.Join(parts, "-")
+}
+
+// WithOperationID returns a context with the operation ID. The operation ID
+// is sent in the header of each vSphere request made with the context, and
+// vCenter records it with the tasks submitted by the requests.
+func WithOperationID(ctx context.Context, opID string) context.Context {
+	return context.WithValue(ctx, vimtypes.ID{}, opID)
+}
+
+// OperationIDFromContext returns the operation ID from the context, or an
+// empty string if there is not one.
+func OperationIDFromContext(ctx context.Context) string {
+	opID, _ := ctx.Value(vimtypes.ID{}).(string)
+	return opID
+}
@@ -0,0 +1,67 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package task_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
+)
+
+var _ = Describe("NewOperationID", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = pkgcfg.NewContextWithDefaultConfig()
+	})
+
+	It("returns an ID with the name, operation, and UID", func() {
+		opID := task.NewOperationID(ctx, "my-ns", "my-vm", "my-uid", "createOrUpdateVM")
+		Expect(opID).To(Equal("vmoperator-my-vm-createOrUpdateVM-my-uid"))
+	})
+
+	It("returns the same ID each time", func() {
+		Expect(task.NewOperationID(ctx, "my-ns", "my-vm", "my-uid", "deleteVM")).To(
+			Equal(task.NewOperationID(ctx, "my-ns", "my-vm", "my-uid", "deleteVM")))
+	})
+
+	When("the object does not have a UID", func() {
+		It("returns an ID with the name and operation", func() {
+			opID := task.NewOperationID(ctx, "my-ns", "my-vm", "", "createOrUpdateVM")
+			Expect(opID).To(Equal("vmoperator-my-vm-createOrUpdateVM"))
+		})
+	})
+
+	When("task tagging is enabled", func() {
+		BeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.Features.VCTaskTagging = true
+			})
+		})
+
+		It("returns an ID with the namespace, name, operation, and UID", func() {
+			opID := task.NewOperationID(ctx, "my-ns", "my-vm", "my-uid", "createOrUpdateVM")
+			Expect(opID).To(Equal("vmoperator-my-ns/my-vm-createOrUpdateVM-my-uid"))
+		})
+	})
+})
+
+var _ = Describe("WithOperationID", func() {
+	It("sets the vSphere operation ID", func() {
+		ctx := task.WithOperationID(context.Background(), "my-op-id")
+		Expect(ctx.Value(vimtypes.ID{})).To(Equal("my-op-id"))
+		Expect(task.OperationIDFromContext(ctx)).To(Equal("my-op-id"))
+	})
+
+	It("returns an empty ID when there is not one", func() {
+		Expect(task.OperationIDFromContext(context.Background())).To(BeEmpty())
+	})
+})
@@ -0,0 +1,94 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/progress"
+)
+
+// DefaultProgressInterval is the default minimum amount of time between
+// progress updates.
+const DefaultProgressInterval = 5 * time.Second
+
+// Progress is the progress of a vSphere task.
+type Progress struct {
+	// Phase describes what the task is doing, ex. "Cloning".
+	Phase string
+
+	// Percent is how much of the task is complete, from 0 to 100.
+	Percent int32
+}
+
+// String returns the progress as a human readable message.
+func (p Progress) String() string {
+	if p.Phase == "" {
+		return fmt.Sprintf("%d%% complete", p.Percent)
+	}
+	return fmt.Sprintf("%s: %d%% complete", p.Phase, p.Percent)
+}
+
+// ProgressFunc is invoked with the progress of a vSphere task.
+type ProgressFunc func(Progress)
+
+// NewProgressSinker returns a progress.Sinker that may be passed to a task's
+// Wait functions and that invokes fn with the task's progress. The function
+// fn is invoked for the first report and then no more than once per interval,
+// and only when the percent complete increases. The most recent report held
+// back by the interval is delivered when the task completes. Reports for
+// failed tasks are ignored.
+//
+// A new goroutine is started each time the sinker's Sink function is called,
+// and fn is invoked from that goroutine.
+func NewProgressSinker(
+	phase string,
+	interval time.Duration,
+	fn ProgressFunc) progress.Sinker {
+
+	return progress.SinkFunc(func() chan<- progress.Report {
+		ch := make(chan progress.Report)
+
+		go func() {
+			var (
+				last     *Progress
+				lastTime time.Time
+				pending  *Progress
+			)
+
+			for r := range ch {
+				if r.Error() != nil {
+					pending = nil
+					continue
+				}
+
+				p := Progress{
+					Phase:   phase,
+					Percent: min(max(int32(r.Percentage()), 0), 100),
+				}
+				if last != nil && p.Percent <= last.Percent {
+					// The percent complete of a task is not always set on
+					// the final report, so never report going backwards.
+					continue
+				}
+
+				if last != nil && time.Since(lastTime) < interval {
+					pending = &p
+					continue
+				}
+
+				fn(p)
+				last, lastTime, pending = &p, time.Now(), nil
+			}
+
+			if pending != nil {
+				fn(*pending)
+			}
+		}()
+
+		return ch
+	})
+}
@@ -0,0 +1,116 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package task_test
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/vim25/progress"
+
+	pkgtask "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
+)
+
+type fakeReport struct {
+	percent float32
+	err     error
+}
+
+func (r fakeReport) Percentage() float32 { return r.percent }
+func (r fakeReport) Detail() string      { return "" }
+func (r fakeReport) Error() error        { return r.err }
+
+var _ = Describe("ProgressSinker", func() {
+
+	var (
+		mu       sync.Mutex
+		reported []pkgtask.Progress
+		interval time.Duration
+		ch       chan<- progress.Report
+	)
+
+	BeforeEach(func() {
+		reported = nil
+		interval = time.Hour
+	})
+
+	JustBeforeEach(func() {
+		fn := func(p pkgtask.Progress) {
+			mu.Lock()
+			defer mu.Unlock()
+			reported = append(reported, p)
+		}
+		ch = pkgtask.NewProgressSinker("Cloning", interval, fn).Sink()
+	})
+
+	getReported := func() []pkgtask.Progress {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]pkgtask.Progress{}, reported...)
+	}
+
+	It("reports the first and most recent progress", func() {
+		ch <- fakeReport{percent: 10}
+		ch <- fakeReport{percent: 20}
+		ch <- fakeReport{percent: 30}
+		close(ch)
+
+		Eventually(getReported).Should(Equal([]pkgtask.Progress{
+			{Phase: "Cloning", Percent: 10},
+			{Phase: "Cloning", Percent: 30},
+		}))
+	})
+
+	It("does not report progress going backwards", func() {
+		ch <- fakeReport{percent: 50}
+		ch <- fakeReport{percent: 0}
+		close(ch)
+
+		Eventually(getReported).Should(HaveLen(1))
+		Consistently(getReported).Should(Equal([]pkgtask.Progress{
+			{Phase: "Cloning", Percent: 50},
+		}))
+	})
+
+	It("ignores reports with errors", func() {
+		ch <- fakeReport{percent: 10}
+		ch <- fakeReport{percent: 20}
+		ch <- fakeReport{percent: 20, err: errors.New("failed")}
+		close(ch)
+
+		Eventually(getReported).Should(HaveLen(1))
+		Consistently(getReported).Should(HaveLen(1))
+	})
+
+	When("the interval has elapsed", func() {
+		BeforeEach(func() {
+			interval = 0
+		})
+
+		It("reports each increase in progress", func() {
+			ch <- fakeReport{percent: 10}
+			ch <- fakeReport{percent: 10}
+			ch <- fakeReport{percent: 120}
+			close(ch)
+
+			Eventually(getReported).Should(Equal([]pkgtask.Progress{
+				{Phase: "Cloning", Percent: 10},
+				{Phase: "Cloning", Percent: 100},
+			}))
+		})
+	})
+
+	DescribeTable("String",
+		func(p pkgtask.Progress, expected string) {
+			Expect(p.String()).To(Equal(expected))
+		},
+		Entry("with phase", pkgtask.Progress{Phase: "Cloning", Percent: 42}, "Cloning: 42% complete"),
+		Entry("without phase", pkgtask.Progress{Percent: 42}, "42% complete"),
+	)
+})
@@ -0,0 +1,88 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+)
+
+const (
+	// OperationIDCustomField is the name of the custom field whose value is
+	// set to the operation ID of the tasks tagged by Tag.
+	OperationIDCustomField = "vmoperator.opID"
+
+	// descriptionKey is the key of the description of the tasks tagged by Tag.
+	descriptionKey = "com.vmware.vmoperator.task"
+)
+
+// Tag sets the description of the task, and the value of its
+// OperationIDCustomField custom field, to the operation ID in the context so
+// VI admins can map the task back to the Kubernetes object that submitted it.
+// Nothing is done unless the context has an operation ID and the VCTaskTagging
+// feature is enabled.
+//
+// Tagging is best effort, so any error is logged rather than returned. A task
+// may complete before it is tagged, in which case its description cannot be
+// changed.
+func Tag(ctx context.Context, t *object.Task) {
+	opID := OperationIDFromContext(ctx)
+	if opID == "" {
+		return
+	}
+
+	if !pkgcfg.FromContext(ctx).Features.VCTaskTagging {
+		return
+	}
+
+	logger := logr.FromContextOrDiscard(ctx).WithValues("task", t.Reference().Value)
+
+	if err := t.SetDescription(ctx, vimtypes.LocalizableMessage{
+		Key:     descriptionKey,
+		Message: "Submitted by VM Operator for " + opID,
+	}); err != nil {
+		logger.V(4).Info("Failed to set task description", "err", err)
+	}
+
+	if err := ensureOperationIDCustomField(ctx, t); err != nil {
+		logger.V(4).Info("Failed to add task custom field", "err", err)
+		return
+	}
+
+	if err := t.SetCustomValue(ctx, OperationIDCustomField, opID); err != nil {
+		logger.V(4).Info("Failed to set task custom field", "err", err)
+	}
+}
+
+// ensureOperationIDCustomField adds the definition of the
+// OperationIDCustomField custom field for tasks if it does not exist.
+func ensureOperationIDCustomField(ctx context.Context, t *object.Task) error {
+	m, err := object.GetCustomFieldsManager(t.Client())
+	if err != nil {
+		return err
+	}
+
+	if _, err := m.FindKey(ctx, OperationIDCustomField); err == nil {
+		return nil
+	} else if !errors.Is(err, object.ErrKeyNameNotFound) {
+		return err
+	}
+
+	if _, err := m.Add(ctx, OperationIDCustomField, "Task", nil, nil); err != nil {
+		// The field may have been added concurrently by another task.
+		if _, findErr := m.FindKey(ctx, OperationIDCustomField); findErr == nil {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
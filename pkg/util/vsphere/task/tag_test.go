@@ -0,0 +1,148 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package task_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
+)
+
+var _ = Describe("Tag", func() {
+	const (
+		opID       = "vmoperator-my-ns/my-vm-createOrUpdateVM-my-uid"
+		taskTypeID = "com.vmware.vmoperator.test"
+	)
+
+	var (
+		ctx         context.Context
+		taskTagging bool
+	)
+
+	BeforeEach(func() {
+		ctx = pkgcfg.NewContextWithDefaultConfig()
+		ctx = task.WithOperationID(ctx, opID)
+		taskTagging = true
+	})
+
+	JustBeforeEach(func() {
+		pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+			config.Features.VCTaskTagging = taskTagging
+		})
+	})
+
+	// registerTaskType registers the type of the tasks returned by newTask.
+	registerTaskType := func(ctx context.Context, c *vim25.Client) {
+		Expect(object.NewExtensionManager(c).Register(ctx, vimtypes.Extension{
+			Key:      taskTypeID,
+			TaskList: []vimtypes.ExtensionTaskTypeInfo{{TaskID: taskTypeID}},
+		})).To(Succeed())
+	}
+
+	// newTask returns a new task that stays queued, so it may be tagged.
+	newTask := func(ctx context.Context, c *vim25.Client) *object.Task {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		Expect(err).ToNot(HaveOccurred())
+
+		res, err := methods.CreateTask(ctx, c, &vimtypes.CreateTask{
+			This:       *c.ServiceContent.TaskManager,
+			Obj:        vm.Reference(),
+			TaskTypeId: taskTypeID,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		t := object.NewTask(c, res.Returnval.Task)
+
+		// The simulator adds the task asynchronously.
+		Eventually(func() error {
+			var moTask mo.Task
+			return t.Properties(ctx, t.Reference(), []string{"info"}, &moTask)
+		}).Should(Succeed())
+
+		return t
+	}
+
+	getTask := func(ctx context.Context, t *object.Task) mo.Task {
+		var moTask mo.Task
+		Expect(t.Properties(ctx, t.Reference(), []string{"info", "value"}, &moTask)).To(Succeed())
+		return moTask
+	}
+
+	It("sets the description and custom field of the task to the operation ID", func() {
+		simulator.Test(func(_ context.Context, c *vim25.Client) {
+			registerTaskType(ctx, c)
+			t := newTask(ctx, c)
+			task.Tag(ctx, t)
+
+			moTask := getTask(ctx, t)
+			Expect(moTask.Info.Description).ToNot(BeNil())
+			Expect(moTask.Info.Description.Message).To(ContainSubstring(opID))
+
+			// The simulator only sets the custom values of managed entities,
+			// so only the definition of the custom field can be verified.
+			m, err := object.GetCustomFieldsManager(c)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(m.Field(ctx)).To(ContainElement(SatisfyAll(
+				HaveField("Name", task.OperationIDCustomField),
+				HaveField("ManagedObjectType", "Task"),
+			)))
+
+			By("tagging another task with the existing custom field", func() {
+				t := newTask(ctx, c)
+				task.Tag(ctx, t)
+				Expect(getTask(ctx, t).Info.Description).ToNot(BeNil())
+
+				Expect(m.Field(ctx)).To(HaveExactElements(
+					HaveField("Name", task.OperationIDCustomField)))
+			})
+		})
+	})
+
+	When("task tagging is disabled", func() {
+		BeforeEach(func() {
+			taskTagging = false
+		})
+
+		It("does not tag the task", func() {
+			simulator.Test(func(_ context.Context, c *vim25.Client) {
+				registerTaskType(ctx, c)
+				t := newTask(ctx, c)
+				task.Tag(ctx, t)
+
+				moTask := getTask(ctx, t)
+				Expect(moTask.Info.Description).To(BeNil())
+				Expect(moTask.Value).To(BeEmpty())
+			})
+		})
+	})
+
+	When("the context does not have an operation ID", func() {
+		BeforeEach(func() {
+			ctx = pkgcfg.NewContextWithDefaultConfig()
+		})
+
+		It("does not tag the task", func() {
+			simulator.Test(func(_ context.Context, c *vim25.Client) {
+				registerTaskType(ctx, c)
+				t := newTask(ctx, c)
+				task.Tag(ctx, t)
+
+				Expect(getTask(ctx, t).Info.Description).To(BeNil())
+			})
+		})
+	})
+})
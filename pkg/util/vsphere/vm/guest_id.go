@@ -5,6 +5,7 @@
 package vm
 
 import (
+	"slices"
 	"strings"
 
 	vimtypes "github.com/vmware/govmomi/vim25/types"
@@ -28,3 +29,81 @@ func IsTaskInfoErrorInvalidGuestID(taskInfo *vimtypes.TaskInfo) bool {
 	}
 	return false
 }
+
+// vmx22 is the hardware version introduced in vSphere 9.0.
+const vmx22 = vimtypes.HardwareVersion(22)
+
+// guestIDMinHardwareVersions are the guest IDs that require a hardware version
+// newer than the oldest one supported by VM Operator. The guest IDs are those
+// introduced after vSphere 7.0, and the hardware version is the one introduced
+// in the same vSphere release as the guest ID.
+var guestIDMinHardwareVersions = map[vimtypes.VirtualMachineGuestOsIdentifier]vimtypes.HardwareVersion{
+	// vSphere 7.0 U1
+	vimtypes.VirtualMachineGuestOsIdentifierWindows2019srvNext_64Guest: vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierFreebsd13Guest:             vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierFreebsd13_64Guest:          vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierRhel9_64Guest:              vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierCentos9_64Guest:            vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierOracleLinux9_64Guest:       vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierSles16_64Guest:             vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierAsianux9_64Guest:           vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierOther5xLinuxGuest:          vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierOther5xLinux64Guest:        vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierDarwin20_64Guest:           vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierDarwin21_64Guest:           vimtypes.VMX18,
+	vimtypes.VirtualMachineGuestOsIdentifierAmazonlinux3_64Guest:       vimtypes.VMX18,
+
+	// vSphere 8.0
+	vimtypes.VirtualMachineGuestOsIdentifierWindows11_64Guest:          vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierWindows12_64Guest:          vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierWindows2022srvNext_64Guest: vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierFreebsd14Guest:             vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierFreebsd14_64Guest:          vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierDebian12Guest:              vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierDebian12_64Guest:           vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierOther6xLinuxGuest:          vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierOther6xLinux64Guest:        vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierDarwin22_64Guest:           vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierDarwin23_64Guest:           vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierVmkernel8Guest:             vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierRockylinux_64Guest:         vimtypes.VMX20,
+	vimtypes.VirtualMachineGuestOsIdentifierAlmalinux_64Guest:          vimtypes.VMX20,
+
+	// vSphere 8.0 U2
+	vimtypes.VirtualMachineGuestOsIdentifierCrxSys1Guest: vimtypes.VMX21,
+
+	// vSphere 9.0
+	vimtypes.VirtualMachineGuestOsIdentifierFreebsd15Guest:        vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierFreebsd15_64Guest:     vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierRhel10_64Guest:        vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierOracleLinux10_64Guest: vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierDebian13Guest:         vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierDebian13_64Guest:      vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierMiraclelinux_64Guest:  vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierPardus_64Guest:        vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierOther7xLinuxGuest:     vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierOther7xLinux64Guest:   vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierFusionos_64Guest:      vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierProlinux_64Guest:      vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierKylinlinux_64Guest:    vmx22,
+	vimtypes.VirtualMachineGuestOsIdentifierVmkernel9Guest:        vmx22,
+}
+
+// IsKnownGuestID returns true if the provided guest ID is one of the guest OS
+// identifiers known to the vSphere API that VM Operator is built against.
+// Please note a guest ID that is not known may still be supported by vSphere,
+// for example when it was introduced by a newer vSphere release, so the guest
+// ID is ultimately validated by vSphere when the VM is deployed or
+// reconfigured.
+func IsKnownGuestID(guestID string) bool {
+	return slices.Contains(
+		vimtypes.VirtualMachineGuestOsIdentifier("").Values(),
+		vimtypes.VirtualMachineGuestOsIdentifier(guestID))
+}
+
+// GetMinHardwareVersionForGuestID returns the minimum hardware version
+// required by the provided guest ID. Zero is returned if the guest ID does not
+// require a specific hardware version or is not supported.
+func GetMinHardwareVersionForGuestID(guestID string) vimtypes.HardwareVersion {
+	return guestIDMinHardwareVersions[vimtypes.VirtualMachineGuestOsIdentifier(guestID)]
+}
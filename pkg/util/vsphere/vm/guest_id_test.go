@@ -67,4 +67,24 @@ func guestIDTests() {
 			true,
 		),
 	)
+
+	DescribeTable("IsKnownGuestID",
+		func(guestID string, expected bool) {
+			Expect(vmutil.IsKnownGuestID(guestID)).To(Equal(expected))
+		},
+		Entry("empty", "", false),
+		Entry("unknown", "not-a-guest-id", false),
+		Entry("wrong case", "VMWAREPHOTON64GUEST", false),
+		Entry("known", string(vimtypes.VirtualMachineGuestOsIdentifierVmwarePhoton64Guest), true),
+	)
+
+	DescribeTable("GetMinHardwareVersionForGuestID",
+		func(guestID string, expected vimtypes.HardwareVersion) {
+			Expect(vmutil.GetMinHardwareVersionForGuestID(guestID)).To(Equal(expected))
+		},
+		Entry("unknown", "not-a-guest-id", vimtypes.HardwareVersion(0)),
+		Entry("no minimum", string(vimtypes.VirtualMachineGuestOsIdentifierOtherGuest64), vimtypes.HardwareVersion(0)),
+		Entry("vSphere 7.0U1", string(vimtypes.VirtualMachineGuestOsIdentifierRhel9_64Guest), vimtypes.VMX18),
+		Entry("vSphere 8.0", string(vimtypes.VirtualMachineGuestOsIdentifierWindows12_64Guest), vimtypes.VMX20),
+	)
 }
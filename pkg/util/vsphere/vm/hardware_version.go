@@ -13,6 +13,8 @@ import (
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	pkgtask "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
 )
 
 const (
@@ -110,6 +112,8 @@ func ReconcileMinHardwareVersion(
 		return 0, fmt.Errorf("failed to invoke upgrade vm: %w", err)
 	}
 
+	pkgtask.Tag(ctx, t)
+
 	// Wait for the upgrade to complete.
 	if err := t.WaitEx(ctx); err != nil {
 		return 0, fmt.Errorf("failed to upgrade vm: %w", err)
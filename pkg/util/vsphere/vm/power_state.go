@@ -21,6 +21,7 @@ import (
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 
 	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
+	pkgtask "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/task"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/vm/internal"
 )
 
@@ -330,6 +331,8 @@ func doAndWaitOnHardPowerOp(
 		return 0, fmt.Errorf(
 			"failed to invoke hard power op for %s %w", desiredPowerState, err)
 	}
+	pkgtask.Tag(ctx, t)
+
 	if ti, err := t.WaitForResult(ctx); err != nil {
 		if err, ok := err.(task.Error); ok {
 			// Ignore error if desired power state already set.
@@ -577,6 +580,8 @@ func doAndWaitOnHardRestart(
 		return 0, fmt.Errorf(
 			"failed to hard restart vm %w", err)
 	}
+	pkgtask.Tag(ctx, t)
+
 	if ti, err := t.WaitForResult(ctx); err != nil {
 		if ti != nil {
 			log.Error(err, "Failed to hard restart VM", "taskInfo", ti)
@@ -700,6 +705,8 @@ func setLastRestartTimeInExtraConfigAndWait(
 			err)
 	}
 
+	pkgtask.Tag(ctx, t)
+
 	if err := t.Wait(ctx); err != nil {
 		return fmt.Errorf(
 			"failed to record extra config key=%s value=%v to vm %w",
@@ -5,6 +5,7 @@
 package builder
 
 import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	clientgorecord "k8s.io/client-go/tools/record"
@@ -29,6 +30,7 @@ import (
 	vmopv1a2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
 func NewFakeClient(objs ...client.Object) client.Client {
@@ -45,6 +47,22 @@ func NewFakeClientWithInterceptors(
 		WithInterceptorFuncs(funcs).
 		WithObjects(objs...).
 		WithStatusSubresource(KnownObjectTypes()...).
+		WithIndex(
+			&vmopv1.VirtualMachine{},
+			vmopv1util.MACAddressField,
+			vmopv1util.VirtualMachineMACAddressIndexFunc).
+		WithIndex(
+			&vmopv1.VirtualMachine{},
+			vmopv1util.BootstrapResourceField,
+			vmopv1util.VirtualMachineBootstrapResourceIndexFunc).
+		WithIndex(
+			&vmopv1.VirtualMachine{},
+			vmopv1util.VirtualMachineImageField,
+			vmopv1util.VirtualMachineImageIndexFunc).
+		WithIndex(
+			&vmopv1a1.ContentSource{},
+			vmopv1util.ContentSourceProviderField,
+			vmopv1util.ContentSourceProviderIndexFunc).
 		Build()
 }
 
@@ -58,11 +76,15 @@ func KnownObjectTypes() []client.Object {
 		&vmopv1.VirtualMachineService{},
 		&vmopv1.VirtualMachineClass{},
 		&vmopv1.VirtualMachinePublishRequest{},
+		&vmopv1.VirtualMachineExportRequest{},
 		&vmopv1.ClusterVirtualMachineImage{},
 		&vmopv1.VirtualMachineImage{},
 		&vmopv1.VirtualMachineImageCache{},
+		&vmopv1.VirtualMachineImageCatalog{},
+		&vmopv1.VirtualMachineInventoryReport{},
 		&vmopv1.VirtualMachineWebConsoleRequest{},
 		&vmopv1a1.WebConsoleRequest{},
+		&vmopv1a1.ContentSource{},
 		&cnsv1alpha1.CnsNodeVmAttachment{},
 		&spqv1.StoragePolicyQuota{},
 		&spqv1.StoragePolicyUsage{},
@@ -75,6 +97,7 @@ func KnownObjectTypes() []client.Object {
 		&byokv1.EncryptionClass{},
 		&capv1.Capabilities{},
 		&appv1a1.SupervisorProperties{},
+		&apiextensionsv1.CustomResourceDefinition{},
 	}
 }
 
@@ -87,6 +110,7 @@ func NewFakeRecorder() (record.Recorder, chan string) {
 func NewScheme() *runtime.Scheme {
 	scheme := runtime.NewScheme()
 	_ = clientgoscheme.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
 	_ = vmopv1a1.AddToScheme(scheme)
 	_ = vmopv1a2.AddToScheme(scheme)
 	_ = vmopv1.AddToScheme(scheme)
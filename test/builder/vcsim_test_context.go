@@ -13,6 +13,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"math/big"
 	"net"
@@ -47,6 +48,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
 
 	// Blank import to make govmomi client aware of these bindings.
 	_ "github.com/vmware/govmomi/vapi/cluster/simulator"
@@ -66,8 +68,12 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
 	pkgmgr "github.com/vmware-tanzu/vm-operator/pkg/manager"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
+	vcconfig "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/credentials"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
@@ -198,6 +204,10 @@ type TestContextForVCSim struct {
 
 	folder *object.Folder
 
+	// providerConfig is the vSphere provider configuration that points at
+	// this vcsim instance.
+	providerConfig *vcconfig.VSphereVMProviderConfig
+
 	azCCRs map[string][]*object.ClusterComputeResource
 }
 
@@ -288,6 +298,63 @@ func NewIntegrationTestContextForVCSim(
 	return &itVcSimCtx
 }
 
+// NewIntegrationTestContextForVCSimWithProvider returns a new integration
+// test context that starts envtest plus vcsim and wires a real vSphere
+// provider into the manager. The provider connects directly to the vcsim
+// instance, allowing end-to-end tests of the controllers without any external
+// infrastructure.
+func NewIntegrationTestContextForVCSimWithProvider(
+	ctx context.Context,
+	config VCSimTestConfig,
+	addToManagerFn pkgmgr.AddToManagerFunc,
+	initEnvFn InitVCSimEnvFn) *IntegrationTestContextForVCSim {
+
+	var itVcSimCtx *IntegrationTestContextForVCSim
+
+	initProvidersFn := func(
+		ctx *pkgctx.ControllerManagerContext,
+		mgr ctrlmgr.Manager) error {
+
+		vmProviderName := fmt.Sprintf("%s/%s/vmProvider", ctx.Namespace, ctx.Name)
+		ctx.VMProvider = vsphere.NewVSphereVMProviderFromClientWithConfigFn(
+			ctx,
+			mgr.GetClient(),
+			record.New(mgr.GetEventRecorderFor(vmProviderName)),
+			func(
+				_ context.Context,
+				_ ctrlclient.Client) (*vcconfig.VSphereVMProviderConfig, error) {
+
+				// The provider config is not available until vcsim is
+				// initialized by the suite's init env funcs.
+				return itVcSimCtx.ProviderConfig()
+			})
+		return nil
+	}
+
+	itVcSimCtx = NewIntegrationTestContextForVCSim(
+		ctx,
+		config,
+		addToManagerFn,
+		initProvidersFn,
+		initEnvFn)
+
+	return itVcSimCtx
+}
+
+// ProviderConfig returns a copy of the vSphere provider configuration that
+// points at this vcsim instance.
+func (c *TestContextForVCSim) ProviderConfig() (*vcconfig.VSphereVMProviderConfig, error) {
+	if c.providerConfig == nil {
+		return nil, errors.New("vcsim is not initialized")
+	}
+	config := *c.providerConfig
+	if c.providerConfig.VcCreds != nil {
+		creds := *c.providerConfig.VcCreds
+		config.VcCreds = &creds
+	}
+	return &config, nil
+}
+
 func (s *TestSuite) NewTestContextForVCSim(
 	config VCSimTestConfig,
 	initObjects ...ctrlclient.Object) *TestContextForVCSim {
@@ -516,7 +583,6 @@ func (c *TestContextForVCSim) setupEnv(config VCSimTestConfig) {
 			cc.NetworkProviderType = ""
 		}
 
-		cc.ContentAPIWait = 1 * time.Second
 		cc.JSONExtraConfig = config.WithJSONExtraConfig
 
 		cc.Features.InstanceStorage = config.WithInstanceStorage
@@ -986,6 +1052,15 @@ func (c *TestContextForVCSim) setupK8sConfig(config VCSimTestConfig) {
 
 	Expect(c.Client.Create(c, cm)).To(Succeed())
 
+	providerConfig, err := vcconfig.ConfigMapToProviderConfig(
+		cm,
+		&credentials.VSphereVMProviderCredentials{
+			Username: simulator.DefaultLogin.Username(),
+			Password: password,
+		})
+	Expect(err).ToNot(HaveOccurred())
+	c.providerConfig = providerConfig
+
 	networkCM := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "vmoperator-network-config",
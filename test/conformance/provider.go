@@ -0,0 +1,504 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformance provides a suite of tests that validates an
+// implementation of providers.VirtualMachineProviderInterface adheres to the
+// contract the controllers rely upon: creating, updating, and deleting VMs
+// and resource policies is idempotent, errors are classified with a
+// providers.ErrorReason, the VM's status is populated, VMs may be published
+// to a content library, and images are synced from content library items.
+//
+// The suite is registered with DescribeProvider and runs against the provider
+// returned by a Harness. The fake provider and the vSphere provider, the
+// latter against vcsim, run the suite as part of their tests. The suite may
+// be run against a real vCenter by implementing a Harness that returns a
+// vSphere provider connected to that vCenter.
+package conformance
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	pkgcond "github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+)
+
+// missingImageName is the name of an image that does not exist.
+const missingImageName = "conformance-missing-image"
+
+// Harness provides the provider under test and the resources required to
+// create VMs with it.
+type Harness interface {
+	// Context returns the context used to invoke the provider.
+	Context() context.Context
+
+	// Provider returns the provider under test.
+	Provider() providers.VirtualMachineProviderInterface
+
+	// NewVirtualMachine returns a VM, with a unique name, that the provider
+	// is able to create. The VM's spec.image must refer to an image by kind
+	// and name, and the image, class, and any other resources referenced by
+	// the VM must already exist.
+	NewVirtualMachine() *vmopv1.VirtualMachine
+
+	// NewVirtualMachineWithErrorReason returns a provider and a VM, with a
+	// unique name, that the provider fails to create with an error that has
+	// the provided reason. The returned provider may differ from Provider,
+	// ex. to simulate vCenter being unavailable, but must manage VMs in the
+	// same vSphere environment. A nil VM is returned if the harness cannot
+	// induce the reason, and the test is skipped.
+	NewVirtualMachineWithErrorReason(
+		reason providers.ErrorReason) (providers.VirtualMachineProviderInterface, *vmopv1.VirtualMachine)
+
+	// ContentLibrary returns a writable content library to which the provider
+	// is able to publish VMs.
+	ContentLibrary() *imgregv1a1.ContentLibrary
+
+	// NewContentLibraryItem returns a content library item of the provided
+	// type from which the provider is able to sync an image.
+	NewContentLibraryItem(itemType imgregv1a1.ContentLibraryItemType) *imgregv1a1.ContentLibraryItem
+
+	// AvailabilityZoneNames returns the names of the zones in which the
+	// provider creates resource policies for the namespace of the VMs.
+	AvailabilityZoneNames() []string
+}
+
+// DescribeProvider registers the conformance tests in a Ginkgo container with
+// the provided text and decorators, ex. labels. The newHarness function is
+// called from a JustBeforeEach node, so the harness may depend on state set
+// up by the caller's BeforeEach and JustBeforeEach nodes.
+func DescribeProvider(text string, newHarness func() Harness, decorators ...any) bool {
+	return Describe(text, append(decorators, func() {
+		providerTests(newHarness)
+	})...)
+}
+
+func providerTests(newHarness func() Harness) {
+	var (
+		h        Harness
+		ctx      context.Context
+		provider providers.VirtualMachineProviderInterface
+		vm       *vmopv1.VirtualMachine
+	)
+
+	JustBeforeEach(func() {
+		h = newHarness()
+		ctx = h.Context()
+		provider = h.Provider()
+		vm = h.NewVirtualMachine()
+	})
+
+	AfterEach(func() {
+		h = nil
+		ctx = nil
+		provider = nil
+		vm = nil
+	})
+
+	enableObserverMode := func() {
+		ctx = pkgcfg.UpdateContext(ctx, func(config *pkgcfg.Config) {
+			config.ObserverMode = true
+		})
+	}
+
+	// managedVMs returns the managed VMs the provider reports for the VM.
+	managedVMs := func() []providers.ManagedVirtualMachine {
+		all, err := provider.ListManagedVirtualMachines(ctx)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+		var vms []providers.ManagedVirtualMachine
+		for _, mvm := range all {
+			if mvm.NamespacedName == vm.NamespacedName() {
+				vms = append(vms, mvm)
+			}
+		}
+		return vms
+	}
+
+	createVM := func() {
+		ExpectWithOffset(1, provider.CreateOrUpdateVirtualMachine(ctx, vm)).To(Succeed())
+		ExpectWithOffset(1, vm.Status.UniqueID).ToNot(BeEmpty())
+	}
+
+	Describe("CreateOrUpdateVirtualMachine", func() {
+
+		When("the VM does not exist", func() {
+			It("creates the VM and populates its status", func() {
+				Expect(provider.CreateOrUpdateVirtualMachine(ctx, vm)).To(Succeed())
+
+				Expect(vm.Status.UniqueID).ToNot(BeEmpty())
+				Expect(vm.Status.BiosUUID).ToNot(BeEmpty())
+				Expect(vm.Status.InstanceUUID).ToNot(BeEmpty())
+				Expect(vm.Status.PowerState).To(Equal(vm.Spec.PowerState))
+				Expect(pkgcond.IsTrue(vm, vmopv1.VirtualMachineConditionCreated)).To(BeTrue())
+
+				Expect(managedVMs()).To(ConsistOf(providers.ManagedVirtualMachine{
+					ID:             vm.Status.UniqueID,
+					Name:           vm.Name,
+					InstanceUUID:   vm.Status.InstanceUUID,
+					NamespacedName: vm.NamespacedName(),
+				}))
+			})
+		})
+
+		When("the VM exists", func() {
+			var uniqueID string
+
+			JustBeforeEach(func() {
+				createVM()
+				uniqueID = vm.Status.UniqueID
+			})
+
+			It("does not create the VM again", func() {
+				Expect(provider.CreateOrUpdateVirtualMachine(ctx, vm)).To(Succeed())
+				Expect(vm.Status.UniqueID).To(Equal(uniqueID))
+				Expect(provider.CreateOrUpdateVirtualMachine(ctx, vm)).To(Succeed())
+				Expect(vm.Status.UniqueID).To(Equal(uniqueID))
+
+				mvms := managedVMs()
+				Expect(mvms).To(HaveLen(1))
+				Expect(mvms[0].ID).To(Equal(uniqueID))
+			})
+
+			It("updates the power state", func() {
+				vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+				Expect(provider.CreateOrUpdateVirtualMachine(ctx, vm)).To(Succeed())
+				Expect(vm.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOff))
+
+				vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+				Expect(provider.CreateOrUpdateVirtualMachine(ctx, vm)).To(Succeed())
+				Expect(vm.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+
+				Expect(vm.Status.UniqueID).To(Equal(uniqueID))
+			})
+		})
+
+		When("the VM's image does not exist", func() {
+			JustBeforeEach(func() {
+				vm.Spec.Image.Name = missingImageName
+				vm.Spec.ImageName = missingImageName
+			})
+
+			It("returns an ImageNotFound error and does not create the VM", func() {
+				err := provider.CreateOrUpdateVirtualMachine(ctx, vm)
+				Expect(err).To(HaveOccurred())
+
+				reason, ok := providers.ReasonFromError(err)
+				Expect(ok).To(BeTrue(), "error is not a providers.Error: %v", err)
+				Expect(reason).To(Equal(providers.ErrorReasonImageNotFound))
+
+				Expect(vm.Status.UniqueID).To(BeEmpty())
+				Expect(managedVMs()).To(BeEmpty())
+			})
+		})
+
+		DescribeTable("the VM cannot be created",
+			func(reason providers.ErrorReason) {
+				errProvider, errVM := h.NewVirtualMachineWithErrorReason(reason)
+				if errVM == nil {
+					Skip(fmt.Sprintf("the harness cannot induce a %s error", reason))
+				}
+
+				err := errProvider.CreateOrUpdateVirtualMachine(ctx, errVM)
+				Expect(err).To(HaveOccurred())
+
+				actual, ok := providers.ReasonFromError(err)
+				Expect(ok).To(BeTrue(), "error is not a providers.Error: %v", err)
+				Expect(actual).To(Equal(reason))
+
+				Expect(errVM.Status.UniqueID).To(BeEmpty())
+
+				all, err := provider.ListManagedVirtualMachines(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				for _, mvm := range all {
+					Expect(mvm.NamespacedName).ToNot(Equal(errVM.NamespacedName()))
+				}
+			},
+			Entry("because of quota", providers.ErrorReasonQuotaExceeded),
+			Entry("because of the network", providers.ErrorReasonNetworkNotReady),
+			Entry("because of placement", providers.ErrorReasonPlacementFailed),
+			Entry("because of a capability", providers.ErrorReasonCapabilityMismatch),
+			Entry("because vCenter is unavailable", providers.ErrorReasonVCUnavailable),
+		)
+
+		When("observer mode is enabled", func() {
+			JustBeforeEach(func() {
+				enableObserverMode()
+			})
+
+			It("does not create the VM", func() {
+				Expect(provider.CreateOrUpdateVirtualMachine(ctx, vm)).To(Succeed())
+
+				Expect(vm.Status.UniqueID).To(BeEmpty())
+				Expect(pkgcond.IsFalse(vm, vmopv1.VirtualMachineConditionCreated)).To(BeTrue())
+				Expect(managedVMs()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("DeleteVirtualMachine", func() {
+
+		When("the VM exists", func() {
+			JustBeforeEach(func() {
+				createVM()
+			})
+
+			It("deletes the VM", func() {
+				Expect(provider.DeleteVirtualMachine(ctx, vm)).To(Succeed())
+				Expect(managedVMs()).To(BeEmpty())
+			})
+
+			It("does not return an error when the VM is deleted again", func() {
+				Expect(provider.DeleteVirtualMachine(ctx, vm)).To(Succeed())
+				Expect(provider.DeleteVirtualMachine(ctx, vm)).To(Succeed())
+				Expect(managedVMs()).To(BeEmpty())
+			})
+		})
+
+		When("the VM was never created", func() {
+			It("does not return an error", func() {
+				Expect(provider.DeleteVirtualMachine(ctx, vm)).To(Succeed())
+				Expect(managedVMs()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("PublishVirtualMachine", func() {
+		var (
+			cl    *imgregv1a1.ContentLibrary
+			vmPub *vmopv1.VirtualMachinePublishRequest
+		)
+
+		JustBeforeEach(func() {
+			cl = h.ContentLibrary()
+
+			vmPub = &vmopv1.VirtualMachinePublishRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      vm.Name,
+					Namespace: vm.Namespace,
+					UID:       uuid.NewUUID(),
+				},
+			}
+			vmPub.Status.SourceRef = &vmopv1.VirtualMachinePublishRequestSource{
+				Name: vm.Name,
+			}
+			vmPub.Status.TargetRef = &vmopv1.VirtualMachinePublishRequestTarget{
+				Item: vmopv1.VirtualMachinePublishRequestTargetItem{
+					Name: vm.Name + "-image",
+				},
+				Location: vmopv1.VirtualMachinePublishRequestTargetLocation{
+					Name: cl.Name,
+				},
+			}
+		})
+
+		When("the VM exists", func() {
+			JustBeforeEach(func() {
+				createVM()
+			})
+
+			It("publishes the VM to a new item in the content library", func() {
+				itemID, err := provider.PublishVirtualMachine(ctx, vm, vmPub, cl, string(vmPub.UID))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(itemID).ToNot(BeEmpty())
+
+				item, err := provider.GetItemFromLibraryByName(
+					ctx, string(cl.Spec.UUID), vmPub.Status.TargetRef.Item.Name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(item).ToNot(BeNil())
+				Expect(item.ID).To(Equal(itemID))
+			})
+
+			When("observer mode is enabled", func() {
+				JustBeforeEach(func() {
+					enableObserverMode()
+				})
+
+				It("returns ErrObserverMode and does not publish the VM", func() {
+					_, err := provider.PublishVirtualMachine(ctx, vm, vmPub, cl, string(vmPub.UID))
+					Expect(err).To(MatchError(providers.ErrObserverMode))
+
+					item, err := provider.GetItemFromLibraryByName(
+						ctx, string(cl.Spec.UUID), vmPub.Status.TargetRef.Item.Name)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(item).To(BeNil())
+				})
+			})
+		})
+	})
+
+	Describe("GetVirtualMachineGuestHeartbeat", func() {
+		When("the VM exists", func() {
+			JustBeforeEach(func() {
+				createVM()
+			})
+
+			It("returns the VM's heartbeat", func() {
+				heartbeat, err := provider.GetVirtualMachineGuestHeartbeat(ctx, vm)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(heartbeat).To(BeElementOf(
+					vmopv1.GuestHeartbeatStatus(""),
+					vmopv1.GrayHeartbeatStatus,
+					vmopv1.GreenHeartbeatStatus,
+					vmopv1.YellowHeartbeatStatus,
+					vmopv1.RedHeartbeatStatus))
+			})
+		})
+
+		When("the VM was never created", func() {
+			It("returns an error", func() {
+				_, err := provider.GetVirtualMachineGuestHeartbeat(ctx, vm)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("GetVirtualMachineWebMKSTicket", func() {
+		When("the VM exists", func() {
+			JustBeforeEach(func() {
+				createVM()
+			})
+
+			It("does not return an empty ticket without an error", func() {
+				ticket, err := provider.GetVirtualMachineWebMKSTicket(ctx, vm, "")
+				if err == nil {
+					Expect(ticket).ToNot(BeEmpty())
+				}
+			})
+		})
+
+		When("the VM was never created", func() {
+			It("returns an error", func() {
+				_, err := provider.GetVirtualMachineWebMKSTicket(ctx, vm, "")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("GetVirtualMachineHardwareVersion", func() {
+		When("the VM exists", func() {
+			JustBeforeEach(func() {
+				createVM()
+			})
+
+			It("returns the VM's hardware version", func() {
+				version, err := provider.GetVirtualMachineHardwareVersion(ctx, vm)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(version.IsValid()).To(BeTrue(), "invalid hardware version %d", version)
+			})
+		})
+
+		When("the VM was never created", func() {
+			It("returns an error", func() {
+				_, err := provider.GetVirtualMachineHardwareVersion(ctx, vm)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("VirtualMachineSetResourcePolicy", func() {
+		var rp *vmopv1.VirtualMachineSetResourcePolicy
+
+		JustBeforeEach(func() {
+			name := vm.Name + "-policy"
+			rp = &vmopv1.VirtualMachineSetResourcePolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: vm.Namespace,
+				},
+				Spec: vmopv1.VirtualMachineSetResourcePolicySpec{
+					ResourcePool: vmopv1.ResourcePoolSpec{
+						Name: name,
+					},
+					Folder: name,
+				},
+			}
+		})
+
+		// isReady returns whether the resource policy is ready in every
+		// zone.
+		isReady := func() bool {
+			zoneNames := h.AvailabilityZoneNames()
+			ExpectWithOffset(1, zoneNames).ToNot(BeEmpty())
+
+			for _, zoneName := range zoneNames {
+				ready, err := provider.IsVirtualMachineSetResourcePolicyReady(ctx, zoneName, rp)
+				ExpectWithOffset(1, err).ToNot(HaveOccurred())
+				if !ready {
+					return false
+				}
+			}
+			return true
+		}
+
+		It("creates and deletes the resource policy", func() {
+			Expect(isReady()).To(BeFalse())
+
+			Expect(provider.CreateOrUpdateVirtualMachineSetResourcePolicy(ctx, rp)).To(Succeed())
+			Expect(provider.CreateOrUpdateVirtualMachineSetResourcePolicy(ctx, rp)).To(Succeed())
+			Expect(isReady()).To(BeTrue())
+
+			Expect(provider.DeleteVirtualMachineSetResourcePolicy(ctx, rp)).To(Succeed())
+			Expect(provider.DeleteVirtualMachineSetResourcePolicy(ctx, rp)).To(Succeed())
+			Expect(isReady()).To(BeFalse())
+		})
+
+		When("observer mode is enabled", func() {
+			JustBeforeEach(func() {
+				enableObserverMode()
+			})
+
+			It("does not create the resource policy", func() {
+				Expect(provider.CreateOrUpdateVirtualMachineSetResourcePolicy(ctx, rp)).To(Succeed())
+				Expect(isReady()).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("SyncVirtualMachineImage", func() {
+		var vmi *vmopv1.VirtualMachineImage
+
+		JustBeforeEach(func() {
+			vmi = &vmopv1.VirtualMachineImage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      vm.Name + "-image",
+					Namespace: vm.Namespace,
+				},
+			}
+		})
+
+		When("the item is an OVF", func() {
+			It("populates the image's status", func() {
+				cli := h.NewContentLibraryItem(imgregv1a1.ContentLibraryItemTypeOvf)
+				Expect(provider.SyncVirtualMachineImage(ctx, cli, vmi)).To(Succeed())
+				Expect(vmi.Status.Disks).ToNot(BeEmpty())
+				Expect(vmi.Status.HardwareVersion).ToNot(BeNil())
+			})
+		})
+
+		When("the item is an ISO", func() {
+			It("does not change the image's status", func() {
+				cli := h.NewContentLibraryItem(imgregv1a1.ContentLibraryItemTypeIso)
+				Expect(provider.SyncVirtualMachineImage(ctx, cli, vmi)).To(Succeed())
+				Expect(vmi.Status).To(Equal(vmopv1.VirtualMachineImageStatus{}))
+			})
+		})
+
+		When("the object is not a content library item", func() {
+			It("returns an error", func() {
+				Expect(provider.SyncVirtualMachineImage(ctx, &imgregv1a1.ContentLibrary{}, vmi)).ToNot(Succeed())
+			})
+		})
+	})
+}
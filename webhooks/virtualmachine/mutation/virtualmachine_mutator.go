@@ -17,6 +17,7 @@ import (
 	"github.com/google/uuid"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -44,7 +45,7 @@ import (
 const (
 	webHookName            = "default"
 	defaultInterfaceName   = "eth0"
-	defaultNamedNetwork    = "VM Network"
+	defaultNamedNetwork    = config.DefaultNamedNetwork
 	defaultCdromNamePrefix = "cdrom"
 )
 
@@ -58,29 +59,6 @@ const (
 
 // AddToManager adds the webhook to the provided manager.
 func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
-	// Index the VirtualMachineImage and ClusterVirtualMachineImage objects by
-	// status.name field to allow efficient querying in ResolveImageName().
-	if err := mgr.GetFieldIndexer().IndexField(
-		ctx,
-		&vmopv1.VirtualMachineImage{},
-		"status.name",
-		func(rawObj ctrlclient.Object) []string {
-			vmi := rawObj.(*vmopv1.VirtualMachineImage)
-			return []string{vmi.Status.Name}
-		}); err != nil {
-		return err
-	}
-	if err := mgr.GetFieldIndexer().IndexField(
-		ctx,
-		&vmopv1.ClusterVirtualMachineImage{},
-		"status.name",
-		func(rawObj ctrlclient.Object) []string {
-			cvmi := rawObj.(*vmopv1.ClusterVirtualMachineImage)
-			return []string{cvmi.Status.Name}
-		}); err != nil {
-		return err
-	}
-
 	hook, err := builder.NewMutatingWebhook(ctx, mgr, webHookName, NewMutator(mgr.GetClient()))
 	if err != nil {
 		return fmt.Errorf("failed to create mutation webhook: %w", err)
@@ -121,7 +99,9 @@ func (m mutator) Mutate(ctx *pkgctx.WebhookRequestContext) admission.Response {
 		wasMutated = true
 		SetCreatedAtAnnotations(ctx, modified)
 		AddDefaultNetworkInterface(ctx, m.client, modified)
+		SetDefaultNetworkInterfaceNames(ctx, modified, nil)
 		SetDefaultPowerState(ctx, m.client, modified)
+		SetDefaultMinHardwareVersion(ctx, modified)
 		SetDefaultCdromImgKindOnCreate(ctx, modified)
 		SetImageNameFromCdrom(ctx, modified)
 		if _, err := SetDefaultInstanceUUID(ctx, m.client, modified); err != nil {
@@ -138,6 +118,9 @@ func (m mutator) Mutate(ctx *pkgctx.WebhookRequestContext) admission.Response {
 				return admission.Denied(err.Error())
 			}
 		}
+		if _, err := SetDefaultResourcePolicy(ctx, m.client, modified); err != nil {
+			return admission.Denied(err.Error())
+		}
 	case admissionv1.Update:
 		oldVM, err := m.vmFromUnstructured(ctx.OldObj)
 		if err != nil {
@@ -159,6 +142,10 @@ func (m mutator) Mutate(ctx *pkgctx.WebhookRequestContext) admission.Response {
 		if ok := SetDefaultCdromImgKindOnUpdate(ctx, modified, oldVM); ok {
 			wasMutated = true
 		}
+
+		if ok := SetDefaultNetworkInterfaceNames(ctx, modified, oldVM); ok {
+			wasMutated = true
+		}
 	}
 
 	if !wasMutated {
@@ -306,6 +293,73 @@ func AddDefaultNetworkInterface(ctx *pkgctx.WebhookRequestContext, client ctrlcl
 		}
 	}
 
+	// Record the default named network so the interfaces that use it can be
+	// migrated if the default network changes.
+	if updated && netName != "" {
+		if vm.Annotations == nil {
+			vm.Annotations = map[string]string{}
+		}
+		vm.Annotations[constants.DefaultNamedNetworkAnnotationKey] = netName
+	}
+
+	return updated
+}
+
+// SetDefaultNetworkInterfaceNames names the VM's unnamed network interfaces.
+// On update, an unnamed interface keeps the name of the old VM's interface at
+// the same index. Otherwise, the interface is given the lowest ethN name that
+// is not used by another interface.
+// Return true if an interface was named, otherwise false.
+func SetDefaultNetworkInterfaceNames(
+	_ *pkgctx.WebhookRequestContext,
+	vm, oldVM *vmopv1.VirtualMachine) bool {
+
+	if vm.Spec.Network == nil {
+		return false
+	}
+
+	interfaces := vm.Spec.Network.Interfaces
+
+	usedNames := map[string]struct{}{}
+	for i := range interfaces {
+		if n := interfaces[i].Name; n != "" {
+			usedNames[n] = struct{}{}
+		}
+	}
+
+	var (
+		updated bool
+		nextIdx int
+	)
+
+	for i := range interfaces {
+		if interfaces[i].Name != "" {
+			continue
+		}
+
+		if oldVM != nil && oldVM.Spec.Network != nil && i < len(oldVM.Spec.Network.Interfaces) {
+			if n := oldVM.Spec.Network.Interfaces[i].Name; n != "" {
+				if _, ok := usedNames[n]; !ok {
+					interfaces[i].Name = n
+					usedNames[n] = struct{}{}
+					updated = true
+					continue
+				}
+			}
+		}
+
+		for {
+			n := fmt.Sprintf("eth%d", nextIdx)
+			nextIdx++
+			if _, ok := usedNames[n]; !ok {
+				interfaces[i].Name = n
+				usedNames[n] = struct{}{}
+				updated = true
+				break
+			}
+		}
+	}
+
 	return updated
 }
 
@@ -338,6 +392,22 @@ func SetDefaultPowerState(
 	return false
 }
 
+// SetDefaultMinHardwareVersion sets the minimum hardware version of a new VM
+// to the minimum supported hardware version if the VM does not specify one.
+// Return true if the default minimum hardware version was set, otherwise
+// false.
+func SetDefaultMinHardwareVersion(
+	ctx *pkgctx.WebhookRequestContext,
+	vm *vmopv1.VirtualMachine) bool {
+
+	minVersion := int32(pkgcfg.FromContext(ctx).MinSupportedHardwareVersion)
+	if minVersion > 0 && vm.Spec.MinHardwareVersion == 0 {
+		vm.Spec.MinHardwareVersion = minVersion
+		return true
+	}
+	return false
+}
+
 // SetDefaultInstanceUUID sets a default instance uuid for a new VM.
 // Return true if a default instance uuid was set, otherwise false.
 func SetDefaultInstanceUUID(
@@ -613,3 +683,53 @@ func SetDefaultEncryptionClass(
 	return true, nil
 
 }
+
+// SetDefaultResourcePolicy assigns spec.reserved.resourcePolicyName to the
+// namespace's default VirtualMachineSetResourcePolicy when creating a VM that
+// does not specify a resource policy. The default policy is named by the
+// namespace's DefaultResourcePolicyAnnotationKey annotation, and is only
+// assigned if the policy exists.
+func SetDefaultResourcePolicy(
+	ctx *pkgctx.WebhookRequestContext,
+	k8sClient ctrlclient.Client,
+	vm *vmopv1.VirtualMachine) (bool, error) {
+
+	// Return early if the VM already specifies a resource policy.
+	if r := vm.Spec.Reserved; r != nil && r.ResourcePolicyName != "" {
+		return false, nil
+	}
+
+	var ns corev1.Namespace
+	if err := k8sClient.Get(ctx, ctrlclient.ObjectKey{Name: vm.Namespace}, &ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	policyName := ns.Annotations[constants.DefaultResourcePolicyAnnotationKey]
+	if policyName == "" {
+		return false, nil
+	}
+
+	var policy vmopv1.VirtualMachineSetResourcePolicy
+	if err := k8sClient.Get(
+		ctx,
+		ctrlclient.ObjectKey{Namespace: vm.Namespace, Name: policyName},
+		&policy); err != nil {
+
+		if apierrors.IsNotFound(err) {
+			ctx.Logger.Info("Namespace's default resource policy does not exist",
+				"resourcePolicyName", policyName)
+			return false, nil
+		}
+		return false, err
+	}
+
+	if vm.Spec.Reserved == nil {
+		vm.Spec.Reserved = &vmopv1.VirtualMachineReservedSpec{}
+	}
+	vm.Spec.Reserved.ResourcePolicyName = policyName
+
+	return true, nil
+}
@@ -189,6 +189,7 @@ func unitTestsMutating() {
 					Expect(ctx.vm.Spec.Network.Interfaces[0].Network.Kind).To(BeEmpty())
 					Expect(ctx.vm.Spec.Network.Interfaces[0].Network.APIVersion).To(BeEmpty())
 					Expect(ctx.vm.Spec.Network.Interfaces[0].Network.Name).To(Equal(networkName))
+					Expect(ctx.vm.Annotations).To(HaveKeyWithValue(constants.DefaultNamedNetworkAnnotationKey, networkName))
 				})
 			})
 
@@ -276,6 +277,38 @@ func unitTestsMutating() {
 		})
 	})
 
+	Describe("SetDefaultMinHardwareVersion", func() {
+		BeforeEach(func() {
+			ctx.vm.Spec.MinHardwareVersion = 0
+		})
+
+		Context("When there is no minimum supported hardware version", func() {
+			It("Should not mutate MinHardwareVersion", func() {
+				Expect(mutation.SetDefaultMinHardwareVersion(&ctx.WebhookRequestContext, ctx.vm)).To(BeFalse())
+				Expect(ctx.vm.Spec.MinHardwareVersion).To(BeZero())
+			})
+		})
+
+		Context("When there is a minimum supported hardware version", func() {
+			BeforeEach(func() {
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.MinSupportedHardwareVersion = 19
+				})
+			})
+
+			It("Should set MinHardwareVersion when it is empty", func() {
+				Expect(mutation.SetDefaultMinHardwareVersion(&ctx.WebhookRequestContext, ctx.vm)).To(BeTrue())
+				Expect(ctx.vm.Spec.MinHardwareVersion).To(Equal(int32(19)))
+			})
+
+			It("Should not mutate MinHardwareVersion when it is set", func() {
+				ctx.vm.Spec.MinHardwareVersion = 21
+				Expect(mutation.SetDefaultMinHardwareVersion(&ctx.WebhookRequestContext, ctx.vm)).To(BeFalse())
+				Expect(ctx.vm.Spec.MinHardwareVersion).To(Equal(int32(21)))
+			})
+		})
+	})
+
 	Describe("SetDefaultInstanceUUID", func() {
 
 		var (
@@ -1195,6 +1228,49 @@ func unitTestsMutating() {
 		})
 	})
 
+	Describe("SetDefaultNetworkInterfaceNames", func() {
+
+		BeforeEach(func() {
+			ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+				Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+					{},
+					{Name: "eth0"},
+					{},
+				},
+			}
+		})
+
+		It("should name the unnamed interfaces with the lowest unused names", func() {
+			Expect(mutation.SetDefaultNetworkInterfaceNames(&ctx.WebhookRequestContext, ctx.vm, nil)).To(BeTrue())
+			Expect(ctx.vm.Spec.Network.Interfaces[0].Name).To(Equal("eth1"))
+			Expect(ctx.vm.Spec.Network.Interfaces[1].Name).To(Equal("eth0"))
+			Expect(ctx.vm.Spec.Network.Interfaces[2].Name).To(Equal("eth2"))
+		})
+
+		It("should not mutate a VM whose interfaces are named", func() {
+			ctx.vm.Spec.Network.Interfaces[0].Name = "eth1"
+			ctx.vm.Spec.Network.Interfaces[2].Name = "eth2"
+			Expect(mutation.SetDefaultNetworkInterfaceNames(&ctx.WebhookRequestContext, ctx.vm, nil)).To(BeFalse())
+		})
+
+		When("the interfaces were previously named", func() {
+			var oldVM *vmopv1.VirtualMachine
+
+			BeforeEach(func() {
+				oldVM = ctx.vm.DeepCopy()
+				oldVM.Spec.Network.Interfaces[0].Name = "nic1"
+				oldVM.Spec.Network.Interfaces[2].Name = "eth0"
+			})
+
+			It("should keep the previous names that are not in use", func() {
+				Expect(mutation.SetDefaultNetworkInterfaceNames(&ctx.WebhookRequestContext, ctx.vm, oldVM)).To(BeTrue())
+				Expect(ctx.vm.Spec.Network.Interfaces[0].Name).To(Equal("nic1"))
+				Expect(ctx.vm.Spec.Network.Interfaces[1].Name).To(Equal("eth0"))
+				Expect(ctx.vm.Spec.Network.Interfaces[2].Name).To(Equal("eth1"))
+			})
+		})
+	})
+
 	Describe("SetDefaultCdromImgKindOnUpdate", func() {
 
 		var oldVM *vmopv1.VirtualMachine
@@ -1269,4 +1345,88 @@ func unitTestsMutating() {
 			Expect(ctx.vm.Spec.ImageName).To(Equal("vmi-new"))
 		})
 	})
+	Describe("SetDefaultResourcePolicy", func() {
+		const (
+			nsName     = "my-namespace"
+			policyName = "my-policy"
+		)
+
+		var (
+			ns         *corev1.Namespace
+			policy     *vmopv1.VirtualMachineSetResourcePolicy
+			wasMutated bool
+			err        error
+		)
+
+		BeforeEach(func() {
+			ctx.vm.Namespace = nsName
+			ns = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nsName,
+					Annotations: map[string]string{
+						constants.DefaultResourcePolicyAnnotationKey: policyName,
+					},
+				},
+			}
+			policy = builder.DummyVirtualMachineSetResourcePolicy2(policyName, nsName)
+		})
+
+		JustBeforeEach(func() {
+			Expect(ctx.Client.Create(ctx, ns)).To(Succeed())
+			if policy != nil {
+				Expect(ctx.Client.Create(ctx, policy)).To(Succeed())
+			}
+			wasMutated, err = mutation.SetDefaultResourcePolicy(
+				&ctx.WebhookRequestContext,
+				ctx.Client,
+				ctx.vm)
+		})
+
+		When("the VM does not specify a resource policy", func() {
+			It("should set the namespace's default resource policy", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(wasMutated).To(BeTrue())
+				Expect(ctx.vm.Spec.Reserved).ToNot(BeNil())
+				Expect(ctx.vm.Spec.Reserved.ResourcePolicyName).To(Equal(policyName))
+			})
+		})
+
+		When("the VM specifies a resource policy", func() {
+			BeforeEach(func() {
+				ctx.vm.Spec.Reserved = &vmopv1.VirtualMachineReservedSpec{
+					ResourcePolicyName: "other-policy",
+				}
+			})
+
+			It("should not change the resource policy", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(wasMutated).To(BeFalse())
+				Expect(ctx.vm.Spec.Reserved.ResourcePolicyName).To(Equal("other-policy"))
+			})
+		})
+
+		When("the namespace does not have a default resource policy", func() {
+			BeforeEach(func() {
+				ns.Annotations = nil
+			})
+
+			It("should not set a resource policy", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(wasMutated).To(BeFalse())
+				Expect(ctx.vm.Spec.Reserved).To(BeNil())
+			})
+		})
+
+		When("the namespace's default resource policy does not exist", func() {
+			BeforeEach(func() {
+				policy = nil
+			})
+
+			It("should not set a resource policy", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(wasMutated).To(BeFalse())
+				Expect(ctx.vm.Spec.Reserved).To(BeNil())
+			})
+		})
+	})
 }
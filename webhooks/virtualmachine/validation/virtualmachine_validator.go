@@ -6,6 +6,7 @@ package validation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -25,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
@@ -40,12 +42,14 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/constants"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
 	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	"github.com/vmware-tanzu/vm-operator/pkg/util"
 	cloudinitvalidate "github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit/validate"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+	vmutil "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/vm"
 	"github.com/vmware-tanzu/vm-operator/webhooks/common"
 )
 
@@ -75,12 +79,31 @@ const (
 	modifyAnnotationNotAllowedForNonAdmin    = "modifying this annotation is not allowed for non-admin users"
 	modifyLabelNotAllowedForNonAdmin         = "modifying this label is not allowed for non-admin users"
 	invalidMinHardwareVersionNotSupported    = "should be less than or equal to %d"
+	invalidMinHardwareVersionBelowMinimum    = "should be greater than or equal to the minimum supported hardware version %d"
 	invalidMinHardwareVersionDowngrade       = "cannot downgrade hardware version"
 	invalidMinHardwareVersionPowerState      = "cannot upgrade hardware version unless powered off"
 	invalidImageKind                         = "supported: " + vmiKind + "; " + cvmiKind
+	unknownGuestIDFmt                        = "spec.guestID %q is not a known guest OS identifier and will be validated by vSphere when the VM is deployed"
+	classCPUsBelowImageRecommendationFmt     = "VirtualMachineClass %q has %d CPUs which is fewer than the %d recommended by image %q"
+	classMemoryBelowImageRecommendationFmt   = "VirtualMachineClass %q has %s of memory which is less than the %s recommended by image %q"
+	interfaceVirtualNetworkNotReadyFmt       = "network interface %q: %s"
+	invalidGuestIDHardwareVersionFmt         = "requires hardware version %s or later; set spec.minHardwareVersion to at least %d"
 	invalidZone                              = "cannot use zone that is being deleted"
 	restrictedToPrivUsers                    = "restricted to privileged users"
 	invalidPVCBYOKFmt                        = "cannot attach volume to vm with spec.crypto.encryptionClassName=%q"
+	strictResourcePolicyRequired             = "must be specified when strict validation is enabled for the namespace"
+	strictEncryptedStorageClassRequired      = "must be an encryption storage class when strict validation is enabled for the namespace"
+	strictNamedNetworkNotAllowed             = "must not be a named network when strict validation is enabled for the namespace"
+	maxVMsPerNamespaceExceededFmt            = "namespace %s already has the maximum of %d VirtualMachines"
+	maxVMsPerZoneExceededFmt                 = "zone %s already has the maximum of %d VirtualMachines"
+	sharedSCSIBusRequiresEagerZero           = "must be " + string(vmopv1.VirtualMachineVolumeProvisioningModeThickEagerZero) + " when a SCSI controller's bus is shared"
+	preventDeleteWithDeletePolicy            = "cannot be set when the volume's deletePolicy is " + string(vmopv1.VirtualMachineVolumeDeletePolicyDelete)
+	preventDeleteWithInstanceStorage         = "cannot be set for an instance storage volume"
+	preventDeleteVolumeChangeNotAllowed      = "cannot remove or change the claim of a volume with preventDelete set; set preventDelete to false first"
+	sharedSCSIBusSnapshotNotAllowed          = "snapshots are not supported when a SCSI controller's bus is shared"
+	macAddrNotUnicast                        = "must be a unicast MAC address"
+	macAddrNotInVMwareStaticRange            = "must be in the range 00:50:56:00:00:00-00:50:56:3f:ff:ff when using the VMware OUI 00:50:56"
+	macAddrInUse                             = "is already used by another VirtualMachine"
 )
 
 // +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha3-virtualmachine,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachines,versions=v1alpha3,name=default.validating.virtualmachine.v1alpha3.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
@@ -130,6 +153,7 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 	var fieldErrs field.ErrorList
 
 	fieldErrs = append(fieldErrs, v.validateAvailabilityZone(ctx, vm, nil)...)
+	fieldErrs = append(fieldErrs, v.validateMaxVMsOnCreate(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateImageOnCreate(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateClassOnCreate(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateStorageClass(ctx, vm)...)
@@ -145,15 +169,22 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 	fieldErrs = append(fieldErrs, v.validateAnnotation(ctx, vm, nil)...)
 	fieldErrs = append(fieldErrs, v.validateLabel(ctx, vm, nil)...)
 	fieldErrs = append(fieldErrs, v.validateNetworkHostAndDomainName(ctx, vm, nil)...)
+	fieldErrs = append(fieldErrs, v.validateMACAddresses(ctx, vm, nil)...)
 	fieldErrs = append(fieldErrs, v.validateMinHardwareVersion(ctx, vm, nil)...)
+	fieldErrs = append(fieldErrs, v.validateGuestID(ctx, vm, nil)...)
 	fieldErrs = append(fieldErrs, v.validateCdrom(ctx, vm)...)
+	fieldErrs = append(fieldErrs, v.validateStrictProfile(ctx, vm, nil)...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
 		validationErrs = append(validationErrs, fieldErr.Error())
 	}
 
-	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+	warnings := v.getRecommendedResourcesWarnings(ctx, vm)
+	warnings = append(warnings, v.getVirtualNetworkWarnings(ctx, vm)...)
+	warnings = append(warnings, v.getGuestIDWarnings(ctx, vm, nil)...)
+
+	return common.BuildValidationResponse(ctx, warnings, validationErrs, nil)
 }
 
 func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
@@ -186,8 +217,12 @@ func (v validator) validateImageOnUpdate(ctx *pkgctx.WebhookRequestContext, vm,
 
 	allErrs = append(allErrs,
 		validation.ValidateImmutableField(vm.Spec.ImageName, oldVM.Spec.ImageName, field.NewPath("spec", "imageName"))...)
-	allErrs = append(allErrs,
-		validation.ValidateImmutableField(vm.Spec.Image, oldVM.Spec.Image, field.NewPath("spec", "image"))...)
+	// Existing VMs being migrated from an older storage version may have an
+	// empty image ref that is backfilled by VM Operator.
+	if oldVM.Spec.Image != nil || !ctx.IsPrivilegedAccount {
+		allErrs = append(allErrs,
+			validation.ValidateImmutableField(vm.Spec.Image, oldVM.Spec.Image, field.NewPath("spec", "image"))...)
+	}
 
 	return allErrs
 }
@@ -236,22 +271,28 @@ func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.R
 	fieldErrs = append(fieldErrs, v.validateBootstrap(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateNetwork(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateVolumes(ctx, vm)...)
+	fieldErrs = append(fieldErrs, v.validateVolumesPreventDelete(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateInstanceStorageVolumes(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateReadinessProbe(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateAdvanced(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateNextRestartTimeOnUpdate(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateAnnotation(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateMinHardwareVersion(ctx, vm, oldVM)...)
+	fieldErrs = append(fieldErrs, v.validateGuestID(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateLabel(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateNetworkHostAndDomainName(ctx, vm, oldVM)...)
+	fieldErrs = append(fieldErrs, v.validateMACAddresses(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateCdrom(ctx, vm)...)
+	fieldErrs = append(fieldErrs, v.validateStrictProfile(ctx, vm, oldVM)...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
 		validationErrs = append(validationErrs, fieldErr.Error())
 	}
 
-	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+	warnings := v.getGuestIDWarnings(ctx, vm, oldVM)
+
+	return common.BuildValidationResponse(ctx, warnings, validationErrs, nil)
 }
 
 func (v validator) validateBootstrap(
@@ -619,7 +660,18 @@ func (v validator) validateNetwork(ctx *pkgctx.WebhookRequestContext, vm *vmopv1
 	if len(networkSpec.Interfaces) > 0 {
 		p := networkPath.Child("interfaces")
 
+		interfaceNames := map[string]struct{}{}
 		for i, interfaceSpec := range networkSpec.Interfaces {
+			namePath := p.Index(i).Child("name")
+			if _, ok := interfaceNames[interfaceSpec.Name]; ok {
+				allErrs = append(allErrs, field.Duplicate(namePath, interfaceSpec.Name))
+			}
+			interfaceNames[interfaceSpec.Name] = struct{}{}
+
+			for _, msg := range utilvalidation.IsDNS1123Label(interfaceSpec.Name) {
+				allErrs = append(allErrs, field.Invalid(namePath, interfaceSpec.Name, msg))
+			}
+
 			allErrs = append(allErrs, v.validateNetworkInterfaceSpec(p.Index(i), interfaceSpec, vm.Name)...)
 			allErrs = append(allErrs, v.validateNetworkInterfaceSpecWithBootstrap(ctx, p.Index(i), interfaceSpec, vm)...)
 		}
@@ -916,6 +968,51 @@ func (v validator) validateVolumes(ctx *pkgctx.WebhookRequestContext, vm *vmopv1
 		} else {
 			allErrs = append(allErrs, v.validateVolumeWithPVC(ctx, vm, vol, volPath)...)
 		}
+
+		if pvc := vol.PersistentVolumeClaim; vol.PreventDelete && pvc != nil {
+			switch {
+			case pvc.InstanceVolumeClaim != nil:
+				allErrs = append(allErrs, field.Forbidden(volPath.Child("preventDelete"), preventDeleteWithInstanceStorage))
+			case pvc.DeletePolicy == vmopv1.VirtualMachineVolumeDeletePolicyDelete:
+				allErrs = append(allErrs, field.Forbidden(volPath.Child("preventDelete"), preventDeleteWithDeletePolicy))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateVolumesPreventDelete validates that a volume whose PreventDelete
+// latch is set is not removed from the VM and its claim is not changed. The
+// latch must be removed first.
+func (v validator) validateVolumesPreventDelete(
+	_ *pkgctx.WebhookRequestContext,
+	vm, oldVM *vmopv1.VirtualMachine) field.ErrorList {
+
+	var allErrs field.ErrorList
+	volumesPath := field.NewPath("spec", "volumes")
+
+	for i, oldVol := range oldVM.Spec.Volumes {
+		if !oldVol.PreventDelete {
+			continue
+		}
+
+		var vol *vmopv1.VirtualMachineVolume
+		for j := range vm.Spec.Volumes {
+			if vm.Spec.Volumes[j].Name == oldVol.Name {
+				vol = &vm.Spec.Volumes[j]
+				break
+			}
+		}
+
+		switch {
+		case vol == nil:
+			allErrs = append(allErrs, field.Forbidden(volumesPath.Index(i), preventDeleteVolumeChangeNotAllowed))
+		case oldVol.PersistentVolumeClaim != nil &&
+			(vol.PersistentVolumeClaim == nil ||
+				vol.PersistentVolumeClaim.ClaimName != oldVol.PersistentVolumeClaim.ClaimName):
+			allErrs = append(allErrs, field.Forbidden(volumesPath.Index(i), preventDeleteVolumeChangeNotAllowed))
+		}
 	}
 
 	return allErrs
@@ -1055,6 +1152,80 @@ func (v validator) validateAdvanced(ctx *pkgctx.WebhookRequestContext, vm *vmopv
 		}
 	}
 
+	dataDisksPath := advancedPath.Child("dataDisks")
+	dataDiskNames := map[string]bool{}
+
+	for i, disk := range advanced.DataDisks {
+		diskPath := dataDisksPath.Index(i)
+
+		if disk.Name != "" {
+			if dataDiskNames[disk.Name] {
+				allErrs = append(allErrs, field.Duplicate(diskPath.Child("name"), disk.Name))
+			} else {
+				dataDiskNames[disk.Name] = true
+			}
+
+			for _, msg := range validation.NameIsDNSLabel(disk.Name, false) {
+				allErrs = append(allErrs, field.Invalid(diskPath.Child("name"), disk.Name, msg))
+			}
+		} else {
+			allErrs = append(allErrs, field.Required(diskPath.Child("name"), ""))
+		}
+
+		if disk.Capacity.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(diskPath.Child("capacity"),
+				disk.Capacity.String(), "must be greater than zero"))
+		} else if disk.Capacity.Value()%megaByte.Value() != 0 {
+			allErrs = append(allErrs, field.Invalid(diskPath.Child("capacity"),
+				disk.Capacity.Value(), vSphereVolumeSizeNotMBMultiple))
+		}
+	}
+
+	allErrs = append(allErrs, validateSCSIControllers(vm, advancedPath)...)
+
+	return allErrs
+}
+
+// validateSCSIControllers validates the VM's SCSI controllers and the
+// constraints vSphere places on a VM with a shared SCSI bus: the VM may not
+// have snapshots and its disks must be eagerly zeroed.
+func validateSCSIControllers(vm *vmopv1.VirtualMachine, advancedPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	advanced := vm.Spec.Advanced
+	controllersPath := advancedPath.Child("scsiControllers")
+	busNumbers := map[int32]bool{}
+
+	for i, c := range advanced.SCSIControllers {
+		if busNumbers[c.BusNumber] {
+			allErrs = append(allErrs, field.Duplicate(controllersPath.Index(i).Child("busNumber"), c.BusNumber))
+		}
+		busNumbers[c.BusNumber] = true
+	}
+
+	if !advanced.HasSharedSCSIBus() {
+		return allErrs
+	}
+
+	for i, disk := range advanced.DataDisks {
+		mode := disk.ProvisioningMode
+		if mode == "" {
+			mode = advanced.DefaultVolumeProvisioningMode
+		}
+		if mode != vmopv1.VirtualMachineVolumeProvisioningModeThickEagerZero {
+			allErrs = append(allErrs, field.Invalid(
+				advancedPath.Child("dataDisks").Index(i).Child("provisioningMode"),
+				mode,
+				sharedSCSIBusRequiresEagerZero))
+		}
+	}
+
+	if _, ok := vm.Annotations[constants.ReconfigureSnapshotAnnotationKey]; ok {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("metadata", "annotations").Key(constants.ReconfigureSnapshotAnnotationKey),
+			sharedSCSIBusSnapshotNotAllowed))
+	}
+
 	return allErrs
 }
 
@@ -1210,6 +1381,17 @@ func (v validator) validateUpdatesWhenPoweredOn(ctx *pkgctx.WebhookRequestContex
 
 	allErrs = append(allErrs, validateCdromWhenPoweredOn(vm.Spec.Cdrom, oldVM.Spec.Cdrom)...)
 
+	var scsiControllers, oldSCSIControllers []vmopv1.VirtualMachineSCSIControllerSpec
+	if adv := vm.Spec.Advanced; adv != nil {
+		scsiControllers = adv.SCSIControllers
+	}
+	if adv := oldVM.Spec.Advanced; adv != nil {
+		oldSCSIControllers = adv.SCSIControllers
+	}
+	if !equality.Semantic.DeepEqual(scsiControllers, oldSCSIControllers) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("advanced", "scsiControllers"), updatesNotAllowedWhenPowerOn))
+	}
+
 	// TODO: More checks.
 
 	return allErrs
@@ -1232,6 +1414,7 @@ func (v validator) validateImmutableFields(ctx *pkgctx.WebhookRequestContext, vm
 	}
 	allErrs = append(allErrs, v.validateImmutableReserved(ctx, vm, oldVM)...)
 	allErrs = append(allErrs, v.validateImmutableNetwork(ctx, vm, oldVM)...)
+	allErrs = append(allErrs, v.validateImmutableDataDisks(ctx, vm, oldVM)...)
 
 	return allErrs
 }
@@ -1251,6 +1434,18 @@ func (v validator) validateImmutableReserved(_ *pkgctx.WebhookRequestContext, vm
 	return append(allErrs, validation.ValidateImmutableField(newResourcePolicyName, oldResourcePolicyName, p.Child("resourcePolicyName"))...)
 }
 
+func (v validator) validateImmutableDataDisks(_ *pkgctx.WebhookRequestContext, vm, oldVM *vmopv1.VirtualMachine) field.ErrorList {
+	var dataDisks, oldDataDisks []vmopv1.VirtualMachineDataDiskSpec
+	if adv := vm.Spec.Advanced; adv != nil {
+		dataDisks = adv.DataDisks
+	}
+	if adv := oldVM.Spec.Advanced; adv != nil {
+		oldDataDisks = adv.DataDisks
+	}
+
+	return validation.ValidateImmutableField(dataDisks, oldDataDisks, field.NewPath("spec", "advanced", "dataDisks"))
+}
+
 func (v validator) validateImmutableNetwork(ctx *pkgctx.WebhookRequestContext, vm, oldVM *vmopv1.VirtualMachine) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -1334,6 +1529,45 @@ func (v validator) validateAvailabilityZone(ctx *pkgctx.WebhookRequestContext, v
 	return allErrs
 }
 
+// validateMaxVMsOnCreate enforces the configured maximum number of VMs per
+// namespace and per zone. The counts are read from the cache, so concurrent
+// creates may exceed the limits. The zone limit is also enforced when the VM
+// is placed, which covers the VMs created without a zone and these races.
+func (v validator) validateMaxVMsOnCreate(
+	ctx *pkgctx.WebhookRequestContext,
+	vm *vmopv1.VirtualMachine) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	cfg := pkgcfg.FromContext(ctx)
+
+	if maxVMs := cfg.MaxVirtualMachinesPerNamespace; maxVMs > 0 {
+		nsPath := field.NewPath("metadata", "namespace")
+		vmList := &vmopv1.VirtualMachineList{}
+		if err := v.client.List(ctx, vmList, ctrlclient.InNamespace(vm.Namespace)); err != nil {
+			allErrs = append(allErrs, field.InternalError(nsPath, err))
+		} else if len(vmList.Items) >= maxVMs {
+			allErrs = append(allErrs, field.Forbidden(nsPath,
+				fmt.Sprintf(maxVMsPerNamespaceExceededFmt, vm.Namespace, maxVMs)))
+		}
+	}
+
+	zoneName := vm.Labels[topology.KubernetesTopologyZoneLabelKey]
+	if maxVMs := cfg.MaxVirtualMachinesPerZone; maxVMs > 0 && zoneName != "" {
+		zoneLabelPath := field.NewPath("metadata", "labels").Key(topology.KubernetesTopologyZoneLabelKey)
+		vmList := &vmopv1.VirtualMachineList{}
+		if err := v.client.List(ctx, vmList,
+			ctrlclient.MatchingLabels{topology.KubernetesTopologyZoneLabelKey: zoneName}); err != nil {
+			allErrs = append(allErrs, field.InternalError(zoneLabelPath, err))
+		} else if len(vmList.Items) >= maxVMs {
+			allErrs = append(allErrs, field.Forbidden(zoneLabelPath,
+				fmt.Sprintf(maxVMsPerZoneExceededFmt, zoneName, maxVMs)))
+		}
+	}
+
+	return allErrs
+}
+
 // vmFromUnstructured returns the VirtualMachine from the unstructured object.
 func (v validator) vmFromUnstructured(obj runtime.Unstructured) (*vmopv1.VirtualMachine, error) {
 	vm := &vmopv1.VirtualMachine{}
@@ -1375,6 +1609,10 @@ func (v validator) validateAnnotation(ctx *pkgctx.WebhookRequestContext, vm, old
 		allErrs = append(allErrs, field.Forbidden(annotationPath.Key(vmopv1.FirstBootDoneAnnotation), modifyAnnotationNotAllowedForNonAdmin))
 	}
 
+	if vm.Annotations[vmopv1.PostFirstBootHooksAnnotation] != oldVM.Annotations[vmopv1.PostFirstBootHooksAnnotation] {
+		allErrs = append(allErrs, field.Forbidden(annotationPath.Key(vmopv1.PostFirstBootHooksAnnotation), modifyAnnotationNotAllowedForNonAdmin))
+	}
+
 	if vm.Annotations[vmopv1.RestoredVMAnnotation] != oldVM.Annotations[vmopv1.RestoredVMAnnotation] {
 		allErrs = append(allErrs, field.Forbidden(annotationPath.Key(vmopv1.RestoredVMAnnotation), modifyAnnotationNotAllowedForNonAdmin))
 	}
@@ -1387,8 +1625,20 @@ func (v validator) validateAnnotation(ctx *pkgctx.WebhookRequestContext, vm, old
 		allErrs = append(allErrs, field.Forbidden(annotationPath.Key(vmopv1.ImportedVMAnnotation), modifyAnnotationNotAllowedForNonAdmin))
 	}
 
+	if vm.Annotations[constants.UpgradedAtBuildVersionAnnotationKey] != oldVM.Annotations[constants.UpgradedAtBuildVersionAnnotationKey] {
+		allErrs = append(allErrs, field.Forbidden(annotationPath.Key(constants.UpgradedAtBuildVersionAnnotationKey), modifyAnnotationNotAllowedForNonAdmin))
+	}
+
+	if vm.Annotations[constants.MigratedNamedNetworkAnnotationKey] != oldVM.Annotations[constants.MigratedNamedNetworkAnnotationKey] {
+		allErrs = append(allErrs, field.Forbidden(annotationPath.Key(constants.MigratedNamedNetworkAnnotationKey), modifyAnnotationNotAllowedForNonAdmin))
+	}
+
 	// The following annotations will be added by the mutation webhook upon VM creation.
 	if !reflect.DeepEqual(oldVM, &vmopv1.VirtualMachine{}) {
+		if vm.Annotations[constants.DefaultNamedNetworkAnnotationKey] != oldVM.Annotations[constants.DefaultNamedNetworkAnnotationKey] {
+			allErrs = append(allErrs, field.Forbidden(annotationPath.Key(constants.DefaultNamedNetworkAnnotationKey), modifyAnnotationNotAllowedForNonAdmin))
+		}
+
 		if vm.Annotations[constants.CreatedAtBuildVersionAnnotationKey] != oldVM.Annotations[constants.CreatedAtBuildVersionAnnotationKey] {
 			allErrs = append(allErrs, field.Forbidden(annotationPath.Key(constants.CreatedAtBuildVersionAnnotationKey), modifyAnnotationNotAllowedForNonAdmin))
 		}
@@ -1414,6 +1664,18 @@ func (v validator) validateMinHardwareVersion(ctx *pkgctx.WebhookRequestContext,
 			fmt.Sprintf(invalidMinHardwareVersionNotSupported, vimtypes.MaxValidHardwareVersion)))
 	}
 
+	// New VMs may not be created with a hardware version older than the
+	// minimum supported hardware version. Existing VMs are not affected.
+	if oldVM == nil {
+		minVersion := int32(pkgcfg.FromContext(ctx).MinSupportedHardwareVersion)
+		if minVersion > 0 && vm.Spec.MinHardwareVersion != 0 && vm.Spec.MinHardwareVersion < minVersion {
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath,
+				vm.Spec.MinHardwareVersion,
+				fmt.Sprintf(invalidMinHardwareVersionBelowMinimum, minVersion)))
+		}
+	}
+
 	if oldVM != nil {
 		// Disallow downgrades.
 		oldHV, newHV := oldVM.Spec.MinHardwareVersion, vm.Spec.MinHardwareVersion
@@ -1440,6 +1702,210 @@ func (v validator) validateMinHardwareVersion(ctx *pkgctx.WebhookRequestContext,
 	return allErrs
 }
 
+// validateStrictProfile enforces the strict validation profile if the VM's
+// namespace opted into it with the StrictValidationLabelKey label. The
+// resource policy and storage class are immutable, so they are only validated
+// when the VM is created, while the network is validated whenever it changes.
+func (v validator) validateStrictProfile(
+	ctx *pkgctx.WebhookRequestContext,
+	vm, oldVM *vmopv1.VirtualMachine) field.ErrorList {
+
+	if oldVM != nil && reflect.DeepEqual(vm.Spec.Network, oldVM.Spec.Network) {
+		return nil
+	}
+
+	var ns corev1.Namespace
+	if err := v.client.Get(ctx, ctrlclient.ObjectKey{Name: vm.Namespace}, &ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return field.ErrorList{field.InternalError(field.NewPath("metadata", "namespace"), err)}
+	}
+
+	if ns.Labels[constants.StrictValidationLabelKey] != "true" {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	if oldVM == nil {
+		var resourcePolicyName string
+		if vm.Spec.Reserved != nil {
+			resourcePolicyName = vm.Spec.Reserved.ResourcePolicyName
+		}
+		if resourcePolicyName == "" {
+			allErrs = append(allErrs, field.Required(
+				field.NewPath("spec", "reserved", "resourcePolicyName"),
+				strictResourcePolicyRequired))
+		}
+
+		storageClassPath := field.NewPath("spec", "storageClass")
+		if ok, _, err := kubeutil.IsEncryptedStorageClass(ctx, v.client, vm.Spec.StorageClass); err != nil {
+			allErrs = append(allErrs, field.InternalError(storageClassPath, err))
+		} else if !ok {
+			allErrs = append(allErrs, field.Invalid(
+				storageClassPath,
+				vm.Spec.StorageClass,
+				strictEncryptedStorageClassRequired))
+		}
+	}
+
+	if vm.Spec.Network != nil && !vm.Spec.Network.Disabled {
+		p := field.NewPath("spec", "network", "interfaces")
+		for i, iface := range vm.Spec.Network.Interfaces {
+			// A named network is referenced only by its name.
+			if iface.Network == nil || iface.Network.Kind == "" {
+				var name string
+				if iface.Network != nil {
+					name = iface.Network.Name
+				}
+				allErrs = append(allErrs, field.Invalid(
+					p.Index(i).Child("network"),
+					name,
+					strictNamedNetworkNotAllowed))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// getRecommendedResourcesWarnings returns warnings if the VM's class has fewer
+// CPUs or less memory than recommended by the VM's image. These are warnings
+// and not errors since the image's recommendations are not requirements, and
+// nothing is returned if either the class or image cannot be retrieved.
+func (v validator) getRecommendedResourcesWarnings(ctx *pkgctx.WebhookRequestContext, vm *vmopv1.VirtualMachine) admission.Warnings {
+	if vm.Spec.ClassName == "" || vm.Spec.Image == nil || vm.Spec.Image.Name == "" {
+		return nil
+	}
+
+	img, err := vmopv1util.GetImage(ctx, v.client, *vm.Spec.Image, vm.Namespace)
+	if err != nil || img.Status.RecommendedResources == nil {
+		return nil
+	}
+
+	var vmClass vmopv1.VirtualMachineClass
+	if err := v.client.Get(
+		ctx,
+		ctrlclient.ObjectKey{Namespace: vm.Namespace, Name: vm.Spec.ClassName},
+		&vmClass); err != nil {
+
+		return nil
+	}
+
+	var (
+		warnings admission.Warnings
+		rec      = img.Status.RecommendedResources
+		hw       = vmClass.Spec.Hardware
+	)
+
+	if rec.Cpus > 0 && hw.Cpus < rec.Cpus {
+		warnings = append(warnings, fmt.Sprintf(
+			classCPUsBelowImageRecommendationFmt,
+			vm.Spec.ClassName, hw.Cpus, rec.Cpus, vm.Spec.Image.Name))
+	}
+	if rec.Memory != nil && hw.Memory.Cmp(*rec.Memory) < 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			classMemoryBelowImageRecommendationFmt,
+			vm.Spec.ClassName, hw.Memory.String(), rec.Memory.String(), vm.Spec.Image.Name))
+	}
+
+	return warnings
+}
+
+// getVirtualNetworkWarnings returns warnings if the NSX-T VirtualNetwork of
+// one of the VM's network interfaces does not exist or has not been realized.
+// These are warnings and not errors since the VirtualNetwork may be created or
+// realized after the VM, and the VM will not be created until it is.
+func (v validator) getVirtualNetworkWarnings(ctx *pkgctx.WebhookRequestContext, vm *vmopv1.VirtualMachine) admission.Warnings {
+	if pkgcfg.FromContext(ctx).NetworkProviderType != pkgcfg.NetworkProviderTypeNSXT {
+		return nil
+	}
+	if vm.Spec.Network == nil || vm.Spec.Network.Disabled {
+		return nil
+	}
+
+	var warnings admission.Warnings
+
+	for _, interfaceSpec := range vm.Spec.Network.Interfaces {
+		netRef := interfaceSpec.Network
+		if netRef == nil || netRef.Name == "" {
+			continue
+		}
+		if kind := netRef.Kind; kind != "" && kind != "VirtualNetwork" {
+			continue
+		}
+
+		err := network.CheckVirtualNetworkReady(ctx, v.client, vm.Namespace, netRef.Name)
+		if errors.Is(err, network.ErrVirtualNetworkNotFound) || errors.Is(err, network.ErrVirtualNetworkNotReady) {
+			warnings = append(warnings, fmt.Sprintf(
+				interfaceVirtualNetworkNotReadyFmt, interfaceSpec.Name, err))
+		}
+	}
+
+	return warnings
+}
+
+// validateGuestID validates the VM's hardware version is high enough to support
+// spec.guestID, which overrides the guest ID from the VM's image. The hardware
+// version is only validated when it can be determined, from
+// spec.minHardwareVersion and either the image on create or the VM's current
+// hardware version on update. A guest ID that is not known is not rejected
+// since it may be supported by a newer vSphere release, and is instead
+// validated by vSphere when the VM is deployed.
+func (v validator) validateGuestID(ctx *pkgctx.WebhookRequestContext, vm, oldVM *vmopv1.VirtualMachine) field.ErrorList {
+	var allErrs field.ErrorList
+
+	guestID := vm.Spec.GuestID
+	if guestID == "" {
+		return allErrs
+	}
+	if oldVM != nil && oldVM.Spec.GuestID == guestID {
+		// Do not fail updates to existing VMs with an unchanged guest ID.
+		return allErrs
+	}
+
+	fieldPath := field.NewPath("spec", "guestID")
+
+	minHV := vmutil.GetMinHardwareVersionForGuestID(guestID)
+	if minHV == 0 {
+		return allErrs
+	}
+
+	hv := vm.Spec.MinHardwareVersion
+	if oldVM != nil {
+		hv = max(hv, vm.Status.HardwareVersion)
+	} else if vm.Spec.Image != nil && vm.Spec.Image.Name != "" {
+		img, err := vmopv1util.GetImage(ctx, v.client, *vm.Spec.Image, vm.Namespace)
+		if err == nil && img.Status.HardwareVersion != nil {
+			hv = max(hv, *img.Status.HardwareVersion)
+		}
+	}
+
+	if hv > 0 && vimtypes.HardwareVersion(hv) < minHV {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath,
+			guestID,
+			fmt.Sprintf(invalidGuestIDHardwareVersionFmt, minHV, int32(minHV))))
+	}
+
+	return allErrs
+}
+
+// getGuestIDWarnings returns a warning if spec.guestID is set to a guest ID
+// that is not known to VM Operator. This is a warning and not an error since
+// the guest ID may be supported by the vSphere release the VM is deployed on.
+func (v validator) getGuestIDWarnings(_ *pkgctx.WebhookRequestContext, vm, oldVM *vmopv1.VirtualMachine) admission.Warnings {
+	guestID := vm.Spec.GuestID
+	if guestID == "" || vmutil.IsKnownGuestID(guestID) {
+		return nil
+	}
+	if oldVM != nil && oldVM.Spec.GuestID == guestID {
+		return nil
+	}
+	return admission.Warnings{fmt.Sprintf(unknownGuestIDFmt, guestID)}
+}
+
 func (v validator) validateLabel(ctx *pkgctx.WebhookRequestContext, vm, oldVM *vmopv1.VirtualMachine) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -1493,6 +1959,91 @@ func (v validator) validateNetworkHostAndDomainName(
 	return nil
 }
 
+// validateMACAddresses validates the MAC addresses assigned to the VM's
+// network interfaces are unicast addresses, in the static range if they have
+// the VMware OUI, that are not used by any other
+// interface of this VM or any other VM, in any namespace. Only MAC addresses
+// that are new or changed are checked against the other VMs so an existing
+// VM is not prevented from being updated.
+func (v validator) validateMACAddresses(
+	ctx *pkgctx.WebhookRequestContext,
+	vm, oldVM *vmopv1.VirtualMachine) field.ErrorList {
+
+	if vm.Spec.Network == nil {
+		return nil
+	}
+
+	oldMACs := map[string]struct{}{}
+	if oldVM != nil && oldVM.Spec.Network != nil {
+		for _, iface := range oldVM.Spec.Network.Interfaces {
+			oldMACs[vmopv1util.NormalizeMACAddress(iface.MACAddr)] = struct{}{}
+		}
+	}
+
+	var (
+		allErrs    field.ErrorList
+		p          = field.NewPath("spec", "network", "interfaces")
+		macs       = map[string]struct{}{}
+		newMACs    []string
+		newMACPath = map[string]*field.Path{}
+	)
+
+	for i, iface := range vm.Spec.Network.Interfaces {
+		if iface.MACAddr == "" {
+			continue
+		}
+
+		macPath := p.Index(i).Child("macAddr")
+
+		mac := vmopv1util.NormalizeMACAddress(iface.MACAddr)
+		if mac == "" {
+			allErrs = append(allErrs, field.Invalid(macPath, iface.MACAddr, "must be a MAC address"))
+			continue
+		}
+
+		hw, _ := net.ParseMAC(mac)
+		if hw[0]&0x01 != 0 {
+			allErrs = append(allErrs, field.Invalid(macPath, iface.MACAddr, macAddrNotUnicast))
+			continue
+		}
+
+		// vSphere only permits static MAC addresses with the VMware OUI in
+		// the range reserved for manually assigned addresses.
+		if hw[0] == 0x00 && hw[1] == 0x50 && hw[2] == 0x56 && hw[3] > 0x3f {
+			allErrs = append(allErrs, field.Invalid(macPath, iface.MACAddr, macAddrNotInVMwareStaticRange))
+			continue
+		}
+
+		if _, ok := macs[mac]; ok {
+			allErrs = append(allErrs, field.Duplicate(macPath, iface.MACAddr))
+			continue
+		}
+		macs[mac] = struct{}{}
+
+		if _, ok := oldMACs[mac]; !ok {
+			newMACs = append(newMACs, mac)
+			newMACPath[mac] = macPath
+		}
+	}
+
+	if len(newMACs) == 0 {
+		return allErrs
+	}
+
+	duplicates, err := vmopv1util.GetDuplicateMACAddresses(ctx, v.client, *vm, newMACs)
+	if err != nil {
+		return append(allErrs, field.InternalError(p, err))
+	}
+
+	for _, mac := range newMACs {
+		if _, ok := duplicates[mac]; ok {
+			allErrs = append(allErrs, field.Invalid(newMACPath[mac], mac, macAddrInUse))
+		}
+	}
+
+	return allErrs
+}
+
 func (v *validator) validateCdrom(
 	ctx *pkgctx.WebhookRequestContext,
 	vm *vmopv1.VirtualMachine) field.ErrorList {
@@ -14,6 +14,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -27,6 +28,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/cloudinit"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha3/sysprep"
+	ncpv1alpha1 "github.com/vmware-tanzu/vm-operator/external/ncp/api/v1alpha1"
 	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
 	pkgbuilder "github.com/vmware-tanzu/vm-operator/pkg/builder"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
@@ -46,6 +48,7 @@ const (
 	updateSuffix                   = "-updated"
 	dummyInstanceIDVal             = "dummy-instance-id"
 	dummyFirstBootDoneVal          = "dummy-first-boot-done"
+	dummyPostFirstBootHooksVal     = "dummy-post-first-boot-hooks"
 	dummyCreatedAtBuildVersionVal  = "dummy-created-at-build-version"
 	dummyCreatedAtSchemaVersionVal = "dummy-created-at-schema-version"
 	dummyRegisteredAnnVal          = "dummy-registered-annotation"
@@ -1000,11 +1003,15 @@ func unitTestsValidateCreate() {
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Annotations[vmopv1.InstanceIDAnnotation] = dummyInstanceIDVal
 						ctx.vm.Annotations[vmopv1.FirstBootDoneAnnotation] = dummyFirstBootDoneVal
+						ctx.vm.Annotations[vmopv1.PostFirstBootHooksAnnotation] = dummyPostFirstBootHooksVal
 						ctx.vm.Annotations[vmopv1.RestoredVMAnnotation] = dummyRegisteredAnnVal
 						ctx.vm.Annotations[vmopv1.ImportedVMAnnotation] = dummyImportedAnnVal
 						ctx.vm.Annotations[vmopv1.FailedOverVMAnnotation] = dummyFailedOverAnnVal
+						ctx.vm.Annotations[constants.UpgradedAtBuildVersionAnnotationKey] = dummyCreatedAtBuildVersionVal
 					},
 					validate: doValidateWithMsg(
+						field.Forbidden(annotationPath.Key(vmopv1.PostFirstBootHooksAnnotation), "modifying this annotation is not allowed for non-admin users").Error(),
+						field.Forbidden(annotationPath.Key(constants.UpgradedAtBuildVersionAnnotationKey), "modifying this annotation is not allowed for non-admin users").Error(),
 						field.Forbidden(annotationPath.Key(vmopv1.RestoredVMAnnotation), "modifying this annotation is not allowed for non-admin users").Error(),
 						field.Forbidden(annotationPath.Key(vmopv1.ImportedVMAnnotation), "modifying this annotation is not allowed for non-admin users").Error(),
 						field.Forbidden(annotationPath.Key(vmopv1.FailedOverVMAnnotation), "modifying this annotation is not allowed for non-admin users").Error(),
@@ -1012,6 +1019,24 @@ func unitTestsValidateCreate() {
 						field.Forbidden(annotationPath.Key(vmopv1.FirstBootDoneAnnotation), "modifying this annotation is not allowed for non-admin users").Error()),
 				},
 			),
+			Entry("should disallow creating VM with the migrated named network annotation set by SSO user",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Annotations[constants.MigratedNamedNetworkAnnotationKey] = "my-network"
+					},
+					validate: doValidateWithMsg(
+						field.Forbidden(annotationPath.Key(constants.MigratedNamedNetworkAnnotationKey), "modifying this annotation is not allowed for non-admin users").Error()),
+				},
+			),
+			Entry("should allow creating VM with the default named network annotation set by SSO user",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						// The annotation is set by the mutation webhook on create.
+						ctx.vm.Annotations[constants.DefaultNamedNetworkAnnotationKey] = "my-network"
+					},
+					expectAllowed: true,
+				},
+			),
 			Entry("should allow creating VM with admin-only annotations set by service user",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -1370,6 +1395,190 @@ func unitTestsValidateCreate() {
 				},
 			),
 		)
+
+		DescribeTable("PreventDelete",
+			doTest,
+			Entry("allow with deletePolicy Retain",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Volumes[0].PreventDelete = true
+						ctx.vm.Spec.Volumes[0].PersistentVolumeClaim.DeletePolicy = vmopv1.VirtualMachineVolumeDeletePolicyRetain
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("disallow with deletePolicy Delete",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Volumes[0].PreventDelete = true
+						ctx.vm.Spec.Volumes[0].PersistentVolumeClaim.DeletePolicy = vmopv1.VirtualMachineVolumeDeletePolicyDelete
+					},
+					validate: doValidateWithMsg(
+						`spec.volumes[0].preventDelete: Forbidden: cannot be set when the volume's deletePolicy is Delete`),
+				},
+			),
+		)
+	})
+
+	Context("Data disks", func() {
+		DescribeTable("create", doTest,
+			Entry("allow valid data disks",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							DataDisks: []vmopv1.VirtualMachineDataDiskSpec{
+								{Name: "data-1", Capacity: resource.MustParse("10Gi")},
+								{Name: "data-2", Capacity: resource.MustParse("512Mi")},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallow duplicate names",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							DataDisks: []vmopv1.VirtualMachineDataDiskSpec{
+								{Name: "data-1", Capacity: resource.MustParse("10Gi")},
+								{Name: "data-1", Capacity: resource.MustParse("10Gi")},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.advanced.dataDisks[1].name: Duplicate value: "data-1"`,
+					),
+				},
+			),
+
+			Entry("disallow invalid names",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							DataDisks: []vmopv1.VirtualMachineDataDiskSpec{
+								{Name: "Data_1", Capacity: resource.MustParse("10Gi")},
+								{Capacity: resource.MustParse("10Gi")},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.advanced.dataDisks[0].name: Invalid value: "Data_1"`,
+						`spec.advanced.dataDisks[1].name: Required value`,
+					),
+				},
+			),
+
+			Entry("disallow invalid capacity",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							DataDisks: []vmopv1.VirtualMachineDataDiskSpec{
+								{Name: "data-1", Capacity: resource.MustParse("0")},
+								{Name: "data-2", Capacity: resource.MustParse("1500k")},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.advanced.dataDisks[0].capacity: Invalid value: "0": must be greater than zero`,
+						`spec.advanced.dataDisks[1].capacity: Invalid value: 1500000: value must be a multiple of MB`,
+					),
+				},
+			),
+		)
+	})
+
+	Context("SCSI controllers", func() {
+		DescribeTable("create", doTest,
+			Entry("allow shared bus with eager-zero data disks",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							DefaultVolumeProvisioningMode: vmopv1.VirtualMachineVolumeProvisioningModeThickEagerZero,
+							DataDisks: []vmopv1.VirtualMachineDataDiskSpec{
+								{Name: "data-1", Capacity: resource.MustParse("10Gi")},
+							},
+							SCSIControllers: []vmopv1.VirtualMachineSCSIControllerSpec{
+								{BusNumber: 1, SharingMode: vmopv1.VirtualMachineSCSIControllerSharingModePhysical},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("allow thin data disks when the bus is not shared",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							DataDisks: []vmopv1.VirtualMachineDataDiskSpec{
+								{Name: "data-1", Capacity: resource.MustParse("10Gi")},
+							},
+							SCSIControllers: []vmopv1.VirtualMachineSCSIControllerSpec{
+								{BusNumber: 1, SharingMode: vmopv1.VirtualMachineSCSIControllerSharingModeNone},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallow duplicate bus numbers",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							SCSIControllers: []vmopv1.VirtualMachineSCSIControllerSpec{
+								{BusNumber: 1},
+								{BusNumber: 1, SharingMode: vmopv1.VirtualMachineSCSIControllerSharingModeVirtual},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.advanced.scsiControllers[1].busNumber: Duplicate value: 1`,
+					),
+				},
+			),
+
+			Entry("disallow shared bus with data disks that are not eager-zero",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							DefaultVolumeProvisioningMode: vmopv1.VirtualMachineVolumeProvisioningModeThickEagerZero,
+							DataDisks: []vmopv1.VirtualMachineDataDiskSpec{
+								{Name: "data-1", Capacity: resource.MustParse("10Gi")},
+								{
+									Name:             "data-2",
+									Capacity:         resource.MustParse("10Gi"),
+									ProvisioningMode: vmopv1.VirtualMachineVolumeProvisioningModeThick,
+								},
+							},
+							SCSIControllers: []vmopv1.VirtualMachineSCSIControllerSpec{
+								{BusNumber: 1, SharingMode: vmopv1.VirtualMachineSCSIControllerSharingModeVirtual},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.advanced.dataDisks[1].provisioningMode: Invalid value: "Thick": must be ThickEagerZero when a SCSI controller's bus is shared`,
+					),
+				},
+			),
+
+			Entry("disallow shared bus with snapshot before reconfigure",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Annotations[constants.ReconfigureSnapshotAnnotationKey] = "1h"
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							SCSIControllers: []vmopv1.VirtualMachineSCSIControllerSpec{
+								{BusNumber: 0, SharingMode: vmopv1.VirtualMachineSCSIControllerSharingModePhysical},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`metadata.annotations[vmoperator.vmware.com/snapshot-before-reconfigure]: Forbidden: snapshots are not supported when a SCSI controller's bus is shared`,
+					),
+				},
+			),
+		)
 	})
 
 	Context("Bootstrap", func() {
@@ -2196,157 +2405,358 @@ func unitTestsValidateCreate() {
 						"regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
 				},
 			),
-		)
 
-		DescribeTable("network create - host and domain names", doTest,
+			Entry("disallow creating VM with duplicate network interface names",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name: "eth0",
+								},
+								{
+									Name: "eth0",
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.network.interfaces[1].name: Duplicate value: "eth0"`),
+				},
+			),
 
-			Entry("allow simple host name",
+			Entry("disallow creating VM with a network interface name that is not a DNS label",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName: "hello-world",
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name: "eth_0",
+								},
+							},
 						}
 					},
-					expectAllowed: true,
+					validate: doValidateWithMsg(
+						`spec.network.interfaces[0].name: Invalid value: "eth_0": a lowercase RFC 1123 label must consist of lower case alphanumeric characters`),
 				},
 			),
+		)
 
-			Entry("allow host name with one character",
+		DescribeTable("network create - MAC addresses", doTest,
+			Entry("allow unique MAC address",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName: "a",
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name:    "eth0",
+									MACAddr: "00:50:56:2a:bb:cc",
+								},
+							},
 						}
 					},
 					expectAllowed: true,
 				},
 			),
 
-			Entry("allow host name with leading digit",
+			Entry("allow MAC address at the end of the VMware static range",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName: "1a",
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name:    "eth0",
+									MACAddr: "00:50:56:3f:ff:ff",
+								},
+							},
 						}
 					},
 					expectAllowed: true,
 				},
 			),
 
-			Entry("allow host name with unicode",
+			Entry("allow MAC address without the VMware OUI",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName: "ch✓ck",
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name:    "eth0",
+									MACAddr: "02:00:00:aa:bb:cc",
+								},
+							},
 						}
 					},
 					expectAllowed: true,
 				},
 			),
 
-			Entry("disallow host name with invalid character",
+			Entry("disallow MAC address with the VMware OUI outside of the static range",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName: "hello_world",
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name:    "eth0",
+									MACAddr: "00:50:56:40:00:00",
+								},
+							},
 						}
 					},
 					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network.hostName: Invalid value: "hello_world": %s`, vmopv1util.ErrInvalidHostName),
-					),
+						`spec.network.interfaces[0].macAddr: Invalid value: "00:50:56:40:00:00": must be in the range 00:50:56:00:00:00-00:50:56:3f:ff:ff when using the VMware OUI 00:50:56`),
 				},
 			),
 
-			Entry("disallow host name with leading dash",
+			Entry("disallow multicast MAC address",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName: "-hello-world",
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name:    "eth0",
+									MACAddr: "01:00:5e:aa:bb:cc",
+								},
+							},
 						}
 					},
 					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network.hostName: Invalid value: "-hello-world": %s`, vmopv1util.ErrInvalidHostName),
-					),
+						`spec.network.interfaces[0].macAddr: Invalid value: "01:00:5e:aa:bb:cc": must be a unicast MAC address`),
 				},
 			),
 
-			Entry("disallow host name longer than 63 characters",
+			Entry("disallow duplicate MAC address on the same VM",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName: strings.Repeat("a", 64),
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name:    "eth0",
+									MACAddr: "00:50:56:2a:bb:cc",
+								},
+								{
+									Name:    "eth1",
+									MACAddr: "00:50:56:2A:BB:CC",
+								},
+							},
 						}
 					},
 					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network.hostName: Invalid value: "%s": %s`, strings.Repeat("a", 64), vmopv1util.ErrInvalidHostName),
-					),
+						`spec.network.interfaces[1].macAddr: Duplicate value: "00:50:56:2A:BB:CC"`),
 				},
 			),
 
-			Entry("disallow host name longer than 15 characters if sysprep",
+			Entry("disallow MAC address assigned to another VM",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName: strings.Repeat("a", 16),
+						otherVM := builder.DummyVirtualMachine()
+						otherVM.Name = "other-vm"
+						otherVM.Namespace = "other-namespace"
+						otherVM.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name:    "eth0",
+									MACAddr: "00:50:56:2a:bb:cc",
+								},
+							},
 						}
-						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
-							Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{
-								Sysprep: &sysprep.Sysprep{},
+						Expect(ctx.Client.Create(ctx, otherVM)).To(Succeed())
+
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name:    "eth0",
+									MACAddr: "00:50:56:2a:bb:cc",
+								},
 							},
 						}
 					},
 					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network.hostName: Invalid value: "%s": %s`, strings.Repeat("a", 16), vmopv1util.ErrInvalidHostNameWindows),
-					),
+						`spec.network.interfaces[0].macAddr: Invalid value: "00:50:56:2a:bb:cc": is already used by another VirtualMachine`),
 				},
 			),
 
-			Entry("disallow host name with valid FQDN",
+			Entry("disallow MAC address observed on another VM",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
+						otherVM := builder.DummyVirtualMachine()
+						otherVM.Name = "other-vm"
+						otherVM.Namespace = "other-namespace"
+						otherVM.Status.Network = &vmopv1.VirtualMachineNetworkStatus{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceStatus{
+								{
+									IP: &vmopv1.VirtualMachineNetworkInterfaceIPStatus{
+										MACAddr: "00:50:56:2a:bb:cc",
+									},
+								},
+							},
+						}
+						Expect(ctx.Client.Create(ctx, otherVM)).To(Succeed())
+
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName: "hello-world.com",
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name:    "eth0",
+									MACAddr: "00:50:56:2a:bb:cc",
+								},
+							},
 						}
 					},
 					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network.hostName: Invalid value: "hello-world.com": %s`, vmopv1util.ErrInvalidHostName),
-					),
+						`is already used by another VirtualMachine`),
 				},
 			),
+		)
 
-			Entry("allow IP4 as host name when domain name is empty",
+		DescribeTable("network create - host and domain names", doTest,
+
+			Entry("allow simple host name",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName: "1.2.3.4",
+							HostName: "hello-world",
 						}
 					},
 					expectAllowed: true,
 				},
 			),
 
-			Entry("allow IP6 as host name when domain name is empty",
+			Entry("allow host name with one character",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName: "2001:db8:3333:4444:5555:6666:7777:8888",
+							HostName: "a",
 						}
 					},
 					expectAllowed: true,
 				},
 			),
 
-			Entry("disallow IP4 as host name when domain name is non-empty",
+			Entry("allow host name with leading digit",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName:   "1.2.3.4",
-							DomainName: "com",
+							HostName: "1a",
 						}
 					},
-					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network.hostName: Invalid value: "1.2.3.4": %s`, vmopv1util.ErrInvalidHostNameIPWithDomainName),
-					),
+					expectAllowed: true,
+				},
+			),
+
+			Entry("allow host name with unicode",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							HostName: "ch✓ck",
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallow host name with invalid character",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							HostName: "hello_world",
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network.hostName: Invalid value: "hello_world": %s`, vmopv1util.ErrInvalidHostName),
+					),
+				},
+			),
+
+			Entry("disallow host name with leading dash",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							HostName: "-hello-world",
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network.hostName: Invalid value: "-hello-world": %s`, vmopv1util.ErrInvalidHostName),
+					),
+				},
+			),
+
+			Entry("disallow host name longer than 63 characters",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							HostName: strings.Repeat("a", 64),
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network.hostName: Invalid value: "%s": %s`, strings.Repeat("a", 64), vmopv1util.ErrInvalidHostName),
+					),
+				},
+			),
+
+			Entry("disallow host name longer than 15 characters if sysprep",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							HostName: strings.Repeat("a", 16),
+						}
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{
+								Sysprep: &sysprep.Sysprep{},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network.hostName: Invalid value: "%s": %s`, strings.Repeat("a", 16), vmopv1util.ErrInvalidHostNameWindows),
+					),
+				},
+			),
+
+			Entry("disallow host name with valid FQDN",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							HostName: "hello-world.com",
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network.hostName: Invalid value: "hello-world.com": %s`, vmopv1util.ErrInvalidHostName),
+					),
+				},
+			),
+
+			Entry("allow IP4 as host name when domain name is empty",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							HostName: "1.2.3.4",
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("allow IP6 as host name when domain name is empty",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							HostName: "2001:db8:3333:4444:5555:6666:7777:8888",
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallow IP4 as host name when domain name is non-empty",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							HostName:   "1.2.3.4",
+							DomainName: "com",
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network.hostName: Invalid value: "1.2.3.4": %s`, vmopv1util.ErrInvalidHostNameIPWithDomainName),
+					),
 				},
 			),
 
@@ -2391,119 +2801,518 @@ func unitTestsValidateCreate() {
 			Entry("allow domain name with multiple parts",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							DomainName: "example.com",
-						}
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							DomainName: "example.com",
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("allow domain name with unicode in sub-domain",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							DomainName: "ch✓ck.com",
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallow domain name with unicode in top-level domain",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							DomainName: "check.✓om",
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network.domainName: Invalid value: "check.✓om": %s`, vmopv1util.ErrInvalidDomainName),
+					),
+				},
+			),
+
+			Entry("disallow domain name with invalid character",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							DomainName: "hello_world",
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network.domainName: Invalid value: "hello_world": %s`, vmopv1util.ErrInvalidDomainName),
+					),
+				},
+			),
+
+			Entry("disallow domain name with leading dash",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							DomainName: "-hello-world",
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network.domainName: Invalid value: "-hello-world": %s`, vmopv1util.ErrInvalidDomainName),
+					),
+				},
+			),
+
+			Entry("disallow domain name with one or more segments longer than 63 characters",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							DomainName: "abc." + strings.Repeat("a", 64) + ".com",
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network.domainName: Invalid value: "abc.%s.com": %s`, strings.Repeat("a", 64), vmopv1util.ErrInvalidDomainName),
+					),
+				},
+			),
+
+			Entry("disallow domain name longer than 255 characters",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							DomainName: strings.Repeat("a", 256),
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network.domainName: Invalid value: "%s": %s`, strings.Repeat("a", 256), vmopv1util.ErrInvalidDomainName),
+					),
+				},
+			),
+
+			Entry("disallow host and domain name if combined if combined they are longer than 255 characters",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							HostName:   strings.Repeat("a", 63),
+							DomainName: fmt.Sprintf("%[1]s.%[1]s.%[1]s.com", strings.Repeat("a", 63)),
+						}
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`spec.network: Invalid value: "%[1]s.%[1]s.%[1]s.%[1]s.com": %s`, strings.Repeat("a", 63), vmopv1util.ErrInvalidHostAndDomainName),
+					),
+				},
+			),
+		)
+	})
+
+	Context("HardwareVersion", func() {
+
+		DescribeTable("MinHardwareVersion", doTest,
+			Entry("disallow greater than max valid hardware version",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.MinHardwareVersion = 22
+						ctx.vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+					},
+					validate: doValidateWithMsg(
+						`spec.minHardwareVersion: Invalid value: 22: should be less than or equal to 21`,
+					),
+					expectAllowed: false,
+				},
+			),
+
+			Entry("disallow less than the minimum supported hardware version",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.MinSupportedHardwareVersion = 19
+						})
+						ctx.vm.Spec.MinHardwareVersion = 17
+					},
+					validate: doValidateWithMsg(
+						`spec.minHardwareVersion: Invalid value: 17: should be greater than or equal to the minimum supported hardware version 19`,
+					),
+					expectAllowed: false,
+				},
+			),
+
+			Entry("allow the minimum supported hardware version",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.MinSupportedHardwareVersion = 19
+						})
+						ctx.vm.Spec.MinHardwareVersion = 19
+					},
+					expectAllowed: true,
+				},
+			),
+		)
+	})
+
+	Context("Max VMs", func() {
+
+		createOtherVM := func(ctx *unitValidatingWebhookContext, namespace, zoneName string) {
+			otherVM := &vmopv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other-vm",
+					Namespace: namespace,
+					Labels: map[string]string{
+						topology.KubernetesTopologyZoneLabelKey: zoneName,
+					},
+				},
+			}
+			Expect(ctx.Client.Create(ctx, otherVM)).To(Succeed())
+		}
+
+		DescribeTable("create", doTest,
+			Entry("should allow when there is no limit",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createOtherVM(ctx, ctx.vm.Namespace, builder.DummyZoneName)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should allow when the namespace is below the limit",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.MaxVirtualMachinesPerNamespace = 2
+						})
+						createOtherVM(ctx, ctx.vm.Namespace, builder.DummyZoneName)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny when the namespace is at the limit",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.MaxVirtualMachinesPerNamespace = 1
+						})
+						createOtherVM(ctx, ctx.vm.Namespace, builder.DummyZoneName)
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`metadata.namespace: Forbidden: namespace %s already has the maximum of 1 VirtualMachines`, dummyNamespaceName),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should allow when the namespace limit is reached in a different namespace",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.MaxVirtualMachinesPerNamespace = 1
+						})
+						createOtherVM(ctx, "other-namespace", builder.DummyZoneName)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny when the zone is at the limit",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.MaxVirtualMachinesPerZone = 1
+						})
+						ctx.vm.Labels[topology.KubernetesTopologyZoneLabelKey] = builder.DummyZoneName
+						createOtherVM(ctx, "other-namespace", builder.DummyZoneName)
+					},
+					validate: doValidateWithMsg(
+						fmt.Sprintf(`metadata.labels[topology.kubernetes.io/zone]: Forbidden: zone %s already has the maximum of 1 VirtualMachines`, builder.DummyZoneName),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should allow when the zone limit is reached in a different zone",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.MaxVirtualMachinesPerZone = 1
+						})
+						ctx.vm.Labels[topology.KubernetesTopologyZoneLabelKey] = builder.DummyZoneName
+						createOtherVM(ctx, "other-namespace", "other-zone")
+					},
+					expectAllowed: true,
+				},
+			),
+		)
+	})
+
+	Context("Strict validation profile", func() {
+
+		setupStrictNamespace := func(ctx *unitValidatingWebhookContext, encrypted bool) {
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: ctx.vm.Namespace,
+					Labels: map[string]string{
+						constants.StrictValidationLabelKey: "true",
+					},
+				},
+			}
+			Expect(ctx.Client.Create(ctx, ns)).To(Succeed())
+
+			storageClass := builder.DummyStorageClass()
+			Expect(ctx.Client.Create(ctx, storageClass)).To(Succeed())
+			resourceQuota := builder.DummyResourceQuota(
+				ctx.vm.Namespace,
+				storageClass.Name+".storageclass.storage.k8s.io/persistentvolumeclaims")
+			Expect(ctx.Client.Create(ctx, resourceQuota)).To(Succeed())
+			Expect(kubeutil.MarkEncryptedStorageClass(ctx, ctx.Client, *storageClass, encrypted)).To(Succeed())
+
+			ctx.vm.Spec.StorageClass = storageClass.Name
+			ctx.vm.Spec.Volumes = nil
+		}
+
+		DescribeTable("create", doTest,
+			Entry("allow when the namespace does not enable strict validation",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Reserved = nil
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("allow when the VM follows the strict profile",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setupStrictNamespace(ctx, true)
+						ctx.vm.Spec.Reserved = &vmopv1.VirtualMachineReservedSpec{
+							ResourcePolicyName: "my-policy",
+						}
+						ctx.vm.Spec.Network.Interfaces[0].Network = &common.PartialObjectRef{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "Network",
+								APIVersion: "netoperator.vmware.com/v1alpha1",
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallow when the VM does not follow the strict profile",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setupStrictNamespace(ctx, false)
+						ctx.vm.Spec.Reserved = nil
+						ctx.vm.Spec.Network.Interfaces[0].Network = &common.PartialObjectRef{
+							Name: "my-named-network",
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.reserved.resourcePolicyName: Required value: must be specified when strict validation is enabled for the namespace`,
+						`spec.storageClass: Invalid value: "`+builder.DummyStorageClassName+`": must be an encryption storage class when strict validation is enabled for the namespace`,
+						`spec.network.interfaces[0].network: Invalid value: "my-named-network": must not be a named network when strict validation is enabled for the namespace`,
+					),
+					expectAllowed: false,
+				},
+			),
+		)
+	})
+
+	Context("Image recommended resources", func() {
+
+		createImageAndClass := func(ctx *unitValidatingWebhookContext, cpus int64, memory string) {
+			img := builder.DummyVirtualMachineImage(ctx.vm.Spec.Image.Name)
+			img.Namespace = ctx.vm.Namespace
+			img.Status.RecommendedResources = &vmopv1.VirtualMachineImageResourceInfo{
+				Cpus:   cpus,
+				Memory: ptr.To(resource.MustParse(memory)),
+			}
+			Expect(ctx.Client.Create(ctx, img)).To(Succeed())
+			Expect(ctx.Client.Status().Update(ctx, img)).To(Succeed())
+
+			vmClass := builder.DummyVirtualMachineClass(ctx.vm.Spec.ClassName)
+			vmClass.Namespace = ctx.vm.Namespace
+			Expect(ctx.Client.Create(ctx, vmClass)).To(Succeed())
+		}
+
+		DescribeTable("create", doTest,
+			Entry("allow without warnings when the class meets the image's recommendations",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createImageAndClass(ctx, 2, "4Gi")
+					},
+					validate: func(response admission.Response) {
+						Expect(response.Warnings).To(BeEmpty())
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("allow with warnings when the class is below the image's recommendations",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createImageAndClass(ctx, 4, "8Gi")
+					},
+					validate: func(response admission.Response) {
+						Expect(response.Warnings).To(ConsistOf(
+							ContainSubstring("has 2 CPUs which is fewer than the 4 recommended by image"),
+							ContainSubstring("has 4Gi of memory which is less than the 8Gi recommended by image"),
+						))
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("allow without warnings when the image does not exist",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {},
+					validate: func(response admission.Response) {
+						Expect(response.Warnings).To(BeEmpty())
+					},
+					expectAllowed: true,
+				},
+			),
+		)
+	})
+
+	Context("NSX-T VirtualNetwork readiness", func() {
+
+		const networkName = "my-vnet"
+
+		setupVirtualNetwork := func(ctx *unitValidatingWebhookContext, ready *bool) {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.NetworkProviderType = pkgcfg.NetworkProviderTypeNSXT
+			})
+			ctx.vm.Spec.Network.Interfaces[0].Network = &common.PartialObjectRef{
+				Name: networkName,
+			}
+			if ready == nil {
+				return
+			}
+			status := "False"
+			if *ready {
+				status = "True"
+			}
+			vnet := &ncpv1alpha1.VirtualNetwork{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      networkName,
+					Namespace: ctx.vm.Namespace,
+				},
+				Status: ncpv1alpha1.VirtualNetworkStatus{
+					Conditions: []ncpv1alpha1.VirtualNetworkCondition{
+						{
+							Type:    "Ready",
+							Status:  status,
+							Reason:  "SegmentNotRealized",
+							Message: "pending",
+						},
+					},
+				},
+			}
+			Expect(ctx.Client.Create(ctx, vnet)).To(Succeed())
+		}
+
+		DescribeTable("create", doTest,
+			Entry("allow without warnings when the VirtualNetwork is ready",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setupVirtualNetwork(ctx, ptr.To(true))
+					},
+					validate: func(response admission.Response) {
+						Expect(response.Warnings).To(BeEmpty())
 					},
 					expectAllowed: true,
 				},
 			),
-
-			Entry("allow domain name with unicode in sub-domain",
+			Entry("allow with warnings when the VirtualNetwork is not ready",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							DomainName: "ch✓ck.com",
-						}
+						setupVirtualNetwork(ctx, ptr.To(false))
+					},
+					validate: func(response admission.Response) {
+						Expect(response.Warnings).To(ConsistOf(
+							`network interface "eth0": virtual network is not ready: my-vnet: SegmentNotRealized - pending`,
+						))
 					},
 					expectAllowed: true,
 				},
 			),
-
-			Entry("disallow domain name with unicode in top-level domain",
+			Entry("allow with warnings when the VirtualNetwork does not exist",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							DomainName: "check.✓om",
-						}
+						setupVirtualNetwork(ctx, nil)
 					},
-					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network.domainName: Invalid value: "check.✓om": %s`, vmopv1util.ErrInvalidDomainName),
-					),
+					validate: func(response admission.Response) {
+						Expect(response.Warnings).To(ConsistOf(
+							`network interface "eth0": virtual network does not exist: my-vnet`,
+						))
+					},
+					expectAllowed: true,
 				},
 			),
+		)
+	})
 
-			Entry("disallow domain name with invalid character",
+	Context("GuestID", func() {
+
+		DescribeTable("GuestID create", doTest,
+			Entry("allow known guest ID without warnings",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							DomainName: "hello_world",
-						}
+						ctx.vm.Spec.GuestID = "vmwarePhoton64Guest"
 					},
-					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network.domainName: Invalid value: "hello_world": %s`, vmopv1util.ErrInvalidDomainName),
-					),
+					validate: func(response admission.Response) {
+						Expect(response.Warnings).To(BeEmpty())
+					},
+					expectAllowed: true,
 				},
 			),
-
-			Entry("disallow domain name with leading dash",
+			Entry("allow unknown guest ID with a warning",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							DomainName: "-hello-world",
-						}
+						ctx.vm.Spec.GuestID = "not-a-guest-id"
 					},
-					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network.domainName: Invalid value: "-hello-world": %s`, vmopv1util.ErrInvalidDomainName),
-					),
+					validate: func(response admission.Response) {
+						Expect(response.Warnings).To(ConsistOf(
+							`spec.guestID "not-a-guest-id" is not a known guest OS identifier and will be validated by vSphere when the VM is deployed`,
+						))
+					},
+					expectAllowed: true,
 				},
 			),
-
-			Entry("disallow domain name with one or more segments longer than 63 characters",
+			Entry("allow guest ID requiring a hardware version when the hardware version is unknown",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							DomainName: "abc." + strings.Repeat("a", 64) + ".com",
-						}
+						ctx.vm.Spec.GuestID = "windows12_64Guest"
+						ctx.vm.Spec.MinHardwareVersion = 0
 					},
-					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network.domainName: Invalid value: "abc.%s.com": %s`, strings.Repeat("a", 64), vmopv1util.ErrInvalidDomainName),
-					),
+					expectAllowed: true,
 				},
 			),
-
-			Entry("disallow domain name longer than 255 characters",
+			Entry("allow guest ID requiring a hardware version when minHardwareVersion is high enough",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							DomainName: strings.Repeat("a", 256),
-						}
+						ctx.vm.Spec.GuestID = "windows12_64Guest"
+						ctx.vm.Spec.MinHardwareVersion = 20
 					},
-					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network.domainName: Invalid value: "%s": %s`, strings.Repeat("a", 256), vmopv1util.ErrInvalidDomainName),
-					),
+					expectAllowed: true,
 				},
 			),
-
-			Entry("disallow host and domain name if combined if combined they are longer than 255 characters",
+			Entry("disallow guest ID requiring a hardware version when minHardwareVersion is too low",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
-							HostName:   strings.Repeat("a", 63),
-							DomainName: fmt.Sprintf("%[1]s.%[1]s.%[1]s.com", strings.Repeat("a", 63)),
-						}
+						ctx.vm.Spec.GuestID = "windows12_64Guest"
+						ctx.vm.Spec.MinHardwareVersion = 19
 					},
 					validate: doValidateWithMsg(
-						fmt.Sprintf(`spec.network: Invalid value: "%[1]s.%[1]s.%[1]s.%[1]s.com": %s`, strings.Repeat("a", 63), vmopv1util.ErrInvalidHostAndDomainName),
+						`spec.guestID: Invalid value: "windows12_64Guest": requires hardware version vmx-20 or later; set spec.minHardwareVersion to at least 20`,
 					),
 				},
 			),
-		)
-	})
-
-	Context("HardwareVersion", func() {
-
-		DescribeTable("MinHardwareVersion", doTest,
-			Entry("disallow greater than max valid hardware version",
+			Entry("disallow guest ID requiring a hardware version when the image's hardware version is too low",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.MinHardwareVersion = 22
-						ctx.vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+						img := builder.DummyVirtualMachineImage(ctx.vm.Spec.Image.Name)
+						img.Namespace = ctx.vm.Namespace
+						img.Status.HardwareVersion = ptr.To[int32](19)
+						Expect(ctx.Client.Create(ctx, img)).To(Succeed())
+						Expect(ctx.Client.Status().Update(ctx, img)).To(Succeed())
+
+						ctx.vm.Spec.GuestID = "windows12_64Guest"
+						ctx.vm.Spec.MinHardwareVersion = 0
 					},
 					validate: doValidateWithMsg(
-						`spec.minHardwareVersion: Invalid value: 22: should be less than or equal to 21`,
+						`spec.guestID: Invalid value: "windows12_64Guest": requires hardware version vmx-20 or later`,
 					),
-					expectAllowed: false,
 				},
 			),
 		)
@@ -2842,6 +3651,49 @@ func unitTestsValidateUpdate() {
 		}
 	}
 
+	Context("Strict validation profile", func() {
+
+		BeforeEach(func() {
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: ctx.vm.Namespace,
+					Labels: map[string]string{
+						constants.StrictValidationLabelKey: "true",
+					},
+				},
+			}
+			Expect(ctx.Client.Create(ctx, ns)).To(Succeed())
+		})
+
+		DescribeTable("update", doTest,
+			Entry("allow when the network is not changed",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Reserved = nil
+						ctx.oldVM.Spec.Reserved = nil
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallow when the network is changed to a named network",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Reserved = nil
+						ctx.oldVM.Spec.Reserved = nil
+						ctx.vm.Spec.Network.Interfaces[0].Network = &common.PartialObjectRef{
+							Name: "my-named-network",
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.network.interfaces[0].network: Invalid value: "my-named-network": must not be a named network when strict validation is enabled for the namespace`,
+					),
+					expectAllowed: false,
+				},
+			),
+		)
+	})
+
 	Context("Annotations", func() {
 		annotationPath := field.NewPath("metadata", "annotations")
 
@@ -2876,6 +3728,34 @@ func unitTestsValidateUpdate() {
 					),
 				},
 			),
+			Entry("should disallow updating the named network annotations by SSO user",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Annotations[constants.DefaultNamedNetworkAnnotationKey] = "my-network"
+						ctx.oldVM.Annotations[constants.MigratedNamedNetworkAnnotationKey] = "my-network"
+
+						ctx.vm.Annotations[constants.DefaultNamedNetworkAnnotationKey] = "my-network" + updateSuffix
+						ctx.vm.Annotations[constants.MigratedNamedNetworkAnnotationKey] = "my-network" + updateSuffix
+					},
+					validate: doValidateWithMsg(
+						field.Forbidden(annotationPath.Key(constants.MigratedNamedNetworkAnnotationKey), "modifying this annotation is not allowed for non-admin users").Error(),
+						field.Forbidden(annotationPath.Key(constants.DefaultNamedNetworkAnnotationKey), "modifying this annotation is not allowed for non-admin users").Error(),
+					),
+				},
+			),
+			Entry("should allow updating the named network annotations by service user",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.IsPrivilegedAccount = true
+
+						ctx.oldVM.Annotations[constants.DefaultNamedNetworkAnnotationKey] = "my-network"
+
+						ctx.vm.Annotations[constants.DefaultNamedNetworkAnnotationKey] = "my-network"
+						ctx.vm.Annotations[constants.MigratedNamedNetworkAnnotationKey] = "my-network" + updateSuffix
+					},
+					expectAllowed: true,
+				},
+			),
 			Entry("should disallow removing admin-only annotations by SSO user",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -3147,6 +4027,21 @@ func unitTestsValidateUpdate() {
 						field.Invalid(field.NewPath("spec", "image"), &vmopv1.VirtualMachineImageRef{Name: dummyVmiName + updateSuffix}, apivalidation.FieldImmutableErrorMsg).Error()),
 				},
 			),
+			Entry("allow changing image from nil to a non-nil value for privileged users",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.IsPrivilegedAccount = true
+						ctx.oldVM.Spec.Image = nil
+
+						ctx.vm = ctx.oldVM.DeepCopy()
+						ctx.vm.Spec.Image = &vmopv1.VirtualMachineImageRef{
+							Kind: vmiKind,
+							Name: dummyVmiName,
+						}
+					},
+					expectAllowed: true,
+				},
+			),
 			Entry("forbid changing image from non-nil to non-nil value",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -3664,6 +4559,80 @@ func unitTestsValidateUpdate() {
 		)
 	})
 
+	Context("Data disks", func() {
+		DescribeTable("update", doTest,
+			Entry("allow unchanged data disks",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							DataDisks: []vmopv1.VirtualMachineDataDiskSpec{
+								{Name: "data-1", Capacity: resource.MustParse("1Gi")},
+							},
+						}
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							DataDisks: []vmopv1.VirtualMachineDataDiskSpec{
+								{Name: "data-1", Capacity: resource.MustParse("1024Mi")},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallow adding a data disk",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							DataDisks: []vmopv1.VirtualMachineDataDiskSpec{
+								{Name: "data-1", Capacity: resource.MustParse("1Gi")},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.advanced.dataDisks: Invalid value:`,
+						`field is immutable`,
+					),
+				},
+			),
+		)
+	})
+
+	Context("SCSI controllers", func() {
+		DescribeTable("update", doTest,
+			Entry("allow if powered off",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+						ctx.vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							SCSIControllers: []vmopv1.VirtualMachineSCSIControllerSpec{
+								{BusNumber: 1, SharingMode: vmopv1.VirtualMachineSCSIControllerSharingModePhysical},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallow if powered on",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+						ctx.vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+						ctx.vm.Spec.Advanced = &vmopv1.VirtualMachineAdvancedSpec{
+							SCSIControllers: []vmopv1.VirtualMachineSCSIControllerSpec{
+								{BusNumber: 1, SharingMode: vmopv1.VirtualMachineSCSIControllerSharingModePhysical},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.advanced.scsiControllers: Forbidden: updates to this field is not allowed when VM power is on`,
+					),
+				},
+			),
+		)
+	})
+
 	Context("GuestID", func() {
 		const (
 			guestID      = "vmwarePhoton64Guest"
@@ -3723,6 +4692,64 @@ func unitTestsValidateUpdate() {
 				},
 			),
 		)
+
+		DescribeTable("GuestID update with supported guest IDs", doTest,
+
+			Entry("allow changing to unknown guest ID with a warning",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.GuestID = guestID
+						ctx.vm.Spec.GuestID = "not-a-guest-id"
+						ctx.vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+					},
+					validate: func(response admission.Response) {
+						Expect(response.Warnings).To(ConsistOf(
+							ContainSubstring(`"not-a-guest-id" is not a known guest OS identifier`),
+						))
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("allow unchanged unknown guest ID without warnings",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.GuestID = "not-a-guest-id"
+						ctx.vm.Spec.GuestID = "not-a-guest-id"
+					},
+					validate: func(response admission.Response) {
+						Expect(response.Warnings).To(BeEmpty())
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallow changing to guest ID that requires a newer hardware version",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.GuestID = guestID
+						ctx.vm.Spec.GuestID = "windows12_64Guest"
+						ctx.vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+						ctx.vm.Status.HardwareVersion = 19
+					},
+					validate: doValidateWithMsg(
+						`spec.guestID: Invalid value: "windows12_64Guest": requires hardware version vmx-20 or later`,
+					),
+				},
+			),
+
+			Entry("allow changing to guest ID when VM hardware version is high enough",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.GuestID = guestID
+						ctx.vm.Spec.GuestID = "windows12_64Guest"
+						ctx.vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+						ctx.vm.Status.HardwareVersion = 20
+					},
+					expectAllowed: true,
+				},
+			),
+		)
 	})
 
 	Context("CD-ROM", func() {
@@ -4090,6 +5117,47 @@ func unitTestsValidateUpdate() {
 				},
 			),
 		)
+
+		DescribeTable("PreventDelete",
+			doTest,
+			Entry("disallow removing a volume with preventDelete set",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.Volumes[0].PreventDelete = true
+						ctx.vm.Spec.Volumes = nil
+					},
+					validate: doValidateWithMsg(
+						`spec.volumes[0]: Forbidden: cannot remove or change the claim of a volume with preventDelete set`),
+				},
+			),
+			Entry("disallow changing the claim of a volume with preventDelete set",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.Volumes[0].PreventDelete = true
+						ctx.vm.Spec.Volumes[0].PreventDelete = true
+						ctx.vm.Spec.Volumes[0].PersistentVolumeClaim.ClaimName += updateSuffix
+					},
+					validate: doValidateWithMsg(
+						`spec.volumes[0]: Forbidden: cannot remove or change the claim of a volume with preventDelete set`),
+				},
+			),
+			Entry("allow clearing preventDelete",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.Volumes[0].PreventDelete = true
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("allow removing a volume after preventDelete is cleared",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Volumes = nil
+					},
+					expectAllowed: true,
+				},
+			),
+		)
 	})
 
 	Context("Network", func() {
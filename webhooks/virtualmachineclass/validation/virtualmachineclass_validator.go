@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -22,15 +23,18 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 
 	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
 	"github.com/vmware-tanzu/vm-operator/webhooks/common"
 )
 
 const (
 	webHookName = "default"
 
-	invalidCPUReqMsg    = "CPU request must not be larger than the CPU limit"
-	invalidMemoryReqMsg = "memory request must not be larger than the memory limit"
+	invalidCPUReqMsg     = "CPU request must not be larger than the CPU limit"
+	invalidMemoryReqMsg  = "memory request must not be larger than the memory limit"
+	unknownCapabilityFmt = "unknown capability %q"
 )
 
 // +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha3-virtualmachineclass,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachineclasses,versions=v1alpha3,name=default.validating.virtualmachineclass.v1alpha3.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
@@ -72,6 +76,7 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 	var fieldErrs field.ErrorList
 
 	fieldErrs = append(fieldErrs, v.validatePolicies(ctx, vmClass, field.NewPath("spec", "policies"))...)
+	fieldErrs = append(fieldErrs, v.validateRequiredCapabilities(vmClass)...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
@@ -86,7 +91,15 @@ func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Respo
 }
 
 func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	vmClass, err := v.vmClassFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
 	var fieldErrs field.ErrorList
+
+	fieldErrs = append(fieldErrs, v.validateRequiredCapabilities(vmClass)...)
+
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
 		validationErrs = append(validationErrs, fieldErr.Error())
@@ -117,6 +130,27 @@ func (v validator) validatePolicies(ctx *pkgctx.WebhookRequestContext, vmClass *
 	return allErrs
 }
 
+// validateRequiredCapabilities validates the capabilities in the required
+// capabilities annotation are known.
+func (v validator) validateRequiredCapabilities(vmClass *vmopv1.VirtualMachineClass) field.ErrorList {
+	var allErrs field.ErrorList
+
+	value, ok := vmClass.Annotations[constants.RequiredCapabilitiesAnnotationKey]
+	if !ok {
+		return allErrs
+	}
+
+	annotationPath := field.NewPath("metadata", "annotations").Key(constants.RequiredCapabilitiesAnnotationKey)
+	for _, s := range strings.Split(value, ",") {
+		c := virtualmachine.Capability(strings.ToLower(strings.TrimSpace(s)))
+		if c != "" && !virtualmachine.IsKnownCapability(c) {
+			allErrs = append(allErrs, field.Invalid(annotationPath, value, fmt.Sprintf(unknownCapabilityFmt, s)))
+		}
+	}
+
+	return allErrs
+}
+
 // vmClassFromUnstructured returns the VirtualMachineClass from the unstructured object.
 func (v validator) vmClassFromUnstructured(obj runtime.Unstructured) (*vmopv1.VirtualMachineClass, error) {
 	vmClass := &vmopv1.VirtualMachineClass{}
@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
 
 	"github.com/vmware-tanzu/vm-operator/test/builder"
@@ -90,6 +91,7 @@ func unitTestsValidateCreate() {
 		invalidMemoryRequest bool
 		noCPULimit           bool
 		noMemoryLimit        bool
+		capabilities         string
 	}
 
 	validateCreate := func(args createArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
@@ -109,6 +111,11 @@ func unitTestsValidateCreate() {
 		if args.noMemoryLimit {
 			ctx.vmClass.Spec.Policies.Resources.Limits.Memory = resource.MustParse("0")
 		}
+		if args.capabilities != "" {
+			ctx.vmClass.Annotations = map[string]string{
+				constants.RequiredCapabilitiesAnnotationKey: args.capabilities,
+			}
+		}
 
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.vmClass)
 		Expect(err).ToNot(HaveOccurred())
@@ -133,12 +140,16 @@ func unitTestsValidateCreate() {
 	reqPath := field.NewPath("spec", "policies", "resources", "requests")
 	invalidCPUField := field.Invalid(reqPath.Child("cpu"), "2Gi", "CPU request must not be larger than the CPU limit")
 	invalidMemField := field.Invalid(reqPath.Child("memory"), "2Gi", "memory request must not be larger than the memory limit")
+	capPath := field.NewPath("metadata", "annotations").Key(constants.RequiredCapabilitiesAnnotationKey)
+	unknownCapField := field.Invalid(capPath, "vtpm,gpu", `unknown capability "gpu"`)
 	DescribeTable("create table", validateCreate,
 		Entry("should allow valid", createArgs{}, true, nil, nil),
 		Entry("should allow no cpu limit", createArgs{noCPULimit: true}, true, nil, nil),
 		Entry("should allow no memory limit", createArgs{noMemoryLimit: true}, true, nil, nil),
 		Entry("should deny invalid cpu request", createArgs{invalidCPURequest: true}, false, invalidCPUField.Error(), nil),
 		Entry("should deny invalid memory request", createArgs{invalidMemoryRequest: true}, false, invalidMemField.Error(), nil),
+		Entry("should allow known required capabilities", createArgs{capabilities: "vsan, vTPM,pmem"}, true, nil, nil),
+		Entry("should deny unknown required capability", createArgs{capabilities: "vtpm,gpu"}, false, unknownCapField.Error(), nil),
 	)
 }
 
@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -68,6 +69,7 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 	var fieldErrs field.ErrorList
 
 	fieldErrs = append(fieldErrs, v.validateLabelSelectorLabelMatch(ctx, rs, nil)...)
+	fieldErrs = append(fieldErrs, v.validateStrategy(ctx, rs)...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
@@ -89,6 +91,7 @@ func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.R
 
 	var fieldErrs field.ErrorList
 	fieldErrs = append(fieldErrs, v.validateLabelSelectorLabelMatch(ctx, rs, nil)...)
+	fieldErrs = append(fieldErrs, v.validateStrategy(ctx, rs)...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
@@ -133,6 +136,60 @@ func (v validator) validateLabelSelectorLabelMatch(
 	return allErrs
 }
 
+func (v validator) validateStrategy(
+	_ *pkgctx.WebhookRequestContext,
+	rs *vmopv1.VirtualMachineReplicaSet) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	ru := rs.Spec.Strategy.RollingUpdate
+	if ru == nil {
+		return nil
+	}
+
+	ruPath := field.NewPath("spec", "strategy", "rollingUpdate")
+
+	if rs.Spec.Strategy.Type == vmopv1.VirtualMachineReplicaSetUpdateStrategyTypeRecreate {
+		allErrs = append(allErrs, field.Forbidden(ruPath,
+			fmt.Sprintf("may not be specified when strategy type is %q",
+				vmopv1.VirtualMachineReplicaSetUpdateStrategyTypeRecreate)))
+		return allErrs
+	}
+
+	isZero := func(p *field.Path, val *intstr.IntOrString) bool {
+		if val == nil {
+			return false
+		}
+		// Scaling a value against 100 replicas validates the value and
+		// returns zero only when the value is zero.
+		scaled, err := intstr.GetScaledValueFromIntOrPercent(val, 100, true)
+		switch {
+		case err != nil:
+			allErrs = append(allErrs, field.Invalid(p, val.String(), err.Error()))
+		case scaled < 0:
+			allErrs = append(allErrs, field.Invalid(p, val.String(), "must be greater than or equal to 0"))
+		case val.Type == intstr.String && scaled > 100:
+			allErrs = append(allErrs, field.Invalid(p, val.String(), "must not be greater than 100%"))
+		}
+		return err == nil && scaled == 0
+	}
+
+	surgeIsZero := isZero(ruPath.Child("maxSurge"), ru.MaxSurge)
+	unavailableIsZero := isZero(ruPath.Child("maxUnavailable"), ru.MaxUnavailable)
+
+	// MaxUnavailable defaults to zero.
+	if ru.MaxUnavailable == nil {
+		unavailableIsZero = true
+	}
+
+	if surgeIsZero && unavailableIsZero {
+		allErrs = append(allErrs, field.Invalid(ruPath.Child("maxUnavailable"),
+			ru.MaxUnavailable, "may not be 0 when maxSurge is 0"))
+	}
+
+	return allErrs
+}
+
 // rsFromUnstructured returns the VirtualMachineClass from the unstructured object.
 func (v validator) rsFromUnstructured(obj runtime.Unstructured) (*vmopv1.VirtualMachineReplicaSet, error) {
 	rs := &vmopv1.VirtualMachineReplicaSet{}
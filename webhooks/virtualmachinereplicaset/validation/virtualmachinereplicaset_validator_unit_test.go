@@ -13,11 +13,13 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha3"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -247,6 +249,86 @@ func unitTestVaildateTemplateObjectMetaAndSelectorMatching() {
 			),
 		)
 	})
+
+	Context("Strategy", func() {
+		ruPath := field.NewPath("spec", "strategy", "rollingUpdate")
+
+		DescribeTable("update strategy validations", doTest,
+			Entry("should allow the default rolling update",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.rs.Spec.Strategy.Type = vmopv1.VirtualMachineReplicaSetUpdateStrategyTypeRollingUpdate
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should allow a percentage maxUnavailable with zero maxSurge",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.rs.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineReplicaSetRollingUpdate{
+							MaxUnavailable: ptr.To(intstr.FromString("25%")),
+							MaxSurge:       ptr.To(intstr.FromInt32(0)),
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should return error when maxSurge and maxUnavailable are both zero",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.rs.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineReplicaSetRollingUpdate{
+							MaxSurge: ptr.To(intstr.FromString("0%")),
+						}
+					},
+					validate: func(ctx *unitValidatingWebhookContext, response admission.Response) {
+						Expect(string(response.Result.Reason)).To(ContainSubstring(
+							ruPath.Child("maxUnavailable").String() + ": Invalid value"))
+						Expect(string(response.Result.Reason)).To(ContainSubstring("may not be 0 when maxSurge is 0"))
+					},
+					expectAllowed: false,
+				},
+			),
+			Entry("should return error on an invalid percentage",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.rs.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineReplicaSetRollingUpdate{
+							MaxSurge: ptr.To(intstr.FromString("ten")),
+						}
+					},
+					validate: func(ctx *unitValidatingWebhookContext, response admission.Response) {
+						Expect(string(response.Result.Reason)).To(ContainSubstring(
+							ruPath.Child("maxSurge").String() + ": Invalid value"))
+					},
+					expectAllowed: false,
+				},
+			),
+			Entry("should return error on a negative value",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.rs.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineReplicaSetRollingUpdate{
+							MaxUnavailable: ptr.To(intstr.FromInt32(-1)),
+						}
+					},
+					validate: func(ctx *unitValidatingWebhookContext, response admission.Response) {
+						Expect(string(response.Result.Reason)).To(ContainSubstring("must be greater than or equal to 0"))
+					},
+					expectAllowed: false,
+				},
+			),
+			Entry("should return error on rollingUpdate with the Recreate strategy",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.rs.Spec.Strategy.Type = vmopv1.VirtualMachineReplicaSetUpdateStrategyTypeRecreate
+						ctx.rs.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineReplicaSetRollingUpdate{}
+					},
+					validate: func(ctx *unitValidatingWebhookContext, response admission.Response) {
+						Expect(string(response.Result.Reason)).To(ContainSubstring(ruPath.String() + ": Forbidden"))
+					},
+					expectAllowed: false,
+				},
+			),
+		)
+	})
 }
 
 func unitTestsValidateUpdate() {
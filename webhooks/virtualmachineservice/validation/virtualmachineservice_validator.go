@@ -91,6 +91,7 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 	var fieldErrs field.ErrorList
 	fieldErrs = append(fieldErrs, v.validateMetadata(ctx, vmService)...)
 	fieldErrs = append(fieldErrs, v.validateSpec(ctx, vmService)...)
+	fieldErrs = append(fieldErrs, validateSelectorRequired(vmService, field.NewPath("spec"))...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
@@ -119,6 +120,12 @@ func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.R
 	fieldErrs = append(fieldErrs, v.validateAllowedChanges(ctx, vmService, oldVMService)...)
 	fieldErrs = append(fieldErrs, v.validateSpec(ctx, vmService)...)
 
+	// Selectorless services that were created before a selector was required
+	// may still be updated as long as they remain selectorless.
+	if len(oldVMService.Spec.Selector) != 0 {
+		fieldErrs = append(fieldErrs, validateSelectorRequired(vmService, field.NewPath("spec"))...)
+	}
+
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
 		validationErrs = append(validationErrs, fieldErr.Error())
@@ -239,6 +246,20 @@ func validatePorts(vmService *vmopv1.VirtualMachineService, specPath *field.Path
 	return allErrs
 }
 
+// validateSelectorRequired returns an error if a non-ExternalName service does
+// not have a selector. Without a selector, the service's endpoints would never
+// include any VMs.
+func validateSelectorRequired(vmService *vmopv1.VirtualMachineService, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if vmService.Spec.Type != vmopv1.VirtualMachineServiceTypeExternalName && len(vmService.Spec.Selector) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("selector"),
+			fmt.Sprintf("must be specified for %s services", vmService.Spec.Type)))
+	}
+
+	return allErrs
+}
+
 func validateServicePort(sp *vmopv1.VirtualMachineServicePort, requireName bool, allNames *sets.Set[string], fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
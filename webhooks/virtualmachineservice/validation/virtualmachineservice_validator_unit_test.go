@@ -91,6 +91,8 @@ func unitTestsValidateCreate() {
 		invalidClusterIP      bool
 		invalidLBSourceRanges bool
 		invalidExternalName   bool
+		emptySelector         bool
+		externalNameNoSel     bool
 	}
 
 	validateCreate := func(args createArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
@@ -123,6 +125,14 @@ func unitTestsValidateCreate() {
 			ctx.vmService.Spec.Type = vmopv1.VirtualMachineServiceTypeExternalName
 			ctx.vmService.Spec.ExternalName = "InValid!"
 		}
+		if args.emptySelector {
+			ctx.vmService.Spec.Selector = nil
+		}
+		if args.externalNameNoSel {
+			ctx.vmService.Spec.Type = vmopv1.VirtualMachineServiceTypeExternalName
+			ctx.vmService.Spec.ExternalName = "my.example.com"
+			ctx.vmService.Spec.Selector = nil
+		}
 
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.vmService)
 		Expect(err).ToNot(HaveOccurred())
@@ -154,6 +164,8 @@ func unitTestsValidateCreate() {
 		Entry("should deny invalid ClusterIP", createArgs{invalidClusterIP: true}, false, "spec.clusterIP: Invalid value: \"100.1000.1.1\": must be a valid IP address", nil),
 		Entry("should deny invalid LoadBalancerSourceRanges", createArgs{invalidLBSourceRanges: true}, false, `spec.loadBalancerSourceRanges[0]: Invalid value: "10.1.1.1/42": must be compatible with https://pkg.go.dev/net#ParseCIDR`, nil),
 		Entry("should deny invalid ExternalName", createArgs{invalidExternalName: true}, false, "spec.externalName: Invalid value: \"InValid!\": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters", nil),
+		Entry("should deny empty selector", createArgs{emptySelector: true}, false, "spec.selector: Required value: must be specified for LoadBalancer services", nil),
+		Entry("should allow empty selector for ExternalName", createArgs{externalNameNoSel: true}, true, nil, nil),
 	)
 
 	validatePortCreate := func(expectedReason string, ports []vmopv1.VirtualMachineServicePort) {
@@ -254,8 +266,11 @@ func unitTestsValidateUpdate() {
 	)
 
 	type updateArgs struct {
-		updateType      bool
-		updateClusterIP bool
+		updateType             bool
+		updateClusterIP        bool
+		removeSelector         bool
+		oldSelectorless        bool
+		oldSelectorlessUpdated bool
 	}
 
 	validateUpdate := func(args updateArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
@@ -267,6 +282,19 @@ func unitTestsValidateUpdate() {
 		if args.updateClusterIP {
 			ctx.vmService.Spec.ClusterIP = "9.9.9.9"
 		}
+		if args.removeSelector {
+			ctx.vmService.Spec.Selector = nil
+		}
+		if args.oldSelectorless {
+			oldVMService := ctx.vmService.DeepCopy()
+			oldVMService.Spec.Selector = nil
+			ctx.WebhookRequestContext.OldObj, err = builder.ToUnstructured(oldVMService)
+			Expect(err).ToNot(HaveOccurred())
+			ctx.vmService.Spec.Selector = nil
+		}
+		if args.oldSelectorlessUpdated {
+			ctx.vmService.Spec.LoadBalancerSourceRanges = []string{"10.1.1.0/24"}
+		}
 
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.vmService)
 		Expect(err).ToNot(HaveOccurred())
@@ -291,6 +319,9 @@ func unitTestsValidateUpdate() {
 	DescribeTable("update table", validateUpdate,
 		Entry("should allow", updateArgs{}, true, nil, nil),
 		Entry("should deny Type change", updateArgs{updateType: true}, false, "spec.type: Forbidden: field is immutable", nil),
+		Entry("should deny removing the selector", updateArgs{removeSelector: true}, false,
+			"spec.selector: Required value: must be specified for LoadBalancer services", nil),
+		Entry("should allow update of a selectorless service", updateArgs{oldSelectorless: true, oldSelectorlessUpdated: true}, true, nil, nil),
 		Entry("should deny ClusterIP change", updateArgs{updateClusterIP: true}, false, "spec.clusterIP: Forbidden: field is immutable", nil),
 	)
 